@@ -0,0 +1,379 @@
+package backtester
+
+import (
+	"math"
+	"sort"
+	"strconv"
+	"time"
+
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+)
+
+// Backtest replays market data through a strategy and simulates the fills of
+// the orders it places
+type Backtest struct {
+	Config   Config
+	funds    float64
+	position float64
+	price    float64
+	current  *Data
+	orders   []*Order
+	nextID   int
+	results  Results
+}
+
+// New returns a backtest for the config, fees and slippage default to none
+func New(cfg Config) (*Backtest, error) {
+	if cfg.InitialFunds < 0 {
+		return nil, ErrInsufficientFunds
+	}
+
+	if cfg.Fees == nil {
+		cfg.Fees = PercentageFee{}
+	}
+
+	if cfg.Slippage == nil {
+		cfg.Slippage = NoSlippage{}
+	}
+
+	return &Backtest{
+		Config: cfg,
+		funds:  cfg.InitialFunds,
+	}, nil
+}
+
+// Run replays the data in time order through the strategy and returns the
+// results of the run
+func (b *Backtest) Run(data []Data, s Strategy) (*Results, error) {
+	if len(data) == 0 {
+		return nil, ErrNoData
+	}
+
+	sort.SliceStable(data, func(i, j int) bool {
+		return data[i].Time.Before(data[j].Time)
+	})
+
+	for x := range data {
+		price := data[x].Price()
+		if price <= 0 {
+			continue
+		}
+
+		b.current = &data[x]
+		b.price = price
+		b.processOrders()
+
+		err := s.OnData(b, b.current)
+		if err != nil {
+			return nil, err
+		}
+
+		b.results.EquityCurve = append(b.results.EquityCurve, EquityPoint{
+			Time:  b.current.Time,
+			Value: b.value(),
+		})
+	}
+
+	if len(b.results.EquityCurve) == 0 {
+		return nil, ErrNoData
+	}
+
+	b.calculateResults()
+	return &b.results, nil
+}
+
+// SubmitOrder places a simulated order and returns its ID, market orders are
+// filled immediately against the current market data and limit orders are
+// filled at their price once the market trades through it
+func (b *Backtest) SubmitOrder(side exchange.OrderSide, orderType exchange.OrderType, amount, price float64) (string, error) {
+	if amount <= 0 ||
+		(side != exchange.BuyOrderSide && side != exchange.SellOrderSide) ||
+		(orderType != exchange.MarketOrderType && orderType != exchange.LimitOrderType) ||
+		(orderType == exchange.LimitOrderType && price <= 0) {
+		return "", ErrInvalidOrder
+	}
+
+	if b.current == nil {
+		return "", ErrNoPrice
+	}
+
+	b.nextID++
+	o := &Order{
+		ID:        strconv.Itoa(b.nextID),
+		Side:      side,
+		OrderType: orderType,
+		Amount:    amount,
+		Price:     price,
+		Status:    OrderStatusOpen,
+		Created:   b.current.Time,
+	}
+
+	if orderType == exchange.LimitOrderType {
+		err := b.checkFunds(o, price, true)
+		if err != nil {
+			b.reject(o)
+			return "", err
+		}
+		b.orders = append(b.orders, o)
+		return o.ID, nil
+	}
+
+	fillPrice, err := b.Config.Slippage.FillPrice(side, amount, b.price,
+		b.current.Orderbook)
+	if err != nil {
+		b.reject(o)
+		return "", err
+	}
+
+	err = b.fill(o, fillPrice, false)
+	if err != nil {
+		b.reject(o)
+		return "", err
+	}
+	return o.ID, nil
+}
+
+// CancelOrder cancels an open limit order
+func (b *Backtest) CancelOrder(id string) error {
+	for x := range b.orders {
+		if b.orders[x].ID != id {
+			continue
+		}
+		b.orders[x].Status = OrderStatusCancelled
+		b.orders = append(b.orders[:x], b.orders[x+1:]...)
+		return nil
+	}
+	return ErrOrderNotFound
+}
+
+// OpenOrders returns the open limit orders
+func (b *Backtest) OpenOrders() []Order {
+	orders := make([]Order, len(b.orders))
+	for x := range b.orders {
+		orders[x] = *b.orders[x]
+	}
+	return orders
+}
+
+// Funds returns the available quote currency balance
+func (b *Backtest) Funds() float64 {
+	return b.funds
+}
+
+// Position returns the base currency balance
+func (b *Backtest) Position() float64 {
+	return b.position
+}
+
+// Price returns the current market price
+func (b *Backtest) Price() float64 {
+	return b.price
+}
+
+// Time returns the time of the current market data
+func (b *Backtest) Time() time.Time {
+	if b.current == nil {
+		return time.Time{}
+	}
+	return b.current.Time
+}
+
+// value returns the account value marked to the current market price
+func (b *Backtest) value() float64 {
+	return b.funds + b.position*b.price
+}
+
+// processOrders fills the open limit orders the current market data trades
+// through
+func (b *Backtest) processOrders() {
+	open := b.orders[:0]
+	for _, o := range b.orders {
+		if !b.current.crosses(o.Side, o.Price) {
+			open = append(open, o)
+			continue
+		}
+
+		if b.fill(o, o.Price, true) != nil {
+			b.reject(o)
+		}
+	}
+	b.orders = open
+}
+
+// fee returns the fee for filling the order at the price
+func (b *Backtest) fee(o *Order, price float64, isMaker bool) (float64, error) {
+	return b.Config.Fees.GetFeeByType(&exchange.FeeBuilder{
+		FeeType:       exchange.CryptocurrencyTradeFee,
+		IsMaker:       isMaker,
+		PurchasePrice: price,
+		Amount:        o.Amount,
+		Pair:          b.Config.Pair,
+	})
+}
+
+// checkFunds returns an error if the balances do not cover filling the order
+// at the price
+func (b *Backtest) checkFunds(o *Order, price float64, isMaker bool) error {
+	if o.Side == exchange.SellOrderSide {
+		if o.Amount > b.position {
+			return ErrInsufficientFunds
+		}
+		return nil
+	}
+
+	fee, err := b.fee(o, price, isMaker)
+	if err != nil {
+		return err
+	}
+
+	if o.Amount*price+fee > b.funds {
+		return ErrInsufficientFunds
+	}
+	return nil
+}
+
+// fill fills the order at the price, updating the balances and trade log
+func (b *Backtest) fill(o *Order, price float64, isMaker bool) error {
+	err := b.checkFunds(o, price, isMaker)
+	if err != nil {
+		return err
+	}
+
+	fee, err := b.fee(o, price, isMaker)
+	if err != nil {
+		return err
+	}
+
+	cost := o.Amount * price
+	if o.Side == exchange.BuyOrderSide {
+		b.funds -= cost + fee
+		b.position += o.Amount
+	} else {
+		b.funds += cost - fee
+		b.position -= o.Amount
+	}
+
+	o.Status = OrderStatusFilled
+	f := Fill{
+		Time:      b.current.Time,
+		OrderID:   o.ID,
+		Side:      o.Side,
+		OrderType: o.OrderType,
+		Price:     price,
+		Amount:    o.Amount,
+		Fee:       fee,
+	}
+
+	if !isMaker {
+		f.Slippage = math.Abs(price-b.price) * o.Amount
+	}
+
+	b.results.Trades = append(b.results.Trades, f)
+	b.results.TotalFees += fee
+	b.results.TotalSlippage += f.Slippage
+	return nil
+}
+
+// reject marks the order as rejected
+func (b *Backtest) reject(o *Order) {
+	o.Status = OrderStatusRejected
+	b.results.RejectedOrders++
+}
+
+// Price returns the market price of the data item, the mid price is used for
+// orderbook snapshots
+func (d *Data) Price() float64 {
+	switch {
+	case d.Candle != nil:
+		return d.Candle.Close
+	case d.Trade != nil:
+		return d.Trade.Price
+	case d.Orderbook != nil:
+		switch {
+		case len(d.Orderbook.Bids) > 0 && len(d.Orderbook.Asks) > 0:
+			return (d.Orderbook.Bids[0].Price + d.Orderbook.Asks[0].Price) / 2
+		case len(d.Orderbook.Bids) > 0:
+			return d.Orderbook.Bids[0].Price
+		case len(d.Orderbook.Asks) > 0:
+			return d.Orderbook.Asks[0].Price
+		}
+	}
+	return 0
+}
+
+// crosses returns whether or not the market data trades through the limit
+// price of an order on the side
+func (d *Data) crosses(side exchange.OrderSide, price float64) bool {
+	buy := side == exchange.BuyOrderSide
+	switch {
+	case d.Candle != nil:
+		if buy {
+			return d.Candle.Low <= price
+		}
+		return d.Candle.High >= price
+	case d.Trade != nil:
+		if buy {
+			return d.Trade.Price <= price
+		}
+		return d.Trade.Price >= price
+	case d.Orderbook != nil:
+		if buy {
+			return len(d.Orderbook.Asks) > 0 && d.Orderbook.Asks[0].Price <= price
+		}
+		return len(d.Orderbook.Bids) > 0 && d.Orderbook.Bids[0].Price >= price
+	}
+	return false
+}
+
+// GetFeeByType returns the maker or taker percentage of the trade value
+func (p PercentageFee) GetFeeByType(feeBuilder *exchange.FeeBuilder) (float64, error) {
+	rate := p.Taker
+	if feeBuilder.IsMaker {
+		rate = p.Maker
+	}
+	return rate / 100 * feeBuilder.PurchasePrice * feeBuilder.Amount, nil
+}
+
+// FillPrice returns the market price
+func (NoSlippage) FillPrice(_ exchange.OrderSide, _, price float64, _ *orderbook.Base) (float64, error) {
+	return price, nil
+}
+
+// FillPrice returns the market price moved against the order by the
+// percentage
+func (f FixedSlippage) FillPrice(side exchange.OrderSide, _, price float64, _ *orderbook.Base) (float64, error) {
+	if f.Percentage < 0 {
+		return 0, errInvalidSlippagePercent
+	}
+
+	if side == exchange.BuyOrderSide {
+		return price * (1 + f.Percentage/100), nil
+	}
+	return price * (1 - f.Percentage/100), nil
+}
+
+// FillPrice returns the volume weighted average price of filling the amount
+// against the orderbook asks for buys or bids for sells
+func (OrderbookSlippage) FillPrice(side exchange.OrderSide, amount, price float64, ob *orderbook.Base) (float64, error) {
+	if ob == nil {
+		return price, nil
+	}
+
+	levels := ob.Bids
+	if side == exchange.BuyOrderSide {
+		levels = ob.Asks
+	}
+
+	remaining := amount
+	var total float64
+	for x := range levels {
+		filled := math.Min(levels[x].Amount, remaining)
+		total += filled * levels[x].Price
+		remaining -= filled
+		if remaining <= 0 {
+			return total / amount, nil
+		}
+	}
+	return 0, ErrInsufficientLiquidity
+}
@@ -0,0 +1,343 @@
+package backtester
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+)
+
+// strategyFunc allows a function to be used as a strategy
+type strategyFunc func(b *Backtest, d *Data) error
+
+func (f strategyFunc) OnData(b *Backtest, d *Data) error {
+	return f(b, d)
+}
+
+func candles(closes ...float64) []Data {
+	item := kline.Item{Interval: kline.OneHour}
+	for x := range closes {
+		item.Candles = append(item.Candles, kline.Candle{
+			Time:  time.Unix(int64(x)*3600, 0),
+			Open:  closes[x],
+			High:  closes[x] + 1,
+			Low:   closes[x] - 1,
+			Close: closes[x],
+		})
+	}
+	return CandlesToData(&item)
+}
+
+func newTestBacktest(t *testing.T, cfg Config) *Backtest {
+	cfg.Pair = currency.NewPairFromString("BTC-USD")
+	b, err := New(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return b
+}
+
+func TestRunNoData(t *testing.T) {
+	b := newTestBacktest(t, Config{InitialFunds: 100})
+	_, err := b.Run(nil, &BuyAndHold{Amount: 1})
+	if err != ErrNoData {
+		t.Errorf("Test failed. Expected %s, received %v", ErrNoData, err)
+	}
+}
+
+func TestMarketOrders(t *testing.T) {
+	b := newTestBacktest(t, Config{
+		InitialFunds: 1000,
+		Fees:         PercentageFee{Maker: 0.1, Taker: 1},
+	})
+
+	results, err := b.Run(candles(100, 110, 120), strategyFunc(
+		func(b *Backtest, d *Data) error {
+			switch d.Candle.Close {
+			case 100:
+				_, err := b.SubmitOrder(exchange.BuyOrderSide,
+					exchange.MarketOrderType, 2, 0)
+				return err
+			case 120:
+				_, err := b.SubmitOrder(exchange.SellOrderSide,
+					exchange.MarketOrderType, b.Position(), 0)
+				return err
+			}
+			return nil
+		}))
+	if err != nil {
+		t.Fatalf("Test failed. Run error: %s", err)
+	}
+
+	if len(results.Trades) != 2 {
+		t.Fatalf("Test failed. Expected 2 trades, received %d",
+			len(results.Trades))
+	}
+
+	// Bought 2 at 100 with a 2 fee and sold 2 at 120 with a 2.4 fee
+	if math.Abs(results.TotalFees-4.4) > 1e-9 || results.FinalPosition != 0 ||
+		math.Abs(results.FinalFunds-1035.6) > 1e-9 ||
+		math.Abs(results.PnL-35.6) > 1e-9 {
+		t.Errorf("Test failed. Unexpected results %+v", results)
+	}
+
+	if results.MaxDrawdown != 0 {
+		t.Errorf("Test failed. Expected no drawdown, received %f",
+			results.MaxDrawdown)
+	}
+}
+
+func TestSubmitOrderRejections(t *testing.T) {
+	b := newTestBacktest(t, Config{InitialFunds: 100})
+	_, err := b.SubmitOrder(exchange.BuyOrderSide, exchange.MarketOrderType, 1, 0)
+	if err != ErrNoPrice {
+		t.Errorf("Test failed. Expected %s, received %v", ErrNoPrice, err)
+	}
+
+	results, err := b.Run(candles(100, 200), strategyFunc(
+		func(b *Backtest, d *Data) error {
+			_, err := b.SubmitOrder(exchange.BuyOrderSide,
+				exchange.LimitOrderType, 1, 0)
+			if err != ErrInvalidOrder {
+				t.Errorf("Test failed. Expected %s, received %v",
+					ErrInvalidOrder, err)
+			}
+
+			_, err = b.SubmitOrder(exchange.SellOrderSide,
+				exchange.MarketOrderType, 1, 0)
+			if err != ErrInsufficientFunds {
+				t.Errorf("Test failed. Expected %s, received %v",
+					ErrInsufficientFunds, err)
+			}
+			return nil
+		}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if results.RejectedOrders != 2 || len(results.Trades) != 0 {
+		t.Errorf("Test failed. Unexpected results %+v", results)
+	}
+}
+
+func TestLimitOrders(t *testing.T) {
+	b := newTestBacktest(t, Config{
+		InitialFunds: 1000,
+		Fees:         PercentageFee{Maker: 0.1, Taker: 1},
+	})
+
+	var cancelID string
+	results, err := b.Run(candles(100, 98, 95), strategyFunc(
+		func(b *Backtest, d *Data) error {
+			if d.Candle.Close != 100 {
+				return nil
+			}
+
+			_, err := b.SubmitOrder(exchange.BuyOrderSide,
+				exchange.LimitOrderType, 1, 97.5)
+			if err != nil {
+				return err
+			}
+
+			cancelID, err = b.SubmitOrder(exchange.BuyOrderSide,
+				exchange.LimitOrderType, 1, 90)
+			if err != nil {
+				return err
+			}
+
+			if len(b.OpenOrders()) != 2 {
+				t.Errorf("Test failed. Expected 2 open orders, received %d",
+					len(b.OpenOrders()))
+			}
+			return nil
+		}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The 98 candle has a low of 97 so fills the order at its price
+	if len(results.Trades) != 1 || results.Trades[0].Price != 97.5 ||
+		!results.Trades[0].Time.Equal(time.Unix(3600, 0)) ||
+		results.Trades[0].Fee != 0.0975 {
+		t.Errorf("Test failed. Unexpected trades %+v", results.Trades)
+	}
+
+	err = b.CancelOrder(cancelID)
+	if err != nil {
+		t.Errorf("Test failed. CancelOrder error: %s", err)
+	}
+
+	err = b.CancelOrder(cancelID)
+	if err != ErrOrderNotFound {
+		t.Errorf("Test failed. Expected %s, received %v", ErrOrderNotFound, err)
+	}
+}
+
+func TestExchangeFeeModel(t *testing.T) {
+	fees := feeModelFunc(func(f *exchange.FeeBuilder) (float64, error) {
+		if f.FeeType != exchange.CryptocurrencyTradeFee || f.IsMaker ||
+			f.Pair.String() != "BTC-USD" {
+			t.Errorf("Test failed. Unexpected fee builder %+v", f)
+		}
+		return 5, nil
+	})
+
+	b := newTestBacktest(t, Config{InitialFunds: 1000, Fees: fees})
+	results, err := b.Run(candles(100), &BuyAndHold{Amount: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if results.TotalFees != 5 || results.FinalFunds != 895 {
+		t.Errorf("Test failed. Unexpected results %+v", results)
+	}
+}
+
+type feeModelFunc func(f *exchange.FeeBuilder) (float64, error)
+
+func (f feeModelFunc) GetFeeByType(feeBuilder *exchange.FeeBuilder) (float64, error) {
+	return f(feeBuilder)
+}
+
+func TestOrderbookSlippage(t *testing.T) {
+	ob := &orderbook.Base{
+		Bids: []orderbook.Item{{Price: 99, Amount: 1}, {Price: 98, Amount: 1}},
+		Asks: []orderbook.Item{{Price: 101, Amount: 1}, {Price: 102, Amount: 3}},
+	}
+
+	var s OrderbookSlippage
+	price, err := s.FillPrice(exchange.BuyOrderSide, 2, 100, ob)
+	if err != nil || price != 101.5 {
+		t.Errorf("Test failed. Expected 101.5, received %f %v", price, err)
+	}
+
+	price, err = s.FillPrice(exchange.SellOrderSide, 1, 100, ob)
+	if err != nil || price != 99 {
+		t.Errorf("Test failed. Expected 99, received %f %v", price, err)
+	}
+
+	_, err = s.FillPrice(exchange.SellOrderSide, 3, 100, ob)
+	if err != ErrInsufficientLiquidity {
+		t.Errorf("Test failed. Expected %s, received %v",
+			ErrInsufficientLiquidity, err)
+	}
+
+	price, err = s.FillPrice(exchange.SellOrderSide, 3, 100, nil)
+	if err != nil || price != 100 {
+		t.Errorf("Test failed. Expected 100, received %f %v", price, err)
+	}
+
+	b := newTestBacktest(t, Config{
+		InitialFunds: 1000,
+		Slippage:     OrderbookSlippage{},
+	})
+
+	data := OrderbooksToData([]orderbook.Base{*ob})
+	results, err := b.Run(data, &BuyAndHold{Amount: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Mid price is 100 so filling 2 at an average of 101.5 costs 3
+	if len(results.Trades) != 1 || results.TotalSlippage != 3 {
+		t.Errorf("Test failed. Unexpected results %+v", results)
+	}
+}
+
+func TestFixedSlippage(t *testing.T) {
+	s := FixedSlippage{Percentage: 1}
+	price, err := s.FillPrice(exchange.BuyOrderSide, 1, 100, nil)
+	if err != nil || price != 101 {
+		t.Errorf("Test failed. Expected 101, received %f %v", price, err)
+	}
+
+	price, err = s.FillPrice(exchange.SellOrderSide, 1, 100, nil)
+	if err != nil || price != 99 {
+		t.Errorf("Test failed. Expected 99, received %f %v", price, err)
+	}
+
+	s.Percentage = -1
+	_, err = s.FillPrice(exchange.SellOrderSide, 1, 100, nil)
+	if err != errInvalidSlippagePercent {
+		t.Errorf("Test failed. Expected %s, received %v",
+			errInvalidSlippagePercent, err)
+	}
+}
+
+func TestDataPrice(t *testing.T) {
+	tests := []struct {
+		data  Data
+		price float64
+	}{
+		{Data{Candle: &kline.Candle{Close: 5}}, 5},
+		{Data{Trade: &exchange.TradeHistory{Price: 6}}, 6},
+		{Data{Orderbook: &orderbook.Base{
+			Bids: []orderbook.Item{{Price: 9}},
+			Asks: []orderbook.Item{{Price: 11}},
+		}}, 10},
+		{Data{Orderbook: &orderbook.Base{
+			Asks: []orderbook.Item{{Price: 11}},
+		}}, 11},
+		{Data{Orderbook: &orderbook.Base{}}, 0},
+		{Data{}, 0},
+	}
+
+	for x := range tests {
+		if p := tests[x].data.Price(); p != tests[x].price {
+			t.Errorf("Test failed. Expected price %f, received %f",
+				tests[x].price, p)
+		}
+	}
+}
+
+func TestNewStrategy(t *testing.T) {
+	_, err := NewStrategy(StrategyBuyAndHold, StrategySettings{})
+	if err != errInvalidStrategySettings {
+		t.Errorf("Test failed. Expected %s, received %v",
+			errInvalidStrategySettings, err)
+	}
+
+	_, err = NewStrategy(StrategySMACrossover, StrategySettings{Amount: 1,
+		ShortPeriod: 5, LongPeriod: 5})
+	if err != errInvalidStrategySettings {
+		t.Errorf("Test failed. Expected %s, received %v",
+			errInvalidStrategySettings, err)
+	}
+
+	_, err = NewStrategy("rawr", StrategySettings{Amount: 1})
+	if err != ErrStrategyNotFound {
+		t.Errorf("Test failed. Expected %s, received %v", ErrStrategyNotFound,
+			err)
+	}
+}
+
+func TestSMACrossover(t *testing.T) {
+	s, err := NewStrategy(StrategySMACrossover, StrategySettings{Amount: 1,
+		ShortPeriod: 1, LongPeriod: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	b := newTestBacktest(t, Config{InitialFunds: 1000})
+	results, err := b.Run(candles(10, 9, 12, 13, 11, 10), s)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Crosses above at 12 and back below at 11
+	if len(results.Trades) != 2 ||
+		results.Trades[0].Side != exchange.BuyOrderSide ||
+		results.Trades[0].Price != 12 ||
+		results.Trades[1].Side != exchange.SellOrderSide ||
+		results.Trades[1].Price != 11 {
+		t.Errorf("Test failed. Unexpected trades %+v", results.Trades)
+	}
+
+	if results.PnL != -1 {
+		t.Errorf("Test failed. Expected PnL -1, received %f", results.PnL)
+	}
+}
@@ -0,0 +1,154 @@
+package backtester
+
+import (
+	"errors"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+)
+
+// Data types which can be replayed
+const (
+	DataTypeCandles   = "CANDLES"
+	DataTypeTrades    = "TRADES"
+	DataTypeOrderbook = "ORDERBOOK"
+)
+
+// Order statuses
+const (
+	OrderStatusOpen      = "OPEN"
+	OrderStatusFilled    = "FILLED"
+	OrderStatusCancelled = "CANCELLED"
+	OrderStatusRejected  = "REJECTED"
+)
+
+// Error declarations
+var (
+	ErrNoData                  = errors.New("no market data to replay")
+	ErrInvalidDataType         = errors.New("invalid data type")
+	ErrUnsupportedFileFormat   = errors.New("unsupported data file format, expected .csv or .json")
+	ErrInsufficientFunds       = errors.New("insufficient funds")
+	ErrInsufficientLiquidity   = errors.New("insufficient orderbook liquidity to fill order")
+	ErrInvalidOrder            = errors.New("invalid order, amount must be above zero and limit orders require a price")
+	ErrOrderNotFound           = errors.New("order not found")
+	ErrNoPrice                 = errors.New("no market price available")
+	ErrStrategyNotFound        = errors.New("strategy not found")
+	errInvalidSlippagePercent  = errors.New("slippage percentage cannot be negative")
+	errInvalidStrategySettings = errors.New("invalid strategy settings")
+)
+
+// Data is a single recorded market data item, only the field matching the
+// data type is set
+type Data struct {
+	Time      time.Time
+	Candle    *kline.Candle
+	Trade     *exchange.TradeHistory
+	Orderbook *orderbook.Base
+}
+
+// Config holds the settings for a backtest run
+type Config struct {
+	Exchange     string
+	Pair         currency.Pair
+	AssetType    asset.Item
+	InitialFunds float64
+	Fees         FeeModel
+	Slippage     SlippageModel
+}
+
+// FeeModel calculates the fee charged in the quote currency for a trade, each
+// exchange wrappers GetFeeByType satisfies this so exchange fees can be
+// simulated without connecting to the exchange
+type FeeModel interface {
+	GetFeeByType(feeBuilder *exchange.FeeBuilder) (float64, error)
+}
+
+// PercentageFee is a fee model which charges a percentage of the trade value
+type PercentageFee struct {
+	Maker float64
+	Taker float64
+}
+
+// SlippageModel returns the average price a market order is filled at, the
+// orderbook is only set when replaying orderbook snapshots
+type SlippageModel interface {
+	FillPrice(side exchange.OrderSide, amount, price float64, ob *orderbook.Base) (float64, error)
+}
+
+// NoSlippage fills market orders at the current market price
+type NoSlippage struct{}
+
+// FixedSlippage fills market orders at a fixed percentage worse than the
+// current market price
+type FixedSlippage struct {
+	Percentage float64
+}
+
+// OrderbookSlippage fills market orders by walking the depth of the current
+// orderbook snapshot, falling back to the current market price when no
+// snapshot is available
+type OrderbookSlippage struct{}
+
+// Order is a simulated order
+type Order struct {
+	ID        string
+	Side      exchange.OrderSide
+	OrderType exchange.OrderType
+	Amount    float64
+	Price     float64
+	Status    string
+	Created   time.Time
+}
+
+// Fill is a trade log entry for a filled order, slippage is the cost in the
+// quote currency of filling away from the market price
+type Fill struct {
+	Time      time.Time
+	OrderID   string
+	Side      exchange.OrderSide
+	OrderType exchange.OrderType
+	Price     float64
+	Amount    float64
+	Fee       float64
+	Slippage  float64
+}
+
+// EquityPoint is the marked to market value of the account at a point in time
+type EquityPoint struct {
+	Time  time.Time
+	Value float64
+}
+
+// Results holds the performance statistics and trade log of a backtest run,
+// percentages are expressed as 0-100
+type Results struct {
+	Exchange           string
+	Pair               currency.Pair
+	AssetType          asset.Item
+	Start              time.Time
+	End                time.Time
+	InitialValue       float64
+	FinalValue         float64
+	PnL                float64
+	PnLPercentage      float64
+	MaxDrawdown        float64
+	SharpeRatio        float64
+	TotalFees          float64
+	TotalSlippage      float64
+	FinalFunds         float64
+	FinalPosition      float64
+	Trades             []Fill
+	RejectedOrders     int
+	EquityCurve        []EquityPoint `json:"-"`
+	DataPointsReplayed int
+}
+
+// Strategy receives each replayed market data item and places orders through
+// the backtest
+type Strategy interface {
+	OnData(b *Backtest, d *Data) error
+}
@@ -0,0 +1,209 @@
+package backtester
+
+import (
+	"encoding/csv"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+)
+
+// LoadFile loads recorded market data of the data type from a .json or .csv
+// file.
+//
+// JSON files use the same formats the REST API returns, a kline.Item for
+// candles, an array of exchange.TradeHistory for trades and an array of
+// orderbook.Base snapshots for orderbooks. CSV files hold one item per row as
+// time,open,high,low,close,volume for candles or time,price,amount[,side] for
+// trades, where time is a unix timestamp in seconds or milliseconds or an
+// RFC3339 time. A header row is skipped.
+func LoadFile(path, dataType string) ([]Data, error) {
+	dataType = strings.ToUpper(dataType)
+	if dataType != DataTypeCandles && dataType != DataTypeTrades &&
+		dataType != DataTypeOrderbook {
+		return nil, ErrInvalidDataType
+	}
+
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".json":
+		return loadJSON(path, dataType)
+	case ".csv":
+		if dataType == DataTypeOrderbook {
+			return nil, ErrUnsupportedFileFormat
+		}
+		f, err := os.Open(path)
+		if err != nil {
+			return nil, err
+		}
+		defer f.Close()
+		return loadCSV(f, dataType)
+	}
+	return nil, ErrUnsupportedFileFormat
+}
+
+// CandlesToData converts candles to replayable market data
+func CandlesToData(item *kline.Item) []Data {
+	data := make([]Data, len(item.Candles))
+	for x := range item.Candles {
+		data[x] = Data{Time: item.Candles[x].Time, Candle: &item.Candles[x]}
+	}
+	return data
+}
+
+// TradesToData converts trades to replayable market data
+func TradesToData(trades []exchange.TradeHistory) []Data {
+	data := make([]Data, len(trades))
+	for x := range trades {
+		data[x] = Data{Time: trades[x].Timestamp, Trade: &trades[x]}
+	}
+	return data
+}
+
+// OrderbooksToData converts orderbook snapshots to replayable market data
+func OrderbooksToData(snapshots []orderbook.Base) []Data {
+	data := make([]Data, len(snapshots))
+	for x := range snapshots {
+		data[x] = Data{Time: snapshots[x].LastUpdated, Orderbook: &snapshots[x]}
+	}
+	return data
+}
+
+func loadJSON(path, dataType string) ([]Data, error) {
+	file, err := common.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	switch dataType {
+	case DataTypeCandles:
+		var item kline.Item
+		err = common.JSONDecode(file, &item)
+		if err != nil {
+			return nil, err
+		}
+		return CandlesToData(&item), nil
+	case DataTypeTrades:
+		var trades []exchange.TradeHistory
+		err = common.JSONDecode(file, &trades)
+		if err != nil {
+			return nil, err
+		}
+		return TradesToData(trades), nil
+	default:
+		var snapshots []orderbook.Base
+		err = common.JSONDecode(file, &snapshots)
+		if err != nil {
+			return nil, err
+		}
+		return OrderbooksToData(snapshots), nil
+	}
+}
+
+func loadCSV(r io.Reader, dataType string) ([]Data, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	var data []Data
+	for row := 0; ; row++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			return data, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		t, err := parseTime(record[0])
+		if err != nil {
+			if row == 0 {
+				continue
+			}
+			return nil, err
+		}
+
+		var d Data
+		if dataType == DataTypeCandles {
+			d, err = parseCandle(t, record)
+		} else {
+			d, err = parseTrade(t, record)
+		}
+		if err != nil {
+			return nil, err
+		}
+		data = append(data, d)
+	}
+}
+
+func parseCandle(t time.Time, record []string) (Data, error) {
+	values, err := parseFloats(record, 1, 6)
+	if err != nil {
+		return Data{}, err
+	}
+
+	return Data{
+		Time: t,
+		Candle: &kline.Candle{
+			Time:   t,
+			Open:   values[0],
+			High:   values[1],
+			Low:    values[2],
+			Close:  values[3],
+			Volume: values[4],
+		},
+	}, nil
+}
+
+func parseTrade(t time.Time, record []string) (Data, error) {
+	values, err := parseFloats(record, 1, 3)
+	if err != nil {
+		return Data{}, err
+	}
+
+	trade := &exchange.TradeHistory{
+		Timestamp: t,
+		Price:     values[0],
+		Amount:    values[1],
+	}
+
+	if len(record) > 3 {
+		trade.Side = exchange.OrderSide(strings.ToUpper(record[3]))
+	}
+	return Data{Time: t, Trade: trade}, nil
+}
+
+// parseFloats parses the fields of the record from start up to end
+func parseFloats(record []string, start, end int) ([]float64, error) {
+	if len(record) < end {
+		return nil, csv.ErrFieldCount
+	}
+
+	values := make([]float64, end-start)
+	for x := start; x < end; x++ {
+		v, err := strconv.ParseFloat(record[x], 64)
+		if err != nil {
+			return nil, err
+		}
+		values[x-start] = v
+	}
+	return values, nil
+}
+
+// parseTime parses a unix timestamp in seconds or milliseconds or an RFC3339
+// time
+func parseTime(s string) (time.Time, error) {
+	if ts, err := strconv.ParseInt(s, 10, 64); err == nil {
+		if ts > 1e12 {
+			return time.Unix(0, ts*int64(time.Millisecond)), nil
+		}
+		return time.Unix(ts, 0), nil
+	}
+	return time.Parse(time.RFC3339, s)
+}
@@ -0,0 +1,129 @@
+package backtester
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+)
+
+func writeTestFile(t *testing.T, dir, name string, data []byte) string {
+	path := filepath.Join(dir, name)
+	err := ioutil.WriteFile(path, data, 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestLoadFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "backtester")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	_, err = LoadFile("data.csv", "ticker")
+	if err != ErrInvalidDataType {
+		t.Errorf("Test failed. Expected %s, received %v", ErrInvalidDataType,
+			err)
+	}
+
+	_, err = LoadFile("data.txt", DataTypeCandles)
+	if err != ErrUnsupportedFileFormat {
+		t.Errorf("Test failed. Expected %s, received %v",
+			ErrUnsupportedFileFormat, err)
+	}
+
+	_, err = LoadFile("data.csv", DataTypeOrderbook)
+	if err != ErrUnsupportedFileFormat {
+		t.Errorf("Test failed. Expected %s, received %v",
+			ErrUnsupportedFileFormat, err)
+	}
+
+	path := writeTestFile(t, dir, "candles.csv", []byte(
+		"time,open,high,low,close,volume\n"+
+			"1546300800,1,3,0.5,2,10\n"+
+			"2019-01-01T01:00:00Z,2,4,1,3,20\n"))
+	data, err := LoadFile(path, "candles")
+	if err != nil {
+		t.Fatalf("Test failed. LoadFile error: %s", err)
+	}
+
+	if len(data) != 2 || data[1].Candle.Close != 3 ||
+		!data[1].Time.Equal(time.Unix(1546304400, 0)) {
+		t.Errorf("Test failed. Unexpected candles %+v", data)
+	}
+
+	path = writeTestFile(t, dir, "trades.csv", []byte(
+		"1546300800000,100,0.5,buy\n1546300801000,101,1\n"))
+	data, err = LoadFile(path, DataTypeTrades)
+	if err != nil {
+		t.Fatalf("Test failed. LoadFile error: %s", err)
+	}
+
+	if len(data) != 2 || data[0].Trade.Side != exchange.BuyOrderSide ||
+		data[1].Trade.Price != 101 ||
+		!data[1].Time.Equal(time.Unix(1546300801, 0)) {
+		t.Errorf("Test failed. Unexpected trades %+v", data)
+	}
+
+	path = writeTestFile(t, dir, "bad.csv", []byte("1546300800,1,2\n"))
+	_, err = LoadFile(path, DataTypeCandles)
+	if err == nil {
+		t.Error("Test failed. Expected an error for a short candle row")
+	}
+
+	pair := currency.NewPairFromString("BTC-USD")
+	item := kline.Item{Pair: pair, Interval: kline.OneMin, Candles: []kline.Candle{
+		{Time: time.Unix(60, 0), Close: 5},
+	}}
+	encoded, err := common.JSONEncode(item)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	path = writeTestFile(t, dir, "candles.json", encoded)
+	data, err = LoadFile(path, DataTypeCandles)
+	if err != nil || len(data) != 1 || data[0].Candle.Close != 5 {
+		t.Errorf("Test failed. Unexpected candles %+v %v", data, err)
+	}
+
+	encoded, err = common.JSONEncode([]orderbook.Base{{
+		Pair:        pair,
+		LastUpdated: time.Unix(60, 0),
+		Bids:        []orderbook.Item{{Price: 9, Amount: 1}},
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	path = writeTestFile(t, dir, "orderbook.JSON", encoded)
+	data, err = LoadFile(path, DataTypeOrderbook)
+	if err != nil || len(data) != 1 || data[0].Price() != 9 {
+		t.Errorf("Test failed. Unexpected orderbooks %+v %v", data, err)
+	}
+}
+
+func TestParseTime(t *testing.T) {
+	for _, s := range []string{"1546300800", "1546300800000",
+		"2019-01-01T00:00:00Z"} {
+		ts, err := parseTime(s)
+		if err != nil || !ts.Equal(time.Unix(1546300800, 0)) {
+			t.Errorf("Test failed. Unable to parse %s: %v %v", s, ts, err)
+		}
+	}
+
+	_, err := parseTime("yesterday")
+	if err == nil || !strings.Contains(err.Error(), "yesterday") {
+		t.Errorf("Test failed. Expected a parse error, received %v", err)
+	}
+}
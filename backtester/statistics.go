@@ -0,0 +1,92 @@
+package backtester
+
+import (
+	"math"
+	"time"
+)
+
+// tradingYear is the period Sharpe ratios are annualised over, crypto markets
+// trade continuously
+const tradingYear = time.Hour * 24 * 365
+
+// calculateResults calculates the performance statistics from the equity
+// curve
+func (b *Backtest) calculateResults() {
+	curve := b.results.EquityCurve
+	b.results.Exchange = b.Config.Exchange
+	b.results.Pair = b.Config.Pair
+	b.results.AssetType = b.Config.AssetType
+	b.results.Start = curve[0].Time
+	b.results.End = curve[len(curve)-1].Time
+	b.results.DataPointsReplayed = len(curve)
+	b.results.InitialValue = b.Config.InitialFunds
+	b.results.FinalValue = curve[len(curve)-1].Value
+	b.results.FinalFunds = b.funds
+	b.results.FinalPosition = b.position
+	b.results.PnL = b.results.FinalValue - b.results.InitialValue
+	if b.results.InitialValue > 0 {
+		b.results.PnLPercentage = b.results.PnL / b.results.InitialValue * 100
+	}
+	b.results.MaxDrawdown = MaxDrawdown(curve)
+	b.results.SharpeRatio = SharpeRatio(curve)
+}
+
+// MaxDrawdown returns the largest peak to trough decline of the equity curve
+// as a percentage of the peak
+func MaxDrawdown(curve []EquityPoint) float64 {
+	var peak, drawdown float64
+	for x := range curve {
+		if curve[x].Value > peak {
+			peak = curve[x].Value
+			continue
+		}
+
+		if peak <= 0 {
+			continue
+		}
+
+		if d := (peak - curve[x].Value) / peak * 100; d > drawdown {
+			drawdown = d
+		}
+	}
+	return drawdown
+}
+
+// SharpeRatio returns the annualised Sharpe ratio of the returns between each
+// point of the equity curve, assuming a risk free rate of zero
+func SharpeRatio(curve []EquityPoint) float64 {
+	if len(curve) < 3 {
+		return 0
+	}
+
+	returns := make([]float64, 0, len(curve)-1)
+	for x := 1; x < len(curve); x++ {
+		if curve[x-1].Value == 0 {
+			continue
+		}
+		returns = append(returns, curve[x].Value/curve[x-1].Value-1)
+	}
+
+	if len(returns) < 2 {
+		return 0
+	}
+
+	var mean float64
+	for x := range returns {
+		mean += returns[x]
+	}
+	mean /= float64(len(returns))
+
+	var variance float64
+	for x := range returns {
+		variance += (returns[x] - mean) * (returns[x] - mean)
+	}
+	stdDev := math.Sqrt(variance / float64(len(returns)-1))
+
+	period := curve[len(curve)-1].Time.Sub(curve[0].Time) /
+		time.Duration(len(curve)-1)
+	if stdDev == 0 || period <= 0 {
+		return 0
+	}
+	return mean / stdDev * math.Sqrt(float64(tradingYear)/float64(period))
+}
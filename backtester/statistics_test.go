@@ -0,0 +1,48 @@
+package backtester
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func equityCurve(values ...float64) []EquityPoint {
+	curve := make([]EquityPoint, len(values))
+	for x := range values {
+		curve[x] = EquityPoint{
+			Time:  time.Unix(int64(x)*86400, 0),
+			Value: values[x],
+		}
+	}
+	return curve
+}
+
+func TestMaxDrawdown(t *testing.T) {
+	d := MaxDrawdown(equityCurve(100, 120, 90, 110, 130, 117))
+	if d != 25 {
+		t.Errorf("Test failed. Expected drawdown 25, received %f", d)
+	}
+
+	d = MaxDrawdown(equityCurve(100, 110, 120))
+	if d != 0 {
+		t.Errorf("Test failed. Expected drawdown 0, received %f", d)
+	}
+}
+
+func TestSharpeRatio(t *testing.T) {
+	if s := SharpeRatio(equityCurve(100, 110)); s != 0 {
+		t.Errorf("Test failed. Expected 0 for too few returns, received %f", s)
+	}
+
+	if s := SharpeRatio(equityCurve(100, 100, 100)); s != 0 {
+		t.Errorf("Test failed. Expected 0 for no volatility, received %f", s)
+	}
+
+	// Daily returns of 10% and -5% have a mean of 2.5% and a standard
+	// deviation of 10.6066%
+	s := SharpeRatio(equityCurve(100, 110, 104.5))
+	expected := 0.025 / (0.15 / math.Sqrt2) * math.Sqrt(365)
+	if math.Abs(s-expected) > 1e-9 {
+		t.Errorf("Test failed. Expected %f, received %f", expected, s)
+	}
+}
@@ -0,0 +1,125 @@
+package backtester
+
+import (
+	"strings"
+
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+)
+
+// Built in strategy names
+const (
+	StrategyBuyAndHold   = "buyandhold"
+	StrategySMACrossover = "smacrossover"
+)
+
+// StrategySettings holds the settings for the built in strategies, amount is
+// the base currency amount bought by each entry
+type StrategySettings struct {
+	Amount      float64
+	ShortPeriod int
+	LongPeriod  int
+}
+
+// BuyAndHold buys the amount with the first market data and holds it
+type BuyAndHold struct {
+	Amount float64
+	bought bool
+}
+
+// SMACrossover buys the amount when the short simple moving average crosses
+// above the long simple moving average and sells the position when it crosses
+// back below
+type SMACrossover struct {
+	Amount      float64
+	ShortPeriod int
+	LongPeriod  int
+	prices      []float64
+	wasAbove    bool
+	primed      bool
+}
+
+// NewStrategy returns the built in strategy for the name
+func NewStrategy(name string, settings StrategySettings) (Strategy, error) {
+	if settings.Amount <= 0 {
+		return nil, errInvalidStrategySettings
+	}
+
+	switch strings.ToLower(name) {
+	case StrategyBuyAndHold:
+		return &BuyAndHold{Amount: settings.Amount}, nil
+	case StrategySMACrossover:
+		if settings.ShortPeriod <= 0 ||
+			settings.LongPeriod <= settings.ShortPeriod {
+			return nil, errInvalidStrategySettings
+		}
+		return &SMACrossover{
+			Amount:      settings.Amount,
+			ShortPeriod: settings.ShortPeriod,
+			LongPeriod:  settings.LongPeriod,
+		}, nil
+	}
+	return nil, ErrStrategyNotFound
+}
+
+// OnData buys the amount once
+func (s *BuyAndHold) OnData(b *Backtest, _ *Data) error {
+	if s.bought {
+		return nil
+	}
+
+	s.bought = true
+	_, err := b.SubmitOrder(exchange.BuyOrderSide, exchange.MarketOrderType,
+		s.Amount, 0)
+	return ignoreRejection(err)
+}
+
+// OnData updates the moving averages and trades on a crossover
+func (s *SMACrossover) OnData(b *Backtest, d *Data) error {
+	s.prices = append(s.prices, d.Price())
+	if len(s.prices) > s.LongPeriod {
+		s.prices = s.prices[1:]
+	}
+
+	if len(s.prices) < s.LongPeriod {
+		return nil
+	}
+
+	above := average(s.prices[s.LongPeriod-s.ShortPeriod:]) > average(s.prices)
+	crossed := s.primed && above != s.wasAbove
+	s.wasAbove = above
+	s.primed = true
+	if !crossed {
+		return nil
+	}
+
+	if above && b.Position() == 0 {
+		_, err := b.SubmitOrder(exchange.BuyOrderSide, exchange.MarketOrderType,
+			s.Amount, 0)
+		return ignoreRejection(err)
+	}
+
+	if !above && b.Position() > 0 {
+		_, err := b.SubmitOrder(exchange.SellOrderSide,
+			exchange.MarketOrderType, b.Position(), 0)
+		return ignoreRejection(err)
+	}
+	return nil
+}
+
+// ignoreRejection ignores orders rejected by the simulated exchange, they are
+// counted in the results instead of stopping the run
+func ignoreRejection(err error) error {
+	if err == ErrInsufficientFunds || err == ErrInsufficientLiquidity {
+		return nil
+	}
+	return err
+}
+
+// average returns the mean of the values
+func average(values []float64) float64 {
+	var total float64
+	for x := range values {
+		total += values[x]
+	}
+	return total / float64(len(values))
+}
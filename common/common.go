@@ -344,13 +344,22 @@ func CalculateNetProfit(amount, priceThen, priceNow, costs float64) float64 {
 // SendHTTPRequest sends a request using the http package and returns a response
 // as a string and an error
 func SendHTTPRequest(method, urlPath string, headers map[string]string, body io.Reader) (string, error) {
+	return SendHTTPRequestWithClient(nil, method, urlPath, headers, body)
+}
+
+// SendHTTPRequestWithClient is SendHTTPRequest with the supplied HTTP client,
+// the global HTTP client is used if it is nil
+func SendHTTPRequestWithClient(client *http.Client, method, urlPath string, headers map[string]string, body io.Reader) (string, error) {
 	result := strings.ToUpper(method)
 
 	if result != http.MethodPost && result != http.MethodGet && result != http.MethodDelete {
 		return "", errors.New("invalid HTTP method specified")
 	}
 
-	initialiseHTTPClient()
+	if client == nil {
+		initialiseHTTPClient()
+		client = HTTPClient
+	}
 
 	req, err := http.NewRequest(method, urlPath, body)
 	if err != nil {
@@ -361,7 +370,7 @@ func SendHTTPRequest(method, urlPath string, headers map[string]string, body io.
 		req.Header.Add(k, v)
 	}
 
-	resp, err := HTTPClient.Do(req)
+	resp, err := client.Do(req)
 	if err != nil {
 		return "", err
 	}
@@ -380,13 +389,23 @@ func SendHTTPRequest(method, urlPath string, headers map[string]string, body io.
 // decodes the response into a struct pointer you have supplied. Returns an error
 // on failure.
 func SendHTTPGetRequest(urlPath string, jsonDecode, isVerbose bool, result interface{}) error {
+	return SendHTTPGetRequestWithClient(nil, urlPath, jsonDecode, isVerbose,
+		result)
+}
+
+// SendHTTPGetRequestWithClient is SendHTTPGetRequest with the supplied HTTP
+// client, the global HTTP client is used if it is nil
+func SendHTTPGetRequestWithClient(client *http.Client, urlPath string, jsonDecode, isVerbose bool, result interface{}) error {
 	if isVerbose {
 		log.Debugf("Raw URL: %s", urlPath)
 	}
 
-	initialiseHTTPClient()
+	if client == nil {
+		initialiseHTTPClient()
+		client = HTTPClient
+	}
 
-	res, err := HTTPClient.Get(urlPath)
+	res, err := client.Get(urlPath)
 	if err != nil {
 		return err
 	}
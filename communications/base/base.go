@@ -2,18 +2,20 @@ package base
 
 import (
 	"fmt"
+	"net/http"
 	"sync"
 	"time"
 
 	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
 )
 
 // global vars contain staged update data that will be sent to the communication
 // mediums
 var (
-	TickerStaged    map[string]map[string]map[string]ticker.Price
-	OrderbookStaged map[string]map[string]map[string]Orderbook
+	TickerStaged    map[string]map[asset.Item]map[string]ticker.Price
+	OrderbookStaged map[string]map[asset.Item]map[string]Orderbook
 	PortfolioStaged Portfolio
 	SettingsStaged  Settings
 	ServiceStarted  time.Time
@@ -24,7 +26,7 @@ var (
 // medium
 type Orderbook struct {
 	CurrencyPair string
-	AssetType    string
+	AssetType    asset.Item
 	TotalAsks    float64
 	TotalBids    float64
 	LastUpdated  string
@@ -56,6 +58,9 @@ type Base struct {
 	Enabled   bool
 	Verbose   bool
 	Connected bool
+	// HTTPClient sends the requests of the communication medium, the global
+	// HTTP client is used if it is nil
+	HTTPClient *http.Client
 }
 
 // Event is a generalise event type
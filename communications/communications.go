@@ -1,6 +1,8 @@
 package communications
 
 import (
+	"net/http"
+
 	"github.com/thrasher-corp/gocryptotrader/communications/base"
 	"github.com/thrasher-corp/gocryptotrader/communications/slack"
 	"github.com/thrasher-corp/gocryptotrader/communications/smsglobal"
@@ -14,31 +16,37 @@ type Communications struct {
 	base.IComm
 }
 
-// NewComm sets up and returns a pointer to a Communications object
-func NewComm(cfg *config.CommunicationsConfig) *Communications {
+// NewComm sets up and returns a pointer to a Communications object, the
+// mediums send their HTTP requests with the client or the global HTTP client
+// if it is nil
+func NewComm(cfg *config.CommunicationsConfig, client *http.Client) *Communications {
 	var comm Communications
 
 	if cfg.TelegramConfig.Enabled {
 		Telegram := new(telegram.Telegram)
 		Telegram.Setup(cfg)
+		Telegram.HTTPClient = client
 		comm.IComm = append(comm.IComm, Telegram)
 	}
 
 	if cfg.SMSGlobalConfig.Enabled {
 		SMSGlobal := new(smsglobal.SMSGlobal)
 		SMSGlobal.Setup(cfg)
+		SMSGlobal.HTTPClient = client
 		comm.IComm = append(comm.IComm, SMSGlobal)
 	}
 
 	if cfg.SMTPConfig.Enabled {
 		SMTP := new(smtpservice.SMTPservice)
 		SMTP.Setup(cfg)
+		SMTP.HTTPClient = client
 		comm.IComm = append(comm.IComm, SMTP)
 	}
 
 	if cfg.SlackConfig.Enabled {
 		Slack := new(slack.Slack)
 		Slack.Setup(cfg)
+		Slack.HTTPClient = client
 		comm.IComm = append(comm.IComm, Slack)
 	}
 
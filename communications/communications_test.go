@@ -8,7 +8,7 @@ import (
 
 func TestNewComm(t *testing.T) {
 	var cfg config.CommunicationsConfig
-	communications := NewComm(&cfg)
+	communications := NewComm(&cfg, nil)
 
 	if len(communications.IComm) != 0 {
 		t.Errorf("Test failed, communications NewComm, expected len 0, got len %d",
@@ -19,7 +19,7 @@ func TestNewComm(t *testing.T) {
 	cfg.SMSGlobalConfig.Enabled = true
 	cfg.SMTPConfig.Enabled = true
 	cfg.SlackConfig.Enabled = true
-	communications = NewComm(&cfg)
+	communications = NewComm(&cfg, nil)
 
 	if len(communications.IComm) != 4 {
 		t.Errorf("Test failed, communications NewComm, expected len 4, got len %d",
@@ -144,7 +144,7 @@ func (s *Slack) GetUsersInGroup(group string) []string {
 // token and a channel
 func (s *Slack) NewConnection() error {
 	if !s.Connected {
-		err := common.SendHTTPGetRequest(s.BuildURL(s.VerificationToken), true, s.Verbose, &s.Details)
+		err := common.SendHTTPGetRequestWithClient(s.HTTPClient, s.BuildURL(s.VerificationToken), true, s.Verbose, &s.Details)
 		if err != nil {
 			return err
 		}
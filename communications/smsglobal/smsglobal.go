@@ -165,7 +165,7 @@ func (s *SMSGlobal) SendMessage(to, message string) error {
 	headers := make(map[string]string)
 	headers["Content-Type"] = "application/x-www-form-urlencoded"
 
-	resp, err := common.SendHTTPRequest(http.MethodPost,
+	resp, err := common.SendHTTPRequestWithClient(s.HTTPClient, http.MethodPost,
 		smsGlobalAPIURL,
 		headers,
 		strings.NewReader(values.Encode()))
@@ -259,7 +259,7 @@ func (t *Telegram) SendHTTPRequest(path string, json []byte, result interface{})
 	headers := make(map[string]string)
 	headers["content-type"] = "application/json"
 
-	resp, err := common.SendHTTPRequest(http.MethodPost, path, headers, bytes.NewBuffer(json))
+	resp, err := common.SendHTTPRequestWithClient(t.HTTPClient, http.MethodPost, path, headers, bytes.NewBuffer(json))
 	if err != nil {
 		return err
 	}
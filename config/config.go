@@ -7,6 +7,7 @@ import (
 	"flag"
 	"fmt"
 	"io"
+	"net"
 	"os"
 	"path/filepath"
 	"runtime"
@@ -20,6 +21,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/currency/forexprovider"
 	"github.com/thrasher-corp/gocryptotrader/currency/forexprovider/base"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	log "github.com/thrasher-corp/gocryptotrader/logger"
 	"github.com/thrasher-corp/gocryptotrader/portfolio"
 )
@@ -33,6 +35,7 @@ const (
 	configFileEncryptionPrompt                 = 0
 	configFileEncryptionEnabled                = 1
 	configFileEncryptionDisabled               = -1
+	configFileEncryptionSecrets                = 2
 	configPairsLastUpdatedWarningThreshold     = 30 // 30 days
 	configDefaultHTTPTimeout                   = time.Second * 15
 	configDefaultWebsocketResponseCheckTimeout = time.Millisecond * 30
@@ -41,6 +44,8 @@ const (
 	configMaxAuthFailres                       = 3
 	defaultNTPAllowedDifference                = 50000000
 	defaultNTPAllowedNegativeDifference        = 50000000
+	defaultScriptTimeout                       = time.Second * 30
+	defaultMaxScripts                          = 10
 )
 
 // Constants here hold some messages
@@ -49,7 +54,9 @@ const (
 	ErrExchangeAvailablePairsEmpty             = "exchange %s available pairs is empty"
 	ErrExchangeEnabledPairsEmpty               = "exchange %s enabled pairs is empty"
 	ErrExchangeBaseCurrenciesEmpty             = "exchange %s base currencies is empty"
+	ErrExchangePaperTradingBalanceNegative     = "exchange %s paper trading balance for %s is negative"
 	ErrExchangeNotFound                        = "exchange %s not found"
+	ErrCredentialSetNotFound                   = "exchange %s credential set %s not found"
 	ErrNoEnabledExchanges                      = "no exchanges enabled"
 	ErrCryptocurrenciesEmpty                   = "cryptocurrencies variable is empty"
 	ErrFailureOpeningConfig                    = "fatal error opening %s file. Error: %s"
@@ -57,7 +64,13 @@ const (
 	ErrSavingConfigBytesMismatch               = "config file %q bytes comparison doesn't match, read %s expected %s"
 	WarningWebserverCredentialValuesEmpty      = "webserver support disabled due to empty Username/Password values"
 	WarningWebserverListenAddressInvalid       = "webserver support disabled due to invalid listen address"
+	WarningGRPCListenAddressInvalid            = "gRPC support disabled due to invalid listen address"
+	WarningScriptNameEmpty                     = "scripting autostart script #%d name is empty"
+	WarningScriptIntervalNegative              = "scripting autostart script %s interval is negative"
+	WarningExchangeHTTPRetryInvalid            = "exchange %s HTTP retry values are invalid, using the default retry policy"
+	WarningExchangeAssetPairsNotEnabled        = "exchange %s has pairs for asset type %s which is not enabled, removing them"
 	WarningExchangeAuthAPIDefaultOrEmptyValues = "exchange %s authenticated API support disabled due to default/empty APIKey/Secret/ClientID values"
+	WarningExchangeCredentialSetInvalid        = "exchange %s credential set %q is unnamed, duplicated or has default/empty APIKey/Secret/ClientID values, removing it"
 	WarningPairsLastUpdatedThresholdExceeded   = "exchange %s last manual update of available currency pairs has exceeded %d days. Manual update required!"
 )
 
@@ -70,6 +83,8 @@ const (
 	DefaultUnsetAPISecret                = "Secret"
 	DefaultUnsetAccountPlan              = "accountPlan"
 	DefaultForexProviderExchangeRatesAPI = "ExchangeRates"
+	DefaultGRPCListenAddress             = "localhost:9052"
+	DefaultCredentialSet                 = "default"
 )
 
 // Variables here are used for configuration
@@ -91,6 +106,36 @@ type WebserverConfig struct {
 	WebsocketAllowInsecureOrigin bool   `json:"websocketAllowInsecureOrigin"`
 }
 
+// GRPCConfig stores the gRPC server settings. Clients authenticate using the
+// webserver admin credentials or a TLS client certificate signed by the
+// client CA
+type GRPCConfig struct {
+	Enabled       bool   `json:"enabled"`
+	ListenAddress string `json:"listenAddress"`
+	ClientCAFile  string `json:"clientCAFile,omitempty"`
+}
+
+// ScriptingConfig stores the scripting engine settings. Scripts are loaded
+// from the scripts folder in the data directory and those listed are started
+// with the engine
+type ScriptingConfig struct {
+	Enabled    bool           `json:"enabled"`
+	Timeout    time.Duration  `json:"timeout"`
+	MaxScripts int            `json:"maxScripts"`
+	Scripts    []ScriptConfig `json:"scripts,omitempty"`
+}
+
+// ScriptConfig stores how a script is run. Scripts run every interval, on
+// each matching ticker or orderbook event, or once if neither is set
+type ScriptConfig struct {
+	Name      string        `json:"name"`
+	Interval  time.Duration `json:"interval,omitempty"`
+	Event     string        `json:"event,omitempty"`
+	Exchange  string        `json:"exchange,omitempty"`
+	Pair      string        `json:"pair,omitempty"`
+	AssetType string        `json:"assetType,omitempty"`
+}
+
 // Post holds the bot configuration data
 type Post struct {
 	Data Config `json:"data"`
@@ -110,6 +155,7 @@ type CurrencyPairFormatConfig struct {
 type Config struct {
 	Name              string                  `json:"name"`
 	EncryptConfig     int                     `json:"encryptConfig"`
+	EncryptionSalt    []byte                  `json:"encryptionSalt,omitempty"`
 	GlobalHTTPTimeout time.Duration           `json:"globalHTTPTimeout"`
 	Logging           log.Logging             `json:"logging"`
 	Profiler          ProfilerConfig          `json:"profiler"`
@@ -118,6 +164,8 @@ type Config struct {
 	Communications    CommunicationsConfig    `json:"communications"`
 	Portfolio         portfolio.Base          `json:"portfolioAddresses"`
 	Webserver         WebserverConfig         `json:"webserver"`
+	GRPC              GRPCConfig              `json:"grpc"`
+	Scripting         ScriptingConfig         `json:"scripting"`
 	Exchanges         []ExchangeConfig        `json:"exchanges"`
 	BankAccounts      []BankAccount           `json:"bankAccounts"`
 	ConnectionMonitor ConnectionMonitorConfig `json:"connectionMonitor"`
@@ -153,38 +201,79 @@ type NTPClientConfig struct {
 
 // ExchangeConfig holds all the information needed for each enabled Exchange.
 type ExchangeConfig struct {
-	Name                             string                    `json:"name"`
-	Enabled                          bool                      `json:"enabled"`
-	Verbose                          bool                      `json:"verbose"`
-	Websocket                        bool                      `json:"websocket"`
-	UseSandbox                       bool                      `json:"useSandbox"`
-	RESTPollingDelay                 time.Duration             `json:"restPollingDelay"`
-	HTTPTimeout                      time.Duration             `json:"httpTimeout"`
-	WebsocketResponseCheckTimeout    time.Duration             `json:"websocketResponseCheckTimeout"`
-	WebsocketResponseMaxLimit        time.Duration             `json:"websocketResponseMaxLimit"`
-	WebsocketOrderbookBufferLimit    int                       `json:"websocketOrderbookBufferLimit"`
-	HTTPUserAgent                    string                    `json:"httpUserAgent"`
-	HTTPDebugging                    bool                      `json:"httpDebugging"`
-	AuthenticatedAPISupport          bool                      `json:"authenticatedApiSupport"`
-	AuthenticatedWebsocketAPISupport bool                      `json:"authenticatedWebsocketApiSupport"`
-	APIKey                           string                    `json:"apiKey"`
-	APISecret                        string                    `json:"apiSecret"`
-	APIAuthPEMKeySupport             bool                      `json:"apiAuthPemKeySupport,omitempty"`
-	APIAuthPEMKey                    string                    `json:"apiAuthPemKey,omitempty"`
-	APIURL                           string                    `json:"apiUrl"`
-	APIURLSecondary                  string                    `json:"apiUrlSecondary"`
-	ProxyAddress                     string                    `json:"proxyAddress"`
-	WebsocketURL                     string                    `json:"websocketUrl"`
-	ClientID                         string                    `json:"clientId,omitempty"`
-	AvailablePairs                   currency.Pairs            `json:"availablePairs"`
-	EnabledPairs                     currency.Pairs            `json:"enabledPairs"`
-	BaseCurrencies                   currency.Currencies       `json:"baseCurrencies"`
-	AssetTypes                       string                    `json:"assetTypes"`
-	SupportsAutoPairUpdates          bool                      `json:"supportsAutoPairUpdates"`
-	PairsLastUpdated                 int64                     `json:"pairsLastUpdated,omitempty"`
-	ConfigCurrencyPairFormat         *CurrencyPairFormatConfig `json:"configCurrencyPairFormat"`
-	RequestCurrencyPairFormat        *CurrencyPairFormatConfig `json:"requestCurrencyPairFormat"`
-	BankAccounts                     []BankAccount             `json:"bankAccounts"`
+	Name                             string                           `json:"name"`
+	Enabled                          bool                             `json:"enabled"`
+	Verbose                          bool                             `json:"verbose"`
+	Websocket                        bool                             `json:"websocket"`
+	UseSandbox                       bool                             `json:"useSandbox"`
+	RESTPollingDelay                 time.Duration                    `json:"restPollingDelay"`
+	HTTPTimeout                      time.Duration                    `json:"httpTimeout"`
+	WebsocketResponseCheckTimeout    time.Duration                    `json:"websocketResponseCheckTimeout"`
+	WebsocketResponseMaxLimit        time.Duration                    `json:"websocketResponseMaxLimit"`
+	WebsocketOrderbookBufferLimit    int                              `json:"websocketOrderbookBufferLimit"`
+	HTTPUserAgent                    string                           `json:"httpUserAgent"`
+	HTTPDebugging                    bool                             `json:"httpDebugging"`
+	AuthenticatedAPISupport          bool                             `json:"authenticatedApiSupport"`
+	AuthenticatedWebsocketAPISupport bool                             `json:"authenticatedWebsocketApiSupport"`
+	APIKey                           string                           `json:"apiKey"`
+	APISecret                        string                           `json:"apiSecret"`
+	APIAuthPEMKeySupport             bool                             `json:"apiAuthPemKeySupport,omitempty"`
+	APIAuthPEMKey                    string                           `json:"apiAuthPemKey,omitempty"`
+	APIURL                           string                           `json:"apiUrl"`
+	APIURLSecondary                  string                           `json:"apiUrlSecondary"`
+	ProxyAddress                     string                           `json:"proxyAddress"`
+	WebsocketURL                     string                           `json:"websocketUrl"`
+	ClientID                         string                           `json:"clientId,omitempty"`
+	CredentialSets                   []CredentialSetConfig            `json:"credentialSets,omitempty"`
+	AvailablePairs                   currency.Pairs                   `json:"availablePairs"`
+	EnabledPairs                     currency.Pairs                   `json:"enabledPairs"`
+	BaseCurrencies                   currency.Currencies              `json:"baseCurrencies"`
+	AssetTypes                       asset.Items                      `json:"assetTypes"`
+	AssetPairs                       map[asset.Item]*AssetPairsConfig `json:"assetPairs,omitempty"`
+	SupportsAutoPairUpdates          bool                             `json:"supportsAutoPairUpdates"`
+	PairsLastUpdated                 int64                            `json:"pairsLastUpdated,omitempty"`
+	ConfigCurrencyPairFormat         *CurrencyPairFormatConfig        `json:"configCurrencyPairFormat"`
+	RequestCurrencyPairFormat        *CurrencyPairFormatConfig        `json:"requestCurrencyPairFormat"`
+	BankAccounts                     []BankAccount                    `json:"bankAccounts"`
+	PaperTrading                     *PaperTradingConfig              `json:"paperTrading,omitempty"`
+	HTTPRetry                        *HTTPRetryConfig                 `json:"httpRetry,omitempty"`
+}
+
+// CredentialSetConfig stores a named set of API credentials used in addition
+// to the exchanges default credentials. Exchanges which issue keys per
+// sub-account or profile, such as Bitmex and Coinbase Pro, have a credential
+// set per sub-account
+type CredentialSetConfig struct {
+	Name          string `json:"name"`
+	APIKey        string `json:"apiKey"`
+	APISecret     string `json:"apiSecret"`
+	APIAuthPEMKey string `json:"apiAuthPemKey,omitempty"`
+	ClientID      string `json:"clientId,omitempty"`
+}
+
+// AssetPairsConfig stores the pairs and pair formats of an asset type other
+// than the exchanges primary asset type, whose pairs and formats are stored
+// at the top level of the exchange config
+type AssetPairsConfig struct {
+	AvailablePairs            currency.Pairs            `json:"availablePairs"`
+	EnabledPairs              currency.Pairs            `json:"enabledPairs"`
+	ConfigCurrencyPairFormat  *CurrencyPairFormatConfig `json:"configCurrencyPairFormat,omitempty"`
+	RequestCurrencyPairFormat *CurrencyPairFormatConfig `json:"requestCurrencyPairFormat,omitempty"`
+}
+
+// HTTPRetryConfig overrides how an exchange retries failed HTTP requests,
+// backoff doubles from the minimum with each retry up to the maximum
+type HTTPRetryConfig struct {
+	MaxRetries int           `json:"maxRetries"`
+	MinBackoff time.Duration `json:"minBackoff"`
+	MaxBackoff time.Duration `json:"maxBackoff"`
+}
+
+// PaperTradingConfig enables simulated trading on an exchange using its live
+// market data, the balances are the starting balances keyed by currency code
+type PaperTradingConfig struct {
+	Enabled  bool               `json:"enabled"`
+	Balances map[string]float64 `json:"balances"`
 }
 
 // BankAccount holds differing bank account details by supported funding
@@ -613,6 +702,88 @@ func (c *Config) CheckPairConsistency(exchName string) error {
 	return nil
 }
 
+// checkAssetPairs removes the pairs of asset types which aren't enabled and
+// enabled pairs which aren't available for each asset type
+func (c *Config) checkAssetPairs(exch *ExchangeConfig) {
+	for a, pairs := range exch.AssetPairs {
+		if pairs == nil || (len(exch.AssetTypes) != 0 && !exch.AssetTypes.Contains(a)) {
+			log.Warnf(WarningExchangeAssetPairsNotEnabled, exch.Name, a)
+			delete(exch.AssetPairs, a)
+			continue
+		}
+
+		var enabled currency.Pairs
+		for x := range pairs.EnabledPairs {
+			if !pairs.AvailablePairs.Contains(pairs.EnabledPairs[x], true) {
+				log.Debugf("Exchange %s: Removing enabled %s pair %s as it isn't an available pair",
+					exch.Name, a, pairs.EnabledPairs[x])
+				continue
+			}
+			enabled = append(enabled, pairs.EnabledPairs[x])
+		}
+		pairs.EnabledPairs = enabled
+	}
+}
+
+// checkCredentialSets removes credential sets which are unnamed, share a
+// name with another set or have default or empty credentials
+func (c *Config) checkCredentialSets(exch *ExchangeConfig) {
+	var sets []CredentialSetConfig
+	names := make(map[string]bool)
+	for x := range exch.CredentialSets {
+		set := &exch.CredentialSets[x]
+		name := strings.ToLower(set.Name)
+		if name == "" || name == DefaultCredentialSet || names[name] ||
+			!credentialsValid(exch.Name, set.APIKey, set.APISecret, set.ClientID) {
+			log.Warnf(WarningExchangeCredentialSetInvalid, exch.Name, set.Name)
+			continue
+		}
+		names[name] = true
+		sets = append(sets, *set)
+	}
+	exch.CredentialSets = sets
+}
+
+// GetCredentialSet returns a credential set by its case insensitive name. An
+// empty name or DefaultCredentialSet returns the exchanges top level
+// credentials
+func (e *ExchangeConfig) GetCredentialSet(name string) (CredentialSetConfig, error) {
+	if name == "" || strings.EqualFold(name, DefaultCredentialSet) {
+		return CredentialSetConfig{
+			Name:          DefaultCredentialSet,
+			APIKey:        e.APIKey,
+			APISecret:     e.APISecret,
+			APIAuthPEMKey: e.APIAuthPEMKey,
+			ClientID:      e.ClientID,
+		}, nil
+	}
+
+	for x := range e.CredentialSets {
+		if strings.EqualFold(e.CredentialSets[x].Name, name) {
+			return e.CredentialSets[x], nil
+		}
+	}
+	return CredentialSetConfig{}, fmt.Errorf(ErrCredentialSetNotFound, e.Name, name)
+}
+
+// WithCredentialSet returns a copy of the exchange config with the top level
+// credentials replaced by the named credential set, which exchanges are set
+// up from like any other exchange config
+func (e *ExchangeConfig) WithCredentialSet(name string) (ExchangeConfig, error) {
+	set, err := e.GetCredentialSet(name)
+	if err != nil {
+		return ExchangeConfig{}, err
+	}
+
+	c := *e
+	c.APIKey = set.APIKey
+	c.APISecret = set.APISecret
+	c.APIAuthPEMKey = set.APIAuthPEMKey
+	c.ClientID = set.ClientID
+	c.CredentialSets = nil
+	return c, nil
+}
+
 // SupportsPair returns true or not whether the exchange supports the supplied
 // pair
 func (c *Config) SupportsPair(exchName string, p currency.Pair) (bool, error) {
@@ -803,6 +974,14 @@ func (c *Config) CheckExchangeConfigValues() error {
 			if len(c.Exchanges[i].BaseCurrencies) == 0 {
 				return fmt.Errorf(ErrExchangeBaseCurrenciesEmpty, c.Exchanges[i].Name)
 			}
+			if c.Exchanges[i].PaperTrading != nil {
+				for code, balance := range c.Exchanges[i].PaperTrading.Balances {
+					if balance < 0 {
+						return fmt.Errorf(ErrExchangePaperTradingBalanceNegative,
+							c.Exchanges[i].Name, code)
+					}
+				}
+			}
 
 			var areAuthenticatedCredentialsValid bool
 			if c.Exchanges[i].AuthenticatedWebsocketAPISupport || c.Exchanges[i].AuthenticatedAPISupport {
@@ -814,6 +993,7 @@ func (c *Config) CheckExchangeConfigValues() error {
 			if c.Exchanges[i].AuthenticatedAPISupport {
 				c.Exchanges[i].AuthenticatedAPISupport = areAuthenticatedCredentialsValid
 			}
+			c.checkCredentialSets(&c.Exchanges[i])
 
 			if !c.Exchanges[i].SupportsAutoPairUpdates {
 				lastUpdated := common.UnixTimestampToTime(c.Exchanges[i].PairsLastUpdated)
@@ -828,6 +1008,12 @@ func (c *Config) CheckExchangeConfigValues() error {
 				c.Exchanges[i].HTTPTimeout = configDefaultHTTPTimeout
 			}
 
+			if r := c.Exchanges[i].HTTPRetry; r != nil && (r.MaxRetries < 0 ||
+				r.MinBackoff < 0 || r.MaxBackoff < r.MinBackoff) {
+				log.Warnf(WarningExchangeHTTPRetryInvalid, c.Exchanges[i].Name)
+				c.Exchanges[i].HTTPRetry = nil
+			}
+
 			if c.Exchanges[i].WebsocketResponseCheckTimeout <= 0 {
 				log.Warnf("Exchange %s Websocket response check timeout value not set, defaulting to %v.", c.Exchanges[i].Name, configDefaultWebsocketResponseCheckTimeout)
 				c.Exchanges[i].WebsocketResponseCheckTimeout = configDefaultWebsocketResponseCheckTimeout
@@ -845,6 +1031,7 @@ func (c *Config) CheckExchangeConfigValues() error {
 			if err != nil {
 				log.Errorf("Exchange %s: CheckPairConsistency error: %s", c.Exchanges[i].Name, err)
 			}
+			c.checkAssetPairs(&c.Exchanges[i])
 
 			if len(c.Exchanges[i].BankAccounts) == 0 {
 				c.Exchanges[i].BankAccounts = append(c.Exchanges[i].BankAccounts, BankAccount{})
@@ -898,25 +1085,32 @@ func (c *Config) areAuthenticatedCredentialsValid(i int) bool {
 		return false
 	}
 
-	resp := true
-	if c.Exchanges[i].APIKey == "" || c.Exchanges[i].APIKey == DefaultUnsetAPIKey {
-		resp = false
+	resp := credentialsValid(c.Exchanges[i].Name, c.Exchanges[i].APIKey,
+		c.Exchanges[i].APISecret, c.Exchanges[i].ClientID)
+	// non-fatal error
+	if !resp {
+		log.Warnf(WarningExchangeAuthAPIDefaultOrEmptyValues, c.Exchanges[i].Name)
 	}
+	return resp
+}
 
-	if (c.Exchanges[i].APISecret == "" || c.Exchanges[i].APISecret == DefaultUnsetAPISecret) &&
-		c.Exchanges[i].Name != "COINUT" {
-		resp = false
+// credentialsValid returns whether the credentials required by the exchange
+// are set to non default values
+func credentialsValid(exchName, apiKey, apiSecret, clientID string) bool {
+	if apiKey == "" || apiKey == DefaultUnsetAPIKey {
+		return false
 	}
 
-	if (c.Exchanges[i].ClientID == "ClientID" || c.Exchanges[i].ClientID == "") &&
-		(c.Exchanges[i].Name == "ITBIT" || c.Exchanges[i].Name == "Bitstamp" || c.Exchanges[i].Name == "COINUT" || c.Exchanges[i].Name == "CoinbasePro") {
-		resp = false
+	if (apiSecret == "" || apiSecret == DefaultUnsetAPISecret) &&
+		exchName != "COINUT" {
+		return false
 	}
-	// non-fatal error
-	if !resp {
-		log.Warnf(WarningExchangeAuthAPIDefaultOrEmptyValues, c.Exchanges[i].Name)
+
+	if (clientID == "ClientID" || clientID == "") &&
+		(exchName == "ITBIT" || exchName == "Bitstamp" || exchName == "COINUT" || exchName == "CoinbasePro") {
+		return false
 	}
-	return resp
+	return true
 }
 
 // CheckWebserverConfigValues checks information before webserver starts and
@@ -951,6 +1145,60 @@ func (c *Config) CheckWebserverConfigValues() error {
 	return nil
 }
 
+// CheckGRPCConfigValues checks the gRPC server settings, setting the default
+// listen address if none is set. The webserver admin credentials are used to
+// authenticate clients so they must be set
+func (c *Config) CheckGRPCConfigValues() error {
+	if c.Webserver.AdminUsername == "" || c.Webserver.AdminPassword == "" {
+		return errors.New(WarningWebserverCredentialValuesEmpty)
+	}
+
+	if c.GRPC.ListenAddress == "" {
+		c.GRPC.ListenAddress = DefaultGRPCListenAddress
+	}
+
+	_, port, err := net.SplitHostPort(c.GRPC.ListenAddress)
+	if err != nil {
+		return errors.New(WarningGRPCListenAddressInvalid)
+	}
+
+	p, err := strconv.Atoi(port)
+	if err != nil || p < 1 || p > 65535 {
+		return errors.New(WarningGRPCListenAddressInvalid)
+	}
+
+	if c.GRPC.ClientCAFile != "" {
+		if _, err = os.Stat(c.GRPC.ClientCAFile); err != nil {
+			return fmt.Errorf("gRPC client CA file: %s", err)
+		}
+	}
+	return nil
+}
+
+// CheckScriptingConfigValues sets the default script timeout and maximum
+// running scripts if unset and checks the scripts to start with the engine
+func (c *Config) CheckScriptingConfigValues() error {
+	if c.Scripting.Timeout <= 0 {
+		c.Scripting.Timeout = defaultScriptTimeout
+	}
+
+	if c.Scripting.MaxScripts <= 0 {
+		c.Scripting.MaxScripts = defaultMaxScripts
+	}
+
+	for x := range c.Scripting.Scripts {
+		if c.Scripting.Scripts[x].Name == "" {
+			return fmt.Errorf(WarningScriptNameEmpty, x)
+		}
+
+		if c.Scripting.Scripts[x].Interval < 0 {
+			return fmt.Errorf(WarningScriptIntervalNegative,
+				c.Scripting.Scripts[x].Name)
+		}
+	}
+	return nil
+}
+
 // CheckCurrencyConfigValues checks to see if the currency config values are correct or not
 func (c *Config) CheckCurrencyConfigValues() error {
 	fxProviders := forexprovider.GetAvailableForexProviders()
@@ -1347,6 +1595,10 @@ func (c *Config) ReadConfig(configPath string) error {
 			return nil
 		}
 
+		if c.EncryptConfig == configFileEncryptionSecrets {
+			return c.readSecrets()
+		}
+
 		if c.EncryptConfig == configFileEncryptionPrompt {
 			m.Lock()
 			IsInitialSetup = true
@@ -1362,8 +1614,11 @@ func (c *Config) ReadConfig(configPath string) error {
 			if errCounter >= configMaxAuthFailres {
 				return errors.New("failed to decrypt config after 3 attempts")
 			}
-			key, err := PromptForConfigKey(IsInitialSetup)
+			key, interactive, err := GetConfigKey(IsInitialSetup)
 			if err != nil {
+				if !interactive {
+					return err
+				}
 				log.Errorf("PromptForConfigKey err: %s", err)
 				errCounter++
 				continue
@@ -1373,6 +1628,9 @@ func (c *Config) ReadConfig(configPath string) error {
 			f = append(f, file...)
 			data, err := DecryptConfigFile(f, key)
 			if err != nil {
+				if !interactive {
+					return err
+				}
 				log.Errorf("DecryptConfigFile err: %s", err)
 				errCounter++
 				continue
@@ -1380,6 +1638,9 @@ func (c *Config) ReadConfig(configPath string) error {
 
 			err = ConfirmConfigJSON(data, &c)
 			if err != nil {
+				if !interactive {
+					return errors.New("unable to decrypt config, the config key is incorrect")
+				}
 				if errCounter < configMaxAuthFailres {
 					log.Errorf("Invalid password.")
 				}
@@ -1404,11 +1665,12 @@ func (c *Config) SaveConfig(configPath string) error {
 		return err
 	}
 
-	if c.EncryptConfig == configFileEncryptionEnabled {
+	switch c.EncryptConfig {
+	case configFileEncryptionEnabled:
 		var key []byte
 
 		if IsInitialSetup {
-			key, err = PromptForConfigKey(true)
+			key, _, err = GetConfigKey(true)
 			if err != nil {
 				return err
 			}
@@ -1419,6 +1681,23 @@ func (c *Config) SaveConfig(configPath string) error {
 		if err != nil {
 			return err
 		}
+	case configFileEncryptionSecrets:
+		if len(sessionDK) == 0 {
+			key, _, err := GetConfigKey(true)
+			if err != nil {
+				return err
+			}
+
+			sessionDK, err = makeNewSessionDK(key)
+			if err != nil {
+				return err
+			}
+		}
+
+		payload, err = c.marshalEncryptedSecrets()
+		if err != nil {
+			return err
+		}
 	}
 	return common.WriteFile(defaultPath, payload)
 }
@@ -1441,6 +1720,22 @@ func (c *Config) CheckConfig() error {
 		}
 	}
 
+	if c.GRPC.Enabled {
+		err = c.CheckGRPCConfigValues()
+		if err != nil {
+			log.Warnf(ErrCheckingConfigValues, err)
+			c.GRPC.Enabled = false
+		}
+	}
+
+	if c.Scripting.Enabled {
+		err = c.CheckScriptingConfigValues()
+		if err != nil {
+			log.Warnf(ErrCheckingConfigValues, err)
+			c.Scripting.Enabled = false
+		}
+	}
+
 	err = c.CheckCurrencyConfigValues()
 	if err != nil {
 		return err
@@ -1488,6 +1783,41 @@ func (c *Config) UpdateConfig(configPath string, newCfg *Config) error {
 	return c.LoadConfig(configPath)
 }
 
+// SetConfig replaces the settings of the config with those of a new config,
+// which must already have been checked with CheckConfig. The settings are
+// applied one at a time under the config lock
+func (c *Config) SetConfig(newCfg *Config) {
+	m.Lock()
+	defer m.Unlock()
+	c.Name = newCfg.Name
+	c.EncryptConfig = newCfg.EncryptConfig
+	c.EncryptionSalt = newCfg.EncryptionSalt
+	c.GlobalHTTPTimeout = newCfg.GlobalHTTPTimeout
+	c.Logging = newCfg.Logging
+	c.Profiler = newCfg.Profiler
+	c.NTPClient = newCfg.NTPClient
+	c.Currency = newCfg.Currency
+	c.Communications = newCfg.Communications
+	c.Portfolio = newCfg.Portfolio
+	c.Webserver = newCfg.Webserver
+	c.GRPC = newCfg.GRPC
+	c.Scripting = newCfg.Scripting
+	c.Exchanges = newCfg.Exchanges
+	c.BankAccounts = newCfg.BankAccounts
+	c.ConnectionMonitor = newCfg.ConnectionMonitor
+	c.CurrencyPairFormat = newCfg.CurrencyPairFormat
+	c.FiatDisplayCurrency = newCfg.FiatDisplayCurrency
+	c.Cryptocurrencies = newCfg.Cryptocurrencies
+	c.SMS = newCfg.SMS
+}
+
+// GetWebserverConfig returns the webserver configuration
+func (c *Config) GetWebserverConfig() WebserverConfig {
+	m.Lock()
+	defer m.Unlock()
+	return c.Webserver
+}
+
 // GetConfig returns a pointer to a configuration object
 func GetConfig() *Config {
 	return &Cfg
@@ -5,9 +5,15 @@ import (
 	"crypto/aes"
 	"crypto/cipher"
 	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
+	"io/ioutil"
+	"os"
+	"strconv"
+	"strings"
 
 	"github.com/thrasher-corp/gocryptotrader/common"
 	log "github.com/thrasher-corp/gocryptotrader/logger"
@@ -22,11 +28,34 @@ const (
 	SaltPrefix = "~GCT~SO~SALTY~"
 	// SaltRandomLength is the number of random bytes to append after the prefix string
 	SaltRandomLength = 12
-
-	errAESBlockSize = "config file data is too small for the AES required block size"
+	// EncryptedSecretPrefix marks a config value which has been encrypted when
+	// only the secret values of the config are encrypted
+	EncryptedSecretPrefix = "encrypted:"
+
+	// KeyEnvVar is the environment variable holding the config key
+	KeyEnvVar = "GCT_CONFIG_KEY"
+	// KeyFileEnvVar is the environment variable holding the path of a file
+	// containing the config key
+	KeyFileEnvVar = "GCT_CONFIG_KEY_FILE"
+	// KeyFDEnvVar is the environment variable holding an open file descriptor
+	// the config key is read from
+	KeyFDEnvVar = "GCT_CONFIG_KEY_FD"
+
+	errAESBlockSize       = "config file data is too small for the AES required block size"
+	errConfigKeyEmpty     = "config key is empty"
+	errConfigNotEncrypted = "config is not encrypted"
+	errSecretInvalid      = "unable to decrypt config secret, the key is incorrect or the value is corrupt"
 )
 
 var (
+	// KeyFile is the path of a file containing the config key, it takes
+	// precedence over the environment
+	KeyFile string
+	// KeyFD is an open file descriptor the config key is read from, it takes
+	// precedence over the environment. The key is read once and reused
+	KeyFD int
+
+	fdKey      []byte
 	storedSalt []byte
 	sessionDK  []byte
 )
@@ -87,6 +116,83 @@ func PromptForConfigKey(initialSetup bool) ([]byte, error) {
 	return cryptoKey, nil
 }
 
+// GetConfigKey returns the config key from the key file or file descriptor,
+// the environment or, when none are set, by prompting for it. Interactive is
+// true when the key was entered at the prompt, a key from any other source
+// is not worth asking for again when it fails to decrypt the config
+func GetConfigKey(initialSetup bool) (key []byte, interactive bool, err error) {
+	switch {
+	case KeyFile != "":
+		key, err = readKeyFile(KeyFile)
+	case KeyFD > 0:
+		key, err = readKeyFD(KeyFD)
+	case os.Getenv(KeyEnvVar) != "":
+		key = []byte(os.Getenv(KeyEnvVar))
+	case os.Getenv(KeyFileEnvVar) != "":
+		key, err = readKeyFile(os.Getenv(KeyFileEnvVar))
+	case os.Getenv(KeyFDEnvVar) != "":
+		var fd int
+		fd, err = strconv.Atoi(os.Getenv(KeyFDEnvVar))
+		if err != nil {
+			return nil, false, fmt.Errorf("invalid %s value: %s", KeyFDEnvVar, err)
+		}
+		key, err = readKeyFD(fd)
+	default:
+		key, err = PromptForConfigKey(initialSetup)
+		return key, true, err
+	}
+
+	if err != nil {
+		return nil, false, err
+	}
+
+	if len(key) == 0 {
+		return nil, false, errors.New(errConfigKeyEmpty)
+	}
+	return key, false, nil
+}
+
+// KeySourceSet returns whether the config key is read from a key file, file
+// descriptor or the environment rather than prompted for
+func KeySourceSet() bool {
+	return KeyFile != "" ||
+		KeyFD > 0 ||
+		os.Getenv(KeyEnvVar) != "" ||
+		os.Getenv(KeyFileEnvVar) != "" ||
+		os.Getenv(KeyFDEnvVar) != ""
+}
+
+// readKeyFile reads the config key from a file, trailing new lines are
+// ignored
+func readKeyFile(path string) ([]byte, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return bytes.TrimRight(data, "\r\n"), nil
+}
+
+// readKeyFD reads the config key from an open file descriptor such as a pipe,
+// which can only be read once, so the key is kept for later calls
+func readKeyFD(fd int) ([]byte, error) {
+	if len(fdKey) > 0 {
+		return fdKey, nil
+	}
+
+	f := os.NewFile(uintptr(fd), "config key")
+	if f == nil {
+		return nil, fmt.Errorf("invalid config key file descriptor %d", fd)
+	}
+	defer f.Close()
+
+	data, err := ioutil.ReadAll(f)
+	if err != nil {
+		return nil, err
+	}
+	fdKey = bytes.TrimRight(data, "\r\n")
+	return fdKey, nil
+}
+
 // EncryptConfigFile encrypts configuration data that is parsed in with a key
 // and returns it as a byte array with an error
 func EncryptConfigFile(configData, key []byte) ([]byte, error) {
@@ -162,6 +268,291 @@ func DecryptConfigFile(configData, key []byte) ([]byte, error) {
 	return result, nil
 }
 
+// RekeyConfigFile decrypts configuration data with the key and encrypts it
+// again with the new key, both fully encrypted configuration data and
+// configuration data with only its secrets encrypted are supported
+func RekeyConfigFile(configData, key, newKey []byte) ([]byte, error) {
+	if len(newKey) == 0 {
+		return nil, errors.New(errConfigKeyEmpty)
+	}
+
+	if !ConfirmECS(configData) {
+		data, err := DecryptConfigSecrets(configData, key)
+		if err != nil {
+			return nil, err
+		}
+		sessionDK = nil
+		return EncryptConfigSecrets(data, newKey)
+	}
+
+	var f []byte
+	f = append(f, configData...)
+	data, err := DecryptConfigFile(f, key)
+	if err != nil {
+		return nil, err
+	}
+
+	var result interface{}
+	err = ConfirmConfigJSON(data, &result)
+	if err != nil {
+		return nil, errors.New("unable to decrypt config data, the key is incorrect")
+	}
+	sessionDK = nil
+	return EncryptConfigFile(data, newKey)
+}
+
+// EncryptConfigSecrets encrypts only the secret values of JSON configuration
+// data, such as API keys and secrets, bank accounts and passwords, leaving
+// the rest of the config readable
+func EncryptConfigSecrets(configData, key []byte) ([]byte, error) {
+	var c Config
+	err := ConfirmConfigJSON(configData, &c)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(sessionDK) == 0 {
+		sessionDK, err = makeNewSessionDK(key)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return c.marshalEncryptedSecrets()
+}
+
+// DecryptConfigSecrets decrypts the secret values of JSON configuration data
+// encrypted with EncryptConfigSecrets and returns the configuration data
+// with encryption disabled
+func DecryptConfigSecrets(configData, key []byte) ([]byte, error) {
+	var c Config
+	err := ConfirmConfigJSON(configData, &c)
+	if err != nil {
+		return nil, err
+	}
+
+	if c.EncryptConfig != configFileEncryptionSecrets {
+		return nil, errors.New(errConfigNotEncrypted)
+	}
+
+	err = c.decryptSecrets(key)
+	if err != nil {
+		return nil, err
+	}
+
+	c.EncryptConfig = configFileEncryptionDisabled
+	c.EncryptionSalt = nil
+	return json.MarshalIndent(&c, "", " ")
+}
+
+// ConfirmSecretsEncryption confirms that the config contains secret values
+// starting with the encrypted secret prefix
+func ConfirmSecretsEncryption(file []byte) bool {
+	var c Config
+	if json.Unmarshal(file, &c) != nil {
+		return false
+	}
+
+	fields := c.secretFields()
+	for i := range fields {
+		if strings.HasPrefix(*fields[i], EncryptedSecretPrefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// readSecrets obtains the config key and decrypts the secret values of the
+// config, a key entered at the prompt can be retried
+func (c *Config) readSecrets() error {
+	for errCounter := 1; ; errCounter++ {
+		key, interactive, err := GetConfigKey(false)
+		if err == nil {
+			err = c.decryptSecrets(key)
+			if err == nil {
+				return nil
+			}
+		}
+
+		if !interactive || errCounter >= configMaxAuthFailres {
+			return err
+		}
+		log.Errorf("Unable to decrypt config secrets: %s", err)
+	}
+}
+
+// decryptSecrets decrypts the secret values of the config with the key and
+// the config salt, the derived key is kept to encrypt the secrets on save.
+// The config is left unchanged when any value fails to decrypt
+func (c *Config) decryptSecrets(key []byte) error {
+	if len(c.EncryptionSalt) == 0 {
+		return errors.New("config encryption salt is empty")
+	}
+
+	dk, err := getScryptDK(key, c.EncryptionSalt)
+	if err != nil {
+		return err
+	}
+
+	fields := c.secretFields()
+	values := make([]string, len(fields))
+	for i := range fields {
+		values[i], err = decryptSecret(*fields[i], dk)
+		if err != nil {
+			return err
+		}
+	}
+
+	for i := range fields {
+		*fields[i] = values[i]
+	}
+
+	storedSalt = c.EncryptionSalt
+	sessionDK = dk
+	return nil
+}
+
+// marshalEncryptedSecrets returns the config as JSON with its secret values
+// encrypted with the session key, the config itself is left decrypted
+func (c *Config) marshalEncryptedSecrets() ([]byte, error) {
+	payload, err := json.Marshal(c)
+	if err != nil {
+		return nil, err
+	}
+
+	var cfg Config
+	err = json.Unmarshal(payload, &cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	fields := cfg.secretFields()
+	for i := range fields {
+		*fields[i], err = encryptSecret(*fields[i], sessionDK)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	cfg.EncryptConfig = configFileEncryptionSecrets
+	cfg.EncryptionSalt = storedSalt
+	return json.MarshalIndent(&cfg, "", " ")
+}
+
+// secretFields returns the config values which are encrypted when only the
+// secrets of the config are encrypted
+func (c *Config) secretFields() []*string {
+	fields := []*string{
+		&c.Webserver.AdminPassword,
+		&c.Currency.CryptocurrencyProvider.APIkey,
+		&c.Communications.SlackConfig.VerificationToken,
+		&c.Communications.SMSGlobalConfig.Password,
+		&c.Communications.SMTPConfig.AccountPassword,
+		&c.Communications.TelegramConfig.VerificationToken,
+	}
+
+	if c.SMS != nil {
+		fields = append(fields, &c.SMS.Password)
+	}
+
+	for i := range c.Currency.ForexProviders {
+		fields = append(fields, &c.Currency.ForexProviders[i].APIKey)
+	}
+
+	for i := range c.Exchanges {
+		exch := &c.Exchanges[i]
+		fields = append(fields,
+			&exch.APIKey,
+			&exch.APISecret,
+			&exch.APIAuthPEMKey,
+			&exch.ClientID)
+		for j := range exch.CredentialSets {
+			set := &exch.CredentialSets[j]
+			fields = append(fields,
+				&set.APIKey,
+				&set.APISecret,
+				&set.APIAuthPEMKey,
+				&set.ClientID)
+		}
+		fields = append(fields, bankAccountSecretFields(exch.BankAccounts)...)
+	}
+	return append(fields, bankAccountSecretFields(c.BankAccounts)...)
+}
+
+// bankAccountSecretFields returns the bank account values which are
+// encrypted when only the secrets of the config are encrypted
+func bankAccountSecretFields(accounts []BankAccount) []*string {
+	var fields []*string
+	for i := range accounts {
+		fields = append(fields,
+			&accounts[i].AccountName,
+			&accounts[i].AccountNumber,
+			&accounts[i].SWIFTCode,
+			&accounts[i].IBAN,
+			&accounts[i].BSBNumber)
+	}
+	return fields
+}
+
+// encryptSecret encrypts a config value with AES-GCM so that an incorrect key
+// is detected when decrypting. Empty and already encrypted values are
+// returned unchanged
+func encryptSecret(value string, dk []byte) (string, error) {
+	if value == "" || strings.HasPrefix(value, EncryptedSecretPrefix) {
+		return value, nil
+	}
+
+	gcm, err := newSecretCipher(dk)
+	if err != nil {
+		return "", err
+	}
+
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err = io.ReadFull(rand.Reader, nonce); err != nil {
+		return "", err
+	}
+
+	sealed := gcm.Seal(nonce, nonce, []byte(value), nil)
+	return EncryptedSecretPrefix + base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+// decryptSecret decrypts a config value encrypted by encryptSecret, values
+// without the encrypted prefix, such as secrets added to the config by hand,
+// are returned unchanged
+func decryptSecret(value string, dk []byte) (string, error) {
+	if !strings.HasPrefix(value, EncryptedSecretPrefix) {
+		return value, nil
+	}
+
+	data, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(value,
+		EncryptedSecretPrefix))
+	if err != nil {
+		return "", errors.New(errSecretInvalid)
+	}
+
+	gcm, err := newSecretCipher(dk)
+	if err != nil {
+		return "", err
+	}
+
+	if len(data) < gcm.NonceSize() {
+		return "", errors.New(errSecretInvalid)
+	}
+
+	result, err := gcm.Open(nil, data[:gcm.NonceSize()], data[gcm.NonceSize():], nil)
+	if err != nil {
+		return "", errors.New(errSecretInvalid)
+	}
+	return string(result), nil
+}
+
+func newSecretCipher(dk []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(dk)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
 // ConfirmConfigJSON confirms JSON in file
 func ConfirmConfigJSON(file []byte, result interface{}) error {
 	return common.JSONDecode(file, &result)
@@ -1,6 +1,11 @@
 package config
 
 import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/thrasher-corp/gocryptotrader/common"
@@ -126,3 +131,234 @@ func TestMakeNewSessionDK(t *testing.T) {
 		t.Fatal("Test failed. makeNewSessionDK passed with nil key")
 	}
 }
+
+func TestGetConfigKey(t *testing.T) {
+	defer func() {
+		KeyFile = ""
+		KeyFD = 0
+		fdKey = nil
+		os.Unsetenv(KeyEnvVar)
+	}()
+
+	os.Setenv(KeyEnvVar, "envkey")
+	key, interactive, err := GetConfigKey(false)
+	if err != nil || interactive || string(key) != "envkey" {
+		t.Errorf("Test failed. Expected envkey got %s %v %v", key, interactive, err)
+	}
+
+	dir, err := ioutil.TempDir("", "gctconfigkey")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	KeyFile = filepath.Join(dir, "key")
+	err = ioutil.WriteFile(KeyFile, []byte("filekey\n"), 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	key, _, err = GetConfigKey(false)
+	if err != nil || string(key) != "filekey" {
+		t.Errorf("Test failed. Expected filekey got %s %v", key, err)
+	}
+
+	KeyFile = ""
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = w.Write([]byte("pipekey\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	w.Close()
+
+	KeyFD = int(r.Fd())
+	for i := 0; i < 2; i++ {
+		key, _, err = GetConfigKey(false)
+		if err != nil || string(key) != "pipekey" {
+			t.Errorf("Test failed. Expected pipekey got %s %v", key, err)
+		}
+	}
+}
+
+func TestConfigSecrets(t *testing.T) {
+	testConfig, err := common.ReadFile(ConfigTestFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var c Config
+	err = ConfirmConfigJSON(testConfig, &c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.Exchanges[0].APISecret = "supersecret"
+	c.Exchanges[0].CredentialSets = []CredentialSetConfig{
+		{Name: "desk1", APIKey: "desk1key", APISecret: "desk1secret"},
+	}
+
+	sessionDK = nil
+	data, err := EncryptConfigSecrets(mustMarshalConfig(t, &c), []byte("key"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if bytes.Contains(data, []byte("supersecret")) ||
+		bytes.Contains(data, []byte("desk1secret")) ||
+		!ConfirmSecretsEncryption(data) {
+		t.Error("Test failed. Config secrets were not encrypted")
+	}
+
+	if !bytes.Contains(data, []byte(c.Exchanges[0].Name)) {
+		t.Error("Test failed. Expected non secret values to be readable")
+	}
+
+	c.Exchanges[0].APISecret = "not encrypted: " + EncryptedSecretPrefix
+	if ConfirmSecretsEncryption(mustMarshalConfig(t, &c)) {
+		t.Error("Test failed. Expected a value without the prefix to be unencrypted")
+	}
+
+	_, err = DecryptConfigSecrets(data, []byte("wrongkey"))
+	if err == nil {
+		t.Error("Test failed. Expected an error decrypting with the wrong key")
+	}
+
+	_, err = DecryptConfigSecrets(testConfig, []byte("key"))
+	if err == nil {
+		t.Error("Test failed. Expected an error decrypting an unencrypted config")
+	}
+
+	data, err = DecryptConfigSecrets(data, []byte("key"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var result Config
+	err = ConfirmConfigJSON(data, &result)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if result.Exchanges[0].APISecret != "supersecret" ||
+		result.Exchanges[0].CredentialSets[0].APISecret != "desk1secret" ||
+		result.EncryptConfig != configFileEncryptionDisabled {
+		t.Error("Test failed. Config secrets were not decrypted")
+	}
+}
+
+func TestRekeyConfigFile(t *testing.T) {
+	testConfig, err := common.ReadFile(ConfigTestFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sessionDK = nil
+	encrypted, err := EncryptConfigFile(testConfig, []byte("key"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = RekeyConfigFile(encrypted, []byte("wrongkey"), []byte("newkey"))
+	if err == nil {
+		t.Error("Test failed. Expected an error rekeying with the wrong key")
+	}
+
+	rekeyed, err := RekeyConfigFile(encrypted, []byte("key"), []byte("newkey"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := DecryptConfigFile(rekeyed, []byte("newkey"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var result interface{}
+	err = ConfirmConfigJSON(data, &result)
+	if err != nil {
+		t.Errorf("Test failed. Unable to decrypt rekeyed config: %s", err)
+	}
+
+	sessionDK = nil
+	encrypted, err = EncryptConfigSecrets(testConfig, []byte("key"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rekeyed, err = RekeyConfigFile(encrypted, []byte("key"), []byte("newkey"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = DecryptConfigSecrets(rekeyed, []byte("key"))
+	if err == nil {
+		t.Error("Test failed. Expected the old key to be rejected")
+	}
+
+	_, err = DecryptConfigSecrets(rekeyed, []byte("newkey"))
+	if err != nil {
+		t.Errorf("Test failed. Unable to decrypt rekeyed config: %s", err)
+	}
+}
+
+func TestReadConfigSecrets(t *testing.T) {
+	defer os.Unsetenv(KeyEnvVar)
+
+	testConfig, err := common.ReadFile(ConfigTestFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dir, err := ioutil.TempDir("", "gctconfig")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	var c Config
+	err = ConfirmConfigJSON(testConfig, &c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.Exchanges[0].APISecret = "supersecret"
+
+	sessionDK = nil
+	data, err := EncryptConfigSecrets(mustMarshalConfig(t, &c), []byte("key"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	path := filepath.Join(dir, ConfigFile)
+	err = common.WriteFile(path, data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	os.Setenv(KeyEnvVar, "wrongkey")
+	var cfg Config
+	err = cfg.ReadConfig(path)
+	if err == nil {
+		t.Error("Test failed. Expected an error reading the config with the wrong key")
+	}
+
+	os.Setenv(KeyEnvVar, "key")
+	err = cfg.ReadConfig(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if cfg.Exchanges[0].APISecret != "supersecret" {
+		t.Errorf("Test failed. Expected supersecret got %s",
+			cfg.Exchanges[0].APISecret)
+	}
+}
+
+func mustMarshalConfig(t *testing.T, c *Config) []byte {
+	data, err := json.Marshal(c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return data
+}
@@ -3,6 +3,7 @@ package config
 import (
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/currency"
@@ -668,6 +669,10 @@ func TestCheckExchangeConfigValues(t *testing.T) {
 	checkExchangeConfigValues.Exchanges[0].WebsocketResponseCheckTimeout = 0
 	checkExchangeConfigValues.Exchanges[0].WebsocketOrderbookBufferLimit = 0
 	checkExchangeConfigValues.Exchanges[0].HTTPTimeout = 0
+	checkExchangeConfigValues.Exchanges[0].HTTPRetry = &HTTPRetryConfig{
+		MaxRetries: 1,
+		MinBackoff: time.Second,
+	}
 	err = checkExchangeConfigValues.CheckExchangeConfigValues()
 	if err != nil {
 		t.Errorf("Test failed. checkExchangeConfigValues.CheckExchangeConfigValues: %s",
@@ -675,6 +680,10 @@ func TestCheckExchangeConfigValues(t *testing.T) {
 		)
 	}
 
+	if checkExchangeConfigValues.Exchanges[0].HTTPRetry != nil {
+		t.Error("Test failed. Expected invalid HTTP retry values to be removed")
+	}
+
 	if checkExchangeConfigValues.Exchanges[0].HTTPTimeout == 0 {
 		t.Fatalf("Test failed. Expected exchange %s to have updated HTTPTimeout value", checkExchangeConfigValues.Exchanges[0].Name)
 	}
@@ -730,6 +739,24 @@ func TestCheckExchangeConfigValues(t *testing.T) {
 		t.Error("Expected AuthenticatedAPISupport and AuthenticatedWebsocketAPISupport to be false from invalid API keys")
 	}
 
+	checkExchangeConfigValues.Exchanges[0].PaperTrading = &PaperTradingConfig{
+		Enabled:  true,
+		Balances: map[string]float64{"USD": 1000, "BTC": -1},
+	}
+	err = checkExchangeConfigValues.CheckExchangeConfigValues()
+	if err == nil {
+		t.Error("Test failed. Expected an error for a negative paper trading balance")
+	}
+
+	checkExchangeConfigValues.Exchanges[0].PaperTrading.Balances["BTC"] = 1
+	err = checkExchangeConfigValues.CheckExchangeConfigValues()
+	if err != nil {
+		t.Errorf(
+			"Test failed. checkExchangeConfigValues.CheckExchangeConfigValues: %s",
+			err,
+		)
+	}
+
 	checkExchangeConfigValues.Exchanges[0].BaseCurrencies = currency.NewCurrenciesFromStringArray([]string{""})
 	err = checkExchangeConfigValues.CheckExchangeConfigValues()
 	if err == nil {
@@ -853,6 +880,62 @@ func TestCheckWebserverConfigValues(t *testing.T) {
 	}
 }
 
+func TestCheckGRPCConfigValues(t *testing.T) {
+	var c Config
+	c.Webserver.AdminUsername = "admin"
+	c.Webserver.AdminPassword = "Password"
+	err := c.CheckGRPCConfigValues()
+	if err != nil {
+		t.Errorf("Test failed. CheckGRPCConfigValues: %s", err)
+	}
+
+	if c.GRPC.ListenAddress != DefaultGRPCListenAddress {
+		t.Errorf("Test failed. Expected default listen address, got %s",
+			c.GRPC.ListenAddress)
+	}
+
+	c.GRPC.ListenAddress = "localhost:LOLOLOL"
+	if err = c.CheckGRPCConfigValues(); err == nil {
+		t.Error("Test failed. Expected invalid listen address error")
+	}
+
+	c.GRPC.ListenAddress = DefaultGRPCListenAddress
+	c.GRPC.ClientCAFile = "LOLOLOL.pem"
+	if err = c.CheckGRPCConfigValues(); err == nil {
+		t.Error("Test failed. Expected missing client CA file error")
+	}
+
+	c.GRPC.ClientCAFile = ""
+	c.Webserver.AdminPassword = ""
+	if err = c.CheckGRPCConfigValues(); err == nil {
+		t.Error("Test failed. Expected empty credentials error")
+	}
+}
+
+func TestCheckScriptingConfigValues(t *testing.T) {
+	var c Config
+	err := c.CheckScriptingConfigValues()
+	if err != nil {
+		t.Errorf("Test failed. CheckScriptingConfigValues: %s", err)
+	}
+
+	if c.Scripting.Timeout != defaultScriptTimeout ||
+		c.Scripting.MaxScripts != defaultMaxScripts {
+		t.Errorf("Test failed. Expected default values, got %+v", c.Scripting)
+	}
+
+	c.Scripting.Scripts = []ScriptConfig{{Interval: time.Minute}}
+	if err = c.CheckScriptingConfigValues(); err == nil {
+		t.Error("Test failed. Expected empty script name error")
+	}
+
+	c.Scripting.Scripts[0].Name = "ticker.gct"
+	c.Scripting.Scripts[0].Interval = -time.Minute
+	if err = c.CheckScriptingConfigValues(); err == nil {
+		t.Error("Test failed. Expected negative interval error")
+	}
+}
+
 func TestRetrieveConfigCurrencyPairs(t *testing.T) {
 	cfg := GetConfig()
 	err := cfg.LoadConfig(ConfigTestFile)
@@ -1135,3 +1218,60 @@ func TestAreAuthenticatedCredentialsValid(t *testing.T) {
 		t.Error("Expecting false with an invalid index")
 	}
 }
+
+func TestCheckCredentialSets(t *testing.T) {
+	var c Config
+	exch := ExchangeConfig{
+		Name: "Bitmex",
+		CredentialSets: []CredentialSetConfig{
+			{Name: "desk1", APIKey: "key1", APISecret: "secret1"},
+			{Name: "DESK1", APIKey: "key2", APISecret: "secret2"},
+			{Name: "", APIKey: "key3", APISecret: "secret3"},
+			{Name: DefaultCredentialSet, APIKey: "key4", APISecret: "secret4"},
+			{Name: "desk2", APIKey: DefaultUnsetAPIKey, APISecret: "secret5"},
+			{Name: "desk3", APIKey: "key6", APISecret: "secret6"},
+		},
+	}
+
+	c.checkCredentialSets(&exch)
+	if len(exch.CredentialSets) != 2 ||
+		exch.CredentialSets[0].APIKey != "key1" ||
+		exch.CredentialSets[1].APIKey != "key6" {
+		t.Errorf("Test failed. Unexpected credential sets %+v", exch.CredentialSets)
+	}
+}
+
+func TestWithCredentialSet(t *testing.T) {
+	exch := ExchangeConfig{
+		Name:      "Bitmex",
+		APIKey:    "key",
+		APISecret: "secret",
+		CredentialSets: []CredentialSetConfig{
+			{Name: "desk1", APIKey: "key1", APISecret: "secret1"},
+		},
+	}
+
+	set, err := exch.GetCredentialSet("")
+	if err != nil || set.Name != DefaultCredentialSet || set.APIKey != "key" {
+		t.Errorf("Test failed. Unexpected default credential set %+v %v", set, err)
+	}
+
+	c, err := exch.WithCredentialSet("Desk1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if c.APIKey != "key1" || c.APISecret != "secret1" || c.CredentialSets != nil {
+		t.Errorf("Test failed. Unexpected credentials %s %s %v",
+			c.APIKey, c.APISecret, c.CredentialSets)
+	}
+
+	if exch.APIKey != "key" {
+		t.Error("Test failed. WithCredentialSet modified the exchange config")
+	}
+
+	_, err = exch.WithCredentialSet("desk2")
+	if err == nil {
+		t.Error("Test failed. Expected an error for a missing credential set")
+	}
+}
@@ -8,9 +8,10 @@ import (
 )
 
 // NewPairDelimiter splits the desired currency string at delimeter, the returns
-// a Pair struct
+// a Pair struct. The quote keeps anything after a second delimiter, such as
+// the contract of a futures instrument e.g. BTC-USD-SWAP
 func NewPairDelimiter(currencyPair, delimiter string) Pair {
-	result := strings.Split(currencyPair, delimiter)
+	result := strings.SplitN(currencyPair, delimiter, 2)
 	return Pair{
 		Delimiter: delimiter,
 		Base:      NewCode(result[0]),
@@ -338,6 +338,12 @@ func TestNewPairDelimiter(t *testing.T) {
 			actual, expected,
 		)
 	}
+
+	pair = NewPairDelimiter("BTC-USD-SWAP", "-")
+	if pair.Base.String() != "BTC" || pair.Quote.String() != "USD-SWAP" ||
+		pair.String() != "BTC-USD-SWAP" {
+		t.Errorf("Test failed. Unexpected contract pair %+v", pair)
+	}
 }
 
 // TestNewPairFromIndex returns a CurrencyPair via a currency string and
@@ -76,6 +76,9 @@ func (p *Pairs) UnmarshalJSON(d []byte) error {
 
 	var allThePairs Pairs
 	for _, data := range common.SplitStrings(pairs, ",") {
+		if data == "" {
+			continue
+		}
 		allThePairs = append(allThePairs, NewPairFromString(data))
 	}
 
@@ -89,6 +89,12 @@ func TestPairsUnmarshalJSON(t *testing.T) {
 		t.Errorf("Test Failed - Pairs UnmarshalJSON() error expected %s but received %s",
 			configPairs, unmarshalHere.Join())
 	}
+
+	err = common.JSONDecode([]byte(`""`), &unmarshalHere)
+	if err != nil || len(unmarshalHere) != 0 {
+		t.Errorf("Test Failed - Pairs UnmarshalJSON() expected no pairs %v %v",
+			unmarshalHere, err)
+	}
 }
 
 func TestPairsMarshalJSON(t *testing.T) {
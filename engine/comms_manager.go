@@ -0,0 +1,90 @@
+package engine
+
+import (
+	"sync"
+	"sync/atomic"
+
+	"github.com/thrasher-corp/gocryptotrader/communications"
+	"github.com/thrasher-corp/gocryptotrader/communications/base"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+	log "github.com/thrasher-corp/gocryptotrader/logger"
+)
+
+// commsManager relays ticker, orderbook and event data to the enabled
+// communication mediums
+type commsManager struct {
+	started int32
+	comms   *communications.Communications
+	engine  *Engine
+	m       sync.RWMutex
+}
+
+// Start sets up and connects the enabled communication mediums
+func (c *commsManager) Start() error {
+	if !atomic.CompareAndSwapInt32(&c.started, 0, 1) {
+		return ErrSubsystemAlreadyStarted
+	}
+
+	log.Debugf("Starting communication mediums..")
+	cfg := c.engine.Config.GetCommunicationsConfig()
+	comms := communications.NewComm(&cfg, c.engine.HTTPClient)
+	comms.GetEnabledCommunicationMediums()
+
+	c.m.Lock()
+	c.comms = comms
+	c.m.Unlock()
+	return nil
+}
+
+// Stop stops relaying data to the communication mediums
+func (c *commsManager) Stop() error {
+	if !atomic.CompareAndSwapInt32(&c.started, 1, 0) {
+		return ErrSubsystemNotStarted
+	}
+
+	c.m.Lock()
+	c.comms = nil
+	c.m.Unlock()
+	log.Debugln("Communications manager shutdown.")
+	return nil
+}
+
+// IsRunning returns whether or not the communications manager is running
+func (c *commsManager) IsRunning() bool {
+	return atomic.LoadInt32(&c.started) == 1
+}
+
+// StageTickerData stages ticker data for the communication mediums if the
+// manager is running
+func (c *commsManager) StageTickerData(exchangeName string, assetType asset.Item, tickerPrice *ticker.Price) {
+	c.m.RLock()
+	defer c.m.RUnlock()
+	if c.comms == nil {
+		return
+	}
+	c.comms.StageTickerData(exchangeName, assetType, tickerPrice)
+}
+
+// StageOrderbookData stages orderbook data for the communication mediums if
+// the manager is running
+func (c *commsManager) StageOrderbookData(exchangeName string, assetType asset.Item, ob *orderbook.Base) {
+	c.m.RLock()
+	defer c.m.RUnlock()
+	if c.comms == nil {
+		return
+	}
+	c.comms.StageOrderbookData(exchangeName, assetType, ob)
+}
+
+// PushEvent pushes an event to the communication mediums if the manager is
+// running
+func (c *commsManager) PushEvent(evt base.Event) {
+	c.m.RLock()
+	defer c.m.RUnlock()
+	if c.comms == nil {
+		return
+	}
+	c.comms.PushEvent(evt)
+}
@@ -0,0 +1,374 @@
+package engine
+
+import (
+	"errors"
+	"os"
+	"reflect"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	log "github.com/thrasher-corp/gocryptotrader/logger"
+)
+
+// configWatcherDelay is the delay between each check of the config file for
+// changes
+const configWatcherDelay = time.Second * 5
+
+// Config reload errors
+var (
+	ErrConfigKeyRequired = errors.New("config is encrypted and its key can only be prompted for, set a config key file, file descriptor or environment variable to reload it")
+)
+
+// ConfigReloadResult lists the changes applied by a config reload. Changed
+// settings which are only read at startup are listed as requiring a restart,
+// the webserver credentials and websocket limits are read live and apply
+// straight away
+type ConfigReloadResult struct {
+	LoadedExchanges       []string `json:"loadedExchanges,omitempty"`
+	ReloadedExchanges     []string `json:"reloadedExchanges,omitempty"`
+	UnloadedExchanges     []string `json:"unloadedExchanges,omitempty"`
+	ResubscribedExchanges []string `json:"resubscribedExchanges,omitempty"`
+	RestartedSubsystems   []string `json:"restartedSubsystems,omitempty"`
+	RestartRequired       []string `json:"restartRequired,omitempty"`
+}
+
+// ReloadConfig checks the new config and, when it is valid, replaces the
+// running config with it and applies the differences to each subsystem.
+// Changed exchanges are reloaded, reconnecting their websocket so that
+// subscriptions follow the enabled pairs, and changed communications, NTP
+// and connection monitor settings restart their subsystem. A config which
+// fails to check leaves the running config in place
+func (e *Engine) ReloadConfig(newCfg *config.Config) (*ConfigReloadResult, error) {
+	e.configReloadMtx.Lock()
+	defer e.configReloadMtx.Unlock()
+
+	err := newCfg.CheckConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	old := *e.Config
+	e.Config.SetConfig(newCfg)
+
+	result := new(ConfigReloadResult)
+	e.reloadExchanges(old.Exchanges, result)
+
+	httpTimeoutChanged := old.GlobalHTTPTimeout != e.Config.GlobalHTTPTimeout
+	if httpTimeoutChanged {
+		e.HTTPClient = common.NewHTTPClientWithTimeout(e.Config.GlobalHTTPTimeout)
+	}
+
+	restarts := []struct {
+		name    string
+		changed bool
+	}{
+		{SubsystemCommsManager, httpTimeoutChanged || !reflect.DeepEqual(old.Communications, e.Config.Communications)},
+		{SubsystemNTPManager, !reflect.DeepEqual(old.NTPClient, e.Config.NTPClient)},
+		{SubsystemConnectionManager, !reflect.DeepEqual(old.ConnectionMonitor, e.Config.ConnectionMonitor)},
+	}
+	for x := range restarts {
+		if !restarts[x].changed {
+			continue
+		}
+
+		s, err := e.GetSubsystem(restarts[x].name)
+		if err != nil || !s.IsRunning() {
+			continue
+		}
+
+		err = s.Stop()
+		if err == nil {
+			err = s.Start()
+		}
+		if err != nil {
+			log.Errorf("Config reload unable to restart %s: %s",
+				restarts[x].name, err)
+			continue
+		}
+		result.RestartedSubsystems = append(result.RestartedSubsystems,
+			restarts[x].name)
+	}
+
+	startup := []struct {
+		name    string
+		changed bool
+	}{
+		{"webserver", old.Webserver.Enabled != e.Config.Webserver.Enabled ||
+			old.Webserver.ListenAddress != e.Config.Webserver.ListenAddress},
+		{"grpc", !reflect.DeepEqual(old.GRPC, e.Config.GRPC)},
+		{"scripting", !reflect.DeepEqual(old.Scripting, e.Config.Scripting)},
+		{"currencyConfig", !reflect.DeepEqual(old.Currency, e.Config.Currency)},
+		{"logging", !reflect.DeepEqual(old.Logging, e.Config.Logging)},
+		{"profiler", !reflect.DeepEqual(old.Profiler, e.Config.Profiler)},
+		{"portfolioAddresses", !reflect.DeepEqual(old.Portfolio, e.Config.Portfolio)},
+	}
+	for x := range startup {
+		if startup[x].changed {
+			result.RestartRequired = append(result.RestartRequired,
+				startup[x].name)
+		}
+	}
+	return result, nil
+}
+
+// ReloadConfigFile reads the config file and reloads the config from it
+func (e *Engine) ReloadConfigFile() (*ConfigReloadResult, error) {
+	path, err := config.GetFilePath(e.Settings.ConfigFile)
+	if err != nil {
+		return nil, err
+	}
+
+	newCfg, err := readConfigFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return e.ReloadConfig(newCfg)
+}
+
+// saveConfig saves the running config to the config file unless the engine
+// is in dry run mode, the config watcher is told so that it does not reload
+// the saved file
+func (e *Engine) saveConfig() error {
+	if e.Settings.DryRun {
+		return nil
+	}
+
+	err := e.Config.SaveConfig(e.Settings.ConfigFile)
+	if err != nil {
+		return err
+	}
+	e.configWatcher.sync()
+	return nil
+}
+
+// reloadExchanges loads, reloads or unloads each exchange whose config
+// differs from its previous config
+func (e *Engine) reloadExchanges(oldExchanges []config.ExchangeConfig, result *ConfigReloadResult) {
+	previous := make(map[string]config.ExchangeConfig)
+	for x := range oldExchanges {
+		previous[strings.ToLower(oldExchanges[x].Name)] = oldExchanges[x]
+	}
+
+	for x := range e.Config.Exchanges {
+		exchCfg := e.Config.Exchanges[x]
+		oldCfg, ok := previous[strings.ToLower(exchCfg.Name)]
+		if ok && reflect.DeepEqual(oldCfg, exchCfg) {
+			continue
+		}
+
+		name := exchCfg.Name
+		exch := e.GetExchangeByName(name)
+		switch {
+		case exch == nil && !exchCfg.Enabled:
+			continue
+
+		case !exchCfg.Enabled:
+			e.disconnectWebsocket(exch)
+			err := e.UnloadExchange(name)
+			if err != nil {
+				log.Errorf("Config reload unable to unload %s: %s", name, err)
+				continue
+			}
+			result.UnloadedExchanges = append(result.UnloadedExchanges, name)
+
+		case exch == nil:
+			err := e.LoadExchange(name, false, nil)
+			if err != nil {
+				log.Errorf("Config reload unable to load %s: %s", name, err)
+				continue
+			}
+			result.LoadedExchanges = append(result.LoadedExchanges, name)
+			e.connectWebsocket(e.GetExchangeByName(name))
+
+		default:
+			connected := e.disconnectWebsocket(exch)
+			err := e.ReloadExchange(name)
+			if err != nil {
+				log.Errorf("Config reload unable to reload %s: %s", name, err)
+				continue
+			}
+			result.ReloadedExchanges = append(result.ReloadedExchanges, name)
+
+			if (connected || (exchCfg.Websocket && !oldCfg.Websocket)) &&
+				e.connectWebsocket(exch) {
+				result.ResubscribedExchanges = append(result.ResubscribedExchanges,
+					name)
+			}
+		}
+	}
+}
+
+// disconnectWebsocket shuts down the exchange websocket when it is connected
+// and clears its subscriptions, which are generated again from the
+// exchanges enabled pairs when it reconnects
+func (e *Engine) disconnectWebsocket(exch exchange.IBotExchange) bool {
+	ws, err := exch.GetWebsocket()
+	if err != nil || !ws.IsConnected() {
+		return false
+	}
+
+	err = ws.Shutdown()
+	if err != nil {
+		log.Errorf("%s unable to shutdown websocket: %s", exch.GetName(), err)
+	}
+	ws.ClearChannelsToSubscribe()
+	return true
+}
+
+// connectWebsocket connects the exchange websocket when the websocket routine
+// manager is running
+func (e *Engine) connectWebsocket(exch exchange.IBotExchange) bool {
+	if exch == nil || !e.websocketRoutineMgr.IsRunning() {
+		return false
+	}
+	return e.websocketRoutineMgr.connect(exch) == nil
+}
+
+// readConfigFile reads a config file without prompting for anything, an
+// encrypted config file can only be read when its key is read from a file,
+// file descriptor or the environment
+func readConfigFile(path string) (*config.Config, error) {
+	data, err := common.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var newCfg config.Config
+	if !config.ConfirmECS(data) && !config.ConfirmSecretsEncryption(data) {
+		err = config.ConfirmConfigJSON(data, &newCfg)
+		if err != nil {
+			return nil, err
+		}
+		return &newCfg, nil
+	}
+
+	if !config.KeySourceSet() {
+		return nil, ErrConfigKeyRequired
+	}
+
+	err = newCfg.ReadConfig(path)
+	if err != nil {
+		return nil, err
+	}
+	return &newCfg, nil
+}
+
+// configWatcher reloads the config whenever the config file is modified
+type configWatcher struct {
+	started  int32
+	shutdown chan struct{}
+	wg       sync.WaitGroup
+	engine   *Engine
+
+	path    string
+	modTime time.Time
+	size    int64
+	m       sync.Mutex
+}
+
+// Start starts watching the config file for changes
+func (c *configWatcher) Start() error {
+	if !atomic.CompareAndSwapInt32(&c.started, 0, 1) {
+		return ErrSubsystemAlreadyStarted
+	}
+
+	path, err := config.GetFilePath(c.engine.Settings.ConfigFile)
+	if err != nil {
+		atomic.StoreInt32(&c.started, 0)
+		return err
+	}
+
+	c.m.Lock()
+	c.path = path
+	c.m.Unlock()
+	c.sync()
+
+	log.Debugf("Config watcher started, watching %s.\n", path)
+	c.shutdown = make(chan struct{})
+	c.wg.Add(1)
+	go c.run()
+	return nil
+}
+
+// Stop stops watching the config file
+func (c *configWatcher) Stop() error {
+	if !atomic.CompareAndSwapInt32(&c.started, 1, 0) {
+		return ErrSubsystemNotStarted
+	}
+
+	close(c.shutdown)
+	c.wg.Wait()
+	log.Debugln("Config watcher shutdown.")
+	return nil
+}
+
+// IsRunning returns whether or not the config watcher is running
+func (c *configWatcher) IsRunning() bool {
+	return atomic.LoadInt32(&c.started) == 1
+}
+
+func (c *configWatcher) run() {
+	t := time.NewTicker(configWatcherDelay)
+	defer func() {
+		t.Stop()
+		c.wg.Done()
+	}()
+
+	for {
+		select {
+		case <-c.shutdown:
+			return
+		case <-t.C:
+			if !c.modified() {
+				continue
+			}
+
+			result, err := c.engine.ReloadConfigFile()
+			if err != nil {
+				log.Errorf("Config watcher unable to reload config: %s", err)
+				continue
+			}
+			log.Debugf("Config watcher reloaded config: %+v\n", *result)
+		}
+	}
+}
+
+// modified returns whether the config file has been modified since it was
+// last seen
+func (c *configWatcher) modified() bool {
+	c.m.Lock()
+	defer c.m.Unlock()
+	info, err := os.Stat(c.path)
+	if err != nil {
+		return false
+	}
+
+	if info.ModTime().Equal(c.modTime) && info.Size() == c.size {
+		return false
+	}
+	c.modTime = info.ModTime()
+	c.size = info.Size()
+	return true
+}
+
+// sync records the current state of the config file so that changes made by
+// the engine itself are not reloaded
+func (c *configWatcher) sync() {
+	c.m.Lock()
+	defer c.m.Unlock()
+	if c.path == "" {
+		return
+	}
+
+	info, err := os.Stat(c.path)
+	if err != nil {
+		return
+	}
+	c.modTime = info.ModTime()
+	c.size = info.Size()
+}
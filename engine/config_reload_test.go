@@ -0,0 +1,199 @@
+package engine
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+)
+
+// copyTestConfig returns a deep copy of the config with the exchange enabled
+// or disabled
+func copyTestConfig(t *testing.T, c *config.Config, exchName string, enabled bool) *config.Config {
+	data, err := json.Marshal(c)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var cfg config.Config
+	err = json.Unmarshal(data, &cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for x := range cfg.Exchanges {
+		if cfg.Exchanges[x].Name == exchName {
+			cfg.Exchanges[x].Enabled = enabled
+		}
+	}
+	return &cfg
+}
+
+func TestReloadConfig(t *testing.T) {
+	SetupTest(t)
+	defer CleanupTest(t)
+
+	original := copyTestConfig(t, testBot.Config, "Bitfinex", true)
+	defer testBot.Config.SetConfig(original)
+
+	_, err := testBot.ReloadConfig(copyTestConfig(t, testBot.Config, "Bitfinex", true))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	invalid := copyTestConfig(t, testBot.Config, "Bitfinex", true)
+	for x := range invalid.Exchanges {
+		invalid.Exchanges[x].Enabled = false
+	}
+	_, err = testBot.ReloadConfig(invalid)
+	if err == nil {
+		t.Error("Test failed. Expected an error reloading a config without enabled exchanges")
+	}
+
+	exchCfg, err := testBot.Config.GetExchangeConfig("Bitfinex")
+	if err != nil || !exchCfg.Enabled {
+		t.Error("Test failed. Invalid config replaced the running config")
+	}
+
+	changed := copyTestConfig(t, testBot.Config, "Bitfinex", true)
+	for x := range changed.Exchanges {
+		if changed.Exchanges[x].Name == "Bitfinex" {
+			changed.Exchanges[x].EnabledPairs = currency.NewPairsFromStrings([]string{"BTCUSD"})
+		}
+	}
+	result, err := testBot.ReloadConfig(changed)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(result.ReloadedExchanges) != 1 || result.ReloadedExchanges[0] != "Bitfinex" ||
+		len(result.LoadedExchanges) != 0 || len(result.UnloadedExchanges) != 0 {
+		t.Errorf("Test failed. Unexpected reload result %+v", *result)
+	}
+
+	pairs := testBot.GetExchangeByName("Bitfinex").GetEnabledCurrencies()
+	if len(pairs) != 1 {
+		t.Errorf("Test failed. Expected 1 enabled pair got %s", pairs)
+	}
+
+	result, err = testBot.ReloadConfig(copyTestConfig(t, testBot.Config, "Bitfinex", true))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(result.ReloadedExchanges) != 0 {
+		t.Errorf("Test failed. Expected an unchanged config to reload nothing %+v",
+			*result)
+	}
+
+	changed = copyTestConfig(t, testBot.Config, "Bitfinex", false)
+	changed.Webserver.ListenAddress = "localhost:9999"
+	result, err = testBot.ReloadConfig(changed)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(result.UnloadedExchanges) != 1 || testBot.CheckExchangeExists("Bitfinex") {
+		t.Errorf("Test failed. Expected Bitfinex to be unloaded %+v", *result)
+	}
+
+	if len(result.RestartRequired) != 1 || result.RestartRequired[0] != "webserver" {
+		t.Errorf("Test failed. Expected webserver to require a restart %+v",
+			*result)
+	}
+
+	changed = copyTestConfig(t, testBot.Config, "Bitfinex", false)
+	changed.Webserver.AdminPassword = "reloaded"
+	result, err = testBot.ReloadConfig(changed)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(result.RestartRequired) != 0 ||
+		testBot.Config.GetWebserverConfig().AdminPassword != "reloaded" {
+		t.Errorf("Test failed. Expected the webserver credentials to apply live %+v",
+			*result)
+	}
+}
+
+func TestReadConfigFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "configreload")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	data, err := common.ReadFile(TestConfig)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cfg, err := readConfigFile(TestConfig)
+	if err != nil || len(cfg.Exchanges) == 0 {
+		t.Errorf("Test failed. Unable to read config file %v", err)
+	}
+
+	data, err = config.EncryptConfigFile(data, []byte("key"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	path := filepath.Join(dir, config.EncryptedConfigFile)
+	err = common.WriteFile(path, data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = readConfigFile(path)
+	if err != ErrConfigKeyRequired {
+		t.Errorf("Test failed. Expected %s got %v", ErrConfigKeyRequired, err)
+	}
+}
+
+func TestConfigWatcherModified(t *testing.T) {
+	dir, err := ioutil.TempDir("", "configwatcher")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	var c configWatcher
+	c.path = filepath.Join(dir, config.ConfigFile)
+	err = common.WriteFile(c.path, []byte("{}"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c.sync()
+	if c.modified() {
+		t.Error("Test failed. Expected the config file to be unmodified")
+	}
+
+	err = common.WriteFile(c.path, []byte(`{"name":"test"}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !c.modified() {
+		t.Error("Test failed. Expected the config file to be modified")
+	}
+
+	if c.modified() {
+		t.Error("Test failed. Expected the modification to be seen once")
+	}
+
+	err = os.Chtimes(c.path, time.Now(), time.Now().Add(time.Minute))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !c.modified() {
+		t.Error("Test failed. Expected a new modification time to be seen")
+	}
+}
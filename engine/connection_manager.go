@@ -0,0 +1,61 @@
+package engine
+
+import (
+	"sync/atomic"
+
+	"github.com/thrasher-corp/gocryptotrader/connchecker"
+	log "github.com/thrasher-corp/gocryptotrader/logger"
+)
+
+// connectionManager monitors internet connectivity
+type connectionManager struct {
+	started int32
+	conn    *connchecker.Checker
+	engine  *Engine
+}
+
+// Start starts the connectivity monitor
+func (c *connectionManager) Start() error {
+	if !atomic.CompareAndSwapInt32(&c.started, 0, 1) {
+		return ErrSubsystemAlreadyStarted
+	}
+
+	log.Debugln("Connection manager starting...")
+	cfg := c.engine.Config.ConnectionMonitor
+	var err error
+	c.conn, err = connchecker.New(cfg.DNSList,
+		cfg.PublicDomainList,
+		cfg.CheckInterval)
+	if err != nil {
+		atomic.StoreInt32(&c.started, 0)
+		return err
+	}
+
+	log.Debugln("Connection manager started.")
+	return nil
+}
+
+// Stop stops the connectivity monitor
+func (c *connectionManager) Stop() error {
+	if !atomic.CompareAndSwapInt32(&c.started, 1, 0) {
+		return ErrSubsystemNotStarted
+	}
+
+	c.conn.Shutdown()
+	log.Debugln("Connection manager shutdown.")
+	return nil
+}
+
+// IsRunning returns whether or not the connectivity monitor is running
+func (c *connectionManager) IsRunning() bool {
+	return atomic.LoadInt32(&c.started) == 1
+}
+
+// IsOnline returns whether or not internet connectivity is available. If the
+// connectivity monitor is not running it is assumed there is connectivity
+func (c *connectionManager) IsOnline() bool {
+	if !c.IsRunning() || c.conn == nil {
+		return true
+	}
+	return c.conn.IsConnected()
+}
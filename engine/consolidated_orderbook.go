@@ -0,0 +1,174 @@
+package engine
+
+import (
+	"errors"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+)
+
+// consolidatedOrderbookMaxAge is the age after which a stored orderbook is
+// too stale to be merged into a consolidated orderbook
+const consolidatedOrderbookMaxAge = time.Minute
+
+var (
+	errNoOrderbooks   = errors.New("no enabled exchange has an orderbook for the currency pair")
+	errStaleOrderbook = errors.New("orderbook is stale")
+)
+
+// ConsolidatedOrderbookRequest holds the parameters of a consolidated
+// orderbook query
+type ConsolidatedOrderbookRequest struct {
+	Pair      currency.Pair `json:"pair"`
+	AssetType asset.Item    `json:"assetType"`
+	// ConvertQuotes merges the orderbooks of pairs with the same base and a
+	// different fiat quote currency, converting their prices into the quote
+	// currency of the pair
+	ConvertQuotes bool `json:"convertQuotes,omitempty"`
+	// Amount is routed against both sides of the consolidated orderbook in
+	// the base currency when set
+	Amount float64 `json:"amount,omitempty"`
+}
+
+// ConsolidatedVenue is an exchange orderbook considered for a consolidated
+// orderbook, Error is set when it could not be merged
+type ConsolidatedVenue struct {
+	Exchange       string        `json:"exchange"`
+	Pair           currency.Pair `json:"pair"`
+	FeeRate        float64       `json:"feeRate"`
+	ConversionRate float64       `json:"conversionRate"`
+	Bids           int           `json:"bids"`
+	Asks           int           `json:"asks"`
+	LastUpdated    time.Time     `json:"lastUpdated"`
+	Error          string        `json:"error,omitempty"`
+}
+
+// ConsolidatedOrderbook is the orderbook of a currency pair merged across the
+// enabled exchanges along with the routes of the request amount
+type ConsolidatedOrderbook struct {
+	*orderbook.Consolidated
+	Venues []ConsolidatedVenue `json:"venues"`
+	Buy    *orderbook.Route    `json:"buy,omitempty"`
+	Sell   *orderbook.Route    `json:"sell,omitempty"`
+}
+
+// GetConsolidatedOrderbook merges the stored orderbook of a currency pair
+// from every enabled exchange, no orderbooks are fetched and those older than
+// consolidatedOrderbookMaxAge are left out. Prices are fee adjusted by the
+// offline taker fee of each exchange, exchanges which cannot quote one are
+// merged without a fee
+func (e *Engine) GetConsolidatedOrderbook(req *ConsolidatedOrderbookRequest) (*ConsolidatedOrderbook, error) {
+	if req.Pair.IsEmpty() || req.Amount < 0 {
+		return nil, errInvalidArguments
+	}
+	if req.AssetType == "" {
+		req.AssetType = asset.Spot
+	}
+
+	var venues []orderbook.Venue
+	var reports []ConsolidatedVenue
+	exchanges := e.GetExchanges()
+	for x := range exchanges {
+		if exchanges[x] == nil || !exchanges[x].IsEnabled() ||
+			!exchanges[x].GetAssetTypes().Contains(req.AssetType) {
+			continue
+		}
+		pairs := exchanges[x].GetEnabledPairs(req.AssetType)
+		for y := range pairs {
+			if !consolidatedPair(req, pairs[y]) {
+				continue
+			}
+			venue, report := consolidatedVenue(exchanges[x], req, pairs[y])
+			reports = append(reports, report)
+			if venue.Book != nil {
+				venues = append(venues, venue)
+			}
+		}
+	}
+	if len(reports) == 0 {
+		return nil, errNoOrderbooks
+	}
+
+	result := &ConsolidatedOrderbook{
+		Consolidated: orderbook.Consolidate(req.Pair, req.AssetType, venues),
+		Venues:       reports,
+	}
+	if req.Amount > 0 {
+		buy, err := result.RouteBuy(req.Amount)
+		if err == nil {
+			result.Buy = &buy
+		}
+		sell, err := result.RouteSell(req.Amount)
+		if err == nil {
+			result.Sell = &sell
+		}
+	}
+	return result, nil
+}
+
+// consolidatedPair returns whether an exchange pair is merged into the
+// consolidated orderbook of the request
+func consolidatedPair(req *ConsolidatedOrderbookRequest, p currency.Pair) bool {
+	if !p.Base.Match(req.Pair.Base) {
+		return false
+	}
+	if p.Quote.Match(req.Pair.Quote) {
+		return true
+	}
+	return req.ConvertQuotes && p.Quote.IsFiatCurrency() &&
+		req.Pair.Quote.IsFiatCurrency()
+}
+
+// consolidatedVenue returns the stored orderbook, fee and conversion rate of
+// an exchange pair, the venue orderbook is nil if it is missing or stale
+func consolidatedVenue(exch exchange.IBotExchange, req *ConsolidatedOrderbookRequest, p currency.Pair) (orderbook.Venue, ConsolidatedVenue) {
+	report := ConsolidatedVenue{
+		Exchange:       exch.GetName(),
+		Pair:           p,
+		ConversionRate: 1,
+	}
+	var venue orderbook.Venue
+
+	if !p.Quote.Match(req.Pair.Quote) {
+		rate, err := currency.ConvertCurrency(1, p.Quote, req.Pair.Quote)
+		if err != nil {
+			report.Error = err.Error()
+			return venue, report
+		}
+		report.ConversionRate = rate
+	}
+
+	ob, err := orderbook.Get(exch.GetName(), p, req.AssetType)
+	if err != nil {
+		report.Error = err.Error()
+		return venue, report
+	}
+	report.Bids, report.Asks = len(ob.Bids), len(ob.Asks)
+	report.LastUpdated = ob.LastUpdated
+	if time.Since(ob.LastUpdated) > consolidatedOrderbookMaxAge {
+		report.Error = errStaleOrderbook.Error()
+		return venue, report
+	}
+
+	// The offline taker fee of a notional of one is the taker fee rate
+	fee, err := exch.GetFeeByType(&exchange.FeeBuilder{
+		FeeType:       exchange.OfflineTradeFee,
+		IsMaker:       false,
+		Pair:          p,
+		PurchasePrice: 1,
+		Amount:        1,
+	})
+	if err == nil {
+		report.FeeRate = fee
+	}
+
+	venue = orderbook.Venue{
+		Book:           &ob,
+		FeeRate:        report.FeeRate,
+		ConversionRate: report.ConversionRate,
+	}
+	return venue, report
+}
@@ -0,0 +1,129 @@
+package engine
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+)
+
+// consolidatedTestExchange is a minimal exchange with a fixed offline taker
+// fee
+type consolidatedTestExchange struct {
+	exchange.IBotExchange
+	name  string
+	pairs currency.Pairs
+	fee   float64
+}
+
+func (c *consolidatedTestExchange) GetName() string { return c.name }
+
+func (c *consolidatedTestExchange) IsEnabled() bool { return true }
+
+func (c *consolidatedTestExchange) GetAssetTypes() asset.Items {
+	return asset.Items{asset.Spot}
+}
+
+func (c *consolidatedTestExchange) GetEnabledPairs(_ asset.Item) currency.Pairs {
+	return c.pairs
+}
+
+func (c *consolidatedTestExchange) GetFeeByType(f *exchange.FeeBuilder) (float64, error) {
+	if f.FeeType != exchange.OfflineTradeFee || f.IsMaker {
+		return 0, errors.New("unexpected fee type")
+	}
+	return c.fee * f.PurchasePrice * f.Amount, nil
+}
+
+func TestGetConsolidatedOrderbook(t *testing.T) {
+	pair := currency.NewPairFromStrings("CONS", "USD")
+	other := currency.NewPairFromStrings("CONS", "BTC")
+	e := &Engine{
+		Exchanges: []exchange.IBotExchange{
+			&consolidatedTestExchange{name: "ConsolidatedA", pairs: currency.Pairs{pair, other}},
+			&consolidatedTestExchange{name: "ConsolidatedB", pairs: currency.Pairs{pair}, fee: 0.01},
+			&consolidatedTestExchange{name: "ConsolidatedC", pairs: currency.Pairs{pair}},
+			&consolidatedTestExchange{name: "ConsolidatedD", pairs: currency.Pairs{pair}},
+		},
+		Config: &config.Config{
+			Webserver: config.WebserverConfig{
+				AdminUsername: "admin",
+				AdminPassword: "Password",
+				ListenAddress: "localhost:9050",
+			},
+		},
+	}
+
+	books := []orderbook.Base{
+		{
+			ExchangeName: "ConsolidatedA",
+			Bids:         []orderbook.Item{{Price: 99, Amount: 1}},
+			Asks:         []orderbook.Item{{Price: 101, Amount: 1}},
+		},
+		{
+			ExchangeName: "ConsolidatedB",
+			Bids:         []orderbook.Item{{Price: 99.5, Amount: 1}},
+			Asks:         []orderbook.Item{{Price: 100.5, Amount: 2}},
+		},
+		{
+			ExchangeName: "ConsolidatedD",
+			Bids:         []orderbook.Item{{Price: 150, Amount: 1}},
+			Asks:         []orderbook.Item{{Price: 50, Amount: 1}},
+			LastUpdated:  time.Now().Add(-consolidatedOrderbookMaxAge * 2),
+		},
+	}
+	for x := range books {
+		books[x].Pair = pair
+		books[x].AssetType = asset.Spot
+		err := books[x].Process()
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	result, err := e.GetConsolidatedOrderbook(&ConsolidatedOrderbookRequest{
+		Pair:   pair,
+		Amount: 2,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(result.Venues) != 4 || result.Venues[0].Error != "" ||
+		result.Venues[1].FeeRate != 0.01 || result.Venues[2].Error == "" ||
+		result.Venues[3].Error != errStaleOrderbook.Error() {
+		t.Fatalf("Test failed. Unexpected venues %+v", result.Venues)
+	}
+	if len(result.Bids) != 2 || result.Bids[0].Exchange != "ConsolidatedA" {
+		t.Errorf("Test failed. Expected the fee adjusted best bid first %+v", result.Bids)
+	}
+	if result.Buy == nil || !result.Buy.FullyFilled || len(result.Buy.Fills) != 2 ||
+		result.Sell == nil || result.Sell.BaseAmount != 2 {
+		t.Errorf("Test failed. Unexpected routes %+v %+v", result.Buy, result.Sell)
+	}
+
+	_, err = e.GetConsolidatedOrderbook(&ConsolidatedOrderbookRequest{})
+	if err != errInvalidArguments {
+		t.Errorf("Test failed. Expected %s got %v", errInvalidArguments, err)
+	}
+
+	tests := map[string]int{
+		"/exchanges/orderbook/consolidated/CONS-USD?amount=1":        http.StatusOK,
+		"/exchanges/orderbook/consolidated/CONS-USD?amount=abc":      http.StatusBadRequest,
+		"/exchanges/orderbook/consolidated/CONS-USD?assetType=stock": http.StatusBadRequest,
+		"/exchanges/orderbook/consolidated/CONS-EUR":                 http.StatusNotFound,
+	}
+	for path, status := range tests {
+		resp := makeAuthRequest(t, e, http.MethodGet, path, nil)
+		if resp.Code != status {
+			t.Errorf("Test failed. %s expected status %d got %d", path,
+				status, resp.Code)
+		}
+	}
+}
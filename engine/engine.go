@@ -0,0 +1,429 @@
+package engine
+
+import (
+	"errors"
+	"net/http"
+	"runtime"
+	"sync"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/currency/coinmarketcap"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	log "github.com/thrasher-corp/gocryptotrader/logger"
+	"github.com/thrasher-corp/gocryptotrader/portfolio"
+)
+
+// Engine contains configuration, portfolio, exchange & ticker data and is the
+// overarching type across this code base. Each engine owns its own set of
+// subsystems which can be started and stopped independently of one another
+type Engine struct {
+	Config    *config.Config
+	Portfolio *portfolio.Base
+	Exchanges []exchange.IBotExchange
+	Settings  Settings
+	Uptime    time.Time
+	// HTTPClient is used by the communication mediums, it is replaced when
+	// the global HTTP timeout of the config changes
+	HTTPClient *http.Client
+
+	ntpManager          ntpManager
+	connectionManager   connectionManager
+	commsManager        commsManager
+	orderManager        orderManager
+	eventManager        eventManager
+	scriptManager       scriptManager
+	portfolioManager    portfolioManager
+	webserverManager    webserverManager
+	grpcServer          grpcServer
+	syncManager         syncManager
+	tickerUpdater       tickerUpdater
+	orderbookUpdater    orderbookUpdater
+	websocketRoutineMgr websocketRoutineManager
+	configWatcher       configWatcher
+
+	// credentialSets holds the exchange instances set up with each named
+	// credential set keyed by lower case exchange name, guarded by
+	// exchangesMtx
+	credentialSets  map[string][]credentialSet
+	exchangesMtx    sync.RWMutex
+	configReloadMtx sync.Mutex
+	running         bool
+	sync.Mutex
+}
+
+// Settings stores the engine params which determine which subsystems are
+// enabled at startup
+type Settings struct {
+	ConfigFile string
+	DataDir    string
+	Verbose    bool
+	DryRun     bool
+
+	// Config key sources used instead of prompting for the key of an
+	// encrypted config
+	ConfigKeyFile string
+	ConfigKeyFD   int
+
+	// Subsystem settings
+	EnableNTPClient           bool
+	EnableConnectivityMonitor bool
+	EnableCommsRelayer        bool
+	EnableOrderManager        bool
+	EnableEventManager        bool
+	EnableScriptManager       bool
+	EnablePortfolioWatcher    bool
+	EnableWebserver           bool
+	EnableGRPC                bool
+	EnableSyncManager         bool
+	EnableTickerRoutine       bool
+	EnableOrderbookRoutine    bool
+	EnableWebsocketRoutine    bool
+	EnableConfigWatcher       bool
+
+	// Sync manager settings
+	SyncTimeout      time.Duration
+	SyncItemTimeout  time.Duration
+	SyncRESTInterval time.Duration
+
+	// Event manager settings
+	EventsMaxNotional float64
+
+	// Currency storage overrides
+	EnableCoinmarketcapAnalysis bool
+	EnableCurrencyConverter     bool
+	EnableCurrencyLayer         bool
+	EnableFixer                 bool
+	EnableOpenExchangeRates     bool
+}
+
+// Engine errors
+var (
+	ErrEngineAlreadyRunning = errors.New("engine already running")
+	ErrEngineNotRunning     = errors.New("engine not running")
+)
+
+// New returns a new engine instance using the default config path and data
+// directory with all subsystems enabled
+func New() (*Engine, error) {
+	defaultPath, err := config.GetFilePath("")
+	if err != nil {
+		return nil, err
+	}
+
+	return NewFromSettings(&Settings{
+		ConfigFile:                defaultPath,
+		DataDir:                   common.GetDefaultDataDir(runtime.GOOS),
+		EnableNTPClient:           true,
+		EnableConnectivityMonitor: true,
+		EnableCommsRelayer:        true,
+		EnableOrderManager:        true,
+		EnableEventManager:        true,
+		EnableScriptManager:       true,
+		EnablePortfolioWatcher:    true,
+		EnableWebserver:           true,
+		EnableGRPC:                true,
+		EnableSyncManager:         true,
+		EnableTickerRoutine:       true,
+		EnableOrderbookRoutine:    true,
+		EnableWebsocketRoutine:    true,
+		EnableConfigWatcher:       true,
+	})
+}
+
+// NewFromSettings returns a new engine instance with the supplied settings.
+// The config file is loaded and validated, and subsystems which have been
+// disabled via the config file are disabled regardless of the settings
+// supplied
+func NewFromSettings(settings *Settings) (*Engine, error) {
+	if settings == nil {
+		return nil, errors.New("engine: settings are nil")
+	}
+
+	e := new(Engine)
+	e.Settings = *settings
+	e.Config = new(config.Config)
+	if e.Settings.ConfigKeyFile != "" {
+		config.KeyFile = e.Settings.ConfigKeyFile
+	}
+	if e.Settings.ConfigKeyFD > 0 {
+		config.KeyFD = e.Settings.ConfigKeyFD
+	}
+	log.Debugf("Loading config file %s..\n", e.Settings.ConfigFile)
+	err := e.Config.LoadConfig(e.Settings.ConfigFile)
+	if err != nil {
+		return nil, err
+	}
+
+	if e.Settings.DataDir == "" {
+		e.Settings.DataDir = common.GetDefaultDataDir(runtime.GOOS)
+	}
+
+	err = common.CreateDir(e.Settings.DataDir)
+	if err != nil {
+		return nil, err
+	}
+	log.Debugf("Using data directory: %s.\n", e.Settings.DataDir)
+
+	err = e.Config.CheckLoggerConfig()
+	if err != nil {
+		log.Errorf("Failed to configure logger reason: %s", err)
+	}
+
+	err = log.SetupLogger()
+	if err != nil {
+		log.Errorf("Failed to setup logger reason: %s", err)
+	}
+
+	if e.Config.NTPClient.Level == -1 {
+		e.Settings.EnableNTPClient = false
+	}
+
+	if !e.Config.Webserver.Enabled {
+		e.Settings.EnableWebserver = false
+	}
+
+	if !e.Config.GRPC.Enabled {
+		e.Settings.EnableGRPC = false
+	}
+
+	if !e.Config.Scripting.Enabled {
+		e.Settings.EnableScriptManager = false
+	}
+
+	e.Portfolio = new(portfolio.Base)
+	e.setupSubsystems()
+	return e, nil
+}
+
+// setupSubsystems binds each subsystem to the engine instance
+func (e *Engine) setupSubsystems() {
+	e.ntpManager.engine = e
+	e.connectionManager.engine = e
+	e.commsManager.engine = e
+	e.orderManager.engine = e
+	e.eventManager.engine = e
+	e.scriptManager.engine = e
+	e.portfolioManager.engine = e
+	e.webserverManager.engine = e
+	e.grpcServer.engine = e
+	e.syncManager.engine = e
+	e.tickerUpdater.engine = e
+	e.orderbookUpdater.engine = e
+	e.websocketRoutineMgr.engine = e
+	e.configWatcher.engine = e
+}
+
+// Start starts the engine and the subsystems enabled via its settings
+func (e *Engine) Start() error {
+	e.Lock()
+	if e.running {
+		e.Unlock()
+		return ErrEngineAlreadyRunning
+	}
+	e.running = true
+	e.Uptime = time.Now()
+	e.Unlock()
+
+	if e.Settings.EnableNTPClient {
+		if err := e.ntpManager.Start(); err != nil {
+			log.Errorf("NTP manager unable to start: %s", err)
+		}
+	}
+
+	if e.Settings.EnableConnectivityMonitor {
+		if err := e.connectionManager.Start(); err != nil {
+			log.Errorf("Connection manager unable to start: %s", err)
+		}
+	}
+
+	log.Debugf("Bot '%s' started.\n", e.Config.Name)
+	log.Debugf("Bot dry run mode: %v.\n", common.IsEnabled(e.Settings.DryRun))
+
+	log.Debugf("Available Exchanges: %d. Enabled Exchanges: %d.\n",
+		len(e.Config.Exchanges),
+		e.Config.CountEnabledExchanges())
+
+	e.HTTPClient = common.NewHTTPClientWithTimeout(e.Config.GlobalHTTPTimeout)
+	log.Debugf("Global HTTP request timeout: %v.\n", e.HTTPClient.Timeout)
+
+	e.SetupExchanges()
+	if len(e.Exchanges) == 0 {
+		return e.abortStart(errors.New("no exchanges were able to be loaded"))
+	}
+
+	if e.Settings.EnableCommsRelayer {
+		if err := e.commsManager.Start(); err != nil {
+			log.Errorf("Communications manager unable to start: %s", err)
+		}
+	}
+
+	if e.Settings.EnableOrderManager {
+		if err := e.orderManager.Start(); err != nil {
+			log.Errorf("Order manager unable to start: %s", err)
+		}
+	}
+
+	if e.Settings.EnableEventManager {
+		if err := e.eventManager.Start(); err != nil {
+			log.Errorf("Event manager unable to start: %s", err)
+		}
+	}
+
+	if e.Settings.EnableScriptManager {
+		if err := e.scriptManager.Start(); err != nil {
+			log.Errorf("Script manager unable to start: %s", err)
+		}
+	}
+
+	var newFxSettings []currency.FXSettings
+	for _, d := range e.Config.Currency.ForexProviders {
+		newFxSettings = append(newFxSettings, currency.FXSettings(d))
+	}
+
+	err := currency.RunStorageUpdater(currency.BotOverrides{
+		Coinmarketcap:       e.Settings.EnableCoinmarketcapAnalysis,
+		FxCurrencyConverter: e.Settings.EnableCurrencyConverter,
+		FxCurrencyLayer:     e.Settings.EnableCurrencyLayer,
+		FxFixer:             e.Settings.EnableFixer,
+		FxOpenExchangeRates: e.Settings.EnableOpenExchangeRates,
+	},
+		&currency.MainConfiguration{
+			ForexProviders:         newFxSettings,
+			CryptocurrencyProvider: coinmarketcap.Settings(e.Config.Currency.CryptocurrencyProvider),
+			Cryptocurrencies:       e.Config.Currency.Cryptocurrencies,
+			FiatDisplayCurrency:    e.Config.Currency.FiatDisplayCurrency,
+			CurrencyDelay:          e.Config.Currency.CurrencyFileUpdateDuration,
+			FxRateDelay:            e.Config.Currency.ForeignExchangeUpdateDuration,
+		},
+		e.Settings.DataDir,
+		e.Settings.Verbose)
+	if err != nil {
+		return e.abortStart(err)
+	}
+
+	e.Portfolio.SeedPortfolio(e.Config.Portfolio)
+	e.SeedExchangeAccountInfo(e.GetAllEnabledExchangeAccountInfo().Data)
+
+	if e.Settings.EnableWebserver {
+		if err := e.webserverManager.Start(); err != nil {
+			log.Errorf("Webserver unable to start: %s", err)
+		}
+	}
+
+	if e.Settings.EnableGRPC {
+		if err := e.grpcServer.Start(); err != nil {
+			log.Errorf("gRPC server unable to start: %s", err)
+		}
+	}
+
+	if e.Settings.EnablePortfolioWatcher {
+		if err := e.portfolioManager.Start(); err != nil {
+			log.Errorf("Portfolio manager unable to start: %s", err)
+		}
+	}
+
+	if e.Settings.EnableSyncManager {
+		if err := e.syncManager.Start(); err != nil {
+			log.Errorf("Sync manager unable to start: %s", err)
+		}
+	}
+
+	if e.Settings.EnableTickerRoutine {
+		if err := e.tickerUpdater.Start(); err != nil {
+			log.Errorf("Ticker updater unable to start: %s", err)
+		}
+	}
+
+	if e.Settings.EnableOrderbookRoutine {
+		if err := e.orderbookUpdater.Start(); err != nil {
+			log.Errorf("Orderbook updater unable to start: %s", err)
+		}
+	}
+
+	if e.Settings.EnableWebsocketRoutine {
+		if err := e.websocketRoutineMgr.Start(); err != nil {
+			log.Errorf("Websocket routine manager unable to start: %s", err)
+		}
+	}
+
+	if e.Settings.EnableConfigWatcher {
+		if err := e.configWatcher.Start(); err != nil {
+			log.Errorf("Config watcher unable to start: %s", err)
+		}
+	}
+
+	return nil
+}
+
+// Stop stops all running subsystems in the reverse order they were started
+// and saves the configuration file unless the engine is in dry run mode
+func (e *Engine) Stop() error {
+	e.Lock()
+	if !e.running {
+		e.Unlock()
+		return ErrEngineNotRunning
+	}
+	e.running = false
+	e.Unlock()
+
+	log.Debugln("Engine shutting down..")
+
+	// Abandon outstanding exchange requests so subsystems waiting on them
+	// stop promptly, orders placed meanwhile are recovered by reconciling on
+	// the next start
+	exchanges := e.GetExchanges()
+	for x := range exchanges {
+		exchanges[x].CancelRequests()
+	}
+	e.stopSubsystems()
+
+	if len(e.Portfolio.Addresses) != 0 {
+		e.Config.Portfolio = *e.Portfolio
+	}
+
+	if !e.Settings.DryRun {
+		err := e.Config.SaveConfig(e.Settings.ConfigFile)
+		if err != nil {
+			log.Warn("Unable to save config.")
+			return err
+		}
+		log.Debugln("Config file saved successfully.")
+	}
+
+	return nil
+}
+
+// stopSubsystems stops all running subsystems in the reverse order they were
+// started
+func (e *Engine) stopSubsystems() {
+	subsystems := e.subsystems()
+	for i := len(subsystems) - 1; i >= 0; i-- {
+		if !subsystems[i].subsystem.IsRunning() {
+			continue
+		}
+		if err := subsystems[i].subsystem.Stop(); err != nil {
+			log.Errorf("%s unable to stop: %s", subsystems[i].name, err)
+		}
+	}
+}
+
+// abortStart stops the subsystems started by a failed Start and marks the
+// engine as not running so that it can be started again, returning the
+// error which caused the failure
+func (e *Engine) abortStart(err error) error {
+	e.stopSubsystems()
+	e.Lock()
+	e.running = false
+	e.Unlock()
+	return err
+}
+
+// IsRunning returns whether or not the engine has been started
+func (e *Engine) IsRunning() bool {
+	e.Lock()
+	defer e.Unlock()
+	return e.running
+}
@@ -0,0 +1,67 @@
+package engine
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/portfolio"
+)
+
+func TestNewFromSettings(t *testing.T) {
+	_, err := NewFromSettings(nil)
+	if err == nil {
+		t.Error("Test failed. Expected an error for nil settings")
+	}
+
+	dir, err := ioutil.TempDir("", "engine")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	settings := &Settings{ConfigFile: TestConfig, DataDir: dir}
+	a, err := NewFromSettings(settings)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := NewFromSettings(settings)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if a.Config == b.Config || a.Config == config.GetConfig() {
+		t.Error("Test failed. Expected each engine to have its own config")
+	}
+	if a.Portfolio == b.Portfolio || a.Portfolio == portfolio.GetPortfolio() {
+		t.Error("Test failed. Expected each engine to have its own portfolio")
+	}
+
+	a.Config.Name = "a"
+	if b.Config.Name == a.Config.Name {
+		t.Error("Test failed. Expected engine configs to be independent")
+	}
+}
+
+func TestStartWithoutExchanges(t *testing.T) {
+	e := &Engine{
+		Config:    new(config.Config),
+		Portfolio: new(portfolio.Base),
+	}
+	e.setupSubsystems()
+
+	for i := 0; i < 2; i++ {
+		err := e.Start()
+		if err == nil || err == ErrEngineAlreadyRunning {
+			t.Fatalf("Test failed. Expected no exchanges to be loaded, got %v", err)
+		}
+		if e.IsRunning() {
+			t.Error("Test failed. Expected the engine not to be running after a failed start")
+		}
+	}
+
+	if err := e.Stop(); err != ErrEngineNotRunning {
+		t.Errorf("Test failed. Expected %s got %v", ErrEngineNotRunning, err)
+	}
+}
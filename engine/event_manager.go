@@ -0,0 +1,253 @@
+package engine
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/events"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+	log "github.com/thrasher-corp/gocryptotrader/logger"
+)
+
+const (
+	eventManagerFile = "events.json"
+	// eventBalanceDelay is the delay between each account balance update for
+	// exchanges with balance conditions
+	eventBalanceDelay = time.Minute
+)
+
+// eventManager evaluates events as ticker, orderbook and account updates
+// arrive and persists them to the data directory
+type eventManager struct {
+	started  int32
+	manager  *events.Manager
+	shutdown chan struct{}
+	wg       sync.WaitGroup
+	engine   *Engine
+	m        sync.RWMutex
+
+	// ctx is done once the event manager stops, it abandons the exchange
+	// requests made for events
+	ctx    context.Context
+	cancel context.CancelFunc
+}
+
+// Start loads the persisted events and starts the balance update routine
+func (ev *eventManager) Start() error {
+	if !atomic.CompareAndSwapInt32(&ev.started, 0, 1) {
+		return ErrSubsystemAlreadyStarted
+	}
+
+	log.Debugln("Event manager starting...")
+	m := events.NewManager(filepath.Join(ev.engine.Settings.DataDir, eventManagerFile))
+	m.Notifier = &ev.engine.commsManager
+	m.ExchangeValidator = func(exchName string) bool {
+		exch := ev.engine.GetExchangeByName(exchName)
+		return exch != nil && exch.IsEnabled()
+	}
+	m.Executor = ev
+	m.DryRun = ev.engine.Settings.DryRun
+	m.MaxNotional = ev.engine.Settings.EventsMaxNotional
+
+	err := m.Load()
+	if err != nil {
+		atomic.StoreInt32(&ev.started, 0)
+		return err
+	}
+
+	ev.m.Lock()
+	ev.manager = m
+	ev.ctx, ev.cancel = context.WithCancel(context.Background())
+	ev.m.Unlock()
+
+	total, executed := m.GetEventCounter()
+	log.Debugf("Event manager started: %d events, %d executed.\n", total,
+		executed)
+
+	ev.shutdown = make(chan struct{})
+	ev.wg.Add(1)
+	go ev.run()
+	return nil
+}
+
+// Stop stops evaluating events, waits for the actions of triggered events and
+// persists them to disk
+func (ev *eventManager) Stop() error {
+	if !atomic.CompareAndSwapInt32(&ev.started, 1, 0) {
+		return ErrSubsystemNotStarted
+	}
+
+	close(ev.shutdown)
+	ev.m.RLock()
+	ev.cancel()
+	ev.m.RUnlock()
+	ev.wg.Wait()
+
+	ev.m.Lock()
+	m := ev.manager
+	ev.manager = nil
+	ev.m.Unlock()
+
+	// Running actions finish promptly as their requests have been abandoned
+	m.Wait()
+	err := m.Save()
+	if err != nil {
+		return err
+	}
+	log.Debugln("Event manager shutdown.")
+	return nil
+}
+
+// IsRunning returns whether or not the event manager is running
+func (ev *eventManager) IsRunning() bool {
+	return atomic.LoadInt32(&ev.started) == 1
+}
+
+// GetManager returns the underlying event store or nil if the event manager
+// is not running
+func (ev *eventManager) GetManager() *events.Manager {
+	ev.m.RLock()
+	defer ev.m.RUnlock()
+	return ev.manager
+}
+
+// context returns the context of the exchange requests made for events
+func (ev *eventManager) context() context.Context {
+	ev.m.RLock()
+	defer ev.m.RUnlock()
+	if ev.ctx == nil {
+		return context.Background()
+	}
+	return ev.ctx
+}
+
+// OnTicker passes a ticker update to the event manager if it is running
+func (ev *eventManager) OnTicker(exchangeName string, assetType asset.Item, t *ticker.Price) {
+	if m := ev.GetManager(); m != nil {
+		m.OnTicker(exchangeName, assetType, t)
+	}
+}
+
+// HasEvents returns whether the event manager is running and has armed
+// events for the exchange, pair and asset
+func (ev *eventManager) HasEvents(exchangeName string, p currency.Pair, assetType asset.Item) bool {
+	m := ev.GetManager()
+	return m != nil && m.HasEvents(exchangeName, p, assetType)
+}
+
+// OnOrderbook passes an orderbook update to the event manager if it is
+// running
+func (ev *eventManager) OnOrderbook(ob *orderbook.Base) {
+	if m := ev.GetManager(); m != nil {
+		m.OnOrderbook(ob)
+	}
+}
+
+func (ev *eventManager) run() {
+	t := time.NewTicker(eventBalanceDelay)
+	defer func() {
+		t.Stop()
+		ev.wg.Done()
+	}()
+
+	for {
+		select {
+		case <-ev.shutdown:
+			return
+		case <-t.C:
+			ev.updateBalances()
+		}
+	}
+}
+
+// updateBalances fetches account info for each exchange which has events
+// with balance conditions
+func (ev *eventManager) updateBalances() {
+	m := ev.GetManager()
+	if m == nil {
+		return
+	}
+
+	ctx := ev.context()
+	exchanges := m.GetBalanceExchanges()
+	for x := range exchanges {
+		if ctx.Err() != nil {
+			return
+		}
+
+		exch := ev.engine.GetExchangeByName(exchanges[x])
+		if exch == nil ||
+			!exch.GetAuthenticatedAPISupport(exchange.RestAuthentication) {
+			continue
+		}
+
+		info, err := ev.engine.GetAccountInfoContext(ctx, exch)
+		if err != nil {
+			log.Errorf("Event manager: unable to get %s account info: %s\n",
+				exchanges[x], err)
+			continue
+		}
+		m.OnAccountInfo(&info)
+	}
+}
+
+// SubmitOrder places an order for a triggered event through the order manager
+// and returns its internal order ID
+func (ev *eventManager) SubmitOrder(exchangeName, credentialSet string, p currency.Pair, assetType asset.Item, side exchange.OrderSide, orderType exchange.OrderType, amount, price float64, clientID string) (string, error) {
+	ord, err := ev.engine.orderManager.SubmitContext(ev.context(), &OrderSubmission{
+		Exchange:      exchangeName,
+		CredentialSet: credentialSet,
+		Pair:          p,
+		AssetType:     assetType,
+		Side:          side,
+		Type:          orderType,
+		Amount:        amount,
+		Price:         price,
+		ClientID:      clientID,
+	})
+	if err != nil && ord.Status == exchange.UnknownOrderStatus {
+		// The order may have been placed, it is left to reconciliation
+		return "", fmt.Errorf("%w: %s", events.ErrOrderOutcomeUnknown, err)
+	}
+	if err != nil {
+		return "", err
+	}
+	return ord.ID, nil
+}
+
+// CancelOrder cancels an order for a triggered event by either its internal
+// or exchange order ID
+func (ev *eventManager) CancelOrder(exchangeName, orderID string) error {
+	ord, err := ev.engine.orderManager.GetExchangeOrder(exchangeName, orderID)
+	if err != nil {
+		return err
+	}
+	return ev.engine.orderManager.CancelContext(ev.context(), ord.ID)
+}
+
+// CancelAllOrders cancels all open orders of a credential set on an exchange
+// for a triggered event
+func (ev *eventManager) CancelAllOrders(exchangeName, credentialSet string, p currency.Pair) error {
+	return ev.engine.orderManager.CancelAllContext(ev.context(), exchangeName,
+		credentialSet, p)
+}
+
+// ModifyOrder amends an order for a triggered event by either its internal or
+// exchange order ID
+func (ev *eventManager) ModifyOrder(exchangeName, orderID string, price, amount float64) error {
+	ord, err := ev.engine.orderManager.GetExchangeOrder(exchangeName, orderID)
+	if err != nil {
+		return err
+	}
+	_, err = ev.engine.orderManager.ModifyContext(ev.context(), ord.ID, price,
+		amount)
+	return err
+}
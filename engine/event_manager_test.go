@@ -0,0 +1,92 @@
+package engine
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/events"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
+)
+
+func TestEventManagerOrderExecutor(t *testing.T) {
+	e, exch, cleanup := setupOrderManagerTest(t)
+	defer cleanup()
+
+	err := e.orderManager.Start()
+	if err != nil {
+		t.Fatalf("Test failed. Unable to start order manager: %s", err)
+	}
+	defer e.orderManager.Stop()
+
+	p := currency.NewPairFromStrings("BTC", "USD")
+	id, err := e.eventManager.SubmitOrder(testOrderExchange, "", p, asset.Spot,
+		exchange.BuyOrderSide, exchange.LimitOrderType, 1, 100, "")
+	if err != nil {
+		t.Fatalf("Test failed. Unable to submit order: %s", err)
+	}
+
+	err = e.eventManager.ModifyOrder(testOrderExchange, "exch1", 110, 0)
+	if err != nil {
+		t.Fatalf("Test failed. Unable to modify order by exchange ID: %s", err)
+	}
+
+	ord, err := e.orderManager.GetOrder(id)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if ord.Price != 110 || ord.Amount != 1 {
+		t.Errorf("Test failed. Unexpected modified order %+v", ord)
+	}
+
+	err = e.eventManager.CancelOrder("asdf", id)
+	if err != ErrOrderNotFound {
+		t.Errorf("Test failed. Expected %s, got %v", ErrOrderNotFound, err)
+	}
+
+	_, err = e.eventManager.SubmitOrder(testOrderExchange, "", p, asset.Spot,
+		exchange.SellOrderSide, exchange.LimitOrderType, 1, 200, "")
+	if err != nil {
+		t.Fatalf("Test failed. Unable to submit order: %s", err)
+	}
+
+	err = e.eventManager.CancelOrder(testOrderExchange, id)
+	if err != nil {
+		t.Errorf("Test failed. Unable to cancel order: %s", err)
+	}
+
+	err = e.eventManager.CancelAllOrders(testOrderExchange, "", p)
+	if err != nil {
+		t.Errorf("Test failed. Unable to cancel all orders: %s", err)
+	}
+
+	if len(exch.cancelled) != 2 || exch.cancelled[1] != "all" {
+		t.Errorf("Test failed. Unexpected cancellations %v", exch.cancelled)
+	}
+
+	if active := e.orderManager.GetOrders(&OrderFilter{ActiveOnly: true}); len(active) != 0 {
+		t.Errorf("Test failed. Expected no active orders, got %d", len(active))
+	}
+
+	_, err = e.eventManager.SubmitOrder(testOrderExchange, "", p, asset.Spot,
+		exchange.BuyOrderSide, exchange.LimitOrderType, 1, -1, "")
+	if err == nil || errors.Is(err, events.ErrOrderOutcomeUnknown) {
+		t.Errorf("Test failed. Expected a rejected order, got %v", err)
+	}
+
+	// A request which may have reached the exchange leaves the order pending
+	exch.submitErr = fmt.Errorf("timed out: %w", request.ErrOutcomeUnknown)
+	_, err = e.eventManager.SubmitOrder(testOrderExchange, "", p, asset.Spot,
+		exchange.BuyOrderSide, exchange.LimitOrderType, 1, 100, "unknown")
+	if !errors.Is(err, events.ErrOrderOutcomeUnknown) {
+		t.Errorf("Test failed. Expected %s, got %v", events.ErrOrderOutcomeUnknown, err)
+	}
+
+	if !e.orderManager.hasOpenOrders(testOrderExchange, "") {
+		t.Error("Test failed. Expected the order to be pending")
+	}
+}
@@ -0,0 +1,543 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/anx"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/binance"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/bitfinex"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/bitflyer"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/bithumb"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/bitmex"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/bitstamp"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/bittrex"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/btcmarkets"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/btse"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/coinbasepro"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/coinbene"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/coinut"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/exmo"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/gateio"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/gemini"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/hitbtc"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/huobi"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/itbit"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kraken"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/lakebtc"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/lbank"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/localbitcoins"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/okcoin"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/okex"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/paper"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/poloniex"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/yobit"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/zb"
+	log "github.com/thrasher-corp/gocryptotrader/logger"
+)
+
+// vars related to exchange functions
+var (
+	ErrNoExchangesLoaded     = errors.New("no exchanges have been loaded")
+	ErrExchangeNotFound      = errors.New("exchange not found")
+	ErrExchangeAlreadyLoaded = errors.New("exchange already loaded")
+	ErrExchangeFailedToLoad  = errors.New("exchange failed to load")
+	ErrCredentialSetNotFound = errors.New("credential set not found")
+
+	errExchangeNotEnabled = errors.New("exchange is not enabled")
+)
+
+// GetExchanges returns a copy of the loaded exchanges which is safe to range
+// over while exchanges are being loaded or unloaded
+func (e *Engine) GetExchanges() []exchange.IBotExchange {
+	e.exchangesMtx.RLock()
+	defer e.exchangesMtx.RUnlock()
+	exchanges := make([]exchange.IBotExchange, len(e.Exchanges))
+	copy(exchanges, e.Exchanges)
+	return exchanges
+}
+
+// CheckExchangeExists returns true whether or not an exchange has already
+// been loaded
+func (e *Engine) CheckExchangeExists(exchName string) bool {
+	return e.GetExchangeByName(exchName) != nil
+}
+
+// GetExchangeByName returns an exchange given an exchange name
+func (e *Engine) GetExchangeByName(exchName string) exchange.IBotExchange {
+	e.exchangesMtx.RLock()
+	defer e.exchangesMtx.RUnlock()
+	for x := range e.Exchanges {
+		if strings.EqualFold(e.Exchanges[x].GetName(), exchName) {
+			return e.Exchanges[x]
+		}
+	}
+	return nil
+}
+
+// credentialSet is an exchange instance set up with a named credential set
+type credentialSet struct {
+	name string
+	exch exchange.IBotExchange
+}
+
+// isDefaultCredentialSet returns whether the credential set name refers to
+// the exchanges top level credentials
+func isDefaultCredentialSet(name string) bool {
+	return name == "" || strings.EqualFold(name, config.DefaultCredentialSet)
+}
+
+// GetExchangeByCredentialSet returns the exchange instance set up with the
+// named credential set. An empty name or config.DefaultCredentialSet returns
+// the exchange loaded by name
+func (e *Engine) GetExchangeByCredentialSet(exchName, set string) (exchange.IBotExchange, error) {
+	exch := e.GetExchangeByName(exchName)
+	if exch == nil {
+		return nil, ErrExchangeNotFound
+	}
+
+	if isDefaultCredentialSet(set) {
+		return exch, nil
+	}
+
+	for _, s := range e.getCredentialSets(exchName) {
+		if strings.EqualFold(s.name, set) {
+			return s.exch, nil
+		}
+	}
+	return nil, ErrCredentialSetNotFound
+}
+
+// GetCredentialSets returns the names of the credential sets loaded for an
+// exchange, starting with the default credential set
+func (e *Engine) GetCredentialSets(exchName string) []string {
+	names := []string{config.DefaultCredentialSet}
+	for _, s := range e.getCredentialSets(exchName) {
+		names = append(names, s.name)
+	}
+	return names
+}
+
+func (e *Engine) getCredentialSets(exchName string) []credentialSet {
+	e.exchangesMtx.RLock()
+	defer e.exchangesMtx.RUnlock()
+	return e.credentialSets[strings.ToLower(exchName)]
+}
+
+// GetAccountInfoContext returns the account balances of an exchange across
+// its default and named credential sets, each account being tagged with the
+// credential set it belongs to. An error retrieving any credential set fails
+// the whole request so balances are never partially reported
+func (e *Engine) GetAccountInfoContext(ctx context.Context, exch exchange.IBotExchange) (exchange.AccountInfo, error) {
+	info, err := exchange.GetAccountInfoContext(ctx, exch)
+	if err != nil {
+		return exchange.AccountInfo{}, err
+	}
+	for x := range info.Accounts {
+		info.Accounts[x].CredentialSet = config.DefaultCredentialSet
+	}
+
+	for _, s := range e.getCredentialSets(exch.GetName()) {
+		setInfo, err := exchange.GetAccountInfoContext(ctx, s.exch)
+		if err != nil {
+			return exchange.AccountInfo{}, fmt.Errorf("%s credential set %s: %s",
+				exch.GetName(), s.name, err)
+		}
+
+		for x := range setInfo.Accounts {
+			setInfo.Accounts[x].CredentialSet = s.name
+		}
+		info.Accounts = append(info.Accounts, setInfo.Accounts...)
+	}
+	return info, nil
+}
+
+// getEnabledExchange returns a loaded and enabled exchange by name
+func (e *Engine) getEnabledExchange(exchName string) (exchange.IBotExchange, error) {
+	exch := e.GetExchangeByName(exchName)
+	if exch == nil {
+		return nil, ErrExchangeNotFound
+	}
+
+	if !exch.IsEnabled() {
+		return nil, errExchangeNotEnabled
+	}
+	return exch, nil
+}
+
+// getEnabledCredentialSet returns the instance of a loaded and enabled
+// exchange set up with the named credential set
+func (e *Engine) getEnabledCredentialSet(exchName, set string) (exchange.IBotExchange, error) {
+	exch, err := e.getEnabledExchange(exchName)
+	if err != nil {
+		return nil, err
+	}
+	return e.GetExchangeByCredentialSet(exch.GetName(), set)
+}
+
+// parseAssetType returns the asset type named by a request, defaulting to the
+// exchanges primary asset type when the name is empty. Asset types the
+// exchange doesn't support return asset.ErrNotSupported
+func parseAssetType(exch exchange.IBotExchange, name string) (asset.Item, error) {
+	assetTypes := exch.GetAssetTypes()
+	if name == "" {
+		if len(assetTypes) == 0 {
+			return asset.Spot, nil
+		}
+		return assetTypes[0], nil
+	}
+
+	a, err := asset.New(name)
+	if err != nil {
+		return "", err
+	}
+
+	if !assetTypes.Contains(a) {
+		return "", asset.ErrNotSupported
+	}
+	return a, nil
+}
+
+// getAssetType returns the asset type of a loaded exchange by name, see
+// parseAssetType
+func (e *Engine) getAssetType(exchName, name string) (asset.Item, error) {
+	exch := e.GetExchangeByName(exchName)
+	if exch == nil {
+		return "", ErrExchangeNotFound
+	}
+	return parseAssetType(exch, name)
+}
+
+// getExchangeAssetTypes returns the asset types of an exchange from the
+// config
+func (e *Engine) getExchangeAssetTypes(exchName string) (asset.Items, error) {
+	exchCfg, err := e.Config.GetExchangeConfig(exchName)
+	if err != nil {
+		return nil, err
+	}
+	return exchCfg.AssetTypes, nil
+}
+
+// ReloadExchange loads an exchange config by name
+func (e *Engine) ReloadExchange(name string) error {
+	if len(e.GetExchanges()) == 0 {
+		return ErrNoExchangesLoaded
+	}
+
+	exch := e.GetExchangeByName(name)
+	if exch == nil {
+		return ErrExchangeNotFound
+	}
+
+	exchCfg, err := e.Config.GetExchangeConfig(name)
+	if err != nil {
+		return err
+	}
+
+	// The websocket is only set up once unless it is reinitialised
+	if ws, err := exch.GetWebsocket(); err == nil {
+		ws.Reinitialise()
+	}
+
+	exch.Setup(&exchCfg)
+	e.unloadCredentialSets(name)
+	e.loadCredentialSets(&exchCfg)
+	log.Debugf("%s exchange reloaded successfully.\n", name)
+	return nil
+}
+
+// UnloadExchange unloads an exchange by name
+func (e *Engine) UnloadExchange(name string) error {
+	if len(e.GetExchanges()) == 0 {
+		return ErrNoExchangesLoaded
+	}
+
+	if !e.CheckExchangeExists(name) {
+		return ErrExchangeNotFound
+	}
+
+	exchCfg, err := e.Config.GetExchangeConfig(name)
+	if err != nil {
+		return err
+	}
+
+	exchCfg.Enabled = false
+	err = e.Config.UpdateExchangeConfig(&exchCfg)
+	if err != nil {
+		return err
+	}
+
+	e.unloadCredentialSets(name)
+	e.exchangesMtx.Lock()
+	defer e.exchangesMtx.Unlock()
+	for x := range e.Exchanges {
+		if strings.EqualFold(e.Exchanges[x].GetName(), name) {
+			e.Exchanges[x].SetEnabled(false)
+			e.Exchanges = append(e.Exchanges[:x], e.Exchanges[x+1:]...)
+			return nil
+		}
+	}
+
+	return ErrExchangeNotFound
+}
+
+// LoadExchange loads an exchange by name
+func (e *Engine) LoadExchange(name string, useWG bool, wg *sync.WaitGroup) error {
+	if e.CheckExchangeExists(name) {
+		return ErrExchangeAlreadyLoaded
+	}
+
+	exch, err := NewExchangeByName(name)
+	if err != nil {
+		return err
+	}
+
+	exch.SetDefaults()
+	exch.SetConfig(e.Config)
+	exchCfg, err := e.Config.GetExchangeConfig(name)
+	if err != nil {
+		return err
+	}
+
+	if exchCfg.PaperTrading != nil && exchCfg.PaperTrading.Enabled {
+		log.Debugf("%s: Paper trading enabled.\n", exchCfg.Name)
+		exch = paper.New(exch, exchCfg.PaperTrading.Balances)
+	}
+
+	e.exchangesMtx.Lock()
+	e.Exchanges = append(e.Exchanges, exch)
+	e.exchangesMtx.Unlock()
+
+	exchCfg.Enabled = true
+	exch.Setup(&exchCfg)
+
+	if useWG {
+		exch.Start(wg)
+	} else {
+		wg := sync.WaitGroup{}
+		exch.Start(&wg)
+		wg.Wait()
+	}
+
+	e.loadCredentialSets(&exchCfg)
+	return nil
+}
+
+// loadCredentialSets loads a separate instance of an exchange for each of
+// its named credential sets. Instances have their own requester, so rate
+// limits and nonces are tracked per credential set, but never connect to the
+// websocket as market data is shared with the default instance. Instances
+// are not started, as the default instance updates the tradable pairs, and
+// are set up against a copy of the exchange config so they never write to
+// the bot config
+func (e *Engine) loadCredentialSets(exchCfg *config.ExchangeConfig) {
+	if len(exchCfg.CredentialSets) == 0 {
+		return
+	}
+
+	var sets []credentialSet
+	for x := range exchCfg.CredentialSets {
+		name := exchCfg.CredentialSets[x].Name
+		setCfg, err := exchCfg.WithCredentialSet(name)
+		if err != nil {
+			log.Errorf("%s: unable to load credential set %s: %s",
+				exchCfg.Name, name, err)
+			continue
+		}
+
+		exch, err := NewExchangeByName(exchCfg.Name)
+		if err != nil {
+			log.Errorf("%s: unable to load credential set %s: %s",
+				exchCfg.Name, name, err)
+			continue
+		}
+
+		setCfg.Enabled = true
+		setCfg.Websocket = false
+		exch.SetDefaults()
+		exch.SetConfig(&config.Config{
+			Exchanges: []config.ExchangeConfig{setCfg},
+		})
+		if setCfg.PaperTrading != nil && setCfg.PaperTrading.Enabled {
+			exch = paper.New(exch, setCfg.PaperTrading.Balances)
+		}
+
+		exch.Setup(&setCfg)
+
+		sets = append(sets, credentialSet{name: name, exch: exch})
+		log.Debugf("%s: Credential set %s loaded.\n", exchCfg.Name, name)
+	}
+
+	e.exchangesMtx.Lock()
+	defer e.exchangesMtx.Unlock()
+	if e.credentialSets == nil {
+		e.credentialSets = make(map[string][]credentialSet)
+	}
+	e.credentialSets[strings.ToLower(exchCfg.Name)] = sets
+}
+
+// unloadCredentialSets disables and removes the credential set instances of
+// an exchange
+func (e *Engine) unloadCredentialSets(exchName string) {
+	e.exchangesMtx.Lock()
+	defer e.exchangesMtx.Unlock()
+	key := strings.ToLower(exchName)
+	for _, s := range e.credentialSets[key] {
+		s.exch.SetEnabled(false)
+	}
+	delete(e.credentialSets, key)
+}
+
+// SupportedExchanges are the exchange names NewExchangeByName accepts
+var SupportedExchanges = []string{
+	"anx",
+	"binance",
+	"bitfinex",
+	"bitflyer",
+	"bithumb",
+	"bitmex",
+	"bitstamp",
+	"bittrex",
+	"btc markets",
+	"btse",
+	"coinbene",
+	"coinut",
+	"exmo",
+	"coinbasepro",
+	"gateio",
+	"gemini",
+	"hitbtc",
+	"huobi",
+	"itbit",
+	"kraken",
+	"lakebtc",
+	"lbank",
+	"localbitcoins",
+	"okcoin international",
+	"okex",
+	"poloniex",
+	"yobit",
+	"zb",
+}
+
+// NewExchangeByName returns a new instance of the named exchange wrapper, the
+// caller is responsible for setting it up
+func NewExchangeByName(name string) (exchange.IBotExchange, error) {
+	var exch exchange.IBotExchange
+
+	switch common.StringToLower(name) {
+	case "anx":
+		exch = new(anx.ANX)
+	case "binance":
+		exch = new(binance.Binance)
+	case "bitfinex":
+		exch = new(bitfinex.Bitfinex)
+	case "bitflyer":
+		exch = new(bitflyer.Bitflyer)
+	case "bithumb":
+		exch = new(bithumb.Bithumb)
+	case "bitmex":
+		exch = new(bitmex.Bitmex)
+	case "bitstamp":
+		exch = new(bitstamp.Bitstamp)
+	case "bittrex":
+		exch = new(bittrex.Bittrex)
+	case "btc markets":
+		exch = new(btcmarkets.BTCMarkets)
+	case "btse":
+		exch = new(btse.BTSE)
+	case "coinbene":
+		exch = new(coinbene.Coinbene)
+	case "coinut":
+		exch = new(coinut.COINUT)
+	case "exmo":
+		exch = new(exmo.EXMO)
+	case "coinbasepro":
+		exch = new(coinbasepro.CoinbasePro)
+	case "gateio":
+		exch = new(gateio.Gateio)
+	case "gemini":
+		exch = new(gemini.Gemini)
+	case "hitbtc":
+		exch = new(hitbtc.HitBTC)
+	case "huobi":
+		exch = new(huobi.HUOBI)
+	case "itbit":
+		exch = new(itbit.ItBit)
+	case "kraken":
+		exch = new(kraken.Kraken)
+	case "lakebtc":
+		exch = new(lakebtc.LakeBTC)
+	case "lbank":
+		exch = new(lbank.Lbank)
+	case "localbitcoins":
+		exch = new(localbitcoins.LocalBitcoins)
+	case "okcoin international":
+		exch = new(okcoin.OKCoin)
+	case "okex":
+		exch = new(okex.OKEX)
+	case "poloniex":
+		exch = new(poloniex.Poloniex)
+	case "yobit":
+		exch = new(yobit.Yobit)
+	case "zb":
+		exch = new(zb.ZB)
+	default:
+		return nil, ErrExchangeNotFound
+	}
+
+	if exch == nil {
+		return nil, ErrExchangeFailedToLoad
+	}
+	return exch, nil
+}
+
+// SetupExchanges sets up the exchanges used by the bot
+func (e *Engine) SetupExchanges() {
+	var wg sync.WaitGroup
+	for x := range e.Config.Exchanges {
+		exch := &e.Config.Exchanges[x]
+		if e.CheckExchangeExists(exch.Name) {
+			loaded := e.GetExchangeByName(exch.Name)
+			if loaded == nil {
+				log.Errorf("%s", ErrExchangeNotFound)
+				continue
+			}
+
+			err := e.ReloadExchange(exch.Name)
+			if err != nil {
+				log.Errorf("ReloadExchange %s failed: %s", exch.Name, err)
+				continue
+			}
+
+			if !loaded.IsEnabled() {
+				e.UnloadExchange(exch.Name)
+				continue
+			}
+			return
+		}
+		if !exch.Enabled {
+			log.Debugf("%s: Exchange support: Disabled", exch.Name)
+			continue
+		}
+		err := e.LoadExchange(exch.Name, true, &wg)
+		if err != nil {
+			log.Errorf("LoadExchange %s failed: %s", exch.Name, err)
+			continue
+		}
+		log.Debugf(
+			"%s: Exchange support: Enabled (Authenticated API support: %s - Verbose mode: %s).\n",
+			exch.Name,
+			common.IsEnabled(exch.AuthenticatedAPISupport),
+			common.IsEnabled(exch.Verbose),
+		)
+	}
+	wg.Wait()
+}
@@ -0,0 +1,275 @@
+package engine
+
+import (
+	"context"
+	"testing"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/portfolio"
+)
+
+var (
+	testSetup = false
+	testBot   *Engine
+)
+
+func SetupTest(t *testing.T) {
+	if !testSetup {
+		testBot = &Engine{
+			Config:    new(config.Config),
+			Portfolio: new(portfolio.Base),
+		}
+		testBot.setupSubsystems()
+		err := testBot.Config.LoadConfig(TestConfig)
+		if err != nil {
+			t.Fatalf("Test failed. SetupTest: Failed to load config: %s", err)
+		}
+		testSetup = true
+	}
+
+	if testBot.CheckExchangeExists("Bitfinex") {
+		return
+	}
+	err := testBot.LoadExchange("Bitfinex", false, nil)
+	if err != nil {
+		t.Errorf("Test failed. SetupTest: Failed to load exchange: %s", err)
+	}
+}
+
+func CleanupTest(t *testing.T) {
+	if !testBot.CheckExchangeExists("Bitfinex") {
+		return
+	}
+
+	err := testBot.UnloadExchange("Bitfinex")
+	if err != nil {
+		t.Fatalf("Test failed. CleanupTest: Failed to unload exchange: %s",
+			err)
+	}
+}
+
+func TestCheckExchangeExists(t *testing.T) {
+	SetupTest(t)
+
+	if !testBot.CheckExchangeExists("Bitfinex") {
+		t.Errorf("Test failed. TestGetExchangeExists: Unable to find exchange")
+	}
+
+	if testBot.CheckExchangeExists("Asdsad") {
+		t.Errorf("Test failed. TestGetExchangeExists: Non-existent exchange found")
+	}
+
+	CleanupTest(t)
+}
+
+func TestGetExchangeByName(t *testing.T) {
+	SetupTest(t)
+
+	exch := testBot.GetExchangeByName("Bitfinex")
+	if exch == nil {
+		t.Errorf("Test failed. TestGetExchangeByName: Failed to get exchange")
+	}
+
+	if !exch.IsEnabled() {
+		t.Errorf("Test failed. TestGetExchangeByName: Unexpected result")
+	}
+
+	exch.SetEnabled(false)
+	bfx := testBot.GetExchangeByName("Bitfinex")
+	if bfx.IsEnabled() {
+		t.Errorf("Test failed. TestGetExchangeByName: Unexpected result")
+	}
+
+	if exch.GetName() != "Bitfinex" {
+		t.Errorf("Test failed. TestGetExchangeByName: Unexpected result")
+	}
+
+	exch = testBot.GetExchangeByName("Asdasd")
+	if exch != nil {
+		t.Errorf("Test failed. TestGetExchangeByName: Non-existent exchange found")
+	}
+
+	CleanupTest(t)
+}
+
+func TestReloadExchange(t *testing.T) {
+	SetupTest(t)
+
+	err := testBot.ReloadExchange("asdf")
+	if err != ErrExchangeNotFound {
+		t.Errorf("Test failed. TestReloadExchange: Incorrect result: %s",
+			err)
+	}
+
+	err = testBot.ReloadExchange("Bitfinex")
+	if err != nil {
+		t.Errorf("Test failed. TestReloadExchange: Incorrect result: %s",
+			err)
+	}
+
+	CleanupTest(t)
+
+	err = testBot.ReloadExchange("asdf")
+	if err != ErrNoExchangesLoaded {
+		t.Errorf("Test failed. TestReloadExchange: Incorrect result: %s",
+			err)
+	}
+}
+
+func TestUnloadExchange(t *testing.T) {
+	SetupTest(t)
+
+	err := testBot.UnloadExchange("asdf")
+	if err != ErrExchangeNotFound {
+		t.Errorf("Test failed. TestUnloadExchange: Incorrect result: %s",
+			err)
+	}
+
+	err = testBot.UnloadExchange("Bitfinex")
+	if err != nil {
+		t.Errorf("Test failed. TestUnloadExchange: Failed to get exchange. %s",
+			err)
+	}
+
+	err = testBot.UnloadExchange("asdf")
+	if err != ErrNoExchangesLoaded {
+		t.Errorf("Test failed. TestUnloadExchange: Incorrect result: %s",
+			err)
+	}
+
+	CleanupTest(t)
+}
+
+func TestNewExchangeByName(t *testing.T) {
+	exch, err := NewExchangeByName("BTC Markets")
+	if err != nil {
+		t.Fatalf("Test failed. TestNewExchangeByName: %s", err)
+	}
+
+	exch.SetDefaults()
+	if exch.GetName() != "BTC Markets" {
+		t.Errorf("Test failed. TestNewExchangeByName: Unexpected exchange %s",
+			exch.GetName())
+	}
+
+	_, err = NewExchangeByName("asdf")
+	if err != ErrExchangeNotFound {
+		t.Errorf("Test failed. TestNewExchangeByName: Incorrect result: %s",
+			err)
+	}
+}
+
+func TestSupportedExchanges(t *testing.T) {
+	for x := range SupportedExchanges {
+		exch, err := NewExchangeByName(SupportedExchanges[x])
+		if err != nil {
+			t.Errorf("Test failed. TestSupportedExchanges: %s %s",
+				SupportedExchanges[x], err)
+			continue
+		}
+
+		exch.SetDefaults()
+		if common.StringToLower(exch.GetName()) != SupportedExchanges[x] {
+			t.Errorf("Test failed. TestSupportedExchanges: Expected %s got %s",
+				SupportedExchanges[x], exch.GetName())
+		}
+	}
+}
+
+func TestSetupExchanges(t *testing.T) {
+	SetupTest(t)
+	testBot.SetupExchanges()
+	CleanupTest(t)
+}
+
+func TestGetExchangeByCredentialSet(t *testing.T) {
+	e, exch, cleanup := setupOrderManagerTest(t)
+	defer cleanup()
+	desk := addTestCredentialSet(e, "desk1")
+
+	if got, err := e.GetExchangeByCredentialSet(testOrderExchange, ""); err != nil || got != exch {
+		t.Errorf("Test failed. Expected the default exchange instance, got %v", err)
+	}
+
+	if got, err := e.GetExchangeByCredentialSet(testOrderExchange, "Desk1"); err != nil || got != desk {
+		t.Errorf("Test failed. Expected the desk1 exchange instance, got %v", err)
+	}
+
+	if _, err := e.GetExchangeByCredentialSet(testOrderExchange, "desk2"); err != ErrCredentialSetNotFound {
+		t.Errorf("Test failed. Expected %s, got %v", ErrCredentialSetNotFound, err)
+	}
+
+	if _, err := e.GetExchangeByCredentialSet("blah", ""); err != ErrExchangeNotFound {
+		t.Errorf("Test failed. Expected %s, got %v", ErrExchangeNotFound, err)
+	}
+
+	sets := e.GetCredentialSets(testOrderExchange)
+	if len(sets) != 2 || sets[0] != config.DefaultCredentialSet || sets[1] != "desk1" {
+		t.Errorf("Test failed. Unexpected credential sets %v", sets)
+	}
+
+	e.unloadCredentialSets(testOrderExchange)
+	if sets := e.GetCredentialSets(testOrderExchange); len(sets) != 1 {
+		t.Errorf("Test failed. Unexpected credential sets after unloading %v", sets)
+	}
+}
+
+func TestLoadCredentialSets(t *testing.T) {
+	e := &Engine{Config: new(config.Config)}
+	err := e.Config.LoadConfig(TestConfig)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	exchCfg, err := e.Config.GetExchangeConfig("Bitfinex")
+	if err != nil {
+		t.Fatal(err)
+	}
+	exchCfg.CredentialSets = []config.CredentialSetConfig{
+		{Name: "desk1", APIKey: "key", APISecret: "secret"},
+	}
+
+	e.loadCredentialSets(&exchCfg)
+	sets := e.getCredentialSets("Bitfinex")
+	if len(sets) != 1 || sets[0].name != "desk1" || !sets[0].exch.IsEnabled() {
+		t.Fatalf("Test failed. Unexpected credential sets %+v", sets)
+	}
+
+	err = sets[0].exch.SetCurrencies(currency.Pairs{currency.NewPair(currency.XRP,
+		currency.USD)}, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cfg, err := e.Config.GetExchangeConfig("Bitfinex")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg.EnabledPairs.Contains(currency.NewPair(currency.XRP, currency.USD), true) {
+		t.Error("Test failed. Expected the credential set not to write to the bot config")
+	}
+}
+
+func TestGetAccountInfoContext(t *testing.T) {
+	e, exch, cleanup := setupOrderManagerTest(t)
+	defer cleanup()
+	desk := addTestCredentialSet(e, "desk1")
+
+	exch.accounts = []exchange.Account{{ID: "exchange"}}
+	desk.accounts = []exchange.Account{{ID: "exchange"}, {ID: "margin"}}
+
+	info, err := e.GetAccountInfoContext(context.Background(), exch)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(info.Accounts) != 3 ||
+		info.Accounts[0].CredentialSet != config.DefaultCredentialSet ||
+		info.Accounts[1].CredentialSet != "desk1" ||
+		info.Accounts[2].ID != "margin" {
+		t.Errorf("Test failed. Unexpected aggregated accounts %+v", info.Accounts)
+	}
+}
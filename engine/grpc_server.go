@@ -0,0 +1,297 @@
+package engine
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/subtle"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/gctrpc"
+	log "github.com/thrasher-corp/gocryptotrader/logger"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// gRPC TLS certificate locations and validity
+const (
+	grpcTLSDir      = "tls"
+	grpcTLSCertFile = "cert.pem"
+	grpcTLSKeyFile  = "key.pem"
+	grpcTLSValidity = time.Hour * 24 * 365
+)
+
+var errGRPCUnauthenticated = errors.New("invalid or missing credentials")
+
+// grpcServer runs the authenticated gRPC server over TLS
+type grpcServer struct {
+	started int32
+	server  *grpc.Server
+	engine  *Engine
+	m       sync.Mutex
+}
+
+// Start loads or generates the TLS certificate and starts serving gRPC
+// requests
+func (g *grpcServer) Start() error {
+	if !atomic.CompareAndSwapInt32(&g.started, 0, 1) {
+		return ErrSubsystemAlreadyStarted
+	}
+
+	server, lis, err := g.setup()
+	if err != nil {
+		atomic.StoreInt32(&g.started, 0)
+		return err
+	}
+
+	g.m.Lock()
+	g.server = server
+	g.m.Unlock()
+
+	go func() {
+		err := server.Serve(lis)
+		if err != nil {
+			log.Errorf("gRPC server failure: %s", err)
+		}
+	}()
+
+	log.Debugf("gRPC server started. Listen address: %s\n",
+		g.engine.Config.GRPC.ListenAddress)
+	return nil
+}
+
+// Stop gracefully stops the gRPC server once in-flight requests complete
+func (g *grpcServer) Stop() error {
+	if !atomic.CompareAndSwapInt32(&g.started, 1, 0) {
+		return ErrSubsystemNotStarted
+	}
+
+	g.m.Lock()
+	defer g.m.Unlock()
+	g.server.GracefulStop()
+	g.server = nil
+	log.Debugln("gRPC server shutdown.")
+	return nil
+}
+
+// IsRunning returns whether or not the gRPC server is running
+func (g *grpcServer) IsRunning() bool {
+	return atomic.LoadInt32(&g.started) == 1
+}
+
+func (g *grpcServer) setup() (*grpc.Server, net.Listener, error) {
+	tlsDir := filepath.Join(g.engine.Settings.DataDir, grpcTLSDir)
+	err := checkCerts(tlsDir)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	cert, err := tls.LoadX509KeyPair(filepath.Join(tlsDir, grpcTLSCertFile),
+		filepath.Join(tlsDir, grpcTLSKeyFile))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tlsConfig := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS12,
+	}
+
+	if caFile := g.engine.Config.GRPC.ClientCAFile; caFile != "" {
+		data, err := ioutil.ReadFile(caFile)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(data) {
+			return nil, nil, errors.New("unable to parse gRPC client CA file")
+		}
+		tlsConfig.ClientCAs = pool
+		tlsConfig.ClientAuth = tls.VerifyClientCertIfGiven
+	}
+
+	lis, err := net.Listen("tcp", g.engine.Config.GRPC.ListenAddress)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	server := grpc.NewServer(grpc.Creds(credentials.NewTLS(tlsConfig)),
+		grpc.UnaryInterceptor(g.authenticate))
+	gctrpc.RegisterGoCryptoTraderServer(server, &rpcServer{engine: g.engine})
+	return server, lis, nil
+}
+
+// authenticate allows requests from clients which presented a certificate
+// signed by the client CA or which carry HTTP basic auth credentials matching
+// the webserver admin credentials
+func (g *grpcServer) authenticate(ctx context.Context, req interface{}, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if hasVerifiedClientCert(ctx) {
+		return handler(ctx, req)
+	}
+
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok || len(md["authorization"]) == 0 {
+		return nil, status.Error(codes.Unauthenticated, errGRPCUnauthenticated.Error())
+	}
+
+	username, password, ok := parseBasicAuth(md["authorization"][0])
+	webserver := g.engine.Config.GetWebserverConfig()
+	if !ok ||
+		subtle.ConstantTimeCompare([]byte(username), []byte(webserver.AdminUsername)) != 1 ||
+		subtle.ConstantTimeCompare([]byte(password), []byte(webserver.AdminPassword)) != 1 {
+		return nil, status.Error(codes.Unauthenticated, errGRPCUnauthenticated.Error())
+	}
+	return handler(ctx, req)
+}
+
+// hasVerifiedClientCert returns whether or not the client presented a
+// certificate which was verified against the client CA
+func hasVerifiedClientCert(ctx context.Context) bool {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return false
+	}
+
+	info, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok {
+		return false
+	}
+	return len(info.State.VerifiedChains) > 0
+}
+
+// parseBasicAuth parses an HTTP basic auth header value
+func parseBasicAuth(auth string) (username, password string, ok bool) {
+	const prefix = "Basic "
+	if !strings.HasPrefix(auth, prefix) {
+		return "", "", false
+	}
+
+	decoded, err := base64.StdEncoding.DecodeString(auth[len(prefix):])
+	if err != nil {
+		return "", "", false
+	}
+
+	s := string(decoded)
+	i := strings.IndexByte(s, ':')
+	if i < 0 {
+		return "", "", false
+	}
+	return s[:i], s[i+1:], true
+}
+
+// checkCerts generates a self signed certificate and key in the TLS directory
+// if they do not exist or the certificate has expired
+func checkCerts(tlsDir string) error {
+	certFile := filepath.Join(tlsDir, grpcTLSCertFile)
+	keyFile := filepath.Join(tlsDir, grpcTLSKeyFile)
+
+	_, certErr := os.Stat(certFile)
+	_, keyErr := os.Stat(keyFile)
+	if os.IsNotExist(certErr) || os.IsNotExist(keyErr) {
+		log.Warnf("gRPC TLS certificate or key not found, generating a self signed certificate in %s\n",
+			tlsDir)
+		return genCert(tlsDir)
+	}
+
+	data, err := ioutil.ReadFile(certFile)
+	if err != nil {
+		return err
+	}
+
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return errors.New("unable to decode gRPC TLS certificate")
+	}
+
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return err
+	}
+
+	if time.Now().After(cert.NotAfter) {
+		log.Warn("gRPC TLS certificate has expired, regenerating")
+		return genCert(tlsDir)
+	}
+	return nil
+}
+
+// genCert generates a self signed certificate and key valid for localhost
+func genCert(tlsDir string) error {
+	err := common.CreateDir(tlsDir)
+	if err != nil {
+		return err
+	}
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return err
+	}
+
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return err
+	}
+
+	host, err := os.Hostname()
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	template := x509.Certificate{
+		SerialNumber: serial,
+		Subject: pkix.Name{
+			Organization: []string{"gocryptotrader"},
+			CommonName:   host,
+		},
+		NotBefore:             now,
+		NotAfter:              now.Add(grpcTLSValidity),
+		KeyUsage:              x509.KeyUsageKeyEncipherment | x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+		DNSNames:              []string{"localhost", host},
+		IPAddresses:           []net.IP{net.ParseIP("127.0.0.1"), net.ParseIP("::1")},
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, &template, &template,
+		&key.PublicKey, key)
+	if err != nil {
+		return err
+	}
+
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		return err
+	}
+
+	certData := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	keyData := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+
+	err = ioutil.WriteFile(filepath.Join(tlsDir, grpcTLSKeyFile), keyData, 0600)
+	if err != nil {
+		return err
+	}
+	return common.WriteFile(filepath.Join(tlsDir, grpcTLSCertFile), certData)
+}
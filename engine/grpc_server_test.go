@@ -0,0 +1,148 @@
+package engine
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/gctrpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestParseBasicAuth(t *testing.T) {
+	auth := "Basic " + base64.StdEncoding.EncodeToString([]byte("admin:pass:word"))
+	username, password, ok := parseBasicAuth(auth)
+	if !ok || username != "admin" || password != "pass:word" {
+		t.Errorf("Test failed. Unexpected credentials %s %s %v", username,
+			password, ok)
+	}
+
+	for _, auth := range []string{"", "Bearer abc", "Basic !!!", "Basic " +
+		base64.StdEncoding.EncodeToString([]byte("admin"))} {
+		if _, _, ok = parseBasicAuth(auth); ok {
+			t.Errorf("Test failed. Expected %q to be invalid", auth)
+		}
+	}
+}
+
+func TestCheckCerts(t *testing.T) {
+	dir, err := ioutil.TempDir("", "grpc")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	err = checkCerts(dir)
+	if err != nil {
+		t.Fatalf("Test failed. Unable to generate certificate: %s", err)
+	}
+
+	_, err = tls.LoadX509KeyPair(filepath.Join(dir, grpcTLSCertFile),
+		filepath.Join(dir, grpcTLSKeyFile))
+	if err != nil {
+		t.Errorf("Test failed. Unable to load generated certificate: %s", err)
+	}
+
+	info, err := os.Stat(filepath.Join(dir, grpcTLSCertFile))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = checkCerts(dir)
+	if err != nil {
+		t.Fatalf("Test failed. checkCerts: %s", err)
+	}
+
+	info2, err := os.Stat(filepath.Join(dir, grpcTLSCertFile))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !info.ModTime().Equal(info2.ModTime()) {
+		t.Error("Test failed. Valid certificate should not be regenerated")
+	}
+}
+
+func TestGRPCServerAuthentication(t *testing.T) {
+	dir, err := ioutil.TempDir("", "grpc")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	e := &Engine{
+		Config: &config.Config{
+			Webserver: config.WebserverConfig{
+				AdminUsername: "admin",
+				AdminPassword: "Password",
+			},
+			GRPC: config.GRPCConfig{ListenAddress: "localhost:0"},
+		},
+		Settings: Settings{DataDir: dir},
+		Uptime:   time.Now(),
+	}
+	e.setupSubsystems()
+
+	server, lis, err := e.grpcServer.setup()
+	if err != nil {
+		t.Fatalf("Test failed. Unable to setup gRPC server: %s", err)
+	}
+	go server.Serve(lis)
+	defer server.Stop()
+
+	data, err := ioutil.ReadFile(filepath.Join(dir, grpcTLSDir, grpcTLSCertFile))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	pool := x509.NewCertPool()
+	pool.AppendCertsFromPEM(data)
+	conn, err := grpc.Dial(lis.Addr().String(),
+		grpc.WithTransportCredentials(credentials.NewTLS(&tls.Config{
+			RootCAs:    pool,
+			ServerName: "localhost",
+		})))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+
+	_, err = client.GetInfo(ctx, &gctrpc.GetInfoRequest{})
+	if status.Code(err) != codes.Unauthenticated {
+		t.Errorf("Test failed. Expected unauthenticated error, got %v", err)
+	}
+
+	badCtx := metadata.AppendToOutgoingContext(ctx, "authorization",
+		"Basic "+base64.StdEncoding.EncodeToString([]byte("admin:wrong")))
+	_, err = client.GetInfo(badCtx, &gctrpc.GetInfoRequest{})
+	if status.Code(err) != codes.Unauthenticated {
+		t.Errorf("Test failed. Expected unauthenticated error, got %v", err)
+	}
+
+	authCtx := metadata.AppendToOutgoingContext(ctx, "authorization",
+		"Basic "+base64.StdEncoding.EncodeToString([]byte("admin:Password")))
+	resp, err := client.GetInfo(authCtx, &gctrpc.GetInfoRequest{})
+	if err != nil {
+		t.Fatalf("Test failed. GetInfo: %s", err)
+	}
+
+	if len(resp.SubsystemStatus) != len(e.subsystems()) {
+		t.Errorf("Test failed. Unexpected subsystem status %v",
+			resp.SubsystemStatus)
+	}
+}
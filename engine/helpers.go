@@ -1,4 +1,4 @@
-package main
+package engine
 
 import (
 	"errors"
@@ -6,6 +6,7 @@ import (
 
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/stats"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
@@ -15,15 +16,15 @@ import (
 
 // GetAllAvailablePairs returns a list of all available pairs on either enabled
 // or disabled exchanges
-func GetAllAvailablePairs(enabledExchangesOnly bool) currency.Pairs {
+func (e *Engine) GetAllAvailablePairs(enabledExchangesOnly bool) currency.Pairs {
 	var pairList currency.Pairs
-	for x := range bot.config.Exchanges {
-		if enabledExchangesOnly && !bot.config.Exchanges[x].Enabled {
+	for x := range e.Config.Exchanges {
+		if enabledExchangesOnly && !e.Config.Exchanges[x].Enabled {
 			continue
 		}
 
-		exchName := bot.config.Exchanges[x].Name
-		pairs, err := bot.config.GetAvailablePairs(exchName)
+		exchName := e.Config.Exchanges[x].Name
+		pairs, err := e.Config.GetAvailablePairs(exchName)
 		if err != nil {
 			continue
 		}
@@ -40,9 +41,9 @@ func GetAllAvailablePairs(enabledExchangesOnly bool) currency.Pairs {
 
 // GetSpecificAvailablePairs returns a list of supported pairs based on specific
 // parameters
-func GetSpecificAvailablePairs(enabledExchangesOnly, fiatPairs, includeUSDT, cryptoPairs bool) currency.Pairs {
+func (e *Engine) GetSpecificAvailablePairs(enabledExchangesOnly, fiatPairs, includeUSDT, cryptoPairs bool) currency.Pairs {
 	var pairList currency.Pairs
-	supportedPairs := GetAllAvailablePairs(enabledExchangesOnly)
+	supportedPairs := e.GetAllAvailablePairs(enabledExchangesOnly)
 
 	for x := range supportedPairs {
 		if fiatPairs {
@@ -87,15 +88,15 @@ func IsRelatablePairs(p1, p2 currency.Pair, includeUSDT bool) bool {
 
 // MapCurrenciesByExchange returns a list of currency pairs mapped to an
 // exchange
-func MapCurrenciesByExchange(p []currency.Pair, enabledExchangesOnly bool) map[string]currency.Pairs {
+func (e *Engine) MapCurrenciesByExchange(p []currency.Pair, enabledExchangesOnly bool) map[string]currency.Pairs {
 	currencyExchange := make(map[string]currency.Pairs)
 	for x := range p {
-		for y := range bot.config.Exchanges {
-			if enabledExchangesOnly && !bot.config.Exchanges[y].Enabled {
+		for y := range e.Config.Exchanges {
+			if enabledExchangesOnly && !e.Config.Exchanges[y].Enabled {
 				continue
 			}
-			exchName := bot.config.Exchanges[y].Name
-			success, err := bot.config.SupportsPair(exchName, p[x])
+			exchName := e.Config.Exchanges[y].Name
+			success, err := e.Config.SupportsPair(exchName, p[x])
 			if err != nil || !success {
 				continue
 			}
@@ -119,15 +120,15 @@ func MapCurrenciesByExchange(p []currency.Pair, enabledExchangesOnly bool) map[s
 
 // GetExchangeNamesByCurrency returns a list of exchanges supporting
 // a currency pair based on whether the exchange is enabled or not
-func GetExchangeNamesByCurrency(p currency.Pair, enabled bool) []string {
+func (e *Engine) GetExchangeNamesByCurrency(p currency.Pair, enabled bool) []string {
 	var exchanges []string
-	for x := range bot.config.Exchanges {
-		if enabled != bot.config.Exchanges[x].Enabled {
+	for x := range e.Config.Exchanges {
+		if enabled != e.Config.Exchanges[x].Enabled {
 			continue
 		}
 
-		exchName := bot.config.Exchanges[x].Name
-		success, err := bot.config.SupportsPair(exchName, p)
+		exchName := e.Config.Exchanges[x].Name
+		success, err := e.Config.SupportsPair(exchName, p)
 		if err != nil {
 			continue
 		}
@@ -236,13 +237,14 @@ func GetRelatableCurrencies(p currency.Pair, incOrig, incUSDT bool) currency.Pai
 
 // GetSpecificOrderbook returns a specific orderbook given the currency,
 // exchangeName and assetType
-func GetSpecificOrderbook(currencyPair, exchangeName, assetType string) (orderbook.Base, error) {
+func (e *Engine) GetSpecificOrderbook(currencyPair, exchangeName string, assetType asset.Item) (orderbook.Base, error) {
 	var specificOrderbook orderbook.Base
 	var err error
-	for x := range bot.exchanges {
-		if bot.exchanges[x] != nil {
-			if bot.exchanges[x].GetName() == exchangeName {
-				specificOrderbook, err = bot.exchanges[x].GetOrderbookEx(
+	exchanges := e.GetExchanges()
+	for x := range exchanges {
+		if exchanges[x] != nil {
+			if exchanges[x].GetName() == exchangeName {
+				specificOrderbook, err = exchanges[x].GetOrderbookEx(
 					currency.NewPairFromString(currencyPair),
 					assetType,
 				)
@@ -255,13 +257,14 @@ func GetSpecificOrderbook(currencyPair, exchangeName, assetType string) (orderbo
 
 // GetSpecificTicker returns a specific ticker given the currency,
 // exchangeName and assetType
-func GetSpecificTicker(currencyPair, exchangeName, assetType string) (ticker.Price, error) {
+func (e *Engine) GetSpecificTicker(currencyPair, exchangeName string, assetType asset.Item) (ticker.Price, error) {
 	var specificTicker ticker.Price
 	var err error
-	for x := range bot.exchanges {
-		if bot.exchanges[x] != nil {
-			if bot.exchanges[x].GetName() == exchangeName {
-				specificTicker, err = bot.exchanges[x].GetTickerPrice(
+	exchanges := e.GetExchanges()
+	for x := range exchanges {
+		if exchanges[x] != nil {
+			if exchanges[x].GetName() == exchangeName {
+				specificTicker, err = exchanges[x].GetTickerPrice(
 					currency.NewPairFromString(currencyPair),
 					assetType,
 				)
@@ -311,7 +314,7 @@ func GetAccountCurrencyInfoByExchangeName(accounts []exchange.AccountInfo, excha
 
 // GetExchangeHighestPriceByCurrencyPair returns the exchange with the highest
 // price for a given currency pair and asset type
-func GetExchangeHighestPriceByCurrencyPair(p currency.Pair, assetType string) (string, error) {
+func GetExchangeHighestPriceByCurrencyPair(p currency.Pair, assetType asset.Item) (string, error) {
 	result := stats.SortExchangesByPrice(p, assetType, true)
 	if len(result) == 0 {
 		return "", fmt.Errorf("no stats for supplied currency pair and asset type")
@@ -322,7 +325,7 @@ func GetExchangeHighestPriceByCurrencyPair(p currency.Pair, assetType string) (s
 
 // GetExchangeLowestPriceByCurrencyPair returns the exchange with the lowest
 // price for a given currency pair and asset type
-func GetExchangeLowestPriceByCurrencyPair(p currency.Pair, assetType string) (string, error) {
+func GetExchangeLowestPriceByCurrencyPair(p currency.Pair, assetType asset.Item) (string, error) {
 	result := stats.SortExchangesByPrice(p, assetType, false)
 	if len(result) == 0 {
 		return "", fmt.Errorf("no stats for supplied currency pair and asset type")
@@ -332,12 +335,12 @@ func GetExchangeLowestPriceByCurrencyPair(p currency.Pair, assetType string) (st
 }
 
 // SeedExchangeAccountInfo seeds account info
-func SeedExchangeAccountInfo(data []exchange.AccountInfo) {
+func (e *Engine) SeedExchangeAccountInfo(data []exchange.AccountInfo) {
 	if len(data) == 0 {
 		return
 	}
 
-	port := portfolio.GetPortfolio()
+	port := e.Portfolio
 
 	for _, exchangeData := range data {
 		exchangeName := exchangeData.Exchange
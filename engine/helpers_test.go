@@ -1,19 +1,19 @@
-package main
+package engine
 
 import (
 	"testing"
 
 	"github.com/thrasher-corp/gocryptotrader/common"
-	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/stats"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
 )
 
 const (
-	TestConfig = "./testdata/configtest.json"
+	TestConfig = "../testdata/configtest.json"
 )
 
 var (
@@ -22,15 +22,8 @@ var (
 
 func SetupTestHelpers(t *testing.T) {
 	if !helperTestLoaded {
-		if !testSetup {
-			bot.config = &config.Cfg
-			err := bot.config.LoadConfig("./testdata/configtest.json")
-			if err != nil {
-				t.Fatalf("Test failed. SetupTest: Failed to load config: %s", err)
-			}
-			testSetup = true
-		}
-		err := bot.config.RetrieveConfigCurrencyPairs(true)
+		SetupTest(t)
+		err := testBot.Config.RetrieveConfigCurrencyPairs(true)
 		if err != nil {
 			t.Fatalf("Failed to retrieve config currency pairs. %s", err)
 		}
@@ -40,7 +33,7 @@ func SetupTestHelpers(t *testing.T) {
 
 func TestGetSpecificAvailablePairs(t *testing.T) {
 	SetupTestHelpers(t)
-	result := GetSpecificAvailablePairs(true, true, true, false)
+	result := testBot.GetSpecificAvailablePairs(true, true, true, false)
 
 	if !result.Contains(currency.NewPairFromStrings("BTC", "USD"), true) {
 		t.Fatal("Unexpected result")
@@ -50,13 +43,13 @@ func TestGetSpecificAvailablePairs(t *testing.T) {
 		t.Fatal("Unexpected result")
 	}
 
-	result = GetSpecificAvailablePairs(true, true, false, false)
+	result = testBot.GetSpecificAvailablePairs(true, true, false, false)
 
 	if result.Contains(currency.NewPairFromStrings("BTC", "USDT"), false) {
 		t.Fatal("Unexpected result")
 	}
 
-	result = GetSpecificAvailablePairs(true, false, false, true)
+	result = testBot.GetSpecificAvailablePairs(true, false, false, true)
 	if !result.Contains(currency.NewPairFromStrings("LTC", "BTC"), false) {
 		t.Fatal("Unexpected result")
 	}
@@ -229,7 +222,7 @@ func TestMapCurrenciesByExchange(t *testing.T) {
 		currency.NewPair(currency.BTC, currency.EUR),
 	}
 
-	result := MapCurrenciesByExchange(pairs, true)
+	result := testBot.MapCurrenciesByExchange(pairs, true)
 	pairs, ok := result["Bitstamp"]
 	if !ok {
 		t.Fatal("Unexpected result")
@@ -243,17 +236,17 @@ func TestMapCurrenciesByExchange(t *testing.T) {
 func TestGetExchangeNamesByCurrency(t *testing.T) {
 	SetupTestHelpers(t)
 
-	result := GetExchangeNamesByCurrency(currency.NewPairFromStrings("BTC", "USD"), true)
+	result := testBot.GetExchangeNamesByCurrency(currency.NewPairFromStrings("BTC", "USD"), true)
 	if !common.StringDataCompare(result, "Bitstamp") {
 		t.Fatal("Unexpected result")
 	}
 
-	result = GetExchangeNamesByCurrency(currency.NewPairFromStrings("BTC", "JPY"), true)
+	result = testBot.GetExchangeNamesByCurrency(currency.NewPairFromStrings("BTC", "JPY"), true)
 	if !common.StringDataCompare(result, "Bitflyer") {
 		t.Fatal("Unexpected result")
 	}
 
-	result = GetExchangeNamesByCurrency(currency.NewPairFromStrings("blah", "JPY"), true)
+	result = testBot.GetExchangeNamesByCurrency(currency.NewPairFromStrings("blah", "JPY"), true)
 	if len(result) > 0 {
 		t.Fatal("Unexpected result")
 	}
@@ -262,7 +255,7 @@ func TestGetExchangeNamesByCurrency(t *testing.T) {
 func TestGetSpecificOrderbook(t *testing.T) {
 	SetupTestHelpers(t)
 
-	LoadExchange("Bitstamp", false, nil)
+	testBot.LoadExchange("Bitstamp", false, nil)
 
 	var bids []orderbook.Item
 	bids = append(bids, orderbook.Item{Price: 1000, Amount: 1})
@@ -271,7 +264,7 @@ func TestGetSpecificOrderbook(t *testing.T) {
 		Pair:         currency.NewPair(currency.BTC, currency.USD),
 		Bids:         bids,
 		ExchangeName: "Bitstamp",
-		AssetType:    orderbook.Spot,
+		AssetType:    asset.Spot,
 	}
 
 	err := base.Process()
@@ -279,7 +272,7 @@ func TestGetSpecificOrderbook(t *testing.T) {
 		t.Fatal("Unexpected result", err)
 	}
 
-	ob, err := GetSpecificOrderbook("BTCUSD", "Bitstamp", ticker.Spot)
+	ob, err := testBot.GetSpecificOrderbook("BTCUSD", "Bitstamp", asset.Spot)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -288,28 +281,28 @@ func TestGetSpecificOrderbook(t *testing.T) {
 		t.Fatal("Unexpected result")
 	}
 
-	ob, err = GetSpecificOrderbook("ETHLTC", "Bitstamp", ticker.Spot)
+	ob, err = testBot.GetSpecificOrderbook("ETHLTC", "Bitstamp", asset.Spot)
 	if err == nil {
 		t.Fatal("Unexpected result")
 	}
 
-	UnloadExchange("Bitstamp")
+	testBot.UnloadExchange("Bitstamp")
 }
 
 func TestGetSpecificTicker(t *testing.T) {
 	SetupTestHelpers(t)
 
-	LoadExchange("Bitstamp", false, nil)
+	testBot.LoadExchange("Bitstamp", false, nil)
 	p := currency.NewPairFromStrings("BTC", "USD")
 
 	err := ticker.ProcessTicker("Bitstamp",
 		&ticker.Price{Pair: p, Last: 1000},
-		ticker.Spot)
+		asset.Spot)
 	if err != nil {
 		t.Fatal("Test failed. ProcessTicker error", err)
 	}
 
-	tick, err := GetSpecificTicker("BTCUSD", "Bitstamp", ticker.Spot)
+	tick, err := testBot.GetSpecificTicker("BTCUSD", "Bitstamp", asset.Spot)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -318,12 +311,12 @@ func TestGetSpecificTicker(t *testing.T) {
 		t.Fatal("Unexpected result")
 	}
 
-	tick, err = GetSpecificTicker("ETHLTC", "Bitstamp", ticker.Spot)
+	tick, err = testBot.GetSpecificTicker("ETHLTC", "Bitstamp", asset.Spot)
 	if err == nil {
 		t.Fatal("Unexpected result")
 	}
 
-	UnloadExchange("Bitstamp")
+	testBot.UnloadExchange("Bitstamp")
 }
 
 func TestGetCollatedExchangeAccountInfoByCoin(t *testing.T) {
@@ -418,9 +411,9 @@ func TestGetExchangeHighestPriceByCurrencyPair(t *testing.T) {
 	SetupTestHelpers(t)
 
 	p := currency.NewPairFromStrings("BTC", "USD")
-	stats.Add("Bitfinex", p, ticker.Spot, 1000, 10000)
-	stats.Add("Bitstamp", p, ticker.Spot, 1337, 10000)
-	exchangeName, err := GetExchangeHighestPriceByCurrencyPair(p, ticker.Spot)
+	stats.Add("Bitfinex", p, asset.Spot, 1000, 10000)
+	stats.Add("Bitstamp", p, asset.Spot, 1337, 10000)
+	exchangeName, err := GetExchangeHighestPriceByCurrencyPair(p, asset.Spot)
 	if err != nil {
 		t.Error(err)
 	}
@@ -429,7 +422,7 @@ func TestGetExchangeHighestPriceByCurrencyPair(t *testing.T) {
 		t.Error("Unexpected result")
 	}
 
-	_, err = GetExchangeHighestPriceByCurrencyPair(currency.NewPairFromStrings("BTC", "AUD"), ticker.Spot)
+	_, err = GetExchangeHighestPriceByCurrencyPair(currency.NewPairFromStrings("BTC", "AUD"), asset.Spot)
 	if err == nil {
 		t.Error("Unexpected result")
 	}
@@ -439,9 +432,9 @@ func TestGetExchangeLowestPriceByCurrencyPair(t *testing.T) {
 	SetupTestHelpers(t)
 
 	p := currency.NewPairFromStrings("BTC", "USD")
-	stats.Add("Bitfinex", p, ticker.Spot, 1000, 10000)
-	stats.Add("Bitstamp", p, ticker.Spot, 1337, 10000)
-	exchangeName, err := GetExchangeLowestPriceByCurrencyPair(p, ticker.Spot)
+	stats.Add("Bitfinex", p, asset.Spot, 1000, 10000)
+	stats.Add("Bitstamp", p, asset.Spot, 1337, 10000)
+	exchangeName, err := GetExchangeLowestPriceByCurrencyPair(p, asset.Spot)
 	if err != nil {
 		t.Error(err)
 	}
@@ -450,7 +443,7 @@ func TestGetExchangeLowestPriceByCurrencyPair(t *testing.T) {
 		t.Error("Unexpected result")
 	}
 
-	_, err = GetExchangeLowestPriceByCurrencyPair(currency.NewPairFromStrings("BTC", "AUD"), ticker.Spot)
+	_, err = GetExchangeLowestPriceByCurrencyPair(currency.NewPairFromStrings("BTC", "AUD"), asset.Spot)
 	if err == nil {
 		t.Error("Unexpected reuslt")
 	}
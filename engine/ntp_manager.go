@@ -0,0 +1,100 @@
+package engine
+
+import (
+	"os"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	log "github.com/thrasher-corp/gocryptotrader/logger"
+	"github.com/thrasher-corp/gocryptotrader/ntpclient"
+)
+
+// ntpCheckInterval is the delay between each NTP time drift check
+const ntpCheckInterval = time.Minute * 5
+
+// ntpManager periodically checks the local clock against the configured NTP
+// pool and warns when the drift exceeds the allowed difference
+type ntpManager struct {
+	started  int32
+	shutdown chan struct{}
+	wg       sync.WaitGroup
+	engine   *Engine
+}
+
+// Start runs an initial time sync check and spawns the NTP check routine
+func (n *ntpManager) Start() error {
+	if !atomic.CompareAndSwapInt32(&n.started, 0, 1) {
+		return ErrSubsystemAlreadyStarted
+	}
+
+	log.Debugln("NTP manager starting...")
+	n.engine.Config.CheckNTPConfig()
+	n.shutdown = make(chan struct{})
+	n.checkTimeInRange(true)
+	n.wg.Add(1)
+	go n.run()
+	return nil
+}
+
+// Stop stops the NTP check routine
+func (n *ntpManager) Stop() error {
+	if !atomic.CompareAndSwapInt32(&n.started, 1, 0) {
+		return ErrSubsystemNotStarted
+	}
+
+	close(n.shutdown)
+	n.wg.Wait()
+	log.Debugln("NTP manager shutdown.")
+	return nil
+}
+
+// IsRunning returns whether or not the NTP manager is running
+func (n *ntpManager) IsRunning() bool {
+	return atomic.LoadInt32(&n.started) == 1
+}
+
+func (n *ntpManager) run() {
+	t := time.NewTicker(ntpCheckInterval)
+	defer func() {
+		t.Stop()
+		n.wg.Done()
+	}()
+
+	for {
+		select {
+		case <-n.shutdown:
+			return
+		case <-t.C:
+			n.checkTimeInRange(false)
+		}
+	}
+}
+
+// checkTimeInRange compares the local time against the NTP pool time. On the
+// initial check the user is prompted to disable future checks if the drift
+// exceeds the allowed range and the config level requests it
+func (n *ntpManager) checkTimeInRange(initialCheck bool) {
+	cfg := n.engine.Config
+	NTPTime, err := ntpclient.NTPClient(cfg.NTPClient.Pool)
+	currentTime := time.Now()
+	if err != nil {
+		log.Warnf("NTPClient failed to create: %v", err)
+		return
+	}
+
+	NTPcurrentTimeDifference := NTPTime.Sub(currentTime)
+	configNTPTime := *cfg.NTPClient.AllowedDifference
+	configNTPNegativeTime := (*cfg.NTPClient.AllowedNegativeDifference - (*cfg.NTPClient.AllowedNegativeDifference * 2))
+	if NTPcurrentTimeDifference > configNTPTime || NTPcurrentTimeDifference < configNTPNegativeTime {
+		log.Warnf("Time out of sync (NTP): %v | (time.Now()): %v | (Difference): %v | (Allowed): +%v / %v", NTPTime, currentTime, NTPcurrentTimeDifference, configNTPTime, configNTPNegativeTime)
+		if initialCheck && *cfg.Logging.Enabled && cfg.NTPClient.Level == 0 {
+			disable, err := cfg.DisableNTPCheck(os.Stdin)
+			if err != nil {
+				log.Errorf("failed to disable ntp time check reason: %v", err)
+			} else {
+				log.Info(disable)
+			}
+		}
+	}
+}
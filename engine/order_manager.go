@@ -0,0 +1,886 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
+	log "github.com/thrasher-corp/gocryptotrader/logger"
+)
+
+// Start loads the persisted orders from disk and starts reconciling them
+// against the exchanges
+func (o *orderManager) Start() error {
+	if !atomic.CompareAndSwapInt32(&o.started, 0, 1) {
+		return ErrSubsystemAlreadyStarted
+	}
+
+	log.Debugln("Order manager starting...")
+	err := o.load()
+	if err != nil {
+		atomic.StoreInt32(&o.started, 0)
+		return err
+	}
+
+	o.shutdown = make(chan struct{})
+	o.wg.Add(1)
+	go o.run()
+	return nil
+}
+
+// Stop stops the order manager and persists all known orders to disk
+func (o *orderManager) Stop() error {
+	if !atomic.CompareAndSwapInt32(&o.started, 1, 0) {
+		return ErrSubsystemNotStarted
+	}
+
+	close(o.shutdown)
+	o.wg.Wait()
+
+	err := o.save()
+	if err != nil {
+		return err
+	}
+	log.Debugln("Order manager shutdown.")
+	return nil
+}
+
+// IsRunning returns whether or not the order manager is running
+func (o *orderManager) IsRunning() bool {
+	return atomic.LoadInt32(&o.started) == 1
+}
+
+func (o *orderManager) run() {
+	log.Debugln("Order manager started.")
+	t := time.NewTicker(orderManagerSyncDelay)
+	defer func() {
+		t.Stop()
+		o.wg.Done()
+	}()
+
+	// Reconciling is abandoned on shutdown rather than holding it up
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		<-o.shutdown
+		cancel()
+	}()
+
+	// Rebuild the order store from the exchanges on startup, then only poll
+	// exchanges which have known open orders
+	o.reconcile(ctx, false)
+	for {
+		select {
+		case <-o.shutdown:
+			return
+		case <-t.C:
+			o.reconcile(ctx, true)
+		}
+	}
+}
+
+// Submit validates and submits an order through the exchange wrapper and
+// records it regardless of whether it was accepted
+func (o *orderManager) Submit(s *OrderSubmission) (Order, error) {
+	return o.SubmitContext(context.Background(), s)
+}
+
+// SubmitContext is Submit with a context. An order is not sent once the
+// context is done, an order abandoned after it has been sent may still have
+// been placed so it is recorded with an unknown status until it is matched
+// by its client ID when reconciled
+func (o *orderManager) SubmitContext(ctx context.Context, s *OrderSubmission) (Order, error) {
+	if !o.IsRunning() {
+		return Order{}, ErrSubsystemNotStarted
+	}
+
+	if s == nil {
+		return Order{}, errors.New("order submission is nil")
+	}
+
+	if s.Pair.IsEmpty() {
+		return Order{}, errors.New("order currency pair is not set")
+	}
+
+	if s.Amount <= 0 {
+		return Order{}, errors.New("order amount must be greater than zero")
+	}
+
+	if s.Side == "" || s.Type == "" {
+		return Order{}, errors.New("order side and type must be set")
+	}
+
+	exch, err := o.engine.GetExchangeByCredentialSet(s.Exchange, s.CredentialSet)
+	if err != nil {
+		return Order{}, err
+	}
+
+	if !exch.GetAuthenticatedAPISupport(exchange.RestAuthentication) {
+		return Order{}, ErrAuthenticationNotOn
+	}
+
+	credentialSet := s.CredentialSet
+	if isDefaultCredentialSet(credentialSet) {
+		credentialSet = ""
+	}
+
+	assetType, err := parseAssetType(exch, s.AssetType.String())
+	if err != nil {
+		return Order{}, err
+	}
+
+	features := exch.GetFeatures()
+	if !features.REST.SubmitOrder {
+		return Order{}, common.ErrFunctionNotSupported
+	}
+
+	if !features.SupportsOrderType(s.Type) {
+		return Order{}, ErrOrderTypeNotSupported
+	}
+
+	if err = ctx.Err(); err != nil {
+		return Order{}, err
+	}
+
+	id, err := newOrderID()
+	if err != nil {
+		return Order{}, err
+	}
+
+	now := time.Now()
+	ord := &Order{
+		ID:              id,
+		Exchange:        exch.GetName(),
+		CredentialSet:   credentialSet,
+		ClientID:        s.ClientID,
+		Pair:            s.Pair,
+		AssetType:       assetType,
+		Side:            s.Side,
+		Type:            s.Type,
+		Price:           s.Price,
+		Amount:          s.Amount,
+		RemainingAmount: s.Amount,
+		Placed:          now,
+	}
+	ord.setStatus(exchange.NewOrderStatus, "submitted", now)
+
+	resp, submitErr := exchange.SubmitOrderContext(ctx, exch, s.Pair,
+		assetType,
+		s.Side,
+		s.Type,
+		s.Amount,
+		s.Price,
+		s.ClientID)
+	switch {
+	case submitErr != nil && (ctx.Err() != nil ||
+		errors.Is(submitErr, request.ErrOutcomeUnknown)):
+		ord.setStatus(exchange.UnknownOrderStatus,
+			"submission abandoned: "+submitErr.Error(), time.Now())
+		log.Warnf("Order manager: %s order %s submission to %s abandoned, awaiting reconciliation by client ID %q\n",
+			ord.Side, ord.ID, ord.Exchange, ord.ClientID)
+	case submitErr != nil:
+		ord.setStatus(exchange.RejectedOrderStatus, submitErr.Error(), time.Now())
+	case !resp.IsOrderPlaced:
+		submitErr = ErrOrderNotPlaced
+		ord.setStatus(exchange.RejectedOrderStatus, submitErr.Error(), time.Now())
+	default:
+		ord.ExchangeOrderID = resp.OrderID
+		ord.setStatus(exchange.ActiveOrderStatus, "placed", time.Now())
+		log.Debugf("Order manager: %s order %s placed on %s with exchange ID %s\n",
+			ord.Side, ord.ID, ord.Exchange, ord.ExchangeOrderID)
+	}
+
+	o.store.add(ord)
+	o.persist()
+	return o.store.get(ord.ID), submitErr
+}
+
+// Cancel cancels an open order by its internal ID
+func (o *orderManager) Cancel(id string) error {
+	return o.CancelContext(context.Background(), id)
+}
+
+// CancelContext is Cancel with a context. If the context is done before the
+// exchange responds the order is left open until it is reconciled
+func (o *orderManager) CancelContext(ctx context.Context, id string) error {
+	if !o.IsRunning() {
+		return ErrSubsystemNotStarted
+	}
+
+	ord, err := o.GetOrder(id)
+	if err != nil {
+		return err
+	}
+
+	if !ord.IsOpen() {
+		return ErrOrderAlreadyClosed
+	}
+
+	exch, err := o.engine.GetExchangeByCredentialSet(ord.Exchange, ord.CredentialSet)
+	if err != nil {
+		return err
+	}
+
+	if !exch.GetFeatures().REST.CancelOrder {
+		return common.ErrFunctionNotSupported
+	}
+
+	err = exchange.CancelOrderContext(ctx, exch, &exchange.OrderCancellation{
+		OrderID:      ord.ExchangeOrderID,
+		Side:         ord.Side,
+		CurrencyPair: ord.Pair,
+		AssetType:    ord.AssetType,
+	})
+	if err != nil {
+		return err
+	}
+
+	o.store.update(id, func(ord *Order) {
+		ord.setStatus(exchange.CancelledOrderStatus, "cancelled", time.Now())
+	})
+	o.persist()
+	log.Debugf("Order manager: order %s cancelled on %s\n", id, ord.Exchange)
+	return nil
+}
+
+// CancelAll cancels all open orders of a credential set on an exchange,
+// optionally limited to a currency pair, and marks the orders the exchange
+// did not report as failed as cancelled
+func (o *orderManager) CancelAll(exchName, credentialSet string, p currency.Pair) error {
+	return o.CancelAllContext(context.Background(), exchName, credentialSet, p)
+}
+
+// CancelAllContext is CancelAll with a context. If the context is done
+// before the exchange responds the orders are left open until they are
+// reconciled
+func (o *orderManager) CancelAllContext(ctx context.Context, exchName, credentialSet string, p currency.Pair) error {
+	if !o.IsRunning() {
+		return ErrSubsystemNotStarted
+	}
+
+	exch, err := o.engine.GetExchangeByCredentialSet(exchName, credentialSet)
+	if err != nil {
+		return err
+	}
+
+	if !exch.GetFeatures().REST.CancelOrders {
+		return common.ErrFunctionNotSupported
+	}
+
+	resp, err := exchange.CancelAllOrdersContext(ctx, exch, &exchange.OrderCancellation{
+		CurrencyPair: p,
+	})
+	if err != nil {
+		return err
+	}
+
+	if isDefaultCredentialSet(credentialSet) {
+		credentialSet = config.DefaultCredentialSet
+	}
+
+	open := o.GetOrders(&OrderFilter{
+		Exchange:      exch.GetName(),
+		CredentialSet: credentialSet,
+		Pair:          p,
+		ActiveOnly:    true,
+	})
+	for x := range open {
+		if _, failed := resp.OrderStatus[open[x].ExchangeOrderID]; failed {
+			continue
+		}
+		o.store.update(open[x].ID, func(ord *Order) {
+			ord.setStatus(exchange.CancelledOrderStatus, "cancelled all", time.Now())
+		})
+	}
+	o.persist()
+
+	if len(resp.OrderStatus) > 0 {
+		return fmt.Errorf("%d orders failed to cancel on %s",
+			len(resp.OrderStatus), exch.GetName())
+	}
+	return nil
+}
+
+// Modify amends the price and amount of an open order by its internal ID. A
+// zero price or amount leaves the current value unchanged
+func (o *orderManager) Modify(id string, price, amount float64) (Order, error) {
+	return o.ModifyContext(context.Background(), id, price, amount)
+}
+
+// ModifyContext is Modify with a context. A modification is not sent once
+// the context is done, but is never abandoned after it has been sent
+func (o *orderManager) ModifyContext(ctx context.Context, id string, price, amount float64) (Order, error) {
+	if !o.IsRunning() {
+		return Order{}, ErrSubsystemNotStarted
+	}
+
+	ord, err := o.GetOrder(id)
+	if err != nil {
+		return Order{}, err
+	}
+
+	if !ord.IsOpen() {
+		return Order{}, ErrOrderAlreadyClosed
+	}
+
+	exch, err := o.engine.GetExchangeByCredentialSet(ord.Exchange, ord.CredentialSet)
+	if err != nil {
+		return Order{}, err
+	}
+
+	if !exch.GetFeatures().REST.ModifyOrder {
+		return Order{}, common.ErrFunctionNotSupported
+	}
+
+	if price == 0 {
+		price = ord.Price
+	}
+	if amount == 0 {
+		amount = ord.Amount
+	}
+
+	newID, err := exchange.ModifyOrderContext(ctx, exch, &exchange.ModifyOrder{
+		OrderID:      ord.ExchangeOrderID,
+		OrderType:    ord.Type,
+		OrderSide:    ord.Side,
+		Price:        price,
+		Amount:       amount,
+		CurrencyPair: ord.Pair,
+		AssetType:    ord.AssetType,
+	})
+	if err != nil {
+		return Order{}, err
+	}
+
+	o.store.update(id, func(ord *Order) {
+		if newID != "" && newID != ord.ExchangeOrderID {
+			o.store.reindex(ord, newID)
+		}
+		ord.Price = price
+		ord.Amount = amount
+		ord.RemainingAmount = amount - ord.ExecutedAmount
+		ord.setStatus(ord.Status, "modified", time.Now())
+	})
+	o.persist()
+	return o.store.get(id), nil
+}
+
+// GetOrder returns an order by its internal ID
+func (o *orderManager) GetOrder(id string) (Order, error) {
+	o.store.m.RLock()
+	defer o.store.m.RUnlock()
+	ord, ok := o.store.Orders[id]
+	if !ok {
+		return Order{}, ErrOrderNotFound
+	}
+	return ord.copy(), nil
+}
+
+// GetOrderByExchangeID returns an order by the ID assigned to it by the
+// exchange
+func (o *orderManager) GetOrderByExchangeID(exchName, exchangeOrderID string) (Order, error) {
+	o.store.m.RLock()
+	defer o.store.m.RUnlock()
+	ord, ok := o.store.Orders[o.store.exchangeID[exchangeOrderKey(exchName, exchangeOrderID)]]
+	if !ok {
+		return Order{}, ErrOrderNotFound
+	}
+	return ord.copy(), nil
+}
+
+// GetExchangeOrder returns an order on an exchange by either its internal ID
+// or the ID assigned to it by the exchange
+func (o *orderManager) GetExchangeOrder(exchName, id string) (Order, error) {
+	ord, err := o.GetOrder(id)
+	if err == nil {
+		if !strings.EqualFold(ord.Exchange, exchName) {
+			return Order{}, ErrOrderNotFound
+		}
+		return ord, nil
+	}
+	return o.GetOrderByExchangeID(exchName, id)
+}
+
+// GetOrders returns all orders which match the supplied filter sorted by the
+// time they were placed
+func (o *orderManager) GetOrders(f *OrderFilter) []Order {
+	o.store.m.RLock()
+	var orders []Order
+	for _, ord := range o.store.Orders {
+		if f != nil && !f.matches(ord) {
+			continue
+		}
+		orders = append(orders, ord.copy())
+	}
+	o.store.m.RUnlock()
+
+	sort.Slice(orders, func(i, j int) bool {
+		return orders[i].Placed.Before(orders[j].Placed)
+	})
+	return orders
+}
+
+// reconcile fetches active orders and order history from each exchange and
+// credential set with authenticated API support and merges them into the
+// order store. If openOnly is set, only exchanges and credential sets with
+// known open orders are queried
+func (o *orderManager) reconcile(ctx context.Context, openOnly bool) {
+	exchanges := o.engine.GetExchanges()
+	for x := range exchanges {
+		if !exchanges[x].IsEnabled() {
+			continue
+		}
+
+		name := exchanges[x].GetName()
+		for _, set := range o.engine.GetCredentialSets(name) {
+			if ctx.Err() != nil {
+				o.persist()
+				return
+			}
+
+			exch, err := o.engine.GetExchangeByCredentialSet(name, set)
+			if err != nil ||
+				!exch.GetAuthenticatedAPISupport(exchange.RestAuthentication) {
+				continue
+			}
+
+			if openOnly && !o.hasOpenOrders(name, set) {
+				continue
+			}
+
+			err = o.reconcileExchange(ctx, exch, set)
+			if err != nil {
+				log.Errorf("Order manager: unable to reconcile %s %s orders: %s\n",
+					name, set, err)
+			}
+		}
+	}
+	o.persist()
+}
+
+// hasOpenOrders returns whether an exchange credential set has open orders
+// or orders awaiting reconciliation
+func (o *orderManager) hasOpenOrders(exchName, credentialSet string) bool {
+	orders := o.GetOrders(&OrderFilter{
+		Exchange:      exchName,
+		CredentialSet: credentialSet,
+	})
+	for x := range orders {
+		if orders[x].IsOpen() || orders[x].isPending() {
+			return true
+		}
+	}
+	return false
+}
+
+// reconcileExchange merges the active orders and order history of each asset
+// type of an exchange credential set into the order store, skipping
+// whichever the exchange does not support
+func (o *orderManager) reconcileExchange(ctx context.Context, exch exchange.IBotExchange, credentialSet string) error {
+	var active, history []exchange.OrderDetail
+	features := exch.GetFeatures()
+	assetTypes := exch.GetAssetTypes()
+	for x := range assetTypes {
+		req := exchange.GetOrdersRequest{
+			OrderType: exchange.AnyOrderType,
+			OrderSide: exchange.AnyOrderSide,
+			AssetType: assetTypes[x],
+		}
+
+		if features.REST.ActiveOrders {
+			orders, err := exchange.GetActiveOrdersContext(ctx, exch, &req)
+			if err != nil {
+				return err
+			}
+			active = append(active, withAssetType(orders, assetTypes[x])...)
+		}
+
+		if features.REST.OrderHistory {
+			orders, err := exchange.GetOrderHistoryContext(ctx, exch, &req)
+			if err != nil {
+				return err
+			}
+			history = append(history, withAssetType(orders, assetTypes[x])...)
+		}
+	}
+
+	name := exch.GetName()
+	open := make(map[string]bool)
+	for x := range active {
+		open[active[x].ID] = true
+		o.store.upsert(name, credentialSet, &active[x], true)
+	}
+	for x := range history {
+		if open[history[x].ID] {
+			continue
+		}
+		o.store.upsert(name, credentialSet, &history[x], false)
+	}
+	return nil
+}
+
+// persist saves the order store and logs any errors, as failing to persist
+// must not fail an order which has already hit the market
+func (o *orderManager) persist() {
+	err := o.save()
+	if err != nil {
+		log.Errorf("Order manager: unable to save orders: %s\n", err)
+	}
+}
+
+func (o *orderManager) filePath() string {
+	return filepath.Join(o.engine.Settings.DataDir, orderManagerFile)
+}
+
+// save writes all known orders to disk
+func (o *orderManager) save() error {
+	o.fileMtx.Lock()
+	defer o.fileMtx.Unlock()
+
+	o.store.m.RLock()
+	data, err := common.JSONEncode(o.store.Orders)
+	o.store.m.RUnlock()
+	if err != nil {
+		return err
+	}
+
+	tmp := o.filePath() + ".tmp"
+	err = common.WriteFile(tmp, data)
+	if err != nil {
+		return err
+	}
+	return os.Rename(tmp, o.filePath())
+}
+
+// load reads the persisted orders from disk into the order store
+func (o *orderManager) load() error {
+	o.fileMtx.Lock()
+	defer o.fileMtx.Unlock()
+
+	data, err := common.ReadFile(o.filePath())
+	if err != nil {
+		if os.IsNotExist(err) {
+			o.store.reset(nil)
+			return nil
+		}
+		return err
+	}
+
+	orders := make(map[string]*Order)
+	err = common.JSONDecode(data, &orders)
+	if err != nil {
+		return err
+	}
+
+	o.store.reset(orders)
+	log.Debugf("Order manager: loaded %d orders from %s\n", len(orders),
+		o.filePath())
+	return nil
+}
+
+// reset replaces all orders in the store and rebuilds the exchange ID index
+func (s *orderStore) reset(orders map[string]*Order) {
+	s.m.Lock()
+	defer s.m.Unlock()
+	if orders == nil {
+		orders = make(map[string]*Order)
+	}
+	s.Orders = orders
+	s.exchangeID = make(map[string]string)
+	for id, ord := range orders {
+		if ord.ExchangeOrderID != "" {
+			s.exchangeID[exchangeOrderKey(ord.Exchange, ord.ExchangeOrderID)] = id
+		}
+	}
+}
+
+func (s *orderStore) add(ord *Order) {
+	s.m.Lock()
+	defer s.m.Unlock()
+	if s.Orders == nil {
+		s.Orders = make(map[string]*Order)
+		s.exchangeID = make(map[string]string)
+	}
+	s.Orders[ord.ID] = ord
+	if ord.ExchangeOrderID != "" {
+		s.exchangeID[exchangeOrderKey(ord.Exchange, ord.ExchangeOrderID)] = ord.ID
+	}
+}
+
+func (s *orderStore) get(id string) Order {
+	s.m.RLock()
+	defer s.m.RUnlock()
+	ord, ok := s.Orders[id]
+	if !ok {
+		return Order{}
+	}
+	return ord.copy()
+}
+
+func (s *orderStore) update(id string, fn func(*Order)) {
+	s.m.Lock()
+	defer s.m.Unlock()
+	ord, ok := s.Orders[id]
+	if !ok {
+		return
+	}
+	fn(ord)
+}
+
+// reindex changes the exchange order ID of an order. Callers must hold the
+// store lock
+func (s *orderStore) reindex(ord *Order, exchangeOrderID string) {
+	delete(s.exchangeID, exchangeOrderKey(ord.Exchange, ord.ExchangeOrderID))
+	ord.ExchangeOrderID = exchangeOrderID
+	s.exchangeID[exchangeOrderKey(ord.Exchange, exchangeOrderID)] = ord.ID
+}
+
+// upsert merges order details retrieved from an exchange credential set into
+// the store, recording orders which were not placed through the order
+// manager as external orders
+func (s *orderStore) upsert(exchName, credentialSet string, d *exchange.OrderDetail, active bool) {
+	if d.ID == "" {
+		return
+	}
+
+	s.m.Lock()
+	defer s.m.Unlock()
+	if s.Orders == nil {
+		s.Orders = make(map[string]*Order)
+		s.exchangeID = make(map[string]string)
+	}
+
+	now := time.Now()
+	key := exchangeOrderKey(exchName, d.ID)
+	ord, ok := s.Orders[s.exchangeID[key]]
+	if !ok && d.ClientID != "" {
+		ord, ok = s.pending(exchName, credentialSet, d.ClientID)
+		if ok {
+			ord.ExchangeOrderID = d.ID
+			s.exchangeID[key] = ord.ID
+		}
+	}
+	if !ok {
+		id, err := newOrderID()
+		if err != nil {
+			log.Errorf("Order manager: unable to generate order ID: %s\n", err)
+			return
+		}
+		if isDefaultCredentialSet(credentialSet) {
+			credentialSet = ""
+		}
+		ord = &Order{
+			ID:              id,
+			Exchange:        exchName,
+			CredentialSet:   credentialSet,
+			ExchangeOrderID: d.ID,
+			Pair:            d.CurrencyPair,
+			AssetType:       d.AssetType,
+			Side:            d.OrderSide,
+			Type:            d.OrderType,
+			External:        true,
+			Placed:          d.OrderDate,
+		}
+		if ord.Placed.IsZero() {
+			ord.Placed = now
+		}
+		s.Orders[id] = ord
+		s.exchangeID[key] = id
+	}
+
+	if d.Price != 0 {
+		ord.Price = d.Price
+	}
+	if d.Amount != 0 {
+		ord.Amount = d.Amount
+	}
+	if d.ExecutedAmount != 0 || d.RemainingAmount != 0 {
+		ord.ExecutedAmount = d.ExecutedAmount
+		ord.RemainingAmount = d.RemainingAmount
+	}
+	if d.Fee != 0 {
+		ord.Fee = d.Fee
+	}
+
+	for x := range d.Trades {
+		ord.addFill(&d.Trades[x])
+	}
+
+	// History rows without a status do not replace a known status
+	status := parseOrderStatus(d, active)
+	if status != ord.Status &&
+		(status != exchange.UnknownOrderStatus || ord.Status == "") {
+		ord.setStatus(status, "reconciled", now)
+	}
+}
+
+// pending returns the order of an exchange credential set awaiting
+// reconciliation by its client ID. Callers must hold the store lock
+func (s *orderStore) pending(exchName, credentialSet, clientID string) (*Order, bool) {
+	for _, ord := range s.Orders {
+		if ord.isPending() && ord.ClientID == clientID &&
+			strings.EqualFold(ord.Exchange, exchName) &&
+			sameCredentialSet(ord.CredentialSet, credentialSet) {
+			return ord, true
+		}
+	}
+	return nil, false
+}
+
+// IsOpen returns whether or not an order is still working on the market
+func (o *Order) IsOpen() bool {
+	switch o.Status {
+	case exchange.NewOrderStatus,
+		exchange.ActiveOrderStatus,
+		exchange.PartiallyFilledOrderStatus,
+		exchange.PendingCancelOrderStatus:
+		return true
+	}
+	return false
+}
+
+// isPending returns whether an order was abandoned during submission and
+// can be matched to an exchange order by its client ID
+func (o *Order) isPending() bool {
+	return o.Status == exchange.UnknownOrderStatus && o.ExchangeOrderID == "" &&
+		o.ClientID != ""
+}
+
+func (o *Order) setStatus(status exchange.OrderStatus, reason string, t time.Time) {
+	o.Status = status
+	o.LastUpdated = t
+	o.StatusHistory = append(o.StatusHistory, OrderStatusChange{
+		Status: status,
+		Time:   t,
+		Reason: reason,
+	})
+}
+
+func (o *Order) addFill(t *exchange.TradeHistory) {
+	id := strconv.FormatInt(t.TID, 10)
+	for x := range o.Fills {
+		if o.Fills[x].ID == id {
+			return
+		}
+	}
+	o.Fills = append(o.Fills, OrderFill{
+		ID:     id,
+		Price:  t.Price,
+		Amount: t.Amount,
+		Fee:    t.Fee,
+		Time:   t.Timestamp,
+	})
+}
+
+func (o *Order) copy() Order {
+	c := *o
+	c.StatusHistory = append([]OrderStatusChange(nil), o.StatusHistory...)
+	c.Fills = append([]OrderFill(nil), o.Fills...)
+	return c
+}
+
+func (f *OrderFilter) matches(o *Order) bool {
+	if f.Exchange != "" && !strings.EqualFold(f.Exchange, o.Exchange) {
+		return false
+	}
+	if f.CredentialSet != "" && !sameCredentialSet(f.CredentialSet, o.CredentialSet) {
+		return false
+	}
+	if !f.Pair.IsEmpty() && !f.Pair.Equal(o.Pair) {
+		return false
+	}
+	if f.Side != "" && f.Side != exchange.AnyOrderSide && f.Side != o.Side {
+		return false
+	}
+	if f.Status != "" && f.Status != exchange.AnyOrderStatus && f.Status != o.Status {
+		return false
+	}
+	if f.ActiveOnly && !o.IsOpen() {
+		return false
+	}
+	return true
+}
+
+// parseOrderStatus converts the free form status string returned by the
+// exchange wrappers into an order status
+func parseOrderStatus(d *exchange.OrderDetail, active bool) exchange.OrderStatus {
+	switch strings.ToUpper(strings.Replace(d.Status, " ", "_", -1)) {
+	case string(exchange.NewOrderStatus):
+		return exchange.NewOrderStatus
+	case string(exchange.ActiveOrderStatus), "OPEN":
+		return exchange.ActiveOrderStatus
+	case string(exchange.PartiallyFilledOrderStatus), "PARTIAL", "PARTIALLY_MATCHED":
+		return exchange.PartiallyFilledOrderStatus
+	case string(exchange.FilledOrderStatus), "CLOSED", "DONE", "EXECUTED":
+		return exchange.FilledOrderStatus
+	// CancelledOrderStatus is the US spelling CANCELED
+	case string(exchange.CancelledOrderStatus), "CANCELLED":
+		return exchange.CancelledOrderStatus
+	case string(exchange.PendingCancelOrderStatus):
+		return exchange.PendingCancelOrderStatus
+	case string(exchange.RejectedOrderStatus):
+		return exchange.RejectedOrderStatus
+	case string(exchange.ExpiredOrderStatus):
+		return exchange.ExpiredOrderStatus
+	case string(exchange.HiddenOrderStatus):
+		return exchange.HiddenOrderStatus
+	}
+
+	if active {
+		if d.ExecutedAmount > 0 {
+			return exchange.PartiallyFilledOrderStatus
+		}
+		return exchange.ActiveOrderStatus
+	}
+
+	if d.Amount > 0 && d.ExecutedAmount >= d.Amount {
+		return exchange.FilledOrderStatus
+	}
+	return exchange.UnknownOrderStatus
+}
+
+// withAssetType sets the asset type of orders the exchange did not report one
+// for to the asset type they were requested for
+func withAssetType(orders []exchange.OrderDetail, assetType asset.Item) []exchange.OrderDetail {
+	for x := range orders {
+		if orders[x].AssetType == "" {
+			orders[x].AssetType = assetType
+		}
+	}
+	return orders
+}
+
+// sameCredentialSet returns whether two credential set names refer to the
+// same credential set
+func sameCredentialSet(a, b string) bool {
+	if isDefaultCredentialSet(a) {
+		return isDefaultCredentialSet(b)
+	}
+	return strings.EqualFold(a, b)
+}
+
+func exchangeOrderKey(exchName, exchangeOrderID string) string {
+	return strings.ToLower(exchName) + ":" + exchangeOrderID
+}
+
+// newOrderID returns a random internal order ID
+func newOrderID() (string, error) {
+	b, err := common.GetRandomSalt(nil, 16)
+	if err != nil {
+		return "", err
+	}
+	return common.HexEncodeToString(b), nil
+}
@@ -0,0 +1,653 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/websocket/wshandler"
+)
+
+const testOrderExchange = "OrderTest"
+
+// orderTestExchange is a minimal exchange which records the orders placed
+// against it
+type orderTestExchange struct {
+	exchange.IBotExchange
+	placed    int
+	cancelled []string
+	active    []exchange.OrderDetail
+	history   []exchange.OrderDetail
+	accounts  []exchange.Account
+	features  exchange.Features
+	// abandon blocks order submissions bound to a context until it is done
+	abandon bool
+	// submitErr is returned by order submissions when set
+	submitErr error
+}
+
+// contextOrderTestExchange is an orderTestExchange bound to a context
+type contextOrderTestExchange struct {
+	*orderTestExchange
+	ctx context.Context
+}
+
+func (o *orderTestExchange) WithContext(ctx context.Context) exchange.IBotExchange {
+	return &contextOrderTestExchange{orderTestExchange: o, ctx: ctx}
+}
+
+func (c *contextOrderTestExchange) SubmitOrder(p currency.Pair, a asset.Item, side exchange.OrderSide, t exchange.OrderType, amount, price float64, clientID string) (exchange.SubmitOrderResponse, error) {
+	if c.abandon {
+		<-c.ctx.Done()
+		return exchange.SubmitOrderResponse{}, c.ctx.Err()
+	}
+	return c.orderTestExchange.SubmitOrder(p, a, side, t, amount, price, clientID)
+}
+
+func (o *orderTestExchange) GetName() string { return testOrderExchange }
+
+func (o *orderTestExchange) IsEnabled() bool { return true }
+
+func (o *orderTestExchange) SetEnabled(_ bool) {}
+
+func (o *orderTestExchange) GetAuthenticatedAPISupport(_ uint8) bool { return true }
+
+func (o *orderTestExchange) GetAssetTypes() asset.Items {
+	return asset.Items{asset.Spot, asset.Margin}
+}
+
+func (o *orderTestExchange) GetEnabledPairs(_ asset.Item) currency.Pairs {
+	return currency.Pairs{currency.NewPairFromStrings("BTC", "USD")}
+}
+
+func (o *orderTestExchange) SubmitOrder(p currency.Pair, _ asset.Item, side exchange.OrderSide, _ exchange.OrderType, amount, price float64, _ string) (exchange.SubmitOrderResponse, error) {
+	if price < 0 {
+		return exchange.SubmitOrderResponse{}, errors.New("invalid price")
+	}
+	if o.submitErr != nil {
+		return exchange.SubmitOrderResponse{}, o.submitErr
+	}
+	o.placed++
+	return exchange.SubmitOrderResponse{
+		IsOrderPlaced: true,
+		OrderID:       "exch" + strconv.Itoa(o.placed),
+	}, nil
+}
+
+func (o *orderTestExchange) CancelOrder(c *exchange.OrderCancellation) error {
+	o.cancelled = append(o.cancelled, c.OrderID)
+	return nil
+}
+
+func (o *orderTestExchange) CancelAllOrders(_ *exchange.OrderCancellation) (exchange.CancelAllOrdersResponse, error) {
+	o.cancelled = append(o.cancelled, "all")
+	return exchange.CancelAllOrdersResponse{}, nil
+}
+
+func (o *orderTestExchange) ModifyOrder(m *exchange.ModifyOrder) (string, error) {
+	return m.OrderID + "-modified", nil
+}
+
+func (o *orderTestExchange) GetActiveOrders(r *exchange.GetOrdersRequest) ([]exchange.OrderDetail, error) {
+	if r.AssetType != asset.Spot {
+		return nil, nil
+	}
+	return o.active, nil
+}
+
+func (o *orderTestExchange) GetOrderHistory(r *exchange.GetOrdersRequest) ([]exchange.OrderDetail, error) {
+	if r.AssetType != asset.Spot {
+		return nil, nil
+	}
+	return o.history, nil
+}
+
+func (o *orderTestExchange) GetAccountInfo() (exchange.AccountInfo, error) {
+	return exchange.AccountInfo{Exchange: testOrderExchange, Accounts: o.accounts}, nil
+}
+
+func (o *orderTestExchange) GetFundingHistory() ([]exchange.FundHistory, error) {
+	return nil, common.ErrFunctionNotSupported
+}
+
+func (o *orderTestExchange) GetRequestStats() request.Stats {
+	return request.Stats{Requests: 3, Retries: 1}
+}
+
+func (o *orderTestExchange) CancelRequests() {}
+
+func (o *orderTestExchange) GetFeatures() exchange.Features { return o.features }
+
+func (o *orderTestExchange) GetOrderbookEx(p currency.Pair, a asset.Item) (orderbook.Base, error) {
+	return orderbook.Get(testOrderExchange, p, a)
+}
+
+func (o *orderTestExchange) GetWebsocket() (*wshandler.Websocket, error) {
+	return nil, common.ErrFunctionNotSupported
+}
+
+func setupOrderManagerTest(t *testing.T) (*Engine, *orderTestExchange, func()) {
+	dir, err := ioutil.TempDir("", "ordermanager")
+	if err != nil {
+		t.Fatalf("Test failed. Unable to create temp dir: %s", err)
+	}
+
+	exch := &orderTestExchange{
+		features: exchange.Features{
+			REST: exchange.ProtocolFeatures{
+				TradeFetching:    true,
+				HistoricTrades:   true,
+				KlineFetching:    true,
+				GetOrder:         true,
+				ActiveOrders:     true,
+				OrderHistory:     true,
+				SubmitOrder:      true,
+				ModifyOrder:      true,
+				CancelOrder:      true,
+				CancelOrders:     true,
+				CryptoWithdrawal: true,
+			},
+			OrderTypes: []exchange.OrderType{exchange.LimitOrderType,
+				exchange.MarketOrderType},
+		},
+	}
+	e := &Engine{
+		Exchanges: []exchange.IBotExchange{exch},
+		Settings:  Settings{DataDir: dir},
+	}
+	e.setupSubsystems()
+	return e, exch, func() { os.RemoveAll(dir) }
+}
+
+func TestOrderManagerSubmitCancel(t *testing.T) {
+	e, exch, cleanup := setupOrderManagerTest(t)
+	defer cleanup()
+
+	_, err := e.orderManager.Submit(&OrderSubmission{})
+	if err != ErrSubsystemNotStarted {
+		t.Errorf("Test failed. Expected %s, got %v", ErrSubsystemNotStarted, err)
+	}
+
+	err = e.orderManager.Start()
+	if err != nil {
+		t.Fatalf("Test failed. Unable to start order manager: %s", err)
+	}
+
+	submission := OrderSubmission{
+		Exchange: testOrderExchange,
+		Pair:     currency.NewPairFromStrings("BTC", "USD"),
+		Side:     exchange.BuyOrderSide,
+		Type:     exchange.LimitOrderType,
+		Amount:   1,
+		Price:    1000,
+		ClientID: "client1",
+	}
+
+	ord, err := e.orderManager.Submit(&submission)
+	if err != nil {
+		t.Fatalf("Test failed. Unable to submit order: %s", err)
+	}
+
+	if ord.ExchangeOrderID != "exch1" || ord.Status != exchange.ActiveOrderStatus {
+		t.Errorf("Test failed. Unexpected order %+v", ord)
+	}
+
+	if len(ord.StatusHistory) != 2 {
+		t.Errorf("Test failed. Expected 2 status changes, got %d",
+			len(ord.StatusHistory))
+	}
+
+	submission.Price = -1
+	rejected, err := e.orderManager.Submit(&submission)
+	if err == nil {
+		t.Error("Test failed. Expected submission error")
+	}
+
+	if rejected.Status != exchange.RejectedOrderStatus {
+		t.Errorf("Test failed. Expected rejected order, got %s", rejected.Status)
+	}
+
+	err = e.orderManager.Cancel(ord.ID)
+	if err != nil {
+		t.Errorf("Test failed. Unable to cancel order: %s", err)
+	}
+
+	if len(exch.cancelled) != 1 || exch.cancelled[0] != "exch1" {
+		t.Errorf("Test failed. Unexpected cancellations %v", exch.cancelled)
+	}
+
+	err = e.orderManager.Cancel(ord.ID)
+	if err != ErrOrderAlreadyClosed {
+		t.Errorf("Test failed. Expected %s, got %v", ErrOrderAlreadyClosed, err)
+	}
+
+	err = e.orderManager.Cancel("asdf")
+	if err != ErrOrderNotFound {
+		t.Errorf("Test failed. Expected %s, got %v", ErrOrderNotFound, err)
+	}
+
+	err = e.orderManager.Stop()
+	if err != nil {
+		t.Fatalf("Test failed. Unable to stop order manager: %s", err)
+	}
+}
+
+func TestOrderManagerPersistence(t *testing.T) {
+	e, _, cleanup := setupOrderManagerTest(t)
+	defer cleanup()
+
+	err := e.orderManager.Start()
+	if err != nil {
+		t.Fatalf("Test failed. Unable to start order manager: %s", err)
+	}
+
+	ord, err := e.orderManager.Submit(&OrderSubmission{
+		Exchange: testOrderExchange,
+		Pair:     currency.NewPairFromStrings("BTC", "USD"),
+		Side:     exchange.SellOrderSide,
+		Type:     exchange.LimitOrderType,
+		Amount:   2,
+		Price:    1000,
+	})
+	if err != nil {
+		t.Fatalf("Test failed. Unable to submit order: %s", err)
+	}
+
+	ord, err = e.orderManager.Modify(ord.ID, 1100, 3)
+	if err != nil {
+		t.Fatalf("Test failed. Unable to modify order: %s", err)
+	}
+
+	if ord.ExchangeOrderID != "exch1-modified" || ord.Price != 1100 {
+		t.Errorf("Test failed. Unexpected modified order %+v", ord)
+	}
+
+	err = e.orderManager.Stop()
+	if err != nil {
+		t.Fatalf("Test failed. Unable to stop order manager: %s", err)
+	}
+
+	var restored orderManager
+	restored.engine = e
+	err = restored.load()
+	if err != nil {
+		t.Fatalf("Test failed. Unable to load orders: %s", err)
+	}
+
+	loaded, err := restored.GetOrder(ord.ID)
+	if err != nil {
+		t.Fatalf("Test failed. Unable to find persisted order: %s", err)
+	}
+
+	if !loaded.Pair.Equal(ord.Pair) || loaded.Amount != 3 ||
+		len(loaded.StatusHistory) != len(ord.StatusHistory) {
+		t.Errorf("Test failed. Persisted order mismatch %+v", loaded)
+	}
+
+	if restored.store.exchangeID[exchangeOrderKey(testOrderExchange, "exch1-modified")] != ord.ID {
+		t.Error("Test failed. Exchange order ID index was not rebuilt")
+	}
+}
+
+func TestOrderManagerReconcile(t *testing.T) {
+	e, exch, cleanup := setupOrderManagerTest(t)
+	defer cleanup()
+
+	err := e.orderManager.load()
+	if err != nil {
+		t.Fatalf("Test failed. Unable to load orders: %s", err)
+	}
+	// Mark the manager as running without starting the reconciliation routine
+	e.orderManager.started = 1
+
+	ord, err := e.orderManager.Submit(&OrderSubmission{
+		Exchange: testOrderExchange,
+		Pair:     currency.NewPairFromStrings("BTC", "USD"),
+		Side:     exchange.BuyOrderSide,
+		Type:     exchange.LimitOrderType,
+		Amount:   1,
+		Price:    1000,
+	})
+	if err != nil {
+		t.Fatalf("Test failed. Unable to submit order: %s", err)
+	}
+
+	exch.active = []exchange.OrderDetail{
+		{ID: "external1", Amount: 5, Price: 10, OrderSide: exchange.SellOrderSide},
+	}
+	exch.history = []exchange.OrderDetail{
+		{
+			ID:             "exch1",
+			Amount:         1,
+			ExecutedAmount: 1,
+			Trades: []exchange.TradeHistory{
+				{TID: 1, Price: 1000, Amount: 0.5},
+				{TID: 2, Price: 1000, Amount: 0.5},
+			},
+		},
+		{ID: "external1", Amount: 5},
+	}
+
+	e.orderManager.reconcile(context.Background(), false)
+	e.orderManager.reconcile(context.Background(), false)
+
+	updated, err := e.orderManager.GetOrder(ord.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if updated.Status != exchange.FilledOrderStatus {
+		t.Errorf("Test failed. Expected filled order, got %s", updated.Status)
+	}
+
+	if len(updated.Fills) != 2 {
+		t.Errorf("Test failed. Expected 2 fills, got %d", len(updated.Fills))
+	}
+
+	external := e.orderManager.GetOrders(&OrderFilter{ActiveOnly: true})
+	if len(external) != 1 || !external[0].External ||
+		external[0].ExchangeOrderID != "external1" {
+		t.Errorf("Test failed. Unexpected external orders %+v", external)
+	}
+}
+
+func TestOrderManagerContext(t *testing.T) {
+	e, exch, cleanup := setupOrderManagerTest(t)
+	defer cleanup()
+
+	err := e.orderManager.Start()
+	if err != nil {
+		t.Fatalf("Test failed. Unable to start order manager: %s", err)
+	}
+	defer e.orderManager.Stop()
+
+	submission := OrderSubmission{
+		Exchange: testOrderExchange,
+		Pair:     currency.NewPairFromStrings("BTC", "USD"),
+		Side:     exchange.BuyOrderSide,
+		Type:     exchange.LimitOrderType,
+		Amount:   1,
+		Price:    1000,
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = e.orderManager.SubmitContext(ctx, &submission)
+	if err != context.Canceled {
+		t.Errorf("Test failed. Expected %s, got %v", context.Canceled, err)
+	}
+
+	if exch.placed != 0 || len(e.orderManager.GetOrders(nil)) != 0 {
+		t.Error("Test failed. Expected a done context not to submit the order")
+	}
+
+	ord, err := e.orderManager.SubmitContext(context.Background(), &submission)
+	if err != nil {
+		t.Fatalf("Test failed. Unable to submit order: %s", err)
+	}
+
+	err = e.orderManager.CancelContext(ctx, ord.ID)
+	if err != context.Canceled || len(exch.cancelled) != 0 {
+		t.Errorf("Test failed. Expected %s, got %v", context.Canceled, err)
+	}
+
+	if restErrorStatus(err) != http.StatusGatewayTimeout {
+		t.Errorf("Test failed. Expected status %d got %d",
+			http.StatusGatewayTimeout, restErrorStatus(err))
+	}
+}
+
+func TestOrderManagerAbandonedSubmit(t *testing.T) {
+	e, exch, cleanup := setupOrderManagerTest(t)
+	defer cleanup()
+
+	err := e.orderManager.load()
+	if err != nil {
+		t.Fatalf("Test failed. Unable to load orders: %s", err)
+	}
+	e.orderManager.started = 1
+
+	exch.abandon = true
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*10)
+	defer cancel()
+	ord, err := e.orderManager.SubmitContext(ctx, &OrderSubmission{
+		Exchange: testOrderExchange,
+		Pair:     currency.NewPairFromStrings("BTC", "USD"),
+		Side:     exchange.BuyOrderSide,
+		Type:     exchange.LimitOrderType,
+		Amount:   1,
+		Price:    1000,
+		ClientID: "abandoned",
+	})
+	if err != context.DeadlineExceeded {
+		t.Fatalf("Test failed. Expected %s got %v", context.DeadlineExceeded, err)
+	}
+
+	// The order may have been placed so it must not be rejected
+	if ord.Status != exchange.UnknownOrderStatus || !ord.isPending() ||
+		!e.orderManager.hasOpenOrders(testOrderExchange, "") {
+		t.Fatalf("Test failed. Expected a pending order %+v", ord)
+	}
+
+	exch.active = []exchange.OrderDetail{
+		{ID: "placed1", ClientID: "abandoned", Amount: 1, Price: 1000},
+	}
+	e.orderManager.reconcile(context.Background(), true)
+
+	updated, err := e.orderManager.GetOrderByExchangeID(testOrderExchange, "placed1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if updated.ID != ord.ID || updated.External ||
+		updated.Status != exchange.ActiveOrderStatus {
+		t.Errorf("Test failed. Expected the pending order to be matched %+v", updated)
+	}
+	if len(e.orderManager.GetOrders(nil)) != 1 {
+		t.Error("Test failed. Expected no external order to be recorded")
+	}
+}
+
+func TestOrderManagerFeatures(t *testing.T) {
+	e, exch, cleanup := setupOrderManagerTest(t)
+	defer cleanup()
+
+	err := e.orderManager.load()
+	if err != nil {
+		t.Fatalf("Test failed. Unable to load orders: %s", err)
+	}
+	e.orderManager.started = 1
+
+	submission := OrderSubmission{
+		Exchange: testOrderExchange,
+		Pair:     currency.NewPairFromStrings("BTC", "USD"),
+		Side:     exchange.BuyOrderSide,
+		Type:     exchange.StopOrderType,
+		Amount:   1,
+		Price:    1000,
+	}
+	_, err = e.orderManager.Submit(&submission)
+	if err != ErrOrderTypeNotSupported {
+		t.Errorf("Test failed. Expected %s, got %v", ErrOrderTypeNotSupported,
+			err)
+	}
+
+	exch.features.REST.SubmitOrder = false
+	submission.Type = exchange.LimitOrderType
+	_, err = e.orderManager.Submit(&submission)
+	if err != common.ErrFunctionNotSupported {
+		t.Errorf("Test failed. Expected %s, got %v",
+			common.ErrFunctionNotSupported, err)
+	}
+
+	if exch.placed != 0 {
+		t.Errorf("Test failed. Expected no orders to be sent, got %d",
+			exch.placed)
+	}
+
+	// Order history is not requested from exchanges without support for it
+	exch.features.REST.OrderHistory = false
+	exch.history = []exchange.OrderDetail{{ID: "external1", Amount: 5}}
+	e.orderManager.reconcile(context.Background(), false)
+	if orders := e.orderManager.GetOrders(&OrderFilter{}); len(orders) != 0 {
+		t.Errorf("Test failed. Unexpected orders %+v", orders)
+	}
+}
+
+// addTestCredentialSet adds a credential set instance of the order test
+// exchange to the engine
+func addTestCredentialSet(e *Engine, name string) *orderTestExchange {
+	exch := &orderTestExchange{features: e.Exchanges[0].GetFeatures()}
+	if e.credentialSets == nil {
+		e.credentialSets = make(map[string][]credentialSet)
+	}
+	key := strings.ToLower(testOrderExchange)
+	e.credentialSets[key] = append(e.credentialSets[key],
+		credentialSet{name: name, exch: exch})
+	return exch
+}
+
+func TestOrderManagerCredentialSets(t *testing.T) {
+	e, exch, cleanup := setupOrderManagerTest(t)
+	defer cleanup()
+	desk := addTestCredentialSet(e, "desk1")
+
+	err := e.orderManager.load()
+	if err != nil {
+		t.Fatalf("Test failed. Unable to load orders: %s", err)
+	}
+	e.orderManager.started = 1
+
+	submission := OrderSubmission{
+		Exchange:      testOrderExchange,
+		CredentialSet: "desk2",
+		Pair:          currency.NewPairFromStrings("BTC", "USD"),
+		Side:          exchange.BuyOrderSide,
+		Type:          exchange.LimitOrderType,
+		Amount:        1,
+		Price:         1000,
+	}
+	_, err = e.orderManager.Submit(&submission)
+	if err != ErrCredentialSetNotFound {
+		t.Errorf("Test failed. Expected %s, got %v", ErrCredentialSetNotFound,
+			err)
+	}
+
+	submission.CredentialSet = "DESK1"
+	deskOrder, err := e.orderManager.Submit(&submission)
+	if err != nil {
+		t.Fatalf("Test failed. Unable to submit order: %s", err)
+	}
+
+	submission.CredentialSet = config.DefaultCredentialSet
+	defaultOrder, err := e.orderManager.Submit(&submission)
+	if err != nil {
+		t.Fatalf("Test failed. Unable to submit order: %s", err)
+	}
+
+	if desk.placed != 1 || exch.placed != 1 {
+		t.Errorf("Test failed. Expected an order per credential set, got %d and %d",
+			desk.placed, exch.placed)
+	}
+
+	if deskOrder.CredentialSet != "DESK1" || defaultOrder.CredentialSet != "" {
+		t.Errorf("Test failed. Unexpected credential sets %q and %q",
+			deskOrder.CredentialSet, defaultOrder.CredentialSet)
+	}
+
+	orders := e.orderManager.GetOrders(&OrderFilter{CredentialSet: "desk1"})
+	if len(orders) != 1 || orders[0].ID != deskOrder.ID {
+		t.Errorf("Test failed. Unexpected desk1 orders %+v", orders)
+	}
+
+	err = e.orderManager.CancelAll(testOrderExchange, "", currency.Pair{})
+	if err != nil {
+		t.Fatalf("Test failed. Unable to cancel all orders: %s", err)
+	}
+
+	if len(exch.cancelled) != 1 || len(desk.cancelled) != 0 {
+		t.Errorf("Test failed. Expected only the default orders to be cancelled, got %v and %v",
+			exch.cancelled, desk.cancelled)
+	}
+
+	if ord, _ := e.orderManager.GetOrder(deskOrder.ID); !ord.IsOpen() {
+		t.Error("Test failed. Expected the desk1 order to remain open")
+	}
+
+	err = e.orderManager.Cancel(deskOrder.ID)
+	if err != nil {
+		t.Fatalf("Test failed. Unable to cancel order: %s", err)
+	}
+
+	if len(desk.cancelled) != 1 || desk.cancelled[0] != deskOrder.ExchangeOrderID {
+		t.Errorf("Test failed. Expected the order to be cancelled with desk1, got %v",
+			desk.cancelled)
+	}
+
+	desk.active = []exchange.OrderDetail{{ID: "external1", Amount: 5, Price: 10}}
+	e.orderManager.reconcile(context.Background(), false)
+	ord, err := e.orderManager.GetOrderByExchangeID(testOrderExchange, "external1")
+	if err != nil {
+		t.Fatalf("Test failed. Unable to find reconciled order: %s", err)
+	}
+
+	if ord.CredentialSet != "desk1" || !ord.External {
+		t.Errorf("Test failed. Unexpected reconciled order %+v", ord)
+	}
+}
+
+func TestParseOrderStatus(t *testing.T) {
+	tests := []struct {
+		detail exchange.OrderDetail
+		active bool
+		status exchange.OrderStatus
+	}{
+		{exchange.OrderDetail{Status: "open"}, true, exchange.ActiveOrderStatus},
+		{exchange.OrderDetail{Status: "Cancelled"}, false, exchange.CancelledOrderStatus},
+		{exchange.OrderDetail{Status: "canceled"}, false, exchange.CancelledOrderStatus},
+		{exchange.OrderDetail{Status: "CANCELED"}, false, exchange.CancelledOrderStatus},
+		{exchange.OrderDetail{Status: "partially filled"}, true, exchange.PartiallyFilledOrderStatus},
+		{exchange.OrderDetail{ExecutedAmount: 1}, true, exchange.PartiallyFilledOrderStatus},
+		{exchange.OrderDetail{Amount: 1, ExecutedAmount: 1}, false, exchange.FilledOrderStatus},
+		{exchange.OrderDetail{Amount: 1}, false, exchange.UnknownOrderStatus},
+	}
+
+	for x := range tests {
+		s := parseOrderStatus(&tests[x].detail, tests[x].active)
+		if s != tests[x].status {
+			t.Errorf("Test failed. Test %d expected %s, got %s", x,
+				tests[x].status, s)
+		}
+	}
+}
+
+func TestUpsertKeepsKnownStatus(t *testing.T) {
+	var s orderStore
+	s.upsert(testOrderExchange, "", &exchange.OrderDetail{ID: "1", Amount: 2,
+		Status: "CANCELED"}, false)
+
+	// A history row without a status does not replace the known status
+	s.upsert(testOrderExchange, "", &exchange.OrderDetail{ID: "1", Amount: 2}, false)
+	ord := s.Orders[s.exchangeID[exchangeOrderKey(testOrderExchange, "1")]]
+	if ord == nil || ord.Status != exchange.CancelledOrderStatus {
+		t.Fatalf("Test failed. Expected the order to stay cancelled %+v", ord)
+	}
+
+	s.upsert(testOrderExchange, "", &exchange.OrderDetail{ID: "2", Amount: 2}, false)
+	ord = s.Orders[s.exchangeID[exchangeOrderKey(testOrderExchange, "2")]]
+	if ord == nil || ord.Status != exchange.UnknownOrderStatus {
+		t.Errorf("Test failed. Expected a new order without a status to be unknown %+v", ord)
+	}
+}
@@ -0,0 +1,111 @@
+package engine
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+)
+
+// vars related to the order manager
+var (
+	ErrOrderNotFound         = errors.New("order not found")
+	ErrOrderNotPlaced        = errors.New("order was not placed by the exchange")
+	ErrOrderAlreadyClosed    = errors.New("order is no longer open")
+	ErrAuthenticationNotOn   = errors.New("authenticated API support is not enabled for exchange")
+	ErrOrderTypeNotSupported = errors.New("order type is not supported by exchange")
+)
+
+const (
+	orderManagerFile      = "orders.json"
+	orderManagerSyncDelay = time.Minute
+)
+
+// Order holds the complete lifecycle of an order known to the order manager
+type Order struct {
+	ID              string               `json:"id"`
+	Exchange        string               `json:"exchange"`
+	CredentialSet   string               `json:"credentialSet,omitempty"`
+	ExchangeOrderID string               `json:"exchangeOrderID"`
+	ClientID        string               `json:"clientID"`
+	Pair            currency.Pair        `json:"pair"`
+	AssetType       asset.Item           `json:"assetType"`
+	Side            exchange.OrderSide   `json:"side"`
+	Type            exchange.OrderType   `json:"type"`
+	Price           float64              `json:"price"`
+	Amount          float64              `json:"amount"`
+	ExecutedAmount  float64              `json:"executedAmount"`
+	RemainingAmount float64              `json:"remainingAmount"`
+	Fee             float64              `json:"fee"`
+	Status          exchange.OrderStatus `json:"status"`
+	StatusHistory   []OrderStatusChange  `json:"statusHistory"`
+	Fills           []OrderFill          `json:"fills"`
+	External        bool                 `json:"external"`
+	Placed          time.Time            `json:"placed"`
+	LastUpdated     time.Time            `json:"lastUpdated"`
+}
+
+// OrderStatusChange records an order transitioning to a new status
+type OrderStatusChange struct {
+	Status exchange.OrderStatus `json:"status"`
+	Time   time.Time            `json:"time"`
+	Reason string               `json:"reason,omitempty"`
+}
+
+// OrderFill holds a single execution against an order
+type OrderFill struct {
+	ID     string    `json:"id"`
+	Price  float64   `json:"price"`
+	Amount float64   `json:"amount"`
+	Fee    float64   `json:"fee"`
+	Time   time.Time `json:"time"`
+}
+
+// OrderSubmission holds the parameters required to submit an order through
+// the order manager
+type OrderSubmission struct {
+	Exchange string
+	// CredentialSet defaults to the exchanges default credentials when empty
+	CredentialSet string
+	Pair          currency.Pair
+	// AssetType defaults to the exchanges primary asset type when empty
+	AssetType asset.Item
+	Side      exchange.OrderSide
+	Type      exchange.OrderType
+	Amount    float64
+	Price     float64
+	ClientID  string
+}
+
+// OrderFilter is used to narrow down the orders returned by the order manager.
+// Empty fields match all orders
+type OrderFilter struct {
+	Exchange      string
+	CredentialSet string
+	Pair          currency.Pair
+	Side          exchange.OrderSide
+	Status        exchange.OrderStatus
+	ActiveOnly    bool
+}
+
+// orderStore holds all orders known to the order manager keyed by their
+// internal ID along with an exchange order ID index
+type orderStore struct {
+	Orders     map[string]*Order
+	exchangeID map[string]string
+	m          sync.RWMutex
+}
+
+// orderManager records every order placed through the engine, persists them
+// to disk and reconciles them against the exchanges
+type orderManager struct {
+	started  int32
+	store    orderStore
+	fileMtx  sync.Mutex
+	shutdown chan struct{}
+	wg       sync.WaitGroup
+	engine   *Engine
+}
@@ -0,0 +1,105 @@
+package engine
+
+import (
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+)
+
+// OrderbookAnalyticsRequest holds the optional parameters of an orderbook
+// analytics query
+type OrderbookAnalyticsRequest struct {
+	// Amount is filled against both sides of the orderbook when set, in the
+	// quote currency if Quote is set and in the base currency otherwise
+	Amount float64 `json:"amount,omitempty"`
+	Quote  bool    `json:"quote,omitempty"`
+	// Percent returns the liquidity within the percentage of the mid price
+	// when set
+	Percent float64 `json:"percent,omitempty"`
+}
+
+// OrderbookAnalytics holds the prices, spread, fills and liquidity of an
+// exchange orderbook
+type OrderbookAnalytics struct {
+	Exchange    string               `json:"exchange"`
+	AssetType   asset.Item           `json:"assetType"`
+	Pair        currency.Pair        `json:"pair"`
+	BestBid     float64              `json:"bestBid"`
+	BestAsk     float64              `json:"bestAsk"`
+	MidPrice    float64              `json:"midPrice"`
+	MicroPrice  float64              `json:"microPrice"`
+	SpreadBPS   float64              `json:"spreadBPS"`
+	Buy         *orderbook.Fill      `json:"buy,omitempty"`
+	Sell        *orderbook.Fill      `json:"sell,omitempty"`
+	Liquidity   *orderbook.Liquidity `json:"liquidity,omitempty"`
+	LastUpdated time.Time            `json:"lastUpdated"`
+}
+
+// GetOrderbookAnalytics returns the analytics of an enabled exchange
+// orderbook, filling the request amount against both sides and measuring the
+// liquidity within the request percentage when they are set
+func (e *Engine) GetOrderbookAnalytics(exchName string, p currency.Pair, assetType asset.Item, req OrderbookAnalyticsRequest) (*OrderbookAnalytics, error) {
+	if req.Amount < 0 || req.Percent < 0 {
+		return nil, errInvalidArguments
+	}
+
+	exch, err := e.getEnabledExchange(exchName)
+	if err != nil {
+		return nil, err
+	}
+
+	ob, err := exch.GetOrderbookEx(p, assetType)
+	if err != nil {
+		return nil, err
+	}
+	return orderbookAnalytics(&ob, req)
+}
+
+// orderbookAnalytics computes the analytics of an orderbook
+func orderbookAnalytics(ob *orderbook.Base, req OrderbookAnalyticsRequest) (*OrderbookAnalytics, error) {
+	mid, err := ob.MidPrice()
+	if err != nil {
+		return nil, err
+	}
+	result := &OrderbookAnalytics{
+		Exchange:    ob.ExchangeName,
+		AssetType:   ob.AssetType,
+		Pair:        ob.Pair,
+		BestBid:     ob.Bids[0].Price,
+		BestAsk:     ob.Asks[0].Price,
+		MidPrice:    mid,
+		LastUpdated: ob.LastUpdated,
+	}
+
+	result.MicroPrice, err = ob.MicroPrice()
+	if err != nil {
+		return nil, err
+	}
+	result.SpreadBPS, err = ob.SpreadBPS()
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Amount > 0 {
+		buy, err := ob.SimulateBuy(req.Amount, req.Quote)
+		if err != nil {
+			return nil, err
+		}
+		sell, err := ob.SimulateSell(req.Amount, req.Quote)
+		if err != nil {
+			return nil, err
+		}
+		result.Buy, result.Sell = &buy, &sell
+	}
+
+	if req.Percent > 0 {
+		liquidity, err := ob.LiquidityWithin(req.Percent)
+		if err != nil {
+			return nil, err
+		}
+		result.Liquidity = &liquidity
+	}
+	return result, nil
+}
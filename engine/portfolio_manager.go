@@ -0,0 +1,83 @@
+package engine
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+
+	log "github.com/thrasher-corp/gocryptotrader/logger"
+)
+
+// portfolioSleepDelay is the delay between each portfolio balance update
+const portfolioSleepDelay = time.Minute * 10
+
+// portfolioManager periodically updates the balances of the portfolio
+// addresses
+type portfolioManager struct {
+	started  int32
+	shutdown chan struct{}
+	wg       sync.WaitGroup
+	engine   *Engine
+}
+
+// Start starts the portfolio watcher routine
+func (p *portfolioManager) Start() error {
+	if !atomic.CompareAndSwapInt32(&p.started, 0, 1) {
+		return ErrSubsystemAlreadyStarted
+	}
+
+	log.Debugf("Portfolio manager started: Have %d entries in portfolio.\n",
+		len(p.engine.Portfolio.Addresses))
+	p.shutdown = make(chan struct{})
+	p.wg.Add(1)
+	go p.run()
+	return nil
+}
+
+// Stop stops the portfolio watcher routine
+func (p *portfolioManager) Stop() error {
+	if !atomic.CompareAndSwapInt32(&p.started, 1, 0) {
+		return ErrSubsystemNotStarted
+	}
+
+	close(p.shutdown)
+	p.wg.Wait()
+	log.Debugln("Portfolio manager shutdown.")
+	return nil
+}
+
+// IsRunning returns whether or not the portfolio manager is running
+func (p *portfolioManager) IsRunning() bool {
+	return atomic.LoadInt32(&p.started) == 1
+}
+
+func (p *portfolioManager) run() {
+	t := time.NewTicker(portfolioSleepDelay)
+	defer func() {
+		t.Stop()
+		p.wg.Done()
+	}()
+
+	p.processPortfolio()
+	for {
+		select {
+		case <-p.shutdown:
+			return
+		case <-t.C:
+			p.processPortfolio()
+		}
+	}
+}
+
+func (p *portfolioManager) processPortfolio() {
+	data := p.engine.Portfolio.GetPortfolioGroupedCoin()
+	for key, value := range data {
+		success := p.engine.Portfolio.UpdatePortfolio(value, key)
+		if success {
+			log.Debugf(
+				"Portfolio manager: Successfully updated address balance for %s address(es) %s\n",
+				key, value,
+			)
+		}
+	}
+}
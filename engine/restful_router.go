@@ -0,0 +1,354 @@
+package engine
+
+import (
+	"crypto/subtle"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/thrasher-corp/gocryptotrader/common"
+	log "github.com/thrasher-corp/gocryptotrader/logger"
+
+	_ "net/http/pprof" // nolint: gosec
+)
+
+// RESTLogger logs the requests internally
+func RESTLogger(inner http.Handler, name string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+
+		inner.ServeHTTP(w, r)
+
+		log.Debugf(
+			"%s\t%s\t%s\t%s",
+			r.Method,
+			r.RequestURI,
+			name,
+			time.Since(start),
+		)
+	})
+}
+
+// RESTAuth requires the request to carry HTTP basic auth credentials which
+// match the webserver admin credentials
+func (e *Engine) RESTAuth(inner http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		username, password, ok := r.BasicAuth()
+		webserver := e.Config.GetWebserverConfig()
+		if !ok ||
+			subtle.ConstantTimeCompare([]byte(username), []byte(webserver.AdminUsername)) != 1 ||
+			subtle.ConstantTimeCompare([]byte(password), []byte(webserver.AdminPassword)) != 1 {
+			w.Header().Set("WWW-Authenticate", `Basic realm="GoCryptoTrader"`)
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		inner.ServeHTTP(w, r)
+	})
+}
+
+// Route is a sub type that holds the request routes
+type Route struct {
+	Name         string
+	Method       string
+	Pattern      string
+	HandlerFunc  http.HandlerFunc
+	AuthRequired bool
+}
+
+// Routes is an array of all the registered routes
+type Routes []Route
+
+// NewRouter takes in the exchange interfaces and returns a new multiplexor
+// router
+func (e *Engine) NewRouter() *mux.Router {
+	router := mux.NewRouter().StrictSlash(true)
+	var listenAddr string
+
+	if common.ExtractPort(e.Config.Webserver.ListenAddress) == 80 {
+		listenAddr = common.ExtractHost(e.Config.Webserver.ListenAddress)
+	} else {
+		listenAddr = common.JoinStrings([]string{common.ExtractHost(e.Config.Webserver.ListenAddress),
+			strconv.Itoa(common.ExtractPort(e.Config.Webserver.ListenAddress))}, ":")
+	}
+
+	routes := Routes{
+		Route{
+			"",
+			http.MethodGet,
+			"/",
+			getIndex,
+			false,
+		},
+		Route{
+			"GetAllSettings",
+			http.MethodGet,
+			"/config/all",
+			e.RESTGetAllSettings,
+			true,
+		},
+		Route{
+			"SaveAllSettings",
+			http.MethodPost,
+			"/config/all/save",
+			e.RESTSaveAllSettings,
+			true,
+		},
+		Route{
+			"ReloadConfig",
+			http.MethodPost,
+			"/config/reload",
+			e.RESTReloadConfig,
+			true,
+		},
+		Route{
+			"AllEnabledAccountInfo",
+			http.MethodGet,
+			"/exchanges/enabled/accounts/all",
+			e.RESTGetAllEnabledAccountInfo,
+			false,
+		},
+		Route{
+			"AllActiveExchangesAndCurrencies",
+			http.MethodGet,
+			"/exchanges/enabled/latest/all",
+			e.RESTGetAllActiveTickers,
+			false,
+		},
+		Route{
+			"IndividualExchangeAndCurrency",
+			http.MethodGet,
+			"/exchanges/{exchangeName}/latest/{currency}",
+			e.RESTGetTicker,
+			false,
+		},
+		Route{
+			"GetPortfolio",
+			http.MethodGet,
+			"/portfolio/all",
+			e.RESTGetPortfolio,
+			false,
+		},
+		Route{
+			"AllActiveExchangesAndOrderbooks",
+			http.MethodGet,
+			"/exchanges/orderbook/latest/all",
+			e.RESTGetAllActiveOrderbooks,
+			false,
+		},
+		Route{
+			"ConsolidatedOrderbook",
+			http.MethodGet,
+			"/exchanges/orderbook/consolidated/{currency}",
+			e.RESTGetConsolidatedOrderbook,
+			false,
+		},
+		Route{
+			"IndividualExchangeOrderbook",
+			http.MethodGet,
+			"/exchanges/{exchangeName}/orderbook/latest/{currency}",
+			e.RESTGetOrderbook,
+			false,
+		},
+		Route{
+			"GetOrderbookAnalytics",
+			http.MethodGet,
+			"/exchanges/{exchangeName}/orderbook/analytics/{currency}",
+			e.RESTGetOrderbookAnalytics,
+			false,
+		},
+		Route{
+			"GetHistoricCandles",
+			http.MethodGet,
+			"/exchanges/{exchangeName}/candles/{currency}",
+			e.RESTGetHistoricCandles,
+			false,
+		},
+		Route{
+			"GetSubsystems",
+			http.MethodGet,
+			"/subsystems",
+			e.RESTGetSubsystems,
+			true,
+		},
+		Route{
+			"SetSubsystem",
+			http.MethodPost,
+			"/subsystems/{subsystem}/{action:enable|disable}",
+			e.RESTSetSubsystem,
+			true,
+		},
+		Route{
+			"GetScripts",
+			http.MethodGet,
+			"/scripts",
+			e.RESTGetScripts,
+			true,
+		},
+		Route{
+			"StartScript",
+			http.MethodPost,
+			"/scripts/{script}/start",
+			e.RESTStartScript,
+			true,
+		},
+		Route{
+			"StopScript",
+			http.MethodPost,
+			"/scripts/{script}/stop",
+			e.RESTStopScript,
+			true,
+		},
+		Route{
+			"GetOrders",
+			http.MethodGet,
+			"/exchanges/{exchangeName}/orders",
+			e.RESTGetOrders,
+			true,
+		},
+		Route{
+			"SubmitOrder",
+			http.MethodPost,
+			"/exchanges/{exchangeName}/orders",
+			e.RESTSubmitOrder,
+			true,
+		},
+		Route{
+			"CancelAllOrders",
+			http.MethodDelete,
+			"/exchanges/{exchangeName}/orders",
+			e.RESTCancelAllOrders,
+			true,
+		},
+		Route{
+			"GetActiveOrders",
+			http.MethodGet,
+			"/exchanges/{exchangeName}/orders/active",
+			e.RESTGetActiveOrders,
+			true,
+		},
+		Route{
+			"GetOrderHistory",
+			http.MethodGet,
+			"/exchanges/{exchangeName}/orders/history",
+			e.RESTGetOrderHistory,
+			true,
+		},
+		Route{
+			"GetOrder",
+			http.MethodGet,
+			"/exchanges/{exchangeName}/orders/{orderID}",
+			e.RESTGetOrder,
+			true,
+		},
+		Route{
+			"CancelOrder",
+			http.MethodDelete,
+			"/exchanges/{exchangeName}/orders/{orderID}",
+			e.RESTCancelOrder,
+			true,
+		},
+		Route{
+			"GetTradeHistory",
+			http.MethodGet,
+			"/exchanges/{exchangeName}/trades/{currency}",
+			e.RESTGetTradeHistory,
+			true,
+		},
+		Route{
+			"GetFundingHistory",
+			http.MethodGet,
+			"/exchanges/{exchangeName}/funding",
+			e.RESTGetFundingHistory,
+			true,
+		},
+		Route{
+			"GetExchangeFeatures",
+			http.MethodGet,
+			"/exchanges/{exchangeName}/features",
+			e.RESTGetExchangeFeatures,
+			false,
+		},
+		Route{
+			"GetSyncStatus",
+			http.MethodGet,
+			"/sync",
+			e.RESTGetSyncStatus,
+			false,
+		},
+		Route{
+			"GetExchangeSyncStatus",
+			http.MethodGet,
+			"/exchanges/{exchangeName}/sync",
+			e.RESTGetSyncStatus,
+			false,
+		},
+		Route{
+			"GetOrderbookStats",
+			http.MethodGet,
+			"/exchanges/{exchangeName}/orderbook/stats",
+			e.RESTGetOrderbookStats,
+			true,
+		},
+		Route{
+			"GetRequestStats",
+			http.MethodGet,
+			"/exchanges/{exchangeName}/requests",
+			e.RESTGetRequestStats,
+			true,
+		},
+		Route{
+			"GetDepositAddress",
+			http.MethodGet,
+			"/exchanges/{exchangeName}/deposit/{currency}",
+			e.RESTGetDepositAddress,
+			true,
+		},
+		Route{
+			"WithdrawCryptocurrencyFunds",
+			http.MethodPost,
+			"/exchanges/{exchangeName}/withdraw/crypto",
+			e.RESTWithdrawCryptocurrencyFunds,
+			true,
+		},
+		Route{
+			"WithdrawFiatFunds",
+			http.MethodPost,
+			"/exchanges/{exchangeName}/withdraw/fiat",
+			e.RESTWithdrawFiatFunds,
+			true,
+		},
+		Route{
+			"ws",
+			http.MethodGet,
+			"/ws",
+			e.WebsocketClientHandler,
+			false,
+		},
+	}
+
+	for _, route := range routes {
+		var handler http.Handler = route.HandlerFunc
+		if route.AuthRequired {
+			handler = e.RESTAuth(handler)
+		}
+		router.
+			Methods(route.Method).
+			Path(route.Pattern).
+			Name(route.Name).
+			Handler(RESTLogger(handler, route.Name)).
+			Host(listenAddr)
+	}
+
+	if e.Config.Profiler.Enabled {
+		log.Debugln("Profiler enabled")
+		router.PathPrefix("/debug").Handler(http.DefaultServeMux)
+	}
+
+	return router
+}
+
+func getIndex(w http.ResponseWriter, _ *http.Request) {
+	fmt.Fprint(w, "<html>GoCryptoTrader RESTful interface. For the web GUI, please visit the <a href=https://github.com/thrasher-corp/gocryptotrader/blob/master/web/README.md>web GUI readme.</a></html>")
+}
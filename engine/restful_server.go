@@ -0,0 +1,1320 @@
+package engine
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+	"github.com/thrasher-corp/gocryptotrader/gctscript"
+	log "github.com/thrasher-corp/gocryptotrader/logger"
+)
+
+// AllEnabledExchangeOrderbooks holds the enabled exchange orderbooks
+type AllEnabledExchangeOrderbooks struct {
+	Data []EnabledExchangeOrderbooks `json:"data"`
+}
+
+// EnabledExchangeOrderbooks is a sub type for singular exchanges and respective
+// orderbooks
+type EnabledExchangeOrderbooks struct {
+	ExchangeName   string           `json:"exchangeName"`
+	ExchangeValues []orderbook.Base `json:"exchangeValues"`
+}
+
+// AllEnabledExchangeCurrencies holds the enabled exchange currencies
+type AllEnabledExchangeCurrencies struct {
+	Data []EnabledExchangeCurrencies `json:"data"`
+}
+
+// EnabledExchangeCurrencies is a sub type for singular exchanges and respective
+// currencies
+type EnabledExchangeCurrencies struct {
+	ExchangeName   string         `json:"exchangeName"`
+	ExchangeValues []ticker.Price `json:"exchangeValues"`
+}
+
+// AllEnabledExchangeAccounts holds all enabled accounts info
+type AllEnabledExchangeAccounts struct {
+	Data []exchange.AccountInfo `json:"data"`
+}
+
+// RESTErrorResponse is the JSON body returned for failed requests
+type RESTErrorResponse struct {
+	Error string `json:"error"`
+}
+
+// RESTGenericResponse is the JSON body returned for requests which have no
+// other result
+type RESTGenericResponse struct {
+	Status string `json:"status"`
+}
+
+// RESTSubmitOrderRequest holds the parameters for submitting an order
+type RESTSubmitOrderRequest struct {
+	Pair      string  `json:"pair"`
+	AssetType string  `json:"assetType"`
+	Side      string  `json:"side"`
+	OrderType string  `json:"orderType"`
+	Amount    float64 `json:"amount"`
+	Price     float64 `json:"price"`
+	ClientID  string  `json:"clientID"`
+}
+
+// RESTWithdrawRequest holds the parameters for a cryptocurrency or fiat
+// withdrawal. Fiat withdrawals are sent to the client bank account configured
+// for the exchange and currency
+type RESTWithdrawRequest struct {
+	Currency    string  `json:"currency"`
+	Amount      float64 `json:"amount"`
+	Address     string  `json:"address,omitempty"`
+	AddressTag  string  `json:"addressTag,omitempty"`
+	Fee         float64 `json:"fee,omitempty"`
+	Description string  `json:"description,omitempty"`
+}
+
+// RESTWithdrawResponse holds the exchange reference for a withdrawal
+type RESTWithdrawResponse struct {
+	ID string `json:"id"`
+}
+
+// RESTDepositAddressResponse holds a deposit address for a cryptocurrency
+type RESTDepositAddressResponse struct {
+	Currency string `json:"currency"`
+	Address  string `json:"address"`
+}
+
+// RESTScriptsResponse holds the scripts in the script directory and the
+// status of each started script
+type RESTScriptsResponse struct {
+	Available []string           `json:"available"`
+	Scripts   []gctscript.Status `json:"scripts"`
+}
+
+// RESTfulJSONResponse outputs a JSON response of the response interface
+func RESTfulJSONResponse(w http.ResponseWriter, response interface{}) error {
+	return RESTfulJSONStatusResponse(w, http.StatusOK, response)
+}
+
+// RESTfulJSONStatusResponse outputs a JSON response of the response interface
+// with the supplied HTTP status code
+func RESTfulJSONStatusResponse(w http.ResponseWriter, status int, response interface{}) error {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	w.WriteHeader(status)
+	return json.NewEncoder(w).Encode(response)
+}
+
+// RESTfulError prints the REST method and error
+func RESTfulError(method string, err error) {
+	log.Errorf("RESTful %s: server failed to send JSON response. Error %s",
+		method, err)
+}
+
+// RESTfulErrorResponse outputs a JSON error response with the supplied HTTP
+// status code
+func RESTfulErrorResponse(w http.ResponseWriter, r *http.Request, status int, err error) {
+	jsonErr := RESTfulJSONStatusResponse(w, status, RESTErrorResponse{
+		Error: err.Error(),
+	})
+	if jsonErr != nil {
+		RESTfulError(r.Method, jsonErr)
+	}
+}
+
+// restErrorStatus returns the HTTP status code for an error returned by the
+// engine or an exchange. Unrecognised errors are treated as exchange
+// failures
+func restErrorStatus(err error) int {
+	switch {
+	case errorIsAny(err, errInvalidArguments, ErrOrderTypeNotSupported,
+		asset.ErrNotSupported, kline.ErrUnsupportedInterval,
+		kline.ErrInvalidTimeRange, exchange.ErrInvalidTimeRange,
+		orderbook.ErrInvalidAmount, orderbook.ErrInvalidPercent):
+		return http.StatusBadRequest
+	case errorIsAny(err, ErrAuthenticationNotOn):
+		return http.StatusForbidden
+	case errorIsAny(err, ErrExchangeNotFound, ErrCredentialSetNotFound,
+		ErrOrderNotFound, ErrSubsystemNotFound, errNoOrderbooks):
+		return http.StatusNotFound
+	case errorIsAny(err, errExchangeNotEnabled, ErrOrderAlreadyClosed,
+		orderbook.ErrNoLiquidity):
+		return http.StatusConflict
+	case errorIsAny(err, common.ErrNotYetImplemented,
+		common.ErrFunctionNotSupported):
+		return http.StatusNotImplemented
+	case errorIsAny(err, ErrSubsystemNotStarted):
+		return http.StatusServiceUnavailable
+	case errorIsAny(err, context.DeadlineExceeded, context.Canceled):
+		return http.StatusGatewayTimeout
+	default:
+		return http.StatusBadGateway
+	}
+}
+
+// errorIsAny returns whether the error or any error it wraps matches one of
+// the targets
+func errorIsAny(err error, targets ...error) bool {
+	for x := range targets {
+		if errors.Is(err, targets[x]) {
+			return true
+		}
+	}
+	return false
+}
+
+// RESTGetAllSettings replies to a request with an encoded JSON response about the
+// trading bots configuration.
+func (e *Engine) RESTGetAllSettings(w http.ResponseWriter, r *http.Request) {
+	err := RESTfulJSONResponse(w, e.Config)
+	if err != nil {
+		RESTfulError(r.Method, err)
+	}
+}
+
+// RESTSaveAllSettings applies the settings from the request body as a JSON
+// document to the running engine, saves them and returns the settings
+func (e *Engine) RESTSaveAllSettings(w http.ResponseWriter, r *http.Request) {
+	// Get the data from the request
+	decoder := json.NewDecoder(r.Body)
+	var responseData config.Post
+	err := decoder.Decode(&responseData)
+	if err != nil {
+		RESTfulErrorResponse(w, r, http.StatusBadRequest, err)
+		return
+	}
+
+	// Apply and save the settings
+	_, err = e.ReloadConfig(&responseData.Data)
+	if err != nil {
+		RESTfulErrorResponse(w, r, http.StatusBadRequest, err)
+		return
+	}
+
+	err = e.saveConfig()
+	if err != nil {
+		RESTfulErrorResponse(w, r, http.StatusInternalServerError, err)
+		return
+	}
+
+	err = RESTfulJSONResponse(w, e.Config)
+	if err != nil {
+		RESTfulError(r.Method, err)
+	}
+}
+
+// RESTReloadConfig reloads the config from the config file and returns the
+// changes applied
+func (e *Engine) RESTReloadConfig(w http.ResponseWriter, r *http.Request) {
+	result, err := e.ReloadConfigFile()
+	if err != nil {
+		RESTfulErrorResponse(w, r, http.StatusBadRequest, err)
+		return
+	}
+
+	err = RESTfulJSONResponse(w, result)
+	if err != nil {
+		RESTfulError(r.Method, err)
+	}
+}
+
+// RESTGetOrderbook returns orderbook info for a given currency, exchange and
+// asset type
+func (e *Engine) RESTGetOrderbook(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	currency := vars["currency"]
+	exchangeName := vars["exchangeName"]
+	assetType, err := e.getAssetType(exchangeName, vars["assetType"])
+	if err != nil {
+		RESTfulErrorResponse(w, r, restErrorStatus(err), err)
+		return
+	}
+
+	response, err := e.GetSpecificOrderbook(currency, exchangeName, assetType)
+	if err != nil {
+		log.Errorf("Failed to fetch orderbook for %s currency: %s\n", exchangeName,
+			currency)
+		RESTfulErrorResponse(w, r, restErrorStatus(err), err)
+		return
+	}
+
+	err = RESTfulJSONResponse(w, response)
+	if err != nil {
+		RESTfulError(r.Method, err)
+	}
+}
+
+// RESTGetOrderbookAnalytics returns the mid and micro prices and spread of an
+// orderbook, the fills of the amount query parameter on both sides in the
+// base currency or the quote currency if quote is true, and the liquidity
+// within the percent query parameter of the mid price
+func (e *Engine) RESTGetOrderbookAnalytics(w http.ResponseWriter, r *http.Request) {
+	exch, err := e.getRESTExchange(r, false)
+	if err != nil {
+		RESTfulErrorResponse(w, r, restErrorStatus(err), err)
+		return
+	}
+
+	q := r.URL.Query()
+	assetType, err := parseAssetType(exch, q.Get("assetType"))
+	if err != nil {
+		RESTfulErrorResponse(w, r, restErrorStatus(err), err)
+		return
+	}
+
+	req := OrderbookAnalyticsRequest{Quote: q.Get("quote") == "true"}
+	if amount := q.Get("amount"); amount != "" {
+		req.Amount, err = strconv.ParseFloat(amount, 64)
+	}
+	if percent := q.Get("percent"); err == nil && percent != "" {
+		req.Percent, err = strconv.ParseFloat(percent, 64)
+	}
+	if err != nil {
+		RESTfulErrorResponse(w, r, http.StatusBadRequest, err)
+		return
+	}
+
+	response, err := e.GetOrderbookAnalytics(exch.GetName(),
+		currency.NewPairFromString(mux.Vars(r)["currency"]), assetType, req)
+	if err != nil {
+		RESTfulErrorResponse(w, r, restErrorStatus(err), err)
+		return
+	}
+
+	err = RESTfulJSONResponse(w, response)
+	if err != nil {
+		RESTfulError(r.Method, err)
+	}
+}
+
+// RESTGetConsolidatedOrderbook returns the orderbook of a currency pair
+// merged across the enabled exchanges. The convert query parameter merges
+// pairs with other fiat quote currencies and the amount query parameter
+// routes a buy and sell of the amount against it
+func (e *Engine) RESTGetConsolidatedOrderbook(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	req := ConsolidatedOrderbookRequest{
+		Pair:          currency.NewPairFromString(mux.Vars(r)["currency"]),
+		ConvertQuotes: q.Get("convert") == "true",
+	}
+
+	var err error
+	if assetType := q.Get("assetType"); assetType != "" {
+		req.AssetType, err = asset.New(assetType)
+		if err != nil {
+			RESTfulErrorResponse(w, r, restErrorStatus(err), err)
+			return
+		}
+	}
+
+	if amount := q.Get("amount"); amount != "" {
+		req.Amount, err = strconv.ParseFloat(amount, 64)
+		if err != nil {
+			RESTfulErrorResponse(w, r, http.StatusBadRequest, err)
+			return
+		}
+	}
+
+	response, err := e.GetConsolidatedOrderbook(&req)
+	if err != nil {
+		RESTfulErrorResponse(w, r, restErrorStatus(err), err)
+		return
+	}
+
+	err = RESTfulJSONResponse(w, response)
+	if err != nil {
+		RESTfulError(r.Method, err)
+	}
+}
+
+// GetAllActiveOrderbooks returns all enabled exchanges orderbooks
+func (e *Engine) GetAllActiveOrderbooks() []EnabledExchangeOrderbooks {
+	var orderbookData []EnabledExchangeOrderbooks
+
+	for _, individualBot := range e.GetExchanges() {
+		if individualBot == nil || !individualBot.IsEnabled() {
+			continue
+		}
+
+		var individualExchange EnabledExchangeOrderbooks
+		exchangeName := individualBot.GetName()
+		individualExchange.ExchangeName = exchangeName
+		currencies := individualBot.GetEnabledCurrencies()
+		assetTypes, err := e.getExchangeAssetTypes(exchangeName)
+		if err != nil {
+			log.Errorf("failed to get %s exchange asset types. Error: %s",
+				exchangeName, err)
+			continue
+		}
+		for _, x := range currencies {
+			pair := x
+
+			var ob orderbook.Base
+			if len(assetTypes) > 1 {
+				for y := range assetTypes {
+					ob, err = individualBot.GetOrderbookEx(pair,
+						assetTypes[y])
+				}
+			} else {
+				ob, err = individualBot.GetOrderbookEx(pair,
+					assetTypes[0])
+			}
+
+			if err != nil {
+				log.Errorf("failed to get %s %s orderbook. Error: %s",
+					pair,
+					exchangeName,
+					err)
+				continue
+			}
+
+			individualExchange.ExchangeValues = append(
+				individualExchange.ExchangeValues, ob,
+			)
+		}
+		orderbookData = append(orderbookData, individualExchange)
+	}
+	return orderbookData
+}
+
+// RESTGetAllActiveOrderbooks returns all enabled exchange orderbooks
+func (e *Engine) RESTGetAllActiveOrderbooks(w http.ResponseWriter, r *http.Request) {
+	var response AllEnabledExchangeOrderbooks
+	response.Data = e.GetAllActiveOrderbooks()
+
+	err := RESTfulJSONResponse(w, response)
+	if err != nil {
+		RESTfulError(r.Method, err)
+	}
+}
+
+// RESTGetPortfolio returns the bot portfolio
+func (e *Engine) RESTGetPortfolio(w http.ResponseWriter, r *http.Request) {
+	result := e.Portfolio.GetPortfolioSummary()
+	err := RESTfulJSONResponse(w, result)
+	if err != nil {
+		RESTfulError(r.Method, err)
+	}
+}
+
+// RESTGetTicker returns ticker info for a given currency, exchange and
+// asset type
+func (e *Engine) RESTGetTicker(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	currency := vars["currency"]
+	exchangeName := vars["exchangeName"]
+	assetType, err := e.getAssetType(exchangeName, vars["assetType"])
+	if err != nil {
+		RESTfulErrorResponse(w, r, restErrorStatus(err), err)
+		return
+	}
+
+	response, err := e.GetSpecificTicker(currency, exchangeName, assetType)
+	if err != nil {
+		log.Errorf("Failed to fetch ticker for %s currency: %s\n", exchangeName,
+			currency)
+		RESTfulErrorResponse(w, r, restErrorStatus(err), err)
+		return
+	}
+	err = RESTfulJSONResponse(w, response)
+	if err != nil {
+		RESTfulError(r.Method, err)
+	}
+}
+
+// GetAllActiveTickers returns all enabled exchange tickers
+func (e *Engine) GetAllActiveTickers() []EnabledExchangeCurrencies {
+	var tickerData []EnabledExchangeCurrencies
+
+	for _, individualBot := range e.GetExchanges() {
+		if individualBot == nil || !individualBot.IsEnabled() {
+			continue
+		}
+
+		var individualExchange EnabledExchangeCurrencies
+		exchangeName := individualBot.GetName()
+		individualExchange.ExchangeName = exchangeName
+		currencies := individualBot.GetEnabledCurrencies()
+		for _, x := range currencies {
+			pair := x
+			assetTypes, err := e.getExchangeAssetTypes(exchangeName)
+			if err != nil {
+				log.Errorf("failed to get %s exchange asset types. Error: %s",
+					exchangeName, err)
+				continue
+			}
+			var tickerPrice ticker.Price
+			if len(assetTypes) > 1 {
+				for y := range assetTypes {
+					tickerPrice, err = individualBot.GetTickerPrice(pair,
+						assetTypes[y])
+				}
+			} else {
+				tickerPrice, err = individualBot.GetTickerPrice(pair,
+					assetTypes[0])
+			}
+
+			if err != nil {
+				log.Errorf("failed to get %s %s ticker. Error: %s",
+					pair,
+					exchangeName,
+					err)
+				continue
+			}
+
+			individualExchange.ExchangeValues = append(
+				individualExchange.ExchangeValues, tickerPrice,
+			)
+		}
+		tickerData = append(tickerData, individualExchange)
+	}
+	return tickerData
+}
+
+// RESTGetAllActiveTickers returns all active tickers
+func (e *Engine) RESTGetAllActiveTickers(w http.ResponseWriter, r *http.Request) {
+	var response AllEnabledExchangeCurrencies
+	response.Data = e.GetAllActiveTickers()
+
+	err := RESTfulJSONResponse(w, response)
+	if err != nil {
+		RESTfulError(r.Method, err)
+	}
+}
+
+// GetAllEnabledExchangeAccountInfo returns all the current enabled exchanges
+func (e *Engine) GetAllEnabledExchangeAccountInfo() AllEnabledExchangeAccounts {
+	return e.GetAllEnabledExchangeAccountInfoContext(context.Background())
+}
+
+// GetAllEnabledExchangeAccountInfoContext returns all the current enabled
+// exchanges, skipping the remaining exchanges once the context is done
+func (e *Engine) GetAllEnabledExchangeAccountInfoContext(ctx context.Context) AllEnabledExchangeAccounts {
+	var response AllEnabledExchangeAccounts
+	for _, individualBot := range e.GetExchanges() {
+		if ctx.Err() != nil {
+			log.Warnf("GetAllEnabledExchangeAccountInfo: Abandoned: %s", ctx.Err())
+			break
+		}
+
+		if individualBot != nil && individualBot.IsEnabled() {
+			if !individualBot.GetAuthenticatedAPISupport(exchange.RestAuthentication) {
+				log.Warnf("GetAllEnabledExchangeAccountInfo: Skippping %s due to disabled authenticated API support.", individualBot.GetName())
+				continue
+			}
+			individualExchange, err := e.GetAccountInfoContext(ctx, individualBot)
+			if err != nil {
+				log.Errorf("Error encountered retrieving exchange account info for %s. Error %s",
+					individualBot.GetName(), err)
+				continue
+			}
+			response.Data = append(response.Data, individualExchange)
+		}
+	}
+	return response
+}
+
+// RESTGetAllEnabledAccountInfo via get request returns JSON response of account
+// info
+func (e *Engine) RESTGetAllEnabledAccountInfo(w http.ResponseWriter, r *http.Request) {
+	response := e.GetAllEnabledExchangeAccountInfoContext(r.Context())
+	err := RESTfulJSONResponse(w, response)
+	if err != nil {
+		RESTfulError(r.Method, err)
+	}
+}
+
+// RESTGetSubsystems returns the running status of each engine subsystem
+func (e *Engine) RESTGetSubsystems(w http.ResponseWriter, r *http.Request) {
+	err := RESTfulJSONResponse(w, e.GetSubsystemsStatus())
+	if err != nil {
+		RESTfulError(r.Method, err)
+	}
+}
+
+// RESTSetSubsystem enables or disables an engine subsystem
+func (e *Engine) RESTSetSubsystem(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	err := e.SetSubsystem(vars["subsystem"], vars["action"] == "enable")
+	if err != nil {
+		status := http.StatusBadRequest
+		if err == ErrSubsystemNotFound {
+			status = http.StatusNotFound
+		}
+		RESTfulErrorResponse(w, r, status, err)
+		return
+	}
+
+	err = RESTfulJSONResponse(w, e.GetSubsystemsStatus())
+	if err != nil {
+		RESTfulError(r.Method, err)
+	}
+}
+
+// restScriptErrorStatus returns the HTTP status code for an error starting or
+// stopping a script. Unrecognised errors are invalid settings or scripts
+// which fail to compile
+func restScriptErrorStatus(err error) int {
+	switch err {
+	case gctscript.ErrScriptNotFound, gctscript.ErrScriptNotRunning:
+		return http.StatusNotFound
+	case gctscript.ErrScriptAlreadyRunning, gctscript.ErrMaxScripts:
+		return http.StatusConflict
+	default:
+		return http.StatusBadRequest
+	}
+}
+
+// RESTGetScripts returns the scripts in the script directory and the status
+// of each started script
+func (e *Engine) RESTGetScripts(w http.ResponseWriter, r *http.Request) {
+	m := e.scriptManager.GetManager()
+	if m == nil {
+		RESTfulErrorResponse(w, r, http.StatusServiceUnavailable,
+			ErrSubsystemNotStarted)
+		return
+	}
+
+	available, err := m.Available()
+	if err != nil {
+		RESTfulErrorResponse(w, r, http.StatusInternalServerError, err)
+		return
+	}
+
+	err = RESTfulJSONResponse(w, RESTScriptsResponse{
+		Available: available,
+		Scripts:   m.List(),
+	})
+	if err != nil {
+		RESTfulError(r.Method, err)
+	}
+}
+
+// RESTStartScript starts a script from the script directory with the
+// settings in the request body, scripts without settings are run once
+func (e *Engine) RESTStartScript(w http.ResponseWriter, r *http.Request) {
+	m := e.scriptManager.GetManager()
+	if m == nil {
+		RESTfulErrorResponse(w, r, http.StatusServiceUnavailable,
+			ErrSubsystemNotStarted)
+		return
+	}
+
+	var settings gctscript.Settings
+	err := json.NewDecoder(r.Body).Decode(&settings)
+	if err != nil && err != io.EOF {
+		RESTfulErrorResponse(w, r, http.StatusBadRequest, err)
+		return
+	}
+
+	status, err := m.Start(mux.Vars(r)["script"], settings)
+	if err != nil {
+		RESTfulErrorResponse(w, r, restScriptErrorStatus(err), err)
+		return
+	}
+
+	err = RESTfulJSONStatusResponse(w, http.StatusCreated, status)
+	if err != nil {
+		RESTfulError(r.Method, err)
+	}
+}
+
+// RESTStopScript stops a started script
+func (e *Engine) RESTStopScript(w http.ResponseWriter, r *http.Request) {
+	m := e.scriptManager.GetManager()
+	if m == nil {
+		RESTfulErrorResponse(w, r, http.StatusServiceUnavailable,
+			ErrSubsystemNotStarted)
+		return
+	}
+
+	err := m.Stop(mux.Vars(r)["script"])
+	if err != nil {
+		RESTfulErrorResponse(w, r, restScriptErrorStatus(err), err)
+		return
+	}
+
+	err = RESTfulJSONResponse(w, RESTGenericResponse{Status: "success"})
+	if err != nil {
+		RESTfulError(r.Method, err)
+	}
+}
+
+// getRESTExchange returns the enabled exchange named in the request route. If
+// authenticated is set, the exchange instance of the credentialSet query
+// parameter is returned and must have authenticated API support
+func (e *Engine) getRESTExchange(r *http.Request, authenticated bool) (exchange.IBotExchange, error) {
+	name := mux.Vars(r)["exchangeName"]
+	if !authenticated {
+		return e.getEnabledExchange(name)
+	}
+
+	exch, err := e.getEnabledCredentialSet(name, r.URL.Query().Get("credentialSet"))
+	if err != nil {
+		return nil, err
+	}
+
+	if !exch.GetAuthenticatedAPISupport(exchange.RestAuthentication) {
+		return nil, ErrAuthenticationNotOn
+	}
+	return exch, nil
+}
+
+// restOrdersRequest builds an exchange order request from the pair, side,
+// type, start and end query parameters. Multiple pairs are comma separated
+// and times are in RFC3339 format
+func restOrdersRequest(r *http.Request) (*exchange.GetOrdersRequest, error) {
+	q := r.URL.Query()
+	req := &exchange.GetOrdersRequest{
+		OrderSide: exchange.AnyOrderSide,
+		OrderType: exchange.AnyOrderType,
+	}
+
+	if side := q.Get("side"); side != "" {
+		req.OrderSide = exchange.OrderSide(common.StringToUpper(side))
+	}
+
+	if orderType := q.Get("type"); orderType != "" {
+		req.OrderType = exchange.OrderType(common.StringToUpper(orderType))
+	}
+
+	if pairs := q.Get("pair"); pairs != "" {
+		for _, p := range common.SplitStrings(pairs, ",") {
+			req.Currencies = append(req.Currencies, currency.NewPairFromString(p))
+		}
+	}
+
+	var err error
+	if start := q.Get("start"); start != "" {
+		req.StartTicks, err = time.Parse(time.RFC3339, start)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if end := q.Get("end"); end != "" {
+		req.EndTicks, err = time.Parse(time.RFC3339, end)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return req, nil
+}
+
+// RESTGetOrders returns the orders recorded by the order manager for an
+// exchange, filtered by the credentialSet, pair, side, status and active query
+// parameters
+func (e *Engine) RESTGetOrders(w http.ResponseWriter, r *http.Request) {
+	if !e.orderManager.IsRunning() {
+		RESTfulErrorResponse(w, r, http.StatusServiceUnavailable,
+			ErrSubsystemNotStarted)
+		return
+	}
+
+	exch, err := e.getRESTExchange(r, false)
+	if err != nil {
+		RESTfulErrorResponse(w, r, restErrorStatus(err), err)
+		return
+	}
+
+	q := r.URL.Query()
+	filter := OrderFilter{
+		Exchange:      exch.GetName(),
+		CredentialSet: q.Get("credentialSet"),
+		Side:          exchange.OrderSide(common.StringToUpper(q.Get("side"))),
+		Status:        exchange.OrderStatus(common.StringToUpper(q.Get("status"))),
+		ActiveOnly:    q.Get("active") == "true",
+	}
+	if p := q.Get("pair"); p != "" {
+		filter.Pair = currency.NewPairFromString(p)
+	}
+
+	orders := e.orderManager.GetOrders(&filter)
+	if orders == nil {
+		orders = []Order{}
+	}
+
+	err = RESTfulJSONResponse(w, orders)
+	if err != nil {
+		RESTfulError(r.Method, err)
+	}
+}
+
+// RESTSubmitOrder submits an order through the order manager and returns the
+// recorded order
+func (e *Engine) RESTSubmitOrder(w http.ResponseWriter, r *http.Request) {
+	var req RESTSubmitOrderRequest
+	err := json.NewDecoder(r.Body).Decode(&req)
+	if err != nil {
+		RESTfulErrorResponse(w, r, http.StatusBadRequest, err)
+		return
+	}
+
+	if req.Pair == "" || req.Side == "" || req.OrderType == "" || req.Amount <= 0 {
+		RESTfulErrorResponse(w, r, http.StatusBadRequest, errInvalidArguments)
+		return
+	}
+
+	exch, err := e.getRESTExchange(r, true)
+	if err != nil {
+		RESTfulErrorResponse(w, r, restErrorStatus(err), err)
+		return
+	}
+
+	ord, err := e.orderManager.SubmitContext(r.Context(), &OrderSubmission{
+		Exchange:      exch.GetName(),
+		CredentialSet: r.URL.Query().Get("credentialSet"),
+		Pair:          currency.NewPairFromString(req.Pair),
+		AssetType:     asset.Item(req.AssetType),
+		Side:          exchange.OrderSide(common.StringToUpper(req.Side)),
+		Type:          exchange.OrderType(common.StringToUpper(req.OrderType)),
+		Amount:        req.Amount,
+		Price:         req.Price,
+		ClientID:      req.ClientID,
+	})
+	if err != nil {
+		RESTfulErrorResponse(w, r, restErrorStatus(err), err)
+		return
+	}
+
+	err = RESTfulJSONStatusResponse(w, http.StatusCreated, ord)
+	if err != nil {
+		RESTfulError(r.Method, err)
+	}
+}
+
+// RESTCancelOrder cancels an order by its internal or exchange order ID. Orders
+// unknown to the order manager, or placed with a different credential set,
+// are cancelled directly on the credentialSet exchange instance using the
+// pair and side query parameters
+func (e *Engine) RESTCancelOrder(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	exch, err := e.getRESTExchange(r, true)
+	if err != nil {
+		RESTfulErrorResponse(w, r, restErrorStatus(err), err)
+		return
+	}
+
+	if !exch.GetFeatures().REST.CancelOrder {
+		RESTfulErrorResponse(w, r, http.StatusNotImplemented,
+			common.ErrFunctionNotSupported)
+		return
+	}
+
+	orderID := mux.Vars(r)["orderID"]
+	ord, err := e.orderManager.GetExchangeOrder(exch.GetName(), orderID)
+	if err == nil && e.orderManager.IsRunning() &&
+		sameCredentialSet(ord.CredentialSet, q.Get("credentialSet")) {
+		err = e.orderManager.CancelContext(r.Context(), ord.ID)
+	} else {
+		err = exchange.CancelOrderContext(r.Context(), exch, &exchange.OrderCancellation{
+			OrderID:      orderID,
+			Side:         exchange.OrderSide(common.StringToUpper(q.Get("side"))),
+			CurrencyPair: currency.NewPairFromString(q.Get("pair")),
+		})
+	}
+	if err != nil {
+		RESTfulErrorResponse(w, r, restErrorStatus(err), err)
+		return
+	}
+
+	err = RESTfulJSONResponse(w, RESTGenericResponse{Status: "success"})
+	if err != nil {
+		RESTfulError(r.Method, err)
+	}
+}
+
+// RESTCancelAllOrders cancels all open orders on an exchange, optionally only
+// for the pair query parameter
+func (e *Engine) RESTCancelAllOrders(w http.ResponseWriter, r *http.Request) {
+	exch, err := e.getRESTExchange(r, true)
+	if err != nil {
+		RESTfulErrorResponse(w, r, restErrorStatus(err), err)
+		return
+	}
+
+	if !exch.GetFeatures().REST.CancelOrders {
+		RESTfulErrorResponse(w, r, http.StatusNotImplemented,
+			common.ErrFunctionNotSupported)
+		return
+	}
+
+	q := r.URL.Query()
+	var p currency.Pair
+	if pair := q.Get("pair"); pair != "" {
+		p = currency.NewPairFromString(pair)
+	}
+
+	if e.orderManager.IsRunning() {
+		err = e.orderManager.CancelAllContext(r.Context(), exch.GetName(),
+			q.Get("credentialSet"), p)
+	} else {
+		var resp exchange.CancelAllOrdersResponse
+		resp, err = exchange.CancelAllOrdersContext(r.Context(), exch, &exchange.OrderCancellation{
+			CurrencyPair: p,
+		})
+		if err == nil && len(resp.OrderStatus) > 0 {
+			err = fmt.Errorf("%d orders failed to cancel on %s",
+				len(resp.OrderStatus), exch.GetName())
+		}
+	}
+	if err != nil {
+		RESTfulErrorResponse(w, r, restErrorStatus(err), err)
+		return
+	}
+
+	err = RESTfulJSONResponse(w, RESTGenericResponse{Status: "success"})
+	if err != nil {
+		RESTfulError(r.Method, err)
+	}
+}
+
+// RESTGetOrder returns an order by its exchange order ID
+func (e *Engine) RESTGetOrder(w http.ResponseWriter, r *http.Request) {
+	exch, err := e.getRESTExchange(r, true)
+	if err != nil {
+		RESTfulErrorResponse(w, r, restErrorStatus(err), err)
+		return
+	}
+
+	if !exch.GetFeatures().REST.GetOrder {
+		RESTfulErrorResponse(w, r, http.StatusNotImplemented,
+			common.ErrFunctionNotSupported)
+		return
+	}
+
+	ord, err := exchange.GetOrderInfoContext(r.Context(), exch,
+		mux.Vars(r)["orderID"])
+	if err != nil {
+		RESTfulErrorResponse(w, r, restErrorStatus(err), err)
+		return
+	}
+
+	err = RESTfulJSONResponse(w, ord)
+	if err != nil {
+		RESTfulError(r.Method, err)
+	}
+}
+
+// RESTGetActiveOrders returns the open orders on an exchange
+func (e *Engine) RESTGetActiveOrders(w http.ResponseWriter, r *http.Request) {
+	e.restGetExchangeOrders(w, r, true)
+}
+
+// RESTGetOrderHistory returns the closed orders on an exchange
+func (e *Engine) RESTGetOrderHistory(w http.ResponseWriter, r *http.Request) {
+	e.restGetExchangeOrders(w, r, false)
+}
+
+func (e *Engine) restGetExchangeOrders(w http.ResponseWriter, r *http.Request, active bool) {
+	req, err := restOrdersRequest(r)
+	if err != nil {
+		RESTfulErrorResponse(w, r, http.StatusBadRequest, err)
+		return
+	}
+
+	exch, err := e.getRESTExchange(r, true)
+	if err != nil {
+		RESTfulErrorResponse(w, r, restErrorStatus(err), err)
+		return
+	}
+
+	features := exch.GetFeatures()
+	if (active && !features.REST.ActiveOrders) ||
+		(!active && !features.REST.OrderHistory) {
+		RESTfulErrorResponse(w, r, http.StatusNotImplemented,
+			common.ErrFunctionNotSupported)
+		return
+	}
+
+	var orders []exchange.OrderDetail
+	if active {
+		orders, err = exchange.GetActiveOrdersContext(r.Context(), exch, req)
+	} else {
+		orders, err = exchange.GetOrderHistoryContext(r.Context(), exch, req)
+	}
+	if err != nil {
+		RESTfulErrorResponse(w, r, restErrorStatus(err), err)
+		return
+	}
+
+	if orders == nil {
+		orders = []exchange.OrderDetail{}
+	}
+
+	err = RESTfulJSONResponse(w, orders)
+	if err != nil {
+		RESTfulError(r.Method, err)
+	}
+}
+
+// RESTGetTradeHistory returns the recent trades on an exchange for a
+// currency pair and asset type, or the trades between the start and end query
+// parameters if they are supplied
+func (e *Engine) RESTGetTradeHistory(w http.ResponseWriter, r *http.Request) {
+	exch, err := e.getRESTExchange(r, false)
+	if err != nil {
+		RESTfulErrorResponse(w, r, restErrorStatus(err), err)
+		return
+	}
+
+	q := r.URL.Query()
+	assetType, err := parseAssetType(exch, q.Get("assetType"))
+	if err != nil {
+		RESTfulErrorResponse(w, r, restErrorStatus(err), err)
+		return
+	}
+
+	historic := q.Get("start") != "" || q.Get("end") != ""
+	features := exch.GetFeatures()
+	if (historic && !features.REST.HistoricTrades) ||
+		(!historic && !features.REST.TradeFetching) {
+		RESTfulErrorResponse(w, r, http.StatusNotImplemented,
+			common.ErrFunctionNotSupported)
+		return
+	}
+
+	p := currency.NewPairFromString(mux.Vars(r)["currency"])
+	var trades []exchange.TradeHistory
+	if historic {
+		var start, end time.Time
+		start, err = time.Parse(time.RFC3339, q.Get("start"))
+		if err == nil {
+			end, err = time.Parse(time.RFC3339, q.Get("end"))
+		}
+		if err == nil {
+			err = exchange.CheckTimeRange(start, end)
+		}
+		if err != nil {
+			RESTfulErrorResponse(w, r, http.StatusBadRequest, err)
+			return
+		}
+		trades, err = exchange.GetHistoricTradesContext(r.Context(), exch, p,
+			assetType, start, end)
+	} else {
+		trades, err = exchange.GetExchangeHistoryContext(r.Context(), exch, p,
+			assetType)
+	}
+	if err != nil {
+		RESTfulErrorResponse(w, r, restErrorStatus(err), err)
+		return
+	}
+
+	if trades == nil {
+		trades = []exchange.TradeHistory{}
+	}
+
+	err = RESTfulJSONResponse(w, trades)
+	if err != nil {
+		RESTfulError(r.Method, err)
+	}
+}
+
+// RESTGetHistoricCandles returns the candles on an exchange for a currency
+// pair and asset type between the start and end query parameters at the
+// interval query parameter e.g. 15m, 1h or 1d
+func (e *Engine) RESTGetHistoricCandles(w http.ResponseWriter, r *http.Request) {
+	exch, err := e.getRESTExchange(r, false)
+	if err != nil {
+		RESTfulErrorResponse(w, r, restErrorStatus(err), err)
+		return
+	}
+
+	if !exch.GetFeatures().REST.KlineFetching {
+		RESTfulErrorResponse(w, r, http.StatusNotImplemented,
+			common.ErrFunctionNotSupported)
+		return
+	}
+
+	q := r.URL.Query()
+	assetType, err := parseAssetType(exch, q.Get("assetType"))
+	if err != nil {
+		RESTfulErrorResponse(w, r, restErrorStatus(err), err)
+		return
+	}
+
+	interval, err := kline.ParseInterval(q.Get("interval"))
+	if err != nil {
+		RESTfulErrorResponse(w, r, http.StatusBadRequest, err)
+		return
+	}
+
+	start, err := time.Parse(time.RFC3339, q.Get("start"))
+	if err != nil {
+		RESTfulErrorResponse(w, r, http.StatusBadRequest, err)
+		return
+	}
+
+	end := time.Now()
+	if q.Get("end") != "" {
+		end, err = time.Parse(time.RFC3339, q.Get("end"))
+		if err != nil {
+			RESTfulErrorResponse(w, r, http.StatusBadRequest, err)
+			return
+		}
+	}
+
+	item, err := exchange.GetHistoricCandlesContext(r.Context(), exch,
+		currency.NewPairFromString(mux.Vars(r)["currency"]), assetType, start,
+		end, interval)
+	if err != nil {
+		RESTfulErrorResponse(w, r, restErrorStatus(err), err)
+		return
+	}
+
+	if item.Candles == nil {
+		item.Candles = []kline.Candle{}
+	}
+
+	err = RESTfulJSONResponse(w, item)
+	if err != nil {
+		RESTfulError(r.Method, err)
+	}
+}
+
+// RESTGetFundingHistory returns the deposit and withdrawal history on an
+// exchange
+func (e *Engine) RESTGetFundingHistory(w http.ResponseWriter, r *http.Request) {
+	exch, err := e.getRESTExchange(r, true)
+	if err != nil {
+		RESTfulErrorResponse(w, r, restErrorStatus(err), err)
+		return
+	}
+
+	if !exch.GetFeatures().REST.FundingHistory {
+		RESTfulErrorResponse(w, r, http.StatusNotImplemented,
+			common.ErrFunctionNotSupported)
+		return
+	}
+
+	history, err := exchange.GetFundingHistoryContext(r.Context(), exch)
+	if err != nil {
+		RESTfulErrorResponse(w, r, restErrorStatus(err), err)
+		return
+	}
+
+	if history == nil {
+		history = []exchange.FundHistory{}
+	}
+
+	err = RESTfulJSONResponse(w, history)
+	if err != nil {
+		RESTfulError(r.Method, err)
+	}
+}
+
+// RESTGetRequestStats returns the HTTP request and retry counters of an
+// exchange
+func (e *Engine) RESTGetRequestStats(w http.ResponseWriter, r *http.Request) {
+	exch, err := e.getRESTExchange(r, false)
+	if err != nil {
+		RESTfulErrorResponse(w, r, restErrorStatus(err), err)
+		return
+	}
+
+	err = RESTfulJSONResponse(w, exch.GetRequestStats())
+	if err != nil {
+		RESTfulError(r.Method, err)
+	}
+}
+
+// RESTGetOrderbookStats returns the websocket orderbook update counters of
+// an exchange, including its checksum mismatches, sequence gaps and resyncs
+func (e *Engine) RESTGetOrderbookStats(w http.ResponseWriter, r *http.Request) {
+	exch, err := e.getRESTExchange(r, false)
+	if err != nil {
+		RESTfulErrorResponse(w, r, restErrorStatus(err), err)
+		return
+	}
+
+	ws, err := exch.GetWebsocket()
+	if err == nil && ws == nil {
+		err = common.ErrFunctionNotSupported
+	}
+	if err != nil {
+		RESTfulErrorResponse(w, r, restErrorStatus(err), err)
+		return
+	}
+
+	err = RESTfulJSONResponse(w, ws.Orderbook.GetStats())
+	if err != nil {
+		RESTfulError(r.Method, err)
+	}
+}
+
+// RESTGetExchangeFeatures returns the operations an exchange supports over
+// REST and websocket and the order types it accepts
+func (e *Engine) RESTGetExchangeFeatures(w http.ResponseWriter, r *http.Request) {
+	exch, err := e.getRESTExchange(r, false)
+	if err != nil {
+		RESTfulErrorResponse(w, r, restErrorStatus(err), err)
+		return
+	}
+
+	err = RESTfulJSONResponse(w, exch.GetFeatures())
+	if err != nil {
+		RESTfulError(r.Method, err)
+	}
+}
+
+// RESTGetSyncStatus returns the market data sync status of each enabled
+// currency pair of the exchange, or of every exchange
+func (e *Engine) RESTGetSyncStatus(w http.ResponseWriter, r *http.Request) {
+	status, err := e.GetSyncStatus(mux.Vars(r)["exchangeName"])
+	if err != nil {
+		RESTfulErrorResponse(w, r, restErrorStatus(err), err)
+		return
+	}
+
+	err = RESTfulJSONResponse(w, status)
+	if err != nil {
+		RESTfulError(r.Method, err)
+	}
+}
+
+// RESTGetDepositAddress returns a deposit address on an exchange for a
+// cryptocurrency, optionally for the accountID query parameter
+func (e *Engine) RESTGetDepositAddress(w http.ResponseWriter, r *http.Request) {
+	exch, err := e.getRESTExchange(r, true)
+	if err != nil {
+		RESTfulErrorResponse(w, r, restErrorStatus(err), err)
+		return
+	}
+
+	if !exch.GetFeatures().REST.DepositAddress {
+		RESTfulErrorResponse(w, r, http.StatusNotImplemented,
+			common.ErrFunctionNotSupported)
+		return
+	}
+
+	code := currency.NewCode(mux.Vars(r)["currency"])
+	address, err := exchange.GetDepositAddressContext(r.Context(), exch, code,
+		r.URL.Query().Get("accountID"))
+	if err != nil {
+		RESTfulErrorResponse(w, r, restErrorStatus(err), err)
+		return
+	}
+
+	err = RESTfulJSONResponse(w, RESTDepositAddressResponse{
+		Currency: code.String(),
+		Address:  address,
+	})
+	if err != nil {
+		RESTfulError(r.Method, err)
+	}
+}
+
+// RESTWithdrawCryptocurrencyFunds withdraws cryptocurrency from an exchange to
+// an address
+func (e *Engine) RESTWithdrawCryptocurrencyFunds(w http.ResponseWriter, r *http.Request) {
+	var req RESTWithdrawRequest
+	err := json.NewDecoder(r.Body).Decode(&req)
+	if err != nil {
+		RESTfulErrorResponse(w, r, http.StatusBadRequest, err)
+		return
+	}
+
+	if req.Address == "" || req.Currency == "" || req.Amount <= 0 {
+		RESTfulErrorResponse(w, r, http.StatusBadRequest, errInvalidArguments)
+		return
+	}
+
+	exch, err := e.getRESTExchange(r, true)
+	if err != nil {
+		RESTfulErrorResponse(w, r, restErrorStatus(err), err)
+		return
+	}
+
+	if !exch.GetFeatures().REST.CryptoWithdrawal {
+		RESTfulErrorResponse(w, r, http.StatusNotImplemented,
+			common.ErrFunctionNotSupported)
+		return
+	}
+
+	id, err := exchange.WithdrawCryptocurrencyFundsContext(r.Context(), exch,
+		&exchange.WithdrawRequest{
+			Currency:    currency.NewCode(req.Currency),
+			Address:     req.Address,
+			AddressTag:  req.AddressTag,
+			Amount:      req.Amount,
+			FeeAmount:   req.Fee,
+			Description: req.Description,
+		})
+	if err != nil {
+		RESTfulErrorResponse(w, r, restErrorStatus(err), err)
+		return
+	}
+
+	err = RESTfulJSONResponse(w, RESTWithdrawResponse{ID: id})
+	if err != nil {
+		RESTfulError(r.Method, err)
+	}
+}
+
+// RESTWithdrawFiatFunds withdraws fiat from an exchange to the client bank
+// account configured for the exchange and currency
+func (e *Engine) RESTWithdrawFiatFunds(w http.ResponseWriter, r *http.Request) {
+	var req RESTWithdrawRequest
+	err := json.NewDecoder(r.Body).Decode(&req)
+	if err != nil {
+		RESTfulErrorResponse(w, r, http.StatusBadRequest, err)
+		return
+	}
+
+	if req.Currency == "" || req.Amount <= 0 {
+		RESTfulErrorResponse(w, r, http.StatusBadRequest, errInvalidArguments)
+		return
+	}
+
+	exch, err := e.getRESTExchange(r, true)
+	if err != nil {
+		RESTfulErrorResponse(w, r, restErrorStatus(err), err)
+		return
+	}
+
+	if !exch.GetFeatures().REST.FiatWithdrawal {
+		RESTfulErrorResponse(w, r, http.StatusNotImplemented,
+			common.ErrFunctionNotSupported)
+		return
+	}
+
+	bank, err := e.Config.GetClientBankAccounts(exch.GetName(),
+		common.StringToUpper(req.Currency))
+	if err != nil {
+		RESTfulErrorResponse(w, r, http.StatusBadRequest, err)
+		return
+	}
+
+	id, err := exchange.WithdrawFiatFundsContext(r.Context(), exch,
+		&exchange.WithdrawRequest{
+			Currency:        currency.NewCode(req.Currency),
+			Amount:          req.Amount,
+			Description:     req.Description,
+			BankAccountName: bank.AccountName,
+			BankName:        bank.BankName,
+			BankAddress:     bank.BankAddress,
+			SwiftCode:       bank.SWIFTCode,
+			IBAN:            bank.IBAN,
+		})
+	if err != nil {
+		RESTfulErrorResponse(w, r, restErrorStatus(err), err)
+		return
+	}
+
+	err = RESTfulJSONResponse(w, RESTWithdrawResponse{ID: id})
+	if err != nil {
+		RESTfulError(r.Method, err)
+	}
+}
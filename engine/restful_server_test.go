@@ -0,0 +1,504 @@
+package engine
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
+)
+
+func loadConfig(t *testing.T) *config.Config {
+	cfg := new(config.Config)
+	err := cfg.LoadConfig(config.ConfigTestFile)
+	if err != nil {
+		t.Error("Test failed. GetCurrencyConfig LoadConfig error", err)
+	}
+	return cfg
+}
+
+func makeHTTPGetRequest(t *testing.T, response interface{}) *http.Response {
+	w := httptest.NewRecorder()
+
+	err := RESTfulJSONResponse(w, response)
+	if err != nil {
+		t.Error("Test failed. Failed to make response.", err)
+	}
+	return w.Result()
+}
+
+// TestConfigAllJsonResponse test if config/all restful json response is valid
+func TestConfigAllJsonResponse(t *testing.T) {
+	cfg := loadConfig(t)
+	resp := makeHTTPGetRequest(t, cfg)
+	body, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		t.Error("Test failed. Body not readable", err)
+	}
+
+	var responseConfig config.Config
+	jsonErr := json.Unmarshal(body, &responseConfig)
+	if jsonErr != nil {
+		t.Error("Test failed. Response not parseable as json", err)
+	}
+
+	if reflect.DeepEqual(responseConfig, cfg) {
+		t.Error("Test failed. Json not equal to config")
+	}
+}
+
+func TestInvalidHostRequest(t *testing.T) {
+	req, err := http.NewRequest(http.MethodGet, "/config/all", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Host = "invalidsite.com"
+
+	e := &Engine{Config: loadConfig(t)}
+	resp := httptest.NewRecorder()
+	e.NewRouter().ServeHTTP(resp, req)
+
+	if status := resp.Code; status != http.StatusNotFound {
+		t.Errorf("Test failed. Response returned wrong status code expected %v got %v", http.StatusNotFound, status)
+	}
+}
+
+func TestValidHostRequest(t *testing.T) {
+	req, err := http.NewRequest(http.MethodGet, "/config/all", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Host = "localhost:9050"
+
+	e := &Engine{Config: loadConfig(t)}
+	req.SetBasicAuth(e.Config.Webserver.AdminUsername,
+		e.Config.Webserver.AdminPassword)
+	resp := httptest.NewRecorder()
+	e.NewRouter().ServeHTTP(resp, req)
+
+	if status := resp.Code; status != http.StatusOK {
+		t.Errorf("Test failed. Response returned wrong status code expected %v got %v", http.StatusOK, status)
+	}
+}
+
+func makeAuthRequest(t *testing.T, e *Engine, method, url string, body interface{}) *httptest.ResponseRecorder {
+	var data []byte
+	if body != nil {
+		var err error
+		data, err = json.Marshal(body)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	req, err := http.NewRequest(method, url, bytes.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Host = e.Config.Webserver.ListenAddress
+	req.SetBasicAuth(e.Config.Webserver.AdminUsername,
+		e.Config.Webserver.AdminPassword)
+
+	resp := httptest.NewRecorder()
+	e.NewRouter().ServeHTTP(resp, req)
+	return resp
+}
+
+func TestRESTErrorStatus(t *testing.T) {
+	tests := map[error]int{
+		errInvalidArguments:             http.StatusBadRequest,
+		ErrOrderTypeNotSupported:        http.StatusBadRequest,
+		kline.ErrUnsupportedInterval:    http.StatusBadRequest,
+		ErrAuthenticationNotOn:          http.StatusForbidden,
+		ErrExchangeNotFound:             http.StatusNotFound,
+		ErrCredentialSetNotFound:        http.StatusNotFound,
+		ErrOrderNotFound:                http.StatusNotFound,
+		errExchangeNotEnabled:           http.StatusConflict,
+		ErrOrderAlreadyClosed:           http.StatusConflict,
+		orderbook.ErrNoLiquidity:        http.StatusConflict,
+		orderbook.ErrInvalidAmount:      http.StatusBadRequest,
+		common.ErrFunctionNotSupported:  http.StatusNotImplemented,
+		ErrSubsystemNotStarted:          http.StatusServiceUnavailable,
+		errors.New("exchange rejected"): http.StatusBadGateway,
+	}
+
+	for err, expected := range tests {
+		if status := restErrorStatus(err); status != expected {
+			t.Errorf("Test failed. %s: expected status %d got %d", err, expected,
+				status)
+		}
+	}
+
+	wrapped := map[error]int{
+		fmt.Errorf("cancel: %w", ErrOrderNotFound):          http.StatusNotFound,
+		fmt.Errorf("request: %w", context.DeadlineExceeded): http.StatusGatewayTimeout,
+	}
+	for err, expected := range wrapped {
+		if status := restErrorStatus(err); status != expected {
+			t.Errorf("Test failed. %s: expected status %d got %d", err, expected,
+				status)
+		}
+	}
+}
+
+func TestRESTTradingRoutes(t *testing.T) {
+	e, exch, cleanup := setupOrderManagerTest(t)
+	defer cleanup()
+	e.Config = &config.Config{
+		Webserver: config.WebserverConfig{
+			AdminUsername: "admin",
+			AdminPassword: "Password",
+			ListenAddress: "localhost:9050",
+		},
+	}
+
+	resp := makeAuthRequest(t, e, http.MethodGet, "/exchanges/OrderTest/orders", nil)
+	if resp.Code != http.StatusServiceUnavailable {
+		t.Errorf("Test failed. Expected status %d got %d",
+			http.StatusServiceUnavailable, resp.Code)
+	}
+
+	err := e.orderManager.Start()
+	if err != nil {
+		t.Fatalf("Test failed. Unable to start order manager: %s", err)
+	}
+	defer e.orderManager.Stop()
+
+	submission := RESTSubmitOrderRequest{
+		Pair:      "BTC-USD",
+		Side:      "buy",
+		OrderType: "limit",
+		Amount:    1,
+		Price:     1000,
+	}
+
+	resp = makeAuthRequest(t, e, http.MethodPost, "/exchanges/Unknown/orders",
+		submission)
+	if resp.Code != http.StatusNotFound {
+		t.Errorf("Test failed. Expected status %d got %d", http.StatusNotFound,
+			resp.Code)
+	}
+
+	resp = makeAuthRequest(t, e, http.MethodPost,
+		"/exchanges/OrderTest/orders?credentialSet=desk1", submission)
+	if resp.Code != http.StatusNotFound {
+		t.Errorf("Test failed. Expected status %d got %d", http.StatusNotFound,
+			resp.Code)
+	}
+
+	resp = makeAuthRequest(t, e, http.MethodPost, "/exchanges/OrderTest/orders",
+		RESTSubmitOrderRequest{Pair: "BTC-USD"})
+	if resp.Code != http.StatusBadRequest {
+		t.Errorf("Test failed. Expected status %d got %d", http.StatusBadRequest,
+			resp.Code)
+	}
+
+	var errResp RESTErrorResponse
+	err = json.NewDecoder(resp.Body).Decode(&errResp)
+	if err != nil || errResp.Error != errInvalidArguments.Error() {
+		t.Errorf("Test failed. Unexpected error response %v %v", errResp, err)
+	}
+
+	resp = makeAuthRequest(t, e, http.MethodPost, "/exchanges/OrderTest/orders",
+		submission)
+	if resp.Code != http.StatusCreated {
+		t.Fatalf("Test failed. Expected status %d got %d", http.StatusCreated,
+			resp.Code)
+	}
+
+	var ord Order
+	err = json.NewDecoder(resp.Body).Decode(&ord)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if ord.ExchangeOrderID != "exch1" || ord.Side != exchange.BuyOrderSide ||
+		ord.Status != exchange.ActiveOrderStatus {
+		t.Errorf("Test failed. Unexpected order %+v", ord)
+	}
+
+	resp = makeAuthRequest(t, e, http.MethodGet,
+		"/exchanges/OrderTest/orders?active=true", nil)
+	var orders []Order
+	err = json.NewDecoder(resp.Body).Decode(&orders)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(orders) != 1 || orders[0].ID != ord.ID {
+		t.Errorf("Test failed. Unexpected orders %+v", orders)
+	}
+
+	desk := &orderTestExchange{features: exch.features}
+	e.credentialSets = map[string][]credentialSet{
+		strings.ToLower(testOrderExchange): {{name: "desk1", exch: desk}},
+	}
+	resp = makeAuthRequest(t, e, http.MethodDelete,
+		"/exchanges/OrderTest/orders/exch1?credentialSet=desk1&pair=BTC-USD", nil)
+	if resp.Code != http.StatusOK {
+		t.Errorf("Test failed. Expected status %d got %d", http.StatusOK,
+			resp.Code)
+	}
+
+	if len(desk.cancelled) != 1 || len(exch.cancelled) != 0 {
+		t.Errorf("Test failed. Expected the credential set to cancel the order %v %v",
+			desk.cancelled, exch.cancelled)
+	}
+
+	resp = makeAuthRequest(t, e, http.MethodDelete,
+		"/exchanges/OrderTest/orders/exch1", nil)
+	if resp.Code != http.StatusOK {
+		t.Errorf("Test failed. Expected status %d got %d", http.StatusOK,
+			resp.Code)
+	}
+
+	if len(exch.cancelled) != 1 || exch.cancelled[0] != "exch1" {
+		t.Errorf("Test failed. Unexpected cancelled orders %v", exch.cancelled)
+	}
+
+	resp = makeAuthRequest(t, e, http.MethodDelete,
+		"/exchanges/OrderTest/orders/"+ord.ID, nil)
+	if resp.Code != http.StatusConflict {
+		t.Errorf("Test failed. Expected status %d got %d", http.StatusConflict,
+			resp.Code)
+	}
+
+	resp = makeAuthRequest(t, e, http.MethodGet,
+		"/exchanges/OrderTest/orders/history?start=yesterday", nil)
+	if resp.Code != http.StatusBadRequest {
+		t.Errorf("Test failed. Expected status %d got %d", http.StatusBadRequest,
+			resp.Code)
+	}
+
+	resp = makeAuthRequest(t, e, http.MethodGet,
+		"/exchanges/OrderTest/trades/BTC-USD?start=2019-01-02T00:00:00Z&end=2019-01-01T00:00:00Z",
+		nil)
+	if resp.Code != http.StatusBadRequest {
+		t.Errorf("Test failed. Expected status %d got %d", http.StatusBadRequest,
+			resp.Code)
+	}
+
+	resp = makeAuthRequest(t, e, http.MethodGet,
+		"/exchanges/OrderTest/candles/BTC-USD?start=2019-01-01T00:00:00Z&interval=2m",
+		nil)
+	if resp.Code != http.StatusBadRequest {
+		t.Errorf("Test failed. Expected status %d got %d", http.StatusBadRequest,
+			resp.Code)
+	}
+
+	resp = makeAuthRequest(t, e, http.MethodGet, "/exchanges/OrderTest/funding",
+		nil)
+	if resp.Code != http.StatusNotImplemented {
+		t.Errorf("Test failed. Expected status %d got %d",
+			http.StatusNotImplemented, resp.Code)
+	}
+
+	resp = makeAuthRequest(t, e, http.MethodGet, "/exchanges/OrderTest/requests",
+		nil)
+	var stats request.Stats
+	err = json.NewDecoder(resp.Body).Decode(&stats)
+	if err != nil || stats.Requests != 3 || stats.Retries != 1 {
+		t.Errorf("Test failed. Unexpected request stats %+v %v", stats, err)
+	}
+
+	resp = makeAuthRequest(t, e, http.MethodPost,
+		"/exchanges/OrderTest/withdraw/crypto", RESTWithdrawRequest{Currency: "BTC"})
+	if resp.Code != http.StatusBadRequest {
+		t.Errorf("Test failed. Expected status %d got %d", http.StatusBadRequest,
+			resp.Code)
+	}
+}
+
+func TestRESTGetExchangeFeatures(t *testing.T) {
+	e, exch, cleanup := setupOrderManagerTest(t)
+	defer cleanup()
+	e.Config = &config.Config{
+		Webserver: config.WebserverConfig{
+			AdminUsername: "admin",
+			AdminPassword: "Password",
+			ListenAddress: "localhost:9050",
+		},
+	}
+
+	resp := makeAuthRequest(t, e, http.MethodGet,
+		"/exchanges/OrderTest/features", nil)
+	var features exchange.Features
+	err := json.NewDecoder(resp.Body).Decode(&features)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !features.REST.SubmitOrder || features.REST.FundingHistory ||
+		!features.SupportsOrderType(exchange.MarketOrderType) {
+		t.Errorf("Test failed. Unexpected features %+v", features)
+	}
+
+	// Unsupported operations are rejected before reaching the exchange
+	exch.features.REST.KlineFetching = false
+	resp = makeAuthRequest(t, e, http.MethodGet,
+		"/exchanges/OrderTest/candles/BTC-USD?start=2019-01-01T00:00:00Z&interval=1h",
+		nil)
+	if resp.Code != http.StatusNotImplemented {
+		t.Errorf("Test failed. Expected status %d got %d",
+			http.StatusNotImplemented, resp.Code)
+	}
+}
+
+func TestRESTGetSyncStatus(t *testing.T) {
+	e, _, cleanup := setupOrderManagerTest(t)
+	defer cleanup()
+	e.Config = &config.Config{
+		Webserver: config.WebserverConfig{
+			AdminUsername: "admin",
+			AdminPassword: "Password",
+			ListenAddress: "localhost:9050",
+		},
+	}
+
+	resp := makeAuthRequest(t, e, http.MethodGet, "/sync", nil)
+	if resp.Code != http.StatusServiceUnavailable {
+		t.Errorf("Test failed. Expected status %d got %d",
+			http.StatusServiceUnavailable, resp.Code)
+	}
+
+	err := e.syncManager.Start()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer e.syncManager.Stop()
+
+	resp = makeAuthRequest(t, e, http.MethodGet, "/exchanges/OrderTest/sync", nil)
+	var status []SyncStatus
+	err = json.NewDecoder(resp.Body).Decode(&status)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// One enabled pair for each of the spot and margin asset types
+	if len(status) != 2 || status[1].AssetType != asset.Margin ||
+		status[0].Ticker.Source != SyncSourceREST || status[0].Ticker.Synced {
+		t.Errorf("Test failed. Unexpected sync status %+v", status)
+	}
+}
+
+func TestRESTGetOrderbookStats(t *testing.T) {
+	e, _, cleanup := setupOrderManagerTest(t)
+	defer cleanup()
+	e.Config = &config.Config{
+		Webserver: config.WebserverConfig{
+			AdminUsername: "admin",
+			AdminPassword: "Password",
+			ListenAddress: "localhost:9050",
+		},
+	}
+
+	resp := makeAuthRequest(t, e, http.MethodGet,
+		"/exchanges/OrderTest/orderbook/stats", nil)
+	if resp.Code != http.StatusNotImplemented {
+		t.Errorf("Test failed. Expected status %d got %d",
+			http.StatusNotImplemented, resp.Code)
+	}
+}
+
+func TestRESTGetOrderbookAnalytics(t *testing.T) {
+	e, _, cleanup := setupOrderManagerTest(t)
+	defer cleanup()
+	e.Config = &config.Config{
+		Webserver: config.WebserverConfig{
+			AdminUsername: "admin",
+			AdminPassword: "Password",
+			ListenAddress: "localhost:9050",
+		},
+	}
+
+	ob := orderbook.Base{
+		ExchangeName: testOrderExchange,
+		Pair:         currency.NewPairFromStrings("BTC", "USD"),
+		AssetType:    asset.Spot,
+		Bids:         []orderbook.Item{{Price: 99, Amount: 1}, {Price: 98, Amount: 2}},
+		Asks:         []orderbook.Item{{Price: 102, Amount: 2}, {Price: 101, Amount: 3}},
+	}
+	err := ob.Process()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resp := makeAuthRequest(t, e, http.MethodGet,
+		"/exchanges/OrderTest/orderbook/analytics/BTC-USD?amount=4&percent=2", nil)
+	if resp.Code != http.StatusOK {
+		t.Fatalf("Test failed. Expected status %d got %d %s",
+			http.StatusOK, resp.Code, resp.Body.String())
+	}
+
+	var result OrderbookAnalytics
+	err = json.Unmarshal(resp.Body.Bytes(), &result)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.BestAsk != 101 || result.MidPrice != 100 || result.SpreadBPS != 200 ||
+		result.Buy == nil || result.Buy.AveragePrice != 101.25 ||
+		result.Sell == nil || result.Sell.FullyFilled ||
+		result.Liquidity == nil || result.Liquidity.AskAmount != 5 {
+		t.Errorf("Test failed. Unexpected analytics %+v", result)
+	}
+
+	tests := map[string]int{
+		"/exchanges/OrderTest/orderbook/analytics/BTC-USD?amount=abc":   http.StatusBadRequest,
+		"/exchanges/OrderTest/orderbook/analytics/BTC-USD?percent=-1":   http.StatusBadRequest,
+		"/exchanges/OrderTest/orderbook/analytics/BTC-USD?assetType=fx": http.StatusBadRequest,
+		"/exchanges/Unknown/orderbook/analytics/BTC-USD":                http.StatusNotFound,
+	}
+	for path, status := range tests {
+		resp = makeAuthRequest(t, e, http.MethodGet, path, nil)
+		if resp.Code != status {
+			t.Errorf("Test failed. %s expected status %d got %d", path,
+				status, resp.Code)
+		}
+	}
+}
+
+func TestRESTTradingRoutesRequireAuth(t *testing.T) {
+	e := &Engine{Config: &config.Config{
+		Webserver: config.WebserverConfig{
+			AdminUsername: "admin",
+			AdminPassword: "Password",
+			ListenAddress: "localhost:9050",
+		},
+	}}
+
+	routes := map[string]string{
+		"/exchanges/OrderTest/orders": http.MethodPost,
+		"/config/all":                 http.MethodGet,
+		"/config/all/save":            http.MethodPost,
+	}
+	for path, method := range routes {
+		req, err := http.NewRequest(method, path, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		req.Host = e.Config.Webserver.ListenAddress
+
+		resp := httptest.NewRecorder()
+		e.NewRouter().ServeHTTP(resp, req)
+		if resp.Code != http.StatusUnauthorized {
+			t.Errorf("Test failed. %s expected status %d got %d", path,
+				http.StatusUnauthorized, resp.Code)
+		}
+	}
+}
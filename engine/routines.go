@@ -0,0 +1,695 @@
+package engine
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/stats"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/websocket/wshandler"
+	"github.com/thrasher-corp/gocryptotrader/gctscript"
+	log "github.com/thrasher-corp/gocryptotrader/logger"
+)
+
+const (
+	// restUpdateDelay is the default delay between each REST ticker and
+	// orderbook fetch cycle
+	restUpdateDelay = time.Second * 10
+	// websocketShutdownTimeout is the maximum time to wait for the websocket
+	// routines to shutdown
+	websocketShutdownTimeout = time.Second * 5
+	// orderbookStageInterval is the minimum delay between staging the
+	// orderbook summary of a websocket orderbook for the communication
+	// mediums
+	orderbookStageInterval = time.Second * 10
+)
+
+func (e *Engine) printCurrencyFormat(price float64) string {
+	displaySymbol, err := currency.GetSymbolByCurrencyName(e.Config.Currency.FiatDisplayCurrency)
+	if err != nil {
+		log.Errorf("Failed to get display symbol: %s", err)
+	}
+
+	return fmt.Sprintf("%s%.8f", displaySymbol, price)
+}
+
+func (e *Engine) printConvertCurrencyFormat(origCurrency currency.Code, origPrice float64) string {
+	displayCurrency := e.Config.Currency.FiatDisplayCurrency
+	conv, err := currency.ConvertCurrency(origPrice,
+		origCurrency,
+		displayCurrency)
+	if err != nil {
+		log.Errorf("Failed to convert currency: %s", err)
+	}
+
+	displaySymbol, err := currency.GetSymbolByCurrencyName(displayCurrency)
+	if err != nil {
+		log.Errorf("Failed to get display symbol: %s", err)
+	}
+
+	origSymbol, err := currency.GetSymbolByCurrencyName(origCurrency)
+	if err != nil {
+		log.Errorf("Failed to get original currency symbol for %s: %s",
+			origCurrency,
+			err)
+	}
+
+	return fmt.Sprintf("%s%.2f %s (%s%.2f %s)",
+		displaySymbol,
+		conv,
+		displayCurrency,
+		origSymbol,
+		origPrice,
+		origCurrency,
+	)
+}
+
+// formatCurrency formats a currency pair in the display format of the config
+func (e *Engine) formatCurrency(p currency.Pair) currency.Pair {
+	return p.Format(e.Config.Currency.CurrencyPairFormat.Delimiter,
+		e.Config.Currency.CurrencyPairFormat.Uppercase)
+}
+
+func (e *Engine) printTickerSummary(result *ticker.Price, p currency.Pair, assetType asset.Item, exchangeName string, err error) {
+	if err != nil {
+		log.Errorf("Failed to get %s %s ticker. Error: %s",
+			p.String(),
+			exchangeName,
+			err)
+		return
+	}
+
+	stats.Add(exchangeName, p, assetType, result.Last, result.Volume)
+	if p.Quote.IsFiatCurrency() &&
+		p.Quote != e.Config.Currency.FiatDisplayCurrency {
+		origCurrency := p.Quote.Upper()
+		log.Infof("%s %s %s: TICKER: Last %s Ask %s Bid %s High %s Low %s Volume %.8f",
+			exchangeName,
+			e.formatCurrency(p).String(),
+			assetType,
+			e.printConvertCurrencyFormat(origCurrency, result.Last),
+			e.printConvertCurrencyFormat(origCurrency, result.Ask),
+			e.printConvertCurrencyFormat(origCurrency, result.Bid),
+			e.printConvertCurrencyFormat(origCurrency, result.High),
+			e.printConvertCurrencyFormat(origCurrency, result.Low),
+			result.Volume)
+	} else {
+		if p.Quote.IsFiatCurrency() &&
+			p.Quote == e.Config.Currency.FiatDisplayCurrency {
+			log.Infof("%s %s %s: TICKER: Last %s Ask %s Bid %s High %s Low %s Volume %.8f",
+				exchangeName,
+				e.formatCurrency(p).String(),
+				assetType,
+				e.printCurrencyFormat(result.Last),
+				e.printCurrencyFormat(result.Ask),
+				e.printCurrencyFormat(result.Bid),
+				e.printCurrencyFormat(result.High),
+				e.printCurrencyFormat(result.Low),
+				result.Volume)
+		} else {
+			log.Infof("%s %s %s: TICKER: Last %.8f Ask %.8f Bid %.8f High %.8f Low %.8f Volume %.8f",
+				exchangeName,
+				e.formatCurrency(p).String(),
+				assetType,
+				result.Last,
+				result.Ask,
+				result.Bid,
+				result.High,
+				result.Low,
+				result.Volume)
+		}
+	}
+}
+
+func (e *Engine) printOrderbookSummary(result *orderbook.Base, p currency.Pair, assetType asset.Item, exchangeName string, err error) {
+	if err != nil {
+		log.Errorf("Failed to get %s %s orderbook of type %s. Error: %s",
+			p,
+			exchangeName,
+			assetType,
+			err)
+		return
+	}
+
+	bidsAmount, bidsValue := result.TotalBidsAmount()
+	asksAmount, asksValue := result.TotalAsksAmount()
+
+	if p.Quote.IsFiatCurrency() &&
+		p.Quote != e.Config.Currency.FiatDisplayCurrency {
+		origCurrency := p.Quote.Upper()
+		log.Infof("%s %s %s: ORDERBOOK: Bids len: %d Amount: %f %s. Total value: %s Asks len: %d Amount: %f %s. Total value: %s",
+			exchangeName,
+			e.formatCurrency(p).String(),
+			assetType,
+			len(result.Bids),
+			bidsAmount,
+			p.Base.String(),
+			e.printConvertCurrencyFormat(origCurrency, bidsValue),
+			len(result.Asks),
+			asksAmount,
+			p.Base.String(),
+			e.printConvertCurrencyFormat(origCurrency, asksValue),
+		)
+	} else {
+		if p.Quote.IsFiatCurrency() &&
+			p.Quote == e.Config.Currency.FiatDisplayCurrency {
+			log.Infof("%s %s %s: ORDERBOOK: Bids len: %d Amount: %f %s. Total value: %s Asks len: %d Amount: %f %s. Total value: %s",
+				exchangeName,
+				e.formatCurrency(p).String(),
+				assetType,
+				len(result.Bids),
+				bidsAmount,
+				p.Base.String(),
+				e.printCurrencyFormat(bidsValue),
+				len(result.Asks),
+				asksAmount,
+				p.Base.String(),
+				e.printCurrencyFormat(asksValue),
+			)
+		} else {
+			log.Infof("%s %s %s: ORDERBOOK: Bids len: %d Amount: %f %s. Total value: %f Asks len: %d Amount: %f %s. Total value: %f",
+				exchangeName,
+				e.formatCurrency(p).String(),
+				assetType,
+				len(result.Bids),
+				bidsAmount,
+				p.Base.String(),
+				bidsValue,
+				len(result.Asks),
+				asksAmount,
+				p.Base.String(),
+				asksValue,
+			)
+		}
+	}
+}
+
+func (e *Engine) relayWebsocketEvent(result interface{}, event string, assetType asset.Item, exchangeName string, p currency.Pair) {
+	evt := WebsocketEvent{
+		Data:      result,
+		Event:     event,
+		AssetType: assetType.String(),
+		Exchange:  exchangeName,
+		Pair:      p.String(),
+	}
+	err := e.webserverManager.BroadcastWebsocketMessage(evt)
+	if err != nil {
+		log.Errorf("Failed to broadcast websocket event %v. Error: %s",
+			event, err)
+	}
+}
+
+// tickerUpdater fetches and updates the ticker for all enabled currency pairs
+// and exchanges
+type tickerUpdater struct {
+	started  int32
+	shutdown chan struct{}
+	wg       sync.WaitGroup
+	engine   *Engine
+}
+
+// Start starts the ticker updater routine
+func (t *tickerUpdater) Start() error {
+	if !atomic.CompareAndSwapInt32(&t.started, 0, 1) {
+		return ErrSubsystemAlreadyStarted
+	}
+
+	log.Debugf("Starting ticker updater routine.")
+	t.shutdown = make(chan struct{})
+	t.wg.Add(1)
+	go t.run()
+	return nil
+}
+
+// Stop stops the ticker updater routine once the current fetch cycle has
+// completed
+func (t *tickerUpdater) Stop() error {
+	if !atomic.CompareAndSwapInt32(&t.started, 1, 0) {
+		return ErrSubsystemNotStarted
+	}
+
+	close(t.shutdown)
+	t.wg.Wait()
+	log.Debugln("Ticker updater routine shutdown.")
+	return nil
+}
+
+// IsRunning returns whether or not the ticker updater is running
+func (t *tickerUpdater) IsRunning() bool {
+	return atomic.LoadInt32(&t.started) == 1
+}
+
+func (t *tickerUpdater) run() {
+	defer t.wg.Done()
+	for {
+		t.engine.updateTickers()
+		log.Debugln("All enabled currency tickers fetched.")
+		select {
+		case <-t.shutdown:
+			return
+		case <-time.After(t.engine.restInterval()):
+		}
+	}
+}
+
+// updateTickers fetches the tickers for all enabled currency pairs and
+// exchanges, skipping those the sync manager receives over websocket
+func (e *Engine) updateTickers() {
+	var wg sync.WaitGroup
+	exchanges := e.GetExchanges()
+	wg.Add(len(exchanges))
+	for x := range exchanges {
+		go func(exch exchange.IBotExchange) {
+			defer wg.Done()
+			if exch == nil {
+				return
+			}
+			exchangeName := exch.GetName()
+			supportsBatching := exch.SupportsRESTTickerBatchUpdates()
+			assetTypes := exch.GetAssetTypes()
+
+			processTicker := func(update bool, c currency.Pair, assetType asset.Item) {
+				var result ticker.Price
+				var err error
+				if update {
+					result, err = exch.UpdateTicker(c, assetType)
+				} else {
+					result, err = exch.GetTickerPrice(c, assetType)
+				}
+				e.printTickerSummary(&result, c, assetType, exchangeName, err)
+				if err == nil {
+					e.syncManager.update(exchangeName, assetType, c, syncItemTicker, false)
+					e.commsManager.StageTickerData(exchangeName, assetType, &result)
+					e.eventManager.OnTicker(exchangeName, assetType, &result)
+					e.scriptManager.OnTicker(exchangeName, assetType, &result)
+					if e.webserverManager.IsRunning() {
+						e.relayWebsocketEvent(result, WebsocketEventTickerUpdate, assetType,
+							exchangeName, c)
+					}
+				}
+			}
+
+			for y := range assetTypes {
+				enabledCurrencies := exch.GetEnabledPairs(assetTypes[y])
+				var batchUpdated bool
+				for z := range enabledCurrencies {
+					if !e.syncManager.requiresREST(exchangeName, assetTypes[y],
+						enabledCurrencies[z], syncItemTicker) {
+						continue
+					}
+					if supportsBatching && batchUpdated {
+						processTicker(false, enabledCurrencies[z], assetTypes[y])
+						continue
+					}
+					processTicker(true, enabledCurrencies[z], assetTypes[y])
+					batchUpdated = true
+				}
+			}
+		}(exchanges[x])
+	}
+	wg.Wait()
+}
+
+// orderbookUpdater fetches and updates the orderbooks for all enabled
+// currency pairs and exchanges
+type orderbookUpdater struct {
+	started  int32
+	shutdown chan struct{}
+	wg       sync.WaitGroup
+	engine   *Engine
+}
+
+// Start starts the orderbook updater routine
+func (o *orderbookUpdater) Start() error {
+	if !atomic.CompareAndSwapInt32(&o.started, 0, 1) {
+		return ErrSubsystemAlreadyStarted
+	}
+
+	log.Debugln("Starting orderbook updater routine.")
+	o.shutdown = make(chan struct{})
+	o.wg.Add(1)
+	go o.run()
+	return nil
+}
+
+// Stop stops the orderbook updater routine once the current fetch cycle has
+// completed
+func (o *orderbookUpdater) Stop() error {
+	if !atomic.CompareAndSwapInt32(&o.started, 1, 0) {
+		return ErrSubsystemNotStarted
+	}
+
+	close(o.shutdown)
+	o.wg.Wait()
+	log.Debugln("Orderbook updater routine shutdown.")
+	return nil
+}
+
+// IsRunning returns whether or not the orderbook updater is running
+func (o *orderbookUpdater) IsRunning() bool {
+	return atomic.LoadInt32(&o.started) == 1
+}
+
+func (o *orderbookUpdater) run() {
+	defer o.wg.Done()
+	for {
+		o.engine.updateOrderbooks()
+		log.Debugln("All enabled currency orderbooks fetched.")
+		select {
+		case <-o.shutdown:
+			return
+		case <-time.After(o.engine.restInterval()):
+		}
+	}
+}
+
+// updateOrderbooks fetches the orderbooks for all enabled currency pairs and
+// exchanges, skipping those the sync manager receives over websocket
+func (e *Engine) updateOrderbooks() {
+	var wg sync.WaitGroup
+	exchanges := e.GetExchanges()
+	wg.Add(len(exchanges))
+	for x := range exchanges {
+		go func(exch exchange.IBotExchange) {
+			defer wg.Done()
+			if exch == nil {
+				return
+			}
+			exchangeName := exch.GetName()
+			assetTypes := exch.GetAssetTypes()
+			for y := range assetTypes {
+				enabledCurrencies := exch.GetEnabledPairs(assetTypes[y])
+				for z := range enabledCurrencies {
+					if !e.syncManager.requiresREST(exchangeName, assetTypes[y],
+						enabledCurrencies[z], syncItemOrderbook) {
+						continue
+					}
+					result, err := exch.UpdateOrderbook(enabledCurrencies[z], assetTypes[y])
+					e.printOrderbookSummary(&result, enabledCurrencies[z], assetTypes[y], exchangeName, err)
+					if err != nil {
+						continue
+					}
+					e.syncManager.update(exchangeName, assetTypes[y],
+						enabledCurrencies[z], syncItemOrderbook, false)
+					e.commsManager.StageOrderbookData(exchangeName, assetTypes[y], &result)
+					e.eventManager.OnOrderbook(&result)
+					e.scriptManager.OnOrderbook(&result)
+					if e.webserverManager.IsRunning() {
+						e.relayWebsocketEvent(result, WebsocketEventOrderbookUpdate,
+							assetTypes[y], exchangeName, enabledCurrencies[z])
+					}
+				}
+			}
+		}(exchanges[x])
+	}
+	wg.Wait()
+}
+
+// websocketRoutineManager connects the exchange websocket feeds and handles
+// the data received from them
+type websocketRoutineManager struct {
+	started  int32
+	shutdown chan struct{}
+	wg       sync.WaitGroup
+	engine   *Engine
+
+	// handlers holds the websocket feeds which have a data handler routine
+	handlers map[*wshandler.Websocket]struct{}
+	m        sync.Mutex
+}
+
+// Start connects all enabled exchange websocket feeds and spawns their data
+// handlers
+func (w *websocketRoutineManager) Start() error {
+	if !atomic.CompareAndSwapInt32(&w.started, 0, 1) {
+		return ErrSubsystemAlreadyStarted
+	}
+
+	log.Debugln("Connecting exchange websocket services...")
+	w.shutdown = make(chan struct{})
+	w.m.Lock()
+	w.handlers = make(map[*wshandler.Websocket]struct{})
+	w.m.Unlock()
+	exchanges := w.engine.GetExchanges()
+	for i := range exchanges {
+		go w.connect(exchanges[i])
+	}
+	return nil
+}
+
+// connect connects an exchange websocket feed, its data handler routine is
+// spawned the first time the feed is connected
+func (w *websocketRoutineManager) connect(exch exchange.IBotExchange) error {
+	if w.engine.Settings.Verbose {
+		log.Debugf("Establishing websocket connection for %s",
+			exch.GetName())
+	}
+
+	ws, err := exch.GetWebsocket()
+	if err != nil {
+		log.Debugf("Websocket not enabled for %s",
+			exch.GetName())
+		return err
+	}
+
+	w.m.Lock()
+	if _, ok := w.handlers[ws]; !ok {
+		w.handlers[ws] = struct{}{}
+		// Data handler routine
+		w.wg.Add(1)
+		go w.websocketDataHandler(ws)
+	}
+	w.m.Unlock()
+
+	err = ws.Connect()
+	if err != nil {
+		switch err.Error() {
+		case wshandler.WebsocketNotEnabled:
+			log.Warnf("%s - websocket disabled", exch.GetName())
+		default:
+			log.Error(err)
+		}
+	}
+	return err
+}
+
+// Stop shuts down the exchange websocket connections and then shuts down the
+// governing routines
+func (w *websocketRoutineManager) Stop() error {
+	if !atomic.CompareAndSwapInt32(&w.started, 1, 0) {
+		return ErrSubsystemNotStarted
+	}
+
+	exchanges := w.engine.GetExchanges()
+	for i := range exchanges {
+		ws, err := exchanges[i].GetWebsocket()
+		if err != nil || !ws.IsConnected() {
+			continue
+		}
+
+		err = ws.Shutdown()
+		if err != nil {
+			log.Errorf("routines.go error - failed to shutdown %s websocket: %s",
+				exchanges[i].GetName(), err)
+		}
+	}
+
+	timer := time.NewTimer(websocketShutdownTimeout)
+	defer timer.Stop()
+	c := make(chan struct{}, 1)
+	go func(c chan struct{}) {
+		close(w.shutdown)
+		w.wg.Wait()
+		c <- struct{}{}
+	}(c)
+
+	select {
+	case <-timer.C:
+		return errors.New("routines.go error - failed to shutdown routines")
+	case <-c:
+		log.Debugln("Websocket routine manager shutdown.")
+		return nil
+	}
+}
+
+// IsRunning returns whether or not the websocket routine manager is running
+func (w *websocketRoutineManager) IsRunning() bool {
+	return atomic.LoadInt32(&w.started) == 1
+}
+
+// streamDiversion is a diversion switch from websocket to REST or other
+// alternative feed, the sync manager falls back to REST for the market data
+// of a disconnected websocket
+func (w *websocketRoutineManager) streamDiversion(ws *wshandler.Websocket) {
+	defer w.wg.Done()
+	verbose := w.engine.Settings.Verbose
+	for {
+		select {
+		case <-w.shutdown:
+			return
+
+		case <-ws.Connected:
+			w.engine.syncManager.setWebsocketConnected(ws.GetName(), true)
+			if verbose {
+				log.Debugf("exchange %s websocket feed connected", ws.GetName())
+			}
+
+		case <-ws.Disconnected:
+			w.engine.syncManager.setWebsocketConnected(ws.GetName(), false)
+			if verbose {
+				log.Debugf("exchange %s websocket feed disconnected, switching to REST functionality",
+					ws.GetName())
+			}
+		}
+	}
+}
+
+// websocketDataHandler handles websocket data coming from a websocket feed
+// associated with an exchange
+func (w *websocketRoutineManager) websocketDataHandler(ws *wshandler.Websocket) {
+	defer w.wg.Done()
+	verbose := w.engine.Settings.Verbose
+
+	w.wg.Add(1)
+	go w.streamDiversion(ws)
+
+	// staged holds when the orderbook summary of each asset and pair was
+	// last staged for the communication mediums
+	staged := make(map[string]time.Time)
+
+	for {
+		select {
+		case <-w.shutdown:
+			return
+
+		case data := <-ws.DataHandler:
+			w.engine.syncManager.traffic(ws.GetName())
+			switch d := data.(type) {
+			case string:
+				switch d {
+				case wshandler.WebsocketNotEnabled:
+					if verbose {
+						log.Warnf("routines.go warning - exchange %s weboscket not enabled",
+							ws.GetName())
+					}
+
+				default:
+					log.Infof(d)
+				}
+
+			case error:
+				switch {
+				case common.StringContains(d.Error(), "close 1006"):
+					go ws.WebsocketReset()
+					continue
+				default:
+					log.Errorf("routines.go exchange %s websocket error - %s", ws.GetName(), data)
+				}
+
+			case wshandler.TradeData:
+				// Trade Data
+				if verbose {
+					log.Infoln("Websocket trades Updated:   ", d)
+				}
+
+			case wshandler.TickerData:
+				// Ticker data
+				if verbose {
+					log.Infoln("Websocket Ticker Updated:   ", d)
+				}
+				t := ticker.Price{
+					Pair:        d.Pair,
+					Last:        d.ClosePrice,
+					High:        d.HighPrice,
+					Low:         d.LowPrice,
+					Bid:         d.BidPrice,
+					Ask:         d.AskPrice,
+					Volume:      d.Quantity,
+					LastUpdated: d.Timestamp,
+				}
+				if w.engine.syncManager.IsRunning() {
+					// The REST ticker updater no longer fetches this ticker
+					// so it is stored and relayed here
+					err := storeWebsocketTicker(d.Exchange, d.AssetType, &t)
+					if err != nil {
+						log.Errorf("%s websocket ticker unable to be stored: %s",
+							d.Exchange, err)
+						continue
+					}
+					// Tickers without a bid and ask keep being fetched
+					// over REST for them
+					if d.BidPrice != 0 && d.AskPrice != 0 {
+						w.engine.syncManager.update(d.Exchange, d.AssetType, d.Pair,
+							syncItemTicker, true)
+					}
+					w.engine.commsManager.StageTickerData(d.Exchange, d.AssetType, &t)
+					if w.engine.webserverManager.IsRunning() {
+						w.engine.relayWebsocketEvent(t, WebsocketEventTickerUpdate,
+							d.AssetType, d.Exchange, d.Pair)
+					}
+				}
+				w.engine.eventManager.OnTicker(d.Exchange, d.AssetType, &t)
+				w.engine.scriptManager.OnTicker(d.Exchange, d.AssetType, &t)
+			case wshandler.KlineData:
+				// Kline data
+				if verbose {
+					log.Infoln("Websocket Kline Updated:    ", d)
+				}
+			case wshandler.WebsocketOrderbookUpdate:
+				// Orderbook data
+				if verbose {
+					log.Infoln("Websocket Orderbook Updated:", d)
+				}
+				syncing := w.engine.syncManager.IsRunning()
+				if syncing {
+					w.engine.syncManager.update(d.Exchange, d.Asset, d.Pair,
+						syncItemOrderbook, true)
+				}
+				// Retrieving the orderbook copies every level, so it is only
+				// retrieved for the consumers of this orderbook and the
+				// communication summaries are staged at an interval
+				key := d.Asset.String() + d.Pair.String()
+				stage := syncing && w.engine.commsManager.IsRunning() &&
+					time.Since(staged[key]) >= orderbookStageInterval
+				relay := syncing && w.engine.webserverManager.HasWebsocketSubscribers(
+					WebsocketEventOrderbookUpdate, d.Exchange, d.Asset, d.Pair)
+				evaluate := w.engine.eventManager.HasEvents(d.Exchange, d.Pair, d.Asset)
+				script := w.engine.scriptManager.Subscribed(gctscript.EventOrderbook,
+					d.Exchange, d.Pair, d.Asset)
+				if !stage && !relay && !evaluate && !script {
+					continue
+				}
+				ob, err := orderbook.Get(d.Exchange, d.Pair, d.Asset)
+				if err != nil {
+					continue
+				}
+				if stage {
+					staged[key] = time.Now()
+					w.engine.commsManager.StageOrderbookData(d.Exchange, d.Asset, &ob)
+				}
+				if relay {
+					w.engine.relayWebsocketEvent(ob, WebsocketEventOrderbookUpdate,
+						d.Asset, d.Exchange, d.Pair)
+				}
+				if evaluate {
+					w.engine.eventManager.OnOrderbook(&ob)
+				}
+				if script {
+					w.engine.scriptManager.OnOrderbook(&ob)
+				}
+			default:
+				if verbose {
+					log.Warnf("Websocket Unknown type:     %s", d)
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,775 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/events"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+	"github.com/thrasher-corp/gocryptotrader/gctrpc"
+	"github.com/thrasher-corp/gocryptotrader/portfolio"
+)
+
+// rpcServer implements the GoCryptoTrader gRPC service
+type rpcServer struct {
+	engine *Engine
+}
+
+var errInvalidArguments = errors.New("invalid arguments received")
+
+// GetInfo returns info about the current GoCryptoTrader session
+func (s *rpcServer) GetInfo(_ context.Context, _ *gctrpc.GetInfoRequest) (*gctrpc.GetInfoResponse, error) {
+	return &gctrpc.GetInfoResponse{
+		Uptime:               time.Since(s.engine.Uptime).String(),
+		AvailableExchanges:   int64(len(s.engine.Config.Exchanges)),
+		EnabledExchanges:     int64(s.engine.Config.CountEnabledExchanges()),
+		DefaultFiatCurrency:  s.engine.Config.Currency.FiatDisplayCurrency.String(),
+		DefaultForexProvider: s.engine.Config.GetPrimaryForexProvider(),
+		SubsystemStatus:      s.engine.GetSubsystemsStatus(),
+		Version:              BuildVersion(true),
+	}, nil
+}
+
+// GetSubsystems returns a list of subsystems and their status
+func (s *rpcServer) GetSubsystems(_ context.Context, _ *gctrpc.GetSubsystemsRequest) (*gctrpc.GetSubsystemsResponse, error) {
+	return &gctrpc.GetSubsystemsResponse{
+		SubsystemsStatus: s.engine.GetSubsystemsStatus(),
+	}, nil
+}
+
+// SetSubsystem enables or disables an engine subsystem
+func (s *rpcServer) SetSubsystem(_ context.Context, r *gctrpc.SetSubsystemRequest) (*gctrpc.GenericResponse, error) {
+	err := s.engine.SetSubsystem(r.Subsystem, r.Enable)
+	if err != nil {
+		return nil, err
+	}
+	return &gctrpc.GenericResponse{Status: "success"}, nil
+}
+
+// ReloadConfig reloads the config from the config file and returns the
+// changes applied
+func (s *rpcServer) ReloadConfig(_ context.Context, _ *gctrpc.ReloadConfigRequest) (*gctrpc.ReloadConfigResponse, error) {
+	result, err := s.engine.ReloadConfigFile()
+	if err != nil {
+		return nil, err
+	}
+	return &gctrpc.ReloadConfigResponse{
+		LoadedExchanges:       result.LoadedExchanges,
+		ReloadedExchanges:     result.ReloadedExchanges,
+		UnloadedExchanges:     result.UnloadedExchanges,
+		ResubscribedExchanges: result.ResubscribedExchanges,
+		RestartedSubsystems:   result.RestartedSubsystems,
+		RestartRequired:       result.RestartRequired,
+	}, nil
+}
+
+// GetExchanges returns a list of exchanges, optionally only the enabled ones
+func (s *rpcServer) GetExchanges(_ context.Context, r *gctrpc.GetExchangesRequest) (*gctrpc.GetExchangesResponse, error) {
+	var exchanges []string
+	for x := range s.engine.Config.Exchanges {
+		if r.Enabled && !s.engine.Config.Exchanges[x].Enabled {
+			continue
+		}
+		exchanges = append(exchanges, s.engine.Config.Exchanges[x].Name)
+	}
+	return &gctrpc.GetExchangesResponse{Exchanges: exchanges}, nil
+}
+
+// GetExchangeInfo returns an exchanges configuration with its credentials
+// omitted
+func (s *rpcServer) GetExchangeInfo(_ context.Context, r *gctrpc.GenericExchangeNameRequest) (*gctrpc.GetExchangeInfoResponse, error) {
+	exchCfg, err := s.engine.Config.GetExchangeConfig(r.Exchange)
+	if err != nil {
+		return nil, err
+	}
+
+	return &gctrpc.GetExchangeInfoResponse{
+		Name:             exchCfg.Name,
+		Enabled:          exchCfg.Enabled,
+		Verbose:          exchCfg.Verbose,
+		UsingSandbox:     exchCfg.UseSandbox,
+		HttpTimeout:      exchCfg.HTTPTimeout.String(),
+		HttpUseragent:    exchCfg.HTTPUserAgent,
+		HttpProxy:        exchCfg.ProxyAddress,
+		BaseCurrencies:   exchCfg.BaseCurrencies.Join(),
+		AvailablePairs:   exchCfg.AvailablePairs.Join(),
+		EnabledPairs:     exchCfg.EnabledPairs.Join(),
+		AuthenticatedApi: exchCfg.AuthenticatedAPISupport,
+		WebsocketEnabled: exchCfg.Websocket,
+	}, nil
+}
+
+// EnableExchange loads and enables an exchange
+func (s *rpcServer) EnableExchange(_ context.Context, r *gctrpc.GenericExchangeNameRequest) (*gctrpc.GenericResponse, error) {
+	exchCfg, err := s.engine.Config.GetExchangeConfig(r.Exchange)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.engine.LoadExchange(exchCfg.Name, false, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	exchCfg.Enabled = true
+	err = s.engine.Config.UpdateExchangeConfig(&exchCfg)
+	if err != nil {
+		return nil, err
+	}
+	return &gctrpc.GenericResponse{Status: "success"}, nil
+}
+
+// DisableExchange disables and unloads an exchange
+func (s *rpcServer) DisableExchange(_ context.Context, r *gctrpc.GenericExchangeNameRequest) (*gctrpc.GenericResponse, error) {
+	err := s.engine.UnloadExchange(r.Exchange)
+	if err != nil {
+		return nil, err
+	}
+	return &gctrpc.GenericResponse{Status: "success"}, nil
+}
+
+// GetTicker returns the ticker for a specified exchange, currency pair and
+// asset type
+func (s *rpcServer) GetTicker(_ context.Context, r *gctrpc.GetTickerRequest) (*gctrpc.TickerResponse, error) {
+	exch, err := s.engine.getEnabledExchange(r.Exchange)
+	if err != nil {
+		return nil, err
+	}
+
+	assetType, err := parseAssetType(exch, r.AssetType)
+	if err != nil {
+		return nil, err
+	}
+
+	t, err := exch.GetTickerPrice(rpcPair(r.Pair), assetType)
+	if err != nil {
+		return nil, err
+	}
+	return rpcTicker(&t), nil
+}
+
+// GetTickers returns the cached tickers for all enabled exchanges
+func (s *rpcServer) GetTickers(_ context.Context, _ *gctrpc.GetTickersRequest) (*gctrpc.GetTickersResponse, error) {
+	var tickers []*gctrpc.Tickers
+	activeTickers := s.engine.GetAllActiveTickers()
+	for x := range activeTickers {
+		t := &gctrpc.Tickers{Exchange: activeTickers[x].ExchangeName}
+		for y := range activeTickers[x].ExchangeValues {
+			t.Tickers = append(t.Tickers,
+				rpcTicker(&activeTickers[x].ExchangeValues[y]))
+		}
+		tickers = append(tickers, t)
+	}
+	return &gctrpc.GetTickersResponse{Tickers: tickers}, nil
+}
+
+// GetOrderbook returns the orderbook for a specified exchange, currency pair
+// and asset type
+func (s *rpcServer) GetOrderbook(_ context.Context, r *gctrpc.GetOrderbookRequest) (*gctrpc.OrderbookResponse, error) {
+	exch, err := s.engine.getEnabledExchange(r.Exchange)
+	if err != nil {
+		return nil, err
+	}
+
+	assetType, err := parseAssetType(exch, r.AssetType)
+	if err != nil {
+		return nil, err
+	}
+
+	ob, err := exch.GetOrderbookEx(rpcPair(r.Pair), assetType)
+	if err != nil {
+		return nil, err
+	}
+	return rpcOrderbook(&ob), nil
+}
+
+// GetOrderbooks returns the cached orderbooks for all enabled exchanges
+func (s *rpcServer) GetOrderbooks(_ context.Context, _ *gctrpc.GetOrderbooksRequest) (*gctrpc.GetOrderbooksResponse, error) {
+	var orderbooks []*gctrpc.Orderbooks
+	activeOrderbooks := s.engine.GetAllActiveOrderbooks()
+	for x := range activeOrderbooks {
+		ob := &gctrpc.Orderbooks{Exchange: activeOrderbooks[x].ExchangeName}
+		for y := range activeOrderbooks[x].ExchangeValues {
+			ob.Orderbooks = append(ob.Orderbooks,
+				rpcOrderbook(&activeOrderbooks[x].ExchangeValues[y]))
+		}
+		orderbooks = append(orderbooks, ob)
+	}
+	return &gctrpc.GetOrderbooksResponse{Orderbooks: orderbooks}, nil
+}
+
+// GetSyncStatus returns the market data sync status of each enabled currency
+// pair of an exchange, or of every exchange when none is specified
+func (s *rpcServer) GetSyncStatus(_ context.Context, r *gctrpc.GetSyncStatusRequest) (*gctrpc.GetSyncStatusResponse, error) {
+	status, err := s.engine.GetSyncStatus(r.Exchange)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := new(gctrpc.GetSyncStatusResponse)
+	for x := range status {
+		resp.Status = append(resp.Status, &gctrpc.SyncStatus{
+			Exchange:           status[x].Exchange,
+			AssetType:          status[x].AssetType.String(),
+			Pair:               rpcCurrencyPair(status[x].Pair),
+			WebsocketConnected: status[x].WebsocketConnected,
+			Ticker:             rpcSyncItemStatus(&status[x].Ticker),
+			Orderbook:          rpcSyncItemStatus(&status[x].Orderbook),
+		})
+	}
+	return resp, nil
+}
+
+// rpcSyncItemStatus converts a market data sync status to its gRPC
+// representation, times which have not been set are zero
+func rpcSyncItemStatus(s *SyncItemStatus) *gctrpc.SyncItemStatus {
+	unix := func(t time.Time) int64 {
+		if t.IsZero() {
+			return 0
+		}
+		return t.Unix()
+	}
+	return &gctrpc.SyncItemStatus{
+		Source:              s.Source,
+		Synced:              s.Synced,
+		LastUpdated:         unix(s.LastUpdated),
+		LastWebsocketUpdate: unix(s.LastWebsocketUpdate),
+		LastRestUpdate:      unix(s.LastRESTUpdate),
+	}
+}
+
+// GetAccountInfo returns an exchanges account balances
+func (s *rpcServer) GetAccountInfo(ctx context.Context, r *gctrpc.GetAccountInfoRequest) (*gctrpc.GetAccountInfoResponse, error) {
+	exch, err := s.engine.getEnabledExchange(r.Exchange)
+	if err != nil {
+		return nil, err
+	}
+
+	if !exch.GetAuthenticatedAPISupport(exchange.RestAuthentication) {
+		return nil, ErrAuthenticationNotOn
+	}
+
+	info, err := s.engine.GetAccountInfoContext(ctx, exch)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &gctrpc.GetAccountInfoResponse{Exchange: info.Exchange}
+	for x := range info.Accounts {
+		account := &gctrpc.Account{
+			Id:            info.Accounts[x].ID,
+			CredentialSet: info.Accounts[x].CredentialSet,
+		}
+		for y := range info.Accounts[x].Currencies {
+			c := &info.Accounts[x].Currencies[y]
+			account.Currencies = append(account.Currencies,
+				&gctrpc.AccountCurrencyInfo{
+					Currency:   c.CurrencyName.String(),
+					TotalValue: c.TotalValue,
+					Hold:       c.Hold,
+				})
+		}
+		resp.Accounts = append(resp.Accounts, account)
+	}
+	return resp, nil
+}
+
+// GetOrders returns the orders recorded by the order manager which match the
+// request filter
+func (s *rpcServer) GetOrders(_ context.Context, r *gctrpc.GetOrdersRequest) (*gctrpc.GetOrdersResponse, error) {
+	if !s.engine.orderManager.IsRunning() {
+		return nil, ErrSubsystemNotStarted
+	}
+
+	orders := s.engine.orderManager.GetOrders(&OrderFilter{
+		Exchange:      r.Exchange,
+		CredentialSet: r.CredentialSet,
+		Pair:          rpcPair(r.Pair),
+		Side:          exchange.OrderSide(common.StringToUpper(r.OrderSide)),
+		Status:        exchange.OrderStatus(common.StringToUpper(r.Status)),
+		ActiveOnly:    r.ActiveOnly,
+	})
+
+	resp := &gctrpc.GetOrdersResponse{}
+	for x := range orders {
+		resp.Orders = append(resp.Orders, rpcOrder(&orders[x]))
+	}
+	return resp, nil
+}
+
+// GetOrder returns an order recorded by the order manager
+func (s *rpcServer) GetOrder(_ context.Context, r *gctrpc.GetOrderRequest) (*gctrpc.OrderDetails, error) {
+	ord, err := s.engine.orderManager.GetOrder(r.OrderId)
+	if err != nil {
+		return nil, err
+	}
+	return rpcOrder(&ord), nil
+}
+
+// SubmitOrder submits an order through the order manager
+func (s *rpcServer) SubmitOrder(ctx context.Context, r *gctrpc.SubmitOrderRequest) (*gctrpc.SubmitOrderResponse, error) {
+	ord, err := s.engine.orderManager.SubmitContext(ctx, &OrderSubmission{
+		Exchange:      r.Exchange,
+		CredentialSet: r.CredentialSet,
+		Pair:          rpcPair(r.Pair),
+		Side:          exchange.OrderSide(common.StringToUpper(r.Side)),
+		Type:          exchange.OrderType(common.StringToUpper(r.OrderType)),
+		Amount:        r.Amount,
+		Price:         r.Price,
+		ClientID:      r.ClientId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &gctrpc.SubmitOrderResponse{
+		OrderPlaced:     true,
+		OrderId:         ord.ID,
+		ExchangeOrderId: ord.ExchangeOrderID,
+		Status:          string(ord.Status),
+	}, nil
+}
+
+// CancelOrder cancels an order recorded by the order manager
+func (s *rpcServer) CancelOrder(ctx context.Context, r *gctrpc.CancelOrderRequest) (*gctrpc.GenericResponse, error) {
+	err := s.engine.orderManager.CancelContext(ctx, r.OrderId)
+	if err != nil {
+		return nil, err
+	}
+	return &gctrpc.GenericResponse{Status: "success"}, nil
+}
+
+// CancelAllOrders cancels all open orders of a credential set on an exchange,
+// optionally only for a currency pair
+func (s *rpcServer) CancelAllOrders(ctx context.Context, r *gctrpc.CancelAllOrdersRequest) (*gctrpc.GenericResponse, error) {
+	err := s.engine.orderManager.CancelAllContext(ctx, r.Exchange,
+		r.CredentialSet, rpcPair(r.Pair))
+	if err != nil {
+		return nil, err
+	}
+	return &gctrpc.GenericResponse{Status: "success"}, nil
+}
+
+// GetDepositAddress returns a deposit address for an exchange and
+// cryptocurrency
+func (s *rpcServer) GetDepositAddress(ctx context.Context, r *gctrpc.GetDepositAddressRequest) (*gctrpc.GetDepositAddressResponse, error) {
+	exch, err := s.engine.getEnabledCredentialSet(r.Exchange, r.CredentialSet)
+	if err != nil {
+		return nil, err
+	}
+
+	address, err := exchange.GetDepositAddressContext(ctx, exch,
+		currency.NewCode(r.Cryptocurrency), r.AccountId)
+	if err != nil {
+		return nil, err
+	}
+	return &gctrpc.GetDepositAddressResponse{Address: address}, nil
+}
+
+// WithdrawCryptocurrencyFunds withdraws cryptocurrency from an exchange to an
+// address
+func (s *rpcServer) WithdrawCryptocurrencyFunds(ctx context.Context, r *gctrpc.WithdrawCryptoRequest) (*gctrpc.WithdrawResponse, error) {
+	if r.Address == "" || r.Currency == "" || r.Amount <= 0 {
+		return nil, errInvalidArguments
+	}
+
+	exch, err := s.engine.getEnabledCredentialSet(r.Exchange, r.CredentialSet)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := exchange.WithdrawCryptocurrencyFundsContext(ctx, exch,
+		&exchange.WithdrawRequest{
+			Currency:    currency.NewCode(r.Currency),
+			Address:     r.Address,
+			AddressTag:  r.AddressTag,
+			Amount:      r.Amount,
+			FeeAmount:   r.Fee,
+			Description: r.Description,
+		})
+	if err != nil {
+		return nil, err
+	}
+	return &gctrpc.WithdrawResponse{Id: id}, nil
+}
+
+// WithdrawFiatFunds withdraws fiat from an exchange to the client bank account
+// configured for the exchange and currency
+func (s *rpcServer) WithdrawFiatFunds(ctx context.Context, r *gctrpc.WithdrawFiatRequest) (*gctrpc.WithdrawResponse, error) {
+	if r.Currency == "" || r.Amount <= 0 {
+		return nil, errInvalidArguments
+	}
+
+	exch, err := s.engine.getEnabledCredentialSet(r.Exchange, r.CredentialSet)
+	if err != nil {
+		return nil, err
+	}
+
+	bank, err := s.engine.Config.GetClientBankAccounts(exch.GetName(),
+		common.StringToUpper(r.Currency))
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := exchange.WithdrawFiatFundsContext(ctx, exch,
+		&exchange.WithdrawRequest{
+			Currency:        currency.NewCode(r.Currency),
+			Amount:          r.Amount,
+			Description:     r.Description,
+			BankAccountName: bank.AccountName,
+			BankName:        bank.BankName,
+			BankAddress:     bank.BankAddress,
+			SwiftCode:       bank.SWIFTCode,
+			IBAN:            bank.IBAN,
+		})
+	if err != nil {
+		return nil, err
+	}
+	return &gctrpc.WithdrawResponse{Id: id}, nil
+}
+
+// GetEvents returns all events
+func (s *rpcServer) GetEvents(_ context.Context, _ *gctrpc.GetEventsRequest) (*gctrpc.GetEventsResponse, error) {
+	m := s.engine.eventManager.GetManager()
+	if m == nil {
+		return nil, ErrSubsystemNotStarted
+	}
+
+	evts := m.GetEvents()
+	resp := &gctrpc.GetEventsResponse{}
+	for x := range evts {
+		resp.Events = append(resp.Events, rpcEvent(&evts[x]))
+	}
+	return resp, nil
+}
+
+// AddEvent validates and adds an event
+func (s *rpcServer) AddEvent(_ context.Context, r *gctrpc.Event) (*gctrpc.AddEventResponse, error) {
+	m := s.engine.eventManager.GetManager()
+	if m == nil {
+		return nil, ErrSubsystemNotStarted
+	}
+
+	evt := events.Event{
+		Name:     r.Name,
+		Exchange: r.Exchange,
+		Pair:     rpcPair(r.Pair),
+		Asset:    asset.Item(r.AssetType),
+		Logic:    r.Logic,
+		Action:   r.Action,
+		Rearm:    r.Rearm,
+		Cooldown: time.Duration(r.CooldownSeconds) * time.Second,
+	}
+
+	for x := range r.Conditions {
+		evt.Conditions = append(evt.Conditions, events.Condition{
+			Item:        r.Conditions[x].Item,
+			Operator:    r.Conditions[x].Operator,
+			Value:       r.Conditions[x].Value,
+			Window:      time.Duration(r.Conditions[x].WindowSeconds) * time.Second,
+			Side:        r.Conditions[x].Side,
+			Price:       r.Conditions[x].Price,
+			Currency:    currency.NewCode(r.Conditions[x].Currency),
+			Amount:      r.Conditions[x].Amount,
+			QuoteAmount: r.Conditions[x].QuoteAmount,
+			Percent:     r.Conditions[x].Percent,
+		})
+	}
+
+	if r.Order != nil {
+		evt.Order = &events.OrderAction{
+			Side:        exchange.OrderSide(r.Order.Side),
+			Type:        exchange.OrderType(r.Order.OrderType),
+			Amount:      r.Order.Amount,
+			Price:       r.Order.Price,
+			OrderID:     r.Order.OrderId,
+			ClientID:    r.Order.ClientId,
+			MaxNotional: r.Order.MaxNotional,
+			DryRun:      r.Order.DryRun,
+		}
+	}
+
+	id, err := m.Add(&evt)
+	if err != nil {
+		return nil, err
+	}
+	return &gctrpc.AddEventResponse{Id: id}, nil
+}
+
+// RemoveEvent removes an event
+func (s *rpcServer) RemoveEvent(_ context.Context, r *gctrpc.GenericEventRequest) (*gctrpc.GenericResponse, error) {
+	m := s.engine.eventManager.GetManager()
+	if m == nil {
+		return nil, ErrSubsystemNotStarted
+	}
+
+	err := m.Remove(r.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &gctrpc.GenericResponse{Status: "success"}, nil
+}
+
+// RearmEvent allows an executed event to trigger again
+func (s *rpcServer) RearmEvent(_ context.Context, r *gctrpc.GenericEventRequest) (*gctrpc.GenericResponse, error) {
+	m := s.engine.eventManager.GetManager()
+	if m == nil {
+		return nil, ErrSubsystemNotStarted
+	}
+
+	err := m.Rearm(r.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &gctrpc.GenericResponse{Status: "success"}, nil
+}
+
+// GetPortfolio returns the portfolio addresses
+func (s *rpcServer) GetPortfolio(_ context.Context, _ *gctrpc.GetPortfolioRequest) (*gctrpc.GetPortfolioResponse, error) {
+	resp := &gctrpc.GetPortfolioResponse{}
+	for x := range s.engine.Portfolio.Addresses {
+		a := &s.engine.Portfolio.Addresses[x]
+		resp.Portfolio = append(resp.Portfolio, &gctrpc.PortfolioAddress{
+			Address:     a.Address,
+			CoinType:    a.CoinType.String(),
+			Description: a.Description,
+			Balance:     a.Balance,
+		})
+	}
+	return resp, nil
+}
+
+// GetPortfolioSummary returns the portfolio coin totals and their offline and
+// online summaries
+func (s *rpcServer) GetPortfolioSummary(_ context.Context, _ *gctrpc.GetPortfolioSummaryRequest) (*gctrpc.GetPortfolioSummaryResponse, error) {
+	summary := s.engine.Portfolio.GetPortfolioSummary()
+	resp := &gctrpc.GetPortfolioSummaryResponse{
+		CoinTotals:          rpcCoins(summary.Totals),
+		CoinsOffline:        rpcCoins(summary.Offline),
+		CoinsOnline:         rpcCoins(summary.Online),
+		CoinsOfflineSummary: make(map[string]*gctrpc.OfflineCoins),
+		CoinsOnlineSummary:  make(map[string]*gctrpc.OnlineCoins),
+	}
+
+	for c, addresses := range summary.OfflineSummary {
+		offline := &gctrpc.OfflineCoins{}
+		for x := range addresses {
+			offline.Addresses = append(offline.Addresses,
+				&gctrpc.OfflineCoinSummary{
+					Address:    addresses[x].Address,
+					Balance:    addresses[x].Balance,
+					Percentage: addresses[x].Percentage,
+				})
+		}
+		resp.CoinsOfflineSummary[c.String()] = offline
+	}
+
+	for exchName, coins := range summary.OnlineSummary {
+		online := &gctrpc.OnlineCoins{
+			Coins: make(map[string]*gctrpc.OnlineCoinSummary),
+		}
+		for c, v := range coins {
+			online.Coins[c.String()] = &gctrpc.OnlineCoinSummary{
+				Balance:    v.Balance,
+				Percentage: v.Percentage,
+			}
+		}
+		resp.CoinsOnlineSummary[exchName] = online
+	}
+	return resp, nil
+}
+
+// AddPortfolioAddress adds an address to the portfolio
+func (s *rpcServer) AddPortfolioAddress(_ context.Context, r *gctrpc.AddPortfolioAddressRequest) (*gctrpc.GenericResponse, error) {
+	if r.Address == "" || r.CoinType == "" {
+		return nil, errInvalidArguments
+	}
+
+	s.engine.Portfolio.AddAddress(r.Address, r.Description,
+		currency.NewCode(r.CoinType), r.Balance)
+	return &gctrpc.GenericResponse{Status: "success"}, nil
+}
+
+// RemovePortfolioAddress removes an address from the portfolio
+func (s *rpcServer) RemovePortfolioAddress(_ context.Context, r *gctrpc.RemovePortfolioAddressRequest) (*gctrpc.GenericResponse, error) {
+	if !s.engine.Portfolio.AddressExists(r.Address) {
+		return nil, errors.New("portfolio address not found")
+	}
+
+	s.engine.Portfolio.RemoveAddress(r.Address, r.Description,
+		currency.NewCode(r.CoinType))
+	return &gctrpc.GenericResponse{Status: "success"}, nil
+}
+
+func rpcPair(p *gctrpc.CurrencyPair) currency.Pair {
+	if p == nil {
+		return currency.Pair{}
+	}
+	return currency.NewPairWithDelimiter(p.Base, p.Quote, p.Delimiter)
+}
+
+func rpcCurrencyPair(p currency.Pair) *gctrpc.CurrencyPair {
+	return &gctrpc.CurrencyPair{
+		Delimiter: p.Delimiter,
+		Base:      p.Base.String(),
+		Quote:     p.Quote.String(),
+	}
+}
+
+func rpcTicker(t *ticker.Price) *gctrpc.TickerResponse {
+	return &gctrpc.TickerResponse{
+		Pair:         rpcCurrencyPair(t.Pair),
+		CurrencyPair: t.Pair.String(),
+		LastUpdated:  t.LastUpdated.Unix(),
+		Last:         t.Last,
+		High:         t.High,
+		Low:          t.Low,
+		Bid:          t.Bid,
+		Ask:          t.Ask,
+		Volume:       t.Volume,
+		PriceAth:     t.PriceATH,
+	}
+}
+
+func rpcOrderbook(ob *orderbook.Base) *gctrpc.OrderbookResponse {
+	resp := &gctrpc.OrderbookResponse{
+		Pair:         rpcCurrencyPair(ob.Pair),
+		CurrencyPair: ob.Pair.String(),
+		LastUpdated:  ob.LastUpdated.Unix(),
+		AssetType:    ob.AssetType.String(),
+	}
+
+	for x := range ob.Bids {
+		resp.Bids = append(resp.Bids, &gctrpc.OrderbookItem{
+			Amount: ob.Bids[x].Amount,
+			Price:  ob.Bids[x].Price,
+			Id:     ob.Bids[x].ID,
+		})
+	}
+
+	for x := range ob.Asks {
+		resp.Asks = append(resp.Asks, &gctrpc.OrderbookItem{
+			Amount: ob.Asks[x].Amount,
+			Price:  ob.Asks[x].Price,
+			Id:     ob.Asks[x].ID,
+		})
+	}
+	return resp
+}
+
+func rpcOrder(ord *Order) *gctrpc.OrderDetails {
+	resp := &gctrpc.OrderDetails{
+		Id:              ord.ID,
+		Exchange:        ord.Exchange,
+		CredentialSet:   ord.CredentialSet,
+		ExchangeOrderId: ord.ExchangeOrderID,
+		ClientId:        ord.ClientID,
+		CurrencyPair:    ord.Pair.String(),
+		OrderSide:       string(ord.Side),
+		OrderType:       string(ord.Type),
+		Price:           ord.Price,
+		Amount:          ord.Amount,
+		ExecutedAmount:  ord.ExecutedAmount,
+		RemainingAmount: ord.RemainingAmount,
+		Fee:             ord.Fee,
+		Status:          string(ord.Status),
+		External:        ord.External,
+		Placed:          ord.Placed.Unix(),
+		LastUpdated:     ord.LastUpdated.Unix(),
+	}
+
+	for x := range ord.StatusHistory {
+		resp.StatusHistory = append(resp.StatusHistory,
+			&gctrpc.OrderStatusChange{
+				Status: string(ord.StatusHistory[x].Status),
+				Time:   ord.StatusHistory[x].Time.Unix(),
+				Reason: ord.StatusHistory[x].Reason,
+			})
+	}
+
+	for x := range ord.Fills {
+		resp.Fills = append(resp.Fills, &gctrpc.OrderFill{
+			Id:     ord.Fills[x].ID,
+			Price:  ord.Fills[x].Price,
+			Amount: ord.Fills[x].Amount,
+			Fee:    ord.Fills[x].Fee,
+			Time:   ord.Fills[x].Time.Unix(),
+		})
+	}
+	return resp
+}
+
+func rpcEvent(evt *events.Event) *gctrpc.Event {
+	resp := &gctrpc.Event{
+		Id:              evt.ID,
+		Name:            evt.Name,
+		Exchange:        evt.Exchange,
+		Pair:            rpcCurrencyPair(evt.Pair),
+		AssetType:       evt.Asset.String(),
+		Logic:           evt.Logic,
+		Action:          evt.Action,
+		Rearm:           evt.Rearm,
+		CooldownSeconds: int64(evt.Cooldown / time.Second),
+		Executed:        evt.Executed,
+		TriggerCount:    evt.TriggerCount,
+		Description:     evt.String(),
+	}
+
+	if !evt.LastTriggered.IsZero() {
+		resp.LastTriggered = evt.LastTriggered.Unix()
+	}
+
+	for x := range evt.Conditions {
+		c := &evt.Conditions[x]
+		resp.Conditions = append(resp.Conditions, &gctrpc.EventCondition{
+			Item:          c.Item,
+			Operator:      c.Operator,
+			Value:         c.Value,
+			WindowSeconds: int64(c.Window / time.Second),
+			Side:          c.Side,
+			Price:         c.Price,
+			Currency:      c.Currency.String(),
+			Amount:        c.Amount,
+			QuoteAmount:   c.QuoteAmount,
+			Percent:       c.Percent,
+		})
+	}
+
+	if evt.Order != nil {
+		resp.Order = &gctrpc.EventOrderAction{
+			Side:        string(evt.Order.Side),
+			OrderType:   string(evt.Order.Type),
+			Amount:      evt.Order.Amount,
+			Price:       evt.Order.Price,
+			OrderId:     evt.Order.OrderID,
+			ClientId:    evt.Order.ClientID,
+			MaxNotional: evt.Order.MaxNotional,
+			DryRun:      evt.Order.DryRun,
+		}
+	}
+	return resp
+}
+
+func rpcCoins(coins []portfolio.Coin) []*gctrpc.Coin {
+	sort.Slice(coins, func(i, j int) bool {
+		return coins[i].Coin.String() < coins[j].Coin.String()
+	})
+
+	var resp []*gctrpc.Coin
+	for x := range coins {
+		resp = append(resp, &gctrpc.Coin{
+			Coin:       coins[x].Coin.String(),
+			Balance:    coins[x].Balance,
+			Address:    coins[x].Address,
+			Percentage: coins[x].Percentage,
+		})
+	}
+	return resp
+}
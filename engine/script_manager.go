@@ -0,0 +1,243 @@
+package engine
+
+import (
+	"context"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+	"github.com/thrasher-corp/gocryptotrader/gctscript"
+	log "github.com/thrasher-corp/gocryptotrader/logger"
+)
+
+// scriptManagerDir is the folder in the data directory scripts are loaded
+// from
+const scriptManagerDir = "scripts"
+
+// scriptManager runs scripts from the data directory on a schedule or as
+// ticker and orderbook updates arrive, scripts access exchanges through the
+// engine
+type scriptManager struct {
+	started int32
+	manager *gctscript.Manager
+	engine  *Engine
+	m       sync.RWMutex
+}
+
+// Start creates the script directory and starts the scripts listed in the
+// config
+func (s *scriptManager) Start() error {
+	if !atomic.CompareAndSwapInt32(&s.started, 0, 1) {
+		return ErrSubsystemAlreadyStarted
+	}
+
+	log.Debugln("Script manager starting...")
+	dir := filepath.Join(s.engine.Settings.DataDir, scriptManagerDir)
+	err := common.CreateDir(dir)
+	if err != nil {
+		atomic.StoreInt32(&s.started, 0)
+		return err
+	}
+
+	cfg := s.engine.Config.Scripting
+	m := gctscript.NewManager(dir)
+	m.Wrapper = s
+	m.DryRun = s.engine.Settings.DryRun
+	if cfg.Timeout > 0 {
+		m.Timeout = cfg.Timeout
+	}
+
+	if cfg.MaxScripts > 0 {
+		m.MaxScripts = cfg.MaxScripts
+	}
+
+	s.m.Lock()
+	s.manager = m
+	s.m.Unlock()
+
+	for x := range cfg.Scripts {
+		_, err = m.Start(cfg.Scripts[x].Name, gctscript.Settings{
+			Interval:  cfg.Scripts[x].Interval,
+			Event:     cfg.Scripts[x].Event,
+			Exchange:  cfg.Scripts[x].Exchange,
+			Pair:      cfg.Scripts[x].Pair,
+			AssetType: cfg.Scripts[x].AssetType,
+		})
+		if err != nil {
+			log.Errorf("Script manager unable to start script %s: %s",
+				cfg.Scripts[x].Name, err)
+		}
+	}
+
+	log.Debugf("Script manager started, loading scripts from %s.\n", dir)
+	return nil
+}
+
+// Stop stops all running scripts
+func (s *scriptManager) Stop() error {
+	if !atomic.CompareAndSwapInt32(&s.started, 1, 0) {
+		return ErrSubsystemNotStarted
+	}
+
+	s.m.Lock()
+	m := s.manager
+	s.manager = nil
+	s.m.Unlock()
+
+	m.StopAll()
+	log.Debugln("Script manager shutdown.")
+	return nil
+}
+
+// IsRunning returns whether or not the script manager is running
+func (s *scriptManager) IsRunning() bool {
+	return atomic.LoadInt32(&s.started) == 1
+}
+
+// GetManager returns the underlying script manager or nil if the script
+// manager is not running
+func (s *scriptManager) GetManager() *gctscript.Manager {
+	s.m.RLock()
+	defer s.m.RUnlock()
+	return s.manager
+}
+
+// OnTicker passes a ticker update to the script manager if it is running
+func (s *scriptManager) OnTicker(exchangeName string, assetType asset.Item, t *ticker.Price) {
+	if m := s.GetManager(); m != nil {
+		m.OnTicker(exchangeName, assetType, t)
+	}
+}
+
+// Subscribed returns whether the script manager is running and has a script
+// triggered by the event for the exchange, pair and asset
+func (s *scriptManager) Subscribed(event, exchangeName string, p currency.Pair, assetType asset.Item) bool {
+	m := s.GetManager()
+	return m != nil && m.Subscribed(event, exchangeName, p, assetType)
+}
+
+// OnOrderbook passes an orderbook update to the script manager if it is
+// running
+func (s *scriptManager) OnOrderbook(ob *orderbook.Base) {
+	if m := s.GetManager(); m != nil {
+		m.OnOrderbook(ob)
+	}
+}
+
+// Exchanges returns the names of the enabled exchanges for scripts
+func (s *scriptManager) Exchanges() []string {
+	var names []string
+	exchanges := s.engine.GetExchanges()
+	for x := range exchanges {
+		if exchanges[x].IsEnabled() {
+			names = append(names, exchanges[x].GetName())
+		}
+	}
+	return names
+}
+
+// Ticker returns the latest ticker of an enabled exchange for scripts
+func (s *scriptManager) Ticker(ctx context.Context, exchName string, p currency.Pair, assetType asset.Item) (ticker.Price, error) {
+	exch, err := s.engine.getEnabledExchange(exchName)
+	if err != nil {
+		return ticker.Price{}, err
+	}
+
+	t, err := ticker.GetTicker(exch.GetName(), p, assetType)
+	if err != nil {
+		return exchange.UpdateTickerContext(ctx, exch, p, assetType)
+	}
+	return t, nil
+}
+
+// Orderbook returns the latest orderbook of an enabled exchange for scripts
+func (s *scriptManager) Orderbook(ctx context.Context, exchName string, p currency.Pair, assetType asset.Item) (orderbook.Base, error) {
+	exch, err := s.engine.getEnabledExchange(exchName)
+	if err != nil {
+		return orderbook.Base{}, err
+	}
+
+	ob, err := orderbook.Get(exch.GetName(), p, assetType)
+	if err != nil {
+		return exchange.UpdateOrderbookContext(ctx, exch, p, assetType)
+	}
+	return ob, nil
+}
+
+// Candles returns the candles of an enabled exchange for scripts
+func (s *scriptManager) Candles(ctx context.Context, exchName string, p currency.Pair, assetType asset.Item, start, end time.Time, interval kline.Interval) (kline.Item, error) {
+	exch, err := s.engine.getEnabledExchange(exchName)
+	if err != nil {
+		return kline.Item{}, err
+	}
+	return exchange.GetHistoricCandlesContext(ctx, exch, p, assetType, start,
+		end, interval)
+}
+
+// AccountInfo returns the account info of an enabled exchange for scripts
+func (s *scriptManager) AccountInfo(ctx context.Context, exchName string) (exchange.AccountInfo, error) {
+	exch, err := s.engine.getEnabledExchange(exchName)
+	if err != nil {
+		return exchange.AccountInfo{}, err
+	}
+
+	if !exch.GetAuthenticatedAPISupport(exchange.RestAuthentication) {
+		return exchange.AccountInfo{}, ErrAuthenticationNotOn
+	}
+	return s.engine.GetAccountInfoContext(ctx, exch)
+}
+
+// ActiveOrders returns the open orders of an enabled exchange for scripts,
+// filtered by the pair if it is set
+func (s *scriptManager) ActiveOrders(ctx context.Context, exchName string, p currency.Pair) ([]exchange.OrderDetail, error) {
+	exch, err := s.engine.getEnabledExchange(exchName)
+	if err != nil {
+		return nil, err
+	}
+
+	if !exch.GetAuthenticatedAPISupport(exchange.RestAuthentication) {
+		return nil, ErrAuthenticationNotOn
+	}
+
+	var req exchange.GetOrdersRequest
+	if !p.IsEmpty() {
+		req.Currencies = []currency.Pair{p}
+	}
+	return exchange.GetActiveOrdersContext(ctx, exch, &req)
+}
+
+// SubmitOrder places an order for a script through the order manager and
+// returns its internal order ID
+func (s *scriptManager) SubmitOrder(ctx context.Context, exchName string, p currency.Pair, side exchange.OrderSide, orderType exchange.OrderType, amount, price float64, clientID string) (string, error) {
+	ord, err := s.engine.orderManager.SubmitContext(ctx, &OrderSubmission{
+		Exchange: exchName,
+		Pair:     p,
+		Side:     side,
+		Type:     orderType,
+		Amount:   amount,
+		Price:    price,
+		ClientID: clientID,
+	})
+	if err != nil {
+		return "", err
+	}
+	return ord.ID, nil
+}
+
+// CancelOrder cancels an order for a script by either its internal or
+// exchange order ID
+func (s *scriptManager) CancelOrder(ctx context.Context, exchName, orderID string) error {
+	ord, err := s.engine.orderManager.GetExchangeOrder(exchName, orderID)
+	if err != nil {
+		return err
+	}
+	return s.engine.orderManager.CancelContext(ctx, ord.ID)
+}
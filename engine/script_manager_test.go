@@ -0,0 +1,116 @@
+package engine
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/config"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+)
+
+func TestScriptManager(t *testing.T) {
+	e, exch, cleanup := setupOrderManagerTest(t)
+	defer cleanup()
+	e.Config = &config.Config{
+		Webserver: config.WebserverConfig{
+			AdminUsername: "admin",
+			AdminPassword: "Password",
+			ListenAddress: "localhost:9050",
+		},
+		Scripting: config.ScriptingConfig{
+			Scripts: []config.ScriptConfig{{Name: "missing"}},
+		},
+	}
+
+	resp := makeAuthRequest(t, e, http.MethodGet, "/scripts", nil)
+	if resp.Code != http.StatusServiceUnavailable {
+		t.Errorf("Test failed. Expected status %d got %d",
+			http.StatusServiceUnavailable, resp.Code)
+	}
+
+	err := e.orderManager.Start()
+	if err != nil {
+		t.Fatalf("Test failed. Unable to start order manager: %s", err)
+	}
+	defer e.orderManager.Stop()
+
+	// Failing to start the scripts listed in the config does not stop the
+	// script manager starting
+	err = e.scriptManager.Start()
+	if err != nil {
+		t.Fatalf("Test failed. Unable to start script manager: %s", err)
+	}
+	defer e.scriptManager.Stop()
+
+	err = ioutil.WriteFile(filepath.Join(e.Settings.DataDir, scriptManagerDir,
+		"buy.gct"), []byte(`
+gct := import("gct")
+id := gct.submit_order("OrderTest", "BTC-USD", "buy", "limit", 1, 1000)
+gct.cancel_order("OrderTest", id)
+`), 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resp = makeAuthRequest(t, e, http.MethodPost, "/scripts/rawr/start", nil)
+	if resp.Code != http.StatusNotFound {
+		t.Errorf("Test failed. Expected status %d got %d", http.StatusNotFound,
+			resp.Code)
+	}
+
+	resp = makeAuthRequest(t, e, http.MethodPost, "/scripts/buy/start",
+		map[string]string{"event": "trades"})
+	if resp.Code != http.StatusBadRequest {
+		t.Errorf("Test failed. Expected status %d got %d", http.StatusBadRequest,
+			resp.Code)
+	}
+
+	resp = makeAuthRequest(t, e, http.MethodPost, "/scripts/buy/start", nil)
+	if resp.Code != http.StatusCreated {
+		t.Fatalf("Test failed. Expected status %d got %d", http.StatusCreated,
+			resp.Code)
+	}
+
+	var orders []Order
+	for x := 0; x < 100; x++ {
+		orders = e.orderManager.GetOrders(&OrderFilter{})
+		if len(orders) == 1 && orders[0].Status == exchange.CancelledOrderStatus {
+			break
+		}
+		time.Sleep(time.Millisecond * 10)
+	}
+
+	if len(orders) != 1 || orders[0].Status != exchange.CancelledOrderStatus ||
+		len(exch.cancelled) != 1 || exch.cancelled[0] != "exch1" {
+		t.Errorf("Test failed. Unexpected orders %+v", orders)
+	}
+
+	resp = makeAuthRequest(t, e, http.MethodGet, "/scripts", nil)
+	var scripts RESTScriptsResponse
+	err = json.NewDecoder(resp.Body).Decode(&scripts)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(scripts.Available) != 1 || scripts.Available[0] != "buy.gct" ||
+		len(scripts.Scripts) != 1 || scripts.Scripts[0].Runs != 1 ||
+		scripts.Scripts[0].Errors != 0 {
+		t.Errorf("Test failed. Unexpected scripts %+v", scripts)
+	}
+
+	resp = makeAuthRequest(t, e, http.MethodPost, "/scripts/buy/stop", nil)
+	if resp.Code != http.StatusOK {
+		t.Errorf("Test failed. Expected status %d got %d", http.StatusOK,
+			resp.Code)
+	}
+
+	resp = makeAuthRequest(t, e, http.MethodPost, "/scripts/buy/stop", nil)
+	if resp.Code != http.StatusNotFound {
+		t.Errorf("Test failed. Expected status %d got %d", http.StatusNotFound,
+			resp.Code)
+	}
+}
@@ -0,0 +1,100 @@
+package engine
+
+import (
+	"errors"
+	"strings"
+)
+
+// Subsystem names which can be used to enable or disable an engine subsystem
+// at runtime
+const (
+	SubsystemNTPManager        = "ntp_timekeeper"
+	SubsystemConnectionManager = "connectivity_monitor"
+	SubsystemCommsManager      = "communications"
+	SubsystemOrderManager      = "order_manager"
+	SubsystemEventManager      = "event_manager"
+	SubsystemScriptManager     = "script_manager"
+	SubsystemPortfolioManager  = "portfolio_watcher"
+	SubsystemWebserver         = "webserver"
+	SubsystemGRPCServer        = "grpc_server"
+	SubsystemSyncManager       = "sync_manager"
+	SubsystemTickerUpdater     = "ticker_updater"
+	SubsystemOrderbookUpdater  = "orderbook_updater"
+	SubsystemWebsocketRoutine  = "websocket_routine"
+	SubsystemConfigWatcher     = "config_watcher"
+)
+
+// Subsystem errors
+var (
+	ErrSubsystemAlreadyStarted = errors.New("subsystem already started")
+	ErrSubsystemNotStarted     = errors.New("subsystem not started")
+	ErrSubsystemNotFound       = errors.New("subsystem not found")
+)
+
+// Subsystem is the contract each engine subsystem must satisfy so that it can
+// be started and stopped independently at runtime
+type Subsystem interface {
+	Start() error
+	Stop() error
+	IsRunning() bool
+}
+
+// namedSubsystem pairs a subsystem with its name
+type namedSubsystem struct {
+	name      string
+	subsystem Subsystem
+}
+
+// subsystems returns the engine subsystems in the order they are started
+func (e *Engine) subsystems() []namedSubsystem {
+	return []namedSubsystem{
+		{SubsystemNTPManager, &e.ntpManager},
+		{SubsystemConnectionManager, &e.connectionManager},
+		{SubsystemCommsManager, &e.commsManager},
+		{SubsystemOrderManager, &e.orderManager},
+		{SubsystemEventManager, &e.eventManager},
+		{SubsystemScriptManager, &e.scriptManager},
+		{SubsystemWebserver, &e.webserverManager},
+		{SubsystemGRPCServer, &e.grpcServer},
+		{SubsystemPortfolioManager, &e.portfolioManager},
+		{SubsystemSyncManager, &e.syncManager},
+		{SubsystemTickerUpdater, &e.tickerUpdater},
+		{SubsystemOrderbookUpdater, &e.orderbookUpdater},
+		{SubsystemWebsocketRoutine, &e.websocketRoutineMgr},
+		{SubsystemConfigWatcher, &e.configWatcher},
+	}
+}
+
+// GetSubsystem returns a subsystem by name
+func (e *Engine) GetSubsystem(name string) (Subsystem, error) {
+	subsystems := e.subsystems()
+	for x := range subsystems {
+		if strings.EqualFold(subsystems[x].name, name) {
+			return subsystems[x].subsystem, nil
+		}
+	}
+	return nil, ErrSubsystemNotFound
+}
+
+// GetSubsystemsStatus returns the running status of each engine subsystem
+func (e *Engine) GetSubsystemsStatus() map[string]bool {
+	status := make(map[string]bool)
+	subsystems := e.subsystems()
+	for x := range subsystems {
+		status[subsystems[x].name] = subsystems[x].subsystem.IsRunning()
+	}
+	return status
+}
+
+// SetSubsystem enables or disables an engine subsystem by name
+func (e *Engine) SetSubsystem(name string, enable bool) error {
+	s, err := e.GetSubsystem(name)
+	if err != nil {
+		return err
+	}
+
+	if enable {
+		return s.Start()
+	}
+	return s.Stop()
+}
@@ -0,0 +1,55 @@
+package engine
+
+import (
+	"testing"
+)
+
+func TestGetSubsystem(t *testing.T) {
+	e := &Engine{}
+	e.setupSubsystems()
+
+	if _, err := e.GetSubsystem(SubsystemPortfolioManager); err != nil {
+		t.Errorf("Test failed. TestGetSubsystem: %s", err)
+	}
+
+	if _, err := e.GetSubsystem("asdf"); err != ErrSubsystemNotFound {
+		t.Errorf("Test failed. TestGetSubsystem: Expected %s, got %v",
+			ErrSubsystemNotFound, err)
+	}
+}
+
+func TestSetSubsystem(t *testing.T) {
+	e := &Engine{Config: loadConfig(t)}
+	e.Portfolio = &e.Config.Portfolio
+	e.setupSubsystems()
+
+	err := e.SetSubsystem(SubsystemPortfolioManager, false)
+	if err != ErrSubsystemNotStarted {
+		t.Errorf("Test failed. TestSetSubsystem: Expected %s, got %v",
+			ErrSubsystemNotStarted, err)
+	}
+
+	err = e.SetSubsystem(SubsystemCommsManager, true)
+	if err != nil {
+		t.Errorf("Test failed. TestSetSubsystem: %s", err)
+	}
+
+	if !e.GetSubsystemsStatus()[SubsystemCommsManager] {
+		t.Error("Test failed. TestSetSubsystem: Communications manager should be running")
+	}
+
+	err = e.SetSubsystem(SubsystemCommsManager, true)
+	if err != ErrSubsystemAlreadyStarted {
+		t.Errorf("Test failed. TestSetSubsystem: Expected %s, got %v",
+			ErrSubsystemAlreadyStarted, err)
+	}
+
+	err = e.SetSubsystem(SubsystemCommsManager, false)
+	if err != nil {
+		t.Errorf("Test failed. TestSetSubsystem: %s", err)
+	}
+
+	if e.GetSubsystemsStatus()[SubsystemCommsManager] {
+		t.Error("Test failed. TestSetSubsystem: Communications manager should be stopped")
+	}
+}
@@ -0,0 +1,379 @@
+package engine
+
+import (
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+	log "github.com/thrasher-corp/gocryptotrader/logger"
+)
+
+const (
+	// DefaultSyncTimeout is the time without any traffic on an exchange
+	// websocket connection after which its market data falls back to REST
+	DefaultSyncTimeout = time.Second * 30
+	// DefaultSyncItemTimeout is the time without a websocket update of a
+	// currency pairs ticker or orderbook after which it falls back to REST,
+	// it is longer than the sync timeout so that illiquid pairs are not
+	// fetched over REST between their updates
+	DefaultSyncItemTimeout = time.Minute * 5
+	// DefaultSyncRESTInterval is the default delay between each REST ticker
+	// and orderbook fetch cycle
+	DefaultSyncRESTInterval = restUpdateDelay
+)
+
+// Market data sync sources
+const (
+	SyncSourceWebsocket = "websocket"
+	SyncSourceREST      = "rest"
+)
+
+// syncItem is a type of market data tracked by the sync manager
+type syncItem int
+
+const (
+	syncItemTicker syncItem = iota
+	syncItemOrderbook
+)
+
+// String returns the name of the market data type
+func (s syncItem) String() string {
+	if s == syncItemOrderbook {
+		return "orderbook"
+	}
+	return "ticker"
+}
+
+// SyncItemStatus holds the freshness of a currency pairs ticker or orderbook
+// and the source it is currently fed by
+type SyncItemStatus struct {
+	Source              string    `json:"source"`
+	Synced              bool      `json:"synced"`
+	LastUpdated         time.Time `json:"lastUpdated"`
+	LastWebsocketUpdate time.Time `json:"lastWebsocketUpdate"`
+	LastRESTUpdate      time.Time `json:"lastRESTUpdate"`
+}
+
+// SyncStatus holds the market data sync status of an exchange currency pair
+type SyncStatus struct {
+	Exchange           string         `json:"exchange"`
+	AssetType          asset.Item     `json:"assetType"`
+	Pair               currency.Pair  `json:"pair"`
+	WebsocketConnected bool           `json:"websocketConnected"`
+	Ticker             SyncItemStatus `json:"ticker"`
+	Orderbook          SyncItemStatus `json:"orderbook"`
+}
+
+// syncKey identifies the market data of an exchange currency pair
+type syncKey struct {
+	exchange  string
+	assetType asset.Item
+	pair      string
+}
+
+// syncTimes holds when market data was last received from each source and
+// the source which currently feeds it
+type syncTimes struct {
+	websocket time.Time
+	rest      time.Time
+	source    string
+}
+
+// websocketHealth holds the connection state of an exchange websocket
+type websocketHealth struct {
+	connected   bool
+	connectedAt time.Time
+	lastTraffic time.Time
+}
+
+// syncManager tracks the ticker and orderbook freshness of each exchange
+// currency pair. Market data is taken from the exchange websocket once a pair
+// has received websocket data on the current connection, the REST updaters
+// only fetch the market data of pairs whose websocket is disconnected, has
+// carried no traffic for longer than the sync timeout or has not updated the
+// market data for longer than the sync item timeout
+type syncManager struct {
+	started int32
+	engine  *Engine
+
+	m          sync.Mutex
+	pairs      map[syncKey]*[2]syncTimes
+	websockets map[string]*websocketHealth
+}
+
+// Start starts tracking market data
+func (s *syncManager) Start() error {
+	if !atomic.CompareAndSwapInt32(&s.started, 0, 1) {
+		return ErrSubsystemAlreadyStarted
+	}
+
+	s.m.Lock()
+	s.pairs = make(map[syncKey]*[2]syncTimes)
+	if s.websockets == nil {
+		s.websockets = make(map[string]*websocketHealth)
+	}
+	s.m.Unlock()
+	log.Debugf("Sync manager started, sync timeout %s REST interval %s.\n",
+		s.timeout(), s.engine.restInterval())
+	return nil
+}
+
+// Stop stops tracking market data, the REST updaters then fetch the market
+// data of every currency pair
+func (s *syncManager) Stop() error {
+	if !atomic.CompareAndSwapInt32(&s.started, 1, 0) {
+		return ErrSubsystemNotStarted
+	}
+
+	log.Debugln("Sync manager shutdown.")
+	return nil
+}
+
+// IsRunning returns whether or not the sync manager is running
+func (s *syncManager) IsRunning() bool {
+	return atomic.LoadInt32(&s.started) == 1
+}
+
+// timeout returns the time without websocket traffic after which market data
+// falls back to REST
+func (s *syncManager) timeout() time.Duration {
+	if s.engine.Settings.SyncTimeout > 0 {
+		return s.engine.Settings.SyncTimeout
+	}
+	return DefaultSyncTimeout
+}
+
+// itemTimeout returns the time without a websocket update of market data
+// after which it falls back to REST
+func (s *syncManager) itemTimeout() time.Duration {
+	if s.engine.Settings.SyncItemTimeout > 0 {
+		return s.engine.Settings.SyncItemTimeout
+	}
+	return DefaultSyncItemTimeout
+}
+
+// restInterval returns the delay between each REST ticker and orderbook fetch
+// cycle
+func (e *Engine) restInterval() time.Duration {
+	if e.Settings.SyncRESTInterval > 0 {
+		return e.Settings.SyncRESTInterval
+	}
+	return DefaultSyncRESTInterval
+}
+
+// newSyncKey returns the key of an exchange currency pair, pairs are keyed by
+// their upper case currencies so that websocket and REST pair formats match
+func newSyncKey(exchName string, assetType asset.Item, p currency.Pair) syncKey {
+	return syncKey{
+		exchange:  strings.ToLower(exchName),
+		assetType: assetType,
+		pair:      p.Base.Upper().String() + "/" + p.Quote.Upper().String(),
+	}
+}
+
+// times returns the sync times of an exchange currency pair, the caller must
+// hold the lock
+func (s *syncManager) times(k syncKey) *[2]syncTimes {
+	t, ok := s.pairs[k]
+	if !ok {
+		t = new([2]syncTimes)
+		s.pairs[k] = t
+	}
+	return t
+}
+
+// source returns the source which feeds the market data, the websocket is
+// used while its connection is healthy and it has delivered the market data
+// since it connected and within the sync item timeout
+func (s *syncManager) source(t *syncTimes, h *websocketHealth) string {
+	if s.healthy(h) && !t.websocket.IsZero() &&
+		!t.websocket.Before(h.connectedAt) &&
+		time.Since(t.websocket) <= s.itemTimeout() {
+		return SyncSourceWebsocket
+	}
+	return SyncSourceREST
+}
+
+// healthy returns whether a websocket is connected and has carried traffic
+// within the sync timeout
+func (s *syncManager) healthy(h *websocketHealth) bool {
+	return h != nil && h.connected && time.Since(h.lastTraffic) <= s.timeout()
+}
+
+// health returns the connection state of an exchange websocket, the caller
+// must hold the lock
+func (s *syncManager) health(exchName string) *websocketHealth {
+	if s.websockets == nil {
+		s.websockets = make(map[string]*websocketHealth)
+	}
+	h, ok := s.websockets[strings.ToLower(exchName)]
+	if !ok {
+		h = new(websocketHealth)
+		s.websockets[strings.ToLower(exchName)] = h
+	}
+	return h
+}
+
+// setWebsocketConnected records whether the exchange websocket is connected,
+// market data of a disconnected websocket falls back to REST immediately
+func (s *syncManager) setWebsocketConnected(exchName string, connected bool) {
+	s.m.Lock()
+	h := s.health(exchName)
+	if connected && !h.connected {
+		h.connectedAt = time.Now()
+		h.lastTraffic = h.connectedAt
+	}
+	h.connected = connected
+	s.m.Unlock()
+}
+
+// traffic records that data has been received on an exchange websocket
+func (s *syncManager) traffic(exchName string) {
+	if !s.IsRunning() {
+		return
+	}
+
+	s.m.Lock()
+	s.health(exchName).lastTraffic = time.Now()
+	s.m.Unlock()
+}
+
+// update records that market data of an exchange currency pair has been
+// received from the websocket or REST
+func (s *syncManager) update(exchName string, assetType asset.Item, p currency.Pair, item syncItem, websocket bool) {
+	if !s.IsRunning() {
+		return
+	}
+
+	s.m.Lock()
+	defer s.m.Unlock()
+	t := &s.times(newSyncKey(exchName, assetType, p))[item]
+	if websocket {
+		t.websocket = time.Now()
+		s.health(exchName).lastTraffic = t.websocket
+		return
+	}
+	t.rest = time.Now()
+}
+
+// requiresREST returns whether the market data of an exchange currency pair
+// must be fetched over REST. All market data is fetched over REST while the
+// sync manager is not running
+func (s *syncManager) requiresREST(exchName string, assetType asset.Item, p currency.Pair, item syncItem) bool {
+	if !s.IsRunning() {
+		return true
+	}
+
+	s.m.Lock()
+	defer s.m.Unlock()
+	t := &s.times(newSyncKey(exchName, assetType, p))[item]
+	h := s.websockets[strings.ToLower(exchName)]
+	source := s.source(t, h)
+	if source != t.source {
+		switch {
+		case source == SyncSourceWebsocket:
+			log.Debugf("%s %s %s %s synced via websocket, REST fetching stopped.\n",
+				exchName, assetType, p, item)
+		case t.source != "" && s.healthy(h):
+			log.Warnf("%s %s %s %s websocket data stale, falling back to REST.\n",
+				exchName, assetType, p, item)
+		case t.source != "":
+			log.Warnf("%s %s %s %s websocket connection unhealthy, falling back to REST.\n",
+				exchName, assetType, p, item)
+		}
+		t.source = source
+	}
+	return source == SyncSourceREST
+}
+
+// status returns the sync status of the market data of an exchange currency
+// pair
+func (s *syncManager) status(exchName string, assetType asset.Item, p currency.Pair) SyncStatus {
+	s.m.Lock()
+	defer s.m.Unlock()
+	h := s.websockets[strings.ToLower(exchName)]
+	result := SyncStatus{
+		Exchange:           exchName,
+		AssetType:          assetType,
+		Pair:               p,
+		WebsocketConnected: h != nil && h.connected,
+	}
+
+	var times [2]syncTimes
+	if t, ok := s.pairs[newSyncKey(exchName, assetType, p)]; ok {
+		times = *t
+	}
+
+	items := []*SyncItemStatus{&result.Ticker, &result.Orderbook}
+	for x := range items {
+		t := &times[x]
+		items[x].Source = s.source(t, h)
+		items[x].LastWebsocketUpdate = t.websocket
+		items[x].LastRESTUpdate = t.rest
+		items[x].LastUpdated = t.websocket
+		if t.rest.After(t.websocket) {
+			items[x].LastUpdated = t.rest
+		}
+
+		// Websocket market data is only used while it has been updated within
+		// the sync item timeout, REST market data is synced when it has been
+		// fetched within a fetch cycle and the sync timeout
+		if items[x].Source == SyncSourceWebsocket {
+			items[x].Synced = true
+		} else {
+			items[x].Synced = !t.rest.IsZero() &&
+				time.Since(t.rest) <= s.engine.restInterval()+s.timeout()
+		}
+	}
+	return result
+}
+
+// GetSyncStatus returns the market data sync status of each enabled currency
+// pair of an exchange, or of every exchange when the exchange name is empty
+func (e *Engine) GetSyncStatus(exchName string) ([]SyncStatus, error) {
+	if !e.syncManager.IsRunning() {
+		return nil, ErrSubsystemNotStarted
+	}
+
+	exchanges := e.GetExchanges()
+	if exchName != "" {
+		exch, err := e.getEnabledExchange(exchName)
+		if err != nil {
+			return nil, err
+		}
+		exchanges = []exchange.IBotExchange{exch}
+	}
+
+	var result []SyncStatus
+	for x := range exchanges {
+		assetTypes := exchanges[x].GetAssetTypes()
+		for y := range assetTypes {
+			pairs := exchanges[x].GetEnabledPairs(assetTypes[y])
+			for z := range pairs {
+				result = append(result, e.syncManager.status(exchanges[x].GetName(),
+					assetTypes[y], pairs[z]))
+			}
+		}
+	}
+	return result, nil
+}
+
+// storeWebsocketTicker stores a ticker received from a websocket. The bid and
+// ask of the stored ticker are kept when the websocket ticker carries none,
+// such tickers keep being fetched over REST for their bid and ask
+func storeWebsocketTicker(exchName string, assetType asset.Item, t *ticker.Price) error {
+	stored, err := ticker.GetTicker(exchName, t.Pair, assetType)
+	if err == nil {
+		if t.Bid == 0 || t.Ask == 0 {
+			t.Bid = stored.Bid
+			t.Ask = stored.Ask
+		}
+		t.PriceATH = stored.PriceATH
+	}
+	return ticker.ProcessTicker(exchName, t, assetType)
+}
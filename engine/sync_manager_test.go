@@ -0,0 +1,176 @@
+package engine
+
+import (
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+)
+
+func TestSyncManagerRequiresREST(t *testing.T) {
+	e := &Engine{Settings: Settings{SyncTimeout: time.Minute}}
+	e.setupSubsystems()
+	p := currency.NewPairFromStrings("BTC", "USD")
+
+	if !e.syncManager.requiresREST("Bitfinex", asset.Spot, p, syncItemTicker) {
+		t.Error("Test failed. Expected REST while the sync manager is stopped")
+	}
+
+	err := e.syncManager.Start()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer e.syncManager.Stop()
+
+	e.syncManager.update("Bitfinex", asset.Spot, p, syncItemTicker, true)
+	if !e.syncManager.requiresREST("Bitfinex", asset.Spot, p, syncItemTicker) {
+		t.Error("Test failed. Expected REST while the websocket is disconnected")
+	}
+
+	e.syncManager.setWebsocketConnected("Bitfinex", true)
+	if !e.syncManager.requiresREST("Bitfinex", asset.Spot, p, syncItemTicker) {
+		t.Error("Test failed. Expected REST until data is received on the connection")
+	}
+
+	e.syncManager.update("Bitfinex", asset.Spot, p, syncItemTicker, true)
+	if e.syncManager.requiresREST("bitfinex", asset.Spot, currency.NewPairFromString("btc-usd"), syncItemTicker) {
+		t.Error("Test failed. Expected websocket data to be used")
+	}
+
+	if !e.syncManager.requiresREST("Bitfinex", asset.Spot, p, syncItemOrderbook) {
+		t.Error("Test failed. Expected REST for an orderbook without websocket data")
+	}
+
+	// A quiet pair keeps using a healthy websocket connection until its
+	// data is older than the sync item timeout
+	e.syncManager.m.Lock()
+	e.syncManager.websockets["bitfinex"].connectedAt = time.Now().Add(-time.Hour * 2)
+	e.syncManager.pairs[newSyncKey("Bitfinex", asset.Spot, p)][syncItemTicker].websocket = time.Now().Add(-time.Minute * 2)
+	e.syncManager.m.Unlock()
+	e.syncManager.traffic("Bitfinex")
+	if e.syncManager.requiresREST("Bitfinex", asset.Spot, p, syncItemTicker) {
+		t.Error("Test failed. Expected websocket data of a quiet pair to be used")
+	}
+
+	e.syncManager.m.Lock()
+	e.syncManager.pairs[newSyncKey("Bitfinex", asset.Spot, p)][syncItemTicker].websocket = time.Now().Add(-time.Hour)
+	e.syncManager.m.Unlock()
+	if !e.syncManager.requiresREST("Bitfinex", asset.Spot, p, syncItemTicker) {
+		t.Error("Test failed. Expected REST once the websocket data is stale")
+	}
+	if e.syncManager.status("Bitfinex", asset.Spot, p).Ticker.Synced {
+		t.Error("Test failed. Expected stale websocket data not to be synced")
+	}
+
+	e.syncManager.update("Bitfinex", asset.Spot, p, syncItemTicker, true)
+	if e.syncManager.requiresREST("Bitfinex", asset.Spot, p, syncItemTicker) {
+		t.Error("Test failed. Expected websocket data to be used once updated")
+	}
+
+	e.syncManager.m.Lock()
+	e.syncManager.websockets["bitfinex"].lastTraffic = time.Now().Add(-time.Hour)
+	e.syncManager.m.Unlock()
+	if !e.syncManager.requiresREST("Bitfinex", asset.Spot, p, syncItemTicker) {
+		t.Error("Test failed. Expected REST once the websocket traffic has stalled")
+	}
+
+	e.syncManager.setWebsocketConnected("Bitfinex", false)
+	e.syncManager.setWebsocketConnected("Bitfinex", true)
+	if !e.syncManager.requiresREST("Bitfinex", asset.Spot, p, syncItemTicker) {
+		t.Error("Test failed. Expected REST until data is received on the new connection")
+	}
+
+	e.syncManager.update("Bitfinex", asset.Spot, p, syncItemTicker, true)
+	e.syncManager.setWebsocketConnected("Bitfinex", false)
+	if !e.syncManager.requiresREST("Bitfinex", asset.Spot, p, syncItemTicker) {
+		t.Error("Test failed. Expected REST once the websocket has disconnected")
+	}
+}
+
+func TestGetSyncStatus(t *testing.T) {
+	SetupTest(t)
+	defer CleanupTest(t)
+
+	_, err := testBot.GetSyncStatus("")
+	if err != ErrSubsystemNotStarted {
+		t.Errorf("Test failed. Expected %s got %v", ErrSubsystemNotStarted, err)
+	}
+
+	err = testBot.syncManager.Start()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer testBot.syncManager.Stop()
+
+	_, err = testBot.GetSyncStatus("Bitstamp")
+	if err != ErrExchangeNotFound {
+		t.Errorf("Test failed. Expected %s got %v", ErrExchangeNotFound, err)
+	}
+
+	pairs := testBot.GetExchangeByName("Bitfinex").GetEnabledPairs(asset.Spot)
+	testBot.syncManager.setWebsocketConnected("Bitfinex", true)
+	testBot.syncManager.update("Bitfinex", asset.Spot, pairs[0], syncItemTicker, true)
+	testBot.syncManager.update("Bitfinex", asset.Spot, pairs[0], syncItemOrderbook, false)
+
+	status, err := testBot.GetSyncStatus("Bitfinex")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(status) != len(pairs) {
+		t.Fatalf("Test failed. Expected %d pairs got %d", len(pairs), len(status))
+	}
+
+	s := status[0]
+	if !s.WebsocketConnected || s.Ticker.Source != SyncSourceWebsocket ||
+		!s.Ticker.Synced || s.Ticker.LastWebsocketUpdate.IsZero() {
+		t.Errorf("Test failed. Unexpected ticker status %+v", s)
+	}
+
+	if s.Orderbook.Source != SyncSourceREST || !s.Orderbook.Synced ||
+		s.Orderbook.LastUpdated != s.Orderbook.LastRESTUpdate {
+		t.Errorf("Test failed. Unexpected orderbook status %+v", s)
+	}
+
+	if len(status) > 1 && (status[1].Ticker.Synced || status[1].Ticker.Source != SyncSourceREST) {
+		t.Errorf("Test failed. Expected an unsynced REST ticker %+v", status[1])
+	}
+}
+
+func TestStoreWebsocketTicker(t *testing.T) {
+	p := currency.NewPairFromStrings("SYNC", "USD")
+	err := ticker.ProcessTicker("synctest", &ticker.Price{Pair: p, Bid: 1, Ask: 2, Last: 1.5}, asset.Spot)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = storeWebsocketTicker("synctest", asset.Spot, &ticker.Price{Pair: p, Last: 3})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	result, err := ticker.GetTicker("synctest", p, asset.Spot)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if result.Last != 3 || result.Bid != 1 || result.Ask != 2 {
+		t.Errorf("Test failed. Unexpected stored ticker %+v", result)
+	}
+
+	err = storeWebsocketTicker("synctest", asset.Spot, &ticker.Price{Pair: p, Last: 3, Bid: 2.5, Ask: 3.5})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	result, err = ticker.GetTicker("synctest", p, asset.Spot)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if result.Bid != 2.5 || result.Ask != 3.5 {
+		t.Errorf("Test failed. Expected the websocket bid and ask to be stored %+v", result)
+	}
+}
@@ -0,0 +1,122 @@
+package engine
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	log "github.com/thrasher-corp/gocryptotrader/logger"
+)
+
+// webserverShutdownTimeout is the maximum time to wait for in-flight HTTP
+// requests to complete when stopping the webserver
+const webserverShutdownTimeout = time.Second * 5
+
+// webserverManager runs the RESTful HTTP server and the websocket hub
+type webserverManager struct {
+	started int32
+	server  *http.Server
+	hub     *WebsocketHub
+	engine  *Engine
+	m       sync.Mutex
+}
+
+// Start starts the HTTP server and websocket hub
+func (w *webserverManager) Start() error {
+	if !atomic.CompareAndSwapInt32(&w.started, 0, 1) {
+		return ErrSubsystemAlreadyStarted
+	}
+
+	listenAddr := w.engine.Config.Webserver.ListenAddress
+	log.Debugf(
+		"HTTP Webserver support enabled. Listen URL: http://%s:%d/\n",
+		common.ExtractHost(listenAddr), common.ExtractPort(listenAddr),
+	)
+
+	log.Debugln("Starting websocket handler.")
+	hub := NewWebsocketHub(w.engine)
+	go hub.run()
+
+	server := &http.Server{
+		Addr:    listenAddr,
+		Handler: w.engine.NewRouter(),
+	}
+
+	w.m.Lock()
+	w.hub = hub
+	w.server = server
+	w.m.Unlock()
+
+	go func() {
+		err := server.ListenAndServe()
+		if err != nil && err != http.ErrServerClosed {
+			log.Errorf("HTTP Webserver failure: %s", err)
+		}
+	}()
+
+	log.Debugln("HTTP Webserver started successfully.")
+	return nil
+}
+
+// Stop gracefully shuts down the HTTP server and websocket hub
+func (w *webserverManager) Stop() error {
+	if !atomic.CompareAndSwapInt32(&w.started, 1, 0) {
+		return ErrSubsystemNotStarted
+	}
+
+	w.m.Lock()
+	defer w.m.Unlock()
+	w.hub.Shutdown()
+	w.hub = nil
+
+	ctx, cancel := context.WithTimeout(context.Background(),
+		webserverShutdownTimeout)
+	defer cancel()
+	err := w.server.Shutdown(ctx)
+	w.server = nil
+	if err != nil {
+		return err
+	}
+
+	log.Debugln("HTTP Webserver shutdown.")
+	return nil
+}
+
+// IsRunning returns whether or not the webserver is running
+func (w *webserverManager) IsRunning() bool {
+	return atomic.LoadInt32(&w.started) == 1
+}
+
+// getHub returns the websocket hub if the webserver is running
+func (w *webserverManager) getHub() *WebsocketHub {
+	w.m.Lock()
+	defer w.m.Unlock()
+	return w.hub
+}
+
+// HasWebsocketSubscribers returns whether any websocket client is subscribed
+// to the event of the exchange, pair and asset
+func (w *webserverManager) HasWebsocketSubscribers(event, exchangeName string, assetType asset.Item, p currency.Pair) bool {
+	hub := w.getHub()
+	return hub != nil && hub.HasSubscribers(WebsocketEvent{
+		Event:     event,
+		Exchange:  exchangeName,
+		AssetType: assetType.String(),
+		Pair:      p.String(),
+	})
+}
+
+// BroadcastWebsocketMessage broadcasts a websocket event to all connected
+// websocket clients
+func (w *webserverManager) BroadcastWebsocketMessage(evt WebsocketEvent) error {
+	hub := w.getHub()
+	if hub == nil {
+		return errWebsocketServiceNotStarted
+	}
+	return hub.BroadcastWebsocketMessage(evt)
+}
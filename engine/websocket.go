@@ -0,0 +1,940 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	log "github.com/thrasher-corp/gocryptotrader/logger"
+)
+
+// Const vars for websocket
+const (
+	WebsocketResponseSuccess = "OK"
+
+	WebsocketEventTickerUpdate    = "ticker_update"
+	WebsocketEventOrderbookUpdate = "orderbook_update"
+
+	WebsocketDataTypeTicker    = "ticker"
+	WebsocketDataTypeOrderbook = "orderbook"
+
+	// websocketMaxSubscriptions is the maximum number of topics a client can
+	// subscribe to
+	websocketMaxSubscriptions = 100
+	// websocketWriteWait is how long a client has to accept a message before
+	// it is disconnected
+	websocketWriteWait = time.Second * 10
+)
+
+var (
+	errWebsocketServiceNotStarted    = errors.New("websocket service not started")
+	errWebsocketInvalidDataType      = errors.New("invalid subscription data type")
+	errWebsocketSubscriptionLimit    = errors.New("subscription limit reached")
+	errWebsocketSubscriptionNotFound = errors.New("subscription not found")
+	errWebsocketClientClosed         = errors.New("websocket client closed")
+)
+
+// websocketEventDataTypes maps the broadcast events to the data types clients
+// subscribe to
+var websocketEventDataTypes = map[string]string{
+	WebsocketEventTickerUpdate:    WebsocketDataTypeTicker,
+	WebsocketEventOrderbookUpdate: WebsocketDataTypeOrderbook,
+}
+
+type wsCommandHandler struct {
+	authRequired bool
+	handler      func(client *WebsocketClient, data interface{}) error
+}
+
+var wsHandlers = map[string]wsCommandHandler{
+	"auth":                     {authRequired: false, handler: wsAuth},
+	"getconfig":                {authRequired: true, handler: wsGetConfig},
+	"saveconfig":               {authRequired: true, handler: wsSaveConfig},
+	"getaccountinfo":           {authRequired: true, handler: wsGetAccountInfo},
+	"gettickers":               {authRequired: false, handler: wsGetTickers},
+	"getticker":                {authRequired: false, handler: wsGetTicker},
+	"getorderbooks":            {authRequired: false, handler: wsGetOrderbooks},
+	"getorderbook":             {authRequired: false, handler: wsGetOrderbook},
+	"getorderbookanalytics":    {authRequired: false, handler: wsGetOrderbookAnalytics},
+	"getconsolidatedorderbook": {authRequired: false, handler: wsGetConsolidatedOrderbook},
+	"getexchangerates":         {authRequired: false, handler: wsGetExchangeRates},
+	"getportfolio":             {authRequired: true, handler: wsGetPortfolio},
+	"getsubsystems":            {authRequired: true, handler: wsGetSubsystems},
+	"setsubsystem":             {authRequired: true, handler: wsSetSubsystem},
+	"subscribe":                {authRequired: false, handler: wsSubscribe},
+	"unsubscribe":              {authRequired: false, handler: wsUnsubscribe},
+	"getsubscriptions":         {authRequired: false, handler: wsGetSubscriptions},
+}
+
+// WebsocketClient stores information related to the websocket client
+type WebsocketClient struct {
+	Hub           *WebsocketHub
+	Conn          *websocket.Conn
+	Authenticated bool
+	authFailures  int
+	Send          chan []byte
+
+	subscriptions []WebsocketSubscription
+	subsMtx       sync.RWMutex
+
+	// pending holds the latest undelivered update for each subscribed topic
+	// so that slow clients skip stale updates instead of blocking the hub
+	pending     map[string][]byte
+	pendingKeys []string
+	dropped     int64
+	pendingMtx  sync.Mutex
+	notify      chan struct{}
+
+	// ctx is done once the client disconnects or the hub shuts down, it
+	// abandons the exchange requests made by the client's commands and stops
+	// the client writer. Send is never closed as handlers may still be
+	// sending on it
+	ctx    context.Context
+	cancel context.CancelFunc
+}
+
+// WebsocketHub stores the data for managing websocket clients
+type WebsocketHub struct {
+	Clients    map[*WebsocketClient]bool
+	clientsMtx sync.RWMutex // Guards Clients for readers outside the hub routine
+	Register   chan *WebsocketClient
+	Unregister chan *WebsocketClient
+	broadcast  chan *websocketBroadcast
+	shutdown   chan struct{}
+	engine     *Engine
+}
+
+// websocketBroadcast is an encoded websocket event and the topic it is
+// delivered to
+type websocketBroadcast struct {
+	topic WebsocketSubscription
+	data  []byte
+}
+
+// WebsocketEvent is the struct used for websocket events
+type WebsocketEvent struct {
+	Exchange  string `json:"exchange,omitempty"`
+	AssetType string `json:"assetType,omitempty"`
+	Pair      string `json:"pair,omitempty"`
+	Event     string
+	Data      interface{}
+}
+
+// WebsocketSubscription is a topic a client receives broadcast events for.
+// Empty fields match all values
+type WebsocketSubscription struct {
+	Exchange  string `json:"exchange"`
+	Pair      string `json:"pair"`
+	AssetType string `json:"assetType"`
+	DataType  string `json:"dataType"`
+}
+
+// WebsocketEventResponse is the struct used for websocket event responses
+type WebsocketEventResponse struct {
+	Event string      `json:"event"`
+	Data  interface{} `json:"data"`
+	Error string      `json:"error"`
+}
+
+// WebsocketOrderbookTickerRequest is a struct used for ticker and orderbook
+// requests
+type WebsocketOrderbookTickerRequest struct {
+	Exchange  string `json:"exchangeName"`
+	Currency  string `json:"currency"`
+	AssetType string `json:"assetType"`
+}
+
+// WebsocketOrderbookAnalyticsRequest is a struct used for orderbook analytics
+// requests
+type WebsocketOrderbookAnalyticsRequest struct {
+	WebsocketOrderbookTickerRequest
+	OrderbookAnalyticsRequest
+}
+
+// WebsocketConsolidatedOrderbookRequest is a struct used for consolidated
+// orderbook requests
+type WebsocketConsolidatedOrderbookRequest struct {
+	Currency      string  `json:"currency"`
+	AssetType     string  `json:"assetType"`
+	ConvertQuotes bool    `json:"convertQuotes"`
+	Amount        float64 `json:"amount"`
+}
+
+// WebsocketAuth is a struct used for
+type WebsocketAuth struct {
+	Username string `json:"username"`
+	Password string `json:"password"`
+}
+
+// WebsocketSubsystemRequest is a struct used for enabling or disabling an
+// engine subsystem
+type WebsocketSubsystemRequest struct {
+	Subsystem string `json:"subsystem"`
+	Enable    bool   `json:"enable"`
+}
+
+// NewWebsocketHub Creates a new websocket hub
+func NewWebsocketHub(e *Engine) *WebsocketHub {
+	return &WebsocketHub{
+		broadcast:  make(chan *websocketBroadcast),
+		Register:   make(chan *WebsocketClient),
+		Unregister: make(chan *WebsocketClient),
+		Clients:    make(map[*WebsocketClient]bool),
+		shutdown:   make(chan struct{}),
+		engine:     e,
+	}
+}
+
+// Shutdown stops the hub and disconnects all clients
+func (h *WebsocketHub) Shutdown() {
+	close(h.shutdown)
+}
+
+func (h *WebsocketHub) run() {
+	for {
+		select {
+		case <-h.shutdown:
+			h.clientsMtx.Lock()
+			for client := range h.Clients {
+				client.cancel()
+				delete(h.Clients, client)
+			}
+			h.clientsMtx.Unlock()
+			return
+		case client := <-h.Register:
+			h.clientsMtx.Lock()
+			h.Clients[client] = true
+			h.clientsMtx.Unlock()
+		case client := <-h.Unregister:
+			if _, ok := h.Clients[client]; ok {
+				log.Debugln("websocket: disconnected client")
+				h.clientsMtx.Lock()
+				delete(h.Clients, client)
+				h.clientsMtx.Unlock()
+				client.cancel()
+			}
+		case message := <-h.broadcast:
+			for client := range h.Clients {
+				if client.isSubscribed(&message.topic) {
+					client.queue(message.topic.key(), message.data)
+				}
+			}
+		}
+	}
+}
+
+// newWebsocketClient returns a client for a hub connection
+func newWebsocketClient(hub *WebsocketHub, conn *websocket.Conn) *WebsocketClient {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &WebsocketClient{
+		Hub:     hub,
+		Conn:    conn,
+		Send:    make(chan []byte, 1024),
+		pending: make(map[string][]byte),
+		notify:  make(chan struct{}, 1),
+		ctx:     ctx,
+		cancel:  cancel,
+	}
+}
+
+// normalise returns the subscription with case and pair delimiters removed
+// so that it can be compared against event topics
+func (s *WebsocketSubscription) normalise() WebsocketSubscription {
+	return WebsocketSubscription{
+		Exchange:  common.StringToUpper(s.Exchange),
+		Pair:      normaliseWebsocketPair(s.Pair),
+		AssetType: common.StringToUpper(s.AssetType),
+		DataType:  common.StringToLower(s.DataType),
+	}
+}
+
+// matches returns whether a normalised event topic matches the normalised
+// subscription
+func (s *WebsocketSubscription) matches(topic *WebsocketSubscription) bool {
+	return (s.Exchange == "" || s.Exchange == topic.Exchange) &&
+		(s.Pair == "" || s.Pair == topic.Pair) &&
+		(s.AssetType == "" || s.AssetType == topic.AssetType) &&
+		(s.DataType == "" || s.DataType == topic.DataType)
+}
+
+func (s *WebsocketSubscription) key() string {
+	return s.DataType + ":" + s.Exchange + ":" + s.AssetType + ":" + s.Pair
+}
+
+func normaliseWebsocketPair(p string) string {
+	return common.StringToUpper(strings.NewReplacer("-", "", "_", "", "/", "").Replace(p))
+}
+
+// subscribe adds a topic to the client subscriptions
+func (c *WebsocketClient) subscribe(sub *WebsocketSubscription) error {
+	s := sub.normalise()
+	if s.DataType != "" &&
+		s.DataType != WebsocketDataTypeTicker &&
+		s.DataType != WebsocketDataTypeOrderbook {
+		return errWebsocketInvalidDataType
+	}
+
+	c.subsMtx.Lock()
+	defer c.subsMtx.Unlock()
+	for x := range c.subscriptions {
+		if c.subscriptions[x] == s {
+			return nil
+		}
+	}
+
+	if len(c.subscriptions) >= websocketMaxSubscriptions {
+		return errWebsocketSubscriptionLimit
+	}
+	c.subscriptions = append(c.subscriptions, s)
+	return nil
+}
+
+// unsubscribe removes a topic from the client subscriptions
+func (c *WebsocketClient) unsubscribe(sub *WebsocketSubscription) error {
+	s := sub.normalise()
+	c.subsMtx.Lock()
+	defer c.subsMtx.Unlock()
+	for x := range c.subscriptions {
+		if c.subscriptions[x] == s {
+			c.subscriptions = append(c.subscriptions[:x], c.subscriptions[x+1:]...)
+			return nil
+		}
+	}
+	return errWebsocketSubscriptionNotFound
+}
+
+// getSubscriptions returns a copy of the client subscriptions
+func (c *WebsocketClient) getSubscriptions() []WebsocketSubscription {
+	c.subsMtx.RLock()
+	defer c.subsMtx.RUnlock()
+	subs := make([]WebsocketSubscription, len(c.subscriptions))
+	copy(subs, c.subscriptions)
+	return subs
+}
+
+// isSubscribed returns whether any client subscription matches the topic
+func (c *WebsocketClient) isSubscribed(topic *WebsocketSubscription) bool {
+	c.subsMtx.RLock()
+	defer c.subsMtx.RUnlock()
+	for x := range c.subscriptions {
+		if c.subscriptions[x].matches(topic) {
+			return true
+		}
+	}
+	return false
+}
+
+// queue stores an update for the client writer without blocking. An
+// undelivered update for the same topic is replaced by the newer one
+func (c *WebsocketClient) queue(key string, data []byte) {
+	c.pendingMtx.Lock()
+	if _, ok := c.pending[key]; ok {
+		c.dropped++
+	} else {
+		c.pendingKeys = append(c.pendingKeys, key)
+	}
+	c.pending[key] = data
+	c.pendingMtx.Unlock()
+
+	select {
+	case c.notify <- struct{}{}:
+	default:
+	}
+}
+
+// takePending returns and clears the queued updates in the order their topics
+// were first queued
+func (c *WebsocketClient) takePending() [][]byte {
+	c.pendingMtx.Lock()
+	defer c.pendingMtx.Unlock()
+	messages := make([][]byte, 0, len(c.pendingKeys))
+	for _, key := range c.pendingKeys {
+		messages = append(messages, c.pending[key])
+		delete(c.pending, key)
+	}
+	c.pendingKeys = c.pendingKeys[:0]
+	if c.dropped > 0 {
+		log.Debugf("websocket: client skipped %d stale updates", c.dropped)
+		c.dropped = 0
+	}
+	return messages
+}
+
+// SendWebsocketMessage sends a websocket event to the client, an error is
+// returned once the client has disconnected or the hub has shut down
+func (c *WebsocketClient) SendWebsocketMessage(evt interface{}) error {
+	data, err := common.JSONEncode(evt)
+	if err != nil {
+		log.Errorf("websocket: failed to send message: %s", err)
+		return err
+	}
+
+	if c.ctx.Err() != nil {
+		return errWebsocketClientClosed
+	}
+	select {
+	case c.Send <- data:
+		return nil
+	case <-c.ctx.Done():
+		return errWebsocketClientClosed
+	}
+}
+
+func (c *WebsocketClient) read() {
+	defer func() {
+		c.cancel()
+		select {
+		case c.Hub.Unregister <- c:
+		case <-c.Hub.shutdown:
+		}
+		c.Conn.Close()
+	}()
+
+	go func() {
+		select {
+		case <-c.Hub.shutdown:
+			c.cancel()
+		case <-c.ctx.Done():
+		}
+	}()
+
+	for {
+		msgType, message, err := c.Conn.ReadMessage()
+		if err != nil {
+			if websocket.IsUnexpectedCloseError(err, websocket.CloseGoingAway, websocket.CloseAbnormalClosure) {
+				log.Errorf("websocket: client disconnected, err: %s", err)
+			}
+			break
+		}
+
+		if msgType == websocket.TextMessage {
+			var evt WebsocketEvent
+			err := common.JSONDecode(message, &evt)
+			if err != nil {
+				log.Errorf("websocket: failed to decode JSON sent from client %s", err)
+				continue
+			}
+
+			if evt.Event == "" {
+				log.Warnf("websocket: client sent a blank event, disconnecting")
+				continue
+			}
+
+			dataJSON, err := common.JSONEncode(evt.Data)
+			if err != nil {
+				log.Errorf("websocket: client sent data we couldn't JSON decode")
+				break
+			}
+
+			req := common.StringToLower(evt.Event)
+			log.Debugf("websocket: request received: %s", req)
+
+			result, ok := wsHandlers[req]
+			if !ok {
+				log.Debugln("websocket: unsupported event")
+				continue
+			}
+
+			if result.authRequired && !c.Authenticated {
+				log.Warnf("Websocket: request %s failed due to unauthenticated request on an authenticated API", evt.Event)
+				c.SendWebsocketMessage(WebsocketEventResponse{Event: evt.Event, Error: "unauthorised request on authenticated API"})
+				continue
+			}
+
+			err = result.handler(c, dataJSON)
+			if err != nil {
+				log.Errorf("websocket: request %s failed. Error %s", evt.Event, err)
+				continue
+			}
+		}
+	}
+}
+
+func (c *WebsocketClient) write() {
+	defer func() {
+		c.Conn.Close()
+	}()
+	for {
+		select {
+		case <-c.ctx.Done():
+			c.Conn.WriteMessage(websocket.CloseMessage, []byte{})
+			log.Debugln("websocket: client closed")
+			return
+		case message := <-c.Send:
+			if err := c.writeMessage(message); err != nil {
+				return
+			}
+		case <-c.notify:
+			for _, message := range c.takePending() {
+				if err := c.writeMessage(message); err != nil {
+					return
+				}
+			}
+		}
+	}
+}
+
+// writeMessage writes a single message to the client. Clients which do not
+// accept the message within the write wait are disconnected
+func (c *WebsocketClient) writeMessage(message []byte) error {
+	c.Conn.SetWriteDeadline(time.Now().Add(websocketWriteWait))
+	err := c.Conn.WriteMessage(websocket.TextMessage, message)
+	if err != nil {
+		log.Errorf("websocket: failed to write message: %s", err)
+	}
+	return err
+}
+
+// eventTopic returns the normalised topic a websocket event is delivered to
+func eventTopic(evt *WebsocketEvent) WebsocketSubscription {
+	dataType, ok := websocketEventDataTypes[evt.Event]
+	if !ok {
+		dataType = evt.Event
+	}
+
+	topic := WebsocketSubscription{
+		Exchange:  evt.Exchange,
+		Pair:      evt.Pair,
+		AssetType: evt.AssetType,
+		DataType:  dataType,
+	}
+	return topic.normalise()
+}
+
+// HasSubscribers returns whether any client is subscribed to the topic of a
+// websocket event, so that events nobody receives are not built
+func (h *WebsocketHub) HasSubscribers(evt WebsocketEvent) bool {
+	topic := eventTopic(&evt)
+	h.clientsMtx.RLock()
+	defer h.clientsMtx.RUnlock()
+	for client := range h.Clients {
+		if client.isSubscribed(&topic) {
+			return true
+		}
+	}
+	return false
+}
+
+// clientCount returns the number of connected clients
+func (h *WebsocketHub) clientCount() int {
+	h.clientsMtx.RLock()
+	defer h.clientsMtx.RUnlock()
+	return len(h.Clients)
+}
+
+// BroadcastWebsocketMessage broadcasts a websocket event to all clients
+// subscribed to its exchange, pair, asset type and data type
+func (h *WebsocketHub) BroadcastWebsocketMessage(evt WebsocketEvent) error {
+	data, err := common.JSONEncode(evt)
+	if err != nil {
+		return err
+	}
+
+	select {
+	case h.broadcast <- &websocketBroadcast{topic: eventTopic(&evt), data: data}:
+		return nil
+	case <-h.shutdown:
+		return errWebsocketServiceNotStarted
+	}
+}
+
+// WebsocketClientHandler upgrades the HTTP connection to a websocket
+// compatible one
+func (e *Engine) WebsocketClientHandler(w http.ResponseWriter, r *http.Request) {
+	wsHub := e.webserverManager.getHub()
+	if wsHub == nil {
+		log.Warnf("websocket: client rejected due to websocket service not started")
+		w.WriteHeader(http.StatusServiceUnavailable)
+		return
+	}
+
+	webserver := e.Config.GetWebserverConfig()
+	connectionLimit := webserver.WebsocketConnectionLimit
+	numClients := wsHub.clientCount()
+
+	if numClients >= connectionLimit {
+		log.Warnf("websocket: client rejected due to websocket client limit reached. Number of clients %d. Limit %d.",
+			numClients, connectionLimit)
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+
+	upgrader := websocket.Upgrader{
+		WriteBufferSize: 1024,
+		ReadBufferSize:  1024,
+	}
+
+	// Allow insecure origin if the Origin request header is present and not
+	// equal to the Host request header. Default to false
+	if webserver.WebsocketAllowInsecureOrigin {
+		upgrader.CheckOrigin = func(r *http.Request) bool { return true }
+	}
+
+	conn, err := upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		log.Error(err)
+		return
+	}
+
+	client := newWebsocketClient(wsHub, conn)
+	select {
+	case client.Hub.Register <- client:
+	case <-client.Hub.shutdown:
+		conn.Close()
+		return
+	}
+	log.Debugf("websocket: client connected. Connected clients: %d. Limit %d.",
+		numClients+1, connectionLimit)
+
+	go client.read()
+	go client.write()
+}
+
+func wsAuth(client *WebsocketClient, data interface{}) error {
+	wsResp := WebsocketEventResponse{
+		Event: "auth",
+	}
+
+	e := client.Hub.engine
+	var auth WebsocketAuth
+	err := common.JSONDecode(data.([]byte), &auth)
+	if err != nil {
+		wsResp.Error = err.Error()
+		client.SendWebsocketMessage(wsResp)
+		return err
+	}
+
+	webserver := e.Config.GetWebserverConfig()
+	hashPW := common.HexEncodeToString(common.GetSHA256([]byte(webserver.AdminPassword)))
+
+	if auth.Username == webserver.AdminUsername && auth.Password == hashPW {
+		client.Authenticated = true
+		wsResp.Data = WebsocketResponseSuccess
+		log.Debugf("websocket: client authenticated successfully")
+		return client.SendWebsocketMessage(wsResp)
+	}
+
+	wsResp.Error = "invalid username/password"
+	client.authFailures++
+	client.SendWebsocketMessage(wsResp)
+	if client.authFailures >= webserver.WebsocketMaxAuthFailures {
+		log.Debugf("websocket: disconnecting client, maximum auth failures threshold reached (failures: %d limit: %d)",
+			client.authFailures, webserver.WebsocketMaxAuthFailures)
+		client.Hub.Unregister <- client
+		return nil
+	}
+
+	log.Debugf("websocket: client sent wrong username/password (failures: %d limit: %d)",
+		client.authFailures, webserver.WebsocketMaxAuthFailures)
+	return nil
+}
+
+func wsGetConfig(client *WebsocketClient, data interface{}) error {
+	wsResp := WebsocketEventResponse{
+		Event: "GetConfig",
+		Data:  client.Hub.engine.Config,
+	}
+	return client.SendWebsocketMessage(wsResp)
+}
+
+func wsSaveConfig(client *WebsocketClient, data interface{}) error {
+	e := client.Hub.engine
+	wsResp := WebsocketEventResponse{
+		Event: "SaveConfig",
+	}
+	var cfg config.Config
+	err := common.JSONDecode(data.([]byte), &cfg)
+	if err != nil {
+		wsResp.Error = err.Error()
+		client.SendWebsocketMessage(wsResp)
+		return err
+	}
+
+	_, err = e.ReloadConfig(&cfg)
+	if err == nil {
+		err = e.saveConfig()
+	}
+	if err != nil {
+		wsResp.Error = err.Error()
+		client.SendWebsocketMessage(wsResp)
+		return err
+	}
+
+	wsResp.Data = WebsocketResponseSuccess
+	return client.SendWebsocketMessage(wsResp)
+}
+
+func wsGetAccountInfo(client *WebsocketClient, data interface{}) error {
+	accountInfo := client.Hub.engine.GetAllEnabledExchangeAccountInfoContext(client.ctx)
+	wsResp := WebsocketEventResponse{
+		Event: "GetAccountInfo",
+		Data:  accountInfo,
+	}
+	return client.SendWebsocketMessage(wsResp)
+}
+
+func wsGetTickers(client *WebsocketClient, data interface{}) error {
+	wsResp := WebsocketEventResponse{
+		Event: "GetTickers",
+	}
+	wsResp.Data = client.Hub.engine.GetAllActiveTickers()
+	return client.SendWebsocketMessage(wsResp)
+}
+
+func wsGetTicker(client *WebsocketClient, data interface{}) error {
+	wsResp := WebsocketEventResponse{
+		Event: "GetTicker",
+	}
+	var tickerReq WebsocketOrderbookTickerRequest
+	err := common.JSONDecode(data.([]byte), &tickerReq)
+	if err != nil {
+		wsResp.Error = err.Error()
+		client.SendWebsocketMessage(wsResp)
+		return err
+	}
+
+	assetType, err := client.Hub.engine.getAssetType(tickerReq.Exchange,
+		tickerReq.AssetType)
+	if err != nil {
+		wsResp.Error = err.Error()
+		client.SendWebsocketMessage(wsResp)
+		return err
+	}
+
+	result, err := client.Hub.engine.GetSpecificTicker(tickerReq.Currency,
+		tickerReq.Exchange, assetType)
+
+	if err != nil {
+		wsResp.Error = err.Error()
+		client.SendWebsocketMessage(wsResp)
+		return err
+	}
+	wsResp.Data = result
+	return client.SendWebsocketMessage(wsResp)
+}
+
+func wsGetOrderbooks(client *WebsocketClient, data interface{}) error {
+	wsResp := WebsocketEventResponse{
+		Event: "GetOrderbooks",
+	}
+	wsResp.Data = client.Hub.engine.GetAllActiveOrderbooks()
+	return client.SendWebsocketMessage(wsResp)
+}
+
+func wsGetOrderbook(client *WebsocketClient, data interface{}) error {
+	wsResp := WebsocketEventResponse{
+		Event: "GetOrderbook",
+	}
+	var orderbookReq WebsocketOrderbookTickerRequest
+	err := common.JSONDecode(data.([]byte), &orderbookReq)
+	if err != nil {
+		wsResp.Error = err.Error()
+		client.SendWebsocketMessage(wsResp)
+		return err
+	}
+
+	assetType, err := client.Hub.engine.getAssetType(orderbookReq.Exchange,
+		orderbookReq.AssetType)
+	if err != nil {
+		wsResp.Error = err.Error()
+		client.SendWebsocketMessage(wsResp)
+		return err
+	}
+
+	result, err := client.Hub.engine.GetSpecificOrderbook(orderbookReq.Currency,
+		orderbookReq.Exchange, assetType)
+
+	if err != nil {
+		wsResp.Error = err.Error()
+		client.SendWebsocketMessage(wsResp)
+		return err
+	}
+	wsResp.Data = result
+	return client.SendWebsocketMessage(wsResp)
+}
+
+func wsGetOrderbookAnalytics(client *WebsocketClient, data interface{}) error {
+	wsResp := WebsocketEventResponse{
+		Event: "GetOrderbookAnalytics",
+	}
+	var analyticsReq WebsocketOrderbookAnalyticsRequest
+	err := common.JSONDecode(data.([]byte), &analyticsReq)
+	if err != nil {
+		wsResp.Error = err.Error()
+		client.SendWebsocketMessage(wsResp)
+		return err
+	}
+
+	assetType, err := client.Hub.engine.getAssetType(analyticsReq.Exchange,
+		analyticsReq.AssetType)
+	if err != nil {
+		wsResp.Error = err.Error()
+		client.SendWebsocketMessage(wsResp)
+		return err
+	}
+
+	result, err := client.Hub.engine.GetOrderbookAnalytics(analyticsReq.Exchange,
+		currency.NewPairFromString(analyticsReq.Currency), assetType,
+		analyticsReq.OrderbookAnalyticsRequest)
+	if err != nil {
+		wsResp.Error = err.Error()
+		client.SendWebsocketMessage(wsResp)
+		return err
+	}
+	wsResp.Data = result
+	return client.SendWebsocketMessage(wsResp)
+}
+
+func wsGetExchangeRates(client *WebsocketClient, data interface{}) error {
+	wsResp := WebsocketEventResponse{
+		Event: "GetExchangeRates",
+	}
+
+	var err error
+	wsResp.Data, err = currency.GetExchangeRates()
+	if err != nil {
+		return err
+	}
+
+	return client.SendWebsocketMessage(wsResp)
+}
+
+func wsGetPortfolio(client *WebsocketClient, data interface{}) error {
+	wsResp := WebsocketEventResponse{
+		Event: "GetPortfolio",
+	}
+	wsResp.Data = client.Hub.engine.Portfolio.GetPortfolioSummary()
+	return client.SendWebsocketMessage(wsResp)
+}
+
+func wsGetSubsystems(client *WebsocketClient, data interface{}) error {
+	wsResp := WebsocketEventResponse{
+		Event: "GetSubsystems",
+	}
+	wsResp.Data = client.Hub.engine.GetSubsystemsStatus()
+	return client.SendWebsocketMessage(wsResp)
+}
+
+func wsSetSubsystem(client *WebsocketClient, data interface{}) error {
+	wsResp := WebsocketEventResponse{
+		Event: "SetSubsystem",
+	}
+
+	var req WebsocketSubsystemRequest
+	err := common.JSONDecode(data.([]byte), &req)
+	if err != nil {
+		wsResp.Error = err.Error()
+		client.SendWebsocketMessage(wsResp)
+		return err
+	}
+
+	err = client.Hub.engine.SetSubsystem(req.Subsystem, req.Enable)
+	if err != nil {
+		wsResp.Error = err.Error()
+		client.SendWebsocketMessage(wsResp)
+		return err
+	}
+
+	wsResp.Data = WebsocketResponseSuccess
+	return client.SendWebsocketMessage(wsResp)
+}
+
+func wsSubscribe(client *WebsocketClient, data interface{}) error {
+	wsResp := WebsocketEventResponse{
+		Event: "Subscribe",
+	}
+
+	var sub WebsocketSubscription
+	err := common.JSONDecode(data.([]byte), &sub)
+	if err != nil {
+		wsResp.Error = err.Error()
+		client.SendWebsocketMessage(wsResp)
+		return err
+	}
+
+	err = client.subscribe(&sub)
+	if err != nil {
+		wsResp.Error = err.Error()
+		client.SendWebsocketMessage(wsResp)
+		return err
+	}
+
+	wsResp.Data = client.getSubscriptions()
+	return client.SendWebsocketMessage(wsResp)
+}
+
+func wsUnsubscribe(client *WebsocketClient, data interface{}) error {
+	wsResp := WebsocketEventResponse{
+		Event: "Unsubscribe",
+	}
+
+	var sub WebsocketSubscription
+	err := common.JSONDecode(data.([]byte), &sub)
+	if err != nil {
+		wsResp.Error = err.Error()
+		client.SendWebsocketMessage(wsResp)
+		return err
+	}
+
+	err = client.unsubscribe(&sub)
+	if err != nil {
+		wsResp.Error = err.Error()
+		client.SendWebsocketMessage(wsResp)
+		return err
+	}
+
+	wsResp.Data = client.getSubscriptions()
+	return client.SendWebsocketMessage(wsResp)
+}
+
+func wsGetSubscriptions(client *WebsocketClient, data interface{}) error {
+	wsResp := WebsocketEventResponse{
+		Event: "GetSubscriptions",
+		Data:  client.getSubscriptions(),
+	}
+	return client.SendWebsocketMessage(wsResp)
+}
+
+func wsGetConsolidatedOrderbook(client *WebsocketClient, data interface{}) error {
+	wsResp := WebsocketEventResponse{
+		Event: "GetConsolidatedOrderbook",
+	}
+	var consolidatedReq WebsocketConsolidatedOrderbookRequest
+	err := common.JSONDecode(data.([]byte), &consolidatedReq)
+	if err != nil {
+		wsResp.Error = err.Error()
+		client.SendWebsocketMessage(wsResp)
+		return err
+	}
+
+	req := ConsolidatedOrderbookRequest{
+		Pair:          currency.NewPairFromString(consolidatedReq.Currency),
+		ConvertQuotes: consolidatedReq.ConvertQuotes,
+		Amount:        consolidatedReq.Amount,
+	}
+	if consolidatedReq.AssetType != "" {
+		req.AssetType, err = asset.New(consolidatedReq.AssetType)
+		if err != nil {
+			wsResp.Error = err.Error()
+			client.SendWebsocketMessage(wsResp)
+			return err
+		}
+	}
+
+	result, err := client.Hub.engine.GetConsolidatedOrderbook(&req)
+	if err != nil {
+		wsResp.Error = err.Error()
+		client.SendWebsocketMessage(wsResp)
+		return err
+	}
+	wsResp.Data = result
+	return client.SendWebsocketMessage(wsResp)
+}
@@ -0,0 +1,192 @@
+package engine
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+)
+
+func TestWebsocketSubscriptionMatches(t *testing.T) {
+	topic := (&WebsocketSubscription{
+		Exchange:  "Bitstamp",
+		Pair:      "BTC-USD",
+		AssetType: "SPOT",
+		DataType:  WebsocketDataTypeTicker,
+	}).normalise()
+
+	tests := []struct {
+		sub      WebsocketSubscription
+		expected bool
+	}{
+		{WebsocketSubscription{}, true},
+		{WebsocketSubscription{Exchange: "bitstamp"}, true},
+		{WebsocketSubscription{Pair: "btc_usd"}, true},
+		{WebsocketSubscription{Pair: "BTC/USD", DataType: "TICKER"}, true},
+		{WebsocketSubscription{Exchange: "Bitfinex"}, false},
+		{WebsocketSubscription{Pair: "LTCUSD"}, false},
+		{WebsocketSubscription{DataType: WebsocketDataTypeOrderbook}, false},
+	}
+
+	for x := range tests {
+		sub := tests[x].sub.normalise()
+		if sub.matches(&topic) != tests[x].expected {
+			t.Errorf("Test failed. %+v matching %+v expected %v", sub, topic,
+				tests[x].expected)
+		}
+	}
+}
+
+func TestWebsocketClientSubscribe(t *testing.T) {
+	client := newWebsocketClient(nil, nil)
+
+	err := client.subscribe(&WebsocketSubscription{DataType: "trades"})
+	if err != errWebsocketInvalidDataType {
+		t.Errorf("Test failed. Expected %s, got %v", errWebsocketInvalidDataType,
+			err)
+	}
+
+	sub := WebsocketSubscription{Exchange: "bitstamp", Pair: "BTC-USD"}
+	for x := 0; x < 2; x++ {
+		err = client.subscribe(&sub)
+		if err != nil {
+			t.Fatalf("Test failed. Unable to subscribe: %s", err)
+		}
+	}
+
+	if subs := client.getSubscriptions(); len(subs) != 1 ||
+		subs[0].Exchange != "BITSTAMP" || subs[0].Pair != "BTCUSD" {
+		t.Errorf("Test failed. Unexpected subscriptions %+v", subs)
+	}
+
+	err = client.unsubscribe(&WebsocketSubscription{Exchange: "BITSTAMP",
+		Pair: "btc_usd"})
+	if err != nil {
+		t.Errorf("Test failed. Unable to unsubscribe: %s", err)
+	}
+
+	err = client.unsubscribe(&sub)
+	if err != errWebsocketSubscriptionNotFound {
+		t.Errorf("Test failed. Expected %s, got %v",
+			errWebsocketSubscriptionNotFound, err)
+	}
+
+	for x := 0; x < websocketMaxSubscriptions; x++ {
+		err = client.subscribe(&WebsocketSubscription{Exchange: strconv.Itoa(x)})
+		if err != nil {
+			t.Fatalf("Test failed. Unable to subscribe: %s", err)
+		}
+	}
+
+	err = client.subscribe(&sub)
+	if err != errWebsocketSubscriptionLimit {
+		t.Errorf("Test failed. Expected %s, got %v",
+			errWebsocketSubscriptionLimit, err)
+	}
+}
+
+func TestWebsocketClientQueue(t *testing.T) {
+	client := newWebsocketClient(nil, nil)
+	client.queue("a", []byte("1"))
+	client.queue("b", []byte("2"))
+	client.queue("a", []byte("3"))
+
+	messages := client.takePending()
+	if len(messages) != 2 || string(messages[0]) != "3" ||
+		string(messages[1]) != "2" {
+		t.Errorf("Test failed. Unexpected pending messages %q", messages)
+	}
+
+	if messages = client.takePending(); len(messages) != 0 {
+		t.Errorf("Test failed. Expected no pending messages, got %q", messages)
+	}
+}
+
+func TestWebsocketHubBroadcast(t *testing.T) {
+	hub := NewWebsocketHub(&Engine{})
+	go hub.run()
+	defer hub.Shutdown()
+
+	subscribed := newWebsocketClient(hub, nil)
+	unsubscribed := newWebsocketClient(hub, nil)
+	hub.Register <- subscribed
+	hub.Register <- unsubscribed
+
+	err := subscribed.subscribe(&WebsocketSubscription{
+		Exchange: "Bitstamp",
+		DataType: WebsocketDataTypeTicker,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !hub.HasSubscribers(WebsocketEvent{Exchange: "bitstamp", Pair: "BTC-USD",
+		AssetType: "SPOT", Event: WebsocketEventTickerUpdate}) {
+		t.Error("Test failed. Expected the ticker to have subscribers")
+	}
+	if hub.HasSubscribers(WebsocketEvent{Exchange: "Bitstamp", Pair: "BTC-USD",
+		AssetType: "SPOT", Event: WebsocketEventOrderbookUpdate}) {
+		t.Error("Test failed. Expected the orderbook to have no subscribers")
+	}
+
+	events := []WebsocketEvent{
+		{Exchange: "Bitstamp", Pair: "BTC-USD", AssetType: "SPOT",
+			Event: WebsocketEventTickerUpdate, Data: 1},
+		{Exchange: "Bitstamp", Pair: "BTC-USD", AssetType: "SPOT",
+			Event: WebsocketEventOrderbookUpdate, Data: 2},
+		{Exchange: "Bitfinex", Pair: "BTC-USD", AssetType: "SPOT",
+			Event: WebsocketEventTickerUpdate, Data: 3},
+		{Exchange: "Bitstamp", Pair: "BTC-USD", AssetType: "SPOT",
+			Event: WebsocketEventTickerUpdate, Data: 4},
+	}
+
+	for x := range events {
+		err = hub.BroadcastWebsocketMessage(events[x])
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// Registering waits for the hub to finish delivering the broadcasts
+	hub.Register <- newWebsocketClient(hub, nil)
+
+	messages := subscribed.takePending()
+	if len(messages) != 1 {
+		t.Fatalf("Test failed. Expected 1 pending message, got %q", messages)
+	}
+
+	var evt WebsocketEvent
+	err = common.JSONDecode(messages[0], &evt)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if evt.Event != WebsocketEventTickerUpdate || evt.Data != float64(4) {
+		t.Errorf("Test failed. Unexpected event %+v", evt)
+	}
+
+	if messages = unsubscribed.takePending(); len(messages) != 0 {
+		t.Errorf("Test failed. Expected no messages for unsubscribed client, got %q",
+			messages)
+	}
+}
+
+func TestWebsocketHubShutdown(t *testing.T) {
+	hub := NewWebsocketHub(&Engine{})
+	done := make(chan struct{})
+	go func() {
+		hub.run()
+		close(done)
+	}()
+
+	client := newWebsocketClient(hub, nil)
+	hub.Register <- client
+	hub.Shutdown()
+	<-done
+
+	// Handlers still running once the hub has shut down must not panic
+	err := client.SendWebsocketMessage(WebsocketEventResponse{Event: "test"})
+	if err != errWebsocketClientClosed {
+		t.Errorf("Test failed. Expected %s got %v", errWebsocketClientClosed, err)
+	}
+}
@@ -0,0 +1,270 @@
+package events
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	log "github.com/thrasher-corp/gocryptotrader/logger"
+)
+
+// trigger holds a copy of a triggered event along with its order parameters
+// resolved against the market data available when it triggered
+type trigger struct {
+	event  Event
+	amount float64
+	price  float64
+	err    error
+}
+
+// isOrderAction returns whether or not the action places, cancels or modifies
+// orders
+func isOrderAction(action string) bool {
+	switch action {
+	case ActionSubmitOrder, ActionCancelOrder, ActionCancelAllOrders,
+		ActionModifyOrder:
+		return true
+	}
+	return false
+}
+
+// validateOrderAction checks the order parameters required by the events
+// order action
+func (e *Event) validateOrderAction() error {
+	action := common.StringToUpper(e.Action)
+	if action == ActionCancelAllOrders {
+		return nil
+	}
+
+	if e.Order == nil {
+		return errNoOrderAction
+	}
+
+	if e.Order.MaxNotional < 0 {
+		return errors.New("order max notional cannot be negative")
+	}
+
+	switch action {
+	case ActionSubmitOrder:
+		if e.Pair.IsEmpty() {
+			return errors.New("submit order action requires a currency pair")
+		}
+
+		switch exchange.OrderSide(common.StringToUpper(string(e.Order.Side))) {
+		case exchange.BuyOrderSide, exchange.SellOrderSide,
+			exchange.BidOrderSide, exchange.AskOrderSide:
+		default:
+			return fmt.Errorf("invalid order side %q", e.Order.Side)
+		}
+
+		orderType := exchange.OrderType(common.StringToUpper(string(e.Order.Type)))
+		if orderType == "" {
+			return errors.New("submit order action requires an order type")
+		}
+
+		if _, err := ParseExpression(e.Order.Amount); err != nil {
+			return fmt.Errorf("order amount: %s", err)
+		}
+
+		if e.Order.Price == "" {
+			if orderType == exchange.LimitOrderType {
+				return errors.New("limit orders require a price")
+			}
+			return nil
+		}
+
+		if _, err := ParseExpression(e.Order.Price); err != nil {
+			return fmt.Errorf("order price: %s", err)
+		}
+	case ActionCancelOrder:
+		if e.Order.OrderID == "" {
+			return errors.New("cancel order action requires an order ID")
+		}
+	case ActionModifyOrder:
+		if e.Order.OrderID == "" {
+			return errors.New("modify order action requires an order ID")
+		}
+
+		if e.Order.Amount == "" && e.Order.Price == "" {
+			return errors.New("modify order action requires an amount or price")
+		}
+
+		for _, expr := range []string{e.Order.Amount, e.Order.Price} {
+			if expr == "" {
+				continue
+			}
+			if _, err := ParseExpression(expr); err != nil {
+				return fmt.Errorf("order %s", err)
+			}
+		}
+	}
+	return nil
+}
+
+// newTrigger copies a triggered event and resolves its order amount and price
+// expressions. Callers must hold the manager lock
+func (m *Manager) newTrigger(evt *Event) trigger {
+	t := trigger{event: evt.copy()}
+	if evt.Action != ActionSubmitOrder && evt.Action != ActionModifyOrder {
+		return t
+	}
+
+	if evt.Order == nil {
+		t.err = errNoOrderAction
+		return t
+	}
+
+	refs := m.refs(evt)
+	if evt.Order.Amount != "" {
+		t.amount, t.err = evaluateExpression(evt.Order.Amount, refs)
+		if t.err != nil {
+			return t
+		}
+		if t.amount <= 0 {
+			t.err = fmt.Errorf("order amount %v must be greater than zero",
+				t.amount)
+			return t
+		}
+	}
+
+	if evt.Order.Price != "" {
+		t.price, t.err = evaluateExpression(evt.Order.Price, refs)
+		if t.err != nil {
+			return t
+		}
+		if t.price <= 0 {
+			t.err = fmt.Errorf("order price %v must be greater than zero",
+				t.price)
+			return t
+		}
+	}
+
+	t.err = m.checkNotional(evt, t.amount, t.price, refs)
+	return t
+}
+
+// checkNotional enforces the manager and event max notional guards. Market
+// orders are valued at the last price
+func (m *Manager) checkNotional(evt *Event, amount, price float64, refs map[string]float64) error {
+	if m.MaxNotional == 0 && evt.Order.MaxNotional == 0 {
+		return nil
+	}
+
+	if amount == 0 {
+		// Modifying only the price of an order keeps its existing amount which
+		// is not known to the event manager
+		return errors.New("unable to enforce max notional without an order amount")
+	}
+
+	if price == 0 {
+		price = refs[RefLast]
+		if price == 0 {
+			return errors.New("unable to enforce max notional without a price")
+		}
+	}
+
+	notional := amount * price
+	for _, max := range []float64{m.MaxNotional, evt.Order.MaxNotional} {
+		if max != 0 && notional > max {
+			return fmt.Errorf("%s: %v > %v", errMaxNotional, notional, max)
+		}
+	}
+	return nil
+}
+
+// refs returns the reference values used to evaluate order expressions.
+// Callers must hold the manager lock
+func (m *Manager) refs(evt *Event) map[string]float64 {
+	refs := make(map[string]float64)
+	if md := m.market[marketKey(evt.Exchange, evt.Pair, evt.Asset)]; md != nil {
+		if md.ticker != nil {
+			refs[RefLast] = md.ticker.Last
+			refs[RefBid] = md.ticker.Bid
+			refs[RefAsk] = md.ticker.Ask
+			refs[RefHigh] = md.ticker.High
+			refs[RefLow] = md.ticker.Low
+		}
+		if md.orderbook != nil && len(md.orderbook.Bids) > 0 &&
+			len(md.orderbook.Asks) > 0 {
+			refs[RefBid] = md.orderbook.Bids[0].Price
+			refs[RefAsk] = md.orderbook.Asks[0].Price
+		}
+	}
+
+	if refs[RefBid] != 0 && refs[RefAsk] != 0 {
+		refs[RefMid] = (refs[RefBid] + refs[RefAsk]) / 2
+	}
+
+	if balances := m.balances[common.StringToLower(evt.Exchange)]; balances != nil {
+		refs[RefBalance] = balances[evt.Pair.Base.Upper().String()]
+	}
+	return refs
+}
+
+// executeOrderAction carries out a triggered events order action unless a
+// guard prevents it
+func (m *Manager) executeOrderAction(t *trigger) error {
+	evt := &t.event
+	if t.err != nil {
+		log.Errorf("Event %d %s action not executed: %s\n", evt.ID,
+			evt.Action, t.err)
+		return t.err
+	}
+
+	action := evt.Action
+	var orderID string
+	if evt.Order != nil {
+		orderID = evt.Order.OrderID
+	}
+
+	if m.DryRun || (evt.Order != nil && evt.Order.DryRun) {
+		log.Debugf("Event %d dry run: %s on %s %s amount: %v price: %v order ID: %s\n",
+			evt.ID, action, evt.Exchange, evt.Pair, t.amount, t.price, orderID)
+		return nil
+	}
+
+	if m.Executor == nil {
+		log.Errorf("Event %d %s action not executed: %s\n", evt.ID, action,
+			errNoOrderExecutor)
+		return errNoOrderExecutor
+	}
+
+	var err error
+	switch action {
+	case ActionSubmitOrder:
+		orderID, err = m.Executor.SubmitOrder(evt.Exchange,
+			evt.Order.CredentialSet,
+			evt.Pair,
+			evt.Asset,
+			evt.Order.Side,
+			evt.Order.Type,
+			t.amount,
+			t.price,
+			evt.Order.ClientID)
+	case ActionCancelOrder:
+		err = m.Executor.CancelOrder(evt.Exchange, orderID)
+	case ActionCancelAllOrders:
+		err = m.Executor.CancelAllOrders(evt.Exchange,
+			evt.Order.CredentialSet, evt.Pair)
+	case ActionModifyOrder:
+		err = m.Executor.ModifyOrder(evt.Exchange, orderID, t.price, t.amount)
+	}
+
+	if err != nil {
+		log.Errorf("Event %d %s action failed on %s: %s\n", evt.ID, action,
+			evt.Exchange, err)
+		return err
+	}
+	log.Debugf("Event %d %s action executed on %s. Order ID: %s\n", evt.ID,
+		action, evt.Exchange, orderID)
+	return nil
+}
+
+func evaluateExpression(s string, refs map[string]float64) (float64, error) {
+	e, err := ParseExpression(s)
+	if err != nil {
+		return 0, err
+	}
+	return e.Evaluate(refs)
+}
@@ -0,0 +1,333 @@
+package events
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+)
+
+type testOrder struct {
+	action        string
+	credentialSet string
+	side          exchange.OrderSide
+	amount        float64
+	price         float64
+	id            string
+}
+
+type testExecutor struct {
+	orders []testOrder
+	err    error
+}
+
+func (e *testExecutor) SubmitOrder(_, credentialSet string, _ currency.Pair, _ asset.Item, side exchange.OrderSide, _ exchange.OrderType, amount, price float64, _ string) (string, error) {
+	if e.err != nil {
+		return "", e.err
+	}
+	e.orders = append(e.orders, testOrder{action: ActionSubmitOrder, credentialSet: credentialSet, side: side, amount: amount, price: price})
+	return "1", nil
+}
+
+func (e *testExecutor) CancelOrder(_, orderID string) error {
+	e.orders = append(e.orders, testOrder{action: ActionCancelOrder, id: orderID})
+	return nil
+}
+
+func (e *testExecutor) CancelAllOrders(_, credentialSet string, _ currency.Pair) error {
+	e.orders = append(e.orders, testOrder{action: ActionCancelAllOrders, credentialSet: credentialSet})
+	return nil
+}
+
+func (e *testExecutor) ModifyOrder(_, orderID string, price, amount float64) error {
+	e.orders = append(e.orders, testOrder{action: ActionModifyOrder, id: orderID, amount: amount, price: price})
+	return nil
+}
+
+func orderEvent(action string, o *OrderAction) Event {
+	return Event{
+		Exchange:   testExchange,
+		Pair:       testPair(),
+		Conditions: []Condition{{Item: ItemPrice, Operator: GreaterThan, Value: 0}},
+		Action:     action,
+		Cooldown:   time.Minute,
+		Order:      o,
+	}
+}
+
+func TestValidateOrderAction(t *testing.T) {
+	m := NewManager("")
+	valid := []Event{
+		orderEvent("submit_order", &OrderAction{Side: "buy", Type: "limit", Amount: "1", Price: "last - 1%"}),
+		orderEvent(ActionSubmitOrder, &OrderAction{Side: exchange.SellOrderSide, Type: exchange.MarketOrderType, Amount: "balance * 50%"}),
+		orderEvent(ActionCancelOrder, &OrderAction{OrderID: "123"}),
+		orderEvent(ActionCancelAllOrders, nil),
+		orderEvent(ActionModifyOrder, &OrderAction{OrderID: "123", Price: "bid"}),
+	}
+
+	for x := range valid {
+		if _, err := m.Add(&valid[x]); err != nil {
+			t.Errorf("Test failed. Event %d: %s", x, err)
+		}
+	}
+
+	invalid := []Event{
+		orderEvent(ActionSubmitOrder, nil),
+		orderEvent(ActionSubmitOrder, &OrderAction{Side: "up", Type: "limit", Amount: "1", Price: "1"}),
+		orderEvent(ActionSubmitOrder, &OrderAction{Side: "buy", Type: "limit", Amount: "1"}),
+		orderEvent(ActionSubmitOrder, &OrderAction{Side: "buy", Type: "market", Amount: "volume"}),
+		orderEvent(ActionSubmitOrder, &OrderAction{Side: "buy", Type: "market", Amount: "1", MaxNotional: -1}),
+		orderEvent(ActionCancelOrder, &OrderAction{}),
+		orderEvent(ActionModifyOrder, &OrderAction{OrderID: "123"}),
+		{
+			Exchange:   testExchange,
+			Pair:       testPair(),
+			Conditions: []Condition{{Item: ItemPrice, Operator: GreaterThan, Value: 0}},
+			Action:     ActionCancelAllOrders,
+		},
+	}
+
+	for x := range invalid {
+		if _, err := m.Add(&invalid[x]); err == nil {
+			t.Errorf("Test failed. Expected event %d to be invalid", x)
+		}
+	}
+}
+
+func TestSubmitOrderAction(t *testing.T) {
+	m := NewManager("")
+	exec := &testExecutor{}
+	m.Executor = exec
+
+	_, err := m.Add(&Event{
+		Exchange:   testExchange,
+		Pair:       testPair(),
+		Conditions: []Condition{{Item: ItemPrice, Operator: GreaterThan, Value: 100}},
+		Action:     ActionSubmitOrder,
+		Cooldown:   time.Minute,
+		Order: &OrderAction{
+			Side:          exchange.BuyOrderSide,
+			Type:          exchange.LimitOrderType,
+			Amount:        "0.5",
+			Price:         "last minus 0.5%",
+			CredentialSet: "desk1",
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	m.OnTicker(testExchange, asset.Spot, &ticker.Price{Pair: testPair(), Last: 200})
+	m.Wait()
+	if len(exec.orders) != 1 {
+		t.Fatalf("Test failed. Expected 1 order, got %d", len(exec.orders))
+	}
+
+	if o := exec.orders[0]; o.side != exchange.BuyOrderSide || o.amount != 0.5 ||
+		o.price != 199 || o.credentialSet != "desk1" {
+		t.Errorf("Test failed. Unexpected order %+v", o)
+	}
+}
+
+func TestFailedOrderAction(t *testing.T) {
+	m := NewManager("")
+	exec := &testExecutor{err: errors.New("exchange unavailable")}
+	m.Executor = exec
+
+	id, err := m.Add(&Event{
+		Exchange:   testExchange,
+		Pair:       testPair(),
+		Conditions: []Condition{{Item: ItemPrice, Operator: GreaterThan, Value: 100}},
+		Action:     ActionSubmitOrder,
+		Cooldown:   time.Hour,
+		Order: &OrderAction{
+			Side:   exchange.BuyOrderSide,
+			Type:   exchange.MarketOrderType,
+			Amount: "1",
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	m.OnTicker(testExchange, asset.Spot, &ticker.Price{Pair: testPair(), Last: 200})
+	m.Wait()
+	e, _ := m.GetEvent(id)
+	if e.Executed || e.TriggerCount != 1 {
+		t.Errorf("Test failed. Expected a failed action to leave the event armed %+v", e)
+	}
+
+	// The retry is held back by the cooldown
+	exec.err = nil
+	m.OnTicker(testExchange, asset.Spot, &ticker.Price{Pair: testPair(), Last: 200})
+	m.Wait()
+	if len(exec.orders) != 0 {
+		t.Errorf("Test failed. Expected no retry within the cooldown, got %+v", exec.orders)
+	}
+
+	m.m.Lock()
+	m.events[id].LastTriggered = time.Now().Add(-time.Hour * 2)
+	m.m.Unlock()
+
+	m.OnTicker(testExchange, asset.Spot, &ticker.Price{Pair: testPair(), Last: 200})
+	m.Wait()
+	e, _ = m.GetEvent(id)
+	if len(exec.orders) != 1 || !e.Executed {
+		t.Errorf("Test failed. Expected the retry to execute %+v %+v", exec.orders, e)
+	}
+}
+
+func TestUnknownOrderOutcome(t *testing.T) {
+	m := NewManager("")
+	exec := &testExecutor{err: fmt.Errorf("%w: request timed out", ErrOrderOutcomeUnknown)}
+	m.Executor = exec
+
+	id, err := m.Add(&Event{
+		Exchange:   testExchange,
+		Pair:       testPair(),
+		Conditions: []Condition{{Item: ItemPrice, Operator: GreaterThan, Value: 100}},
+		Action:     ActionSubmitOrder,
+		Rearm:      true,
+		Cooldown:   time.Minute,
+		Order: &OrderAction{
+			Side:   exchange.BuyOrderSide,
+			Type:   exchange.MarketOrderType,
+			Amount: "1",
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	m.OnTicker(testExchange, asset.Spot, &ticker.Price{Pair: testPair(), Last: 200})
+	m.Wait()
+
+	m.m.Lock()
+	m.events[id].LastTriggered = time.Now().Add(-time.Hour)
+	m.m.Unlock()
+
+	exec.err = nil
+	m.OnTicker(testExchange, asset.Spot, &ticker.Price{Pair: testPair(), Last: 200})
+	m.Wait()
+	e, _ := m.GetEvent(id)
+	if !e.Executed || e.TriggerCount != 1 || len(exec.orders) != 0 {
+		t.Errorf("Test failed. Expected an unknown outcome to disarm the event %+v %+v",
+			e, exec.orders)
+	}
+}
+
+func TestOrderActionGuards(t *testing.T) {
+	m := NewManager("")
+	exec := &testExecutor{}
+	m.Executor = exec
+	m.MaxNotional = 1000
+
+	submit := Event{
+		Exchange:   testExchange,
+		Pair:       testPair(),
+		Conditions: []Condition{{Item: ItemSpread, Operator: LessThan, Value: 10}},
+		Action:     ActionSubmitOrder,
+		Cooldown:   time.Minute,
+		Order: &OrderAction{
+			Side:   exchange.SellOrderSide,
+			Type:   exchange.MarketOrderType,
+			Amount: "10",
+		},
+	}
+	if _, err := m.Add(&submit); err != nil {
+		t.Fatal(err)
+	}
+
+	submit.Order = &OrderAction{
+		Side:   exchange.SellOrderSide,
+		Type:   exchange.LimitOrderType,
+		Amount: "1",
+		Price:  "ask",
+		DryRun: true,
+	}
+	if _, err := m.Add(&submit); err != nil {
+		t.Fatal(err)
+	}
+
+	m.OnTicker(testExchange, asset.Spot, &ticker.Price{Pair: testPair(), Last: 200})
+	m.Wait()
+	m.OnOrderbook(&orderbook.Base{
+		ExchangeName: testExchange,
+		Pair:         testPair(),
+		AssetType:    asset.Spot,
+		Bids:         []orderbook.Item{{Price: 199, Amount: 1}},
+		Asks:         []orderbook.Item{{Price: 201, Amount: 1}},
+	})
+	m.Wait()
+	if len(exec.orders) != 0 {
+		t.Errorf("Test failed. Expected no orders, got %+v", exec.orders)
+	}
+
+	// The event rejected by the notional guard stays armed
+	total, executed := m.GetEventCounter()
+	if total != 2 || executed != 1 {
+		t.Errorf("Test failed. Expected only the dry run to execute, got %d %d",
+			total, executed)
+	}
+
+	m.DryRun = true
+	m.MaxNotional = 0
+	_, err := m.Add(&Event{
+		Exchange:   testExchange,
+		Pair:       testPair(),
+		Conditions: []Condition{{Item: ItemPrice, Operator: GreaterThan, Value: 100}},
+		Action:     ActionCancelAllOrders,
+		Cooldown:   time.Minute,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	m.OnTicker(testExchange, asset.Spot, &ticker.Price{Pair: testPair(), Last: 200})
+	m.Wait()
+	if len(exec.orders) != 0 {
+		t.Errorf("Test failed. Expected dry run, got %+v", exec.orders)
+	}
+}
+
+func TestCancelModifyOrderActions(t *testing.T) {
+	m := NewManager("")
+	exec := &testExecutor{}
+	m.Executor = exec
+
+	cancel := orderEvent(ActionCancelOrder, &OrderAction{OrderID: "abc"})
+	modify := orderEvent(ActionModifyOrder, &OrderAction{OrderID: "def", Price: "bid + 1"})
+	for _, evt := range []*Event{&cancel, &modify} {
+		if _, err := m.Add(evt); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	m.OnTicker(testExchange, asset.Spot, &ticker.Price{Pair: testPair(), Last: 50, Bid: 49, Ask: 51})
+	m.Wait()
+	if len(exec.orders) != 2 {
+		t.Fatalf("Test failed. Expected 2 orders, got %d", len(exec.orders))
+	}
+
+	for x := range exec.orders {
+		switch exec.orders[x].action {
+		case ActionCancelOrder:
+			if exec.orders[x].id != "abc" {
+				t.Errorf("Test failed. Unexpected cancel %+v", exec.orders[x])
+			}
+		case ActionModifyOrder:
+			if exec.orders[x].id != "def" || exec.orders[x].price != 50 ||
+				exec.orders[x].amount != 0 {
+				t.Errorf("Test failed. Unexpected modify %+v", exec.orders[x])
+			}
+		default:
+			t.Errorf("Test failed. Unexpected action %s", exec.orders[x].action)
+		}
+	}
+}
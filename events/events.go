@@ -3,280 +3,904 @@ package events
 import (
 	"errors"
 	"fmt"
-	"strconv"
+	"os"
+	"sort"
+	"strings"
+	"time"
 
 	"github.com/thrasher-corp/gocryptotrader/common"
-	"github.com/thrasher-corp/gocryptotrader/communications"
 	"github.com/thrasher-corp/gocryptotrader/communications/base"
-	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/currency"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
 	log "github.com/thrasher-corp/gocryptotrader/logger"
 )
 
-const (
-	itemPrice          = "PRICE"
-	greaterThan        = ">"
-	greaterThanOrEqual = ">="
-	lessThan           = "<"
-	lessThanOrEqual    = "<="
-	isEqual            = "=="
-	actionSMSNotify    = "SMS"
-	actionConsolePrint = "CONSOLE_PRINT"
-	actionTest         = "ACTION_TEST"
-)
+// NewManager returns a new event manager which persists its events to the
+// supplied file path. If the path is empty events are only held in memory
+func NewManager(filePath string) *Manager {
+	return &Manager{
+		events:   make(map[int64]*Event),
+		nextID:   1,
+		market:   make(map[string]*marketData),
+		balances: make(map[string]map[string]float64),
+		filePath: filePath,
+	}
+}
 
-var (
-	errInvalidItem      = errors.New("invalid item")
-	errInvalidCondition = errors.New("invalid conditional option")
-	errInvalidAction    = errors.New("invalid action")
-	errExchangeDisabled = errors.New("desired exchange is disabled")
+// Load reads the persisted events from disk, replacing any events currently
+// held by the manager
+func (m *Manager) Load() error {
+	if m.filePath == "" {
+		return nil
+	}
 
-	// NOTE comms is an interim implementation
-	comms *communications.Communications
-)
+	m.fileMtx.Lock()
+	data, err := common.ReadFile(m.filePath)
+	m.fileMtx.Unlock()
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
 
-// Event struct holds the event variables
-type Event struct {
-	ID        int
-	Exchange  string
-	Item      string
-	Condition string
-	Pair      currency.Pair
-	Asset     string
-	Action    string
-	Executed  bool
+	var events []Event
+	err = common.JSONDecode(data, &events)
+	if err != nil {
+		return err
+	}
+
+	m.m.Lock()
+	defer m.m.Unlock()
+	m.events = make(map[int64]*Event)
+	m.nextID = 1
+	for x := range events {
+		evt := events[x]
+		evt.normalise()
+		m.events[evt.ID] = &evt
+		if evt.ID >= m.nextID {
+			m.nextID = evt.ID + 1
+		}
+	}
+	log.Debugf("Events: loaded %d events from %s\n", len(events), m.filePath)
+	return nil
 }
 
-// Events variable is a pointer array to the event structures that will be
-// appended
-var Events []*Event
+// Save writes all events to disk
+func (m *Manager) Save() error {
+	if m.filePath == "" {
+		return nil
+	}
+
+	m.fileMtx.Lock()
+	defer m.fileMtx.Unlock()
 
-// SetComms is an interim function that will support a median integration. This
-// sets the current comms package.
-func SetComms(commsP *communications.Communications) {
-	comms = commsP
+	data, err := common.JSONEncode(m.GetEvents())
+	if err != nil {
+		return err
+	}
+
+	tmp := m.filePath + ".tmp"
+	err = common.WriteFile(tmp, data)
+	if err != nil {
+		return err
+	}
+	return os.Rename(tmp, m.filePath)
 }
 
-// AddEvent adds an event to the Events chain and returns an index/eventID
-// and an error
-func AddEvent(exchange, item, condition string, currencyPair currency.Pair, asset, action string) (int, error) {
-	err := IsValidEvent(exchange, item, condition, action)
+// Add validates and adds an event, returning its ID
+func (m *Manager) Add(evt *Event) (int64, error) {
+	err := m.Validate(evt)
 	if err != nil {
 		return 0, err
 	}
 
-	evt := &Event{}
+	m.m.Lock()
+	e := evt.copy()
+	e.normalise()
+	e.ID = m.nextID
+	e.Executed = false
+	e.executing = false
+	e.TriggerCount = 0
+	e.LastTriggered = time.Time{}
+	m.nextID++
+	m.events[e.ID] = &e
+	m.m.Unlock()
+
+	m.persist()
+	return e.ID, nil
+}
 
-	if len(Events) == 0 {
-		evt.ID = 0
-	} else {
-		evt.ID = len(Events) + 1
+// Remove deletes an event by its ID
+func (m *Manager) Remove(id int64) error {
+	m.m.Lock()
+	if _, ok := m.events[id]; !ok {
+		m.m.Unlock()
+		return ErrEventNotFound
 	}
+	delete(m.events, id)
+	m.m.Unlock()
+
+	m.persist()
+	return nil
+}
 
-	evt.Exchange = exchange
-	evt.Item = item
-	evt.Condition = condition
-	evt.Pair = currencyPair
-	evt.Asset = asset
-	evt.Action = action
+// Rearm re-enables an event which has already executed
+func (m *Manager) Rearm(id int64) error {
+	m.m.Lock()
+	evt, ok := m.events[id]
+	if !ok {
+		m.m.Unlock()
+		return ErrEventNotFound
+	}
 	evt.Executed = false
-	Events = append(Events, evt)
-	return evt.ID, nil
+	evt.LastTriggered = time.Time{}
+	m.m.Unlock()
+
+	m.persist()
+	return nil
 }
 
-// RemoveEvent deletes and event by its ID
-func RemoveEvent(eventID int) bool {
-	for i, x := range Events {
-		if x.ID == eventID {
-			Events = append(Events[:i], Events[i+1:]...)
-			return true
-		}
+// GetEvent returns an event by its ID
+func (m *Manager) GetEvent(id int64) (Event, error) {
+	m.m.Lock()
+	defer m.m.Unlock()
+	evt, ok := m.events[id]
+	if !ok {
+		return Event{}, ErrEventNotFound
 	}
-	return false
+	return evt.copy(), nil
 }
 
-// GetEventCounter displays the emount of total events on the chain and the
-// events that have been executed.
-func GetEventCounter() (total, executed int) {
-	total = len(Events)
+// GetEvents returns all events sorted by ID
+func (m *Manager) GetEvents() []Event {
+	m.m.Lock()
+	events := make([]Event, 0, len(m.events))
+	for _, evt := range m.events {
+		events = append(events, evt.copy())
+	}
+	m.m.Unlock()
+
+	sort.Slice(events, func(i, j int) bool {
+		return events[i].ID < events[j].ID
+	})
+	return events
+}
 
-	for _, x := range Events {
-		if x.Executed {
+// GetEventCounter returns the total amount of events and the amount of events
+// which have executed and are not re-armable
+func (m *Manager) GetEventCounter() (total, executed int) {
+	m.m.Lock()
+	defer m.m.Unlock()
+	total = len(m.events)
+	for _, evt := range m.events {
+		if evt.Executed {
 			executed++
 		}
 	}
 	return total, executed
 }
 
-// ExecuteAction will execute the action pending on the chain
-func (e *Event) ExecuteAction() bool {
-	if common.StringContains(e.Action, ",") {
-		action := common.SplitStrings(e.Action, ",")
-		if action[0] == actionSMSNotify {
-			message := fmt.Sprintf("Event triggered: %s", e.String())
-			if action[1] == "ALL" {
-				comms.PushEvent(base.Event{TradeDetails: message})
-			}
+// GetBalanceExchanges returns the exchanges which have events with balance
+// conditions that are still armed
+func (m *Manager) GetBalanceExchanges() []string {
+	m.m.Lock()
+	defer m.m.Unlock()
+	var exchanges []string
+	for _, evt := range m.events {
+		if evt.Executed || !evt.hasItem(ItemBalance) {
+			continue
+		}
+		if !common.StringDataCompareInsensitive(exchanges, evt.Exchange) {
+			exchanges = append(exchanges, evt.Exchange)
 		}
-	} else {
-		log.Debugf("Event triggered: %s", e.String())
 	}
-	return true
+	return exchanges
 }
 
-// String turns the structure event into a string
-func (e *Event) String() string {
-	condition := common.SplitStrings(e.Condition, ",")
-	return fmt.Sprintf(
-		"If the %s%s [%s] %s on %s is %s then %s.", e.Pair.Base.String(),
-		e.Pair.Quote.String(),
-		e.Asset,
-		e.Item,
-		e.Exchange,
-		condition[0]+" "+condition[1],
-		e.Action,
-	)
+// OnTicker evaluates all events for the exchange, pair and asset once a
+// ticker update has been received
+func (m *Manager) OnTicker(exchangeName string, assetType asset.Item, t *ticker.Price) {
+	if t == nil {
+		return
+	}
+
+	now := time.Now()
+	m.m.Lock()
+	key := marketKey(exchangeName, t.Pair, assetType)
+	md := m.getMarketData(key)
+	tick := *t
+	md.ticker = &tick
+	if t.Last != 0 {
+		md.record(t.Last, now, m.historyWindow(exchangeName, t.Pair, assetType))
+	}
+	triggered := m.evaluate(exchangeName, t.Pair, assetType, now)
+	m.m.Unlock()
+
+	m.execute(triggered)
 }
 
-// CheckCondition will check the event structure to see if there is a condition
-// met
-func (e *Event) CheckCondition() bool {
-	condition := common.SplitStrings(e.Condition, ",")
-	targetPrice, _ := strconv.ParseFloat(condition[1], 64)
+// HasEvents returns whether any event which has not executed is evaluated
+// against the market data of the exchange, pair and asset
+func (m *Manager) HasEvents(exchangeName string, p currency.Pair, assetType asset.Item) bool {
+	m.m.Lock()
+	defer m.m.Unlock()
+	for _, evt := range m.events {
+		if !evt.Executed && evt.matches(exchangeName, p, assetType) {
+			return true
+		}
+	}
+	return false
+}
 
-	t, err := ticker.GetTicker(e.Exchange, e.Pair, e.Asset)
-	if err != nil {
-		return false
+// OnOrderbook evaluates all events for the exchange, pair and asset once an
+// orderbook update has been received
+func (m *Manager) OnOrderbook(ob *orderbook.Base) {
+	if ob == nil {
+		return
 	}
 
-	lastPrice := t.Last
+	now := time.Now()
+	m.m.Lock()
+	md := m.getMarketData(marketKey(ob.ExchangeName, ob.Pair, ob.AssetType))
+	book := *ob
+	md.orderbook = &book
+	triggered := m.evaluate(ob.ExchangeName, ob.Pair, ob.AssetType, now)
+	m.m.Unlock()
 
-	if lastPrice == 0 {
-		return false
+	m.execute(triggered)
+}
+
+// OnAccountInfo evaluates all events for an exchange once its account
+// balances have been updated
+func (m *Manager) OnAccountInfo(info *exchange.AccountInfo) {
+	if info == nil {
+		return
 	}
 
-	switch condition[0] {
-	case greaterThan:
-		if lastPrice > targetPrice {
-			return e.ExecuteAction()
-		}
-	case greaterThanOrEqual:
-		if lastPrice >= targetPrice {
-			return e.ExecuteAction()
-		}
-	case lessThan:
-		if lastPrice < targetPrice {
-			return e.ExecuteAction()
+	balances := make(map[string]float64)
+	for x := range info.Accounts {
+		for y := range info.Accounts[x].Currencies {
+			c := info.Accounts[x].Currencies[y]
+			balances[c.CurrencyName.Upper().String()] += c.TotalValue
 		}
-	case lessThanOrEqual:
-		if lastPrice <= targetPrice {
-			return e.ExecuteAction()
+	}
+
+	now := time.Now()
+	m.m.Lock()
+	m.balances[common.StringToLower(info.Exchange)] = balances
+	var triggered []trigger
+	for _, evt := range m.events {
+		if !strings.EqualFold(evt.Exchange, info.Exchange) {
+			continue
 		}
-	case isEqual:
-		if lastPrice == targetPrice {
-			return e.ExecuteAction()
+		if m.check(evt, now) {
+			triggered = append(triggered, m.newTrigger(evt))
 		}
 	}
-	return false
+	m.m.Unlock()
+
+	m.execute(triggered)
 }
 
-// IsValidEvent checks the actions to be taken and returns an error if incorrect
-func IsValidEvent(exchange, item, condition, action string) error {
-	exchange = common.StringToUpper(exchange)
-	item = common.StringToUpper(item)
-	action = common.StringToUpper(action)
+// Validate checks that an event and its conditions are valid
+func (m *Manager) Validate(evt *Event) error {
+	if evt == nil {
+		return errors.New("event is nil")
+	}
 
-	if !IsValidExchange(exchange) {
+	if m.ExchangeValidator != nil && !m.ExchangeValidator(evt.Exchange) {
 		return errExchangeDisabled
 	}
 
-	if !IsValidItem(item) {
-		return errInvalidItem
+	logic := common.StringToUpper(evt.Logic)
+	if logic != "" && logic != LogicAnd && logic != LogicOr {
+		return errInvalidLogic
 	}
 
-	if !common.StringContains(condition, ",") {
-		return errInvalidCondition
+	if evt.Asset != "" {
+		if _, err := asset.New(evt.Asset.String()); err != nil {
+			return err
+		}
 	}
 
-	c := common.SplitStrings(condition, ",")
+	if len(evt.Conditions) == 0 {
+		return errNoConditions
+	}
 
-	if !IsValidCondition(c[0]) || c[1] == "" {
-		return errInvalidCondition
+	if evt.Cooldown < 0 {
+		return errors.New("event cooldown cannot be negative")
 	}
 
-	if common.StringContains(action, ",") {
-		a := common.SplitStrings(action, ",")
+	if evt.Cooldown == 0 && isOrderAction(common.StringToUpper(evt.Action)) {
+		return errNoOrderCooldown
+	}
 
-		if a[0] != actionSMSNotify {
-			return errInvalidAction
+	for x := range evt.Conditions {
+		err := evt.Conditions[x].Validate()
+		if err != nil {
+			return err
 		}
 
-		if a[1] != "ALL" {
-			comms.PushEvent(base.Event{Type: a[1]})
+		if evt.Conditions[x].requiresMarketData() && evt.Pair.IsEmpty() {
+			return fmt.Errorf("%s condition requires a currency pair",
+				common.StringToUpper(evt.Conditions[x].Item))
 		}
-	} else if action != actionConsolePrint && action != actionTest {
-		return errInvalidAction
 	}
 
+	err := IsValidAction(evt.Action)
+	if err != nil {
+		return err
+	}
+
+	if isOrderAction(common.StringToUpper(evt.Action)) {
+		return evt.validateOrderAction()
+	}
 	return nil
 }
 
-// CheckEvents is the overarching routine that will iterate through the Events
-// chain
-func CheckEvents() {
-	for {
-		total, executed := GetEventCounter()
-		if total > 0 && executed != total {
-			for _, event := range Events {
-				if !event.Executed {
-					success := event.CheckCondition()
-					if success {
-						log.Debugf(
-							"Event %d triggered on %s successfully.\n", event.ID,
-							event.Exchange,
-						)
-						event.Executed = true
-					}
-				}
-			}
+// Validate checks that a condition is valid
+func (c *Condition) Validate() error {
+	if !IsValidItem(c.Item) {
+		return errInvalidItem
+	}
+
+	if !IsValidCondition(c.Operator) {
+		return errInvalidCondition
+	}
+
+	switch common.StringToUpper(c.Item) {
+	case ItemPercentChange:
+		if c.Window <= 0 || c.Window > maxPriceHistory {
+			return fmt.Errorf("%s condition window must be between 0 and %s",
+				ItemPercentChange, maxPriceHistory)
+		}
+	case ItemDepth:
+		err := c.validateSide()
+		if err != nil {
+			return err
+		}
+		if c.Price <= 0 {
+			return fmt.Errorf("%s condition price must be greater than zero",
+				ItemDepth)
+		}
+	case ItemBalance:
+		if c.Currency.IsEmpty() {
+			return fmt.Errorf("%s condition requires a currency", ItemBalance)
+		}
+	case ItemAveragePrice, ItemSlippage, ItemMarketImpact, ItemFillPrice:
+		err := c.validateSide()
+		if err != nil {
+			return err
+		}
+		if c.Amount <= 0 {
+			return fmt.Errorf("%s condition amount must be greater than zero",
+				common.StringToUpper(c.Item))
+		}
+	case ItemLiquidity:
+		err := c.validateSide()
+		if err != nil {
+			return err
+		}
+		if c.Percent <= 0 {
+			return fmt.Errorf("%s condition percent must be greater than zero",
+				ItemLiquidity)
 		}
 	}
+	return nil
 }
 
-// IsValidExchange validates the exchange
-func IsValidExchange(exchange string) bool {
-	exchange = common.StringToUpper(exchange)
-	cfg := config.GetConfig()
-	for x := range cfg.Exchanges {
-		if cfg.Exchanges[x].Name == exchange && cfg.Exchanges[x].Enabled {
-			return true
+// validateSide checks that a condition has an orderbook side
+func (c *Condition) validateSide() error {
+	side := common.StringToUpper(c.Side)
+	if side != SideBids && side != SideAsks {
+		return fmt.Errorf("%s condition side must be %s or %s",
+			common.StringToUpper(c.Item), SideBids, SideAsks)
+	}
+	return nil
+}
+
+// String turns the event into a readable string
+func (e *Event) String() string {
+	conditions := make([]string, len(e.Conditions))
+	for x := range e.Conditions {
+		conditions[x] = e.Conditions[x].String()
+	}
+
+	if e.Pair.IsEmpty() {
+		return fmt.Sprintf("If %s has %s then %s.",
+			e.Exchange,
+			strings.Join(conditions, " "+e.Logic+" "),
+			e.Action)
+	}
+
+	return fmt.Sprintf("If the %s [%s] on %s has %s then %s.",
+		e.Pair,
+		e.Asset,
+		e.Exchange,
+		strings.Join(conditions, " "+e.Logic+" "),
+		e.Action)
+}
+
+// String turns the condition into a readable string
+func (c *Condition) String() string {
+	switch c.Item {
+	case ItemPercentChange:
+		return fmt.Sprintf("%s over %s %s %v", c.Item, c.Window, c.Operator, c.Value)
+	case ItemDepth:
+		return fmt.Sprintf("%s %s at %v %s %v", c.Item, c.Side, c.Price, c.Operator, c.Value)
+	case ItemBalance:
+		return fmt.Sprintf("%s %s %s %v", c.Item, c.Currency, c.Operator, c.Value)
+	case ItemAveragePrice, ItemSlippage, ItemMarketImpact, ItemFillPrice:
+		unit := "base"
+		if c.QuoteAmount {
+			unit = "quote"
 		}
+		return fmt.Sprintf("%s %s for %v %s %s %v", c.Item, c.Side, c.Amount,
+			unit, c.Operator, c.Value)
+	case ItemLiquidity:
+		return fmt.Sprintf("%s %s within %v%% %s %v", c.Item, c.Side, c.Percent,
+			c.Operator, c.Value)
 	}
-	return false
+	return fmt.Sprintf("%s %s %v", c.Item, c.Operator, c.Value)
 }
 
-// IsValidCondition validates passed in condition
+// IsValidCondition validates passed in condition operator
 func IsValidCondition(condition string) bool {
 	switch condition {
-	case greaterThan, greaterThanOrEqual, lessThan, lessThanOrEqual, isEqual:
+	case GreaterThan, GreaterThanOrEqual, LessThan, LessThanOrEqual, IsEqual:
 		return true
 	}
 	return false
 }
 
 // IsValidAction validates passed in action
-func IsValidAction(action string) bool {
+func IsValidAction(action string) error {
 	action = common.StringToUpper(action)
+	if common.StringContains(action, ",") {
+		a := common.SplitStrings(action, ",")
+		if a[0] != ActionSMSNotify || a[1] == "" {
+			return errInvalidAction
+		}
+		return nil
+	}
+
 	switch action {
-	case actionSMSNotify, actionConsolePrint, actionTest:
-		return true
+	case ActionConsolePrint, ActionTest:
+		return nil
 	}
-	return false
+
+	if isOrderAction(action) {
+		return nil
+	}
+	return errInvalidAction
 }
 
 // IsValidItem validates passed in Item
 func IsValidItem(item string) bool {
-	item = common.StringToUpper(item)
-	return (item == itemPrice)
+	switch common.StringToUpper(item) {
+	case ItemPrice, ItemPercentChange, ItemSpread, ItemDepth, ItemVolume,
+		ItemBalance, ItemMidPrice, ItemMicroPrice, ItemSpreadBPS,
+		ItemAveragePrice, ItemSlippage, ItemMarketImpact, ItemFillPrice,
+		ItemLiquidity:
+		return true
+	}
+	return false
+}
+
+// evaluate checks all events matching the exchange, pair and asset and
+// returns the events which have triggered. Callers must hold the manager lock
+func (m *Manager) evaluate(exchangeName string, p currency.Pair, assetType asset.Item, now time.Time) []trigger {
+	var triggered []trigger
+	for _, evt := range m.events {
+		if !evt.matches(exchangeName, p, assetType) {
+			continue
+		}
+		if m.check(evt, now) {
+			triggered = append(triggered, m.newTrigger(evt))
+		}
+	}
+	return triggered
+}
+
+// check evaluates an events conditions and marks it as triggered if they are
+// met. An event is not checked while its action is running or within the
+// cooldown of its last trigger, which also delays retrying a failed action.
+// Callers must hold the manager lock
+func (m *Manager) check(evt *Event, now time.Time) bool {
+	if evt.Executed || evt.executing {
+		return false
+	}
+
+	if !evt.LastTriggered.IsZero() &&
+		now.Sub(evt.LastTriggered) < evt.Cooldown {
+		return false
+	}
+
+	md := m.market[marketKey(evt.Exchange, evt.Pair, evt.Asset)]
+	balances := m.balances[common.StringToLower(evt.Exchange)]
+
+	met := evt.Logic == LogicAnd
+	for x := range evt.Conditions {
+		result := evt.Conditions[x].check(md, balances, now)
+		if evt.Logic == LogicOr && result {
+			met = true
+			break
+		}
+		if evt.Logic == LogicAnd && !result {
+			met = false
+			break
+		}
+	}
+
+	if !met {
+		return false
+	}
+
+	evt.TriggerCount++
+	evt.LastTriggered = now
+	evt.executing = true
+	return true
+}
+
+// execute runs the actions for triggered events in the background so market
+// data updates are not held up by exchange requests
+func (m *Manager) execute(triggered []trigger) {
+	if len(triggered) == 0 {
+		return
+	}
+
+	m.wg.Add(1)
+	go func() {
+		defer m.wg.Done()
+		for x := range triggered {
+			err := m.executeAction(&triggered[x])
+			m.finish(&triggered[x].event, err)
+		}
+		m.persist()
+	}()
+}
+
+// Wait blocks until the actions of all triggered events have completed
+func (m *Manager) Wait() {
+	m.wg.Wait()
+}
+
+// finish records the outcome of a triggered events action. Events which are
+// not re-armable are only marked as executed once their action succeeds. An
+// order submission with an unknown outcome is marked as executed whether or
+// not the event is re-armable, so an order which may be live is never placed
+// again
+func (m *Manager) finish(t *Event, err error) {
+	m.m.Lock()
+	defer m.m.Unlock()
+	evt, ok := m.events[t.ID]
+	if !ok {
+		return
+	}
+	evt.executing = false
+	if errors.Is(err, ErrOrderOutcomeUnknown) {
+		evt.Executed = true
+		log.Warnf("Event %d %s outcome on %s is unknown, the event has been disarmed: %s\n",
+			evt.ID, evt.Action, evt.Exchange, err)
+		return
+	}
+	if err != nil {
+		return
+	}
+	evt.Executed = !evt.Rearm
+	log.Debugf("Event %d triggered on %s successfully.\n", evt.ID,
+		evt.Exchange)
+}
+
+// executeAction executes the action of a triggered event
+func (m *Manager) executeAction(t *trigger) error {
+	evt := &t.event
+	message := fmt.Sprintf("Event triggered: %s", evt.String())
+	action := common.StringToUpper(evt.Action)
+	if isOrderAction(action) {
+		log.Debugln(message)
+		return m.executeOrderAction(t)
+	}
+
+	if common.StringContains(action, ",") {
+		a := common.SplitStrings(action, ",")
+		if a[0] == ActionSMSNotify && m.Notifier != nil {
+			e := base.Event{TradeDetails: message}
+			if a[1] != "ALL" {
+				e.Type = a[1]
+			}
+			m.Notifier.PushEvent(e)
+		}
+		return nil
+	}
+	log.Debugln(message)
+	return nil
+}
+
+func (m *Manager) persist() {
+	err := m.Save()
+	if err != nil {
+		log.Errorf("Events: unable to save events: %s\n", err)
+	}
+}
+
+// getMarketData returns the cached market data for a key, creating it if it
+// does not exist. Callers must hold the manager lock
+func (m *Manager) getMarketData(key string) *marketData {
+	md, ok := m.market[key]
+	if !ok {
+		md = new(marketData)
+		m.market[key] = md
+	}
+	return md
+}
+
+// historyWindow returns the largest percent change window used by events for
+// the exchange, pair and asset. Callers must hold the manager lock
+func (m *Manager) historyWindow(exchangeName string, p currency.Pair, assetType asset.Item) time.Duration {
+	var window time.Duration
+	for _, evt := range m.events {
+		if !evt.matches(exchangeName, p, assetType) {
+			continue
+		}
+		for x := range evt.Conditions {
+			if evt.Conditions[x].Item == ItemPercentChange &&
+				evt.Conditions[x].Window > window {
+				window = evt.Conditions[x].Window
+			}
+		}
+	}
+	return window
+}
+
+// record adds a price to the history and prunes prices which are no longer
+// needed to evaluate the largest window. The newest price older than the
+// window is kept as the reference price
+func (md *marketData) record(price float64, now time.Time, window time.Duration) {
+	if window == 0 {
+		md.history = nil
+		return
+	}
+
+	md.history = append(md.history, pricePoint{price: price, time: now})
+	cutoff := now.Add(-window)
+	var drop int
+	for x := 1; x < len(md.history); x++ {
+		if md.history[x].time.After(cutoff) {
+			break
+		}
+		drop = x
+	}
+	if drop > 0 {
+		md.history = append(md.history[:0], md.history[drop:]...)
+	}
+}
+
+// percentChange returns the percentage change between the price at the start
+// of the window and the latest price
+func (md *marketData) percentChange(window time.Duration, now time.Time) (float64, bool) {
+	if len(md.history) < 2 {
+		return 0, false
+	}
+
+	cutoff := now.Add(-window)
+	ref := -1
+	for x := range md.history {
+		if md.history[x].time.After(cutoff) {
+			break
+		}
+		ref = x
+	}
+
+	if ref == -1 || md.history[ref].price == 0 {
+		return 0, false
+	}
+
+	last := md.history[len(md.history)-1].price
+	return common.CalculatePercentageGainOrLoss(last, md.history[ref].price), true
+}
+
+// check evaluates the condition against the cached market data and balances
+func (c *Condition) check(md *marketData, balances map[string]float64, now time.Time) bool {
+	value, ok := c.value(md, balances, now)
+	if !ok {
+		return false
+	}
+
+	switch c.Operator {
+	case GreaterThan:
+		return value > c.Value
+	case GreaterThanOrEqual:
+		return value >= c.Value
+	case LessThan:
+		return value < c.Value
+	case LessThanOrEqual:
+		return value <= c.Value
+	case IsEqual:
+		return value == c.Value
+	}
+	return false
+}
+
+// value returns the current value for the conditions item and whether there
+// is enough data to evaluate it
+func (c *Condition) value(md *marketData, balances map[string]float64, now time.Time) (float64, bool) {
+	if c.Item == ItemBalance {
+		if balances == nil {
+			return 0, false
+		}
+		return balances[c.Currency.Upper().String()], true
+	}
+
+	if md == nil {
+		return 0, false
+	}
+
+	switch c.Item {
+	case ItemPrice:
+		if md.ticker == nil || md.ticker.Last == 0 {
+			return 0, false
+		}
+		return md.ticker.Last, true
+	case ItemVolume:
+		if md.ticker == nil {
+			return 0, false
+		}
+		return md.ticker.Volume, true
+	case ItemPercentChange:
+		return md.percentChange(c.Window, now)
+	case ItemSpread:
+		if md.orderbook != nil && len(md.orderbook.Bids) > 0 &&
+			len(md.orderbook.Asks) > 0 {
+			return md.orderbook.Asks[0].Price - md.orderbook.Bids[0].Price, true
+		}
+		if md.ticker != nil && md.ticker.Bid != 0 && md.ticker.Ask != 0 {
+			return md.ticker.Ask - md.ticker.Bid, true
+		}
+		return 0, false
+	case ItemDepth:
+		if md.orderbook == nil {
+			return 0, false
+		}
+		return c.depth(md.orderbook), true
+	case ItemMidPrice, ItemMicroPrice, ItemSpreadBPS, ItemAveragePrice,
+		ItemSlippage, ItemMarketImpact, ItemFillPrice, ItemLiquidity:
+		if md.orderbook == nil {
+			return 0, false
+		}
+		return c.analytics(md.orderbook)
+	}
+	return 0, false
+}
+
+// analytics returns the value of an orderbook analytics condition and whether
+// the orderbook holds enough liquidity to evaluate it. Fills which cannot
+// be completed are not evaluated
+func (c *Condition) analytics(ob *orderbook.Base) (float64, bool) {
+	var value float64
+	var err error
+	switch c.Item {
+	case ItemMidPrice:
+		value, err = ob.MidPrice()
+	case ItemMicroPrice:
+		value, err = ob.MicroPrice()
+	case ItemSpreadBPS:
+		value, err = ob.SpreadBPS()
+	case ItemLiquidity:
+		var l orderbook.Liquidity
+		l, err = ob.LiquidityWithin(c.Percent)
+		value = l.AskAmount
+		if c.Side == SideBids {
+			value = l.BidAmount
+		}
+	default:
+		var f orderbook.Fill
+		if c.Side == SideBids {
+			f, err = ob.SimulateSell(c.Amount, c.QuoteAmount)
+		} else {
+			f, err = ob.SimulateBuy(c.Amount, c.QuoteAmount)
+		}
+		if err != nil || !f.FullyFilled {
+			return 0, false
+		}
+		switch c.Item {
+		case ItemAveragePrice:
+			value = f.AveragePrice
+		case ItemSlippage:
+			value = f.SlippagePercent
+		case ItemMarketImpact:
+			value = f.ImpactPercent
+		case ItemFillPrice:
+			value = f.WorstPrice
+		}
+	}
+	return value, err == nil
+}
+
+// depth returns the total amount available on the conditions side of the
+// orderbook up to and including the conditions price
+func (c *Condition) depth(ob *orderbook.Base) float64 {
+	var total float64
+	if c.Side == SideBids {
+		for x := range ob.Bids {
+			if ob.Bids[x].Price >= c.Price {
+				total += ob.Bids[x].Amount
+			}
+		}
+		return total
+	}
+
+	for x := range ob.Asks {
+		if ob.Asks[x].Price <= c.Price {
+			total += ob.Asks[x].Amount
+		}
+	}
+	return total
+}
+
+func (c *Condition) requiresMarketData() bool {
+	return common.StringToUpper(c.Item) != ItemBalance
+}
+
+func (e *Event) matches(exchangeName string, p currency.Pair, assetType asset.Item) bool {
+	if !strings.EqualFold(e.Exchange, exchangeName) {
+		return false
+	}
+	if !strings.EqualFold(e.Asset.String(), assetType.String()) {
+		return false
+	}
+	return e.Pair.Base.Upper().String() == p.Base.Upper().String() &&
+		e.Pair.Quote.Upper().String() == p.Quote.Upper().String()
+}
+
+func (e *Event) hasItem(item string) bool {
+	for x := range e.Conditions {
+		if e.Conditions[x].Item == item {
+			return true
+		}
+	}
+	return false
+}
+
+// normalise sets default values and formats the event and its conditions so
+// they can be compared against incoming data
+func (e *Event) normalise() {
+	e.Action = common.StringToUpper(e.Action)
+	if e.Order != nil {
+		e.Order.Side = exchange.OrderSide(common.StringToUpper(string(e.Order.Side)))
+		e.Order.Type = exchange.OrderType(common.StringToUpper(string(e.Order.Type)))
+	}
+	e.Logic = common.StringToUpper(e.Logic)
+	if e.Logic == "" {
+		e.Logic = LogicAnd
+	}
+	if e.Asset == "" {
+		e.Asset = asset.Spot
+	}
+	e.Asset = asset.Item(common.StringToUpper(e.Asset.String()))
+	for x := range e.Conditions {
+		e.Conditions[x].Item = common.StringToUpper(e.Conditions[x].Item)
+		e.Conditions[x].Side = common.StringToUpper(e.Conditions[x].Side)
+	}
+}
+
+func (e *Event) copy() Event {
+	c := *e
+	c.Conditions = append([]Condition(nil), e.Conditions...)
+	if e.Order != nil {
+		o := *e.Order
+		c.Order = &o
+	}
+	return c
+}
+
+// marketKey returns the market data key for an exchange, pair and asset
+func marketKey(exchangeName string, p currency.Pair, assetType asset.Item) string {
+	return common.StringToLower(exchangeName) + ":" +
+		p.Base.Upper().String() + p.Quote.Upper().String() + ":" +
+		common.StringToLower(assetType.String())
 }
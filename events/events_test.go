@@ -0,0 +1,455 @@
+package events
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/communications/base"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+)
+
+const testExchange = "Bitstamp"
+
+type testNotifier struct {
+	events []base.Event
+}
+
+func (t *testNotifier) PushEvent(e base.Event) {
+	t.events = append(t.events, e)
+}
+
+func testPair() currency.Pair {
+	return currency.NewPairFromStrings("BTC", "USD")
+}
+
+func TestAddRemoveEvent(t *testing.T) {
+	m := NewManager("")
+	m.ExchangeValidator = func(exch string) bool { return exch == testExchange }
+
+	evt := Event{
+		Exchange: testExchange,
+		Pair:     testPair(),
+		Asset:    asset.Spot,
+		Conditions: []Condition{
+			{Item: "price", Operator: GreaterThan, Value: 10},
+		},
+		Action: ActionTest,
+	}
+
+	id, err := m.Add(&evt)
+	if err != nil {
+		t.Fatalf("Test failed. Add: %s", err)
+	}
+
+	e, err := m.GetEvent(id)
+	if err != nil {
+		t.Fatalf("Test failed. GetEvent: %s", err)
+	}
+
+	if e.Logic != LogicAnd || e.Conditions[0].Item != ItemPrice {
+		t.Errorf("Test failed. Event was not normalised %+v", e)
+	}
+
+	if e.String() != "If the BTCUSD [SPOT] on Bitstamp has PRICE > 10 then ACTION_TEST." {
+		t.Errorf("Test failed. Unexpected event string %s", e.String())
+	}
+
+	invalid := evt
+	invalid.Exchange = "asdf"
+	if _, err = m.Add(&invalid); err != errExchangeDisabled {
+		t.Errorf("Test failed. Expected %s, got %v", errExchangeDisabled, err)
+	}
+
+	invalid = evt
+	invalid.Conditions = []Condition{{Item: "blah", Operator: GreaterThan}}
+	if _, err = m.Add(&invalid); err != errInvalidItem {
+		t.Errorf("Test failed. Expected %s, got %v", errInvalidItem, err)
+	}
+
+	invalid.Conditions = []Condition{{Item: ItemPrice, Operator: "^"}}
+	if _, err = m.Add(&invalid); err != errInvalidCondition {
+		t.Errorf("Test failed. Expected %s, got %v", errInvalidCondition, err)
+	}
+
+	invalid.Conditions = []Condition{{Item: ItemDepth, Operator: GreaterThan}}
+	if _, err = m.Add(&invalid); err == nil {
+		t.Error("Test failed. Expected depth condition error")
+	}
+
+	invalid = evt
+	invalid.Logic = "XOR"
+	if _, err = m.Add(&invalid); err != errInvalidLogic {
+		t.Errorf("Test failed. Expected %s, got %v", errInvalidLogic, err)
+	}
+
+	invalid = evt
+	invalid.Action = "SMS,"
+	if _, err = m.Add(&invalid); err != errInvalidAction {
+		t.Errorf("Test failed. Expected %s, got %v", errInvalidAction, err)
+	}
+
+	if err = m.Remove(id); err != nil {
+		t.Errorf("Test failed. Remove: %s", err)
+	}
+
+	if err = m.Remove(id); err != ErrEventNotFound {
+		t.Errorf("Test failed. Expected %s, got %v", ErrEventNotFound, err)
+	}
+}
+
+func TestPriceEventTriggersOnce(t *testing.T) {
+	m := NewManager("")
+	n := &testNotifier{}
+	m.Notifier = n
+
+	id, err := m.Add(&Event{
+		Exchange:   testExchange,
+		Pair:       testPair(),
+		Conditions: []Condition{{Item: ItemPrice, Operator: GreaterThanOrEqual, Value: 100}},
+		Action:     "SMS,ALL",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !m.HasEvents(testExchange, testPair(), asset.Spot) ||
+		m.HasEvents(testExchange, testPair(), asset.Futures) {
+		t.Error("Test failed. Expected events only for the events market")
+	}
+
+	m.OnTicker(testExchange, asset.Spot, &ticker.Price{Pair: testPair(), Last: 99})
+	m.Wait()
+	if len(n.events) != 0 {
+		t.Error("Test failed. Event triggered below threshold")
+	}
+
+	m.OnTicker(testExchange, asset.Spot, &ticker.Price{Pair: testPair(), Last: 100})
+	m.Wait()
+	m.OnTicker(testExchange, asset.Spot, &ticker.Price{Pair: testPair(), Last: 101})
+	m.Wait()
+	if len(n.events) != 1 {
+		t.Errorf("Test failed. Expected 1 notification, got %d", len(n.events))
+	}
+
+	total, executed := m.GetEventCounter()
+	if total != 1 || executed != 1 {
+		t.Errorf("Test failed. Unexpected counter %d %d", total, executed)
+	}
+	if m.HasEvents(testExchange, testPair(), asset.Spot) {
+		t.Error("Test failed. Expected executed events not to need market data")
+	}
+
+	if err = m.Rearm(id); err != nil {
+		t.Fatal(err)
+	}
+
+	m.OnTicker(testExchange, asset.Spot, &ticker.Price{Pair: testPair(), Last: 101})
+	m.Wait()
+	if len(n.events) != 2 {
+		t.Errorf("Test failed. Expected 2 notifications, got %d", len(n.events))
+	}
+}
+
+func TestRearmCooldown(t *testing.T) {
+	m := NewManager("")
+	id, err := m.Add(&Event{
+		Exchange:   testExchange,
+		Pair:       testPair(),
+		Conditions: []Condition{{Item: ItemVolume, Operator: GreaterThan, Value: 1}},
+		Action:     ActionTest,
+		Rearm:      true,
+		Cooldown:   time.Hour,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	m.OnTicker(testExchange, asset.Spot, &ticker.Price{Pair: testPair(), Volume: 5})
+	m.Wait()
+	m.OnTicker(testExchange, asset.Spot, &ticker.Price{Pair: testPair(), Volume: 5})
+	m.Wait()
+
+	e, _ := m.GetEvent(id)
+	if e.TriggerCount != 1 || e.Executed {
+		t.Errorf("Test failed. Unexpected trigger state %+v", e)
+	}
+
+	// Move the last trigger outside of the cooldown
+	m.m.Lock()
+	m.events[id].LastTriggered = time.Now().Add(-time.Hour * 2)
+	m.m.Unlock()
+
+	m.OnTicker(testExchange, asset.Spot, &ticker.Price{Pair: testPair(), Volume: 5})
+	m.Wait()
+	e, _ = m.GetEvent(id)
+	if e.TriggerCount != 2 {
+		t.Errorf("Test failed. Expected 2 triggers, got %d", e.TriggerCount)
+	}
+}
+
+func TestOrderbookConditions(t *testing.T) {
+	m := NewManager("")
+	ob := orderbook.Base{
+		ExchangeName: testExchange,
+		Pair:         testPair(),
+		AssetType:    asset.Spot,
+		Bids:         []orderbook.Item{{Price: 99, Amount: 1}, {Price: 98, Amount: 2}, {Price: 90, Amount: 10}},
+		Asks:         []orderbook.Item{{Price: 101, Amount: 1}, {Price: 105, Amount: 3}},
+	}
+
+	and, err := m.Add(&Event{
+		Exchange: testExchange,
+		Pair:     testPair(),
+		Logic:    LogicAnd,
+		Conditions: []Condition{
+			{Item: ItemSpread, Operator: LessThanOrEqual, Value: 2},
+			{Item: ItemDepth, Operator: GreaterThanOrEqual, Value: 3, Side: "bids", Price: 98},
+			{Item: ItemDepth, Operator: GreaterThan, Value: 4, Side: SideAsks, Price: 105},
+		},
+		Action: ActionTest,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	or, err := m.Add(&Event{
+		Exchange: testExchange,
+		Pair:     testPair(),
+		Logic:    LogicOr,
+		Conditions: []Condition{
+			{Item: ItemSpread, Operator: GreaterThan, Value: 100},
+			{Item: ItemDepth, Operator: IsEqual, Value: 4, Side: SideAsks, Price: 105},
+		},
+		Action: ActionTest,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	m.OnOrderbook(&ob)
+	m.Wait()
+
+	e, _ := m.GetEvent(and)
+	if e.Executed {
+		t.Error("Test failed. AND event should not trigger")
+	}
+
+	e, _ = m.GetEvent(or)
+	if !e.Executed {
+		t.Error("Test failed. OR event should trigger")
+	}
+
+	ob.Asks = append(ob.Asks, orderbook.Item{Price: 104, Amount: 1})
+	m.OnOrderbook(&ob)
+	m.Wait()
+	e, _ = m.GetEvent(and)
+	if !e.Executed {
+		t.Error("Test failed. AND event should trigger")
+	}
+}
+
+func TestOrderbookAnalyticsConditions(t *testing.T) {
+	m := NewManager("")
+	ob := orderbook.Base{
+		ExchangeName: testExchange,
+		Pair:         testPair(),
+		AssetType:    asset.Spot,
+		Bids:         []orderbook.Item{{Price: 99, Amount: 1}, {Price: 98, Amount: 2}},
+		Asks:         []orderbook.Item{{Price: 101, Amount: 3}, {Price: 102, Amount: 2}},
+	}
+
+	_, err := m.Add(&Event{
+		Exchange:   testExchange,
+		Pair:       testPair(),
+		Conditions: []Condition{{Item: ItemSlippage, Operator: GreaterThan, Value: 1, Side: SideAsks}},
+		Action:     ActionTest,
+	})
+	if err == nil {
+		t.Error("Test failed. Expected an error for a slippage condition without an amount")
+	}
+
+	_, err = m.Add(&Event{
+		Exchange:   testExchange,
+		Pair:       testPair(),
+		Conditions: []Condition{{Item: ItemLiquidity, Operator: GreaterThan, Value: 1, Side: "mid", Percent: 1}},
+		Action:     ActionTest,
+	})
+	if err == nil {
+		t.Error("Test failed. Expected an error for a liquidity condition without a valid side")
+	}
+
+	prices, err := m.Add(&Event{
+		Exchange: testExchange,
+		Pair:     testPair(),
+		Conditions: []Condition{
+			{Item: ItemMidPrice, Operator: IsEqual, Value: 100},
+			{Item: ItemSpreadBPS, Operator: LessThanOrEqual, Value: 200},
+			{Item: ItemMicroPrice, Operator: LessThan, Value: 100},
+			{Item: ItemLiquidity, Operator: GreaterThanOrEqual, Value: 3, Side: "bids", Percent: 2},
+		},
+		Action: ActionTest,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	fill, err := m.Add(&Event{
+		Exchange: testExchange,
+		Pair:     testPair(),
+		Conditions: []Condition{
+			{Item: ItemAveragePrice, Operator: GreaterThan, Value: 101, Side: SideAsks, Amount: 4},
+			{Item: ItemFillPrice, Operator: IsEqual, Value: 98, Side: SideBids, Amount: 197, QuoteAmount: true},
+		},
+		Action: ActionTest,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	unfilled, err := m.Add(&Event{
+		Exchange:   testExchange,
+		Pair:       testPair(),
+		Conditions: []Condition{{Item: ItemMarketImpact, Operator: GreaterThan, Value: 0, Side: SideBids, Amount: 10}},
+		Action:     ActionTest,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	m.OnOrderbook(&ob)
+	m.Wait()
+	for _, id := range []int64{prices, fill} {
+		e, _ := m.GetEvent(id)
+		if !e.Executed {
+			t.Errorf("Test failed. Expected %s to trigger", e.String())
+		}
+	}
+
+	e, _ := m.GetEvent(unfilled)
+	if e.Executed {
+		t.Error("Test failed. Expected a fill beyond the orderbook not to trigger")
+	}
+}
+
+func TestPercentChange(t *testing.T) {
+	md := new(marketData)
+	now := time.Now()
+	md.record(100, now.Add(-time.Minute*10), time.Minute*5)
+	md.record(105, now.Add(-time.Minute*4), time.Minute*5)
+	md.record(110, now, time.Minute*5)
+
+	change, ok := md.percentChange(time.Minute*5, now)
+	if !ok || change != 10 {
+		t.Errorf("Test failed. Expected 10%% change, got %v %v", change, ok)
+	}
+
+	if len(md.history) != 3 {
+		t.Errorf("Test failed. Expected reference price to be kept, got %d",
+			len(md.history))
+	}
+
+	md.record(120, now.Add(time.Minute*7), time.Minute*5)
+	if len(md.history) != 2 || md.history[0].price != 110 {
+		t.Errorf("Test failed. Expected stale prices to be pruned %+v",
+			md.history)
+	}
+
+	md = new(marketData)
+	md.record(100, now, time.Minute)
+	md.record(101, now.Add(time.Second), time.Minute)
+	if _, ok = md.percentChange(time.Minute, now.Add(time.Second)); ok {
+		t.Error("Test failed. Expected insufficient history")
+	}
+}
+
+func TestBalanceCondition(t *testing.T) {
+	m := NewManager("")
+	id, err := m.Add(&Event{
+		Exchange: testExchange,
+		Conditions: []Condition{
+			{Item: ItemBalance, Operator: LessThan, Value: 1, Currency: currency.BTC},
+		},
+		Action: ActionConsolePrint,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if exchs := m.GetBalanceExchanges(); len(exchs) != 1 || exchs[0] != testExchange {
+		t.Errorf("Test failed. Unexpected balance exchanges %v", exchs)
+	}
+
+	info := exchange.AccountInfo{
+		Exchange: testExchange,
+		Accounts: []exchange.Account{
+			{Currencies: []exchange.AccountCurrencyInfo{{CurrencyName: currency.BTC, TotalValue: 0.6}}},
+			{Currencies: []exchange.AccountCurrencyInfo{{CurrencyName: currency.BTC, TotalValue: 0.6}}},
+		},
+	}
+	m.OnAccountInfo(&info)
+	m.Wait()
+	e, _ := m.GetEvent(id)
+	if e.Executed {
+		t.Error("Test failed. Balance event should not trigger")
+	}
+
+	info.Accounts = info.Accounts[:1]
+	m.OnAccountInfo(&info)
+	m.Wait()
+	e, _ = m.GetEvent(id)
+	if !e.Executed {
+		t.Error("Test failed. Balance event should trigger")
+	}
+}
+
+func TestPersistence(t *testing.T) {
+	dir, err := ioutil.TempDir("", "events")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "events.json")
+	m := NewManager(path)
+	_, err = m.Add(&Event{
+		Exchange: testExchange,
+		Pair:     testPair(),
+		Conditions: []Condition{
+			{Item: ItemPercentChange, Operator: LessThan, Value: -5, Window: time.Hour},
+		},
+		Action:   ActionTest,
+		Rearm:    true,
+		Cooldown: time.Minute,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	loaded := NewManager(path)
+	err = loaded.Load()
+	if err != nil {
+		t.Fatalf("Test failed. Load: %s", err)
+	}
+
+	events := loaded.GetEvents()
+	if len(events) != 1 || events[0].Conditions[0].Window != time.Hour ||
+		!events[0].Pair.Equal(testPair()) || events[0].Cooldown != time.Minute {
+		t.Errorf("Test failed. Unexpected loaded events %+v", events)
+	}
+
+	id, err := loaded.Add(&events[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if id != 2 {
+		t.Errorf("Test failed. Expected next ID 2, got %d", id)
+	}
+}
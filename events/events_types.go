@@ -0,0 +1,216 @@
+package events
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/communications/base"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+)
+
+// Condition items which can be evaluated
+const (
+	ItemPrice         = "PRICE"
+	ItemPercentChange = "PERCENT_CHANGE"
+	ItemSpread        = "SPREAD"
+	ItemDepth         = "DEPTH"
+	ItemVolume        = "VOLUME"
+	ItemBalance       = "BALANCE"
+	ItemMidPrice      = "MID_PRICE"
+	ItemMicroPrice    = "MICRO_PRICE"
+	ItemSpreadBPS     = "SPREAD_BPS"
+	ItemAveragePrice  = "AVERAGE_PRICE"
+	ItemSlippage      = "SLIPPAGE"
+	ItemMarketImpact  = "MARKET_IMPACT"
+	ItemFillPrice     = "FILL_PRICE"
+	ItemLiquidity     = "LIQUIDITY"
+)
+
+// Condition operators
+const (
+	GreaterThan        = ">"
+	GreaterThanOrEqual = ">="
+	LessThan           = "<"
+	LessThanOrEqual    = "<="
+	IsEqual            = "=="
+)
+
+// Condition logic used to combine an events conditions
+const (
+	LogicAnd = "AND"
+	LogicOr  = "OR"
+)
+
+// Orderbook sides used by depth, fill and liquidity conditions, a fill
+// against the asks is a buy and against the bids a sell
+const (
+	SideBids = "BIDS"
+	SideAsks = "ASKS"
+)
+
+// Event actions
+const (
+	ActionSMSNotify       = "SMS"
+	ActionConsolePrint    = "CONSOLE_PRINT"
+	ActionTest            = "ACTION_TEST"
+	ActionSubmitOrder     = "SUBMIT_ORDER"
+	ActionCancelOrder     = "CANCEL_ORDER"
+	ActionCancelAllOrders = "CANCEL_ALL_ORDERS"
+	ActionModifyOrder     = "MODIFY_ORDER"
+)
+
+const (
+	// maxPriceHistory is the maximum window a percent change condition can
+	// be evaluated over
+	maxPriceHistory = time.Hour * 24 * 7
+)
+
+// vars related to events
+var (
+	ErrEventNotFound    = errors.New("event not found")
+	errInvalidItem      = errors.New("invalid item")
+	errInvalidCondition = errors.New("invalid conditional option")
+	errInvalidAction    = errors.New("invalid action")
+	errInvalidLogic     = errors.New("invalid condition logic")
+	errNoConditions     = errors.New("event has no conditions")
+	errExchangeDisabled = errors.New("desired exchange is disabled")
+	errNoOrderAction    = errors.New("order action parameters are not set")
+	errNoOrderExecutor  = errors.New("no order executor has been set")
+	errMaxNotional      = errors.New("order notional exceeds the maximum allowed")
+	errNoOrderCooldown  = errors.New("order actions require a cooldown")
+
+	// ErrOrderOutcomeUnknown is returned by an OrderExecutor when an order
+	// submission may have been placed, the event is not retried
+	ErrOrderOutcomeUnknown = errors.New("order submission outcome is unknown")
+)
+
+// Condition is a single check against market or account data
+type Condition struct {
+	Item     string  `json:"item"`
+	Operator string  `json:"operator"`
+	Value    float64 `json:"value"`
+
+	// Window is the lookback period used by percent change conditions
+	Window time.Duration `json:"window,omitempty"`
+	// Side and Price are used by depth conditions. The depth is the total
+	// amount available on that side of the book up to and including Price
+	Side  string  `json:"side,omitempty"`
+	Price float64 `json:"price,omitempty"`
+	// Currency is the balance currency used by balance conditions
+	Currency currency.Code `json:"currency,omitempty"`
+	// Amount is filled against Side by the average price, slippage, market
+	// impact and fill price conditions, in the quote currency when
+	// QuoteAmount is set and in the base currency otherwise
+	Amount      float64 `json:"amount,omitempty"`
+	QuoteAmount bool    `json:"quoteAmount,omitempty"`
+	// Percent is the distance from the mid price within which liquidity
+	// conditions total the amount available on Side
+	Percent float64 `json:"percent,omitempty"`
+}
+
+// Event holds a set of conditions which are evaluated as market data arrives
+// and the action to take once they are met
+type Event struct {
+	ID         int64         `json:"id"`
+	Name       string        `json:"name,omitempty"`
+	Exchange   string        `json:"exchange"`
+	Pair       currency.Pair `json:"pair"`
+	Asset      asset.Item    `json:"asset"`
+	Logic      string        `json:"logic"`
+	Conditions []Condition   `json:"conditions"`
+	Action     string        `json:"action"`
+
+	// Rearm allows the event to trigger again once the cooldown has elapsed.
+	// The cooldown also delays retrying an action which has failed and must
+	// be set for order actions
+	Rearm    bool          `json:"rearm"`
+	Cooldown time.Duration `json:"cooldown"`
+
+	// Order holds the order parameters used by order actions
+	Order *OrderAction `json:"order,omitempty"`
+
+	Executed      bool      `json:"executed"`
+	TriggerCount  int64     `json:"triggerCount"`
+	LastTriggered time.Time `json:"lastTriggered"`
+
+	// executing is set while the events action is running
+	executing bool
+}
+
+// OrderAction holds the parameters for the submit, cancel, cancel all and
+// modify order actions. Amount and Price accept absolute values or
+// expressions such as "last - 0.5%", see ParseExpression
+type OrderAction struct {
+	Side     exchange.OrderSide `json:"side,omitempty"`
+	Type     exchange.OrderType `json:"type,omitempty"`
+	Amount   string             `json:"amount,omitempty"`
+	Price    string             `json:"price,omitempty"`
+	OrderID  string             `json:"orderID,omitempty"`
+	ClientID string             `json:"clientID,omitempty"`
+	// CredentialSet selects the exchange credentials orders are submitted
+	// and cancelled with, empty uses the default credentials
+	CredentialSet string `json:"credentialSet,omitempty"`
+	// MaxNotional rejects the order if amount multiplied by price exceeds it
+	MaxNotional float64 `json:"maxNotional,omitempty"`
+	// DryRun logs the order instead of sending it to the exchange
+	DryRun bool `json:"dryRun,omitempty"`
+}
+
+// OrderExecutor places, cancels and modifies orders on behalf of triggered
+// events
+type OrderExecutor interface {
+	SubmitOrder(exchangeName, credentialSet string, p currency.Pair, assetType asset.Item, side exchange.OrderSide, orderType exchange.OrderType, amount, price float64, clientID string) (string, error)
+	CancelOrder(exchangeName, orderID string) error
+	CancelAllOrders(exchangeName, credentialSet string, p currency.Pair) error
+	ModifyOrder(exchangeName, orderID string, price, amount float64) error
+}
+
+// Notifier pushes triggered event messages to the communication mediums
+type Notifier interface {
+	PushEvent(base.Event)
+}
+
+// Manager stores events, evaluates them as ticker, orderbook and account
+// updates arrive and persists them to disk
+type Manager struct {
+	Notifier Notifier
+	// ExchangeValidator reports whether an exchange is enabled. If nil all
+	// exchanges are considered valid
+	ExchangeValidator func(exchangeName string) bool
+	// Executor carries out order actions
+	Executor OrderExecutor
+	// DryRun logs order actions instead of executing them for all events
+	DryRun bool
+	// MaxNotional is the maximum notional value of any order placed or
+	// modified by an event. Zero disables the guard
+	MaxNotional float64
+
+	events   map[int64]*Event
+	nextID   int64
+	market   map[string]*marketData
+	balances map[string]map[string]float64
+	filePath string
+	m        sync.Mutex
+	fileMtx  sync.Mutex
+	// wg tracks the actions of triggered events which are running
+	wg sync.WaitGroup
+}
+
+// marketData caches the latest ticker and orderbook data for an exchange,
+// pair and asset along with the price history used by percent change
+// conditions
+type marketData struct {
+	ticker    *ticker.Price
+	orderbook *orderbook.Base
+	history   []pricePoint
+}
+
+type pricePoint struct {
+	price float64
+	time  time.Time
+}
@@ -0,0 +1,151 @@
+package events
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Expression references which can be used in order amount and price
+// expressions
+const (
+	RefLast    = "last"
+	RefBid     = "bid"
+	RefAsk     = "ask"
+	RefHigh    = "high"
+	RefLow     = "low"
+	RefMid     = "mid"
+	RefBalance = "balance"
+)
+
+var errInvalidExpression = errors.New("invalid expression")
+
+// Expression is a parsed order amount or price. It is either an absolute
+// value or a reference to market or balance data with an optional adjustment,
+// such as "last - 0.5%" or "balance * 50%"
+type Expression struct {
+	Ref     string
+	Op      string
+	Operand float64
+	Percent bool
+}
+
+// ParseExpression parses an absolute value or an expression in the form
+// "<ref> [<op> <value>[%]]" where op is one of +, -, *, / or the words plus,
+// minus and times
+func ParseExpression(s string) (Expression, error) {
+	s = strings.ToLower(strings.TrimSpace(s))
+	if s == "" {
+		return Expression{}, errInvalidExpression
+	}
+
+	r := strings.NewReplacer(" plus ", "+", " minus ", "-", " times ", "*")
+	s = strings.Replace(r.Replace(" "+s+" "), " ", "", -1)
+
+	if v, err := strconv.ParseFloat(s, 64); err == nil {
+		if math.IsNaN(v) || math.IsInf(v, 0) {
+			return Expression{}, errInvalidExpression
+		}
+		return Expression{Operand: v}, nil
+	}
+
+	i := strings.IndexAny(s, "+-*/")
+	ref := s
+	if i != -1 {
+		ref = s[:i]
+	}
+
+	if !IsValidRef(ref) {
+		return Expression{}, fmt.Errorf("%s: unknown reference %q",
+			errInvalidExpression, ref)
+	}
+
+	e := Expression{Ref: ref}
+	if i == -1 {
+		return e, nil
+	}
+
+	e.Op = s[i : i+1]
+	operand := s[i+1:]
+	if strings.HasSuffix(operand, "%") {
+		e.Percent = true
+		operand = strings.TrimSuffix(operand, "%")
+	}
+
+	v, err := strconv.ParseFloat(operand, 64)
+	if err != nil || math.IsNaN(v) || math.IsInf(v, 0) {
+		return Expression{}, fmt.Errorf("%s: invalid operand %q",
+			errInvalidExpression, operand)
+	}
+
+	if e.Op == "/" && v == 0 {
+		return Expression{}, fmt.Errorf("%s: division by zero",
+			errInvalidExpression)
+	}
+
+	e.Operand = v
+	return e, nil
+}
+
+// IsValidRef returns whether or not an expression reference is supported
+func IsValidRef(ref string) bool {
+	switch ref {
+	case RefLast, RefBid, RefAsk, RefHigh, RefLow, RefMid, RefBalance:
+		return true
+	}
+	return false
+}
+
+// Evaluate resolves the expression using the supplied reference values
+func (e *Expression) Evaluate(refs map[string]float64) (float64, error) {
+	if e.Ref == "" {
+		return e.Operand, nil
+	}
+
+	base, ok := refs[e.Ref]
+	if !ok || base == 0 {
+		return 0, fmt.Errorf("no %s data available to evaluate expression",
+			e.Ref)
+	}
+
+	operand := e.Operand
+	if e.Percent {
+		switch e.Op {
+		case "+", "-":
+			operand = base * e.Operand / 100
+		default:
+			operand = e.Operand / 100
+		}
+	}
+
+	switch e.Op {
+	case "+":
+		return base + operand, nil
+	case "-":
+		return base - operand, nil
+	case "*":
+		return base * operand, nil
+	case "/":
+		return base / operand, nil
+	}
+	return base, nil
+}
+
+// String returns the expression in its canonical form
+func (e *Expression) String() string {
+	if e.Ref == "" {
+		return strconv.FormatFloat(e.Operand, 'f', -1, 64)
+	}
+
+	if e.Op == "" {
+		return e.Ref
+	}
+
+	s := e.Ref + " " + e.Op + " " + strconv.FormatFloat(e.Operand, 'f', -1, 64)
+	if e.Percent {
+		s += "%"
+	}
+	return s
+}
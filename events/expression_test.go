@@ -0,0 +1,75 @@
+package events
+
+import "testing"
+
+func TestParseExpression(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+		err  bool
+	}{
+		{in: "0.5", want: "0.5"},
+		{in: "last", want: "last"},
+		{in: "Last minus 0.5%", want: "last - 0.5%"},
+		{in: "bid plus 10", want: "bid + 10"},
+		{in: "balance * 50%", want: "balance * 50%"},
+		{in: "mid/2", want: "mid / 2"},
+		{in: "", err: true},
+		{in: "close - 1", err: true},
+		{in: "last - abc", err: true},
+		{in: "last / 0", err: true},
+		{in: "NaN", err: true},
+	}
+
+	for x := range tests {
+		e, err := ParseExpression(tests[x].in)
+		if tests[x].err {
+			if err == nil {
+				t.Errorf("Test failed. Expected %q to fail", tests[x].in)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("Test failed. %q: %s", tests[x].in, err)
+			continue
+		}
+
+		if e.String() != tests[x].want {
+			t.Errorf("Test failed. Expected %q, got %q", tests[x].want,
+				e.String())
+		}
+	}
+}
+
+func TestEvaluateExpression(t *testing.T) {
+	refs := map[string]float64{RefLast: 200, RefBalance: 4}
+	tests := []struct {
+		in   string
+		want float64
+	}{
+		{in: "1.5", want: 1.5},
+		{in: "last", want: 200},
+		{in: "last minus 0.5%", want: 199},
+		{in: "last + 10", want: 210},
+		{in: "balance * 50%", want: 2},
+		{in: "balance / 4", want: 1},
+	}
+
+	for x := range tests {
+		v, err := evaluateExpression(tests[x].in, refs)
+		if err != nil {
+			t.Errorf("Test failed. %q: %s", tests[x].in, err)
+			continue
+		}
+
+		if v != tests[x].want {
+			t.Errorf("Test failed. %q expected %v, got %v", tests[x].in,
+				tests[x].want, v)
+		}
+	}
+
+	if _, err := evaluateExpression("ask - 1", refs); err == nil {
+		t.Error("Test failed. Expected missing reference error")
+	}
+}
@@ -11,8 +11,8 @@ import (
 	"github.com/gorilla/websocket"
 	"github.com/thrasher-corp/gocryptotrader/common"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
-	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
 )
 
 const (
@@ -40,6 +40,8 @@ const (
 	// alphapoint rate times
 	alphapointAuthRate   = 500
 	alphapointUnauthRate = 500
+
+	alphapointRecentTradesCount = 100
 )
 
 // Alphapoint is the overarching type across the alphapoint package
@@ -52,12 +54,27 @@ type Alphapoint struct {
 func (a *Alphapoint) SetDefaults() {
 	a.APIUrl = alphapointDefaultAPIURL
 	a.WebsocketURL = alphapointDefaultWebsocketURL
-	a.AssetTypes = []string{ticker.Spot}
-	a.SupportsAutoPairUpdating = false
-	a.SupportsRESTTickerBatching = false
+	a.AssetTypes = asset.Items{asset.Spot}
+	a.Features = exchange.Features{
+		REST: exchange.ProtocolFeatures{
+			TickerFetching:    true,
+			OrderbookFetching: true,
+			TradeFetching:     true,
+			HistoricTrades:    true,
+			KlineFetching:     true,
+			AccountInfo:       true,
+			GetOrder:          true,
+			ActiveOrders:      true,
+			OrderHistory:      true,
+			SubmitOrder:       true,
+			CancelOrder:       true,
+			CancelOrders:      true,
+			DepositAddress:    true,
+		},
+		OrderTypes: []exchange.OrderType{exchange.LimitOrderType, exchange.MarketOrderType},
+	}
 	a.APIWithdrawPermissions = exchange.WithdrawCryptoWith2FA |
-		exchange.AutoWithdrawCryptoWithAPIPermission |
-		exchange.NoFiatWithdrawals
+		exchange.AutoWithdrawCryptoWithAPIPermission
 	a.Requester = request.New(a.Name,
 		request.NewRateLimit(time.Minute*10, alphapointAuthRate),
 		request.NewRateLimit(time.Minute*10, alphapointUnauthRate),
@@ -9,6 +9,8 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/websocket/wshandler"
@@ -42,7 +44,7 @@ func (a *Alphapoint) GetAccountInfo() (exchange.AccountInfo, error) {
 }
 
 // UpdateTicker updates and returns the ticker for a currency pair
-func (a *Alphapoint) UpdateTicker(p currency.Pair, assetType string) (ticker.Price, error) {
+func (a *Alphapoint) UpdateTicker(p currency.Pair, assetType asset.Item) (ticker.Price, error) {
 	var tickerPrice ticker.Price
 	tick, err := a.GetTicker(p.String())
 	if err != nil {
@@ -66,7 +68,7 @@ func (a *Alphapoint) UpdateTicker(p currency.Pair, assetType string) (ticker.Pri
 }
 
 // GetTickerPrice returns the ticker for a currency pair
-func (a *Alphapoint) GetTickerPrice(p currency.Pair, assetType string) (ticker.Price, error) {
+func (a *Alphapoint) GetTickerPrice(p currency.Pair, assetType asset.Item) (ticker.Price, error) {
 	tick, err := ticker.GetTicker(a.GetName(), p, assetType)
 	if err != nil {
 		return a.UpdateTicker(p, assetType)
@@ -75,7 +77,7 @@ func (a *Alphapoint) GetTickerPrice(p currency.Pair, assetType string) (ticker.P
 }
 
 // UpdateOrderbook updates and returns the orderbook for a currency pair
-func (a *Alphapoint) UpdateOrderbook(p currency.Pair, assetType string) (orderbook.Base, error) {
+func (a *Alphapoint) UpdateOrderbook(p currency.Pair, assetType asset.Item) (orderbook.Base, error) {
 	var orderBook orderbook.Base
 	orderbookNew, err := a.GetOrderbook(p.String())
 	if err != nil {
@@ -107,7 +109,7 @@ func (a *Alphapoint) UpdateOrderbook(p currency.Pair, assetType string) (orderbo
 }
 
 // GetOrderbookEx returns the orderbook for a currency pair
-func (a *Alphapoint) GetOrderbookEx(p currency.Pair, assetType string) (orderbook.Base, error) {
+func (a *Alphapoint) GetOrderbookEx(p currency.Pair, assetType asset.Item) (orderbook.Base, error) {
 	ob, err := orderbook.Get(a.GetName(), p, assetType)
 	if err != nil {
 		return a.UpdateOrderbook(p, assetType)
@@ -123,16 +125,65 @@ func (a *Alphapoint) GetFundingHistory() ([]exchange.FundHistory, error) {
 	return fundHistory, common.ErrNotYetImplemented
 }
 
-// GetExchangeHistory returns historic trade data since exchange opening.
-func (a *Alphapoint) GetExchangeHistory(p currency.Pair, assetType string) ([]exchange.TradeHistory, error) {
-	var resp []exchange.TradeHistory
+// GetExchangeHistory returns the most recent trades for a currency pair
+func (a *Alphapoint) GetExchangeHistory(p currency.Pair, assetType asset.Item) ([]exchange.TradeHistory, error) {
+	trades, err := a.GetTrades(p.String(), 0, alphapointRecentTradesCount)
+	if err != nil {
+		return nil, err
+	}
+	return a.convertTrades(trades.Trades), nil
+}
+
+// GetHistoricTrades returns the trades for a currency pair between the start
+// and end times
+func (a *Alphapoint) GetHistoricTrades(p currency.Pair, assetType asset.Item, timestampStart, timestampEnd time.Time) ([]exchange.TradeHistory, error) {
+	err := exchange.CheckTimeRange(timestampStart, timestampEnd)
+	if err != nil {
+		return nil, err
+	}
+
+	trades, err := a.GetTradesByDate(p.String(), timestampStart.Unix(),
+		timestampEnd.Unix())
+	if err != nil {
+		return nil, err
+	}
+
+	resp := a.convertTrades(trades.Trades)
+	exchange.FilterTradesByTimeRange(&resp, timestampStart, timestampEnd)
+	return resp, nil
+}
+
+// GetHistoricCandles returns candles between the start and end times, built
+// from historic trades
+func (a *Alphapoint) GetHistoricCandles(p currency.Pair, assetType asset.Item, timestampStart, timestampEnd time.Time, interval kline.Interval) (kline.Item, error) {
+	return exchange.CreateKlineFromTrades(a, p, assetType, timestampStart,
+		timestampEnd, interval)
+}
+
+func (a *Alphapoint) convertTrades(trades []Trade) []exchange.TradeHistory {
+	resp := make([]exchange.TradeHistory, len(trades))
+	for i := range trades {
+		side := exchange.BuyOrderSide
+		if trades[i].IncomingOrderSide == 1 {
+			side = exchange.SellOrderSide
+		}
 
-	return resp, common.ErrNotYetImplemented
+		resp[i] = exchange.TradeHistory{
+			Timestamp: time.Unix(int64(trades[i].Unixtime), 0),
+			TID:       trades[i].TID,
+			ID:        strconv.FormatInt(trades[i].TID, 10),
+			Price:     trades[i].Price,
+			Amount:    trades[i].Quantity,
+			Exchange:  a.Name,
+			Side:      side,
+		}
+	}
+	return resp
 }
 
 // SubmitOrder submits a new order and returns a true value when
 // successfully submitted
-func (a *Alphapoint) SubmitOrder(p currency.Pair, side exchange.OrderSide, orderType exchange.OrderType, amount, price float64, _ string) (exchange.SubmitOrderResponse, error) {
+func (a *Alphapoint) SubmitOrder(p currency.Pair, _ asset.Item, side exchange.OrderSide, orderType exchange.OrderType, amount, price float64, _ string) (exchange.SubmitOrderResponse, error) {
 	var submitOrderResponse exchange.SubmitOrderResponse
 
 	response, err := a.CreateOrder(p.String(),
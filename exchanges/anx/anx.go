@@ -12,8 +12,8 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
-	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/websocket/wshandler"
 	log "github.com/thrasher-corp/gocryptotrader/logger"
 )
@@ -64,9 +64,23 @@ func (a *ANX) SetDefaults() {
 		exchange.AutoWithdrawCryptoWithSetup |
 		exchange.WithdrawCryptoWith2FA |
 		exchange.WithdrawFiatViaWebsiteOnly
-	a.AssetTypes = []string{ticker.Spot}
-	a.SupportsAutoPairUpdating = true
-	a.SupportsRESTTickerBatching = false
+	a.AssetTypes = asset.Items{asset.Spot}
+	a.Features = exchange.Features{
+		REST: exchange.ProtocolFeatures{
+			TickerFetching:    true,
+			OrderbookFetching: true,
+			AutoPairUpdates:   true,
+			AccountInfo:       true,
+			ActiveOrders:      true,
+			OrderHistory:      true,
+			SubmitOrder:       true,
+			CancelOrder:       true,
+			CancelOrders:      true,
+			DepositAddress:    true,
+			CryptoWithdrawal:  true,
+		},
+		OrderTypes: []exchange.OrderType{exchange.LimitOrderType},
+	}
 	a.Requester = request.New(a.Name,
 		request.NewRateLimit(time.Second, anxAuthRate),
 		request.NewRateLimit(time.Second, anxUnauthRate),
@@ -86,6 +100,7 @@ func (a *ANX) Setup(exch *config.ExchangeConfig) {
 		a.SetAPIKeys(exch.APIKey, exch.APISecret, "", true)
 		a.SetHTTPClientTimeout(exch.HTTPTimeout)
 		a.SetHTTPClientUserAgent(exch.HTTPUserAgent)
+		a.SetHTTPRetryPolicy(exch.HTTPRetry)
 		a.RESTPollingDelay = exch.RESTPollingDelay
 		a.Verbose = exch.Verbose
 		a.HTTPDebugging = exch.HTTPDebugging
@@ -6,6 +6,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 )
 
 // Please supply your own keys here for due diligence testing
@@ -233,7 +234,7 @@ func TestSubmitOrder(t *testing.T) {
 		Quote:     currency.USD,
 	}
 	// TODO: QA Pass to submit order
-	response, err := a.SubmitOrder(p, exchange.BuyOrderSide, exchange.MarketOrderType, 1, 1, "")
+	response, err := a.SubmitOrder(p, asset.Spot, exchange.BuyOrderSide, exchange.MarketOrderType, 1, 1, "")
 	if areTestAPIKeysSet() && (err != nil || !response.IsOrderPlaced) && !mockTests {
 		t.Errorf("Order failed to be placed: %v", err)
 	} else if !areTestAPIKeysSet() && err == nil {
@@ -379,7 +380,7 @@ func TestUpdateOrderbook(t *testing.T) {
 		Base:      currency.BTC,
 		Quote:     currency.USD}
 
-	_, err := a.UpdateOrderbook(q, "spot")
+	_, err := a.UpdateOrderbook(q, asset.Spot)
 	if err != nil {
 		t.Fatalf("Update for orderbook failed: %v", err)
 	}
@@ -1,6 +1,7 @@
 package anx
 
 import (
+	"context"
 	"fmt"
 	"strconv"
 	"strings"
@@ -10,6 +11,8 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/websocket/wshandler"
@@ -25,6 +28,14 @@ func (a *ANX) Start(wg *sync.WaitGroup) {
 	}()
 }
 
+// WithContext returns a copy of ANX whose HTTP requests are abandoned once
+// the context is done
+func (a *ANX) WithContext(ctx context.Context) exchange.IBotExchange {
+	cp := *a
+	cp.Base = a.ContextBase(ctx)
+	return &cp
+}
+
 // Run implements the ANX wrapper
 func (a *ANX) Run() {
 	if a.Verbose {
@@ -88,9 +99,9 @@ func (a *ANX) GetTradablePairs() ([]string, error) {
 }
 
 // UpdateTicker updates and returns the ticker for a currency pair
-func (a *ANX) UpdateTicker(p currency.Pair, assetType string) (ticker.Price, error) {
+func (a *ANX) UpdateTicker(p currency.Pair, assetType asset.Item) (ticker.Price, error) {
 	var tickerPrice ticker.Price
-	tick, err := a.GetTicker(exchange.FormatExchangeCurrency(a.GetName(), p).String())
+	tick, err := a.GetTicker(a.FormatExchangeCurrency(p).String())
 	if err != nil {
 		return tickerPrice, err
 	}
@@ -160,7 +171,7 @@ func (a *ANX) UpdateTicker(p currency.Pair, assetType string) (ticker.Price, err
 }
 
 // GetTickerPrice returns the ticker for a currency pair
-func (a *ANX) GetTickerPrice(p currency.Pair, assetType string) (ticker.Price, error) {
+func (a *ANX) GetTickerPrice(p currency.Pair, assetType asset.Item) (ticker.Price, error) {
 	tickerNew, err := ticker.GetTicker(a.GetName(), p, assetType)
 	if err != nil {
 		return a.UpdateTicker(p, assetType)
@@ -169,7 +180,7 @@ func (a *ANX) GetTickerPrice(p currency.Pair, assetType string) (ticker.Price, e
 }
 
 // GetOrderbookEx returns the orderbook for a currency pair
-func (a *ANX) GetOrderbookEx(p currency.Pair, assetType string) (orderbook.Base, error) {
+func (a *ANX) GetOrderbookEx(p currency.Pair, assetType asset.Item) (orderbook.Base, error) {
 	ob, err := orderbook.Get(a.GetName(), p, assetType)
 	if err != nil {
 		return a.UpdateOrderbook(p, assetType)
@@ -178,9 +189,9 @@ func (a *ANX) GetOrderbookEx(p currency.Pair, assetType string) (orderbook.Base,
 }
 
 // UpdateOrderbook updates and returns the orderbook for a currency pair
-func (a *ANX) UpdateOrderbook(p currency.Pair, assetType string) (orderbook.Base, error) {
+func (a *ANX) UpdateOrderbook(p currency.Pair, assetType asset.Item) (orderbook.Base, error) {
 	var orderBook orderbook.Base
-	orderbookNew, err := a.GetDepth(exchange.FormatExchangeCurrency(a.GetName(), p).String())
+	orderbookNew, err := a.GetDepth(a.FormatExchangeCurrency(p).String())
 	if err != nil {
 		return orderBook, err
 	}
@@ -245,14 +256,24 @@ func (a *ANX) GetFundingHistory() ([]exchange.FundHistory, error) {
 }
 
 // GetExchangeHistory returns historic trade data since exchange opening.
-func (a *ANX) GetExchangeHistory(p currency.Pair, assetType string) ([]exchange.TradeHistory, error) {
+func (a *ANX) GetExchangeHistory(p currency.Pair, assetType asset.Item) ([]exchange.TradeHistory, error) {
 	var resp []exchange.TradeHistory
 
 	return resp, common.ErrNotYetImplemented
 }
 
+// GetHistoricTrades returns historic trade data within the timeframe provided.
+func (a *ANX) GetHistoricTrades(p currency.Pair, assetType asset.Item, timestampStart, timestampEnd time.Time) ([]exchange.TradeHistory, error) {
+	return nil, common.ErrFunctionNotSupported
+}
+
+// GetHistoricCandles returns candles between the start and end times
+func (a *ANX) GetHistoricCandles(p currency.Pair, assetType asset.Item, timestampStart, timestampEnd time.Time, interval kline.Interval) (kline.Item, error) {
+	return kline.Item{}, common.ErrFunctionNotSupported
+}
+
 // SubmitOrder submits a new order
-func (a *ANX) SubmitOrder(p currency.Pair, side exchange.OrderSide, orderType exchange.OrderType, amount, price float64, _ string) (exchange.SubmitOrderResponse, error) {
+func (a *ANX) SubmitOrder(p currency.Pair, _ asset.Item, side exchange.OrderSide, orderType exchange.OrderType, amount, price float64, _ string) (exchange.SubmitOrderResponse, error) {
 	var submitOrderResponse exchange.SubmitOrderResponse
 
 	var isBuying bool
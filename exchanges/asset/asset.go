@@ -0,0 +1,124 @@
+package asset
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+)
+
+// Supported asset types
+const (
+	Spot          = Item("SPOT")
+	Margin        = Item("MARGIN")
+	Futures       = Item("FUTURES")
+	PerpetualSwap = Item("PERPETUALSWAP")
+	Index         = Item("INDEX")
+)
+
+// Error declarations
+var (
+	ErrNotSupported = errors.New("unsupported asset type")
+)
+
+// supported lists every asset type in the order they are displayed
+var supported = Items{
+	Spot,
+	Margin,
+	Futures,
+	PerpetualSwap,
+	Index,
+}
+
+// Item is a market an exchange trades on e.g. spot or perpetual swaps. The
+// pairs, tickers, orderbooks and orders of each asset type are kept apart
+type Item string
+
+// Items is a list of asset types
+type Items []Item
+
+// Supported returns a copy of the list of supported asset types
+func Supported() Items {
+	s := make(Items, len(supported))
+	copy(s, supported)
+	return s
+}
+
+// IsValid returns whether the asset type is supported
+func IsValid(a Item) bool {
+	return supported.Contains(a)
+}
+
+// New returns the asset type from its case insensitive name
+func New(name string) (Item, error) {
+	a := Item(strings.ToUpper(strings.TrimSpace(name)))
+	if !IsValid(a) {
+		return "", ErrNotSupported
+	}
+	return a, nil
+}
+
+// NewItems returns the asset types from a comma separated list of names
+func NewItems(names string) (Items, error) {
+	var items Items
+	for _, name := range strings.Split(names, ",") {
+		if strings.TrimSpace(name) == "" {
+			continue
+		}
+		a, err := New(name)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, a)
+	}
+	return items, nil
+}
+
+// String returns the asset type name
+func (a Item) String() string {
+	return string(a)
+}
+
+// Strings returns the asset type names
+func (a Items) Strings() []string {
+	s := make([]string, len(a))
+	for x := range a {
+		s[x] = string(a[x])
+	}
+	return s
+}
+
+// Contains returns whether the asset type is in the list
+func (a Items) Contains(i Item) bool {
+	for x := range a {
+		if a[x] == i {
+			return true
+		}
+	}
+	return false
+}
+
+// JoinToString joins the asset type names with the separator
+func (a Items) JoinToString(separator string) string {
+	return strings.Join(a.Strings(), separator)
+}
+
+// UnmarshalJSON decodes a list of asset types. Config files written before
+// asset types were typed store them as a comma separated string, which is
+// still accepted
+func (a *Items) UnmarshalJSON(data []byte) error {
+	var names []string
+	if err := json.Unmarshal(data, &names); err != nil {
+		var joined string
+		if json.Unmarshal(data, &joined) != nil {
+			return err
+		}
+		names = strings.Split(joined, ",")
+	}
+
+	items, err := NewItems(strings.Join(names, ","))
+	if err != nil {
+		return err
+	}
+	*a = items
+	return nil
+}
@@ -0,0 +1,75 @@
+package asset
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestNew(t *testing.T) {
+	a, err := New(" perpetualswap ")
+	if err != nil || a != PerpetualSwap {
+		t.Errorf("Test failed. Expected %s got %s %v", PerpetualSwap, a, err)
+	}
+
+	_, err = New("binary")
+	if err != ErrNotSupported {
+		t.Errorf("Test failed. Expected %s got %v", ErrNotSupported, err)
+	}
+}
+
+func TestNewItems(t *testing.T) {
+	items, err := NewItems("spot,FUTURES,")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(items) != 2 || items[0] != Spot || items[1] != Futures {
+		t.Errorf("Test failed. Unexpected asset types %v", items)
+	}
+
+	_, err = NewItems("spot,swap")
+	if err != ErrNotSupported {
+		t.Errorf("Test failed. Expected %s got %v", ErrNotSupported, err)
+	}
+}
+
+func TestItems(t *testing.T) {
+	items := Items{Spot, Index}
+	if !items.Contains(Index) || items.Contains(Margin) {
+		t.Error("Test failed. Unexpected Contains result")
+	}
+
+	if s := items.JoinToString(","); s != "SPOT,INDEX" {
+		t.Errorf("Test failed. Expected SPOT,INDEX got %s", s)
+	}
+
+	s := Supported()
+	s[0] = Index
+	if !IsValid(Spot) {
+		t.Error("Test failed. Supported returned the underlying list")
+	}
+}
+
+func TestUnmarshalJSON(t *testing.T) {
+	var items Items
+	err := json.Unmarshal([]byte(`["spot","margin"]`), &items)
+	if err != nil || len(items) != 2 || items[1] != Margin {
+		t.Errorf("Test failed. Unexpected asset types %v %v", items, err)
+	}
+
+	// Legacy comma separated config values
+	err = json.Unmarshal([]byte(`"SPOT,FUTURES"`), &items)
+	if err != nil || len(items) != 2 || items[1] != Futures {
+		t.Errorf("Test failed. Unexpected asset types %v %v", items, err)
+	}
+
+	err = json.Unmarshal([]byte(`["SPOT","BINARY"]`), &items)
+	if err != ErrNotSupported {
+		t.Errorf("Test failed. Expected %s got %v", ErrNotSupported, err)
+	}
+
+	data, err := json.Marshal(Items{Spot})
+	if err != nil || string(data) != `["SPOT"]` {
+		t.Errorf("Test failed. Unexpected encoding %s %v", data, err)
+	}
+}
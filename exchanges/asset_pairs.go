@@ -0,0 +1,218 @@
+package exchange
+
+import (
+	"fmt"
+
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	log "github.com/thrasher-corp/gocryptotrader/logger"
+)
+
+// AssetPairs holds the pairs and pair formats of an asset type other than the
+// primary asset type, whose pairs and formats are held directly by Base
+type AssetPairs struct {
+	Available     currency.Pairs
+	Enabled       currency.Pairs
+	RequestFormat config.CurrencyPairFormatConfig
+	ConfigFormat  config.CurrencyPairFormatConfig
+}
+
+// GetPrimaryAssetType returns the first asset type of the exchange, its pairs
+// are the exchanges AvailablePairs and EnabledPairs
+func (e *Base) GetPrimaryAssetType() asset.Item {
+	if len(e.AssetTypes) == 0 {
+		return asset.Spot
+	}
+	return e.AssetTypes[0]
+}
+
+// SupportsAsset returns whether the asset type is enabled for the exchange
+func (e *Base) SupportsAsset(assetType asset.Item) bool {
+	return e.AssetTypes.Contains(assetType)
+}
+
+// GetEnabledPairs returns the enabled currency pairs of the asset type
+func (e *Base) GetEnabledPairs(assetType asset.Item) currency.Pairs {
+	if assetType == e.GetPrimaryAssetType() {
+		return e.GetEnabledCurrencies()
+	}
+
+	p, ok := e.AssetPairs[assetType]
+	if !ok || !e.SupportsAsset(assetType) {
+		return nil
+	}
+	return p.Enabled.Format(p.ConfigFormat.Delimiter,
+		p.ConfigFormat.Index,
+		p.ConfigFormat.Uppercase)
+}
+
+// GetAvailablePairs returns the available currency pairs of the asset type
+func (e *Base) GetAvailablePairs(assetType asset.Item) currency.Pairs {
+	if assetType == e.GetPrimaryAssetType() {
+		return e.GetAvailableCurrencies()
+	}
+
+	p, ok := e.AssetPairs[assetType]
+	if !ok || !e.SupportsAsset(assetType) {
+		return nil
+	}
+	return p.Available.Format(p.ConfigFormat.Delimiter,
+		p.ConfigFormat.Index,
+		p.ConfigFormat.Uppercase)
+}
+
+// GetPairFormat returns the request or config currency pair format of the
+// asset type
+func (e *Base) GetPairFormat(assetType asset.Item, requestFormat bool) (config.CurrencyPairFormatConfig, error) {
+	if assetType != e.GetPrimaryAssetType() && !e.SupportsAsset(assetType) {
+		return config.CurrencyPairFormatConfig{}, asset.ErrNotSupported
+	}
+
+	if p, ok := e.AssetPairs[assetType]; ok && assetType != e.GetPrimaryAssetType() {
+		if requestFormat {
+			return p.RequestFormat, nil
+		}
+		return p.ConfigFormat, nil
+	}
+
+	if requestFormat {
+		return e.RequestCurrencyPairFormat, nil
+	}
+	return e.ConfigCurrencyPairFormat, nil
+}
+
+// FormatExchangePair formats the currency pair for requests of the asset
+// type
+func (e *Base) FormatExchangePair(p currency.Pair, assetType asset.Item) (currency.Pair, error) {
+	f, err := e.GetPairFormat(assetType, true)
+	if err != nil {
+		return currency.Pair{}, err
+	}
+	return p.Format(f.Delimiter, f.Uppercase), nil
+}
+
+// UpdateAssetPairs updates the enabled or available currency pairs of the
+// asset type
+func (e *Base) UpdateAssetPairs(assetType asset.Item, pairs currency.Pairs, enabled, force bool) error {
+	if assetType == e.GetPrimaryAssetType() {
+		return e.UpdateCurrencies(pairs, enabled, force)
+	}
+
+	if !e.SupportsAsset(assetType) {
+		return asset.ErrNotSupported
+	}
+
+	if len(pairs) == 0 {
+		return fmt.Errorf("%s UpdateAssetPairs error - %s pairs is empty",
+			e.Name, assetType)
+	}
+
+	p := e.assetPairs(assetType)
+	var products currency.Pairs
+	for x := range pairs {
+		if pairs[x].String() == "" {
+			continue
+		}
+		products = append(products, pairs[x].Format(p.ConfigFormat.Delimiter,
+			p.ConfigFormat.Uppercase))
+	}
+
+	current := p.Available
+	if enabled {
+		current = p.Enabled
+	}
+
+	newPairs, removedPairs := current.FindDifferences(products)
+	if !force && len(newPairs) == 0 && len(removedPairs) == 0 {
+		return nil
+	}
+
+	if len(newPairs) > 0 || len(removedPairs) > 0 {
+		log.Debugf("%s Updating %s pairs - New: %s Removed: %s.\n",
+			e.Name, assetType, newPairs, removedPairs)
+	}
+
+	if enabled {
+		p.Enabled = products
+	} else {
+		p.Available = products
+	}
+
+	cfg := e.GetConfig()
+	exch, err := cfg.GetExchangeConfig(e.Name)
+	if err != nil {
+		return err
+	}
+
+	if exch.AssetPairs == nil {
+		exch.AssetPairs = make(map[asset.Item]*config.AssetPairsConfig)
+	}
+	exch.AssetPairs[assetType] = p.toConfig()
+	return cfg.UpdateExchangeConfig(&exch)
+}
+
+// assetPairs returns the pairs of an asset type, asset types without default
+// pairs use the primary asset types pair formats
+func (e *Base) assetPairs(assetType asset.Item) *AssetPairs {
+	if e.AssetPairs == nil {
+		e.AssetPairs = make(map[asset.Item]*AssetPairs)
+	}
+
+	p, ok := e.AssetPairs[assetType]
+	if !ok {
+		p = &AssetPairs{
+			RequestFormat: e.RequestCurrencyPairFormat,
+			ConfigFormat:  e.ConfigCurrencyPairFormat,
+		}
+		e.AssetPairs[assetType] = p
+	}
+	return p
+}
+
+// setAssetPairs loads the pairs of each non primary asset type from the
+// exchange config, storing the exchange defaults in the config where they
+// are missing. Pair formats which differ from the defaults are reset
+func (e *Base) setAssetPairs(exch *config.ExchangeConfig) {
+	for _, a := range e.AssetTypes {
+		if a == e.GetPrimaryAssetType() {
+			continue
+		}
+
+		p := e.assetPairs(a)
+		if exch.AssetPairs == nil {
+			exch.AssetPairs = make(map[asset.Item]*config.AssetPairsConfig)
+		}
+
+		c, ok := exch.AssetPairs[a]
+		if !ok || c == nil {
+			exch.AssetPairs[a] = p.toConfig()
+			continue
+		}
+
+		p.Available = c.AvailablePairs
+		p.Enabled = c.EnabledPairs
+		if c.RequestCurrencyPairFormat == nil ||
+			!CompareCurrencyPairFormats(p.RequestFormat, c.RequestCurrencyPairFormat) {
+			c.RequestCurrencyPairFormat = &config.CurrencyPairFormatConfig{}
+			*c.RequestCurrencyPairFormat = p.RequestFormat
+		}
+		if c.ConfigCurrencyPairFormat == nil ||
+			!CompareCurrencyPairFormats(p.ConfigFormat, c.ConfigCurrencyPairFormat) {
+			c.ConfigCurrencyPairFormat = &config.CurrencyPairFormatConfig{}
+			*c.ConfigCurrencyPairFormat = p.ConfigFormat
+		}
+	}
+}
+
+// toConfig returns the config representation of the asset pairs
+func (p *AssetPairs) toConfig() *config.AssetPairsConfig {
+	requestFormat := p.RequestFormat
+	configFormat := p.ConfigFormat
+	return &config.AssetPairsConfig{
+		AvailablePairs:            p.Available,
+		EnabledPairs:              p.Enabled,
+		RequestCurrencyPairFormat: &requestFormat,
+		ConfigCurrencyPairFormat:  &configFormat,
+	}
+}
@@ -0,0 +1,108 @@
+package exchange
+
+import (
+	"testing"
+
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+)
+
+func TestGetPrimaryAssetType(t *testing.T) {
+	var b Base
+	if a := b.GetPrimaryAssetType(); a != asset.Spot {
+		t.Errorf("Test failed. Expected %s got %s", asset.Spot, a)
+	}
+
+	b.AssetTypes = asset.Items{asset.Futures, asset.Spot}
+	if a := b.GetPrimaryAssetType(); a != asset.Futures {
+		t.Errorf("Test failed. Expected %s got %s", asset.Futures, a)
+	}
+}
+
+func TestGetPairFormat(t *testing.T) {
+	b := Base{
+		AssetTypes:                asset.Items{asset.Spot, asset.Futures},
+		RequestCurrencyPairFormat: config.CurrencyPairFormatConfig{Delimiter: "_"},
+		ConfigCurrencyPairFormat:  config.CurrencyPairFormatConfig{Delimiter: "-", Uppercase: true},
+	}
+	b.assetPairs(asset.Futures).RequestFormat.Delimiter = "/"
+
+	f, err := b.GetPairFormat(asset.Spot, true)
+	if err != nil || f.Delimiter != "_" {
+		t.Errorf("Test failed. Unexpected spot request format %+v %v", f, err)
+	}
+
+	f, err = b.GetPairFormat(asset.Futures, true)
+	if err != nil || f.Delimiter != "/" {
+		t.Errorf("Test failed. Unexpected futures request format %+v %v", f, err)
+	}
+
+	f, err = b.GetPairFormat(asset.Futures, false)
+	if err != nil || f.Delimiter != "-" || !f.Uppercase {
+		t.Errorf("Test failed. Unexpected futures config format %+v %v", f, err)
+	}
+
+	_, err = b.GetPairFormat(asset.Margin, true)
+	if err != asset.ErrNotSupported {
+		t.Errorf("Test failed. Expected %s got %v", asset.ErrNotSupported, err)
+	}
+
+	p, err := b.FormatExchangePair(currency.NewPairFromStrings("btc", "usd"), asset.Futures)
+	if err != nil || p.String() != "btc/usd" {
+		t.Errorf("Test failed. Expected btc/usd got %s %v", p, err)
+	}
+}
+
+func TestUpdateAssetPairs(t *testing.T) {
+	cfg := config.GetConfig()
+	err := cfg.LoadConfig(config.ConfigTestFile)
+	if err != nil {
+		t.Fatalf("Test failed. TestUpdateAssetPairs failed to load config file. Error: %s", err)
+	}
+
+	b := Base{
+		Name:                     defaultTestExchange,
+		AssetTypes:               asset.Items{asset.Spot, asset.Futures},
+		ConfigCurrencyPairFormat: config.CurrencyPairFormatConfig{Delimiter: "-", Uppercase: true},
+	}
+
+	pairs := currency.NewPairsFromStrings([]string{"btc-usd", "ltc-usd"})
+	err = b.UpdateAssetPairs(asset.Futures, pairs, false, false)
+	if err != nil {
+		t.Fatalf("Test failed. TestUpdateAssetPairs error: %s", err)
+	}
+
+	err = b.UpdateAssetPairs(asset.Futures, pairs[:1], true, false)
+	if err != nil {
+		t.Fatalf("Test failed. TestUpdateAssetPairs error: %s", err)
+	}
+
+	if p := b.GetAvailablePairs(asset.Futures); len(p) != 2 || p[0].String() != "BTC-USD" {
+		t.Errorf("Test failed. Unexpected available pairs %v", p)
+	}
+
+	if p := b.GetEnabledPairs(asset.Futures); len(p) != 1 {
+		t.Errorf("Test failed. Unexpected enabled pairs %v", p)
+	}
+
+	exch, err := cfg.GetExchangeConfig(b.Name)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c, ok := exch.AssetPairs[asset.Futures]
+	if !ok || len(c.AvailablePairs) != 2 || len(c.EnabledPairs) != 1 {
+		t.Error("Test failed. Asset pairs were not stored in the config")
+	}
+
+	err = b.UpdateAssetPairs(asset.Margin, pairs, true, false)
+	if err != asset.ErrNotSupported {
+		t.Errorf("Test failed. Expected %s got %v", asset.ErrNotSupported, err)
+	}
+
+	err = b.UpdateAssetPairs(asset.Futures, nil, true, false)
+	if err == nil {
+		t.Error("Test failed. Expected an error updating empty pairs")
+	}
+}
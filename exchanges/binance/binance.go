@@ -14,8 +14,8 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
-	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/websocket/wshandler"
 	log "github.com/thrasher-corp/gocryptotrader/logger"
 )
@@ -65,10 +65,17 @@ const (
 	tradeFee          = "/wapi/v3/tradeFee.html"
 	assetDetail       = "/wapi/v3/assetDetail.html"
 
-	// binance authenticated and unauthenticated limit rates
-	// to-do
-	binanceAuthRate   = 0
-	binanceUnauthRate = 0
+	// Binance limits the total weight of requests per minute across
+	// authenticated and unauthenticated requests, and separately the number
+	// of orders per second
+	binanceWeightLimit      = 1200
+	binanceWeightInterval   = time.Minute
+	binanceOrderLimit       = 10
+	binanceOrderInterval    = time.Second
+	binanceUsedWeightHeader = "X-Mbx-Used-Weight"
+
+	// binanceKlineLimit is the maximum number of candles returned per request
+	binanceKlineLimit = 1000
 )
 
 // SetDefaults sets the basic defaults for Binance
@@ -81,24 +88,44 @@ func (b *Binance) SetDefaults() {
 	b.RequestCurrencyPairFormat.Uppercase = true
 	b.ConfigCurrencyPairFormat.Delimiter = "-"
 	b.ConfigCurrencyPairFormat.Uppercase = true
-	b.AssetTypes = []string{ticker.Spot}
-	b.SupportsAutoPairUpdating = true
-	b.SupportsRESTTickerBatching = true
-	b.APIWithdrawPermissions = exchange.AutoWithdrawCrypto |
-		exchange.NoFiatWithdrawals
+	b.AssetTypes = asset.Items{asset.Spot}
+	b.Features = exchange.Features{
+		REST: exchange.ProtocolFeatures{
+			TickerFetching:    true,
+			TickerBatching:    true,
+			OrderbookFetching: true,
+			TradeFetching:     true,
+			HistoricTrades:    true,
+			KlineFetching:     true,
+			AutoPairUpdates:   true,
+			AccountInfo:       true,
+			ActiveOrders:      true,
+			OrderHistory:      true,
+			SubmitOrder:       true,
+			CancelOrder:       true,
+			CancelOrders:      true,
+			DepositAddress:    true,
+			CryptoWithdrawal:  true,
+		},
+		Websocket: exchange.ProtocolFeatures{
+			TickerFetching:    true,
+			OrderbookFetching: true,
+			TradeFetching:     true,
+			KlineFetching:     true,
+		},
+		OrderTypes: []exchange.OrderType{exchange.LimitOrderType, exchange.MarketOrderType},
+	}
 	b.SetValues()
 	b.Requester = request.New(b.Name,
-		request.NewRateLimit(time.Second, binanceAuthRate),
-		request.NewRateLimit(time.Second, binanceUnauthRate),
+		request.NewRateLimit(time.Second, 0),
+		request.NewRateLimit(time.Second, 0),
 		common.NewHTTPClientWithTimeout(exchange.DefaultHTTPTimeout))
+	b.setRateLimits()
 	b.APIUrlDefault = apiURL
 	b.APIUrl = b.APIUrlDefault
 	b.Websocket = wshandler.New()
 	b.WebsocketURL = binanceDefaultWebsocketURL
-	b.Websocket.Functionality = wshandler.WebsocketTradeDataSupported |
-		wshandler.WebsocketTickerSupported |
-		wshandler.WebsocketKlineSupported |
-		wshandler.WebsocketOrderbookSupported
+	b.Websocket.Functionality = b.Features.Websocket.Functionality()
 	b.WebsocketResponseMaxLimit = exchange.DefaultWebsocketResponseMaxLimit
 	b.WebsocketResponseCheckTimeout = exchange.DefaultWebsocketResponseCheckTimeout
 	b.WebsocketOrderbookBufferLimit = exchange.DefaultWebsocketOrderbookBufferLimit
@@ -114,6 +141,7 @@ func (b *Binance) Setup(exch *config.ExchangeConfig) {
 		b.SetAPIKeys(exch.APIKey, exch.APISecret, "", false)
 		b.SetHTTPClientTimeout(exch.HTTPTimeout)
 		b.SetHTTPClientUserAgent(exch.HTTPUserAgent)
+		b.SetHTTPRetryPolicy(exch.HTTPRetry)
 		b.RESTPollingDelay = exch.RESTPollingDelay
 		b.Verbose = exch.Verbose
 		b.HTTPDebugging = exch.HTTPDebugging
@@ -168,6 +196,7 @@ func (b *Binance) Setup(exch *config.ExchangeConfig) {
 			true,
 			false,
 			exch.Name)
+		b.Websocket.Orderbook.SetupVerification(nil, b.wsOrderbookSnapshot)
 	}
 }
 
@@ -285,23 +314,31 @@ func (b *Binance) GetHistoricalTrades(symbol string, limit int, fromID int64) ([
 	return nil, common.ErrFunctionNotSupported
 }
 
-// GetAggregatedTrades returns aggregated trade activity
-//
-// symbol: string of currency pair
-// limit: Optional. Default 500; max 1000.
-func (b *Binance) GetAggregatedTrades(symbol string, limit int) ([]AggregatedTrade, error) {
+// GetAggregatedTrades returns aggregated trade activity. Trades can be
+// requested from an aggregate trade ID or for a time range of up to an hour
+func (b *Binance) GetAggregatedTrades(arg *AggregatedTradeRequestParams) ([]AggregatedTrade, error) {
 	var resp []AggregatedTrade
 
-	if err := b.CheckLimit(limit); err != nil {
+	if err := b.CheckLimit(arg.Limit); err != nil {
 		return resp, err
 	}
-	if err := b.CheckSymbol(symbol); err != nil {
+	if err := b.CheckSymbol(arg.Symbol); err != nil {
 		return resp, err
 	}
 
 	params := url.Values{}
-	params.Set("symbol", common.StringToUpper(symbol))
-	params.Set("limit", strconv.Itoa(limit))
+	params.Set("symbol", common.StringToUpper(arg.Symbol))
+	params.Set("limit", strconv.Itoa(arg.Limit))
+	if arg.FromID > 0 {
+		params.Set("fromId", strconv.FormatInt(arg.FromID, 10))
+	}
+	if !arg.StartTime.IsZero() && !arg.EndTime.IsZero() {
+		if arg.EndTime.Sub(arg.StartTime) > time.Hour {
+			return resp, errors.New("aggregated trades time range must be no more than an hour")
+		}
+		params.Set("startTime", strconv.FormatInt(common.UnixMillis(arg.StartTime), 10))
+		params.Set("endTime", strconv.FormatInt(common.UnixMillis(arg.EndTime), 10))
+	}
 
 	path := fmt.Sprintf("%s%s?%s", b.APIUrl, aggregatedTrades, params.Encode())
 
@@ -470,7 +507,7 @@ func (b *Binance) NewOrder(o *NewOrderRequest) (NewOrderResponse, error) {
 	}
 
 	if o.NewClientOrderID != "" {
-		params.Set("newClientOrderID", o.NewClientOrderID)
+		params.Set("newClientOrderId", o.NewClientOrderID)
 	}
 
 	if o.StopPrice != 0 {
@@ -605,6 +642,67 @@ func (b *Binance) GetAccount() (*Account, error) {
 	return &resp.Account, nil
 }
 
+// setRateLimits sets the shared weight limit, the order limit and the
+// weight of each endpoint
+func (b *Binance) setRateLimits() {
+	weight := request.NewWeightedWindow(binanceWeightInterval,
+		binanceWeightLimit)
+	b.Requester.SetLimiter(true, weight)
+	b.Requester.SetLimiter(false, weight)
+	used := request.UsedHeader(binanceUsedWeightHeader, binanceWeightLimit)
+	b.Requester.SetRemainingHeader(true, used)
+	b.Requester.SetRemainingHeader(false, used)
+
+	for path, w := range map[string]int{
+		historicalTrades: 5,
+		accountInfo:      5,
+		allOrders:        5,
+	} {
+		b.Requester.SetEndpoint("", path, request.Endpoint{Weight: w})
+	}
+
+	b.Requester.SetEndpoint("", orderBookDepth, request.Endpoint{
+		WeightFunc: depthWeight,
+	})
+	// Both endpoints cost 40 when requested for all symbols
+	for _, path := range []string{priceChange, openOrders} {
+		b.Requester.SetEndpoint("", path, request.Endpoint{
+			WeightFunc: symbolWeight(40),
+		})
+	}
+
+	b.Requester.SetEndpoint(http.MethodPost, newOrder, request.Endpoint{
+		Weight:  1,
+		Limiter: request.NewWeightedWindow(binanceOrderInterval, binanceOrderLimit),
+	})
+}
+
+// depthWeight returns the weight of an orderbook request by its limit
+func depthWeight(req *http.Request) int {
+	limit, _ := strconv.Atoi(req.URL.Query().Get("limit"))
+	switch {
+	case limit <= 100:
+		return 1
+	case limit <= 500:
+		return 5
+	case limit <= 1000:
+		return 10
+	default:
+		return 50
+	}
+}
+
+// symbolWeight returns the weight of a request which costs one for a single
+// symbol and all otherwise
+func symbolWeight(all int) func(req *http.Request) int {
+	return func(req *http.Request) int {
+		if req.URL.Query().Get("symbol") == "" {
+			return all
+		}
+		return 1
+	}
+}
+
 // SendHTTPRequest sends an unauthenticated request
 func (b *Binance) SendHTTPRequest(path string, result interface{}) error {
 	return b.SendPayload(http.MethodGet,
@@ -689,7 +787,7 @@ func (b *Binance) CheckLimit(limit int) error {
 func (b *Binance) CheckSymbol(symbol string) error {
 	enPairs := b.GetAvailableCurrencies()
 	for x := range enPairs {
-		if exchange.FormatExchangeCurrency(b.Name, enPairs[x]).String() == symbol {
+		if b.FormatExchangeCurrency(enPairs[x]).String() == symbol {
 			return nil
 		}
 	}
@@ -1,11 +1,13 @@
 package binance
 
 import (
+	"net/http"
 	"testing"
 
 	"github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 )
 
 // Please supply your own keys here for due diligence testing
@@ -34,6 +36,33 @@ func setFeeBuilder() *exchange.FeeBuilder {
 	}
 }
 
+func TestRequestWeights(t *testing.T) {
+	t.Parallel()
+
+	for path, weight := range map[string]int{
+		orderBookDepth + "?limit=5":    1,
+		orderBookDepth + "?limit=1000": 10,
+		orderBookDepth + "?limit=5000": 50,
+	} {
+		req, _ := http.NewRequest(http.MethodGet, apiURL+path, nil)
+		if w := depthWeight(req); w != weight {
+			t.Errorf("Test failed. Expected weight %d for %s, received %d",
+				weight, path, w)
+		}
+	}
+
+	allTickers := symbolWeight(40)
+	req, _ := http.NewRequest(http.MethodGet, apiURL+priceChange, nil)
+	if w := allTickers(req); w != 40 {
+		t.Errorf("Test failed. Expected weight 40 for all symbols, received %d", w)
+	}
+
+	req, _ = http.NewRequest(http.MethodGet, apiURL+priceChange+"?symbol=BTCUSDT", nil)
+	if w := allTickers(req); w != 1 {
+		t.Errorf("Test failed. Expected weight 1 for a symbol, received %d", w)
+	}
+}
+
 func TestGetExchangeValidCurrencyPairs(t *testing.T) {
 	t.Parallel()
 
@@ -84,7 +113,10 @@ func TestGetHistoricalTrades(t *testing.T) {
 func TestGetAggregatedTrades(t *testing.T) {
 	t.Parallel()
 
-	_, err := b.GetAggregatedTrades("BTCUSDT", 5)
+	_, err := b.GetAggregatedTrades(&AggregatedTradeRequestParams{
+		Symbol: "BTCUSDT",
+		Limit:  5,
+	})
 	if err != nil {
 		t.Error("Test Failed - Binance GetAggregatedTrades() error", err)
 	}
@@ -354,8 +386,7 @@ func TestSubmitOrder(t *testing.T) {
 		t.Skip("API keys set, canManipulateRealOrders false, skipping test")
 	}
 
-	_, err := b.SubmitOrder(currency.NewPair(currency.LTC, currency.BTC),
-		exchange.BuyOrderSide,
+	_, err := b.SubmitOrder(currency.NewPair(currency.LTC, currency.BTC), asset.Spot, exchange.BuyOrderSide,
 		exchange.MarketOrderType,
 		1,
 		1,
@@ -2,6 +2,7 @@ package binance
 
 import (
 	"encoding/json"
+	"time"
 
 	"github.com/thrasher-corp/gocryptotrader/currency"
 )
@@ -204,6 +205,18 @@ type HistoricalTrade struct {
 	IsBestMatch  bool    `json:"isBestMatch"`
 }
 
+// AggregatedTradeRequestParams holds request params for aggregated trades
+type AggregatedTradeRequestParams struct {
+	Symbol string // Required field. example LTCBTC, BTCUSDT
+	Limit  int    // Default 500; max 1000.
+	// FromID is the aggregate trade ID to start from, inclusive
+	FromID int64
+	// StartTime and EndTime are inclusive and must be no more than an hour
+	// apart
+	StartTime time.Time
+	EndTime   time.Time
+}
+
 // AggregatedTrade holds aggregated trade information
 type AggregatedTrade struct {
 	ATradeID       int64   `json:"a"`
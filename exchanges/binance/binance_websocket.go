@@ -11,9 +11,8 @@ import (
 	"github.com/gorilla/websocket"
 	"github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/currency"
-	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
-	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/websocket/wshandler"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/websocket/wsorderbook"
 )
@@ -133,7 +132,7 @@ func (b *Binance) WsHandleData() {
 					Price:        price,
 					Amount:       amount,
 					Exchange:     b.GetName(),
-					AssetType:    orderbook.Spot,
+					AssetType:    asset.Spot,
 					Side:         trade.EventType,
 				}
 				continue
@@ -151,7 +150,7 @@ func (b *Binance) WsHandleData() {
 
 				wsTicker.Timestamp = time.Unix(t.EventTime/1000, 0)
 				wsTicker.Pair = currency.NewPairFromString(t.Symbol)
-				wsTicker.AssetType = ticker.Spot
+				wsTicker.AssetType = asset.Spot
 				wsTicker.Exchange = b.GetName()
 				wsTicker.ClosePrice, _ = strconv.ParseFloat(t.CurrDayClose, 64)
 				wsTicker.Quantity, _ = strconv.ParseFloat(t.TotalTradedVolume, 64)
@@ -175,7 +174,7 @@ func (b *Binance) WsHandleData() {
 				var wsKline wshandler.KlineData
 				wsKline.Timestamp = time.Unix(0, kline.EventTime)
 				wsKline.Pair = currency.NewPairFromString(kline.Symbol)
-				wsKline.AssetType = ticker.Spot
+				wsKline.AssetType = asset.Spot
 				wsKline.Exchange = b.GetName()
 				wsKline.StartTime = time.Unix(0, kline.Kline.StartTime)
 				wsKline.CloseTime = time.Unix(0, kline.Kline.CloseTime)
@@ -208,7 +207,7 @@ func (b *Binance) WsHandleData() {
 				currencyPair := currency.NewPairFromString(depth.Pair)
 				b.Websocket.DataHandler <- wshandler.WebsocketOrderbookUpdate{
 					Pair:     currencyPair,
-					Asset:    orderbook.Spot,
+					Asset:    asset.Spot,
 					Exchange: b.GetName(),
 				}
 				continue
@@ -219,15 +218,25 @@ func (b *Binance) WsHandleData() {
 
 // SeedLocalCache seeds depth data
 func (b *Binance) SeedLocalCache(p currency.Pair) error {
+	newOrderBook, err := b.wsOrderbookSnapshot(p, asset.Spot)
+	if err != nil {
+		return err
+	}
+	return b.Websocket.Orderbook.LoadSnapshot(&newOrderBook, false)
+}
+
+// wsOrderbookSnapshot fetches a depth snapshot over REST, its last update ID
+// is the sequence number which websocket depth updates follow
+func (b *Binance) wsOrderbookSnapshot(p currency.Pair, assetType asset.Item) (orderbook.Base, error) {
 	var newOrderBook orderbook.Base
-	formattedPair := exchange.FormatExchangeCurrency(b.Name, p)
+	formattedPair := b.FormatExchangeCurrency(p)
 	orderbookNew, err := b.GetOrderBook(
 		OrderBookDataRequestParams{
 			Symbol: formattedPair.String(),
 			Limit:  1000,
 		})
 	if err != nil {
-		return err
+		return newOrderBook, err
 	}
 
 	for i := range orderbookNew.Bids {
@@ -240,10 +249,11 @@ func (b *Binance) SeedLocalCache(p currency.Pair) error {
 	}
 
 	newOrderBook.LastUpdated = time.Unix(orderbookNew.LastUpdateID, 0)
+	newOrderBook.LastUpdateID = orderbookNew.LastUpdateID
 	newOrderBook.Pair = currency.NewPairFromString(formattedPair.String())
-	newOrderBook.AssetType = ticker.Spot
-
-	return b.Websocket.Orderbook.LoadSnapshot(&newOrderBook, false)
+	newOrderBook.AssetType = assetType
+	newOrderBook.ExchangeName = b.Name
+	return newOrderBook, nil
 }
 
 // UpdateLocalCache updates and returns the most recent iteration of the orderbook
@@ -277,10 +287,12 @@ func (b *Binance) UpdateLocalCache(wsdp *WebsocketDepthStream) error {
 	currencyPair := currency.NewPairFromString(wsdp.Pair)
 
 	return b.Websocket.Orderbook.Update(&wsorderbook.WebsocketOrderbookUpdate{
-		Bids:         updateBid,
-		Asks:         updateAsk,
-		CurrencyPair: currencyPair,
-		UpdateID:     wsdp.LastUpdateID,
-		AssetType:    orderbook.Spot,
+		Bids:          updateBid,
+		Asks:          updateAsk,
+		CurrencyPair:  currencyPair,
+		UpdateID:      wsdp.LastUpdateID,
+		AssetType:     asset.Spot,
+		FirstSequence: wsdp.FirstUpdateID,
+		Sequence:      wsdp.LastUpdateID,
 	})
 }
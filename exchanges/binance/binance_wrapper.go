@@ -1,6 +1,7 @@
 package binance
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"strconv"
@@ -11,6 +12,8 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/websocket/wshandler"
@@ -26,6 +29,14 @@ func (b *Binance) Start(wg *sync.WaitGroup) {
 	}()
 }
 
+// WithContext returns a copy of Binance whose HTTP requests are abandoned once
+// the context is done
+func (b *Binance) WithContext(ctx context.Context) exchange.IBotExchange {
+	cp := *b
+	cp.Base = b.ContextBase(ctx)
+	return &cp
+}
+
 // Run implements the OKEX wrapper
 func (b *Binance) Run() {
 	if b.Verbose {
@@ -79,7 +90,7 @@ func (b *Binance) Run() {
 }
 
 // UpdateTicker updates and returns the ticker for a currency pair
-func (b *Binance) UpdateTicker(p currency.Pair, assetType string) (ticker.Price, error) {
+func (b *Binance) UpdateTicker(p currency.Pair, assetType asset.Item) (ticker.Price, error) {
 	var tickerPrice ticker.Price
 	tick, err := b.GetTickers()
 	if err != nil {
@@ -87,7 +98,7 @@ func (b *Binance) UpdateTicker(p currency.Pair, assetType string) (ticker.Price,
 	}
 
 	for _, x := range b.GetEnabledCurrencies() {
-		curr := exchange.FormatExchangeCurrency(b.Name, x)
+		curr := b.FormatExchangeCurrency(x)
 		for y := range tick {
 			if tick[y].Symbol != curr.String() {
 				continue
@@ -106,7 +117,7 @@ func (b *Binance) UpdateTicker(p currency.Pair, assetType string) (ticker.Price,
 }
 
 // GetTickerPrice returns the ticker for a currency pair
-func (b *Binance) GetTickerPrice(p currency.Pair, assetType string) (ticker.Price, error) {
+func (b *Binance) GetTickerPrice(p currency.Pair, assetType asset.Item) (ticker.Price, error) {
 	tickerNew, err := ticker.GetTicker(b.GetName(), p, assetType)
 	if err != nil {
 		return b.UpdateTicker(p, assetType)
@@ -115,7 +126,7 @@ func (b *Binance) GetTickerPrice(p currency.Pair, assetType string) (ticker.Pric
 }
 
 // GetOrderbookEx returns orderbook base on the currency pair
-func (b *Binance) GetOrderbookEx(currency currency.Pair, assetType string) (orderbook.Base, error) {
+func (b *Binance) GetOrderbookEx(currency currency.Pair, assetType asset.Item) (orderbook.Base, error) {
 	ob, err := orderbook.Get(b.GetName(), currency, assetType)
 	if err != nil {
 		return b.UpdateOrderbook(currency, assetType)
@@ -124,9 +135,9 @@ func (b *Binance) GetOrderbookEx(currency currency.Pair, assetType string) (orde
 }
 
 // UpdateOrderbook updates and returns the orderbook for a currency pair
-func (b *Binance) UpdateOrderbook(p currency.Pair, assetType string) (orderbook.Base, error) {
+func (b *Binance) UpdateOrderbook(p currency.Pair, assetType asset.Item) (orderbook.Base, error) {
 	var orderBook orderbook.Base
-	orderbookNew, err := b.GetOrderBook(OrderBookDataRequestParams{Symbol: exchange.FormatExchangeCurrency(b.Name, p).String(), Limit: 1000})
+	orderbookNew, err := b.GetOrderBook(OrderBookDataRequestParams{Symbol: b.FormatExchangeCurrency(p).String(), Limit: 1000})
 	if err != nil {
 		return orderBook, err
 	}
@@ -196,14 +207,157 @@ func (b *Binance) GetFundingHistory() ([]exchange.FundHistory, error) {
 	return fundHistory, common.ErrFunctionNotSupported
 }
 
-// GetExchangeHistory returns historic trade data since exchange opening.
-func (b *Binance) GetExchangeHistory(p currency.Pair, assetType string) ([]exchange.TradeHistory, error) {
+// GetExchangeHistory returns the most recent trades for a currency pair
+func (b *Binance) GetExchangeHistory(p currency.Pair, assetType asset.Item) ([]exchange.TradeHistory, error) {
+	trades, err := b.GetRecentTrades(RecentTradeRequestParams{
+		Symbol: b.FormatExchangeCurrency(p).String(),
+		Limit:  500,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp := make([]exchange.TradeHistory, len(trades))
+	for i := range trades {
+		resp[i] = exchange.TradeHistory{
+			Timestamp: time.Unix(0, int64(trades[i].Time)*int64(time.Millisecond)),
+			TID:       trades[i].ID,
+			ID:        strconv.FormatInt(trades[i].ID, 10),
+			Price:     trades[i].Price,
+			Amount:    trades[i].Quantity,
+			Exchange:  b.Name,
+			Side:      takerSide(trades[i].IsBuyerMaker),
+		}
+	}
+	return resp, nil
+}
+
+// GetHistoricTrades returns the trades for a currency pair between the start
+// and end times. Aggregated trades can only be requested by time for an hour
+// at a time, so each hour is searched until a trade is found and the rest are
+// paged through by aggregate trade ID
+func (b *Binance) GetHistoricTrades(p currency.Pair, assetType asset.Item, timestampStart, timestampEnd time.Time) ([]exchange.TradeHistory, error) {
+	err := exchange.CheckTimeRange(timestampStart, timestampEnd)
+	if err != nil {
+		return nil, err
+	}
+
+	symbol := b.FormatExchangeCurrency(p).String()
+	windowStart := timestampStart
+	var fromID int64
 	var resp []exchange.TradeHistory
-	return resp, common.ErrNotYetImplemented
+	for {
+		req := AggregatedTradeRequestParams{
+			Symbol: symbol,
+			Limit:  1000,
+		}
+
+		if fromID == 0 {
+			if !windowStart.Before(timestampEnd) {
+				break
+			}
+			req.StartTime = windowStart
+			req.EndTime = windowStart.Add(time.Hour)
+			if req.EndTime.After(timestampEnd) {
+				req.EndTime = timestampEnd
+			}
+			windowStart = req.EndTime
+		} else {
+			req.FromID = fromID
+		}
+
+		trades, err := b.GetAggregatedTrades(&req)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(trades) == 0 {
+			if fromID != 0 {
+				break
+			}
+			continue
+		}
+
+		for i := range trades {
+			resp = append(resp, exchange.TradeHistory{
+				Timestamp: time.Unix(0, trades[i].TimeStamp*int64(time.Millisecond)),
+				TID:       trades[i].ATradeID,
+				ID:        strconv.FormatInt(trades[i].ATradeID, 10),
+				Price:     trades[i].Price,
+				Amount:    trades[i].Quantity,
+				Exchange:  b.Name,
+				Side:      takerSide(trades[i].Maker),
+			})
+		}
+
+		last := trades[len(trades)-1]
+		if time.Unix(0, last.TimeStamp*int64(time.Millisecond)).After(timestampEnd) ||
+			(fromID != 0 && len(trades) < req.Limit) {
+			break
+		}
+		fromID = last.ATradeID + 1
+	}
+
+	exchange.FilterTradesByTimeRange(&resp, timestampStart, timestampEnd)
+	return resp, nil
+}
+
+// GetHistoricCandles returns candles between the start and end times, paging
+// through the time range in windows of up to 1000 candles
+func (b *Binance) GetHistoricCandles(p currency.Pair, assetType asset.Item, timestampStart, timestampEnd time.Time, interval kline.Interval) (kline.Item, error) {
+	err := kline.CheckRequest(timestampStart, timestampEnd, interval)
+	if err != nil {
+		return kline.Item{}, err
+	}
+
+	item := kline.Item{
+		Exchange:  b.Name,
+		Pair:      p,
+		AssetType: assetType,
+		Interval:  interval,
+	}
+
+	symbol := b.FormatExchangeCurrency(p).String()
+	dates := kline.CalculateCandleDateRanges(timestampStart, timestampEnd,
+		interval, binanceKlineLimit)
+	for x := range dates {
+		candles, err := b.GetSpotKline(KlinesRequestParams{
+			Symbol:    symbol,
+			Interval:  TimeInterval(interval.String()),
+			Limit:     binanceKlineLimit,
+			StartTime: common.UnixMillis(dates[x].Start),
+			EndTime:   common.UnixMillis(dates[x].End) - 1,
+		})
+		if err != nil {
+			return kline.Item{}, err
+		}
+
+		for i := range candles {
+			item.Candles = append(item.Candles, kline.Candle{
+				Time:   time.Unix(0, int64(candles[i].OpenTime)*int64(time.Millisecond)),
+				Open:   candles[i].Open,
+				High:   candles[i].High,
+				Low:    candles[i].Low,
+				Close:  candles[i].Close,
+				Volume: candles[i].Volume,
+			})
+		}
+	}
+
+	item.FilterCandles(timestampStart, timestampEnd)
+	return item, nil
+}
+
+// takerSide returns the side of the order which took liquidity in a trade
+func takerSide(isBuyerMaker bool) exchange.OrderSide {
+	if isBuyerMaker {
+		return exchange.SellOrderSide
+	}
+	return exchange.BuyOrderSide
 }
 
 // SubmitOrder submits a new order
-func (b *Binance) SubmitOrder(p currency.Pair, side exchange.OrderSide, orderType exchange.OrderType, amount, price float64, _ string) (exchange.SubmitOrderResponse, error) {
+func (b *Binance) SubmitOrder(p currency.Pair, _ asset.Item, side exchange.OrderSide, orderType exchange.OrderType, amount, price float64, clientID string) (exchange.SubmitOrderResponse, error) {
 	var submitOrderResponse exchange.SubmitOrderResponse
 
 	var sideType RequestParamsSideType
@@ -225,12 +379,13 @@ func (b *Binance) SubmitOrder(p currency.Pair, side exchange.OrderSide, orderTyp
 	}
 
 	var orderRequest = NewOrderRequest{
-		Symbol:      p.Base.String() + p.Quote.String(),
-		Side:        sideType,
-		Price:       price,
-		Quantity:    amount,
-		TradeType:   requestParamsOrderType,
-		TimeInForce: BinanceRequestParamsTimeGTC,
+		Symbol:           p.Base.String() + p.Quote.String(),
+		Side:             sideType,
+		Price:            price,
+		Quantity:         amount,
+		TradeType:        requestParamsOrderType,
+		TimeInForce:      BinanceRequestParamsTimeGTC,
+		NewClientOrderID: clientID,
 	}
 
 	response, err := b.NewOrder(&orderRequest)
@@ -259,7 +414,7 @@ func (b *Binance) CancelOrder(order *exchange.OrderCancellation) error {
 		return err
 	}
 
-	_, err = b.CancelExistingOrder(exchange.FormatExchangeCurrency(b.Name, order.CurrencyPair).String(),
+	_, err = b.CancelExistingOrder(b.FormatExchangeCurrency(order.CurrencyPair).String(),
 		orderIDInt,
 		order.AccountID)
 
@@ -341,7 +496,7 @@ func (b *Binance) GetActiveOrders(getOrdersRequest *exchange.GetOrdersRequest) (
 
 	var orders []exchange.OrderDetail
 	for _, c := range getOrdersRequest.Currencies {
-		resp, err := b.OpenOrders(exchange.FormatExchangeCurrency(b.Name, c).String())
+		resp, err := b.OpenOrders(b.FormatExchangeCurrency(c).String())
 		if err != nil {
 			return nil, err
 		}
@@ -356,6 +511,7 @@ func (b *Binance) GetActiveOrders(getOrdersRequest *exchange.GetOrdersRequest) (
 				OrderDate:    orderDate,
 				Exchange:     b.Name,
 				ID:           fmt.Sprintf("%v", resp[i].OrderID),
+				ClientID:     resp[i].ClientOrderID,
 				OrderSide:    orderSide,
 				OrderType:    orderType,
 				Price:        resp[i].Price,
@@ -381,7 +537,7 @@ func (b *Binance) GetOrderHistory(getOrdersRequest *exchange.GetOrdersRequest) (
 
 	var orders []exchange.OrderDetail
 	for _, c := range getOrdersRequest.Currencies {
-		resp, err := b.AllOrders(exchange.FormatExchangeCurrency(b.Name, c).String(), "", "1000")
+		resp, err := b.AllOrders(b.FormatExchangeCurrency(c).String(), "", "1000")
 		if err != nil {
 			return nil, err
 		}
@@ -400,6 +556,7 @@ func (b *Binance) GetOrderHistory(getOrdersRequest *exchange.GetOrdersRequest) (
 				OrderDate:    orderDate,
 				Exchange:     b.Name,
 				ID:           fmt.Sprintf("%v", resp[i].OrderID),
+				ClientID:     resp[i].ClientOrderID,
 				OrderSide:    orderSide,
 				OrderType:    orderType,
 				Price:        resp[i].Price,
@@ -12,8 +12,8 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
-	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/websocket/wshandler"
 	log "github.com/thrasher-corp/gocryptotrader/logger"
 )
@@ -31,6 +31,7 @@ const (
 	bitfinexOrderbook          = "book/"
 	bitfinexTrades             = "trades/"
 	bitfinexTradesV2           = "https://api.bitfinex.com/v2/trades/%s/hist?limit=1000&start=%s&end=%s"
+	bitfinexTradesV2Limit      = 1000
 	bitfinexKeyPermissions     = "key_info"
 	bitfinexLends              = "lends/"
 	bitfinexSymbols            = "symbols/"
@@ -102,9 +103,36 @@ func (b *Bitfinex) SetDefaults() {
 	b.RequestCurrencyPairFormat.Uppercase = true
 	b.ConfigCurrencyPairFormat.Delimiter = ""
 	b.ConfigCurrencyPairFormat.Uppercase = true
-	b.AssetTypes = []string{ticker.Spot}
-	b.SupportsAutoPairUpdating = true
-	b.SupportsRESTTickerBatching = true
+	b.AssetTypes = asset.Items{asset.Spot}
+	b.Features = exchange.Features{
+		REST: exchange.ProtocolFeatures{
+			TickerFetching:    true,
+			TickerBatching:    true,
+			OrderbookFetching: true,
+			TradeFetching:     true,
+			HistoricTrades:    true,
+			KlineFetching:     true,
+			AutoPairUpdates:   true,
+			AccountInfo:       true,
+			ActiveOrders:      true,
+			OrderHistory:      true,
+			SubmitOrder:       true,
+			CancelOrder:       true,
+			CancelOrders:      true,
+			DepositAddress:    true,
+			CryptoWithdrawal:  true,
+			FiatWithdrawal:    true,
+		},
+		Websocket: exchange.ProtocolFeatures{
+			TickerFetching:         true,
+			OrderbookFetching:      true,
+			TradeFetching:          true,
+			Subscribe:              true,
+			Unsubscribe:            true,
+			AuthenticatedEndpoints: true,
+		},
+		OrderTypes: []exchange.OrderType{exchange.LimitOrderType, exchange.MarketOrderType},
+	}
 	b.Requester = request.New(b.Name,
 		request.NewRateLimit(time.Second*60, bitfinexAuthRate),
 		request.NewRateLimit(time.Second*60, bitfinexUnauthRate),
@@ -112,12 +140,7 @@ func (b *Bitfinex) SetDefaults() {
 	b.APIUrlDefault = bitfinexAPIURLBase
 	b.APIUrl = b.APIUrlDefault
 	b.Websocket = wshandler.New()
-	b.Websocket.Functionality = wshandler.WebsocketTickerSupported |
-		wshandler.WebsocketTradeDataSupported |
-		wshandler.WebsocketOrderbookSupported |
-		wshandler.WebsocketSubscribeSupported |
-		wshandler.WebsocketUnsubscribeSupported |
-		wshandler.WebsocketAuthenticatedEndpointsSupported
+	b.Websocket.Functionality = b.Features.Websocket.Functionality()
 	b.WebsocketResponseMaxLimit = exchange.DefaultWebsocketResponseMaxLimit
 	b.WebsocketResponseCheckTimeout = exchange.DefaultWebsocketResponseCheckTimeout
 	b.WebsocketOrderbookBufferLimit = exchange.DefaultWebsocketOrderbookBufferLimit
@@ -134,6 +157,7 @@ func (b *Bitfinex) Setup(exch *config.ExchangeConfig) {
 		b.SetAPIKeys(exch.APIKey, exch.APISecret, "", false)
 		b.SetHTTPClientTimeout(exch.HTTPTimeout)
 		b.SetHTTPClientUserAgent(exch.HTTPUserAgent)
+		b.SetHTTPRetryPolicy(exch.HTTPRetry)
 		b.RESTPollingDelay = exch.RESTPollingDelay
 		b.Verbose = exch.Verbose
 		b.HTTPDebugging = exch.HTTPDebugging
@@ -190,6 +214,7 @@ func (b *Bitfinex) Setup(exch *config.ExchangeConfig) {
 			false,
 			false,
 			exch.Name)
+		b.Websocket.Orderbook.SetupVerification(b.wsOrderbookChecksum, nil)
 	}
 }
 
@@ -12,6 +12,8 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/sharedtestvalues"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/websocket/wshandler"
 )
@@ -775,7 +777,7 @@ func TestSubmitOrder(t *testing.T) {
 		Base:      currency.LTC,
 		Quote:     currency.BTC,
 	}
-	response, err := b.SubmitOrder(p, exchange.BuyOrderSide, exchange.MarketOrderType, 1, 1, "clientId")
+	response, err := b.SubmitOrder(p, asset.Spot, exchange.BuyOrderSide, exchange.MarketOrderType, 1, 1, "clientId")
 	if areTestAPIKeysSet() && (err != nil || !response.IsOrderPlaced) {
 		t.Errorf("Order failed to be placed: %v", err)
 	} else if !areTestAPIKeysSet() && err == nil {
@@ -993,3 +995,17 @@ func TestWsAuth(t *testing.T) {
 	}
 	timer.Stop()
 }
+
+// TestWsOrderbookChecksum verifies the bids and asks alternate in the
+// checksum with ask amounts negated
+func TestWsOrderbookChecksum(t *testing.T) {
+	d := orderbook.NewDepth("Bitfinex", currency.NewPairFromStrings("BTC", "USD"), asset.Spot)
+	d.Load(&orderbook.Base{
+		Bids: []orderbook.Item{{Price: 6000, Amount: 1.5}, {Price: 5999.5, Amount: 2}},
+		Asks: []orderbook.Item{{Price: 6001, Amount: 0.0000001}},
+	})
+	if checksum := b.wsOrderbookChecksum(d); checksum != 2694911641 {
+		t.Errorf("Test failed - wsOrderbookChecksum() expected 2694911641 received %d",
+			checksum)
+	}
+}
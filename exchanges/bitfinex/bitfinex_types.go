@@ -77,7 +77,7 @@ type TradeStructure struct {
 	Price     float64 `json:"price,string"`
 	Amount    float64 `json:"amount,string"`
 	Exchange  string  `json:"exchange"`
-	Type      string  `json:"sell"`
+	Type      string  `json:"type"`
 }
 
 // TradeStructureV2 holds resp information
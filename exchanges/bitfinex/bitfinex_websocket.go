@@ -3,15 +3,18 @@ package bitfinex
 import (
 	"errors"
 	"fmt"
+	"hash/crc32"
 	"net/http"
 	"reflect"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/gorilla/websocket"
 	"github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/websocket/wshandler"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/websocket/wsorderbook"
@@ -35,6 +38,7 @@ const (
 	bitfinexWebsocketTradeExecutionUpdate = "tu"
 	bitfinexWebsocketTradeSnapshots       = "ts"
 	bitfinexWebsocketHeartbeat            = "hb"
+	bitfinexWebsocketChecksum             = "cs"
 	bitfinexWebsocketAlertRestarting      = "20051"
 	bitfinexWebsocketAlertRefreshing      = "20060"
 	bitfinexWebsocketAlertResume          = "20061"
@@ -43,6 +47,10 @@ const (
 	bitfinexWebsocketSubscriptionFailed   = "10300"
 	bitfinexWebsocketAlreadySubscribed    = "10301"
 	bitfinexWebsocketUnknownChannel       = "10302"
+	// bitfinexWebsocketChecksumFlag configures the orderbook channels to
+	// publish the checksum of the top 25 levels a side after each update
+	bitfinexWebsocketChecksumFlag  = 131072
+	bitfinexWebsocketChecksumDepth = 25
 )
 
 // WebsocketHandshake defines the communication between the websocket API for
@@ -97,6 +105,14 @@ func (b *Bitfinex) WsSendUnauth() error {
 	return b.WebsocketConn.SendMessage(req)
 }
 
+// WsEnableChecksums configures the orderbook channels to publish checksums
+func (b *Bitfinex) WsEnableChecksums() error {
+	req := make(map[string]interface{})
+	req["event"] = "conf"
+	req["flags"] = bitfinexWebsocketChecksumFlag
+	return b.WebsocketConn.SendMessage(req)
+}
+
 // WsAddSubscriptionChannel adds a new subscription channel to the
 // WebsocketSubdChannels map in bitfinex.go (Bitfinex struct)
 func (b *Bitfinex) WsAddSubscriptionChannel(chanID int, channel, pair string) {
@@ -146,6 +162,11 @@ func (b *Bitfinex) WsConnect() error {
 		log.Errorf("%v - authentication failed: %v", b.Name, err)
 	}
 
+	err = b.WsEnableChecksums()
+	if err != nil {
+		log.Errorf("%v - unable to enable orderbook checksums: %v", b.Name, err)
+	}
+
 	b.GenerateDefaultSubscriptions()
 	if hs.Event == "info" {
 		if b.Verbose {
@@ -233,6 +254,17 @@ func (b *Bitfinex) WsDataHandler() {
 						var newOrderbook []WebsocketBook
 						curr := currency.NewPairFromString(chanInfo.Pair)
 						switch len(chanData) {
+						case 3:
+							if chanData[1] != bitfinexWebsocketChecksum {
+								continue
+							}
+							err := b.WsVerifyOrderbook(curr,
+								asset.Spot,
+								chanData[2].(float64))
+							if err != nil {
+								b.Websocket.DataHandler <- fmt.Errorf("bitfinex_websocket.go verifying orderbook error: %s",
+									err)
+							}
 						case 2:
 							data := chanData[1].([]interface{})
 							for i := range data {
@@ -244,7 +276,7 @@ func (b *Bitfinex) WsDataHandler() {
 							}
 
 							err := b.WsInsertSnapshot(curr,
-								orderbook.Spot,
+								asset.Spot,
 								newOrderbook)
 
 							if err != nil {
@@ -257,7 +289,7 @@ func (b *Bitfinex) WsDataHandler() {
 								Count:  int(chanData[2].(float64)),
 								Amount: chanData[3].(float64)})
 							err := b.WsUpdateOrderbook(curr,
-								orderbook.Spot,
+								asset.Spot,
 								newOrderbook)
 
 							if err != nil {
@@ -271,9 +303,11 @@ func (b *Bitfinex) WsDataHandler() {
 							ClosePrice: chanData[7].(float64),
 							HighPrice:  chanData[9].(float64),
 							LowPrice:   chanData[10].(float64),
+							BidPrice:   chanData[1].(float64),
+							AskPrice:   chanData[3].(float64),
 							Pair:       currency.NewPairFromString(chanInfo.Pair),
 							Exchange:   b.GetName(),
-							AssetType:  orderbook.Spot,
+							AssetType:  asset.Spot,
 						}
 
 					case "account":
@@ -442,7 +476,7 @@ func (b *Bitfinex) WsDataHandler() {
 								Price:        trades[0].Price,
 								Amount:       newAmount,
 								Exchange:     b.GetName(),
-								AssetType:    orderbook.Spot,
+								AssetType:    asset.Spot,
 								Side:         side,
 							}
 						}
@@ -455,7 +489,7 @@ func (b *Bitfinex) WsDataHandler() {
 
 // WsInsertSnapshot add the initial orderbook snapshot when subscribed to a
 // channel
-func (b *Bitfinex) WsInsertSnapshot(p currency.Pair, assetType string, books []WebsocketBook) error {
+func (b *Bitfinex) WsInsertSnapshot(p currency.Pair, assetType asset.Item, books []WebsocketBook) error {
 	if len(books) == 0 {
 		return errors.New("bitfinex.go error - no orderbooks submitted")
 	}
@@ -487,7 +521,7 @@ func (b *Bitfinex) WsInsertSnapshot(p currency.Pair, assetType string, books []W
 
 // WsUpdateOrderbook updates the orderbook list, removing and adding to the
 // orderbook sides
-func (b *Bitfinex) WsUpdateOrderbook(p currency.Pair, assetType string, book []WebsocketBook) error {
+func (b *Bitfinex) WsUpdateOrderbook(p currency.Pair, assetType asset.Item, book []WebsocketBook) error {
 	orderbookUpdate := wsorderbook.WebsocketOrderbookUpdate{
 		Asks:         []orderbook.Item{},
 		Bids:         []orderbook.Item{},
@@ -528,6 +562,49 @@ func (b *Bitfinex) WsUpdateOrderbook(p currency.Pair, assetType string, book []W
 	return nil
 }
 
+// WsVerifyOrderbook verifies an orderbook against its published checksum,
+// an orderbook which does not match is dropped and its channel resubscribed
+// to receive a new snapshot
+func (b *Bitfinex) WsVerifyOrderbook(p currency.Pair, assetType asset.Item, checksum float64) error {
+	err := b.Websocket.Orderbook.VerifyChecksum(p, assetType, uint32(int32(checksum)))
+	if err != nil {
+		b.Websocket.ResubscribeToChannel(wshandler.WebsocketChannelSubscription{
+			Channel:  "book",
+			Currency: p,
+			Params:   map[string]interface{}{"prec": "P0"},
+		})
+	}
+	return err
+}
+
+// wsOrderbookChecksum returns the CRC32 checksum of the top 25 bids and asks
+// of an orderbook, alternating between them with ask amounts negated
+func (b *Bitfinex) wsOrderbookChecksum(ob *orderbook.Depth) uint32 {
+	bids, asks := ob.Levels(bitfinexWebsocketChecksumDepth)
+	var values []string
+	for i := 0; i < bitfinexWebsocketChecksumDepth; i++ {
+		if i < len(bids) {
+			values = append(values, checksumValue(bids[i].Price),
+				checksumValue(bids[i].Amount))
+		}
+		if i < len(asks) {
+			values = append(values, checksumValue(asks[i].Price),
+				checksumValue(-asks[i].Amount))
+		}
+	}
+	return crc32.ChecksumIEEE([]byte(strings.Join(values, ":")))
+}
+
+// checksumValue formats a price or amount as the exchange does for its
+// checksum, small values are in exponent notation
+func checksumValue(value float64) string {
+	if value != 0 && value > -1e-6 && value < 1e-6 {
+		s := strconv.FormatFloat(value, 'e', -1, 64)
+		return strings.Replace(strings.Replace(s, "e-0", "e-", 1), "e+0", "e+", 1)
+	}
+	return strconv.FormatFloat(value, 'f', -1, 64)
+}
+
 // GenerateDefaultSubscriptions Adds default subscriptions to websocket to be handled by ManageSubscriptions()
 func (b *Bitfinex) GenerateDefaultSubscriptions() {
 	var channels = []string{"book", "trades", "ticker"}
@@ -1,6 +1,7 @@
 package bitfinex
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"net/url"
@@ -12,6 +13,8 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/websocket/wshandler"
@@ -27,6 +30,14 @@ func (b *Bitfinex) Start(wg *sync.WaitGroup) {
 	}()
 }
 
+// WithContext returns a copy of Bitfinex whose HTTP requests are abandoned once
+// the context is done
+func (b *Bitfinex) WithContext(ctx context.Context) exchange.IBotExchange {
+	cp := *b
+	cp.Base = b.ContextBase(ctx)
+	return &cp
+}
+
 // Run implements the Bitfinex wrapper
 func (b *Bitfinex) Run() {
 	if b.Verbose {
@@ -53,7 +64,7 @@ func (b *Bitfinex) Run() {
 }
 
 // UpdateTicker updates and returns the ticker for a currency pair
-func (b *Bitfinex) UpdateTicker(p currency.Pair, assetType string) (ticker.Price, error) {
+func (b *Bitfinex) UpdateTicker(p currency.Pair, assetType asset.Item) (ticker.Price, error) {
 	var tickerPrice ticker.Price
 	enabledPairs := b.GetEnabledCurrencies()
 
@@ -89,8 +100,8 @@ func (b *Bitfinex) UpdateTicker(p currency.Pair, assetType string) (ticker.Price
 }
 
 // GetTickerPrice returns the ticker for a currency pair
-func (b *Bitfinex) GetTickerPrice(p currency.Pair, assetType string) (ticker.Price, error) {
-	tick, err := ticker.GetTicker(b.GetName(), p, ticker.Spot)
+func (b *Bitfinex) GetTickerPrice(p currency.Pair, assetType asset.Item) (ticker.Price, error) {
+	tick, err := ticker.GetTicker(b.GetName(), p, asset.Spot)
 	if err != nil {
 		return b.UpdateTicker(p, assetType)
 	}
@@ -98,7 +109,7 @@ func (b *Bitfinex) GetTickerPrice(p currency.Pair, assetType string) (ticker.Pri
 }
 
 // GetOrderbookEx returns the orderbook for a currency pair
-func (b *Bitfinex) GetOrderbookEx(p currency.Pair, assetType string) (orderbook.Base, error) {
+func (b *Bitfinex) GetOrderbookEx(p currency.Pair, assetType asset.Item) (orderbook.Base, error) {
 	ob, err := orderbook.Get(b.GetName(), p, assetType)
 	if err != nil {
 		return b.UpdateOrderbook(p, assetType)
@@ -107,7 +118,7 @@ func (b *Bitfinex) GetOrderbookEx(p currency.Pair, assetType string) (orderbook.
 }
 
 // UpdateOrderbook updates and returns the orderbook for a currency pair
-func (b *Bitfinex) UpdateOrderbook(p currency.Pair, assetType string) (orderbook.Base, error) {
+func (b *Bitfinex) UpdateOrderbook(p currency.Pair, assetType asset.Item) (orderbook.Base, error) {
 	var orderBook orderbook.Base
 	urlVals := url.Values{}
 	urlVals.Set("limit_bids", "100")
@@ -181,15 +192,89 @@ func (b *Bitfinex) GetFundingHistory() ([]exchange.FundHistory, error) {
 	return fundHistory, common.ErrFunctionNotSupported
 }
 
-// GetExchangeHistory returns historic trade data since exchange opening.
-func (b *Bitfinex) GetExchangeHistory(p currency.Pair, assetType string) ([]exchange.TradeHistory, error) {
+// GetExchangeHistory returns the most recent trades for a currency pair
+func (b *Bitfinex) GetExchangeHistory(p currency.Pair, assetType asset.Item) ([]exchange.TradeHistory, error) {
+	trades, err := b.GetTrades(b.FormatExchangeCurrency(p).String(),
+		url.Values{})
+	if err != nil {
+		return nil, err
+	}
+
+	resp := make([]exchange.TradeHistory, len(trades))
+	for i := range trades {
+		resp[i] = exchange.TradeHistory{
+			Timestamp: time.Unix(trades[i].Timestamp, 0),
+			TID:       trades[i].Tid,
+			ID:        strconv.FormatInt(trades[i].Tid, 10),
+			Price:     trades[i].Price,
+			Amount:    trades[i].Amount,
+			Exchange:  b.Name,
+			Side:      exchange.OrderSide(common.StringToUpper(trades[i].Type)),
+		}
+	}
+	return resp, nil
+}
+
+// GetHistoricTrades returns the trades for a currency pair between the start
+// and end times. Trades are returned newest first, so the end time is moved
+// back to the oldest trade of each page until the start time is reached
+func (b *Bitfinex) GetHistoricTrades(p currency.Pair, assetType asset.Item, timestampStart, timestampEnd time.Time) ([]exchange.TradeHistory, error) {
+	err := exchange.CheckTimeRange(timestampStart, timestampEnd)
+	if err != nil {
+		return nil, err
+	}
+
+	symbol := "t" + b.FormatExchangeCurrency(p).String()
+	start := common.UnixMillis(timestampStart)
+	end := common.UnixMillis(timestampEnd)
 	var resp []exchange.TradeHistory
+	for {
+		trades, err := b.GetTradesV2(symbol, start, end, false)
+		if err != nil {
+			return nil, err
+		}
+
+		oldest := end
+		for i := range trades {
+			resp = append(resp, exchange.TradeHistory{
+				Timestamp: time.Unix(0, trades[i].Timestamp*int64(time.Millisecond)),
+				TID:       trades[i].TID,
+				ID:        strconv.FormatInt(trades[i].TID, 10),
+				Price:     trades[i].Price,
+				Amount:    trades[i].Amount,
+				Exchange:  b.Name,
+				Side:      exchange.OrderSide(trades[i].Type),
+			})
+			if trades[i].Timestamp < oldest {
+				oldest = trades[i].Timestamp
+			}
+		}
+
+		if len(trades) < bitfinexTradesV2Limit || oldest <= start {
+			break
+		}
+
+		// Trades sharing the oldest timestamp are requested again and removed
+		// as duplicates, unless the whole page shares it
+		if oldest == end {
+			oldest--
+		}
+		end = oldest
+	}
+
+	exchange.FilterTradesByTimeRange(&resp, timestampStart, timestampEnd)
+	return resp, nil
+}
 
-	return resp, common.ErrNotYetImplemented
+// GetHistoricCandles returns candles between the start and end times, built
+// from historic trades
+func (b *Bitfinex) GetHistoricCandles(p currency.Pair, assetType asset.Item, timestampStart, timestampEnd time.Time, interval kline.Interval) (kline.Item, error) {
+	return exchange.CreateKlineFromTrades(b, p, assetType, timestampStart,
+		timestampEnd, interval)
 }
 
 // SubmitOrder submits a new order
-func (b *Bitfinex) SubmitOrder(p currency.Pair, side exchange.OrderSide, orderType exchange.OrderType, amount, price float64, _ string) (exchange.SubmitOrderResponse, error) {
+func (b *Bitfinex) SubmitOrder(p currency.Pair, _ asset.Item, side exchange.OrderSide, orderType exchange.OrderType, amount, price float64, _ string) (exchange.SubmitOrderResponse, error) {
 	var submitOrderResponse exchange.SubmitOrderResponse
 	var isBuying bool
 
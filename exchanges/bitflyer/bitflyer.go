@@ -12,8 +12,8 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
-	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/websocket/wshandler"
 	log "github.com/thrasher-corp/gocryptotrader/logger"
 )
@@ -70,6 +70,8 @@ const (
 
 	bitflyerAuthRate   = 200
 	bitflyerUnauthRate = 500
+
+	bitflyerExecutionHistoryLimit = 500
 )
 
 // Bitflyer is the overarching type across this package
@@ -83,15 +85,21 @@ func (b *Bitflyer) SetDefaults() {
 	b.Enabled = false
 	b.Verbose = false
 	b.RESTPollingDelay = 10
-	b.APIWithdrawPermissions = exchange.WithdrawCryptoViaWebsiteOnly |
-		exchange.AutoWithdrawFiat
+	b.APIWithdrawPermissions = exchange.WithdrawCryptoViaWebsiteOnly
 	b.RequestCurrencyPairFormat.Delimiter = "_"
 	b.RequestCurrencyPairFormat.Uppercase = true
 	b.ConfigCurrencyPairFormat.Delimiter = "_"
 	b.ConfigCurrencyPairFormat.Uppercase = true
-	b.AssetTypes = []string{ticker.Spot}
-	b.SupportsAutoPairUpdating = false
-	b.SupportsRESTTickerBatching = false
+	b.AssetTypes = asset.Items{asset.Spot}
+	b.Features = exchange.Features{
+		REST: exchange.ProtocolFeatures{
+			TickerFetching:    true,
+			OrderbookFetching: true,
+			TradeFetching:     true,
+			HistoricTrades:    true,
+			KlineFetching:     true,
+		},
+	}
 	b.Requester = request.New(b.Name,
 		request.NewRateLimit(time.Minute, bitflyerAuthRate),
 		request.NewRateLimit(time.Minute, bitflyerUnauthRate),
@@ -113,6 +121,7 @@ func (b *Bitflyer) Setup(exch *config.ExchangeConfig) {
 		b.SetAPIKeys(exch.APIKey, exch.APISecret, "", false)
 		b.SetHTTPClientTimeout(exch.HTTPTimeout)
 		b.SetHTTPClientUserAgent(exch.HTTPUserAgent)
+		b.SetHTTPRetryPolicy(exch.HTTPRetry)
 		b.RESTPollingDelay = exch.RESTPollingDelay
 		b.Verbose = exch.Verbose
 		b.HTTPDebugging = exch.HTTPDebugging
@@ -218,9 +227,22 @@ func (b *Bitflyer) GetTicker(symbol string) (Ticker, error) {
 
 // GetExecutionHistory returns past trades that were executed on the market
 func (b *Bitflyer) GetExecutionHistory(symbol string) ([]ExecutedTrade, error) {
+	return b.GetExecutionHistoryBefore(symbol, 0, 0)
+}
+
+// GetExecutionHistoryBefore returns past trades that were executed on the
+// market, newest first, with an ID lower than the before ID. A before ID or
+// count of zero uses the exchange defaults
+func (b *Bitflyer) GetExecutionHistoryBefore(symbol string, before, count int64) ([]ExecutedTrade, error) {
 	var resp []ExecutedTrade
 	v := url.Values{}
 	v.Set("product_code", symbol)
+	if before > 0 {
+		v.Set("before", strconv.FormatInt(before, 10))
+	}
+	if count > 0 {
+		v.Set("count", strconv.FormatInt(count, 10))
+	}
 	path := fmt.Sprintf("%s%s?%s", b.APIUrl, pubGetExecutionHistory, v.Encode())
 
 	return resp, b.SendHTTPRequest(path, &resp)
@@ -7,6 +7,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	log "github.com/thrasher-corp/gocryptotrader/logger"
 )
 
@@ -249,7 +250,7 @@ func TestGetFee(t *testing.T) {
 
 func TestFormatWithdrawPermissions(t *testing.T) {
 	b.SetDefaults()
-	expectedResult := exchange.AutoWithdrawFiatText + " & " + exchange.WithdrawCryptoViaWebsiteOnlyText
+	expectedResult := exchange.WithdrawCryptoViaWebsiteOnlyText + " & " + exchange.NoFiatWithdrawalsText
 
 	withdrawPermissions := b.FormatWithdrawPermissions()
 
@@ -311,7 +312,7 @@ func TestSubmitOrder(t *testing.T) {
 		Base:      currency.LTC,
 		Quote:     currency.BTC,
 	}
-	_, err := b.SubmitOrder(p, exchange.BuyOrderSide, exchange.MarketOrderType, 1, 1, "clientId")
+	_, err := b.SubmitOrder(p, asset.Spot, exchange.BuyOrderSide, exchange.MarketOrderType, 1, 1, "clientId")
 	if err != common.ErrNotYetImplemented {
 		t.Errorf("Expected 'Not Yet Implemented', received %v", err)
 	}
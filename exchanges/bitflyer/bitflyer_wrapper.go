@@ -1,11 +1,16 @@
 package bitflyer
 
 import (
+	"context"
+	"strconv"
 	"sync"
+	"time"
 
 	"github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/websocket/wshandler"
@@ -21,6 +26,14 @@ func (b *Bitflyer) Start(wg *sync.WaitGroup) {
 	}()
 }
 
+// WithContext returns a copy of Bitflyer whose HTTP requests are abandoned once
+// the context is done
+func (b *Bitflyer) WithContext(ctx context.Context) exchange.IBotExchange {
+	cp := *b
+	cp.Base = b.ContextBase(ctx)
+	return &cp
+}
+
 // Run implements the Bitflyer wrapper
 func (b *Bitflyer) Run() {
 	if b.Verbose {
@@ -52,7 +65,7 @@ func (b *Bitflyer) Run() {
 }
 
 // UpdateTicker updates and returns the ticker for a currency pair
-func (b *Bitflyer) UpdateTicker(p currency.Pair, assetType string) (ticker.Price, error) {
+func (b *Bitflyer) UpdateTicker(p currency.Pair, assetType asset.Item) (ticker.Price, error) {
 	var tickerPrice ticker.Price
 
 	p = b.CheckFXString(p)
@@ -78,8 +91,8 @@ func (b *Bitflyer) UpdateTicker(p currency.Pair, assetType string) (ticker.Price
 }
 
 // GetTickerPrice returns the ticker for a currency pair
-func (b *Bitflyer) GetTickerPrice(p currency.Pair, assetType string) (ticker.Price, error) {
-	tick, err := ticker.GetTicker(b.GetName(), p, ticker.Spot)
+func (b *Bitflyer) GetTickerPrice(p currency.Pair, assetType asset.Item) (ticker.Price, error) {
+	tick, err := ticker.GetTicker(b.GetName(), p, asset.Spot)
 	if err != nil {
 		return b.UpdateTicker(p, assetType)
 	}
@@ -96,7 +109,7 @@ func (b *Bitflyer) CheckFXString(p currency.Pair) currency.Pair {
 }
 
 // GetOrderbookEx returns the orderbook for a currency pair
-func (b *Bitflyer) GetOrderbookEx(p currency.Pair, assetType string) (orderbook.Base, error) {
+func (b *Bitflyer) GetOrderbookEx(p currency.Pair, assetType asset.Item) (orderbook.Base, error) {
 	ob, err := orderbook.Get(b.GetName(), p, assetType)
 	if err != nil {
 		return b.UpdateOrderbook(p, assetType)
@@ -105,7 +118,7 @@ func (b *Bitflyer) GetOrderbookEx(p currency.Pair, assetType string) (orderbook.
 }
 
 // UpdateOrderbook updates and returns the orderbook for a currency pair
-func (b *Bitflyer) UpdateOrderbook(p currency.Pair, assetType string) (orderbook.Base, error) {
+func (b *Bitflyer) UpdateOrderbook(p currency.Pair, assetType asset.Item) (orderbook.Base, error) {
 	var orderBook orderbook.Base
 
 	p = b.CheckFXString(p)
@@ -147,15 +160,82 @@ func (b *Bitflyer) GetFundingHistory() ([]exchange.FundHistory, error) {
 	return nil, common.ErrFunctionNotSupported
 }
 
-// GetExchangeHistory returns historic trade data since exchange opening.
-func (b *Bitflyer) GetExchangeHistory(p currency.Pair, assetType string) ([]exchange.TradeHistory, error) {
+// GetExchangeHistory returns the most recent trades for a currency pair
+func (b *Bitflyer) GetExchangeHistory(p currency.Pair, assetType asset.Item) ([]exchange.TradeHistory, error) {
+	trades, err := b.GetExecutionHistory(b.FormatExchangeCurrency(p).String())
+	if err != nil {
+		return nil, err
+	}
+	return b.convertTrades(trades)
+}
+
+// GetHistoricTrades returns the trades for a currency pair between the start
+// and end times. Executions are returned newest first, so pages are requested
+// before the oldest execution until the start time is reached
+func (b *Bitflyer) GetHistoricTrades(p currency.Pair, assetType asset.Item, timestampStart, timestampEnd time.Time) ([]exchange.TradeHistory, error) {
+	err := exchange.CheckTimeRange(timestampStart, timestampEnd)
+	if err != nil {
+		return nil, err
+	}
+
+	symbol := b.FormatExchangeCurrency(p).String()
+	var before int64
 	var resp []exchange.TradeHistory
+	for {
+		executions, err := b.GetExecutionHistoryBefore(symbol, before,
+			bitflyerExecutionHistoryLimit)
+		if err != nil {
+			return nil, err
+		}
+
+		trades, err := b.convertTrades(executions)
+		if err != nil {
+			return nil, err
+		}
+		resp = append(resp, trades...)
+
+		if len(executions) < bitflyerExecutionHistoryLimit ||
+			trades[len(trades)-1].Timestamp.Before(timestampStart) {
+			break
+		}
+		before = executions[len(executions)-1].ID
+	}
 
-	return resp, common.ErrNotYetImplemented
+	exchange.FilterTradesByTimeRange(&resp, timestampStart, timestampEnd)
+	return resp, nil
+}
+
+// GetHistoricCandles returns candles between the start and end times, built
+// from historic trades
+func (b *Bitflyer) GetHistoricCandles(p currency.Pair, assetType asset.Item, timestampStart, timestampEnd time.Time, interval kline.Interval) (kline.Item, error) {
+	return exchange.CreateKlineFromTrades(b, p, assetType, timestampStart,
+		timestampEnd, interval)
+}
+
+func (b *Bitflyer) convertTrades(executions []ExecutedTrade) ([]exchange.TradeHistory, error) {
+	resp := make([]exchange.TradeHistory, len(executions))
+	for i := range executions {
+		// Execution dates are UTC without a time zone designator
+		t, err := time.Parse("2006-01-02T15:04:05.999999999", executions[i].ExecDate)
+		if err != nil {
+			return nil, err
+		}
+
+		resp[i] = exchange.TradeHistory{
+			Timestamp: t,
+			TID:       executions[i].ID,
+			ID:        strconv.FormatInt(executions[i].ID, 10),
+			Price:     executions[i].Price,
+			Amount:    executions[i].Size,
+			Exchange:  b.Name,
+			Side:      exchange.OrderSide(executions[i].Side),
+		}
+	}
+	return resp, nil
 }
 
 // SubmitOrder submits a new order
-func (b *Bitflyer) SubmitOrder(p currency.Pair, side exchange.OrderSide, orderType exchange.OrderType, amount, price float64, clientID string) (exchange.SubmitOrderResponse, error) {
+func (b *Bitflyer) SubmitOrder(p currency.Pair, _ asset.Item, side exchange.OrderSide, orderType exchange.OrderType, amount, price float64, clientID string) (exchange.SubmitOrderResponse, error) {
 	var submitOrderResponse exchange.SubmitOrderResponse
 
 	return submitOrderResponse, common.ErrNotYetImplemented
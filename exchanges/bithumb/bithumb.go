@@ -16,8 +16,8 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
-	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/websocket/wshandler"
 	log "github.com/thrasher-corp/gocryptotrader/logger"
 )
@@ -56,6 +56,9 @@ const (
 	bithumbUnauthRate = 20
 )
 
+// bithumbTimeZone is the Korea Standard Time zone used by Bithumb timestamps
+var bithumbTimeZone = time.FixedZone("KST", 9*60*60)
+
 // Bithumb is the overarching type across the Bithumb package
 type Bithumb struct {
 	exchange.Base
@@ -67,16 +70,32 @@ func (b *Bithumb) SetDefaults() {
 	b.Enabled = false
 	b.Verbose = false
 	b.RESTPollingDelay = 10
-	b.APIWithdrawPermissions = exchange.AutoWithdrawCrypto |
-		exchange.AutoWithdrawFiat
 	b.RequestCurrencyPairFormat.Delimiter = ""
 	b.RequestCurrencyPairFormat.Uppercase = true
 	b.ConfigCurrencyPairFormat.Delimiter = ""
 	b.ConfigCurrencyPairFormat.Uppercase = true
 	b.ConfigCurrencyPairFormat.Index = "KRW"
-	b.AssetTypes = []string{ticker.Spot}
-	b.SupportsAutoPairUpdating = true
-	b.SupportsRESTTickerBatching = true
+	b.AssetTypes = asset.Items{asset.Spot}
+	b.Features = exchange.Features{
+		REST: exchange.ProtocolFeatures{
+			TickerFetching:    true,
+			TickerBatching:    true,
+			OrderbookFetching: true,
+			TradeFetching:     true,
+			AutoPairUpdates:   true,
+			AccountInfo:       true,
+			ActiveOrders:      true,
+			OrderHistory:      true,
+			SubmitOrder:       true,
+			ModifyOrder:       true,
+			CancelOrder:       true,
+			CancelOrders:      true,
+			DepositAddress:    true,
+			CryptoWithdrawal:  true,
+			FiatWithdrawal:    true,
+		},
+		OrderTypes: []exchange.OrderType{exchange.MarketOrderType},
+	}
 	b.Requester = request.New(b.Name,
 		request.NewRateLimit(time.Second, bithumbAuthRate),
 		request.NewRateLimit(time.Second, bithumbUnauthRate),
@@ -96,6 +115,7 @@ func (b *Bithumb) Setup(exch *config.ExchangeConfig) {
 		b.SetAPIKeys(exch.APIKey, exch.APISecret, "", false)
 		b.SetHTTPClientTimeout(exch.HTTPTimeout)
 		b.SetHTTPClientUserAgent(exch.HTTPUserAgent)
+		b.SetHTTPRetryPolicy(exch.HTTPRetry)
 		b.RESTPollingDelay = exch.RESTPollingDelay
 		b.Verbose = exch.Verbose
 		b.HTTPDebugging = exch.HTTPDebugging
@@ -557,7 +577,7 @@ func (b *Bithumb) SendAuthenticatedHTTPRequest(path string, params url.Values, r
 
 	params.Set("endpoint", path)
 	payload := params.Encode()
-	hmacPayload := path + string(0) + payload + string(0) + n
+	hmacPayload := path + "\x00" + payload + "\x00" + n
 	hmac := common.GetHMAC(common.HashSHA512,
 		[]byte(hmacPayload),
 		[]byte(b.APISecret))
@@ -1,6 +1,7 @@
 package bithumb
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"math"
@@ -11,6 +12,8 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/websocket/wshandler"
@@ -26,6 +29,14 @@ func (b *Bithumb) Start(wg *sync.WaitGroup) {
 	}()
 }
 
+// WithContext returns a copy of Bithumb whose HTTP requests are abandoned once
+// the context is done
+func (b *Bithumb) WithContext(ctx context.Context) exchange.IBotExchange {
+	cp := *b
+	cp.Base = b.ContextBase(ctx)
+	return &cp
+}
+
 // Run implements the OKEX wrapper
 func (b *Bithumb) Run() {
 	if b.Verbose {
@@ -66,7 +77,7 @@ func (b *Bithumb) GetTradingPairs() ([]string, error) {
 }
 
 // UpdateTicker updates and returns the ticker for a currency pair
-func (b *Bithumb) UpdateTicker(p currency.Pair, assetType string) (ticker.Price, error) {
+func (b *Bithumb) UpdateTicker(p currency.Pair, assetType asset.Item) (ticker.Price, error) {
 	var tickerPrice ticker.Price
 
 	tickers, err := b.GetAllTickers()
@@ -92,7 +103,7 @@ func (b *Bithumb) UpdateTicker(p currency.Pair, assetType string) (ticker.Price,
 }
 
 // GetTickerPrice returns the ticker for a currency pair
-func (b *Bithumb) GetTickerPrice(p currency.Pair, assetType string) (ticker.Price, error) {
+func (b *Bithumb) GetTickerPrice(p currency.Pair, assetType asset.Item) (ticker.Price, error) {
 	tickerNew, err := ticker.GetTicker(b.GetName(), p, assetType)
 	if err != nil {
 		return b.UpdateTicker(p, assetType)
@@ -101,7 +112,7 @@ func (b *Bithumb) GetTickerPrice(p currency.Pair, assetType string) (ticker.Pric
 }
 
 // GetOrderbookEx returns orderbook base on the currency pair
-func (b *Bithumb) GetOrderbookEx(currency currency.Pair, assetType string) (orderbook.Base, error) {
+func (b *Bithumb) GetOrderbookEx(currency currency.Pair, assetType asset.Item) (orderbook.Base, error) {
 	ob, err := orderbook.Get(b.GetName(), currency, assetType)
 	if err != nil {
 		return b.UpdateOrderbook(currency, assetType)
@@ -110,7 +121,7 @@ func (b *Bithumb) GetOrderbookEx(currency currency.Pair, assetType string) (orde
 }
 
 // UpdateOrderbook updates and returns the orderbook for a currency pair
-func (b *Bithumb) UpdateOrderbook(p currency.Pair, assetType string) (orderbook.Base, error) {
+func (b *Bithumb) UpdateOrderbook(p currency.Pair, assetType asset.Item) (orderbook.Base, error) {
 	var orderBook orderbook.Base
 	currency := p.Base.String()
 
@@ -178,16 +189,53 @@ func (b *Bithumb) GetFundingHistory() ([]exchange.FundHistory, error) {
 	return fundHistory, common.ErrFunctionNotSupported
 }
 
-// GetExchangeHistory returns historic trade data since exchange opening.
-func (b *Bithumb) GetExchangeHistory(p currency.Pair, assetType string) ([]exchange.TradeHistory, error) {
-	var resp []exchange.TradeHistory
+// GetExchangeHistory returns the most recent trades for a currency pair
+func (b *Bithumb) GetExchangeHistory(p currency.Pair, assetType asset.Item) ([]exchange.TradeHistory, error) {
+	history, err := b.GetTransactionHistory(p.Base.String())
+	if err != nil {
+		return nil, err
+	}
+
+	resp := make([]exchange.TradeHistory, len(history.Data))
+	for i := range history.Data {
+		t, err := time.ParseInLocation("2006-01-02 15:04:05",
+			history.Data[i].TransactionDate, bithumbTimeZone)
+		if err != nil {
+			return nil, err
+		}
+
+		side := exchange.BuyOrderSide
+		if history.Data[i].Type == "ask" {
+			side = exchange.SellOrderSide
+		}
+
+		resp[i] = exchange.TradeHistory{
+			Timestamp: t,
+			TID:       history.Data[i].ContNumber,
+			ID:        strconv.FormatInt(history.Data[i].ContNumber, 10),
+			Price:     history.Data[i].Price,
+			Amount:    history.Data[i].UnitsTraded,
+			Exchange:  b.Name,
+			Side:      side,
+		}
+	}
+	return resp, nil
+}
+
+// GetHistoricTrades returns historic trade data within the timeframe provided.
+// Bithumb only provides the most recent transactions
+func (b *Bithumb) GetHistoricTrades(p currency.Pair, assetType asset.Item, timestampStart, timestampEnd time.Time) ([]exchange.TradeHistory, error) {
+	return nil, common.ErrFunctionNotSupported
+}
 
-	return resp, common.ErrNotYetImplemented
+// GetHistoricCandles returns candles between the start and end times
+func (b *Bithumb) GetHistoricCandles(p currency.Pair, assetType asset.Item, timestampStart, timestampEnd time.Time, interval kline.Interval) (kline.Item, error) {
+	return kline.Item{}, common.ErrFunctionNotSupported
 }
 
 // SubmitOrder submits a new order
 // TODO: Fill this out to support limit orders
-func (b *Bithumb) SubmitOrder(p currency.Pair, side exchange.OrderSide, _ exchange.OrderType, amount, _ float64, _ string) (exchange.SubmitOrderResponse, error) {
+func (b *Bithumb) SubmitOrder(p currency.Pair, _ asset.Item, side exchange.OrderSide, _ exchange.OrderType, amount, _ float64, _ string) (exchange.SubmitOrderResponse, error) {
 	var submitOrderResponse exchange.SubmitOrderResponse
 	var err error
 	var orderID string
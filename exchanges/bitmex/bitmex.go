@@ -13,8 +13,9 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
-	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/websocket/wshandler"
 	log "github.com/thrasher-corp/gocryptotrader/logger"
 )
@@ -96,10 +97,12 @@ const (
 	bitmexEndpointUserWalletSummary     = "/user/walletSummary"
 	bitmexEndpointUserRequestWithdraw   = "/user/requestWithdrawal"
 
-	// Rate limits - 150 requests per 5 minutes
+	// Rate limits - token buckets of 30 unauthenticated and 60 authenticated
+	// requests refilling over a minute
 	bitmexUnauthRate = 30
-	// 300 requests per 5 minutes
-	bitmexAuthRate = 40
+	bitmexAuthRate   = 60
+	// bitmexRemainingHeader reports the remaining requests of the bucket
+	bitmexRemainingHeader = "X-Ratelimit-Remaining"
 
 	// ContractPerpetual perpetual contract type
 	ContractPerpetual = iota
@@ -111,6 +114,25 @@ const (
 	ContractUpsideProfit
 )
 
+// bitmexTradesLimit is the maximum number of trades or trade buckets returned
+// per request
+const bitmexTradesLimit = 1000
+
+// Instrument types of the perpetual swap and futures contracts
+const (
+	bitmexPerpetualSwapType = "FFWCSX"
+	bitmexFuturesType       = "FFCCSX"
+)
+
+// bitmexBinSizes maps candle intervals to the trade bucket sizes Bitmex
+// supports
+var bitmexBinSizes = map[kline.Interval]string{
+	kline.OneMin:  "1m",
+	kline.FiveMin: "5m",
+	kline.OneHour: "1h",
+	kline.OneDay:  "1d",
+}
+
 // SetDefaults sets the basic defaults for Bitmex
 func (b *Bitmex) SetDefaults() {
 	b.Name = "Bitmex"
@@ -119,28 +141,53 @@ func (b *Bitmex) SetDefaults() {
 	b.RESTPollingDelay = 10
 	b.APIWithdrawPermissions = exchange.AutoWithdrawCryptoWithAPIPermission |
 		exchange.WithdrawCryptoWithEmail |
-		exchange.WithdrawCryptoWith2FA |
-		exchange.NoFiatWithdrawals
+		exchange.WithdrawCryptoWith2FA
 	b.RequestCurrencyPairFormat.Delimiter = ""
 	b.RequestCurrencyPairFormat.Uppercase = true
 	b.ConfigCurrencyPairFormat.Delimiter = ""
 	b.ConfigCurrencyPairFormat.Uppercase = true
-	b.AssetTypes = []string{ticker.Spot}
+	b.AssetTypes = asset.Items{asset.PerpetualSwap, asset.Futures}
 	b.Requester = request.New(b.Name,
-		request.NewRateLimit(time.Second, bitmexAuthRate),
-		request.NewRateLimit(time.Second, bitmexUnauthRate),
+		request.NewRateLimit(time.Minute, bitmexAuthRate),
+		request.NewRateLimit(time.Minute, bitmexUnauthRate),
 		common.NewHTTPClientWithTimeout(exchange.DefaultHTTPTimeout))
+	b.Requester.SetRemainingHeader(true,
+		request.RemainingHeader(bitmexRemainingHeader))
+	b.Requester.SetRemainingHeader(false,
+		request.RemainingHeader(bitmexRemainingHeader))
 	b.APIUrlDefault = bitmexAPIURL
 	b.APIUrl = b.APIUrlDefault
-	b.SupportsAutoPairUpdating = true
+	b.Features = exchange.Features{
+		REST: exchange.ProtocolFeatures{
+			TickerFetching:    true,
+			OrderbookFetching: true,
+			TradeFetching:     true,
+			HistoricTrades:    true,
+			KlineFetching:     true,
+			AutoPairUpdates:   true,
+			AccountInfo:       true,
+			ActiveOrders:      true,
+			OrderHistory:      true,
+			SubmitOrder:       true,
+			ModifyOrder:       true,
+			CancelOrder:       true,
+			CancelOrders:      true,
+			DepositAddress:    true,
+			CryptoWithdrawal:  true,
+		},
+		Websocket: exchange.ProtocolFeatures{
+			OrderbookFetching:      true,
+			TradeFetching:          true,
+			AccountInfo:            true,
+			Subscribe:              true,
+			Unsubscribe:            true,
+			AuthenticatedEndpoints: true,
+			DeadMansSwitch:         true,
+		},
+		OrderTypes: []exchange.OrderType{exchange.LimitOrderType},
+	}
 	b.Websocket = wshandler.New()
-	b.Websocket.Functionality = wshandler.WebsocketTradeDataSupported |
-		wshandler.WebsocketOrderbookSupported |
-		wshandler.WebsocketSubscribeSupported |
-		wshandler.WebsocketUnsubscribeSupported |
-		wshandler.WebsocketAuthenticatedEndpointsSupported |
-		wshandler.WebsocketAccountDataSupported |
-		wshandler.WebsocketDeadMansSwitchSupported
+	b.Websocket.Functionality = b.Features.Websocket.Functionality()
 	b.WebsocketResponseMaxLimit = exchange.DefaultWebsocketResponseMaxLimit
 	b.WebsocketResponseCheckTimeout = exchange.DefaultWebsocketResponseCheckTimeout
 	b.WebsocketOrderbookBufferLimit = exchange.DefaultWebsocketOrderbookBufferLimit
@@ -158,6 +205,7 @@ func (b *Bitmex) Setup(exch *config.ExchangeConfig) {
 		b.RESTPollingDelay = exch.RESTPollingDelay
 		b.Verbose = exch.Verbose
 		b.HTTPDebugging = exch.HTTPDebugging
+		b.SetHTTPRetryPolicy(exch.HTTPRetry)
 		b.Websocket.SetWsStatusAndConnection(exch.Websocket)
 		b.BaseCurrencies = exch.BaseCurrencies
 		b.AvailablePairs = exch.AvailablePairs
@@ -635,8 +683,8 @@ func (b *Bitmex) GetTrade(params *GenericRequestParams) ([]Trade, error) {
 }
 
 // GetPreviousTrades previous trade history in time buckets
-func (b *Bitmex) GetPreviousTrades(params *TradeGetBucketedParams) ([]Trade, error) {
-	var trade []Trade
+func (b *Bitmex) GetPreviousTrades(params *TradeGetBucketedParams) ([]TradeBucket, error) {
+	var trade []TradeBucket
 
 	return trade, b.SendHTTPRequest(bitmexEndpointTradeBucketed,
 		params,
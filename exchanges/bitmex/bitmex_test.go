@@ -11,6 +11,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/sharedtestvalues"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/websocket/wshandler"
 )
@@ -49,6 +50,21 @@ func TestStart(t *testing.T) {
 	testWg.Wait()
 }
 
+func TestUnsupportedAssetType(t *testing.T) {
+	p := currency.NewPairFromString("XBTUSD")
+	_, err := b.UpdateTicker(p, asset.Spot)
+	if err != asset.ErrNotSupported {
+		t.Errorf("Test failed - UpdateTicker() expected %s got %v",
+			asset.ErrNotSupported, err)
+	}
+
+	_, err = b.UpdateOrderbook(p, asset.Spot)
+	if err != asset.ErrNotSupported {
+		t.Errorf("Test failed - UpdateOrderbook() expected %s got %v",
+			asset.ErrNotSupported, err)
+	}
+}
+
 func TestGetUrgentAnnouncement(t *testing.T) {
 	_, err := b.GetUrgentAnnouncement()
 	if err == nil {
@@ -529,7 +545,7 @@ func TestSubmitOrder(t *testing.T) {
 		Base:      currency.XBT,
 		Quote:     currency.USD,
 	}
-	response, err := b.SubmitOrder(p, exchange.BuyOrderSide, exchange.MarketOrderType, 1, 1, "clientId")
+	response, err := b.SubmitOrder(p, asset.PerpetualSwap, exchange.BuyOrderSide, exchange.MarketOrderType, 1, 1, "clientId")
 	if areTestAPIKeysSet() && (err != nil || !response.IsOrderPlaced) {
 		t.Errorf("Order failed to be placed: %v", err)
 	} else if !areTestAPIKeysSet() && err == nil {
@@ -12,6 +12,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/websocket/wshandler"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/websocket/wsorderbook"
@@ -201,8 +202,7 @@ func (b *Bitmex) wsHandleIncomingData() {
 					}
 
 					p := currency.NewPairFromString(orderbooks.Data[0].Symbol)
-					// TODO: update this to support multiple asset types
-					err = b.processOrderbook(orderbooks.Data, orderbooks.Action, p, "CONTRACT")
+					err = b.processOrderbook(orderbooks.Data, orderbooks.Action, p, b.getAssetType(p))
 					if err != nil {
 						b.Websocket.DataHandler <- err
 						continue
@@ -227,14 +227,14 @@ func (b *Bitmex) wsHandleIncomingData() {
 							b.Websocket.DataHandler <- err
 							continue
 						}
-						// TODO: update this to support multiple asset types
+						p := currency.NewPairFromString(trades.Data[i].Symbol)
 						b.Websocket.DataHandler <- wshandler.TradeData{
 							Timestamp:    timestamp,
 							Price:        trades.Data[i].Price,
 							Amount:       float64(trades.Data[i].Size),
-							CurrencyPair: currency.NewPairFromString(trades.Data[i].Symbol),
+							CurrencyPair: p,
 							Exchange:     b.GetName(),
-							AssetType:    "CONTRACT",
+							AssetType:    b.getAssetType(p),
 							Side:         trades.Data[i].Side,
 						}
 					}
@@ -326,7 +326,7 @@ func (b *Bitmex) wsHandleIncomingData() {
 }
 
 // ProcessOrderbook processes orderbook updates
-func (b *Bitmex) processOrderbook(data []OrderBookL2, action string, currencyPair currency.Pair, assetType string) error { // nolint: unparam
+func (b *Bitmex) processOrderbook(data []OrderBookL2, action string, currencyPair currency.Pair, assetType asset.Item) error {
 	if len(data) < 1 {
 		return errors.New("bitmex_websocket.go error - no orderbook data")
 	}
@@ -340,12 +340,14 @@ func (b *Bitmex) processOrderbook(data []OrderBookL2, action string, currencyPai
 				asks = append(asks, orderbook.Item{
 					Price:  data[i].Price,
 					Amount: float64(data[i].Size),
+					ID:     data[i].ID,
 				})
 				continue
 			}
 			bids = append(bids, orderbook.Item{
 				Price:  data[i].Price,
 				Amount: float64(data[i].Size),
+				ID:     data[i].ID,
 			})
 		}
 
@@ -374,12 +376,14 @@ func (b *Bitmex) processOrderbook(data []OrderBookL2, action string, currencyPai
 				asks = append(asks, orderbook.Item{
 					Price:  data[i].Price,
 					Amount: float64(data[i].Size),
+					ID:     data[i].ID,
 				})
 				continue
 			}
 			bids = append(bids, orderbook.Item{
 				Price:  data[i].Price,
 				Amount: float64(data[i].Size),
+				ID:     data[i].ID,
 			})
 		}
 
@@ -404,9 +408,27 @@ func (b *Bitmex) processOrderbook(data []OrderBookL2, action string, currencyPai
 	return nil
 }
 
+// getAssetType returns the asset type of a contract, contracts which aren't
+// enabled futures are perpetual swaps
+func (b *Bitmex) getAssetType(p currency.Pair) asset.Item {
+	if b.GetEnabledPairs(asset.Futures).Contains(p, true) {
+		return asset.Futures
+	}
+	return asset.PerpetualSwap
+}
+
+// getEnabledContracts returns the enabled contracts of every asset type
+func (b *Bitmex) getEnabledContracts() currency.Pairs {
+	var contracts currency.Pairs
+	for _, a := range b.GetAssetTypes() {
+		contracts = append(contracts, b.GetEnabledPairs(a)...)
+	}
+	return contracts
+}
+
 // GenerateDefaultSubscriptions Adds default subscriptions to websocket to be handled by ManageSubscriptions()
 func (b *Bitmex) GenerateDefaultSubscriptions() {
-	contracts := b.GetEnabledCurrencies()
+	contracts := b.getEnabledContracts()
 	channels := []string{bitmexWSOrderbookL2, bitmexWSTrade}
 	subscriptions := []wshandler.WebsocketChannelSubscription{
 		{
@@ -430,7 +452,7 @@ func (b *Bitmex) GenerateAuthenticatedSubscriptions() {
 	if !b.Websocket.CanUseAuthenticatedEndpoints() {
 		return
 	}
-	contracts := b.GetEnabledCurrencies()
+	contracts := b.getEnabledContracts()
 	channels := []string{bitmexWSExecution,
 		bitmexWSPosition,
 	}
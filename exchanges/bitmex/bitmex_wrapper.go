@@ -1,15 +1,19 @@
 package bitmex
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"math"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/websocket/wshandler"
@@ -25,6 +29,14 @@ func (b *Bitmex) Start(wg *sync.WaitGroup) {
 	}()
 }
 
+// WithContext returns a copy of Bitmex whose HTTP requests are abandoned once
+// the context is done
+func (b *Bitmex) WithContext(ctx context.Context) exchange.IBotExchange {
+	cp := *b
+	cp.Base = b.ContextBase(ctx)
+	return &cp
+}
+
 // Run implements the Bitmex wrapper
 func (b *Bitmex) Run() {
 	if b.Verbose {
@@ -36,32 +48,46 @@ func (b *Bitmex) Run() {
 	marketInfo, err := b.GetActiveInstruments(&GenericRequestParams{})
 	if err != nil {
 		log.Errorf("%s Failed to get available symbols.\n", b.GetName())
-	} else {
-		var exchangeProducts []string
-		for i := range marketInfo {
-			exchangeProducts = append(exchangeProducts, marketInfo[i].Symbol)
-		}
+		return
+	}
 
-		var NewExchangeProducts currency.Pairs
-		for _, p := range exchangeProducts {
-			NewExchangeProducts = append(NewExchangeProducts,
-				currency.NewPairFromString(p))
+	var swaps, futures currency.Pairs
+	for i := range marketInfo {
+		switch marketInfo[i].Typ {
+		case bitmexPerpetualSwapType:
+			swaps = append(swaps, currency.NewPairFromString(marketInfo[i].Symbol))
+		case bitmexFuturesType:
+			futures = append(futures, currency.NewPairFromString(marketInfo[i].Symbol))
 		}
+	}
 
-		err = b.UpdateCurrencies(NewExchangeProducts, false, false)
-		if err != nil {
-			log.Errorf("%s Failed to update available currencies.\n", b.GetName())
-		}
+	err = b.UpdateAssetPairs(asset.PerpetualSwap, swaps, false, false)
+	if err != nil {
+		log.Errorf("%s Failed to update available %s currencies.\n", b.GetName(),
+			asset.PerpetualSwap)
+	}
+
+	if !b.SupportsAsset(asset.Futures) {
+		return
+	}
+
+	err = b.UpdateAssetPairs(asset.Futures, futures, false, false)
+	if err != nil {
+		log.Errorf("%s Failed to update available %s currencies.\n", b.GetName(),
+			asset.Futures)
 	}
 }
 
 // UpdateTicker updates and returns the ticker for a currency pair
-func (b *Bitmex) UpdateTicker(p currency.Pair, assetType string) (ticker.Price, error) {
+func (b *Bitmex) UpdateTicker(p currency.Pair, assetType asset.Item) (ticker.Price, error) {
 	var tickerPrice ticker.Price
-	currency := exchange.FormatExchangeCurrency(b.Name, p)
+	symbol, err := b.FormatExchangePair(p, assetType)
+	if err != nil {
+		return tickerPrice, err
+	}
 
 	tick, err := b.GetTrade(&GenericRequestParams{
-		Symbol:  currency.String(),
+		Symbol:  symbol.String(),
 		Reverse: true,
 		Count:   1})
 	if err != nil {
@@ -79,7 +105,7 @@ func (b *Bitmex) UpdateTicker(p currency.Pair, assetType string) (ticker.Price,
 }
 
 // GetTickerPrice returns the ticker for a currency pair
-func (b *Bitmex) GetTickerPrice(p currency.Pair, assetType string) (ticker.Price, error) {
+func (b *Bitmex) GetTickerPrice(p currency.Pair, assetType asset.Item) (ticker.Price, error) {
 	tickerNew, err := ticker.GetTicker(b.GetName(), p, assetType)
 	if err != nil {
 		return b.UpdateTicker(p, assetType)
@@ -88,7 +114,7 @@ func (b *Bitmex) GetTickerPrice(p currency.Pair, assetType string) (ticker.Price
 }
 
 // GetOrderbookEx returns orderbook base on the currency pair
-func (b *Bitmex) GetOrderbookEx(currency currency.Pair, assetType string) (orderbook.Base, error) {
+func (b *Bitmex) GetOrderbookEx(currency currency.Pair, assetType asset.Item) (orderbook.Base, error) {
 	ob, err := orderbook.Get(b.GetName(), currency, assetType)
 	if err != nil {
 		return b.UpdateOrderbook(currency, assetType)
@@ -97,11 +123,15 @@ func (b *Bitmex) GetOrderbookEx(currency currency.Pair, assetType string) (order
 }
 
 // UpdateOrderbook updates and returns the orderbook for a currency pair
-func (b *Bitmex) UpdateOrderbook(p currency.Pair, assetType string) (orderbook.Base, error) {
+func (b *Bitmex) UpdateOrderbook(p currency.Pair, assetType asset.Item) (orderbook.Base, error) {
 	var orderBook orderbook.Base
+	symbol, err := b.FormatExchangePair(p, assetType)
+	if err != nil {
+		return orderBook, err
+	}
 
 	orderbookNew, err := b.GetOrderbook(OrderBookGetL2Params{
-		Symbol: exchange.FormatExchangeCurrency(b.Name, p).String(),
+		Symbol: symbol.String(),
 		Depth:  500})
 	if err != nil {
 		return orderBook, err
@@ -165,15 +195,142 @@ func (b *Bitmex) GetFundingHistory() ([]exchange.FundHistory, error) {
 	return nil, common.ErrNotYetImplemented
 }
 
-// GetExchangeHistory returns historic trade data since exchange opening.
-func (b *Bitmex) GetExchangeHistory(p currency.Pair, assetType string) ([]exchange.TradeHistory, error) {
+// GetExchangeHistory returns the most recent trades for a currency pair
+func (b *Bitmex) GetExchangeHistory(p currency.Pair, assetType asset.Item) ([]exchange.TradeHistory, error) {
+	trades, err := b.GetTrade(&GenericRequestParams{
+		Symbol:  b.FormatExchangeCurrency(p).String(),
+		Count:   bitmexTradesLimit,
+		Reverse: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return b.convertTrades(trades)
+}
+
+// GetHistoricTrades returns the trades for a currency pair between the start
+// and end times
+func (b *Bitmex) GetHistoricTrades(p currency.Pair, assetType asset.Item, timestampStart, timestampEnd time.Time) ([]exchange.TradeHistory, error) {
+	err := exchange.CheckTimeRange(timestampStart, timestampEnd)
+	if err != nil {
+		return nil, err
+	}
+
+	params := GenericRequestParams{
+		Symbol:    b.FormatExchangeCurrency(p).String(),
+		Count:     bitmexTradesLimit,
+		StartTime: timestampStart.UTC().Format(time.RFC3339Nano),
+		EndTime:   timestampEnd.UTC().Format(time.RFC3339Nano),
+	}
+
 	var resp []exchange.TradeHistory
+	for {
+		trades, err := b.GetTrade(&params)
+		if err != nil {
+			return nil, err
+		}
+
+		converted, err := b.convertTrades(trades)
+		if err != nil {
+			return nil, err
+		}
+		resp = append(resp, converted...)
+
+		if len(trades) < int(params.Count) {
+			break
+		}
+		params.Start += params.Count
+	}
+
+	exchange.FilterTradesByTimeRange(&resp, timestampStart, timestampEnd)
+	return resp, nil
+}
 
-	return resp, common.ErrNotYetImplemented
+// GetHistoricCandles returns candles between the start and end times. Bin
+// sizes Bitmex does not provide are built from historic trades
+func (b *Bitmex) GetHistoricCandles(p currency.Pair, assetType asset.Item, timestampStart, timestampEnd time.Time, interval kline.Interval) (kline.Item, error) {
+	err := kline.CheckRequest(timestampStart, timestampEnd, interval)
+	if err != nil {
+		return kline.Item{}, err
+	}
+
+	binSize, ok := bitmexBinSizes[interval]
+	if !ok {
+		return exchange.CreateKlineFromTrades(b, p, assetType, timestampStart,
+			timestampEnd, interval)
+	}
+
+	item := kline.Item{
+		Exchange:  b.Name,
+		Pair:      p,
+		AssetType: assetType,
+		Interval:  interval,
+	}
+
+	symbol := b.FormatExchangeCurrency(p).String()
+	dates := kline.CalculateCandleDateRanges(timestampStart, timestampEnd,
+		interval, bitmexTradesLimit)
+	for x := range dates {
+		// Bucket timestamps are the end of the bucket
+		buckets, err := b.GetPreviousTrades(&TradeGetBucketedParams{
+			Symbol:    symbol,
+			BinSize:   binSize,
+			Count:     bitmexTradesLimit,
+			StartTime: dates[x].Start.Add(interval.Duration()).UTC().Format(time.RFC3339),
+			EndTime:   dates[x].End.UTC().Format(time.RFC3339),
+		})
+		if err != nil {
+			return kline.Item{}, err
+		}
+
+		for i := range buckets {
+			t, err := time.Parse(time.RFC3339Nano, buckets[i].Timestamp)
+			if err != nil {
+				return kline.Item{}, err
+			}
+
+			item.Candles = append(item.Candles, kline.Candle{
+				Time:   t.Add(-interval.Duration()),
+				Open:   buckets[i].Open,
+				High:   buckets[i].High,
+				Low:    buckets[i].Low,
+				Close:  buckets[i].Close,
+				Volume: buckets[i].Volume,
+			})
+		}
+	}
+
+	item.FilterCandles(timestampStart, timestampEnd)
+	return item, nil
+}
+
+func (b *Bitmex) convertTrades(trades []Trade) ([]exchange.TradeHistory, error) {
+	resp := make([]exchange.TradeHistory, len(trades))
+	for i := range trades {
+		t, err := time.Parse(time.RFC3339Nano, trades[i].Timestamp)
+		if err != nil {
+			return nil, err
+		}
+
+		side := exchange.BuyOrderSide
+		if trades[i].Side == "Sell" {
+			side = exchange.SellOrderSide
+		}
+
+		resp[i] = exchange.TradeHistory{
+			Timestamp: t,
+			ID:        trades[i].TrdMatchID,
+			Price:     trades[i].Price,
+			Amount:    float64(trades[i].Size),
+			Exchange:  b.Name,
+			Side:      side,
+		}
+	}
+	return resp, nil
 }
 
 // SubmitOrder submits a new order
-func (b *Bitmex) SubmitOrder(p currency.Pair, side exchange.OrderSide, orderType exchange.OrderType, amount, price float64, _ string) (exchange.SubmitOrderResponse, error) {
+func (b *Bitmex) SubmitOrder(p currency.Pair, _ asset.Item, side exchange.OrderSide, orderType exchange.OrderType, amount, price float64, clientID string) (exchange.SubmitOrderResponse, error) {
 	var submitOrderResponse exchange.SubmitOrderResponse
 
 	if math.Mod(amount, 1) != 0 {
@@ -186,6 +343,7 @@ func (b *Bitmex) SubmitOrder(p currency.Pair, side exchange.OrderSide, orderType
 		Symbol:   p.String(),
 		OrderQty: amount,
 		Side:     side.ToString(),
+		ClOrdID:  clientID,
 	}
 
 	if orderType == exchange.LimitOrderType {
@@ -337,6 +495,7 @@ func (b *Bitmex) GetActiveOrders(getOrdersRequest *exchange.GetOrdersRequest) ([
 			Amount:    float64(resp[i].OrderQty),
 			Exchange:  b.Name,
 			ID:        resp[i].OrderID,
+			ClientID:  resp[i].ClOrdID,
 			OrderSide: orderSide,
 			OrderType: orderType,
 			Status:    resp[i].OrdStatus,
@@ -380,6 +539,7 @@ func (b *Bitmex) GetOrderHistory(getOrdersRequest *exchange.GetOrdersRequest) ([
 			Amount:    float64(resp[i].OrderQty),
 			Exchange:  b.Name,
 			ID:        resp[i].OrderID,
+			ClientID:  resp[i].ClOrdID,
 			OrderSide: orderSide,
 			OrderType: orderType,
 			Status:    resp[i].OrdStatus,
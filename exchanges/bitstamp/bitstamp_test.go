@@ -6,6 +6,7 @@ import (
 
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 )
 
 // Please add your private keys and customerID for better tests
@@ -380,8 +381,7 @@ func TestSubmitOrder(t *testing.T) {
 		Base:      currency.BTC,
 		Quote:     currency.USD,
 	}
-	response, err := b.SubmitOrder(p,
-		exchange.BuyOrderSide,
+	response, err := b.SubmitOrder(p, asset.Spot, exchange.BuyOrderSide,
 		exchange.MarketOrderType,
 		1,
 		1,
@@ -9,8 +9,8 @@ import (
 	"github.com/gorilla/websocket"
 	"github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
-	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/websocket/wshandler"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/websocket/wsorderbook"
 	log "github.com/thrasher-corp/gocryptotrader/logger"
@@ -90,7 +90,7 @@ func (b *Bitstamp) WsHandleData() {
 				currencyPair := common.SplitStrings(wsResponse.Channel, "_")
 				p := currency.NewPairFromString(common.StringToUpper(currencyPair[3]))
 
-				err = b.wsUpdateOrderbook(wsOrderBookTemp.Data, p, ticker.Spot)
+				err = b.wsUpdateOrderbook(wsOrderBookTemp.Data, p, asset.Spot)
 				if err != nil {
 					b.Websocket.DataHandler <- err
 					continue
@@ -113,7 +113,7 @@ func (b *Bitstamp) WsHandleData() {
 					Amount:       wsTradeTemp.Data.Amount,
 					CurrencyPair: p,
 					Exchange:     b.GetName(),
-					AssetType:    ticker.Spot,
+					AssetType:    asset.Spot,
 				}
 			}
 		}
@@ -156,7 +156,7 @@ func (b *Bitstamp) Unsubscribe(channelToSubscribe wshandler.WebsocketChannelSubs
 	return b.WebsocketConn.SendMessage(req)
 }
 
-func (b *Bitstamp) wsUpdateOrderbook(update websocketOrderBook, p currency.Pair, assetType string) error {
+func (b *Bitstamp) wsUpdateOrderbook(update websocketOrderBook, p currency.Pair, assetType asset.Item) error {
 	if len(update.Asks) == 0 && len(update.Bids) == 0 {
 		return errors.New("bitstamp_websocket.go error - no orderbook data")
 	}
@@ -202,7 +202,7 @@ func (b *Bitstamp) wsUpdateOrderbook(update websocketOrderBook, p currency.Pair,
 		Asks:         asks,
 		CurrencyPair: p,
 		UpdateID:     update.Timestamp,
-		AssetType:    orderbook.Spot,
+		AssetType:    asset.Spot,
 	})
 	if err != nil {
 		return err
@@ -245,7 +245,7 @@ func (b *Bitstamp) seedOrderBook() error {
 		newOrderBook.Asks = asks
 		newOrderBook.Bids = bids
 		newOrderBook.Pair = p[x]
-		newOrderBook.AssetType = ticker.Spot
+		newOrderBook.AssetType = asset.Spot
 
 		err = b.Websocket.Orderbook.LoadSnapshot(&newOrderBook, false)
 		if err != nil {
@@ -254,7 +254,7 @@ func (b *Bitstamp) seedOrderBook() error {
 
 		b.Websocket.DataHandler <- wshandler.WebsocketOrderbookUpdate{
 			Pair:     p[x],
-			Asset:    ticker.Spot,
+			Asset:    asset.Spot,
 			Exchange: b.GetName(),
 		}
 	}
@@ -12,8 +12,8 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
-	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/websocket/wshandler"
 	log "github.com/thrasher-corp/gocryptotrader/logger"
 )
@@ -71,19 +71,35 @@ func (b *Bittrex) SetDefaults() {
 	b.Enabled = false
 	b.Verbose = false
 	b.RESTPollingDelay = 10
-	b.APIWithdrawPermissions = exchange.AutoWithdrawCryptoWithAPIPermission |
-		exchange.NoFiatWithdrawals
+	b.APIWithdrawPermissions = exchange.AutoWithdrawCryptoWithAPIPermission
 	b.RequestCurrencyPairFormat.Delimiter = "-"
 	b.RequestCurrencyPairFormat.Uppercase = true
 	b.ConfigCurrencyPairFormat.Delimiter = "-"
 	b.ConfigCurrencyPairFormat.Uppercase = true
-	b.AssetTypes = []string{ticker.Spot}
-	b.SupportsAutoPairUpdating = true
-	b.SupportsRESTTickerBatching = true
+	b.AssetTypes = asset.Items{asset.Spot}
+	b.Features = exchange.Features{
+		REST: exchange.ProtocolFeatures{
+			TickerFetching:    true,
+			TickerBatching:    true,
+			OrderbookFetching: true,
+			TradeFetching:     true,
+			AutoPairUpdates:   true,
+			AccountInfo:       true,
+			ActiveOrders:      true,
+			OrderHistory:      true,
+			SubmitOrder:       true,
+			CancelOrder:       true,
+			CancelOrders:      true,
+			DepositAddress:    true,
+			CryptoWithdrawal:  true,
+		},
+		OrderTypes: []exchange.OrderType{exchange.LimitOrderType},
+	}
 	b.Requester = request.New(b.Name,
 		request.NewRateLimit(time.Second, bittrexAuthRate),
 		request.NewRateLimit(time.Second, bittrexUnauthRate),
 		common.NewHTTPClientWithTimeout(exchange.DefaultHTTPTimeout))
+	b.setEndpoints()
 	b.APIUrlDefault = bittrexAPIURL
 	b.APIUrl = b.APIUrlDefault
 	b.Websocket = wshandler.New()
@@ -99,6 +115,7 @@ func (b *Bittrex) Setup(exch *config.ExchangeConfig) {
 		b.SetAPIKeys(exch.APIKey, exch.APISecret, exch.ClientID, false)
 		b.SetHTTPClientTimeout(exch.HTTPTimeout)
 		b.SetHTTPClientUserAgent(exch.HTTPUserAgent)
+		b.SetHTTPRetryPolicy(exch.HTTPRetry)
 		b.RESTPollingDelay = exch.RESTPollingDelay
 		b.Verbose = exch.Verbose
 		b.HTTPDebugging = exch.HTTPDebugging
@@ -507,6 +524,29 @@ func (b *Bittrex) SendHTTPRequest(path string, result interface{}) error {
 		b.HTTPRecording)
 }
 
+// setEndpoints declares which authenticated endpoints are safe to retry, as
+// orders are placed, cancelled and withdrawn over GET requests
+func (b *Bittrex) setEndpoints() {
+	for _, path := range []string{bittrexAPIBuyLimit, bittrexAPISellLimit,
+		bittrexAPICancel, bittrexAPIWithdraw} {
+		b.Requester.SetEndpoint(http.MethodGet, bittrexPath(path),
+			request.Endpoint{Weight: 1, Idempotency: request.NotIdempotent})
+	}
+
+	for _, path := range []string{bittrexAPIGetOpenOrders,
+		bittrexAPIGetBalances, bittrexAPIGetBalance, bittrexAPIGetOrder,
+		bittrexAPIGetOrderHistory, bittrexAPIGetWithdrawalHistory,
+		bittrexAPIGetDepositHistory} {
+		b.Requester.SetEndpoint(http.MethodGet, bittrexPath(path),
+			request.Endpoint{Weight: 1, Idempotency: request.Idempotent})
+	}
+}
+
+// bittrexPath returns the URL path of an API method
+func bittrexPath(method string) string {
+	return fmt.Sprintf("/api/%s/%s", bittrexAPIVersion, method)
+}
+
 // SendAuthenticatedHTTPRequest sends an authenticated http request to a desired
 // path
 func (b *Bittrex) SendAuthenticatedHTTPRequest(path string, values url.Values, result interface{}) (err error) {
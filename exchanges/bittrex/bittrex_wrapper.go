@@ -1,8 +1,10 @@
 package bittrex
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -10,6 +12,8 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/websocket/wshandler"
@@ -25,6 +29,14 @@ func (b *Bittrex) Start(wg *sync.WaitGroup) {
 	}()
 }
 
+// WithContext returns a copy of Bittrex whose HTTP requests are abandoned once
+// the context is done
+func (b *Bittrex) WithContext(ctx context.Context) exchange.IBotExchange {
+	cp := *b
+	cp.Base = b.ContextBase(ctx)
+	return &cp
+}
+
 // Run implements the Bittrex wrapper
 func (b *Bittrex) Run() {
 	if b.Verbose {
@@ -102,7 +114,7 @@ func (b *Bittrex) GetAccountInfo() (exchange.AccountInfo, error) {
 }
 
 // UpdateTicker updates and returns the ticker for a currency pair
-func (b *Bittrex) UpdateTicker(p currency.Pair, assetType string) (ticker.Price, error) {
+func (b *Bittrex) UpdateTicker(p currency.Pair, assetType asset.Item) (ticker.Price, error) {
 	var tickerPrice ticker.Price
 	tick, err := b.GetMarketSummaries()
 	if err != nil {
@@ -110,7 +122,7 @@ func (b *Bittrex) UpdateTicker(p currency.Pair, assetType string) (ticker.Price,
 	}
 
 	for _, x := range b.GetEnabledCurrencies() {
-		curr := exchange.FormatExchangeCurrency(b.Name, x)
+		curr := b.FormatExchangeCurrency(x)
 		for y := range tick.Result {
 			if tick.Result[y].MarketName != curr.String() {
 				continue
@@ -129,8 +141,8 @@ func (b *Bittrex) UpdateTicker(p currency.Pair, assetType string) (ticker.Price,
 }
 
 // GetTickerPrice returns the ticker for a currency pair
-func (b *Bittrex) GetTickerPrice(p currency.Pair, assetType string) (ticker.Price, error) {
-	tick, err := ticker.GetTicker(b.GetName(), p, ticker.Spot)
+func (b *Bittrex) GetTickerPrice(p currency.Pair, assetType asset.Item) (ticker.Price, error) {
+	tick, err := ticker.GetTicker(b.GetName(), p, asset.Spot)
 	if err != nil {
 		return b.UpdateTicker(p, assetType)
 	}
@@ -138,7 +150,7 @@ func (b *Bittrex) GetTickerPrice(p currency.Pair, assetType string) (ticker.Pric
 }
 
 // GetOrderbookEx returns the orderbook for a currency pair
-func (b *Bittrex) GetOrderbookEx(p currency.Pair, assetType string) (orderbook.Base, error) {
+func (b *Bittrex) GetOrderbookEx(p currency.Pair, assetType asset.Item) (orderbook.Base, error) {
 	ob, err := orderbook.Get(b.GetName(), p, assetType)
 	if err != nil {
 		return b.UpdateOrderbook(p, assetType)
@@ -147,9 +159,9 @@ func (b *Bittrex) GetOrderbookEx(p currency.Pair, assetType string) (orderbook.B
 }
 
 // UpdateOrderbook updates and returns the orderbook for a currency pair
-func (b *Bittrex) UpdateOrderbook(p currency.Pair, assetType string) (orderbook.Base, error) {
+func (b *Bittrex) UpdateOrderbook(p currency.Pair, assetType asset.Item) (orderbook.Base, error) {
 	var orderBook orderbook.Base
-	orderbookNew, err := b.GetOrderbook(exchange.FormatExchangeCurrency(b.GetName(), p).String())
+	orderbookNew, err := b.GetOrderbook(b.FormatExchangeCurrency(p).String())
 	if err != nil {
 		return orderBook, err
 	}
@@ -191,15 +203,53 @@ func (b *Bittrex) GetFundingHistory() ([]exchange.FundHistory, error) {
 	return fundHistory, common.ErrFunctionNotSupported
 }
 
-// GetExchangeHistory returns historic trade data since exchange opening.
-func (b *Bittrex) GetExchangeHistory(p currency.Pair, assetType string) ([]exchange.TradeHistory, error) {
-	var resp []exchange.TradeHistory
+// GetExchangeHistory returns the most recent trades for a currency pair
+func (b *Bittrex) GetExchangeHistory(p currency.Pair, assetType asset.Item) ([]exchange.TradeHistory, error) {
+	history, err := b.GetMarketHistory(b.FormatExchangeCurrency(p).String())
+	if err != nil {
+		return nil, err
+	}
+
+	resp := make([]exchange.TradeHistory, len(history.Result))
+	for i := range history.Result {
+		t, err := time.ParseInLocation("2006-01-02T15:04:05.999999999",
+			history.Result[i].Timestamp, time.UTC)
+		if err != nil {
+			return nil, err
+		}
+
+		side := exchange.BuyOrderSide
+		if history.Result[i].OrderType == "SELL" {
+			side = exchange.SellOrderSide
+		}
+
+		resp[i] = exchange.TradeHistory{
+			Timestamp: t,
+			TID:       int64(history.Result[i].ID),
+			ID:        strconv.Itoa(history.Result[i].ID),
+			Price:     history.Result[i].Price,
+			Amount:    history.Result[i].Quantity,
+			Exchange:  b.Name,
+			Side:      side,
+			Type:      history.Result[i].FillType,
+		}
+	}
+	return resp, nil
+}
+
+// GetHistoricTrades returns historic trade data within the timeframe provided.
+// Bittrex only provides the most recent trades
+func (b *Bittrex) GetHistoricTrades(p currency.Pair, assetType asset.Item, timestampStart, timestampEnd time.Time) ([]exchange.TradeHistory, error) {
+	return nil, common.ErrFunctionNotSupported
+}
 
-	return resp, common.ErrNotYetImplemented
+// GetHistoricCandles returns candles between the start and end times
+func (b *Bittrex) GetHistoricCandles(p currency.Pair, assetType asset.Item, timestampStart, timestampEnd time.Time, interval kline.Interval) (kline.Item, error) {
+	return kline.Item{}, common.ErrFunctionNotSupported
 }
 
 // SubmitOrder submits a new order
-func (b *Bittrex) SubmitOrder(p currency.Pair, side exchange.OrderSide, orderType exchange.OrderType, amount, price float64, _ string) (exchange.SubmitOrderResponse, error) {
+func (b *Bittrex) SubmitOrder(p currency.Pair, _ asset.Item, side exchange.OrderSide, orderType exchange.OrderType, amount, price float64, _ string) (exchange.SubmitOrderResponse, error) {
 	var submitOrderResponse exchange.SubmitOrderResponse
 	buy := side == exchange.BuyOrderSide
 	var response UUID
@@ -12,6 +12,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/websocket/wshandler"
 	log "github.com/thrasher-corp/gocryptotrader/logger"
@@ -84,7 +85,7 @@ func (b *BTSE) WsHandleData() {
 					b.Websocket.DataHandler <- wshandler.TradeData{
 						Timestamp:    time.Unix(tradeHistory.Data[x].TransactionTime, 0),
 						CurrencyPair: currency.NewPairFromString(strings.Replace(tradeHistory.Topic, "tradeHistory", "", 1)),
-						AssetType:    orderbook.Spot,
+						AssetType:    asset.Spot,
 						Exchange:     b.Name,
 						Price:        tradeHistory.Data[x].Price,
 						Amount:       tradeHistory.Data[x].Amount,
@@ -133,7 +134,7 @@ func (b *BTSE) WsHandleData() {
 				var newOB orderbook.Base
 				newOB.Asks = asks
 				newOB.Bids = bids
-				newOB.AssetType = orderbook.Spot
+				newOB.AssetType = asset.Spot
 				newOB.Pair = currency.NewPairFromString(t.Topic[strings.Index(t.Topic, ":")+1 : strings.Index(t.Topic, "_")])
 				newOB.ExchangeName = b.Name
 				err = b.Websocket.Orderbook.LoadSnapshot(&newOB, true)
@@ -142,7 +143,7 @@ func (b *BTSE) WsHandleData() {
 					continue
 				}
 				b.Websocket.DataHandler <- wshandler.WebsocketOrderbookUpdate{Pair: newOB.Pair,
-					Asset:    orderbook.Spot,
+					Asset:    asset.Spot,
 					Exchange: b.Name}
 			default:
 				log.Warnf("%s: unhandled websocket response: %s", b.Name, resp.Raw)
@@ -1,6 +1,7 @@
 package btse
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"strings"
@@ -10,6 +11,8 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/websocket/wshandler"
@@ -25,6 +28,14 @@ func (b *BTSE) Start(wg *sync.WaitGroup) {
 	}()
 }
 
+// WithContext returns a copy of BTSE whose HTTP requests are abandoned once
+// the context is done
+func (b *BTSE) WithContext(ctx context.Context) exchange.IBotExchange {
+	cp := *b
+	cp.Base = b.ContextBase(ctx)
+	return &cp
+}
+
 // Run implements the BTSE wrapper
 func (b *BTSE) Run() {
 	if b.Verbose {
@@ -55,15 +66,15 @@ func (b *BTSE) Run() {
 }
 
 // UpdateTicker updates and returns the ticker for a currency pair
-func (b *BTSE) UpdateTicker(p currency.Pair, assetType string) (ticker.Price, error) {
+func (b *BTSE) UpdateTicker(p currency.Pair, assetType asset.Item) (ticker.Price, error) {
 	var tickerPrice ticker.Price
 
-	t, err := b.GetTicker(exchange.FormatExchangeCurrency(b.Name, p).String())
+	t, err := b.GetTicker(b.FormatExchangeCurrency(p).String())
 	if err != nil {
 		return tickerPrice, err
 	}
 
-	s, err := b.GetMarketStatistics(exchange.FormatExchangeCurrency(b.Name, p).String())
+	s, err := b.GetMarketStatistics(b.FormatExchangeCurrency(p).String())
 	if err != nil {
 		return tickerPrice, err
 	}
@@ -84,7 +95,7 @@ func (b *BTSE) UpdateTicker(p currency.Pair, assetType string) (ticker.Price, er
 }
 
 // GetTickerPrice returns the ticker for a currency pair
-func (b *BTSE) GetTickerPrice(p currency.Pair, assetType string) (ticker.Price, error) {
+func (b *BTSE) GetTickerPrice(p currency.Pair, assetType asset.Item) (ticker.Price, error) {
 	tickerNew, err := ticker.GetTicker(b.GetName(), p, assetType)
 	if err != nil {
 		return b.UpdateTicker(p, assetType)
@@ -93,7 +104,7 @@ func (b *BTSE) GetTickerPrice(p currency.Pair, assetType string) (ticker.Price,
 }
 
 // GetOrderbookEx returns orderbook base on the currency pair
-func (b *BTSE) GetOrderbookEx(p currency.Pair, assetType string) (orderbook.Base, error) {
+func (b *BTSE) GetOrderbookEx(p currency.Pair, assetType asset.Item) (orderbook.Base, error) {
 	ob, err := orderbook.Get(b.GetName(), p, assetType)
 	if err != nil {
 		return b.UpdateOrderbook(p, assetType)
@@ -102,9 +113,9 @@ func (b *BTSE) GetOrderbookEx(p currency.Pair, assetType string) (orderbook.Base
 }
 
 // UpdateOrderbook updates and returns the orderbook for a currency pair
-func (b *BTSE) UpdateOrderbook(p currency.Pair, assetType string) (orderbook.Base, error) {
+func (b *BTSE) UpdateOrderbook(p currency.Pair, assetType asset.Item) (orderbook.Base, error) {
 	var resp orderbook.Base
-	a, err := b.FetchOrderBook(exchange.FormatExchangeCurrency(b.Name, p).String())
+	a, err := b.FetchOrderBook(b.FormatExchangeCurrency(p).String())
 	if err != nil {
 		return resp, err
 	}
@@ -162,16 +173,48 @@ func (b *BTSE) GetFundingHistory() ([]exchange.FundHistory, error) {
 	return nil, common.ErrFunctionNotSupported
 }
 
-// GetExchangeHistory returns historic trade data since exchange opening.
-func (b *BTSE) GetExchangeHistory(p currency.Pair, assetType string) ([]exchange.TradeHistory, error) {
-	return nil, common.ErrNotYetImplemented
+// GetExchangeHistory returns the most recent trades for a currency pair
+func (b *BTSE) GetExchangeHistory(p currency.Pair, assetType asset.Item) ([]exchange.TradeHistory, error) {
+	trades, err := b.GetTrades(b.FormatExchangeCurrency(p).String())
+	if err != nil {
+		return nil, err
+	}
+
+	resp := make([]exchange.TradeHistory, len(trades))
+	for i := range trades {
+		t, err := time.Parse(time.RFC3339, trades[i].Time)
+		if err != nil {
+			return nil, err
+		}
+
+		resp[i] = exchange.TradeHistory{
+			Timestamp: t,
+			ID:        trades[i].SerialID,
+			Price:     trades[i].Price,
+			Amount:    trades[i].Amount,
+			Exchange:  b.Name,
+			Side:      exchange.OrderSide(strings.ToUpper(trades[i].Type)),
+		}
+	}
+	return resp, nil
+}
+
+// GetHistoricTrades returns historic trade data within the timeframe provided.
+// BTSE only provides the most recent trades
+func (b *BTSE) GetHistoricTrades(p currency.Pair, assetType asset.Item, timestampStart, timestampEnd time.Time) ([]exchange.TradeHistory, error) {
+	return nil, common.ErrFunctionNotSupported
+}
+
+// GetHistoricCandles returns candles between the start and end times
+func (b *BTSE) GetHistoricCandles(p currency.Pair, assetType asset.Item, timestampStart, timestampEnd time.Time, interval kline.Interval) (kline.Item, error) {
+	return kline.Item{}, common.ErrFunctionNotSupported
 }
 
 // SubmitOrder submits a new order
-func (b *BTSE) SubmitOrder(p currency.Pair, side exchange.OrderSide, orderType exchange.OrderType, amount, price float64, clientID string) (exchange.SubmitOrderResponse, error) {
+func (b *BTSE) SubmitOrder(p currency.Pair, _ asset.Item, side exchange.OrderSide, orderType exchange.OrderType, amount, price float64, clientID string) (exchange.SubmitOrderResponse, error) {
 	var resp exchange.SubmitOrderResponse
 	r, err := b.CreateOrder(amount, price, side.ToString(),
-		orderType.ToString(), exchange.FormatExchangeCurrency(b.Name, p).String(), "", clientID)
+		orderType.ToString(), b.FormatExchangeCurrency(p).String(), "", clientID)
 	if err != nil {
 		return resp, err
 	}
@@ -193,7 +236,7 @@ func (b *BTSE) ModifyOrder(action *exchange.ModifyOrder) (string, error) {
 // CancelOrder cancels an order by its corresponding ID number
 func (b *BTSE) CancelOrder(order *exchange.OrderCancellation) error {
 	r, err := b.CancelExistingOrder(order.OrderID,
-		exchange.FormatExchangeCurrency(b.Name, order.CurrencyPair).String())
+		b.FormatExchangeCurrency(order.CurrencyPair).String())
 	if err != nil {
 		return err
 	}
@@ -218,7 +261,7 @@ func (b *BTSE) CancelAllOrders(orderCancellation *exchange.OrderCancellation) (e
 		return resp, err
 	}
 	for x := range a {
-		strPair := exchange.FormatExchangeCurrency(b.Name, orderCancellation.CurrencyPair).String()
+		strPair := b.FormatExchangeCurrency(orderCancellation.CurrencyPair).String()
 		checkPair := currency.NewPairWithDelimiter(a[x].BaseCurrency, a[x].QuoteCurrency, b.RequestCurrencyPairFormat.Delimiter).String()
 		if strPair != "" && strPair != checkPair {
 			continue
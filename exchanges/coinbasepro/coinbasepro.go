@@ -14,8 +14,9 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
-	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/websocket/wshandler"
 	log "github.com/thrasher-corp/gocryptotrader/logger"
 )
@@ -55,8 +56,22 @@ const (
 
 	coinbaseproAuthRate   = 5
 	coinbaseproUnauthRate = 3
+
+	coinbaseproTradesLimit = 100
+	coinbaseproKlineLimit  = 300
 )
 
+// coinbaseproGranularities maps candle intervals to the granularities in
+// seconds Coinbase Pro supports
+var coinbaseproGranularities = map[kline.Interval]int64{
+	kline.OneMin:     60,
+	kline.FiveMin:    300,
+	kline.FifteenMin: 900,
+	kline.OneHour:    3600,
+	kline.SixHour:    21600,
+	kline.OneDay:     86400,
+}
+
 // CoinbasePro is the overarching type across the coinbasepro package
 type CoinbasePro struct {
 	exchange.Base
@@ -77,9 +92,34 @@ func (c *CoinbasePro) SetDefaults() {
 	c.RequestCurrencyPairFormat.Uppercase = true
 	c.ConfigCurrencyPairFormat.Delimiter = ""
 	c.ConfigCurrencyPairFormat.Uppercase = true
-	c.AssetTypes = []string{ticker.Spot}
-	c.SupportsAutoPairUpdating = true
-	c.SupportsRESTTickerBatching = false
+	c.AssetTypes = asset.Items{asset.Spot}
+	c.Features = exchange.Features{
+		REST: exchange.ProtocolFeatures{
+			TickerFetching:    true,
+			OrderbookFetching: true,
+			TradeFetching:     true,
+			HistoricTrades:    true,
+			KlineFetching:     true,
+			AutoPairUpdates:   true,
+			AccountInfo:       true,
+			ActiveOrders:      true,
+			OrderHistory:      true,
+			SubmitOrder:       true,
+			CancelOrder:       true,
+			CancelOrders:      true,
+			CryptoWithdrawal:  true,
+			FiatWithdrawal:    true,
+		},
+		Websocket: exchange.ProtocolFeatures{
+			TickerFetching:         true,
+			OrderbookFetching:      true,
+			Subscribe:              true,
+			Unsubscribe:            true,
+			AuthenticatedEndpoints: true,
+			SequenceNumbers:        true,
+		},
+		OrderTypes: []exchange.OrderType{exchange.LimitOrderType, exchange.MarketOrderType},
+	}
 	c.Requester = request.New(c.Name,
 		request.NewRateLimit(time.Second, coinbaseproAuthRate),
 		request.NewRateLimit(time.Second, coinbaseproUnauthRate),
@@ -87,12 +127,7 @@ func (c *CoinbasePro) SetDefaults() {
 	c.APIUrlDefault = coinbaseproAPIURL
 	c.APIUrl = c.APIUrlDefault
 	c.Websocket = wshandler.New()
-	c.Websocket.Functionality = wshandler.WebsocketTickerSupported |
-		wshandler.WebsocketOrderbookSupported |
-		wshandler.WebsocketSubscribeSupported |
-		wshandler.WebsocketUnsubscribeSupported |
-		wshandler.WebsocketAuthenticatedEndpointsSupported |
-		wshandler.WebsocketSequenceNumberSupported
+	c.Websocket.Functionality = c.Features.Websocket.Functionality()
 	c.WebsocketResponseMaxLimit = exchange.DefaultWebsocketResponseMaxLimit
 	c.WebsocketResponseCheckTimeout = exchange.DefaultWebsocketResponseCheckTimeout
 	c.WebsocketOrderbookBufferLimit = exchange.DefaultWebsocketOrderbookBufferLimit
@@ -109,6 +144,7 @@ func (c *CoinbasePro) Setup(exch *config.ExchangeConfig) {
 		c.SetAPIKeys(exch.APIKey, exch.APISecret, exch.ClientID, true)
 		c.SetHTTPClientTimeout(exch.HTTPTimeout)
 		c.SetHTTPClientUserAgent(exch.HTTPUserAgent)
+		c.SetHTTPRetryPolicy(exch.HTTPRetry)
 		c.RESTPollingDelay = exch.RESTPollingDelay
 		c.Verbose = exch.Verbose
 		c.HTTPDebugging = exch.HTTPDebugging
@@ -166,6 +202,7 @@ func (c *CoinbasePro) Setup(exch *config.ExchangeConfig) {
 			false,
 			false,
 			exch.Name)
+		c.Websocket.Orderbook.SetupVerification(nil, c.getOrderbookSnapshot)
 	}
 }
 
@@ -261,10 +298,24 @@ func (c *CoinbasePro) GetTicker(currencyPair string) (Ticker, error) {
 // GetTrades listd the latest trades for a product
 // currencyPair - example "BTC-USD"
 func (c *CoinbasePro) GetTrades(currencyPair string) ([]Trade, error) {
+	return c.GetTradesAfter(currencyPair, 0)
+}
+
+// GetTradesAfter lists trades for a product older than the supplied trade ID,
+// or the latest trades if the trade ID is 0
+// currencyPair - example "BTC-USD"
+func (c *CoinbasePro) GetTradesAfter(currencyPair string, tradeID int64) ([]Trade, error) {
 	var trades []Trade
 	path := fmt.Sprintf(
 		"%s/%s/%s", c.APIUrl+coinbaseproProducts, currencyPair, coinbaseproTrades)
 
+	if tradeID != 0 {
+		values := url.Values{}
+		values.Set("after", strconv.FormatInt(tradeID, 10))
+		values.Set("limit", strconv.Itoa(coinbaseproTradesLimit))
+		path = common.EncodeURLValues(path, values)
+	}
+
 	return trades, c.SendHTTPRequest(path, &trades)
 }
 
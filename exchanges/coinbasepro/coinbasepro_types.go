@@ -456,6 +456,7 @@ type WebsocketTicker struct {
 type WebsocketOrderbookSnapshot struct {
 	ProductID string          `json:"product_id"`
 	Type      string          `json:"type"`
+	Sequence  int64           `json:"sequence"`
 	Bids      [][]interface{} `json:"bids"`
 	Asks      [][]interface{} `json:"asks"`
 }
@@ -465,6 +466,7 @@ type WebsocketL2Update struct {
 	Type      string          `json:"type"`
 	ProductID string          `json:"product_id"`
 	Time      string          `json:"time"`
+	Sequence  int64           `json:"sequence"`
 	Changes   [][]interface{} `json:"changes"`
 }
 
@@ -11,6 +11,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/websocket/wshandler"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/websocket/wsorderbook"
@@ -89,13 +90,15 @@ func (c *CoinbasePro) WsHandleData() {
 				c.Websocket.DataHandler <- wshandler.TickerData{
 					Timestamp:  ticker.Time,
 					Pair:       currency.NewPairFromString(ticker.ProductID),
-					AssetType:  orderbook.Spot,
+					AssetType:  asset.Spot,
 					Exchange:   c.GetName(),
 					OpenPrice:  ticker.Open24H,
 					HighPrice:  ticker.High24H,
 					LowPrice:   ticker.Low24H,
 					ClosePrice: ticker.Price,
 					Quantity:   ticker.Volume24H,
+					BidPrice:   ticker.BestBid,
+					AskPrice:   ticker.BestAsk,
 				}
 
 			case "snapshot":
@@ -209,8 +212,9 @@ func (c *CoinbasePro) ProcessSnapshot(snapshot *WebsocketOrderbookSnapshot) erro
 	}
 
 	pair := currency.NewPairFromString(snapshot.ProductID)
-	base.AssetType = orderbook.Spot
+	base.AssetType = asset.Spot
 	base.Pair = pair
+	base.LastUpdateID = snapshot.Sequence
 
 	err := c.Websocket.Orderbook.LoadSnapshot(&base, false)
 	if err != nil {
@@ -219,14 +223,16 @@ func (c *CoinbasePro) ProcessSnapshot(snapshot *WebsocketOrderbookSnapshot) erro
 
 	c.Websocket.DataHandler <- wshandler.WebsocketOrderbookUpdate{
 		Pair:     pair,
-		Asset:    orderbook.Spot,
+		Asset:    asset.Spot,
 		Exchange: c.GetName(),
 	}
 
 	return nil
 }
 
-// ProcessUpdate updates the orderbook local cache
+// ProcessUpdate updates the orderbook local cache, an update which does not
+// follow the sequence number of the previous one resyncs the orderbook from a
+// REST snapshot
 func (c *CoinbasePro) ProcessUpdate(update WebsocketL2Update) error {
 	var asks, bids []orderbook.Item
 
@@ -255,7 +261,8 @@ func (c *CoinbasePro) ProcessUpdate(update WebsocketL2Update) error {
 		Asks:         asks,
 		CurrencyPair: p,
 		UpdateTime:   timestamp,
-		AssetType:    orderbook.Spot,
+		AssetType:    asset.Spot,
+		Sequence:     update.Sequence,
 	})
 	if err != nil {
 		return err
@@ -263,7 +270,7 @@ func (c *CoinbasePro) ProcessUpdate(update WebsocketL2Update) error {
 
 	c.Websocket.DataHandler <- wshandler.WebsocketOrderbookUpdate{
 		Pair:     p,
-		Asset:    orderbook.Spot,
+		Asset:    asset.Spot,
 		Exchange: c.GetName(),
 	}
 
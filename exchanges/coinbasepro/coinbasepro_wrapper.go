@@ -1,8 +1,10 @@
 package coinbasepro
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -10,6 +12,8 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/websocket/wshandler"
@@ -25,6 +29,14 @@ func (c *CoinbasePro) Start(wg *sync.WaitGroup) {
 	}()
 }
 
+// WithContext returns a copy of CoinbasePro whose HTTP requests are abandoned once
+// the context is done
+func (c *CoinbasePro) WithContext(ctx context.Context) exchange.IBotExchange {
+	cp := *c
+	cp.Base = c.ContextBase(ctx)
+	return &cp
+}
+
 // Run implements the coinbasepro wrapper
 func (c *CoinbasePro) Run() {
 	if c.Verbose {
@@ -85,14 +97,14 @@ func (c *CoinbasePro) GetAccountInfo() (exchange.AccountInfo, error) {
 }
 
 // UpdateTicker updates and returns the ticker for a currency pair
-func (c *CoinbasePro) UpdateTicker(p currency.Pair, assetType string) (ticker.Price, error) {
+func (c *CoinbasePro) UpdateTicker(p currency.Pair, assetType asset.Item) (ticker.Price, error) {
 	var tickerPrice ticker.Price
-	tick, err := c.GetTicker(exchange.FormatExchangeCurrency(c.Name, p).String())
+	tick, err := c.GetTicker(c.FormatExchangeCurrency(p).String())
 	if err != nil {
 		return ticker.Price{}, err
 	}
 
-	stats, err := c.GetStats(exchange.FormatExchangeCurrency(c.Name, p).String())
+	stats, err := c.GetStats(c.FormatExchangeCurrency(p).String())
 
 	if err != nil {
 		return ticker.Price{}, err
@@ -113,7 +125,7 @@ func (c *CoinbasePro) UpdateTicker(p currency.Pair, assetType string) (ticker.Pr
 }
 
 // GetTickerPrice returns the ticker for a currency pair
-func (c *CoinbasePro) GetTickerPrice(p currency.Pair, assetType string) (ticker.Price, error) {
+func (c *CoinbasePro) GetTickerPrice(p currency.Pair, assetType asset.Item) (ticker.Price, error) {
 	tickerNew, err := ticker.GetTicker(c.GetName(), p, assetType)
 	if err != nil {
 		return c.UpdateTicker(p, assetType)
@@ -122,7 +134,7 @@ func (c *CoinbasePro) GetTickerPrice(p currency.Pair, assetType string) (ticker.
 }
 
 // GetOrderbookEx returns orderbook base on the currency pair
-func (c *CoinbasePro) GetOrderbookEx(p currency.Pair, assetType string) (orderbook.Base, error) {
+func (c *CoinbasePro) GetOrderbookEx(p currency.Pair, assetType asset.Item) (orderbook.Base, error) {
 	ob, err := orderbook.Get(c.GetName(), p, assetType)
 	if err != nil {
 		return c.UpdateOrderbook(p, assetType)
@@ -131,9 +143,25 @@ func (c *CoinbasePro) GetOrderbookEx(p currency.Pair, assetType string) (orderbo
 }
 
 // UpdateOrderbook updates and returns the orderbook for a currency pair
-func (c *CoinbasePro) UpdateOrderbook(p currency.Pair, assetType string) (orderbook.Base, error) {
+func (c *CoinbasePro) UpdateOrderbook(p currency.Pair, assetType asset.Item) (orderbook.Base, error) {
+	orderBook, err := c.getOrderbookSnapshot(p, assetType)
+	if err != nil {
+		return orderBook, err
+	}
+
+	err = orderBook.Process()
+	if err != nil {
+		return orderBook, err
+	}
+
+	return orderbook.Get(c.Name, p, assetType)
+}
+
+// getOrderbookSnapshot fetches the level 2 orderbook of a currency pair, its
+// sequence number is the one which websocket updates follow
+func (c *CoinbasePro) getOrderbookSnapshot(p currency.Pair, assetType asset.Item) (orderbook.Base, error) {
 	var orderBook orderbook.Base
-	orderbookNew, err := c.GetOrderbook(exchange.FormatExchangeCurrency(c.Name, p).String(), 2)
+	orderbookNew, err := c.GetOrderbook(c.FormatExchangeCurrency(p).String(), 2)
 	if err != nil {
 		return orderBook, err
 	}
@@ -151,13 +179,8 @@ func (c *CoinbasePro) UpdateOrderbook(p currency.Pair, assetType string) (orderb
 	orderBook.Pair = p
 	orderBook.ExchangeName = c.GetName()
 	orderBook.AssetType = assetType
-
-	err = orderBook.Process()
-	if err != nil {
-		return orderBook, err
-	}
-
-	return orderbook.Get(c.Name, p, assetType)
+	orderBook.LastUpdateID = obNew.Sequence
+	return orderBook, nil
 }
 
 // GetFundingHistory returns funding history, deposits and
@@ -167,15 +190,131 @@ func (c *CoinbasePro) GetFundingHistory() ([]exchange.FundHistory, error) {
 	return fundHistory, common.ErrFunctionNotSupported
 }
 
-// GetExchangeHistory returns historic trade data since exchange opening.
-func (c *CoinbasePro) GetExchangeHistory(p currency.Pair, assetType string) ([]exchange.TradeHistory, error) {
+// GetExchangeHistory returns the most recent trades for a currency pair
+func (c *CoinbasePro) GetExchangeHistory(p currency.Pair, assetType asset.Item) ([]exchange.TradeHistory, error) {
+	trades, err := c.GetTrades(c.FormatExchangeCurrency(p).String())
+	if err != nil {
+		return nil, err
+	}
+	return c.convertTrades(trades)
+}
+
+// GetHistoricTrades returns the trades for a currency pair between the start
+// and end times. Trades are returned newest first so pages are requested
+// backwards by trade ID until the start time is reached
+func (c *CoinbasePro) GetHistoricTrades(p currency.Pair, assetType asset.Item, timestampStart, timestampEnd time.Time) ([]exchange.TradeHistory, error) {
+	err := exchange.CheckTimeRange(timestampStart, timestampEnd)
+	if err != nil {
+		return nil, err
+	}
+
+	symbol := c.FormatExchangeCurrency(p).String()
 	var resp []exchange.TradeHistory
+	var after int64
+	for {
+		trades, err := c.GetTradesAfter(symbol, after)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(trades) == 0 {
+			break
+		}
 
-	return resp, common.ErrNotYetImplemented
+		converted, err := c.convertTrades(trades)
+		if err != nil {
+			return nil, err
+		}
+		resp = append(resp, converted...)
+
+		oldest := converted[len(converted)-1]
+		if oldest.Timestamp.Before(timestampStart) || oldest.TID <= 1 {
+			break
+		}
+		after = oldest.TID
+	}
+
+	exchange.FilterTradesByTimeRange(&resp, timestampStart, timestampEnd)
+	return resp, nil
+}
+
+// GetHistoricCandles returns candles between the start and end times, paging
+// through the time range in windows of up to 300 candles. Granularities
+// Coinbase Pro does not provide are built from historic trades
+func (c *CoinbasePro) GetHistoricCandles(p currency.Pair, assetType asset.Item, timestampStart, timestampEnd time.Time, interval kline.Interval) (kline.Item, error) {
+	err := kline.CheckRequest(timestampStart, timestampEnd, interval)
+	if err != nil {
+		return kline.Item{}, err
+	}
+
+	granularity, ok := coinbaseproGranularities[interval]
+	if !ok {
+		return exchange.CreateKlineFromTrades(c, p, assetType, timestampStart,
+			timestampEnd, interval)
+	}
+
+	item := kline.Item{
+		Exchange:  c.Name,
+		Pair:      p,
+		AssetType: assetType,
+		Interval:  interval,
+	}
+
+	symbol := c.FormatExchangeCurrency(p).String()
+	dates := kline.CalculateCandleDateRanges(timestampStart, timestampEnd,
+		interval, coinbaseproKlineLimit)
+	for x := range dates {
+		history, err := c.GetHistoricRates(symbol, dates[x].Start.Unix(),
+			dates[x].End.Unix(), granularity)
+		if err != nil {
+			return kline.Item{}, err
+		}
+
+		for i := range history {
+			item.Candles = append(item.Candles, kline.Candle{
+				Time:   time.Unix(history[i].Time, 0),
+				Open:   history[i].Open,
+				High:   history[i].High,
+				Low:    history[i].Low,
+				Close:  history[i].Close,
+				Volume: history[i].Volume,
+			})
+		}
+	}
+
+	item.FilterCandles(timestampStart, timestampEnd)
+	return item, nil
+}
+
+func (c *CoinbasePro) convertTrades(trades []Trade) ([]exchange.TradeHistory, error) {
+	resp := make([]exchange.TradeHistory, len(trades))
+	for i := range trades {
+		t, err := time.Parse(time.RFC3339Nano, trades[i].Time)
+		if err != nil {
+			return nil, err
+		}
+
+		// The side returned is that of the maker order
+		side := exchange.SellOrderSide
+		if trades[i].Side == "sell" {
+			side = exchange.BuyOrderSide
+		}
+
+		resp[i] = exchange.TradeHistory{
+			Timestamp: t,
+			TID:       trades[i].TradeID,
+			ID:        strconv.FormatInt(trades[i].TradeID, 10),
+			Price:     trades[i].Price,
+			Amount:    trades[i].Size,
+			Exchange:  c.Name,
+			Side:      side,
+		}
+	}
+	return resp, nil
 }
 
 // SubmitOrder submits a new order
-func (c *CoinbasePro) SubmitOrder(p currency.Pair, side exchange.OrderSide, orderType exchange.OrderType, amount, price float64, _ string) (exchange.SubmitOrderResponse, error) {
+func (c *CoinbasePro) SubmitOrder(p currency.Pair, _ asset.Item, side exchange.OrderSide, orderType exchange.OrderType, amount, price float64, _ string) (exchange.SubmitOrderResponse, error) {
 	var submitOrderResponse exchange.SubmitOrderResponse
 	var response string
 	var err error
@@ -301,7 +440,7 @@ func (c *CoinbasePro) GetActiveOrders(getOrdersRequest *exchange.GetOrdersReques
 	var respOrders []GeneralizedOrderResponse
 	for i := range getOrdersRequest.Currencies {
 		resp, err := c.GetOrders([]string{"open", "pending", "active"},
-			exchange.FormatExchangeCurrency(c.Name, getOrdersRequest.Currencies[i]).String())
+			c.FormatExchangeCurrency(getOrdersRequest.Currencies[i]).String())
 		if err != nil {
 			return nil, err
 		}
@@ -345,7 +484,7 @@ func (c *CoinbasePro) GetOrderHistory(getOrdersRequest *exchange.GetOrdersReques
 	var respOrders []GeneralizedOrderResponse
 	for _, currency := range getOrdersRequest.Currencies {
 		resp, err := c.GetOrders([]string{"done", "settled"},
-			exchange.FormatExchangeCurrency(c.Name, currency).String())
+			c.FormatExchangeCurrency(currency).String())
 		if err != nil {
 			return nil, err
 		}
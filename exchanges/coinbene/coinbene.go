@@ -14,8 +14,8 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/config"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
-	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/websocket/wshandler"
 	log "github.com/thrasher-corp/gocryptotrader/logger"
 )
@@ -62,9 +62,32 @@ func (c *Coinbene) SetDefaults() {
 	c.RequestCurrencyPairFormat.Uppercase = true
 	c.ConfigCurrencyPairFormat.Delimiter = "/"
 	c.ConfigCurrencyPairFormat.Uppercase = true
-	c.AssetTypes = []string{ticker.Spot}
-	c.SupportsAutoPairUpdating = true
-	c.SupportsRESTTickerBatching = false
+	c.AssetTypes = asset.Items{asset.Spot}
+	c.Features = exchange.Features{
+		REST: exchange.ProtocolFeatures{
+			TickerFetching:    true,
+			OrderbookFetching: true,
+			AutoPairUpdates:   true,
+			AccountInfo:       true,
+			GetOrder:          true,
+			ActiveOrders:      true,
+			OrderHistory:      true,
+			SubmitOrder:       true,
+			CancelOrder:       true,
+			CancelOrders:      true,
+		},
+		Websocket: exchange.ProtocolFeatures{
+			TickerFetching:         true,
+			OrderbookFetching:      true,
+			TradeFetching:          true,
+			KlineFetching:          true,
+			AccountInfo:            true,
+			Subscribe:              true,
+			Unsubscribe:            true,
+			AuthenticatedEndpoints: true,
+		},
+		OrderTypes: []exchange.OrderType{exchange.LimitOrderType, exchange.MarketOrderType},
+	}
 	c.Requester = request.New(c.Name,
 		request.NewRateLimit(time.Minute, authRateLimit),
 		request.NewRateLimit(time.Second, unauthRateLimit),
@@ -73,14 +96,7 @@ func (c *Coinbene) SetDefaults() {
 	c.APIUrl = c.APIUrlDefault
 	c.Websocket = wshandler.New()
 	c.WebsocketURL = coinbeneWsURL
-	c.Websocket.Functionality = wshandler.WebsocketTickerSupported |
-		wshandler.WebsocketTradeDataSupported |
-		wshandler.WebsocketKlineSupported |
-		wshandler.WebsocketAccountDataSupported |
-		wshandler.WebsocketOrderbookSupported |
-		wshandler.WebsocketSubscribeSupported |
-		wshandler.WebsocketUnsubscribeSupported |
-		wshandler.WebsocketAuthenticatedEndpointsSupported
+	c.Websocket.Functionality = c.Features.Websocket.Functionality()
 	c.WebsocketResponseMaxLimit = exchange.DefaultWebsocketResponseMaxLimit
 	c.WebsocketResponseCheckTimeout = exchange.DefaultWebsocketResponseCheckTimeout
 	c.WebsocketOrderbookBufferLimit = exchange.DefaultWebsocketOrderbookBufferLimit
@@ -97,6 +113,7 @@ func (c *Coinbene) Setup(exch *config.ExchangeConfig) {
 		c.SetAPIKeys(exch.APIKey, exch.APISecret, "", false)
 		c.SetHTTPClientTimeout(exch.HTTPTimeout)
 		c.SetHTTPClientUserAgent(exch.HTTPUserAgent)
+		c.SetHTTPRetryPolicy(exch.HTTPRetry)
 		c.RESTPollingDelay = exch.RESTPollingDelay
 		c.Verbose = exch.Verbose
 		c.Websocket.SetWsStatusAndConnection(exch.Websocket)
@@ -7,6 +7,7 @@ import (
 
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 )
 
 // Please supply your own keys here for due diligence testing
@@ -157,7 +158,7 @@ func TestFetchClosedOrders(t *testing.T) {
 func TestUpdateTicker(t *testing.T) {
 	t.Parallel()
 	cp := currency.NewPairWithDelimiter("BTC", "USDT", "/")
-	_, err := c.UpdateTicker(cp, "spot")
+	_, err := c.UpdateTicker(cp, asset.Spot)
 	if err != nil {
 		t.Error(err)
 	}
@@ -177,7 +178,7 @@ func TestGetAccountInfo(t *testing.T) {
 func TestUpdateOrderbook(t *testing.T) {
 	t.Parallel()
 	cp := currency.NewPairWithDelimiter("BTC", "USDT", "/")
-	_, err := c.UpdateOrderbook(cp, "spot")
+	_, err := c.UpdateOrderbook(cp, asset.Spot)
 	if err != nil {
 		t.Error(err)
 	}
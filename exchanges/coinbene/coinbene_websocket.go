@@ -12,6 +12,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/websocket/wshandler"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/websocket/wsorderbook"
@@ -135,9 +136,11 @@ func (c *Coinbene) WsDataHandler() {
 						ClosePrice: ticker.Data[x].LastPrice,
 						HighPrice:  ticker.Data[x].High24h,
 						LowPrice:   ticker.Data[x].Low24h,
+						BidPrice:   ticker.Data[x].BestBidPrice,
+						AskPrice:   ticker.Data[x].BestAskPrice,
 						Pair:       currency.NewPairFromString(ticker.Data[x].Symbol),
 						Exchange:   c.Name,
-						AssetType:  orderbook.Swap,
+						AssetType:  asset.PerpetualSwap,
 					}
 				}
 			case strings.Contains(result[topic].(string), "tradeList"):
@@ -170,7 +173,7 @@ func (c *Coinbene) WsDataHandler() {
 					Price:        price,
 					Amount:       amount,
 					Exchange:     c.Name,
-					AssetType:    orderbook.Swap,
+					AssetType:    asset.PerpetualSwap,
 					Side:         tradeList.Data[0][1],
 				}
 			case strings.Contains(result[topic].(string), "orderBook"):
@@ -218,7 +221,7 @@ func (c *Coinbene) WsDataHandler() {
 					var newOB orderbook.Base
 					newOB.Asks = asks
 					newOB.Bids = bids
-					newOB.AssetType = orderbook.Swap
+					newOB.AssetType = asset.PerpetualSwap
 					newOB.Pair = currency.NewPairFromString(strings.Replace(orderBook.Topic, "tradeList.", "", 1))
 					newOB.ExchangeName = c.Name
 					err = c.Websocket.Orderbook.LoadSnapshot(&newOB, true)
@@ -227,14 +230,14 @@ func (c *Coinbene) WsDataHandler() {
 						continue
 					}
 					c.Websocket.DataHandler <- wshandler.WebsocketOrderbookUpdate{Pair: newOB.Pair,
-						Asset:    orderbook.Swap,
+						Asset:    asset.PerpetualSwap,
 						Exchange: c.Name,
 					}
 				} else if orderBook.Action == "update" {
 					newOB := wsorderbook.WebsocketOrderbookUpdate{
 						Asks:         asks,
 						Bids:         bids,
-						AssetType:    orderbook.Swap,
+						AssetType:    asset.PerpetualSwap,
 						CurrencyPair: currency.NewPairFromString(strings.Replace(orderBook.Topic, "tradeList.", "", 1)),
 						UpdateID:     orderBook.Version,
 					}
@@ -244,7 +247,7 @@ func (c *Coinbene) WsDataHandler() {
 						continue
 					}
 					c.Websocket.DataHandler <- wshandler.WebsocketOrderbookUpdate{Pair: newOB.CurrencyPair,
-						Asset:    orderbook.Swap,
+						Asset:    asset.PerpetualSwap,
 						Exchange: c.Name,
 					}
 				}
@@ -268,7 +271,7 @@ func (c *Coinbene) WsDataHandler() {
 				c.Websocket.DataHandler <- wshandler.KlineData{
 					Timestamp:  time.Unix(int64(kline.Data[0][1].(float64)), 0),
 					Pair:       currency.NewPairFromString(kline.Data[0][0].(string)),
-					AssetType:  orderbook.Swap,
+					AssetType:  asset.PerpetualSwap,
 					Exchange:   c.Name,
 					OpenPrice:  tempKline[0],
 					ClosePrice: tempKline[1],
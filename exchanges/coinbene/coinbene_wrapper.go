@@ -1,6 +1,7 @@
 package coinbene
 
 import (
+	"context"
 	"fmt"
 	"strconv"
 	"sync"
@@ -9,6 +10,8 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/websocket/wshandler"
@@ -24,6 +27,14 @@ func (c *Coinbene) Start(wg *sync.WaitGroup) {
 	}()
 }
 
+// WithContext returns a copy of Coinbene whose HTTP requests are abandoned once
+// the context is done
+func (c *Coinbene) WithContext(ctx context.Context) exchange.IBotExchange {
+	cp := *c
+	cp.Base = c.ContextBase(ctx)
+	return &cp
+}
+
 // Run implements the Coinbene wrapper
 func (c *Coinbene) Run() {
 	if c.Verbose {
@@ -50,12 +61,11 @@ func (c *Coinbene) Run() {
 }
 
 // UpdateTicker updates and returns the ticker for a currency pair
-func (c *Coinbene) UpdateTicker(p currency.Pair, assetType string) (ticker.Price, error) {
+func (c *Coinbene) UpdateTicker(p currency.Pair, assetType asset.Item) (ticker.Price, error) {
 	var resp ticker.Price
 	allPairs := c.GetEnabledCurrencies()
 	for x := range allPairs {
-		tempResp, err := c.FetchTicker(exchange.FormatExchangeCurrency(c.Name,
-			allPairs[x]).String())
+		tempResp, err := c.FetchTicker(c.FormatExchangeCurrency(allPairs[x]).String())
 		if err != nil {
 			return resp, err
 		}
@@ -76,7 +86,7 @@ func (c *Coinbene) UpdateTicker(p currency.Pair, assetType string) (ticker.Price
 }
 
 // GetTickerPrice returns the ticker for a currency pair
-func (c *Coinbene) GetTickerPrice(p currency.Pair, assetType string) (ticker.Price, error) {
+func (c *Coinbene) GetTickerPrice(p currency.Pair, assetType asset.Item) (ticker.Price, error) {
 	tickerNew, err := ticker.GetTicker(c.Name, p, assetType)
 	if err != nil {
 		return c.UpdateTicker(p, assetType)
@@ -85,7 +95,7 @@ func (c *Coinbene) GetTickerPrice(p currency.Pair, assetType string) (ticker.Pri
 }
 
 // GetOrderbookEx returns orderbook base on the currency pair
-func (c *Coinbene) GetOrderbookEx(currency currency.Pair, assetType string) (orderbook.Base, error) {
+func (c *Coinbene) GetOrderbookEx(currency currency.Pair, assetType asset.Item) (orderbook.Base, error) {
 	ob, err := orderbook.Get(c.Name, currency, assetType)
 	if err != nil {
 		return c.UpdateOrderbook(currency, assetType)
@@ -94,9 +104,9 @@ func (c *Coinbene) GetOrderbookEx(currency currency.Pair, assetType string) (ord
 }
 
 // UpdateOrderbook updates and returns the orderbook for a currency pair
-func (c *Coinbene) UpdateOrderbook(p currency.Pair, assetType string) (orderbook.Base, error) {
+func (c *Coinbene) UpdateOrderbook(p currency.Pair, assetType asset.Item) (orderbook.Base, error) {
 	var resp orderbook.Base
-	strPair := exchange.FormatExchangeCurrency(c.Name, p).String()
+	strPair := c.FormatExchangeCurrency(p).String()
 	tempResp, err := c.FetchOrderbooks(strPair, 100)
 	if err != nil {
 		return resp, err
@@ -168,12 +178,22 @@ func (c *Coinbene) GetFundingHistory() ([]exchange.FundHistory, error) {
 }
 
 // GetExchangeHistory returns historic trade data since exchange opening.
-func (c *Coinbene) GetExchangeHistory(p currency.Pair, assetType string) ([]exchange.TradeHistory, error) {
+func (c *Coinbene) GetExchangeHistory(p currency.Pair, assetType asset.Item) ([]exchange.TradeHistory, error) {
 	return nil, common.ErrFunctionNotSupported
 }
 
+// GetHistoricTrades returns historic trade data within the timeframe provided.
+func (c *Coinbene) GetHistoricTrades(p currency.Pair, assetType asset.Item, timestampStart, timestampEnd time.Time) ([]exchange.TradeHistory, error) {
+	return nil, common.ErrFunctionNotSupported
+}
+
+// GetHistoricCandles returns candles between the start and end times
+func (c *Coinbene) GetHistoricCandles(p currency.Pair, assetType asset.Item, timestampStart, timestampEnd time.Time, interval kline.Interval) (kline.Item, error) {
+	return kline.Item{}, common.ErrFunctionNotSupported
+}
+
 // SubmitOrder submits a new order
-func (c *Coinbene) SubmitOrder(p currency.Pair, side exchange.OrderSide, orderType exchange.OrderType, amount, price float64, clientID string) (exchange.SubmitOrderResponse, error) {
+func (c *Coinbene) SubmitOrder(p currency.Pair, _ asset.Item, side exchange.OrderSide, orderType exchange.OrderType, amount, price float64, clientID string) (exchange.SubmitOrderResponse, error) {
 	var resp exchange.SubmitOrderResponse
 	if side != exchange.BuyOrderSide && side != exchange.SellOrderSide {
 		return resp,
@@ -181,7 +201,7 @@ func (c *Coinbene) SubmitOrder(p currency.Pair, side exchange.OrderSide, orderTy
 	}
 	tempResp, err := c.PlaceOrder(price,
 		amount,
-		exchange.FormatExchangeCurrency(c.Name, p).String(),
+		c.FormatExchangeCurrency(p).String(),
 		orderType.ToString(),
 		clientID)
 	if err != nil {
@@ -208,8 +228,7 @@ func (c *Coinbene) CancelOrder(order *exchange.OrderCancellation) error {
 func (c *Coinbene) CancelAllOrders(orderCancellation *exchange.OrderCancellation) (exchange.CancelAllOrdersResponse, error) {
 	var resp exchange.CancelAllOrdersResponse
 	tempMap := make(map[string]string)
-	orders, err := c.FetchOpenOrders(exchange.FormatExchangeCurrency(c.Name,
-		orderCancellation.CurrencyPair).String())
+	orders, err := c.FetchOpenOrders(c.FormatExchangeCurrency(orderCancellation.CurrencyPair).String())
 	if err != nil {
 		return resp, err
 	}
@@ -293,7 +312,7 @@ func (c *Coinbene) GetActiveOrders(getOrdersRequest *exchange.GetOrdersRequest)
 	}
 	var err error
 	for x := range getOrdersRequest.Currencies {
-		tempData, err = c.FetchOpenOrders(exchange.FormatExchangeCurrency(c.Name, getOrdersRequest.Currencies[x]).String())
+		tempData, err = c.FetchOpenOrders(c.FormatExchangeCurrency(getOrdersRequest.Currencies[x]).String())
 		if err != nil {
 			return resp, err
 		}
@@ -339,7 +358,7 @@ func (c *Coinbene) GetOrderHistory(getOrdersRequest *exchange.GetOrdersRequest)
 	}
 	var err error
 	for x := range getOrdersRequest.Currencies {
-		tempData, err = c.FetchClosedOrders(exchange.FormatExchangeCurrency(c.Name, getOrdersRequest.Currencies[x]).String(), "")
+		tempData, err = c.FetchClosedOrders(c.FormatExchangeCurrency(getOrdersRequest.Currencies[x]).String(), "")
 		if err != nil {
 			return resp, err
 		}
@@ -371,7 +390,7 @@ func (c *Coinbene) GetOrderHistory(getOrdersRequest *exchange.GetOrdersRequest)
 // GetFeeByType returns an estimate of fee based on the type of transaction
 func (c *Coinbene) GetFeeByType(feeBuilder *exchange.FeeBuilder) (float64, error) {
 	var fee float64
-	tempData, err := c.GetPairInfo(exchange.FormatExchangeCurrency(c.Name, feeBuilder.Pair).String())
+	tempData, err := c.GetPairInfo(c.FormatExchangeCurrency(feeBuilder.Pair).String())
 	if err != nil {
 		return fee, err
 	}
@@ -11,6 +11,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/websocket/wshandler"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/websocket/wsorderbook"
@@ -136,9 +137,9 @@ func (c *COINUT) wsProcessResponse(resp []byte) {
 		c.Websocket.DataHandler <- wshandler.TickerData{
 			Timestamp:  time.Unix(0, ticker.Timestamp),
 			Exchange:   c.GetName(),
-			AssetType:  orderbook.Spot,
-			HighPrice:  ticker.HighestBuy,
-			LowPrice:   ticker.LowestSell,
+			AssetType:  asset.Spot,
+			BidPrice:   ticker.HighestBuy,
+			AskPrice:   ticker.LowestSell,
 			ClosePrice: ticker.Last,
 			Quantity:   ticker.Volume,
 		}
@@ -158,7 +159,7 @@ func (c *COINUT) wsProcessResponse(resp []byte) {
 		currencyPair := instrumentListByCode[orderbooksnapshot.InstID]
 		c.Websocket.DataHandler <- wshandler.WebsocketOrderbookUpdate{
 			Exchange: c.GetName(),
-			Asset:    orderbook.Spot,
+			Asset:    asset.Spot,
 			Pair:     currency.NewPairFromString(currencyPair),
 		}
 	case "inst_order_book_update":
@@ -176,7 +177,7 @@ func (c *COINUT) wsProcessResponse(resp []byte) {
 		currencyPair := instrumentListByCode[orderbookUpdate.InstID]
 		c.Websocket.DataHandler <- wshandler.WebsocketOrderbookUpdate{
 			Exchange: c.GetName(),
-			Asset:    orderbook.Spot,
+			Asset:    asset.Spot,
 			Pair:     currency.NewPairFromString(currencyPair),
 		}
 	case "inst_trade":
@@ -198,7 +199,7 @@ func (c *COINUT) wsProcessResponse(resp []byte) {
 		c.Websocket.DataHandler <- wshandler.TradeData{
 			Timestamp:    time.Unix(tradeUpdate.Timestamp, 0),
 			CurrencyPair: currency.NewPairFromString(currencyPair),
-			AssetType:    orderbook.Spot,
+			AssetType:    asset.Spot,
 			Exchange:     c.GetName(),
 			Price:        tradeUpdate.Price,
 			Side:         tradeUpdate.Side,
@@ -227,7 +228,7 @@ func (c *COINUT) GetNonce() int64 {
 func (c *COINUT) WsSetInstrumentList() error {
 	request := wsRequest{
 		Request: "inst_list",
-		SecType: orderbook.Spot,
+		SecType: asset.Spot.String(),
 		Nonce:   c.WebsocketConn.GenerateMessageID(false),
 	}
 	resp, err := c.WebsocketConn.SendMessageReturnResponse(request.Nonce, request)
@@ -271,7 +272,7 @@ func (c *COINUT) WsProcessOrderbookSnapshot(ob *WsOrderbookSnapshot) error {
 	newOrderBook.Asks = asks
 	newOrderBook.Bids = bids
 	newOrderBook.Pair = currency.NewPairFromString(instrumentListByCode[ob.InstID])
-	newOrderBook.AssetType = orderbook.Spot
+	newOrderBook.AssetType = asset.Spot
 
 	return c.Websocket.Orderbook.LoadSnapshot(&newOrderBook, false)
 }
@@ -282,7 +283,7 @@ func (c *COINUT) WsProcessOrderbookUpdate(update *WsOrderbookUpdate) error {
 	bufferUpdate := &wsorderbook.WebsocketOrderbookUpdate{
 		CurrencyPair: p,
 		UpdateID:     update.TransID,
-		AssetType:    orderbook.Spot,
+		AssetType:    asset.Spot,
 	}
 	if strings.EqualFold(update.Side, "buy") {
 		bufferUpdate.Bids = []orderbook.Item{{Price: update.Price, Amount: update.Volume}}
@@ -408,7 +409,7 @@ func (c *COINUT) wsSubmitOrder(order *WsSubmitOrderParameters) (*WsStandardOrder
 	if !c.Websocket.CanUseAuthenticatedEndpoints() {
 		return nil, fmt.Errorf("%v not authorised to submit order", c.Name)
 	}
-	currency := exchange.FormatExchangeCurrency(c.Name, order.Currency).String()
+	currency := c.FormatExchangeCurrency(order.Currency).String()
 	var orderSubmissionRequest WsSubmitOrderRequest
 	orderSubmissionRequest.Request = "new_order"
 	orderSubmissionRequest.Nonce = c.WebsocketConn.GenerateMessageID(false)
@@ -517,7 +518,7 @@ func (c *COINUT) wsSubmitOrders(orders []WsSubmitOrderParameters) ([]WsStandardO
 	}
 	orderRequest := WsSubmitOrdersRequest{}
 	for i := range orders {
-		currency := exchange.FormatExchangeCurrency(c.Name, orders[i].Currency).String()
+		currency := c.FormatExchangeCurrency(orders[i].Currency).String()
 		orderRequest.Orders = append(orderRequest.Orders,
 			WsSubmitOrdersRequestData{
 				Qty:         orders[i].Amount,
@@ -576,7 +577,7 @@ func (c *COINUT) wsGetOpenOrders(p currency.Pair) error {
 	if !c.Websocket.CanUseAuthenticatedEndpoints() {
 		return fmt.Errorf("%v not authorised to get open orders", c.Name)
 	}
-	currency := exchange.FormatExchangeCurrency(c.Name, p).String()
+	currency := c.FormatExchangeCurrency(p).String()
 	var openOrdersRequest WsGetOpenOrdersRequest
 	openOrdersRequest.Request = "user_open_orders"
 	openOrdersRequest.Nonce = c.WebsocketConn.GenerateMessageID(false)
@@ -603,7 +604,7 @@ func (c *COINUT) wsCancelOrder(cancellation WsCancelOrderParameters) error {
 	if !c.Websocket.CanUseAuthenticatedEndpoints() {
 		return fmt.Errorf("%v not authorised to cancel order", c.Name)
 	}
-	currency := exchange.FormatExchangeCurrency(c.Name, cancellation.Currency).String()
+	currency := c.FormatExchangeCurrency(cancellation.Currency).String()
 	var cancellationRequest WsCancelOrderRequest
 	cancellationRequest.Request = "cancel_order"
 	cancellationRequest.InstID = instrumentListByString[currency]
@@ -636,7 +637,7 @@ func (c *COINUT) wsCancelOrders(cancellations []WsCancelOrderParameters) (*WsCan
 	}
 	cancelOrderRequest := WsCancelOrdersRequest{}
 	for i := range cancellations {
-		currency := exchange.FormatExchangeCurrency(c.Name, cancellations[i].Currency).String()
+		currency := c.FormatExchangeCurrency(cancellations[i].Currency).String()
 		cancelOrderRequest.Entries = append(cancelOrderRequest.Entries, WsCancelOrdersRequestEntry{
 			InstID:  instrumentListByString[currency],
 			OrderID: cancellations[i].OrderID,
@@ -673,7 +674,7 @@ func (c *COINUT) wsGetTradeHistory(p currency.Pair, start, limit int64) error {
 	if !c.Websocket.CanUseAuthenticatedEndpoints() {
 		return fmt.Errorf("%v not authorised to get trade history", c.Name)
 	}
-	currency := exchange.FormatExchangeCurrency(c.Name, p).String()
+	currency := c.FormatExchangeCurrency(p).String()
 	var request WsTradeHistoryRequest
 	request.Request = "trade_history"
 	request.InstID = instrumentListByString[currency]
@@ -1,6 +1,7 @@
 package coinut
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"strconv"
@@ -11,6 +12,8 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/websocket/wshandler"
@@ -26,6 +29,14 @@ func (c *COINUT) Start(wg *sync.WaitGroup) {
 	}()
 }
 
+// WithContext returns a copy of COINUT whose HTTP requests are abandoned once
+// the context is done
+func (c *COINUT) WithContext(ctx context.Context) exchange.IBotExchange {
+	cp := *c
+	cp.Base = c.ContextBase(ctx)
+	return &cp
+}
+
 // Run implements the COINUT wrapper
 func (c *COINUT) Run() {
 	if c.Verbose {
@@ -135,7 +146,7 @@ func (c *COINUT) GetAccountInfo() (exchange.AccountInfo, error) {
 }
 
 // UpdateTicker updates and returns the ticker for a currency pair
-func (c *COINUT) UpdateTicker(p currency.Pair, assetType string) (ticker.Price, error) {
+func (c *COINUT) UpdateTicker(p currency.Pair, assetType asset.Item) (ticker.Price, error) {
 	var tickerPrice ticker.Price
 	tick, err := c.GetInstrumentTicker(c.InstrumentMap[p.String()])
 	if err != nil {
@@ -157,7 +168,7 @@ func (c *COINUT) UpdateTicker(p currency.Pair, assetType string) (ticker.Price,
 }
 
 // GetTickerPrice returns the ticker for a currency pair
-func (c *COINUT) GetTickerPrice(p currency.Pair, assetType string) (ticker.Price, error) {
+func (c *COINUT) GetTickerPrice(p currency.Pair, assetType asset.Item) (ticker.Price, error) {
 	tickerNew, err := ticker.GetTicker(c.GetName(), p, assetType)
 	if err != nil {
 		return c.UpdateTicker(p, assetType)
@@ -166,7 +177,7 @@ func (c *COINUT) GetTickerPrice(p currency.Pair, assetType string) (ticker.Price
 }
 
 // GetOrderbookEx returns orderbook base on the currency pair
-func (c *COINUT) GetOrderbookEx(p currency.Pair, assetType string) (orderbook.Base, error) {
+func (c *COINUT) GetOrderbookEx(p currency.Pair, assetType asset.Item) (orderbook.Base, error) {
 	ob, err := orderbook.Get(c.GetName(), p, assetType)
 	if err != nil {
 		return c.UpdateOrderbook(p, assetType)
@@ -175,7 +186,7 @@ func (c *COINUT) GetOrderbookEx(p currency.Pair, assetType string) (orderbook.Ba
 }
 
 // UpdateOrderbook updates and returns the orderbook for a currency pair
-func (c *COINUT) UpdateOrderbook(p currency.Pair, assetType string) (orderbook.Base, error) {
+func (c *COINUT) UpdateOrderbook(p currency.Pair, assetType asset.Item) (orderbook.Base, error) {
 	var orderBook orderbook.Base
 	orderbookNew, err := c.GetInstrumentOrderbook(c.InstrumentMap[p.String()], 200)
 	if err != nil {
@@ -210,15 +221,41 @@ func (c *COINUT) GetFundingHistory() ([]exchange.FundHistory, error) {
 	return fundHistory, common.ErrFunctionNotSupported
 }
 
-// GetExchangeHistory returns historic trade data since exchange opening.
-func (c *COINUT) GetExchangeHistory(p currency.Pair, assetType string) ([]exchange.TradeHistory, error) {
-	var resp []exchange.TradeHistory
+// GetExchangeHistory returns the most recent trades for a currency pair
+func (c *COINUT) GetExchangeHistory(p currency.Pair, assetType asset.Item) ([]exchange.TradeHistory, error) {
+	trades, err := c.GetTrades(c.InstrumentMap[p.String()])
+	if err != nil {
+		return nil, err
+	}
+
+	resp := make([]exchange.TradeHistory, len(trades.Trades))
+	for i := range trades.Trades {
+		resp[i] = exchange.TradeHistory{
+			Timestamp: time.Unix(0, int64(trades.Trades[i].Timestamp)*int64(time.Microsecond)),
+			TID:       trades.Trades[i].TransID,
+			ID:        strconv.FormatInt(trades.Trades[i].TransID, 10),
+			Price:     trades.Trades[i].Price,
+			Amount:    trades.Trades[i].Quantity,
+			Exchange:  c.Name,
+			Side:      exchange.OrderSide(strings.ToUpper(trades.Trades[i].Side)),
+		}
+	}
+	return resp, nil
+}
+
+// GetHistoricTrades returns historic trade data within the timeframe provided.
+// COINUT only provides the most recent trades
+func (c *COINUT) GetHistoricTrades(p currency.Pair, assetType asset.Item, timestampStart, timestampEnd time.Time) ([]exchange.TradeHistory, error) {
+	return nil, common.ErrFunctionNotSupported
+}
 
-	return resp, common.ErrNotYetImplemented
+// GetHistoricCandles returns candles between the start and end times
+func (c *COINUT) GetHistoricCandles(p currency.Pair, assetType asset.Item, timestampStart, timestampEnd time.Time, interval kline.Interval) (kline.Item, error) {
+	return kline.Item{}, common.ErrFunctionNotSupported
 }
 
 // SubmitOrder submits a new order
-func (c *COINUT) SubmitOrder(p currency.Pair, side exchange.OrderSide, orderType exchange.OrderType, amount, price float64, clientID string) (exchange.SubmitOrderResponse, error) {
+func (c *COINUT) SubmitOrder(p currency.Pair, _ asset.Item, side exchange.OrderSide, orderType exchange.OrderType, amount, price float64, clientID string) (exchange.SubmitOrderResponse, error) {
 	var submitOrderResponse exchange.SubmitOrderResponse
 	var err error
 	var APIresponse interface{}
@@ -288,7 +325,7 @@ func (c *COINUT) CancelOrder(order *exchange.OrderCancellation) error {
 		return err
 	}
 
-	currencyArray := instruments.Instruments[exchange.FormatExchangeCurrency(c.Name, order.CurrencyPair).String()]
+	currencyArray := instruments.Instruments[c.FormatExchangeCurrency(order.CurrencyPair).String()]
 	currencyID := currencyArray[0].InstID
 	_, err = c.CancelExistingOrder(currencyID, int(orderIDInt))
 
@@ -0,0 +1,240 @@
+package exchange
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+)
+
+// ContextExchange is implemented by exchanges which can bind the HTTP
+// requests of their wrapper methods to a context
+type ContextExchange interface {
+	// WithContext returns a shallow copy of the exchange whose HTTP requests
+	// are abandoned once the context is done
+	WithContext(ctx context.Context) IBotExchange
+}
+
+// ContextBase returns a copy of the base whose HTTP requests are sent with
+// the context, for use by the WithContext methods of the exchanges
+func (e *Base) ContextBase(ctx context.Context) Base {
+	b := *e
+	b.requestCtx = ctx
+	return b
+}
+
+// SendPayload sends an HTTP request through the requester of the exchange,
+// the request is abandoned once the context the base was copied with is done
+func (e *Base) SendPayload(method, path string, headers map[string]string, body io.Reader, result interface{}, authRequest, nonceEnabled, verbose, httpDebugging, record bool) error {
+	ctx := e.requestCtx
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	return e.Requester.SendPayloadContext(ctx, method, path, headers, body,
+		result, authRequest, nonceEnabled, verbose, httpDebugging, record)
+}
+
+// withContext returns the exchange bound to the context, exchanges which do
+// not implement ContextExchange are returned as is and run to completion.
+// An error is returned if the context is already done
+func withContext(ctx context.Context, exch IBotExchange) (IBotExchange, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if c, ok := exch.(ContextExchange); ok {
+		return c.WithContext(ctx), nil
+	}
+	return exch, nil
+}
+
+// UpdateTickerContext updates and returns the ticker of a currency pair,
+// abandoning its requests once the context is done
+func UpdateTickerContext(ctx context.Context, exch IBotExchange, p currency.Pair, assetType asset.Item) (ticker.Price, error) {
+	exch, err := withContext(ctx, exch)
+	if err != nil {
+		return ticker.Price{}, err
+	}
+	return exch.UpdateTicker(p, assetType)
+}
+
+// UpdateOrderbookContext updates and returns the orderbook of a currency
+// pair, abandoning its requests once the context is done
+func UpdateOrderbookContext(ctx context.Context, exch IBotExchange, p currency.Pair, assetType asset.Item) (orderbook.Base, error) {
+	exch, err := withContext(ctx, exch)
+	if err != nil {
+		return orderbook.Base{}, err
+	}
+	return exch.UpdateOrderbook(p, assetType)
+}
+
+// GetAccountInfoContext returns the account balances of an exchange,
+// abandoning its requests once the context is done
+func GetAccountInfoContext(ctx context.Context, exch IBotExchange) (AccountInfo, error) {
+	exch, err := withContext(ctx, exch)
+	if err != nil {
+		return AccountInfo{}, err
+	}
+	return exch.GetAccountInfo()
+}
+
+// GetActiveOrdersContext returns the open orders of an exchange, abandoning
+// its requests once the context is done
+func GetActiveOrdersContext(ctx context.Context, exch IBotExchange, req *GetOrdersRequest) ([]OrderDetail, error) {
+	exch, err := withContext(ctx, exch)
+	if err != nil {
+		return nil, err
+	}
+	return exch.GetActiveOrders(req)
+}
+
+// GetOrderHistoryContext returns the order history of an exchange,
+// abandoning its requests once the context is done
+func GetOrderHistoryContext(ctx context.Context, exch IBotExchange, req *GetOrdersRequest) ([]OrderDetail, error) {
+	exch, err := withContext(ctx, exch)
+	if err != nil {
+		return nil, err
+	}
+	return exch.GetOrderHistory(req)
+}
+
+// GetOrderInfoContext returns the details of an order, abandoning its
+// requests once the context is done
+func GetOrderInfoContext(ctx context.Context, exch IBotExchange, orderID string) (OrderDetail, error) {
+	exch, err := withContext(ctx, exch)
+	if err != nil {
+		return OrderDetail{}, err
+	}
+	return exch.GetOrderInfo(orderID)
+}
+
+// GetExchangeHistoryContext returns the recent trades of a currency pair,
+// abandoning its requests once the context is done
+func GetExchangeHistoryContext(ctx context.Context, exch IBotExchange, p currency.Pair, assetType asset.Item) ([]TradeHistory, error) {
+	exch, err := withContext(ctx, exch)
+	if err != nil {
+		return nil, err
+	}
+	return exch.GetExchangeHistory(p, assetType)
+}
+
+// GetFundingHistoryContext returns the deposits and withdrawals of an
+// exchange, abandoning its requests once the context is done
+func GetFundingHistoryContext(ctx context.Context, exch IBotExchange) ([]FundHistory, error) {
+	exch, err := withContext(ctx, exch)
+	if err != nil {
+		return nil, err
+	}
+	return exch.GetFundingHistory()
+}
+
+// GetDepositAddressContext returns the deposit address of a cryptocurrency,
+// abandoning its requests once the context is done
+func GetDepositAddressContext(ctx context.Context, exch IBotExchange, cryptocurrency currency.Code, accountID string) (string, error) {
+	exch, err := withContext(ctx, exch)
+	if err != nil {
+		return "", err
+	}
+	return exch.GetDepositAddress(cryptocurrency, accountID)
+}
+
+// GetHistoricTradesContext returns the trades of a currency pair within a
+// time range, abandoning its requests once the context is done
+func GetHistoricTradesContext(ctx context.Context, exch IBotExchange, p currency.Pair, assetType asset.Item, start, end time.Time) ([]TradeHistory, error) {
+	exch, err := withContext(ctx, exch)
+	if err != nil {
+		return nil, err
+	}
+	return exch.GetHistoricTrades(p, assetType, start, end)
+}
+
+// GetHistoricCandlesContext returns the candles of a currency pair within a
+// time range, abandoning its requests once the context is done
+func GetHistoricCandlesContext(ctx context.Context, exch IBotExchange, p currency.Pair, assetType asset.Item, start, end time.Time, interval kline.Interval) (kline.Item, error) {
+	exch, err := withContext(ctx, exch)
+	if err != nil {
+		return kline.Item{}, err
+	}
+	return exch.GetHistoricCandles(p, assetType, start, end, interval)
+}
+
+// CancelOrderContext cancels an order, abandoning its requests once the
+// context is done. The order may still be cancelled after the call is
+// abandoned
+func CancelOrderContext(ctx context.Context, exch IBotExchange, order *OrderCancellation) error {
+	exch, err := withContext(ctx, exch)
+	if err != nil {
+		return err
+	}
+	return exch.CancelOrder(order)
+}
+
+// CancelAllOrdersContext cancels all orders matching the cancellation,
+// abandoning its requests once the context is done. The orders may still be
+// cancelled after the call is abandoned
+func CancelAllOrdersContext(ctx context.Context, exch IBotExchange, orders *OrderCancellation) (CancelAllOrdersResponse, error) {
+	exch, err := withContext(ctx, exch)
+	if err != nil {
+		return CancelAllOrdersResponse{}, err
+	}
+	return exch.CancelAllOrders(orders)
+}
+
+// SubmitOrderContext submits an order, abandoning its requests once the
+// context is done. An abandoned order may still be placed, callers must
+// treat its state as unknown rather than rejected
+func SubmitOrderContext(ctx context.Context, exch IBotExchange, p currency.Pair, assetType asset.Item, side OrderSide, orderType OrderType, amount, price float64, clientID string) (SubmitOrderResponse, error) {
+	exch, err := withContext(ctx, exch)
+	if err != nil {
+		return SubmitOrderResponse{}, err
+	}
+	return exch.SubmitOrder(p, assetType, side, orderType, amount, price, clientID)
+}
+
+// ModifyOrderContext modifies an order unless the context is already done.
+// Once sent the call is never abandoned, as the order may be replaced
+// regardless and its new ID would be lost
+func ModifyOrderContext(ctx context.Context, exch IBotExchange, action *ModifyOrder) (string, error) {
+	if err := ctx.Err(); err != nil {
+		return "", err
+	}
+	return exch.ModifyOrder(action)
+}
+
+// WithdrawCryptocurrencyFundsContext withdraws cryptocurrency, abandoning its
+// requests once the context is done. An abandoned withdrawal may still be
+// processed, callers must check the funding history before retrying
+func WithdrawCryptocurrencyFundsContext(ctx context.Context, exch IBotExchange, withdrawRequest *WithdrawRequest) (string, error) {
+	exch, err := withContext(ctx, exch)
+	if err != nil {
+		return "", err
+	}
+	return exch.WithdrawCryptocurrencyFunds(withdrawRequest)
+}
+
+// WithdrawFiatFundsContext withdraws fiat to a bank account, abandoning its
+// requests once the context is done. An abandoned withdrawal may still be
+// processed, callers must check the funding history before retrying
+func WithdrawFiatFundsContext(ctx context.Context, exch IBotExchange, withdrawRequest *WithdrawRequest) (string, error) {
+	exch, err := withContext(ctx, exch)
+	if err != nil {
+		return "", err
+	}
+	return exch.WithdrawFiatFunds(withdrawRequest)
+}
+
+// WithdrawFiatFundsToInternationalBankContext withdraws fiat to an
+// international bank account, abandoning its requests once the context is
+// done. An abandoned withdrawal may still be processed, callers must check
+// the funding history before retrying
+func WithdrawFiatFundsToInternationalBankContext(ctx context.Context, exch IBotExchange, withdrawRequest *WithdrawRequest) (string, error) {
+	exch, err := withContext(ctx, exch)
+	if err != nil {
+		return "", err
+	}
+	return exch.WithdrawFiatFundsToInternationalBank(withdrawRequest)
+}
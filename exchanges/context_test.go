@@ -0,0 +1,144 @@
+package exchange
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+)
+
+// contextTestExchange is an exchange whose ticker is fetched from a test
+// server through the base requester
+type contextTestExchange struct {
+	IBotExchange
+	base   Base
+	placed int
+}
+
+func (c *contextTestExchange) WithContext(ctx context.Context) IBotExchange {
+	cp := *c
+	cp.base = c.base.ContextBase(ctx)
+	return &cp
+}
+
+// syncTestExchange is an exchange which cannot bind a context
+type syncTestExchange struct {
+	IBotExchange
+	placed    int
+	withdrawn int
+}
+
+func (s *syncTestExchange) SubmitOrder(_ currency.Pair, _ asset.Item, _ OrderSide, _ OrderType, _, _ float64, _ string) (SubmitOrderResponse, error) {
+	s.placed++
+	return SubmitOrderResponse{IsOrderPlaced: true, OrderID: "1"}, nil
+}
+
+func (s *syncTestExchange) WithdrawCryptocurrencyFunds(_ *WithdrawRequest) (string, error) {
+	s.withdrawn++
+	return "1", nil
+}
+
+func (c *contextTestExchange) UpdateTicker(p currency.Pair, _ asset.Item) (ticker.Price, error) {
+	var result struct {
+		Last float64 `json:"last"`
+	}
+	err := c.base.SendPayload(http.MethodGet, c.base.APIUrl, nil, nil, &result, false,
+		false, false, false, false)
+	if err != nil {
+		return ticker.Price{}, err
+	}
+	return ticker.Price{Pair: p, Last: result.Last}, nil
+}
+
+func newContextTestExchange(delay time.Duration) (*contextTestExchange, *httptest.Server) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-time.After(delay):
+		case <-r.Context().Done():
+			return
+		}
+		w.Write([]byte(`{"last":100}`)) // nolint:errcheck
+	}))
+
+	c := &contextTestExchange{}
+	c.base.Name = "ContextTest"
+	c.base.APIUrl = server.URL
+	c.base.Requester = request.New(c.base.Name,
+		request.NewRateLimit(time.Second, 100),
+		request.NewRateLimit(time.Second, 100),
+		new(http.Client))
+	return c, server
+}
+
+func TestUpdateTickerContext(t *testing.T) {
+	exch, server := newContextTestExchange(time.Second * 5)
+	defer server.Close()
+	p := currency.NewPairFromStrings("BTC", "USD")
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*20)
+	defer cancel()
+	start := time.Now()
+	price, err := UpdateTickerContext(ctx, exch, p, asset.Spot)
+	if err != context.DeadlineExceeded || price.Last != 0 {
+		t.Errorf("Test failed. Expected deadline exceeded got %v %v", err, price)
+	}
+	if time.Since(start) > time.Second {
+		t.Error("Test failed. Expected the request to be abandoned")
+	}
+
+	exch, server = newContextTestExchange(0)
+	defer server.Close()
+	price, err = UpdateTickerContext(context.Background(), exch, p, asset.Spot)
+	if err != nil || price.Last != 100 {
+		t.Errorf("Test failed. Unexpected ticker %v %v", price, err)
+	}
+}
+
+func TestSubmitOrderContext(t *testing.T) {
+	exch := &syncTestExchange{}
+	p := currency.NewPairFromStrings("BTC", "USD")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := SubmitOrderContext(ctx, exch, p, asset.Spot, BuyOrderSide, LimitOrderType, 1,
+		1, "")
+	if err != context.Canceled || exch.placed != 0 {
+		t.Errorf("Test failed. Expected the order not to be sent, error %v", err)
+	}
+
+	// Exchanges which cannot bind a context run to completion
+	resp, err := SubmitOrderContext(context.Background(), exch, p, asset.Spot,
+		BuyOrderSide, LimitOrderType, 1, 1, "")
+	if err != nil || !resp.IsOrderPlaced || exch.placed != 1 {
+		t.Errorf("Test failed. Expected the order to be placed %v %v", resp, err)
+	}
+}
+
+func TestWithdrawCryptocurrencyFundsContext(t *testing.T) {
+	exch := &syncTestExchange{}
+	req := &WithdrawRequest{Currency: currency.BTC, Address: "1", Amount: 1}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := WithdrawCryptocurrencyFundsContext(ctx, exch, req)
+	if err != context.Canceled || exch.withdrawn != 0 {
+		t.Errorf("Test failed. Expected the withdrawal not to be sent, error %v", err)
+	}
+
+	id, err := WithdrawCryptocurrencyFundsContext(context.Background(), exch, req)
+	if err != nil || id != "1" || exch.withdrawn != 1 {
+		t.Errorf("Test failed. Expected the withdrawal to be sent %v %v", id, err)
+	}
+}
+
+func TestCancelRequests(t *testing.T) {
+	var b Base
+	// A base without a requester has nothing to cancel
+	b.CancelRequests()
+}
@@ -1,6 +1,7 @@
 package exchange
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"net/http"
@@ -13,6 +14,8 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
@@ -22,6 +25,7 @@ import (
 
 const (
 	warningBase64DecryptSecretKeyFailed = "exchange %s unable to base64 decode secret key.. Disabling Authenticated API support" // nolint:gosec
+	warningAssetTypesUnsupported        = "exchange %s asset types %s are unsupported, resetting to %s"
 	// WarningAuthenticatedRequestWithoutCredentialsSet error message for authenticated request without credentials set
 	WarningAuthenticatedRequestWithoutCredentialsSet = "exchange %s authenticated HTTP request called but not supported due to unset/default API keys"
 	// ErrExchangeNotFound is a stand for an error message
@@ -36,6 +40,10 @@ const (
 	DefaultWebsocketOrderbookBufferLimit = 5
 )
 
+// ErrInvalidTimeRange is returned when a historic request has an unset time
+// range or a start time after the end time
+var ErrInvalidTimeRange = errors.New("invalid time range, start time must be before end time")
+
 // FeeType custom type for calculating fees based on method
 type FeeType uint8
 
@@ -101,6 +109,7 @@ type OrderCancellation struct {
 	WalletAddress string
 	Side          OrderSide
 	CurrencyPair  currency.Pair
+	AssetType     asset.Item
 }
 
 // WithdrawRequest used for wrapper crypto and FIAT withdraw methods
@@ -193,6 +202,29 @@ const (
 	WebsocketAuthentication uint8 = 1
 )
 
+// cryptoWithdrawalMethods and fiatWithdrawalMethods are the withdraw
+// permissions which describe how a withdrawal is made. AutoWithdrawCrypto,
+// AutoWithdrawFiat and NoFiatWithdrawals are derived from the exchange
+// features when none are set
+const (
+	cryptoWithdrawalMethods = AutoWithdrawCryptoWithAPIPermission |
+		AutoWithdrawCryptoWithSetup |
+		WithdrawCryptoWith2FA |
+		WithdrawCryptoWithSMS |
+		WithdrawCryptoWithEmail |
+		WithdrawCryptoWithWebsiteApproval |
+		WithdrawCryptoWithAPIPermission |
+		WithdrawCryptoViaWebsiteOnly
+	fiatWithdrawalMethods = AutoWithdrawFiatWithAPIPermission |
+		AutoWithdrawFiatWithSetup |
+		WithdrawFiatWith2FA |
+		WithdrawFiatWithSMS |
+		WithdrawFiatWithEmail |
+		WithdrawFiatWithWebsiteApproval |
+		WithdrawFiatWithAPIPermission |
+		WithdrawFiatViaWebsiteOnly
+)
+
 // AccountInfo is a Generic type to hold each exchange's holdings in
 // all enabled currencies
 type AccountInfo struct {
@@ -202,8 +234,11 @@ type AccountInfo struct {
 
 // Account defines a singular account type with asocciated currencies
 type Account struct {
-	ID         string
-	Currencies []AccountCurrencyInfo
+	ID string
+	// CredentialSet is the name of the credential set the account belongs to
+	// when account info is aggregated across credential sets
+	CredentialSet string
+	Currencies    []AccountCurrencyInfo
 }
 
 // AccountCurrencyInfo is a sub type to store currency name and value
@@ -217,9 +252,11 @@ type AccountCurrencyInfo struct {
 type TradeHistory struct {
 	Timestamp   time.Time
 	TID         int64
+	ID          string
 	Price       float64
 	Amount      float64
 	Exchange    string
+	Side        OrderSide
 	Type        string
 	Fee         float64
 	Description string
@@ -230,7 +267,9 @@ type OrderDetail struct {
 	Exchange        string
 	AccountID       string
 	ID              string
+	ClientID        string
 	CurrencyPair    currency.Pair
+	AssetType       asset.Item
 	OrderSide       OrderSide
 	OrderType       OrderType
 	OrderDate       time.Time
@@ -279,10 +318,10 @@ type Base struct {
 	BaseCurrencies                             currency.Currencies
 	AvailablePairs                             currency.Pairs
 	EnabledPairs                               currency.Pairs
-	AssetTypes                                 []string
+	AssetTypes                                 asset.Items
+	AssetPairs                                 map[asset.Item]*AssetPairs
 	PairsLastUpdated                           int64
-	SupportsAutoPairUpdating                   bool
-	SupportsRESTTickerBatching                 bool
+	Features                                   Features
 	HTTPTimeout                                time.Duration
 	HTTPUserAgent                              string
 	HTTPDebugging                              bool
@@ -296,6 +335,13 @@ type Base struct {
 	ConfigCurrencyPairFormat                   config.CurrencyPairFormatConfig
 	Websocket                                  *wshandler.Websocket
 	*request.Requester
+
+	// requestCtx is the context of the HTTP requests of a base copied by
+	// ContextBase, nil for the exchange itself
+	requestCtx context.Context
+	// config is the bot config the exchange reads and writes its settings
+	// to, the global config is used if it is not set
+	config *config.Config
 }
 
 // IBotExchange enforces standard functions for all exchanges supported in
@@ -307,26 +353,32 @@ type IBotExchange interface {
 	GetName() string
 	IsEnabled() bool
 	SetEnabled(bool)
-	GetTickerPrice(currency currency.Pair, assetType string) (ticker.Price, error)
-	UpdateTicker(currency currency.Pair, assetType string) (ticker.Price, error)
-	GetOrderbookEx(currency currency.Pair, assetType string) (orderbook.Base, error)
-	UpdateOrderbook(currency currency.Pair, assetType string) (orderbook.Base, error)
+	GetTickerPrice(currency currency.Pair, assetType asset.Item) (ticker.Price, error)
+	UpdateTicker(currency currency.Pair, assetType asset.Item) (ticker.Price, error)
+	GetOrderbookEx(currency currency.Pair, assetType asset.Item) (orderbook.Base, error)
+	UpdateOrderbook(currency currency.Pair, assetType asset.Item) (orderbook.Base, error)
 	GetEnabledCurrencies() currency.Pairs
 	GetAvailableCurrencies() currency.Pairs
-	GetAssetTypes() []string
+	GetAssetTypes() asset.Items
+	GetEnabledPairs(assetType asset.Item) currency.Pairs
+	GetAvailablePairs(assetType asset.Item) currency.Pairs
 	GetAccountInfo() (AccountInfo, error)
 	GetAuthenticatedAPISupport(endpoint uint8) bool
 	SetCurrencies(pairs []currency.Pair, enabledPairs bool) error
-	GetExchangeHistory(p currency.Pair, assetType string) ([]TradeHistory, error)
+	GetExchangeHistory(p currency.Pair, assetType asset.Item) ([]TradeHistory, error)
+	GetHistoricTrades(p currency.Pair, assetType asset.Item, timestampStart, timestampEnd time.Time) ([]TradeHistory, error)
+	GetHistoricCandles(p currency.Pair, assetType asset.Item, timestampStart, timestampEnd time.Time, interval kline.Interval) (kline.Item, error)
 	SupportsAutoPairUpdates() bool
 	GetLastPairsUpdateTime() int64
 	SupportsRESTTickerBatchUpdates() bool
+	SupportsHistoricTradeRetrieval() bool
+	SupportsHistoricCandleRetrieval() bool
 	GetFeeByType(feeBuilder *FeeBuilder) (float64, error)
 	GetWithdrawPermissions() uint32
 	FormatWithdrawPermissions() string
 	SupportsWithdrawPermissions(permissions uint32) bool
 	GetFundingHistory() ([]FundHistory, error)
-	SubmitOrder(p currency.Pair, side OrderSide, orderType OrderType, amount, price float64, clientID string) (SubmitOrderResponse, error)
+	SubmitOrder(p currency.Pair, assetType asset.Item, side OrderSide, orderType OrderType, amount, price float64, clientID string) (SubmitOrderResponse, error)
 	ModifyOrder(action *ModifyOrder) (string, error)
 	CancelOrder(order *OrderCancellation) error
 	CancelAllOrders(orders *OrderCancellation) (CancelAllOrdersResponse, error)
@@ -342,12 +394,43 @@ type IBotExchange interface {
 	UnsubscribeToWebsocketChannels(channels []wshandler.WebsocketChannelSubscription) error
 	AuthenticateWebsocket() error
 	GetSubscriptions() ([]wshandler.WebsocketChannelSubscription, error)
+	GetRequestStats() request.Stats
+	CancelRequests()
+	GetFeatures() Features
+	SetConfig(cfg *config.Config)
+}
+
+// SetConfig sets the bot config the exchange reads and writes its settings
+// to, it must be called before Setup
+func (e *Base) SetConfig(cfg *config.Config) {
+	e.config = cfg
+}
+
+// GetConfig returns the bot config of the exchange, or the global config if
+// one has not been set
+func (e *Base) GetConfig() *config.Config {
+	if e.config == nil {
+		return config.GetConfig()
+	}
+	return e.config
 }
 
 // SupportsRESTTickerBatchUpdates returns whether or not the
 // exhange supports REST batch ticker fetching
 func (e *Base) SupportsRESTTickerBatchUpdates() bool {
-	return e.SupportsRESTTickerBatching
+	return e.Features.REST.TickerBatching
+}
+
+// SupportsHistoricTradeRetrieval returns whether or not the exchange supports
+// fetching trades for a time range
+func (e *Base) SupportsHistoricTradeRetrieval() bool {
+	return e.Features.REST.HistoricTrades
+}
+
+// SupportsHistoricCandleRetrieval returns whether or not the exchange supports
+// fetching candles for a time range
+func (e *Base) SupportsHistoricCandleRetrieval() bool {
+	return e.Features.REST.KlineFetching
 }
 
 // SetHTTPClientTimeout sets the timeout value for the exchanges
@@ -396,6 +479,26 @@ func (e *Base) SetHTTPClientUserAgent(ua string) {
 	e.HTTPUserAgent = ua
 }
 
+// SetHTTPRetryPolicy sets how the exchange retries failed HTTP requests, a
+// nil config keeps the default retry policy
+func (e *Base) SetHTTPRetryPolicy(cfg *config.HTTPRetryConfig) {
+	if cfg == nil {
+		return
+	}
+
+	if e.Requester == nil {
+		e.Requester = request.New(e.Name,
+			request.NewRateLimit(time.Second, 0),
+			request.NewRateLimit(time.Second, 0),
+			new(http.Client))
+	}
+	e.Requester.SetRetryPolicy(request.RetryPolicy{
+		MaxRetries: cfg.MaxRetries,
+		MinBackoff: cfg.MinBackoff,
+		MaxBackoff: cfg.MaxBackoff,
+	})
+}
+
 // GetHTTPClientUserAgent gets the exchanges HTTP user agent
 func (e *Base) GetHTTPClientUserAgent() string {
 	return e.HTTPUserAgent
@@ -429,14 +532,14 @@ func (e *Base) SetClientProxyAddress(addr string) error {
 // SetAutoPairDefaults sets the default values for whether or not the exchange
 // supports auto pair updating or not
 func (e *Base) SetAutoPairDefaults() error {
-	cfg := config.GetConfig()
+	cfg := e.GetConfig()
 	exch, err := cfg.GetExchangeConfig(e.Name)
 	if err != nil {
 		return err
 	}
 
 	update := false
-	if e.SupportsAutoPairUpdating {
+	if e.Features.REST.AutoPairUpdates {
 		if !exch.SupportsAutoPairUpdates {
 			exch.SupportsAutoPairUpdates = true
 			exch.PairsLastUpdated = 0
@@ -459,7 +562,7 @@ func (e *Base) SetAutoPairDefaults() error {
 // SupportsAutoPairUpdates returns whether or not the exchange supports
 // auto currency pair updating
 func (e *Base) SupportsAutoPairUpdates() bool {
-	return e.SupportsAutoPairUpdating
+	return e.Features.REST.AutoPairUpdates
 }
 
 // GetLastPairsUpdateTime returns the unix timestamp of when the exchanges
@@ -469,58 +572,72 @@ func (e *Base) GetLastPairsUpdateTime() int64 {
 }
 
 // SetAssetTypes checks the exchange asset types (whether it supports SPOT,
-// Binary or Futures) and sets it to a default setting if it doesn't exist
+// margin or futures) and sets it to a default setting if it doesn't exist.
+// Asset types the exchange doesn't support reset the config to the defaults,
+// the first asset type is the exchanges primary asset type and can't change
 func (e *Base) SetAssetTypes() error {
-	cfg := config.GetConfig()
+	cfg := e.GetConfig()
 	exch, err := cfg.GetExchangeConfig(e.Name)
 	if err != nil {
 		return err
 	}
 
-	var update bool
-	if exch.AssetTypes == "" {
-		exch.AssetTypes = common.JoinStrings(e.AssetTypes, ",")
-		update = true
+	if len(exch.AssetTypes) == 0 || !e.supportsAssetTypes(exch.AssetTypes) {
+		if len(exch.AssetTypes) != 0 {
+			log.Warnf(warningAssetTypesUnsupported, e.Name,
+				exch.AssetTypes.JoinToString(","), e.AssetTypes.JoinToString(","))
+		}
+		exch.AssetTypes = e.AssetTypes
 	} else {
-		e.AssetTypes = common.SplitStrings(exch.AssetTypes, ",")
-		update = true
+		e.AssetTypes = exch.AssetTypes
 	}
 
-	if update {
-		return cfg.UpdateExchangeConfig(&exch)
-	}
+	e.setAssetPairs(&exch)
+	return cfg.UpdateExchangeConfig(&exch)
+}
 
-	return nil
+// supportsAssetTypes returns whether the exchange supports every asset type
+// and the primary asset type comes first
+func (e *Base) supportsAssetTypes(assetTypes asset.Items) bool {
+	if len(e.AssetTypes) == 0 || assetTypes[0] != e.AssetTypes[0] {
+		return false
+	}
+	for x := range assetTypes {
+		if !e.AssetTypes.Contains(assetTypes[x]) {
+			return false
+		}
+	}
+	return true
 }
 
 // GetAssetTypes returns the available asset types for an individual exchange
-func (e *Base) GetAssetTypes() []string {
+func (e *Base) GetAssetTypes() asset.Items {
 	return e.AssetTypes
 }
 
 // GetExchangeAssetTypes returns the asset types the exchange supports (SPOT,
-// binary, futures)
-func GetExchangeAssetTypes(exchName string) ([]string, error) {
+// margin, futures)
+func GetExchangeAssetTypes(exchName string) (asset.Items, error) {
 	cfg := config.GetConfig()
 	exch, err := cfg.GetExchangeConfig(exchName)
 	if err != nil {
 		return nil, err
 	}
 
-	return common.SplitStrings(exch.AssetTypes, ","), nil
+	return exch.AssetTypes, nil
 }
 
 // GetClientBankAccounts returns banking details associated with
 // a client for withdrawal purposes
 func (e *Base) GetClientBankAccounts(exchangeName, withdrawalCurrency string) (config.BankAccount, error) {
-	cfg := config.GetConfig()
+	cfg := e.GetConfig()
 	return cfg.GetClientBankAccounts(exchangeName, withdrawalCurrency)
 }
 
 // GetExchangeBankAccounts returns banking details associated with an
 // exchange for funding purposes
 func (e *Base) GetExchangeBankAccounts(exchangeName, depositCurrency string) (config.BankAccount, error) {
-	cfg := config.GetConfig()
+	cfg := e.GetConfig()
 	return cfg.GetExchangeBankAccounts(exchangeName, depositCurrency)
 }
 
@@ -539,7 +656,7 @@ func CompareCurrencyPairFormats(pair1 config.CurrencyPairFormatConfig, pair2 *co
 // SetCurrencyPairFormat checks the exchange request and config currency pair
 // formats and sets it to a default setting if it doesn't exist
 func (e *Base) SetCurrencyPairFormat() error {
-	cfg := config.GetConfig()
+	cfg := e.GetConfig()
 	exch, err := cfg.GetExchangeConfig(e.Name)
 	if err != nil {
 		return err
@@ -675,6 +792,27 @@ func FormatExchangeCurrency(exchName string, p currency.Pair) currency.Pair {
 		exch.RequestCurrencyPairFormat.Uppercase)
 }
 
+// FormatExchangeCurrency formats and returns a currency pair in the request
+// format of the exchange
+func (e *Base) FormatExchangeCurrency(p currency.Pair) currency.Pair {
+	return p.Format(e.RequestCurrencyPairFormat.Delimiter,
+		e.RequestCurrencyPairFormat.Uppercase)
+}
+
+// GetAndFormatExchangeCurrencies returns the currency pairs in the request
+// format of the exchange joined by its request separator
+func (e *Base) GetAndFormatExchangeCurrencies(pairs []currency.Pair) string {
+	var currencyItems string
+	for x := range pairs {
+		currencyItems += e.FormatExchangeCurrency(pairs[x]).String()
+		if x == len(pairs)-1 {
+			continue
+		}
+		currencyItems += e.RequestCurrencyPairFormat.Separator
+	}
+	return currencyItems
+}
+
 // FormatCurrency is a method that formats and returns a currency pair
 // based on the user currency display preferences
 func FormatCurrency(p currency.Pair) currency.Pair {
@@ -720,7 +858,7 @@ func (e *Base) SetCurrencies(pairs []currency.Pair, enabledPairs bool) error {
 		return fmt.Errorf("%s SetCurrencies error - pairs is empty", e.Name)
 	}
 
-	cfg := config.GetConfig()
+	cfg := e.GetConfig()
 	exchCfg, err := cfg.GetExchangeConfig(e.Name)
 	if err != nil {
 		return err
@@ -772,7 +910,7 @@ func (e *Base) UpdateCurrencies(exchangeProducts currency.Pairs, enabled, force
 	}
 
 	if force || len(newPairs) > 0 || len(removedPairs) > 0 {
-		cfg := config.GetConfig()
+		cfg := e.GetConfig()
 		exch, err := cfg.GetExchangeConfig(e.Name)
 		if err != nil {
 			return err
@@ -810,6 +948,7 @@ type ModifyOrder struct {
 	LimitPriceUpper float64
 	LimitPriceLower float64
 	CurrencyPair    currency.Pair
+	AssetType       asset.Item
 
 	ImmediateOrCancel bool
 	HiddenOrder       bool
@@ -910,9 +1049,24 @@ func (e *Base) GetAPIURLSecondaryDefault() string {
 	return e.APIUrlSecondaryDefault
 }
 
-// GetWithdrawPermissions passes through the exchange's withdraw permissions
+// GetWithdrawPermissions passes through the exchange's withdraw permissions,
+// whether crypto and fiat withdrawals are supported at all is taken from the
+// exchange REST features
 func (e *Base) GetWithdrawPermissions() uint32 {
-	return e.APIWithdrawPermissions
+	permissions := e.APIWithdrawPermissions
+	if e.Features.REST.CryptoWithdrawal &&
+		permissions&cryptoWithdrawalMethods == 0 {
+		permissions |= AutoWithdrawCrypto
+	}
+	if e.Features.REST.FiatWithdrawal {
+		if permissions&fiatWithdrawalMethods == 0 {
+			permissions |= AutoWithdrawFiat
+		}
+	} else if permissions != NoAPIWithdrawalMethods &&
+		permissions&WithdrawFiatViaWebsiteOnly == 0 {
+		permissions |= NoFiatWithdrawals
+	}
+	return permissions
 }
 
 // SupportsWithdrawPermissions compares the supplied permissions with the exchange's to verify they're supported
@@ -986,6 +1140,8 @@ type GetOrdersRequest struct {
 	EndTicks   time.Time
 	// Currencies Empty array = all currencies. Some endpoints only support singular currency enquiries
 	Currencies []currency.Pair
+	// AssetType empty = the exchanges primary asset type
+	AssetType asset.Item
 }
 
 // OrderStatus defines order status types
@@ -1198,3 +1354,84 @@ func SortOrdersBySide(orders *[]OrderDetail, reverse bool) {
 		sort.Sort(ByOrderSide(*orders))
 	}
 }
+
+// CheckTimeRange returns an error if either time is unset or the start time is
+// after the end time
+func CheckTimeRange(start, end time.Time) error {
+	if start.IsZero() || end.IsZero() || start.After(end) {
+		return ErrInvalidTimeRange
+	}
+	return nil
+}
+
+// FilterTradesByTimeRange removes any trades outside of the time range along
+// with trades repeated across pages, then sorts the remaining trades by
+// timestamp
+func FilterTradesByTimeRange(trades *[]TradeHistory, start, end time.Time) {
+	seen := make(map[string]bool)
+	var filteredTrades []TradeHistory
+	for i := range *trades {
+		t := (*trades)[i]
+		if t.Timestamp.Before(start) || t.Timestamp.After(end) {
+			continue
+		}
+
+		if t.ID != "" {
+			if seen[t.ID] {
+				continue
+			}
+			seen[t.ID] = true
+		}
+		filteredTrades = append(filteredTrades, t)
+	}
+
+	sort.SliceStable(filteredTrades, func(i, j int) bool {
+		return filteredTrades[i].Timestamp.Before(filteredTrades[j].Timestamp)
+	})
+	*trades = filteredTrades
+}
+
+// HistoricTradeRetriever is implemented by exchanges which can fetch trades
+// for a time range
+type HistoricTradeRetriever interface {
+	GetName() string
+	SupportsHistoricTradeRetrieval() bool
+	GetHistoricTrades(p currency.Pair, assetType asset.Item, timestampStart, timestampEnd time.Time) ([]TradeHistory, error)
+}
+
+// CreateKlineFromTrades builds candles for the time range and interval from
+// the exchanges historic trades, for exchanges which do not provide candles or
+// do not support the interval
+func CreateKlineFromTrades(exch HistoricTradeRetriever, p currency.Pair, assetType asset.Item, timestampStart, timestampEnd time.Time, interval kline.Interval) (kline.Item, error) {
+	err := kline.CheckRequest(timestampStart, timestampEnd, interval)
+	if err != nil {
+		return kline.Item{}, err
+	}
+
+	if !exch.SupportsHistoricTradeRetrieval() {
+		return kline.Item{}, common.ErrFunctionNotSupported
+	}
+
+	start := timestampStart.Truncate(interval.Duration())
+	trades, err := exch.GetHistoricTrades(p, assetType, start, timestampEnd)
+	if err != nil {
+		return kline.Item{}, err
+	}
+
+	klineTrades := make([]kline.Trade, len(trades))
+	for i := range trades {
+		klineTrades[i] = kline.Trade{
+			Timestamp: trades[i].Timestamp,
+			Price:     trades[i].Price,
+			Amount:    trades[i].Amount,
+		}
+	}
+
+	item, err := kline.CreateKline(klineTrades, interval, p, assetType,
+		exch.GetName())
+	if err != nil {
+		return kline.Item{}, err
+	}
+	item.FilterCandles(timestampStart, timestampEnd)
+	return item, nil
+}
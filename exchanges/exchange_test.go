@@ -9,9 +9,9 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/currency"
-	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
-	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/websocket/wshandler"
 )
 
@@ -22,8 +22,8 @@ const (
 
 func TestSupportsRESTTickerBatchUpdates(t *testing.T) {
 	b := Base{
-		Name:                       "RAWR",
-		SupportsRESTTickerBatching: true,
+		Name:     "RAWR",
+		Features: Features{REST: ProtocolFeatures{TickerBatching: true}},
 	}
 
 	if !b.SupportsRESTTickerBatchUpdates() {
@@ -31,6 +31,25 @@ func TestSupportsRESTTickerBatchUpdates(t *testing.T) {
 	}
 }
 
+func TestSetHTTPRetryPolicy(t *testing.T) {
+	b := Base{Name: "RAWR"}
+	b.SetHTTPRetryPolicy(nil)
+	if b.Requester != nil {
+		t.Error("Test failed. Expected nil config to leave the requester unset")
+	}
+
+	b.SetHTTPRetryPolicy(&config.HTTPRetryConfig{
+		MaxRetries: 5,
+		MinBackoff: time.Second,
+		MaxBackoff: time.Minute,
+	})
+	p := b.Requester.GetRetryPolicy()
+	if p.MaxRetries != 5 || p.MinBackoff != time.Second ||
+		p.MaxBackoff != time.Minute {
+		t.Errorf("Test failed. Unexpected retry policy %+v", p)
+	}
+}
+
 func TestHTTPClient(t *testing.T) {
 	r := Base{Name: "asdf"}
 	r.SetHTTPClientTimeout(time.Second * 5)
@@ -110,8 +129,8 @@ func TestSetAutoPairDefaults(t *testing.T) {
 	}
 
 	b := Base{
-		Name:                     "TESTNAME",
-		SupportsAutoPairUpdating: true,
+		Name:     "TESTNAME",
+		Features: Features{REST: ProtocolFeatures{AutoPairUpdates: true}},
 	}
 
 	err = b.SetAutoPairDefaults()
@@ -167,7 +186,7 @@ func TestSetAutoPairDefaults(t *testing.T) {
 		t.Fatal("Test failed. TestSetAutoPairDefaults Incorrect value")
 	}
 
-	b.SupportsAutoPairUpdating = false
+	b.Features.REST.AutoPairUpdates = false
 	err = b.SetAutoPairDefaults()
 	if err != nil {
 		t.Fatalf("Test failed. TestSetAutoPairDefaults. Error %s", err)
@@ -180,8 +199,8 @@ func TestSetAutoPairDefaults(t *testing.T) {
 
 func TestSupportsAutoPairUpdates(t *testing.T) {
 	b := Base{
-		Name:                     "TESTNAME",
-		SupportsAutoPairUpdating: false,
+		Name:     "TESTNAME",
+		Features: Features{REST: ProtocolFeatures{AutoPairUpdates: false}},
 	}
 
 	if b.SupportsAutoPairUpdates() {
@@ -218,7 +237,7 @@ func TestSetAssetTypes(t *testing.T) {
 	}
 
 	b.Name = defaultTestExchange
-	b.AssetTypes = []string{orderbook.Spot}
+	b.AssetTypes = asset.Items{asset.Spot}
 	err = b.SetAssetTypes()
 	if err != nil {
 		t.Fatalf("Test failed. TestSetAssetTypes. Error %s", err)
@@ -229,7 +248,7 @@ func TestSetAssetTypes(t *testing.T) {
 		t.Fatalf("Test failed. TestSetAssetTypes load config failed. Error %s", err)
 	}
 
-	exch.AssetTypes = ""
+	exch.AssetTypes = nil
 	err = cfg.UpdateExchangeConfig(&exch)
 	if err != nil {
 		t.Fatalf("Test failed. TestSetAssetTypes update config failed. Error %s", err)
@@ -240,8 +259,8 @@ func TestSetAssetTypes(t *testing.T) {
 		t.Fatalf("Test failed. TestSetAssetTypes load config failed. Error %s", err)
 	}
 
-	if exch.AssetTypes != "" {
-		t.Fatal("Test failed. TestSetAssetTypes assetTypes != ''")
+	if len(exch.AssetTypes) != 0 {
+		t.Fatal("Test failed. TestSetAssetTypes assetTypes is not empty")
 	}
 
 	err = b.SetAssetTypes()
@@ -249,14 +268,36 @@ func TestSetAssetTypes(t *testing.T) {
 		t.Fatalf("Test failed. TestSetAssetTypes. Error %s", err)
 	}
 
-	if !common.StringDataCompare(b.AssetTypes, ticker.Spot) {
+	if !b.AssetTypes.Contains(asset.Spot) {
 		t.Fatal("Test failed. TestSetAssetTypes assetTypes is not set")
 	}
+
+	// Asset types the exchange doesn't support reset to the defaults
+	exch.AssetTypes = asset.Items{asset.Spot, asset.Futures}
+	err = cfg.UpdateExchangeConfig(&exch)
+	if err != nil {
+		t.Fatalf("Test failed. TestSetAssetTypes update config failed. Error %s", err)
+	}
+
+	err = b.SetAssetTypes()
+	if err != nil {
+		t.Fatalf("Test failed. TestSetAssetTypes. Error %s", err)
+	}
+
+	exch, err = cfg.GetExchangeConfig(b.Name)
+	if err != nil {
+		t.Fatalf("Test failed. TestSetAssetTypes load config failed. Error %s", err)
+	}
+
+	if len(exch.AssetTypes) != 1 || len(b.AssetTypes) != 1 {
+		t.Errorf("Test failed. TestSetAssetTypes unsupported asset types were not reset %v",
+			exch.AssetTypes)
+	}
 }
 
 func TestGetAssetTypes(t *testing.T) {
 	testExchange := Base{
-		AssetTypes: []string{orderbook.Spot, "Binary", "Futures"},
+		AssetTypes: asset.Items{asset.Spot, asset.Margin, asset.Futures},
 	}
 
 	aT := testExchange.GetAssetTypes()
@@ -277,7 +318,7 @@ func TestGetExchangeAssetTypes(t *testing.T) {
 		t.Fatal("Test failed. Unable to obtain Bitfinex asset types")
 	}
 
-	if !common.StringDataCompare(result, ticker.Spot) {
+	if !result.Contains(asset.Spot) {
 		t.Fatal("Test failed. Bitfinex does not contain default asset type 'SPOT'")
 	}
 
@@ -644,6 +685,28 @@ func TestFormatExchangeCurrency(t *testing.T) {
 	}
 }
 
+func TestBaseFormatExchangeCurrency(t *testing.T) {
+	b := Base{
+		RequestCurrencyPairFormat: config.CurrencyPairFormatConfig{
+			Delimiter: "_",
+			Separator: "-",
+		},
+	}
+
+	p := currency.NewPair(currency.BTC, currency.USD)
+	if actual := b.FormatExchangeCurrency(p).String(); actual != "btc_usd" {
+		t.Errorf("Test failed - Exchange TestBaseFormatExchangeCurrency %s != btc_usd",
+			actual)
+	}
+
+	actual := b.GetAndFormatExchangeCurrencies([]currency.Pair{p,
+		currency.NewPair(currency.LTC, currency.BTC)})
+	if actual != "btc_usd-ltc_btc" {
+		t.Errorf("Test failed - Exchange TestBaseFormatExchangeCurrency %s != btc_usd-ltc_btc",
+			actual)
+	}
+}
+
 func TestFormatCurrency(t *testing.T) {
 	cfg := config.GetConfig()
 	err := cfg.LoadConfig(config.ConfigTestFile)
@@ -905,6 +968,30 @@ func BenchmarkSetAPIURL(b *testing.B) {
 	}
 }
 
+func TestGetWithdrawPermissions(t *testing.T) {
+	UAC := Base{Name: defaultTestExchange}
+	if p := UAC.GetWithdrawPermissions(); p != NoAPIWithdrawalMethods {
+		t.Errorf("Expected: %v, Received: %v", NoAPIWithdrawalMethods, p)
+	}
+
+	UAC.Features.REST.CryptoWithdrawal = true
+	if p := UAC.GetWithdrawPermissions(); p != AutoWithdrawCrypto|NoFiatWithdrawals {
+		t.Errorf("Expected: %v, Received: %v", AutoWithdrawCrypto|NoFiatWithdrawals, p)
+	}
+
+	UAC.Features.REST.FiatWithdrawal = true
+	UAC.APIWithdrawPermissions = AutoWithdrawCryptoWithSetup
+	if p := UAC.GetWithdrawPermissions(); p != AutoWithdrawCryptoWithSetup|AutoWithdrawFiat {
+		t.Errorf("Expected: %v, Received: %v", AutoWithdrawCryptoWithSetup|AutoWithdrawFiat, p)
+	}
+
+	UAC.Features.REST.FiatWithdrawal = false
+	UAC.APIWithdrawPermissions = WithdrawFiatViaWebsiteOnly
+	if p := UAC.GetWithdrawPermissions(); p != AutoWithdrawCrypto|WithdrawFiatViaWebsiteOnly {
+		t.Errorf("Expected: %v, Received: %v", AutoWithdrawCrypto|WithdrawFiatViaWebsiteOnly, p)
+	}
+}
+
 func TestSupportsWithdrawPermissions(t *testing.T) {
 	UAC := Base{Name: defaultTestExchange}
 	UAC.APIWithdrawPermissions = AutoWithdrawCrypto | AutoWithdrawCryptoWithAPIPermission
@@ -1257,3 +1344,134 @@ func TestSortOrdersByOrderType(t *testing.T) {
 		t.Errorf("Test failed. Expected: '%v', received: '%v'", TrailingStopOrderType, orders[0].OrderType)
 	}
 }
+
+func TestSupportsHistoricTradeRetrieval(t *testing.T) {
+	b := Base{
+		Name:     "RAWR",
+		Features: Features{REST: ProtocolFeatures{HistoricTrades: true}},
+	}
+
+	if !b.SupportsHistoricTradeRetrieval() {
+		t.Error("Test failed. TestSupportsHistoricTradeRetrieval returned false")
+	}
+}
+
+func TestCheckTimeRange(t *testing.T) {
+	now := time.Now()
+	if err := CheckTimeRange(now.Add(-time.Hour), now); err != nil {
+		t.Errorf("Test failed. CheckTimeRange: %s", err)
+	}
+
+	ranges := [][2]time.Time{
+		{{}, now},
+		{now, {}},
+		{now, now.Add(-time.Hour)},
+	}
+	for x := range ranges {
+		if err := CheckTimeRange(ranges[x][0], ranges[x][1]); err != ErrInvalidTimeRange {
+			t.Errorf("Test failed. Expected %s, received %v", ErrInvalidTimeRange,
+				err)
+		}
+	}
+}
+
+func TestFilterTradesByTimeRange(t *testing.T) {
+	start := time.Unix(1000, 0)
+	end := time.Unix(2000, 0)
+	trades := []TradeHistory{
+		{ID: "4", Timestamp: time.Unix(2000, 0)},
+		{ID: "3", Timestamp: time.Unix(1500, 0)},
+		{ID: "5", Timestamp: time.Unix(2001, 0)},
+		{ID: "3", Timestamp: time.Unix(1500, 0)},
+		{ID: "1", Timestamp: time.Unix(999, 0)},
+		{ID: "2", Timestamp: time.Unix(1000, 0)},
+		{Timestamp: time.Unix(1200, 0)},
+	}
+
+	FilterTradesByTimeRange(&trades, start, end)
+	if len(trades) != 4 {
+		t.Fatalf("Test failed. Expected 4 trades, received %d", len(trades))
+	}
+
+	expected := []string{"2", "", "3", "4"}
+	for x := range trades {
+		if trades[x].ID != expected[x] {
+			t.Errorf("Test failed. Expected trade %q at %d, received %q",
+				expected[x], x, trades[x].ID)
+		}
+	}
+}
+
+func TestSupportsHistoricCandleRetrieval(t *testing.T) {
+	b := Base{
+		Name:     "RAWR",
+		Features: Features{REST: ProtocolFeatures{KlineFetching: true}},
+	}
+
+	if !b.SupportsHistoricCandleRetrieval() {
+		t.Error("Test failed. TestSupportsHistoricCandleRetrieval returned false")
+	}
+}
+
+type tradeRetriever struct {
+	Base
+	trades []TradeHistory
+}
+
+func (r *tradeRetriever) GetHistoricTrades(p currency.Pair, assetType asset.Item, timestampStart, timestampEnd time.Time) ([]TradeHistory, error) {
+	trades := append([]TradeHistory(nil), r.trades...)
+	FilterTradesByTimeRange(&trades, timestampStart, timestampEnd)
+	return trades, nil
+}
+
+func TestCreateKlineFromTrades(t *testing.T) {
+	r := &tradeRetriever{
+		Base: Base{Name: "RAWR"},
+		trades: []TradeHistory{
+			{Timestamp: time.Unix(110, 0), Price: 2, Amount: 1},
+			{Timestamp: time.Unix(60, 0), Price: 1, Amount: 1},
+			{Timestamp: time.Unix(150, 0), Price: 4, Amount: 2},
+			{Timestamp: time.Unix(170, 0), Price: 3, Amount: 1},
+			{Timestamp: time.Unix(190, 0), Price: 5, Amount: 1},
+		},
+	}
+	p := currency.NewPairFromString(defaultTestCurrencyPair)
+
+	_, err := CreateKlineFromTrades(r, p, asset.Spot, time.Unix(100, 0),
+		time.Unix(180, 0), kline.OneMin)
+	if err != common.ErrFunctionNotSupported {
+		t.Errorf("Test failed. Expected %s, received %v",
+			common.ErrFunctionNotSupported, err)
+	}
+
+	r.Features.REST.HistoricTrades = true
+	_, err = CreateKlineFromTrades(r, p, asset.Spot, time.Unix(180, 0),
+		time.Unix(100, 0), kline.OneMin)
+	if err != kline.ErrInvalidTimeRange {
+		t.Errorf("Test failed. Expected %s, received %v",
+			kline.ErrInvalidTimeRange, err)
+	}
+
+	item, err := CreateKlineFromTrades(r, p, asset.Spot, time.Unix(100, 0),
+		time.Unix(180, 0), kline.OneMin)
+	if err != nil {
+		t.Fatalf("Test failed. CreateKlineFromTrades error: %s", err)
+	}
+
+	if item.Exchange != "RAWR" || item.Interval != kline.OneMin {
+		t.Errorf("Test failed. Unexpected item %+v", item)
+	}
+
+	// The first candle starts at the start of the interval containing the
+	// start time and the last candle starts before the end time
+	if len(item.Candles) != 2 {
+		t.Fatalf("Test failed. Expected 2 candles, received %d",
+			len(item.Candles))
+	}
+
+	c := item.Candles[1]
+	if !c.Time.Equal(time.Unix(120, 0)) || c.Open != 4 || c.High != 4 ||
+		c.Low != 3 || c.Close != 3 || c.Volume != 3 {
+		t.Errorf("Test failed. Unexpected candle %+v", c)
+	}
+}
@@ -13,8 +13,8 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
-	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/websocket/wshandler"
 	log "github.com/thrasher-corp/gocryptotrader/logger"
 )
@@ -59,15 +59,31 @@ func (e *EXMO) SetDefaults() {
 	e.Enabled = false
 	e.Verbose = false
 	e.RESTPollingDelay = 10
-	e.APIWithdrawPermissions = exchange.AutoWithdrawCryptoWithSetup | exchange.NoFiatWithdrawals
+	e.APIWithdrawPermissions = exchange.AutoWithdrawCryptoWithSetup
 	e.RequestCurrencyPairFormat.Delimiter = "_"
 	e.RequestCurrencyPairFormat.Uppercase = true
 	e.RequestCurrencyPairFormat.Separator = ","
 	e.ConfigCurrencyPairFormat.Delimiter = "_"
 	e.ConfigCurrencyPairFormat.Uppercase = true
-	e.AssetTypes = []string{ticker.Spot}
-	e.SupportsAutoPairUpdating = true
-	e.SupportsRESTTickerBatching = true
+	e.AssetTypes = asset.Items{asset.Spot}
+	e.Features = exchange.Features{
+		REST: exchange.ProtocolFeatures{
+			TickerFetching:    true,
+			TickerBatching:    true,
+			OrderbookFetching: true,
+			TradeFetching:     true,
+			AutoPairUpdates:   true,
+			AccountInfo:       true,
+			ActiveOrders:      true,
+			OrderHistory:      true,
+			SubmitOrder:       true,
+			CancelOrder:       true,
+			CancelOrders:      true,
+			DepositAddress:    true,
+			CryptoWithdrawal:  true,
+		},
+		OrderTypes: []exchange.OrderType{exchange.LimitOrderType, exchange.MarketOrderType},
+	}
 	e.Requester = request.New(e.Name,
 		request.NewRateLimit(time.Minute, exmoAuthRate),
 		request.NewRateLimit(time.Minute, exmoUnauthRate),
@@ -88,6 +104,7 @@ func (e *EXMO) Setup(exch *config.ExchangeConfig) {
 		e.SetAPIKeys(exch.APIKey, exch.APISecret, "", false)
 		e.SetHTTPClientTimeout(exch.HTTPTimeout)
 		e.SetHTTPClientUserAgent(exch.HTTPUserAgent)
+		e.SetHTTPRetryPolicy(exch.HTTPRetry)
 		e.RESTPollingDelay = exch.RESTPollingDelay
 		e.Verbose = exch.Verbose
 		e.BaseCurrencies = exch.BaseCurrencies
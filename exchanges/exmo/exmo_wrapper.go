@@ -1,6 +1,7 @@
 package exmo
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"strconv"
@@ -11,6 +12,8 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/websocket/wshandler"
@@ -26,6 +29,14 @@ func (e *EXMO) Start(wg *sync.WaitGroup) {
 	}()
 }
 
+// WithContext returns a copy of EXMO whose HTTP requests are abandoned once
+// the context is done
+func (e *EXMO) WithContext(ctx context.Context) exchange.IBotExchange {
+	cp := *e
+	cp.Base = e.ContextBase(ctx)
+	return &cp
+}
+
 // Run implements the EXMO wrapper
 func (e *EXMO) Run() {
 	if e.Verbose {
@@ -56,12 +67,9 @@ func (e *EXMO) Run() {
 }
 
 // UpdateTicker updates and returns the ticker for a currency pair
-func (e *EXMO) UpdateTicker(p currency.Pair, assetType string) (ticker.Price, error) {
+func (e *EXMO) UpdateTicker(p currency.Pair, assetType asset.Item) (ticker.Price, error) {
 	var tickerPrice ticker.Price
-	pairsCollated, err := exchange.GetAndFormatExchangeCurrencies(e.Name, e.GetEnabledCurrencies())
-	if err != nil {
-		return tickerPrice, err
-	}
+	pairsCollated := e.GetAndFormatExchangeCurrencies(e.GetEnabledCurrencies())
 
 	result, err := e.GetTicker(pairsCollated)
 	if err != nil {
@@ -69,7 +77,7 @@ func (e *EXMO) UpdateTicker(p currency.Pair, assetType string) (ticker.Price, er
 	}
 
 	for _, x := range e.GetEnabledCurrencies() {
-		currency := exchange.FormatExchangeCurrency(e.Name, x).String()
+		currency := e.FormatExchangeCurrency(x).String()
 		var tickerPrice ticker.Price
 		tickerPrice.Pair = x
 		tickerPrice.Last = result[currency].Last
@@ -89,7 +97,7 @@ func (e *EXMO) UpdateTicker(p currency.Pair, assetType string) (ticker.Price, er
 }
 
 // GetTickerPrice returns the ticker for a currency pair
-func (e *EXMO) GetTickerPrice(p currency.Pair, assetType string) (ticker.Price, error) {
+func (e *EXMO) GetTickerPrice(p currency.Pair, assetType asset.Item) (ticker.Price, error) {
 	tick, err := ticker.GetTicker(e.GetName(), p, assetType)
 	if err != nil {
 		return e.UpdateTicker(p, assetType)
@@ -98,7 +106,7 @@ func (e *EXMO) GetTickerPrice(p currency.Pair, assetType string) (ticker.Price,
 }
 
 // GetOrderbookEx returns the orderbook for a currency pair
-func (e *EXMO) GetOrderbookEx(p currency.Pair, assetType string) (orderbook.Base, error) {
+func (e *EXMO) GetOrderbookEx(p currency.Pair, assetType asset.Item) (orderbook.Base, error) {
 	ob, err := orderbook.Get(e.GetName(), p, assetType)
 	if err != nil {
 		return e.UpdateOrderbook(p, assetType)
@@ -107,12 +115,9 @@ func (e *EXMO) GetOrderbookEx(p currency.Pair, assetType string) (orderbook.Base
 }
 
 // UpdateOrderbook updates and returns the orderbook for a currency pair
-func (e *EXMO) UpdateOrderbook(p currency.Pair, assetType string) (orderbook.Base, error) {
+func (e *EXMO) UpdateOrderbook(p currency.Pair, assetType asset.Item) (orderbook.Base, error) {
 	var orderBook orderbook.Base
-	pairsCollated, err := exchange.GetAndFormatExchangeCurrencies(e.Name, e.GetEnabledCurrencies())
-	if err != nil {
-		return orderBook, err
-	}
+	pairsCollated := e.GetAndFormatExchangeCurrencies(e.GetEnabledCurrencies())
 
 	result, err := e.GetOrderbook(pairsCollated)
 	if err != nil {
@@ -120,7 +125,7 @@ func (e *EXMO) UpdateOrderbook(p currency.Pair, assetType string) (orderbook.Bas
 	}
 
 	for _, x := range e.GetEnabledCurrencies() {
-		currency := exchange.FormatExchangeCurrency(e.Name, x)
+		currency := e.FormatExchangeCurrency(x)
 		data, ok := result[currency.String()]
 		if !ok {
 			continue
@@ -195,15 +200,43 @@ func (e *EXMO) GetFundingHistory() ([]exchange.FundHistory, error) {
 	return fundHistory, common.ErrFunctionNotSupported
 }
 
-// GetExchangeHistory returns historic trade data since exchange opening.
-func (e *EXMO) GetExchangeHistory(p currency.Pair, assetType string) ([]exchange.TradeHistory, error) {
-	var resp []exchange.TradeHistory
+// GetExchangeHistory returns the most recent trades for a currency pair
+func (e *EXMO) GetExchangeHistory(p currency.Pair, assetType asset.Item) ([]exchange.TradeHistory, error) {
+	symbol := e.FormatExchangeCurrency(p).String()
+	result, err := e.GetTrades(symbol)
+	if err != nil {
+		return nil, err
+	}
+
+	trades := result[symbol]
+	resp := make([]exchange.TradeHistory, len(trades))
+	for i := range trades {
+		resp[i] = exchange.TradeHistory{
+			Timestamp: time.Unix(trades[i].Date, 0),
+			TID:       trades[i].TradeID,
+			ID:        strconv.FormatInt(trades[i].TradeID, 10),
+			Price:     trades[i].Price,
+			Amount:    trades[i].Quantity,
+			Exchange:  e.Name,
+			Side:      exchange.OrderSide(strings.ToUpper(trades[i].Type)),
+		}
+	}
+	return resp, nil
+}
+
+// GetHistoricTrades returns historic trade data within the timeframe provided.
+// EXMO only provides the most recent trades
+func (e *EXMO) GetHistoricTrades(p currency.Pair, assetType asset.Item, timestampStart, timestampEnd time.Time) ([]exchange.TradeHistory, error) {
+	return nil, common.ErrFunctionNotSupported
+}
 
-	return resp, common.ErrNotYetImplemented
+// GetHistoricCandles returns candles between the start and end times
+func (e *EXMO) GetHistoricCandles(p currency.Pair, assetType asset.Item, timestampStart, timestampEnd time.Time, interval kline.Interval) (kline.Item, error) {
+	return kline.Item{}, common.ErrFunctionNotSupported
 }
 
 // SubmitOrder submits a new order
-func (e *EXMO) SubmitOrder(p currency.Pair, side exchange.OrderSide, orderType exchange.OrderType, amount, price float64, _ string) (exchange.SubmitOrderResponse, error) {
+func (e *EXMO) SubmitOrder(p currency.Pair, _ asset.Item, side exchange.OrderSide, orderType exchange.OrderType, amount, price float64, _ string) (exchange.SubmitOrderResponse, error) {
 	var submitOrderResponse exchange.SubmitOrderResponse
 	var oT string
 
@@ -366,7 +399,7 @@ func (e *EXMO) GetOrderHistory(getOrdersRequest *exchange.GetOrdersRequest) ([]e
 
 	var allTrades []UserTrades
 	for _, currency := range getOrdersRequest.Currencies {
-		resp, err := e.GetUserTrades(exchange.FormatExchangeCurrency(e.Name, currency).String(), "", "10000")
+		resp, err := e.GetUserTrades(e.FormatExchangeCurrency(currency).String(), "", "10000")
 		if err != nil {
 			return nil, err
 		}
@@ -0,0 +1,96 @@
+package exchange
+
+import (
+	"github.com/thrasher-corp/gocryptotrader/exchanges/websocket/wshandler"
+)
+
+// Features declares the operations an exchange supports over its REST and
+// websocket APIs. Callers should check the relevant feature before calling a
+// wrapper method, as unsupported methods return common.ErrFunctionNotSupported
+type Features struct {
+	REST      ProtocolFeatures `json:"rest"`
+	Websocket ProtocolFeatures `json:"websocket"`
+	// OrderTypes are the order types SubmitOrder accepts
+	OrderTypes []OrderType `json:"orderTypes"`
+}
+
+// ProtocolFeatures declares the operations supported over a single protocol.
+// Subscription and connection features only apply to websockets
+type ProtocolFeatures struct {
+	TickerFetching    bool `json:"tickerFetching"`
+	TickerBatching    bool `json:"tickerBatching"`
+	OrderbookFetching bool `json:"orderbookFetching"`
+	TradeFetching     bool `json:"tradeFetching"`
+	HistoricTrades    bool `json:"historicTrades"`
+	KlineFetching     bool `json:"klineFetching"`
+	AutoPairUpdates   bool `json:"autoPairUpdates"`
+	AccountInfo       bool `json:"accountInfo"`
+	GetOrder          bool `json:"getOrder"`
+	ActiveOrders      bool `json:"activeOrders"`
+	OrderHistory      bool `json:"orderHistory"`
+	SubmitOrder       bool `json:"submitOrder"`
+	BatchOrders       bool `json:"batchOrders"`
+	ModifyOrder       bool `json:"modifyOrder"`
+	CancelOrder       bool `json:"cancelOrder"`
+	CancelOrders      bool `json:"cancelOrders"`
+	FundingHistory    bool `json:"fundingHistory"`
+	DepositAddress    bool `json:"depositAddress"`
+	CryptoWithdrawal  bool `json:"cryptoWithdrawal"`
+	FiatWithdrawal    bool `json:"fiatWithdrawal"`
+
+	Subscribe              bool `json:"subscribe"`
+	Unsubscribe            bool `json:"unsubscribe"`
+	AuthenticatedEndpoints bool `json:"authenticatedEndpoints"`
+	MessageCorrelation     bool `json:"messageCorrelation"`
+	SequenceNumbers        bool `json:"sequenceNumbers"`
+	DeadMansSwitch         bool `json:"deadMansSwitch"`
+}
+
+// websocketFunctionality maps the websocket handler functionality bits to
+// the features they represent
+var websocketFunctionality = []struct {
+	bit     uint32
+	feature func(p *ProtocolFeatures) *bool
+}{
+	{wshandler.WebsocketTickerSupported, func(p *ProtocolFeatures) *bool { return &p.TickerFetching }},
+	{wshandler.WebsocketOrderbookSupported, func(p *ProtocolFeatures) *bool { return &p.OrderbookFetching }},
+	{wshandler.WebsocketKlineSupported, func(p *ProtocolFeatures) *bool { return &p.KlineFetching }},
+	{wshandler.WebsocketTradeDataSupported, func(p *ProtocolFeatures) *bool { return &p.TradeFetching }},
+	{wshandler.WebsocketAccountDataSupported, func(p *ProtocolFeatures) *bool { return &p.AccountInfo }},
+	{wshandler.WebsocketSubmitOrderSupported, func(p *ProtocolFeatures) *bool { return &p.SubmitOrder }},
+	{wshandler.WebsocketCancelOrderSupported, func(p *ProtocolFeatures) *bool { return &p.CancelOrder }},
+	{wshandler.WebsocketWithdrawSupported, func(p *ProtocolFeatures) *bool { return &p.CryptoWithdrawal }},
+	{wshandler.WebsocketSubscribeSupported, func(p *ProtocolFeatures) *bool { return &p.Subscribe }},
+	{wshandler.WebsocketUnsubscribeSupported, func(p *ProtocolFeatures) *bool { return &p.Unsubscribe }},
+	{wshandler.WebsocketAuthenticatedEndpointsSupported, func(p *ProtocolFeatures) *bool { return &p.AuthenticatedEndpoints }},
+	{wshandler.WebsocketMessageCorrelationSupported, func(p *ProtocolFeatures) *bool { return &p.MessageCorrelation }},
+	{wshandler.WebsocketSequenceNumberSupported, func(p *ProtocolFeatures) *bool { return &p.SequenceNumbers }},
+	{wshandler.WebsocketDeadMansSwitchSupported, func(p *ProtocolFeatures) *bool { return &p.DeadMansSwitch }},
+}
+
+// Functionality returns the websocket handler functionality bitmask of the
+// features, websocket handlers are set up from it
+func (p ProtocolFeatures) Functionality() uint32 {
+	var f uint32
+	for x := range websocketFunctionality {
+		if *websocketFunctionality[x].feature(&p) {
+			f |= websocketFunctionality[x].bit
+		}
+	}
+	return f
+}
+
+// SupportsOrderType returns whether SubmitOrder accepts the order type
+func (f *Features) SupportsOrderType(t OrderType) bool {
+	for x := range f.OrderTypes {
+		if f.OrderTypes[x] == t {
+			return true
+		}
+	}
+	return false
+}
+
+// GetFeatures returns the operations the exchange supports
+func (e *Base) GetFeatures() Features {
+	return e.Features
+}
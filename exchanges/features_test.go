@@ -0,0 +1,48 @@
+package exchange
+
+import (
+	"testing"
+
+	"github.com/thrasher-corp/gocryptotrader/exchanges/websocket/wshandler"
+)
+
+func TestFunctionality(t *testing.T) {
+	var p ProtocolFeatures
+	if p.Functionality() != 0 {
+		t.Error("Test failed. Expected no functionality")
+	}
+
+	p = ProtocolFeatures{
+		TickerFetching:    true,
+		OrderbookFetching: true,
+		Subscribe:         true,
+		// Operations without a websocket handler bit are not included
+		ModifyOrder: true,
+	}
+	expected := wshandler.WebsocketTickerSupported |
+		wshandler.WebsocketOrderbookSupported |
+		wshandler.WebsocketSubscribeSupported
+	if f := p.Functionality(); f != expected {
+		t.Errorf("Test failed. Expected %d got %d", expected, f)
+	}
+}
+
+func TestSupportsOrderType(t *testing.T) {
+	f := Features{OrderTypes: []OrderType{LimitOrderType}}
+	if !f.SupportsOrderType(LimitOrderType) {
+		t.Error("Test failed. Expected limit orders to be supported")
+	}
+
+	if f.SupportsOrderType(MarketOrderType) {
+		t.Error("Test failed. Expected market orders to be unsupported")
+	}
+}
+
+func TestGetFeatures(t *testing.T) {
+	b := Base{
+		Features: Features{REST: ProtocolFeatures{SubmitOrder: true}},
+	}
+	if !b.GetFeatures().REST.SubmitOrder {
+		t.Error("Test failed. Expected submit order to be supported")
+	}
+}
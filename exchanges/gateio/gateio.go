@@ -13,8 +13,9 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
-	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/websocket/wshandler"
 	log "github.com/thrasher-corp/gocryptotrader/logger"
 )
@@ -45,6 +46,21 @@ const (
 	gateioGenerateAddress = "New address is being generated for you, please wait a moment and refresh this page. "
 )
 
+// gateioKlineIntervals maps candle intervals to the group intervals Gateio
+// supports
+var gateioKlineIntervals = map[kline.Interval]TimeInterval{
+	kline.OneMin:     TimeIntervalMinute,
+	kline.ThreeMin:   TimeIntervalThreeMinutes,
+	kline.FiveMin:    TimeIntervalFiveMinutes,
+	kline.FifteenMin: TimeIntervalFifteenMinutes,
+	kline.ThirtyMin:  TimeIntervalThirtyMinutes,
+	kline.OneHour:    TimeIntervalHour,
+	kline.TwoHour:    TimeIntervalTwoHours,
+	kline.FourHour:   TimeIntervalFourHours,
+	kline.SixHour:    TimeIntervalSixHours,
+	kline.OneDay:     TimeIntervalDay,
+}
+
 // Gateio is the overarching type across this package
 type Gateio struct {
 	WebsocketConn *wshandler.WebsocketConnection
@@ -57,15 +73,40 @@ func (g *Gateio) SetDefaults() {
 	g.Enabled = false
 	g.Verbose = false
 	g.RESTPollingDelay = 10
-	g.APIWithdrawPermissions = exchange.AutoWithdrawCrypto |
-		exchange.NoFiatWithdrawals
 	g.RequestCurrencyPairFormat.Delimiter = "_"
 	g.RequestCurrencyPairFormat.Uppercase = false
 	g.ConfigCurrencyPairFormat.Delimiter = "_"
 	g.ConfigCurrencyPairFormat.Uppercase = true
-	g.AssetTypes = []string{ticker.Spot}
-	g.SupportsAutoPairUpdating = true
-	g.SupportsRESTTickerBatching = true
+	g.AssetTypes = asset.Items{asset.Spot}
+	g.Features = exchange.Features{
+		REST: exchange.ProtocolFeatures{
+			TickerFetching:    true,
+			TickerBatching:    true,
+			OrderbookFetching: true,
+			KlineFetching:     true,
+			AutoPairUpdates:   true,
+			AccountInfo:       true,
+			GetOrder:          true,
+			ActiveOrders:      true,
+			OrderHistory:      true,
+			SubmitOrder:       true,
+			CancelOrder:       true,
+			CancelOrders:      true,
+			DepositAddress:    true,
+			CryptoWithdrawal:  true,
+		},
+		Websocket: exchange.ProtocolFeatures{
+			TickerFetching:         true,
+			OrderbookFetching:      true,
+			TradeFetching:          true,
+			KlineFetching:          true,
+			Subscribe:              true,
+			Unsubscribe:            true,
+			AuthenticatedEndpoints: true,
+			MessageCorrelation:     true,
+		},
+		OrderTypes: []exchange.OrderType{exchange.LimitOrderType},
+	}
 	g.Requester = request.New(g.Name,
 		request.NewRateLimit(time.Second*10, gateioAuthRate),
 		request.NewRateLimit(time.Second*10, gateioUnauthRate),
@@ -75,14 +116,7 @@ func (g *Gateio) SetDefaults() {
 	g.APIUrlSecondaryDefault = gateioMarketURL
 	g.APIUrlSecondary = g.APIUrlSecondaryDefault
 	g.Websocket = wshandler.New()
-	g.Websocket.Functionality = wshandler.WebsocketTickerSupported |
-		wshandler.WebsocketTradeDataSupported |
-		wshandler.WebsocketOrderbookSupported |
-		wshandler.WebsocketKlineSupported |
-		wshandler.WebsocketSubscribeSupported |
-		wshandler.WebsocketUnsubscribeSupported |
-		wshandler.WebsocketAuthenticatedEndpointsSupported |
-		wshandler.WebsocketMessageCorrelationSupported
+	g.Websocket.Functionality = g.Features.Websocket.Functionality()
 	g.WebsocketResponseMaxLimit = exchange.DefaultWebsocketResponseMaxLimit
 	g.WebsocketResponseCheckTimeout = exchange.DefaultWebsocketResponseCheckTimeout
 	g.WebsocketOrderbookBufferLimit = exchange.DefaultWebsocketOrderbookBufferLimit
@@ -100,6 +134,7 @@ func (g *Gateio) Setup(exch *config.ExchangeConfig) {
 		g.APIAuthPEMKey = exch.APIAuthPEMKey
 		g.SetHTTPClientTimeout(exch.HTTPTimeout)
 		g.SetHTTPClientUserAgent(exch.HTTPUserAgent)
+		g.SetHTTPRetryPolicy(exch.HTTPRetry)
 		g.RESTPollingDelay = exch.RESTPollingDelay
 		g.Verbose = exch.Verbose
 		g.BaseCurrencies = exch.BaseCurrencies
@@ -12,6 +12,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/websocket/wshandler"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/websocket/wsorderbook"
@@ -136,7 +137,7 @@ func (g *Gateio) WsHandleData() {
 				g.Websocket.DataHandler <- wshandler.TickerData{
 					Timestamp:  time.Now(),
 					Pair:       currency.NewPairFromString(c),
-					AssetType:  orderbook.Spot,
+					AssetType:  asset.Spot,
 					Exchange:   g.GetName(),
 					ClosePrice: ticker.Close,
 					Quantity:   ticker.BaseVolume,
@@ -164,7 +165,7 @@ func (g *Gateio) WsHandleData() {
 					g.Websocket.DataHandler <- wshandler.TradeData{
 						Timestamp:    time.Now(),
 						CurrencyPair: currency.NewPairFromString(c),
-						AssetType:    orderbook.Spot,
+						AssetType:    asset.Spot,
 						Exchange:     g.GetName(),
 						Price:        trades[i].Price,
 						Amount:       trades[i].Amount,
@@ -232,7 +233,7 @@ func (g *Gateio) WsHandleData() {
 					var newOrderBook orderbook.Base
 					newOrderBook.Asks = asks
 					newOrderBook.Bids = bids
-					newOrderBook.AssetType = orderbook.Spot
+					newOrderBook.AssetType = asset.Spot
 					newOrderBook.Pair = currency.NewPairFromString(c)
 
 					err = g.Websocket.Orderbook.LoadSnapshot(&newOrderBook,
@@ -247,7 +248,7 @@ func (g *Gateio) WsHandleData() {
 							Bids:         bids,
 							CurrencyPair: currency.NewPairFromString(c),
 							UpdateTime:   time.Now(),
-							AssetType:    orderbook.Spot,
+							AssetType:    asset.Spot,
 						})
 					if err != nil {
 						g.Websocket.DataHandler <- err
@@ -256,7 +257,7 @@ func (g *Gateio) WsHandleData() {
 
 				g.Websocket.DataHandler <- wshandler.WebsocketOrderbookUpdate{
 					Pair:     currency.NewPairFromString(c),
-					Asset:    orderbook.Spot,
+					Asset:    asset.Spot,
 					Exchange: g.GetName(),
 				}
 
@@ -277,7 +278,7 @@ func (g *Gateio) WsHandleData() {
 				g.Websocket.DataHandler <- wshandler.KlineData{
 					Timestamp:  time.Now(),
 					Pair:       currency.NewPairFromString(data[7].(string)),
-					AssetType:  orderbook.Spot,
+					AssetType:  asset.Spot,
 					Exchange:   g.GetName(),
 					OpenPrice:  open,
 					ClosePrice: closePrice,
@@ -1,6 +1,7 @@
 package gateio
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"strconv"
@@ -11,6 +12,8 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/websocket/wshandler"
@@ -26,6 +29,14 @@ func (g *Gateio) Start(wg *sync.WaitGroup) {
 	}()
 }
 
+// WithContext returns a copy of Gateio whose HTTP requests are abandoned once
+// the context is done
+func (g *Gateio) WithContext(ctx context.Context) exchange.IBotExchange {
+	cp := *g
+	cp.Base = g.ContextBase(ctx)
+	return &cp
+}
+
 // Run implements the GateIO wrapper
 func (g *Gateio) Run() {
 	if g.Verbose {
@@ -52,7 +63,7 @@ func (g *Gateio) Run() {
 }
 
 // UpdateTicker updates and returns the ticker for a currency pair
-func (g *Gateio) UpdateTicker(p currency.Pair, assetType string) (ticker.Price, error) {
+func (g *Gateio) UpdateTicker(p currency.Pair, assetType asset.Item) (ticker.Price, error) {
 	var tickerPrice ticker.Price
 	result, err := g.GetTickers()
 	if err != nil {
@@ -60,7 +71,7 @@ func (g *Gateio) UpdateTicker(p currency.Pair, assetType string) (ticker.Price,
 	}
 
 	for _, x := range g.GetEnabledCurrencies() {
-		currency := exchange.FormatExchangeCurrency(g.Name, x).String()
+		currency := g.FormatExchangeCurrency(x).String()
 		var tp ticker.Price
 		tp.Pair = x
 		tp.High = result[currency].High
@@ -79,7 +90,7 @@ func (g *Gateio) UpdateTicker(p currency.Pair, assetType string) (ticker.Price,
 }
 
 // GetTickerPrice returns the ticker for a currency pair
-func (g *Gateio) GetTickerPrice(p currency.Pair, assetType string) (ticker.Price, error) {
+func (g *Gateio) GetTickerPrice(p currency.Pair, assetType asset.Item) (ticker.Price, error) {
 	tickerNew, err := ticker.GetTicker(g.GetName(), p, assetType)
 	if err != nil {
 		return g.UpdateTicker(p, assetType)
@@ -88,7 +99,7 @@ func (g *Gateio) GetTickerPrice(p currency.Pair, assetType string) (ticker.Price
 }
 
 // GetOrderbookEx returns orderbook base on the currency pair
-func (g *Gateio) GetOrderbookEx(currency currency.Pair, assetType string) (orderbook.Base, error) {
+func (g *Gateio) GetOrderbookEx(currency currency.Pair, assetType asset.Item) (orderbook.Base, error) {
 	ob, err := orderbook.Get(g.GetName(), currency, assetType)
 	if err != nil {
 		return g.UpdateOrderbook(currency, assetType)
@@ -97,9 +108,9 @@ func (g *Gateio) GetOrderbookEx(currency currency.Pair, assetType string) (order
 }
 
 // UpdateOrderbook updates and returns the orderbook for a currency pair
-func (g *Gateio) UpdateOrderbook(p currency.Pair, assetType string) (orderbook.Base, error) {
+func (g *Gateio) UpdateOrderbook(p currency.Pair, assetType asset.Item) (orderbook.Base, error) {
 	var orderBook orderbook.Base
-	currency := exchange.FormatExchangeCurrency(g.Name, p).String()
+	currency := g.FormatExchangeCurrency(p).String()
 
 	orderbookNew, err := g.GetOrderbook(currency)
 	if err != nil {
@@ -201,15 +212,64 @@ func (g *Gateio) GetFundingHistory() ([]exchange.FundHistory, error) {
 }
 
 // GetExchangeHistory returns historic trade data since exchange opening.
-func (g *Gateio) GetExchangeHistory(p currency.Pair, assetType string) ([]exchange.TradeHistory, error) {
+func (g *Gateio) GetExchangeHistory(p currency.Pair, assetType asset.Item) ([]exchange.TradeHistory, error) {
 	var resp []exchange.TradeHistory
 
 	return resp, common.ErrNotYetImplemented
 }
 
+// GetHistoricTrades returns historic trade data within the timeframe provided.
+func (g *Gateio) GetHistoricTrades(p currency.Pair, assetType asset.Item, timestampStart, timestampEnd time.Time) ([]exchange.TradeHistory, error) {
+	return nil, common.ErrFunctionNotSupported
+}
+
+// GetHistoricCandles returns candles between the start and end times. Gateio
+// only provides candles for a number of hours before the current time
+func (g *Gateio) GetHistoricCandles(p currency.Pair, assetType asset.Item, timestampStart, timestampEnd time.Time, interval kline.Interval) (kline.Item, error) {
+	err := kline.CheckRequest(timestampStart, timestampEnd, interval)
+	if err != nil {
+		return kline.Item{}, err
+	}
+
+	groupSec, ok := gateioKlineIntervals[interval]
+	if !ok {
+		return kline.Item{}, kline.ErrUnsupportedInterval
+	}
+
+	candles, err := g.GetSpotKline(KlinesRequestParams{
+		Symbol:   g.FormatExchangeCurrency(p).String(),
+		GroupSec: groupSec,
+		HourSize: int(time.Since(timestampStart)/time.Hour) + 1,
+	})
+	if err != nil {
+		return kline.Item{}, err
+	}
+
+	item := kline.Item{
+		Exchange:  g.Name,
+		Pair:      p,
+		AssetType: assetType,
+		Interval:  interval,
+	}
+
+	for i := range candles {
+		item.Candles = append(item.Candles, kline.Candle{
+			Time:   candles[i].KlineTime,
+			Open:   candles[i].Open,
+			High:   candles[i].High,
+			Low:    candles[i].Low,
+			Close:  candles[i].Close,
+			Volume: candles[i].Volume,
+		})
+	}
+
+	item.FilterCandles(timestampStart, timestampEnd)
+	return item, nil
+}
+
 // SubmitOrder submits a new order
 // TODO: support multiple order types (IOC)
-func (g *Gateio) SubmitOrder(p currency.Pair, side exchange.OrderSide, _ exchange.OrderType, amount, price float64, _ string) (exchange.SubmitOrderResponse, error) {
+func (g *Gateio) SubmitOrder(p currency.Pair, _ asset.Item, side exchange.OrderSide, _ exchange.OrderType, amount, price float64, _ string) (exchange.SubmitOrderResponse, error) {
 	var submitOrderResponse exchange.SubmitOrderResponse
 	var orderTypeFormat SpotNewOrderRequestParamsType
 
@@ -252,7 +312,7 @@ func (g *Gateio) CancelOrder(order *exchange.OrderCancellation) error {
 	if err != nil {
 		return err
 	}
-	_, err = g.CancelExistingOrder(orderIDInt, exchange.FormatExchangeCurrency(g.Name, order.CurrencyPair).String())
+	_, err = g.CancelExistingOrder(orderIDInt, g.FormatExchangeCurrency(order.CurrencyPair).String())
 
 	return err
 }
@@ -11,8 +11,8 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/config"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
-	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/websocket/wshandler"
 	log "github.com/thrasher-corp/gocryptotrader/logger"
 )
@@ -50,6 +50,9 @@ const (
 	// Too many requests returns this
 	geminiRateError = "429"
 
+	// geminiTradesLimit is the maximum number of trades returned per request
+	geminiTradesLimit = 500
+
 	// Assigned API key roles on creation
 	geminiRoleTrader      = "trader"
 	geminiRoleFundManager = "fundmanager"
@@ -80,9 +83,32 @@ func (g *Gemini) SetDefaults() {
 	g.RequestCurrencyPairFormat.Uppercase = true
 	g.ConfigCurrencyPairFormat.Delimiter = ""
 	g.ConfigCurrencyPairFormat.Uppercase = true
-	g.AssetTypes = []string{ticker.Spot}
-	g.SupportsAutoPairUpdating = true
-	g.SupportsRESTTickerBatching = false
+	g.AssetTypes = asset.Items{asset.Spot}
+	g.Features = exchange.Features{
+		REST: exchange.ProtocolFeatures{
+			TickerFetching:    true,
+			OrderbookFetching: true,
+			TradeFetching:     true,
+			HistoricTrades:    true,
+			KlineFetching:     true,
+			AutoPairUpdates:   true,
+			AccountInfo:       true,
+			ActiveOrders:      true,
+			OrderHistory:      true,
+			SubmitOrder:       true,
+			CancelOrder:       true,
+			CancelOrders:      true,
+			DepositAddress:    true,
+			CryptoWithdrawal:  true,
+		},
+		Websocket: exchange.ProtocolFeatures{
+			OrderbookFetching:      true,
+			TradeFetching:          true,
+			AuthenticatedEndpoints: true,
+			SequenceNumbers:        true,
+		},
+		OrderTypes: []exchange.OrderType{exchange.LimitOrderType},
+	}
 	g.Requester = request.New(g.Name,
 		request.NewRateLimit(time.Second, geminiAuthRate),
 		request.NewRateLimit(time.Second, geminiUnauthRate),
@@ -90,10 +116,7 @@ func (g *Gemini) SetDefaults() {
 	g.APIUrlDefault = geminiAPIURL
 	g.APIUrl = g.APIUrlDefault
 	g.Websocket = wshandler.New()
-	g.Websocket.Functionality = wshandler.WebsocketOrderbookSupported |
-		wshandler.WebsocketTradeDataSupported |
-		wshandler.WebsocketAuthenticatedEndpointsSupported |
-		wshandler.WebsocketSequenceNumberSupported
+	g.Websocket.Functionality = g.Features.Websocket.Functionality()
 	g.WebsocketResponseMaxLimit = exchange.DefaultWebsocketResponseMaxLimit
 	g.WebsocketResponseCheckTimeout = exchange.DefaultWebsocketResponseCheckTimeout
 	g.WebsocketOrderbookBufferLimit = exchange.DefaultWebsocketOrderbookBufferLimit
@@ -110,6 +133,7 @@ func (g *Gemini) Setup(exch *config.ExchangeConfig) {
 		g.SetAPIKeys(exch.APIKey, exch.APISecret, "", false)
 		g.SetHTTPClientTimeout(exch.HTTPTimeout)
 		g.SetHTTPClientUserAgent(exch.HTTPUserAgent)
+		g.SetHTTPRetryPolicy(exch.HTTPRetry)
 		g.RESTPollingDelay = exch.RESTPollingDelay
 		g.Verbose = exch.Verbose
 		g.HTTPDebugging = exch.HTTPDebugging
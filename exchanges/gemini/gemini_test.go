@@ -9,6 +9,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/sharedtestvalues"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/websocket/wshandler"
 )
@@ -401,8 +402,7 @@ func TestSubmitOrder(t *testing.T) {
 		Quote:     currency.BTC,
 	}
 
-	response, err := g.SubmitOrder(p,
-		exchange.BuyOrderSide,
+	response, err := g.SubmitOrder(p, asset.Spot, exchange.BuyOrderSide,
 		exchange.LimitOrderType,
 		1,
 		10,
@@ -13,6 +13,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/websocket/wshandler"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/websocket/wsorderbook"
@@ -276,7 +277,7 @@ func (g *Gemini) wsProcessUpdate(result WsMarketUpdateResponse, pair currency.Pa
 		var newOrderBook orderbook.Base
 		newOrderBook.Asks = asks
 		newOrderBook.Bids = bids
-		newOrderBook.AssetType = orderbook.Spot
+		newOrderBook.AssetType = asset.Spot
 		newOrderBook.Pair = pair
 		err := g.Websocket.Orderbook.LoadSnapshot(&newOrderBook,
 			false)
@@ -285,7 +286,7 @@ func (g *Gemini) wsProcessUpdate(result WsMarketUpdateResponse, pair currency.Pa
 			return
 		}
 		g.Websocket.DataHandler <- wshandler.WebsocketOrderbookUpdate{Pair: pair,
-			Asset:    orderbook.Spot,
+			Asset:    asset.Spot,
 			Exchange: g.GetName()}
 	} else {
 		var asks, bids []orderbook.Item
@@ -294,7 +295,7 @@ func (g *Gemini) wsProcessUpdate(result WsMarketUpdateResponse, pair currency.Pa
 				g.Websocket.DataHandler <- wshandler.TradeData{
 					Timestamp:    time.Now(),
 					CurrencyPair: pair,
-					AssetType:    orderbook.Spot,
+					AssetType:    asset.Spot,
 					Exchange:     g.Name,
 					EventTime:    result.Timestamp,
 					Price:        result.Events[i].Price,
@@ -318,13 +319,13 @@ func (g *Gemini) wsProcessUpdate(result WsMarketUpdateResponse, pair currency.Pa
 			Bids:         bids,
 			CurrencyPair: pair,
 			UpdateTime:   time.Unix(0, result.TimestampMS),
-			AssetType:    orderbook.Spot,
+			AssetType:    asset.Spot,
 		})
 		if err != nil {
 			g.Websocket.DataHandler <- fmt.Errorf("%v %v", g.Name, err)
 		}
 		g.Websocket.DataHandler <- wshandler.WebsocketOrderbookUpdate{Pair: pair,
-			Asset:    orderbook.Spot,
+			Asset:    asset.Spot,
 			Exchange: g.GetName()}
 	}
 }
@@ -1,6 +1,7 @@
 package gemini
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"net/url"
@@ -12,6 +13,8 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/websocket/wshandler"
@@ -27,6 +30,14 @@ func (g *Gemini) Start(wg *sync.WaitGroup) {
 	}()
 }
 
+// WithContext returns a copy of Gemini whose HTTP requests are abandoned once
+// the context is done
+func (g *Gemini) WithContext(ctx context.Context) exchange.IBotExchange {
+	cp := *g
+	cp.Base = g.ContextBase(ctx)
+	return &cp
+}
+
 // Run implements the Gemini wrapper
 func (g *Gemini) Run() {
 	if g.Verbose {
@@ -78,7 +89,7 @@ func (g *Gemini) GetAccountInfo() (exchange.AccountInfo, error) {
 }
 
 // UpdateTicker updates and returns the ticker for a currency pair
-func (g *Gemini) UpdateTicker(p currency.Pair, assetType string) (ticker.Price, error) {
+func (g *Gemini) UpdateTicker(p currency.Pair, assetType asset.Item) (ticker.Price, error) {
 	var tickerPrice ticker.Price
 	tick, err := g.GetTicker(p.String())
 	if err != nil {
@@ -99,7 +110,7 @@ func (g *Gemini) UpdateTicker(p currency.Pair, assetType string) (ticker.Price,
 }
 
 // GetTickerPrice returns the ticker for a currency pair
-func (g *Gemini) GetTickerPrice(p currency.Pair, assetType string) (ticker.Price, error) {
+func (g *Gemini) GetTickerPrice(p currency.Pair, assetType asset.Item) (ticker.Price, error) {
 	tickerNew, err := ticker.GetTicker(g.GetName(), p, assetType)
 	if err != nil {
 		return g.UpdateTicker(p, assetType)
@@ -108,7 +119,7 @@ func (g *Gemini) GetTickerPrice(p currency.Pair, assetType string) (ticker.Price
 }
 
 // GetOrderbookEx returns orderbook base on the currency pair
-func (g *Gemini) GetOrderbookEx(p currency.Pair, assetType string) (orderbook.Base, error) {
+func (g *Gemini) GetOrderbookEx(p currency.Pair, assetType asset.Item) (orderbook.Base, error) {
 	ob, err := orderbook.Get(g.GetName(), p, assetType)
 	if err != nil {
 		return g.UpdateOrderbook(p, assetType)
@@ -117,7 +128,7 @@ func (g *Gemini) GetOrderbookEx(p currency.Pair, assetType string) (orderbook.Ba
 }
 
 // UpdateOrderbook updates and returns the orderbook for a currency pair
-func (g *Gemini) UpdateOrderbook(p currency.Pair, assetType string) (orderbook.Base, error) {
+func (g *Gemini) UpdateOrderbook(p currency.Pair, assetType asset.Item) (orderbook.Base, error) {
 	var orderBook orderbook.Base
 	orderbookNew, err := g.GetOrderbook(p.String(), url.Values{})
 	if err != nil {
@@ -151,17 +162,81 @@ func (g *Gemini) GetFundingHistory() ([]exchange.FundHistory, error) {
 	return fundHistory, common.ErrFunctionNotSupported
 }
 
-// GetExchangeHistory returns historic trade data since exchange opening.
-func (g *Gemini) GetExchangeHistory(p currency.Pair, assetType string) ([]exchange.TradeHistory, error) {
+// GetExchangeHistory returns the most recent trades for a currency pair
+func (g *Gemini) GetExchangeHistory(p currency.Pair, assetType asset.Item) ([]exchange.TradeHistory, error) {
+	trades, err := g.GetTrades(g.FormatExchangeCurrency(p).String(), url.Values{})
+	if err != nil {
+		return nil, err
+	}
+	return g.convertTrades(trades), nil
+}
+
+// GetHistoricTrades returns the trades for a currency pair between the start
+// and end times, paging forward from the start time
+func (g *Gemini) GetHistoricTrades(p currency.Pair, assetType asset.Item, timestampStart, timestampEnd time.Time) ([]exchange.TradeHistory, error) {
+	err := exchange.CheckTimeRange(timestampStart, timestampEnd)
+	if err != nil {
+		return nil, err
+	}
+
+	symbol := g.FormatExchangeCurrency(p).String()
+	since := common.UnixMillis(timestampStart)
 	var resp []exchange.TradeHistory
+	for {
+		params := url.Values{}
+		params.Set("since", strconv.FormatInt(since, 10))
+		params.Set("limit_trades", strconv.Itoa(geminiTradesLimit))
+		trades, err := g.GetTrades(symbol, params)
+		if err != nil {
+			return nil, err
+		}
 
-	return resp, common.ErrNotYetImplemented
+		var newest int64
+		for i := range trades {
+			if trades[i].Timestampms > newest {
+				newest = trades[i].Timestampms
+			}
+		}
+		resp = append(resp, g.convertTrades(trades)...)
+
+		if len(trades) < geminiTradesLimit || newest <= since ||
+			newest > common.UnixMillis(timestampEnd) {
+			break
+		}
+		since = newest
+	}
+
+	exchange.FilterTradesByTimeRange(&resp, timestampStart, timestampEnd)
+	return resp, nil
+}
+
+// GetHistoricCandles returns candles between the start and end times, built
+// from historic trades
+func (g *Gemini) GetHistoricCandles(p currency.Pair, assetType asset.Item, timestampStart, timestampEnd time.Time, interval kline.Interval) (kline.Item, error) {
+	return exchange.CreateKlineFromTrades(g, p, assetType, timestampStart,
+		timestampEnd, interval)
+}
+
+func (g *Gemini) convertTrades(trades []Trade) []exchange.TradeHistory {
+	resp := make([]exchange.TradeHistory, len(trades))
+	for i := range trades {
+		resp[i] = exchange.TradeHistory{
+			Timestamp: time.Unix(0, trades[i].Timestampms*int64(time.Millisecond)),
+			TID:       trades[i].TID,
+			ID:        strconv.FormatInt(trades[i].TID, 10),
+			Price:     trades[i].Price,
+			Amount:    trades[i].Amount,
+			Exchange:  g.Name,
+			Side:      exchange.OrderSide(strings.ToUpper(trades[i].Side)),
+		}
+	}
+	return resp
 }
 
 // SubmitOrder submits a new order
-func (g *Gemini) SubmitOrder(p currency.Pair, side exchange.OrderSide, orderType exchange.OrderType, amount, price float64, _ string) (exchange.SubmitOrderResponse, error) {
+func (g *Gemini) SubmitOrder(p currency.Pair, _ asset.Item, side exchange.OrderSide, orderType exchange.OrderType, amount, price float64, _ string) (exchange.SubmitOrderResponse, error) {
 	var submitOrderResponse exchange.SubmitOrderResponse
-	p = exchange.FormatExchangeCurrency(g.Name, p)
+	p = g.FormatExchangeCurrency(p)
 
 	if orderType != exchange.LimitOrderType {
 		return submitOrderResponse, errors.New("only limit orders are enabled through this API")
@@ -326,7 +401,7 @@ func (g *Gemini) GetOrderHistory(getOrdersRequest *exchange.GetOrdersRequest) ([
 
 	var trades []TradeHistory
 	for _, currency := range getOrdersRequest.Currencies {
-		resp, err := g.GetTradeHistory(exchange.FormatExchangeCurrency(g.Name, currency).String(),
+		resp, err := g.GetTradeHistory(g.FormatExchangeCurrency(currency).String(),
 			getOrdersRequest.StartTicks.Unix())
 		if err != nil {
 			return nil, err
@@ -13,8 +13,9 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
-	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/websocket/wshandler"
 	log "github.com/thrasher-corp/gocryptotrader/logger"
 )
@@ -48,8 +49,23 @@ const (
 
 	hitbtcAuthRate   = 0
 	hitbtcUnauthRate = 0
+
+	hitbtcTradesLimit = 1000
 )
 
+// hitbtcCandlePeriods maps candle intervals to the periods HitBTC supports
+var hitbtcCandlePeriods = map[kline.Interval]string{
+	kline.OneMin:     "M1",
+	kline.ThreeMin:   "M3",
+	kline.FiveMin:    "M5",
+	kline.FifteenMin: "M15",
+	kline.ThirtyMin:  "M30",
+	kline.OneHour:    "H1",
+	kline.FourHour:   "H4",
+	kline.OneDay:     "D1",
+	kline.OneWeek:    "D7",
+}
+
 // HitBTC is the overarching type across the hitbtc package
 type HitBTC struct {
 	exchange.Base
@@ -63,15 +79,41 @@ func (h *HitBTC) SetDefaults() {
 	h.Fee = 0
 	h.Verbose = false
 	h.RESTPollingDelay = 10
-	h.APIWithdrawPermissions = exchange.AutoWithdrawCrypto |
-		exchange.NoFiatWithdrawals
 	h.RequestCurrencyPairFormat.Delimiter = ""
 	h.RequestCurrencyPairFormat.Uppercase = true
 	h.ConfigCurrencyPairFormat.Delimiter = "-"
 	h.ConfigCurrencyPairFormat.Uppercase = true
-	h.AssetTypes = []string{ticker.Spot}
-	h.SupportsAutoPairUpdating = true
-	h.SupportsRESTTickerBatching = true
+	h.AssetTypes = asset.Items{asset.Spot}
+	h.Features = exchange.Features{
+		REST: exchange.ProtocolFeatures{
+			TickerFetching:    true,
+			TickerBatching:    true,
+			OrderbookFetching: true,
+			TradeFetching:     true,
+			HistoricTrades:    true,
+			KlineFetching:     true,
+			AutoPairUpdates:   true,
+			AccountInfo:       true,
+			ActiveOrders:      true,
+			OrderHistory:      true,
+			SubmitOrder:       true,
+			CancelOrder:       true,
+			CancelOrders:      true,
+			DepositAddress:    true,
+			CryptoWithdrawal:  true,
+		},
+		Websocket: exchange.ProtocolFeatures{
+			TickerFetching:         true,
+			OrderbookFetching:      true,
+			SubmitOrder:            true,
+			CancelOrder:            true,
+			Subscribe:              true,
+			Unsubscribe:            true,
+			AuthenticatedEndpoints: true,
+			MessageCorrelation:     true,
+		},
+		OrderTypes: []exchange.OrderType{exchange.LimitOrderType, exchange.MarketOrderType},
+	}
 	h.Requester = request.New(h.Name,
 		request.NewRateLimit(time.Second, hitbtcAuthRate),
 		request.NewRateLimit(time.Second, hitbtcUnauthRate),
@@ -79,14 +121,7 @@ func (h *HitBTC) SetDefaults() {
 	h.APIUrlDefault = apiURL
 	h.APIUrl = h.APIUrlDefault
 	h.Websocket = wshandler.New()
-	h.Websocket.Functionality = wshandler.WebsocketTickerSupported |
-		wshandler.WebsocketOrderbookSupported |
-		wshandler.WebsocketSubscribeSupported |
-		wshandler.WebsocketUnsubscribeSupported |
-		wshandler.WebsocketAuthenticatedEndpointsSupported |
-		wshandler.WebsocketSubmitOrderSupported |
-		wshandler.WebsocketCancelOrderSupported |
-		wshandler.WebsocketMessageCorrelationSupported
+	h.Websocket.Functionality = h.Features.Websocket.Functionality()
 	h.WebsocketResponseMaxLimit = exchange.DefaultWebsocketResponseMaxLimit
 	h.WebsocketResponseCheckTimeout = exchange.DefaultWebsocketResponseCheckTimeout
 	h.WebsocketOrderbookBufferLimit = exchange.DefaultWebsocketOrderbookBufferLimit
@@ -103,6 +138,7 @@ func (h *HitBTC) Setup(exch *config.ExchangeConfig) {
 		h.SetAPIKeys(exch.APIKey, exch.APISecret, "", false)
 		h.SetHTTPClientTimeout(exch.HTTPTimeout)
 		h.SetHTTPClientUserAgent(exch.HTTPUserAgent)
+		h.SetHTTPRetryPolicy(exch.HTTPRetry)
 		h.RESTPollingDelay = exch.RESTPollingDelay // Max 60000ms
 		h.Verbose = exch.Verbose
 		h.HTTPDebugging = exch.HTTPDebugging
@@ -355,9 +391,11 @@ func (h *HitBTC) GetOrderbook(currencyPair string, limit int) (Orderbook, error)
 
 // GetCandles returns candles which is used for OHLC a specific currency.
 // Note: Result contain candles only with non zero volume.
-func (h *HitBTC) GetCandles(currencyPair, limit, period string) ([]ChartData, error) {
+func (h *HitBTC) GetCandles(currencyPair, limit, period string, start, end time.Time) ([]ChartData, error) {
 	// limit   Limit of candles, default 100.
 	// period  One of: M1 (one minute), M3, M5, M15, M30, H1, H4, D1, D7, 1M (one month). Default is M30 (30 minutes).
+	// from    Datetime, optional
+	// till    Datetime, optional
 	vals := url.Values{}
 
 	if limit != "" {
@@ -368,6 +406,14 @@ func (h *HitBTC) GetCandles(currencyPair, limit, period string) ([]ChartData, er
 		vals.Set("period", period)
 	}
 
+	if !start.IsZero() {
+		vals.Set("from", start.UTC().Format(time.RFC3339))
+	}
+
+	if !end.IsZero() {
+		vals.Set("till", end.UTC().Format(time.RFC3339))
+	}
+
 	var resp []ChartData
 	path := fmt.Sprintf("%s/%s/%s?%s", h.APIUrl, apiV2Candles, currencyPair, vals.Encode())
 
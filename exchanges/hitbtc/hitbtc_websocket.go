@@ -11,6 +11,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/nonce"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/websocket/wshandler"
@@ -115,13 +116,15 @@ func (h *HitBTC) handleSubscriptionUpdates(resp wshandler.WebsocketResponse, ini
 		}
 		h.Websocket.DataHandler <- wshandler.TickerData{
 			Exchange:  h.GetName(),
-			AssetType: orderbook.Spot,
+			AssetType: asset.Spot,
 			Pair:      currency.NewPairFromString(ticker.Params.Symbol),
 			Quantity:  ticker.Params.Volume,
 			Timestamp: ts,
 			OpenPrice: ticker.Params.Open,
 			HighPrice: ticker.Params.High,
 			LowPrice:  ticker.Params.Low,
+			BidPrice:  ticker.Params.Bid,
+			AskPrice:  ticker.Params.Ask,
 		}
 	case "snapshotOrderbook":
 		var obSnapshot WsOrderbook
@@ -240,7 +243,7 @@ func (h *HitBTC) WsProcessOrderbookSnapshot(ob WsOrderbook) error {
 	var newOrderBook orderbook.Base
 	newOrderBook.Asks = asks
 	newOrderBook.Bids = bids
-	newOrderBook.AssetType = orderbook.Spot
+	newOrderBook.AssetType = asset.Spot
 	newOrderBook.Pair = p
 
 	err := h.Websocket.Orderbook.LoadSnapshot(&newOrderBook, false)
@@ -250,7 +253,7 @@ func (h *HitBTC) WsProcessOrderbookSnapshot(ob WsOrderbook) error {
 
 	h.Websocket.DataHandler <- wshandler.WebsocketOrderbookUpdate{
 		Exchange: h.GetName(),
-		Asset:    orderbook.Spot,
+		Asset:    asset.Spot,
 		Pair:     p,
 	}
 
@@ -278,7 +281,7 @@ func (h *HitBTC) WsProcessOrderbookUpdate(update WsOrderbook) error {
 		Bids:         bids,
 		CurrencyPair: p,
 		UpdateID:     update.Params.Sequence,
-		AssetType:    orderbook.Spot,
+		AssetType:    asset.Spot,
 	})
 	if err != nil {
 		return err
@@ -286,7 +289,7 @@ func (h *HitBTC) WsProcessOrderbookUpdate(update WsOrderbook) error {
 
 	h.Websocket.DataHandler <- wshandler.WebsocketOrderbookUpdate{
 		Exchange: h.GetName(),
-		Asset:    orderbook.Spot,
+		Asset:    asset.Spot,
 		Pair:     p,
 	}
 	return nil
@@ -1,15 +1,19 @@
 package hitbtc
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"strconv"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/websocket/wshandler"
@@ -25,6 +29,14 @@ func (h *HitBTC) Start(wg *sync.WaitGroup) {
 	}()
 }
 
+// WithContext returns a copy of HitBTC whose HTTP requests are abandoned once
+// the context is done
+func (h *HitBTC) WithContext(ctx context.Context) exchange.IBotExchange {
+	cp := *h
+	cp.Base = h.ContextBase(ctx)
+	return &cp
+}
+
 // Run implements the HitBTC wrapper
 func (h *HitBTC) Run() {
 	if h.Verbose {
@@ -73,7 +85,7 @@ func (h *HitBTC) Run() {
 }
 
 // UpdateTicker updates and returns the ticker for a currency pair
-func (h *HitBTC) UpdateTicker(currencyPair currency.Pair, assetType string) (ticker.Price, error) {
+func (h *HitBTC) UpdateTicker(currencyPair currency.Pair, assetType asset.Item) (ticker.Price, error) {
 	tick, err := h.GetTicker("")
 	if err != nil {
 		return ticker.Price{}, err
@@ -81,7 +93,7 @@ func (h *HitBTC) UpdateTicker(currencyPair currency.Pair, assetType string) (tic
 
 	for _, x := range h.GetEnabledCurrencies() {
 		var tp ticker.Price
-		curr := exchange.FormatExchangeCurrency(h.GetName(), x).String()
+		curr := h.FormatExchangeCurrency(x).String()
 		tp.Pair = x
 		tp.Ask = tick[curr].Ask
 		tp.Bid = tick[curr].Bid
@@ -99,7 +111,7 @@ func (h *HitBTC) UpdateTicker(currencyPair currency.Pair, assetType string) (tic
 }
 
 // GetTickerPrice returns the ticker for a currency pair
-func (h *HitBTC) GetTickerPrice(currencyPair currency.Pair, assetType string) (ticker.Price, error) {
+func (h *HitBTC) GetTickerPrice(currencyPair currency.Pair, assetType asset.Item) (ticker.Price, error) {
 	tickerNew, err := ticker.GetTicker(h.GetName(), currencyPair, assetType)
 	if err != nil {
 		return h.UpdateTicker(currencyPair, assetType)
@@ -108,7 +120,7 @@ func (h *HitBTC) GetTickerPrice(currencyPair currency.Pair, assetType string) (t
 }
 
 // GetOrderbookEx returns orderbook base on the currency pair
-func (h *HitBTC) GetOrderbookEx(currencyPair currency.Pair, assetType string) (orderbook.Base, error) {
+func (h *HitBTC) GetOrderbookEx(currencyPair currency.Pair, assetType asset.Item) (orderbook.Base, error) {
 	ob, err := orderbook.Get(h.GetName(), currencyPair, assetType)
 	if err != nil {
 		return h.UpdateOrderbook(currencyPair, assetType)
@@ -117,9 +129,9 @@ func (h *HitBTC) GetOrderbookEx(currencyPair currency.Pair, assetType string) (o
 }
 
 // UpdateOrderbook updates and returns the orderbook for a currency pair
-func (h *HitBTC) UpdateOrderbook(currencyPair currency.Pair, assetType string) (orderbook.Base, error) {
+func (h *HitBTC) UpdateOrderbook(currencyPair currency.Pair, assetType asset.Item) (orderbook.Base, error) {
 	var orderBook orderbook.Base
-	orderbookNew, err := h.GetOrderbook(exchange.FormatExchangeCurrency(h.GetName(), currencyPair).String(), 1000)
+	orderbookNew, err := h.GetOrderbook(h.FormatExchangeCurrency(currencyPair).String(), 1000)
 	if err != nil {
 		return orderBook, err
 	}
@@ -179,15 +191,124 @@ func (h *HitBTC) GetFundingHistory() ([]exchange.FundHistory, error) {
 	return fundHistory, common.ErrFunctionNotSupported
 }
 
-// GetExchangeHistory returns historic trade data since exchange opening.
-func (h *HitBTC) GetExchangeHistory(p currency.Pair, assetType string) ([]exchange.TradeHistory, error) {
+// GetExchangeHistory returns the most recent trades for a currency pair
+func (h *HitBTC) GetExchangeHistory(p currency.Pair, assetType asset.Item) ([]exchange.TradeHistory, error) {
+	trades, err := h.GetTrades(h.FormatExchangeCurrency(p).String(), "", "", "", "", "", "")
+	if err != nil {
+		return nil, err
+	}
+	return h.convertTrades(trades)
+}
+
+// GetHistoricTrades returns the trades for a currency pair between the start
+// and end times. The first page is requested by time and the remaining pages
+// by trade ID
+func (h *HitBTC) GetHistoricTrades(p currency.Pair, assetType asset.Item, timestampStart, timestampEnd time.Time) ([]exchange.TradeHistory, error) {
+	err := exchange.CheckTimeRange(timestampStart, timestampEnd)
+	if err != nil {
+		return nil, err
+	}
+
+	symbol := h.FormatExchangeCurrency(p).String()
+	limit := strconv.Itoa(hitbtcTradesLimit)
+	from := timestampStart.UTC().Format(time.RFC3339Nano)
+	by := "timestamp"
 	var resp []exchange.TradeHistory
+	for {
+		trades, err := h.GetTrades(symbol, from, "", limit, "", by, "ASC")
+		if err != nil {
+			return nil, err
+		}
+
+		converted, err := h.convertTrades(trades)
+		if err != nil {
+			return nil, err
+		}
+		resp = append(resp, converted...)
+
+		if len(trades) < hitbtcTradesLimit ||
+			converted[len(converted)-1].Timestamp.After(timestampEnd) {
+			break
+		}
+		from = strconv.FormatInt(trades[len(trades)-1].ID+1, 10)
+		by = "id"
+	}
+
+	exchange.FilterTradesByTimeRange(&resp, timestampStart, timestampEnd)
+	return resp, nil
+}
 
-	return resp, common.ErrNotYetImplemented
+// GetHistoricCandles returns candles between the start and end times, paging
+// through the time range in windows of up to 1000 candles. Periods HitBTC does
+// not provide are built from historic trades
+func (h *HitBTC) GetHistoricCandles(p currency.Pair, assetType asset.Item, timestampStart, timestampEnd time.Time, interval kline.Interval) (kline.Item, error) {
+	err := kline.CheckRequest(timestampStart, timestampEnd, interval)
+	if err != nil {
+		return kline.Item{}, err
+	}
+
+	period, ok := hitbtcCandlePeriods[interval]
+	if !ok {
+		return exchange.CreateKlineFromTrades(h, p, assetType, timestampStart,
+			timestampEnd, interval)
+	}
+
+	item := kline.Item{
+		Exchange:  h.Name,
+		Pair:      p,
+		AssetType: assetType,
+		Interval:  interval,
+	}
+
+	symbol := h.FormatExchangeCurrency(p).String()
+	dates := kline.CalculateCandleDateRanges(timestampStart, timestampEnd,
+		interval, hitbtcTradesLimit)
+	for x := range dates {
+		candles, err := h.GetCandles(symbol, strconv.Itoa(hitbtcTradesLimit),
+			period, dates[x].Start, dates[x].End)
+		if err != nil {
+			return kline.Item{}, err
+		}
+
+		for i := range candles {
+			item.Candles = append(item.Candles, kline.Candle{
+				Time:   candles[i].Timestamp,
+				Open:   candles[i].Open,
+				High:   candles[i].Max,
+				Low:    candles[i].Min,
+				Close:  candles[i].Close,
+				Volume: candles[i].Volume,
+			})
+		}
+	}
+
+	item.FilterCandles(timestampStart, timestampEnd)
+	return item, nil
+}
+
+func (h *HitBTC) convertTrades(trades []TradeHistory) ([]exchange.TradeHistory, error) {
+	resp := make([]exchange.TradeHistory, len(trades))
+	for i := range trades {
+		t, err := time.Parse(time.RFC3339Nano, trades[i].Timestamp)
+		if err != nil {
+			return nil, err
+		}
+
+		resp[i] = exchange.TradeHistory{
+			Timestamp: t,
+			TID:       trades[i].ID,
+			ID:        strconv.FormatInt(trades[i].ID, 10),
+			Price:     trades[i].Price,
+			Amount:    trades[i].Quantity,
+			Exchange:  h.Name,
+			Side:      exchange.OrderSide(strings.ToUpper(trades[i].Side)),
+		}
+	}
+	return resp, nil
 }
 
 // SubmitOrder submits a new order
-func (h *HitBTC) SubmitOrder(p currency.Pair, side exchange.OrderSide, orderType exchange.OrderType, amount, price float64, _ string) (exchange.SubmitOrderResponse, error) {
+func (h *HitBTC) SubmitOrder(p currency.Pair, _ asset.Item, side exchange.OrderSide, orderType exchange.OrderType, amount, price float64, _ string) (exchange.SubmitOrderResponse, error) {
 	var submitOrderResponse exchange.SubmitOrderResponse
 	response, err := h.PlaceOrder(p.String(),
 		price,
@@ -20,8 +20,9 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
-	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/websocket/wshandler"
 	log "github.com/thrasher-corp/gocryptotrader/logger"
 )
@@ -62,8 +63,23 @@ const (
 
 	huobiAuthRate   = 100
 	huobiUnauthRate = 100
+
+	// huobiKlineLimit is the maximum number of candles returned per request
+	huobiKlineLimit = 2000
 )
 
+// huobiKlinePeriods maps candle intervals to the periods Huobi supports
+var huobiKlinePeriods = map[kline.Interval]TimeInterval{
+	kline.OneMin:     TimeIntervalMinute,
+	kline.FiveMin:    TimeIntervalFiveMinutes,
+	kline.FifteenMin: TimeIntervalFifteenMinutes,
+	kline.ThirtyMin:  TimeIntervalThirtyMinutes,
+	kline.OneHour:    TimeIntervalHour,
+	kline.FourHour:   TimeIntervalFourHours,
+	kline.OneDay:     TimeIntervalDay,
+	kline.OneWeek:    TimeIntervalWeek,
+}
+
 // HUOBI is the overarching type across this package
 type HUOBI struct {
 	exchange.Base
@@ -79,15 +95,39 @@ func (h *HUOBI) SetDefaults() {
 	h.Fee = 0
 	h.Verbose = false
 	h.RESTPollingDelay = 10
-	h.APIWithdrawPermissions = exchange.AutoWithdrawCryptoWithSetup |
-		exchange.NoFiatWithdrawals
+	h.APIWithdrawPermissions = exchange.AutoWithdrawCryptoWithSetup
 	h.RequestCurrencyPairFormat.Delimiter = ""
 	h.RequestCurrencyPairFormat.Uppercase = false
 	h.ConfigCurrencyPairFormat.Delimiter = "-"
 	h.ConfigCurrencyPairFormat.Uppercase = true
-	h.AssetTypes = []string{ticker.Spot}
-	h.SupportsAutoPairUpdating = true
-	h.SupportsRESTTickerBatching = false
+	h.AssetTypes = asset.Items{asset.Spot}
+	h.Features = exchange.Features{
+		REST: exchange.ProtocolFeatures{
+			TickerFetching:    true,
+			OrderbookFetching: true,
+			TradeFetching:     true,
+			KlineFetching:     true,
+			AutoPairUpdates:   true,
+			AccountInfo:       true,
+			ActiveOrders:      true,
+			OrderHistory:      true,
+			SubmitOrder:       true,
+			CancelOrder:       true,
+			CancelOrders:      true,
+			CryptoWithdrawal:  true,
+		},
+		Websocket: exchange.ProtocolFeatures{
+			OrderbookFetching:      true,
+			TradeFetching:          true,
+			KlineFetching:          true,
+			AccountInfo:            true,
+			Subscribe:              true,
+			Unsubscribe:            true,
+			AuthenticatedEndpoints: true,
+			MessageCorrelation:     true,
+		},
+		OrderTypes: []exchange.OrderType{exchange.LimitOrderType, exchange.MarketOrderType},
+	}
 	h.Requester = request.New(h.Name,
 		request.NewRateLimit(time.Second*10, huobiAuthRate),
 		request.NewRateLimit(time.Second*10, huobiUnauthRate),
@@ -95,14 +135,7 @@ func (h *HUOBI) SetDefaults() {
 	h.APIUrlDefault = huobiAPIURL
 	h.APIUrl = h.APIUrlDefault
 	h.Websocket = wshandler.New()
-	h.Websocket.Functionality = wshandler.WebsocketKlineSupported |
-		wshandler.WebsocketOrderbookSupported |
-		wshandler.WebsocketTradeDataSupported |
-		wshandler.WebsocketSubscribeSupported |
-		wshandler.WebsocketUnsubscribeSupported |
-		wshandler.WebsocketAuthenticatedEndpointsSupported |
-		wshandler.WebsocketAccountDataSupported |
-		wshandler.WebsocketMessageCorrelationSupported
+	h.Websocket.Functionality = h.Features.Websocket.Functionality()
 	h.WebsocketResponseMaxLimit = exchange.DefaultWebsocketResponseMaxLimit
 	h.WebsocketResponseCheckTimeout = exchange.DefaultWebsocketResponseCheckTimeout
 	h.WebsocketOrderbookBufferLimit = exchange.DefaultWebsocketOrderbookBufferLimit
@@ -121,6 +154,7 @@ func (h *HUOBI) Setup(exch *config.ExchangeConfig) {
 		h.APIAuthPEMKey = exch.APIAuthPEMKey
 		h.SetHTTPClientTimeout(exch.HTTPTimeout)
 		h.SetHTTPClientUserAgent(exch.HTTPUserAgent)
+		h.SetHTTPRetryPolicy(exch.HTTPRetry)
 		h.RESTPollingDelay = exch.RESTPollingDelay
 		h.Verbose = exch.Verbose
 		h.HTTPDebugging = exch.HTTPDebugging
@@ -12,6 +12,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/websocket/wshandler"
 	log "github.com/thrasher-corp/gocryptotrader/logger"
@@ -242,7 +243,7 @@ func (h *HUOBI) wsHandleMarketData(resp WsMessage) {
 		h.Websocket.DataHandler <- wshandler.KlineData{
 			Timestamp:  time.Unix(0, kline.Timestamp),
 			Exchange:   h.GetName(),
-			AssetType:  orderbook.Spot,
+			AssetType:  asset.Spot,
 			Pair:       currency.NewPairFromString(data[1]),
 			OpenPrice:  kline.Tick.Open,
 			ClosePrice: kline.Tick.Close,
@@ -260,7 +261,7 @@ func (h *HUOBI) wsHandleMarketData(resp WsMessage) {
 		data := common.SplitStrings(trade.Channel, ".")
 		h.Websocket.DataHandler <- wshandler.TradeData{
 			Exchange:     h.GetName(),
-			AssetType:    orderbook.Spot,
+			AssetType:    asset.Spot,
 			CurrencyPair: currency.NewPairFromString(data[1]),
 			Timestamp:    time.Unix(0, trade.Tick.Timestamp),
 		}
@@ -292,7 +293,7 @@ func (h *HUOBI) WsProcessOrderbook(update *WsDepth, symbol string) error {
 	h.Websocket.DataHandler <- wshandler.WebsocketOrderbookUpdate{
 		Pair:     p,
 		Exchange: h.GetName(),
-		Asset:    orderbook.Spot,
+		Asset:    asset.Spot,
 	}
 
 	return nil
@@ -1,6 +1,7 @@
 package huobi
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"strconv"
@@ -9,9 +10,10 @@ import (
 	"time"
 
 	"github.com/thrasher-corp/gocryptotrader/common"
-	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/websocket/wshandler"
@@ -27,6 +29,14 @@ func (h *HUOBI) Start(wg *sync.WaitGroup) {
 	}()
 }
 
+// WithContext returns a copy of HUOBI whose HTTP requests are abandoned once
+// the context is done
+func (h *HUOBI) WithContext(ctx context.Context) exchange.IBotExchange {
+	cp := *h
+	cp.Base = h.ContextBase(ctx)
+	return &cp
+}
+
 // Run implements the HUOBI wrapper
 func (h *HUOBI) Run() {
 	if h.Verbose {
@@ -46,7 +56,7 @@ func (h *HUOBI) Run() {
 		}
 
 		if common.StringDataContains(h.BaseCurrencies.Strings(), "CNY") {
-			cfg := config.GetConfig()
+			cfg := h.GetConfig()
 			exchCfg, errCNY := cfg.GetExchangeConfig(h.Name)
 			if errCNY != nil {
 				log.Errorf("%s failed to get exchange config. %s\n", h.Name, errCNY)
@@ -97,9 +107,9 @@ func (h *HUOBI) Run() {
 }
 
 // UpdateTicker updates and returns the ticker for a currency pair
-func (h *HUOBI) UpdateTicker(p currency.Pair, assetType string) (ticker.Price, error) {
+func (h *HUOBI) UpdateTicker(p currency.Pair, assetType asset.Item) (ticker.Price, error) {
 	var tickerPrice ticker.Price
-	tick, err := h.GetMarketDetailMerged(exchange.FormatExchangeCurrency(h.Name, p).String())
+	tick, err := h.GetMarketDetailMerged(h.FormatExchangeCurrency(p).String())
 	if err != nil {
 		return tickerPrice, err
 	}
@@ -127,7 +137,7 @@ func (h *HUOBI) UpdateTicker(p currency.Pair, assetType string) (ticker.Price, e
 }
 
 // GetTickerPrice returns the ticker for a currency pair
-func (h *HUOBI) GetTickerPrice(p currency.Pair, assetType string) (ticker.Price, error) {
+func (h *HUOBI) GetTickerPrice(p currency.Pair, assetType asset.Item) (ticker.Price, error) {
 	tickerNew, err := ticker.GetTicker(h.GetName(), p, assetType)
 	if err != nil {
 		return h.UpdateTicker(p, assetType)
@@ -136,7 +146,7 @@ func (h *HUOBI) GetTickerPrice(p currency.Pair, assetType string) (ticker.Price,
 }
 
 // GetOrderbookEx returns orderbook base on the currency pair
-func (h *HUOBI) GetOrderbookEx(p currency.Pair, assetType string) (orderbook.Base, error) {
+func (h *HUOBI) GetOrderbookEx(p currency.Pair, assetType asset.Item) (orderbook.Base, error) {
 	ob, err := orderbook.Get(h.GetName(), p, assetType)
 	if err != nil {
 		return h.UpdateOrderbook(p, assetType)
@@ -145,10 +155,10 @@ func (h *HUOBI) GetOrderbookEx(p currency.Pair, assetType string) (orderbook.Bas
 }
 
 // UpdateOrderbook updates and returns the orderbook for a currency pair
-func (h *HUOBI) UpdateOrderbook(p currency.Pair, assetType string) (orderbook.Base, error) {
+func (h *HUOBI) UpdateOrderbook(p currency.Pair, assetType asset.Item) (orderbook.Base, error) {
 	var orderBook orderbook.Base
 	orderbookNew, err := h.GetDepth(OrderBookDataRequestParams{
-		Symbol: exchange.FormatExchangeCurrency(h.Name, p).String(),
+		Symbol: h.FormatExchangeCurrency(p).String(),
 		Type:   OrderBookDataRequestParamsTypeStep1,
 	})
 	if err != nil {
@@ -264,15 +274,88 @@ func (h *HUOBI) GetFundingHistory() ([]exchange.FundHistory, error) {
 	return fundHistory, common.ErrFunctionNotSupported
 }
 
-// GetExchangeHistory returns historic trade data since exchange opening.
-func (h *HUOBI) GetExchangeHistory(p currency.Pair, assetType string) ([]exchange.TradeHistory, error) {
+// GetExchangeHistory returns the most recent trades for a currency pair
+func (h *HUOBI) GetExchangeHistory(p currency.Pair, assetType asset.Item) ([]exchange.TradeHistory, error) {
+	history, err := h.GetTradeHistory(h.FormatExchangeCurrency(p).String(), "2000")
+	if err != nil {
+		return nil, err
+	}
+
 	var resp []exchange.TradeHistory
+	for i := range history {
+		for j := range history[i].Trades {
+			trade := history[i].Trades[j]
+			resp = append(resp, exchange.TradeHistory{
+				Timestamp: time.Unix(0, trade.Timestamp*int64(time.Millisecond)),
+				TID:       int64(trade.ID),
+				ID:        strconv.FormatFloat(trade.ID, 'f', -1, 64),
+				Price:     trade.Price,
+				Amount:    trade.Amount,
+				Exchange:  h.Name,
+				Side:      exchange.OrderSide(strings.ToUpper(trade.Direction)),
+			})
+		}
+	}
+	return resp, nil
+}
+
+// GetHistoricTrades returns historic trade data within the timeframe provided.
+// Huobi only provides the most recent trades
+func (h *HUOBI) GetHistoricTrades(p currency.Pair, assetType asset.Item, timestampStart, timestampEnd time.Time) ([]exchange.TradeHistory, error) {
+	return nil, common.ErrFunctionNotSupported
+}
+
+// GetHistoricCandles returns candles between the start and end times. Huobi
+// only provides the most recent 2000 candles for each period
+func (h *HUOBI) GetHistoricCandles(p currency.Pair, assetType asset.Item, timestampStart, timestampEnd time.Time, interval kline.Interval) (kline.Item, error) {
+	err := kline.CheckRequest(timestampStart, timestampEnd, interval)
+	if err != nil {
+		return kline.Item{}, err
+	}
+
+	period, ok := huobiKlinePeriods[interval]
+	if !ok {
+		return kline.Item{}, kline.ErrUnsupportedInterval
+	}
+
+	size := int(time.Since(timestampStart)/interval.Duration()) + 1
+	if size > huobiKlineLimit {
+		size = huobiKlineLimit
+	}
+
+	candles, err := h.GetSpotKline(KlinesRequestParams{
+		Symbol: h.FormatExchangeCurrency(p).String(),
+		Period: period,
+		Size:   size,
+	})
+	if err != nil {
+		return kline.Item{}, err
+	}
+
+	item := kline.Item{
+		Exchange:  h.Name,
+		Pair:      p,
+		AssetType: assetType,
+		Interval:  interval,
+	}
+
+	for i := range candles {
+		item.Candles = append(item.Candles, kline.Candle{
+			Time:   time.Unix(candles[i].ID, 0),
+			Open:   candles[i].Open,
+			High:   candles[i].High,
+			Low:    candles[i].Low,
+			Close:  candles[i].Close,
+			Volume: candles[i].Amount,
+		})
+	}
 
-	return resp, common.ErrNotYetImplemented
+	item.FilterCandles(timestampStart, timestampEnd)
+	return item, nil
 }
 
 // SubmitOrder submits a new order
-func (h *HUOBI) SubmitOrder(p currency.Pair, side exchange.OrderSide, orderType exchange.OrderType, amount, price float64, clientID string) (exchange.SubmitOrderResponse, error) {
+func (h *HUOBI) SubmitOrder(p currency.Pair, _ asset.Item, side exchange.OrderSide, orderType exchange.OrderType, amount, price float64, clientID string) (exchange.SubmitOrderResponse, error) {
 	var submitOrderResponse exchange.SubmitOrderResponse
 	accountID, err := strconv.ParseInt(clientID, 10, 64)
 	if err != nil {
@@ -338,7 +421,7 @@ func (h *HUOBI) CancelOrder(order *exchange.OrderCancellation) error {
 func (h *HUOBI) CancelAllOrders(orderCancellation *exchange.OrderCancellation) (exchange.CancelAllOrdersResponse, error) {
 	var cancelAllOrdersResponse exchange.CancelAllOrdersResponse
 	for _, currency := range h.GetEnabledCurrencies() {
-		resp, err := h.CancelOpenOrdersBatch(orderCancellation.AccountID, exchange.FormatExchangeCurrency(h.Name, currency).String())
+		resp, err := h.CancelOpenOrdersBatch(orderCancellation.AccountID, h.FormatExchangeCurrency(currency).String())
 		if err != nil {
 			return cancelAllOrdersResponse, err
 		}
@@ -14,8 +14,8 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
-	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/websocket/wshandler"
 	log "github.com/thrasher-corp/gocryptotrader/logger"
 )
@@ -57,9 +57,21 @@ func (i *ItBit) SetDefaults() {
 	i.RequestCurrencyPairFormat.Uppercase = true
 	i.ConfigCurrencyPairFormat.Delimiter = ""
 	i.ConfigCurrencyPairFormat.Uppercase = true
-	i.AssetTypes = []string{ticker.Spot}
-	i.SupportsAutoPairUpdating = false
-	i.SupportsRESTTickerBatching = false
+	i.AssetTypes = asset.Items{asset.Spot}
+	i.Features = exchange.Features{
+		REST: exchange.ProtocolFeatures{
+			TickerFetching:    true,
+			OrderbookFetching: true,
+			TradeFetching:     true,
+			AccountInfo:       true,
+			ActiveOrders:      true,
+			OrderHistory:      true,
+			SubmitOrder:       true,
+			CancelOrder:       true,
+			CancelOrders:      true,
+		},
+		OrderTypes: []exchange.OrderType{exchange.LimitOrderType, exchange.MarketOrderType},
+	}
 	i.Requester = request.New(i.Name,
 		request.NewRateLimit(time.Second, itbitAuthRate),
 		request.NewRateLimit(time.Second, itbitUnauthRate),
@@ -79,6 +91,7 @@ func (i *ItBit) Setup(exch *config.ExchangeConfig) {
 		i.SetAPIKeys(exch.APIKey, exch.APISecret, exch.ClientID, false)
 		i.SetHTTPClientTimeout(exch.HTTPTimeout)
 		i.SetHTTPClientUserAgent(exch.HTTPUserAgent)
+		i.SetHTTPRetryPolicy(exch.HTTPRetry)
 		i.RESTPollingDelay = exch.RESTPollingDelay
 		i.Verbose = exch.Verbose
 		i.HTTPDebugging = exch.HTTPDebugging
@@ -1,6 +1,7 @@
 package itbit
 
 import (
+	"context"
 	"fmt"
 	"net/url"
 	"strconv"
@@ -11,6 +12,8 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/websocket/wshandler"
@@ -26,6 +29,14 @@ func (i *ItBit) Start(wg *sync.WaitGroup) {
 	}()
 }
 
+// WithContext returns a copy of ItBit whose HTTP requests are abandoned once
+// the context is done
+func (i *ItBit) WithContext(ctx context.Context) exchange.IBotExchange {
+	cp := *i
+	cp.Base = i.ContextBase(ctx)
+	return &cp
+}
+
 // Run implements the ItBit wrapper
 func (i *ItBit) Run() {
 	if i.Verbose {
@@ -35,10 +46,9 @@ func (i *ItBit) Run() {
 }
 
 // UpdateTicker updates and returns the ticker for a currency pair
-func (i *ItBit) UpdateTicker(p currency.Pair, assetType string) (ticker.Price, error) {
+func (i *ItBit) UpdateTicker(p currency.Pair, assetType asset.Item) (ticker.Price, error) {
 	var tickerPrice ticker.Price
-	tick, err := i.GetTicker(exchange.FormatExchangeCurrency(i.Name,
-		p).String())
+	tick, err := i.GetTicker(i.FormatExchangeCurrency(p).String())
 	if err != nil {
 		return tickerPrice, err
 	}
@@ -60,7 +70,7 @@ func (i *ItBit) UpdateTicker(p currency.Pair, assetType string) (ticker.Price, e
 }
 
 // GetTickerPrice returns the ticker for a currency pair
-func (i *ItBit) GetTickerPrice(p currency.Pair, assetType string) (ticker.Price, error) {
+func (i *ItBit) GetTickerPrice(p currency.Pair, assetType asset.Item) (ticker.Price, error) {
 	tickerNew, err := ticker.GetTicker(i.GetName(), p, assetType)
 	if err != nil {
 		return i.UpdateTicker(p, assetType)
@@ -69,7 +79,7 @@ func (i *ItBit) GetTickerPrice(p currency.Pair, assetType string) (ticker.Price,
 }
 
 // GetOrderbookEx returns orderbook base on the currency pair
-func (i *ItBit) GetOrderbookEx(p currency.Pair, assetType string) (orderbook.Base, error) {
+func (i *ItBit) GetOrderbookEx(p currency.Pair, assetType asset.Item) (orderbook.Base, error) {
 	ob, err := orderbook.Get(i.GetName(), p, assetType)
 	if err != nil {
 		return i.UpdateOrderbook(p, assetType)
@@ -78,10 +88,9 @@ func (i *ItBit) GetOrderbookEx(p currency.Pair, assetType string) (orderbook.Bas
 }
 
 // UpdateOrderbook updates and returns the orderbook for a currency pair
-func (i *ItBit) UpdateOrderbook(p currency.Pair, assetType string) (orderbook.Base, error) {
+func (i *ItBit) UpdateOrderbook(p currency.Pair, assetType asset.Item) (orderbook.Base, error) {
 	var orderBook orderbook.Base
-	orderbookNew, err := i.GetOrderbook(exchange.FormatExchangeCurrency(i.Name,
-		p).String())
+	orderbookNew, err := i.GetOrderbook(i.FormatExchangeCurrency(p).String())
 	if err != nil {
 		return orderBook, err
 	}
@@ -178,15 +187,50 @@ func (i *ItBit) GetFundingHistory() ([]exchange.FundHistory, error) {
 	return fundHistory, common.ErrFunctionNotSupported
 }
 
-// GetExchangeHistory returns historic trade data since exchange opening.
-func (i *ItBit) GetExchangeHistory(p currency.Pair, assetType string) ([]exchange.TradeHistory, error) {
-	var resp []exchange.TradeHistory
+// GetExchangeHistory returns the most recent trades for a currency pair
+func (i *ItBit) GetExchangeHistory(p currency.Pair, assetType asset.Item) ([]exchange.TradeHistory, error) {
+	trades, err := i.GetTradeHistory(i.FormatExchangeCurrency(p).String(), "")
+	if err != nil {
+		return nil, err
+	}
+
+	resp := make([]exchange.TradeHistory, len(trades.RecentTrades))
+	for x := range trades.RecentTrades {
+		t, err := time.Parse(time.RFC3339Nano, trades.RecentTrades[x].Timestamp)
+		if err != nil {
+			return nil, err
+		}
+
+		tid, err := strconv.ParseInt(trades.RecentTrades[x].MatchNumber, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+
+		resp[x] = exchange.TradeHistory{
+			Timestamp: t,
+			TID:       tid,
+			ID:        trades.RecentTrades[x].MatchNumber,
+			Price:     trades.RecentTrades[x].Price,
+			Amount:    trades.RecentTrades[x].Amount,
+			Exchange:  i.Name,
+		}
+	}
+	return resp, nil
+}
+
+// GetHistoricTrades returns historic trade data within the timeframe provided.
+// ItBit only provides the most recent trades
+func (i *ItBit) GetHistoricTrades(p currency.Pair, assetType asset.Item, timestampStart, timestampEnd time.Time) ([]exchange.TradeHistory, error) {
+	return nil, common.ErrFunctionNotSupported
+}
 
-	return resp, common.ErrNotYetImplemented
+// GetHistoricCandles returns candles between the start and end times
+func (i *ItBit) GetHistoricCandles(p currency.Pair, assetType asset.Item, timestampStart, timestampEnd time.Time, interval kline.Interval) (kline.Item, error) {
+	return kline.Item{}, common.ErrFunctionNotSupported
 }
 
 // SubmitOrder submits a new order
-func (i *ItBit) SubmitOrder(p currency.Pair, side exchange.OrderSide, orderType exchange.OrderType, amount, price float64, _ string) (exchange.SubmitOrderResponse, error) {
+func (i *ItBit) SubmitOrder(p currency.Pair, _ asset.Item, side exchange.OrderSide, orderType exchange.OrderType, amount, price float64, _ string) (exchange.SubmitOrderResponse, error) {
 	var submitOrderResponse exchange.SubmitOrderResponse
 	var wallet string
 
@@ -0,0 +1,255 @@
+package kline
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+)
+
+// Standard candle intervals
+const (
+	OneMin     = Interval(time.Minute)
+	ThreeMin   = 3 * OneMin
+	FiveMin    = 5 * OneMin
+	FifteenMin = 15 * OneMin
+	ThirtyMin  = 30 * OneMin
+	OneHour    = Interval(time.Hour)
+	TwoHour    = 2 * OneHour
+	FourHour   = 4 * OneHour
+	SixHour    = 6 * OneHour
+	TwelveHour = 12 * OneHour
+	OneDay     = 24 * OneHour
+	ThreeDay   = 3 * OneDay
+	OneWeek    = 7 * OneDay
+)
+
+// Error declarations
+var (
+	ErrUnsupportedInterval = errors.New("unsupported candle interval")
+	ErrInvalidTimeRange    = errors.New("invalid time range, start time must be before end time")
+)
+
+// SupportedIntervals lists the standard candle intervals
+var SupportedIntervals = []Interval{
+	OneMin,
+	ThreeMin,
+	FiveMin,
+	FifteenMin,
+	ThirtyMin,
+	OneHour,
+	TwoHour,
+	FourHour,
+	SixHour,
+	TwelveHour,
+	OneDay,
+	ThreeDay,
+	OneWeek,
+}
+
+// Interval is the duration covered by a single candle
+type Interval time.Duration
+
+// Candle holds the open, high, low, close and volume for an interval starting
+// at Time
+type Candle struct {
+	Time   time.Time
+	Open   float64
+	High   float64
+	Low    float64
+	Close  float64
+	Volume float64
+}
+
+// Item holds the candles for an exchange, currency pair and asset type
+type Item struct {
+	Exchange  string
+	Pair      currency.Pair
+	AssetType asset.Item
+	Interval  Interval
+	Candles   []Candle
+}
+
+// Trade is the trade information needed to build candles
+type Trade struct {
+	Timestamp time.Time
+	Price     float64
+	Amount    float64
+}
+
+// DateRange is a time range which is requested from an exchange in a single
+// request
+type DateRange struct {
+	Start time.Time
+	End   time.Time
+}
+
+// Duration returns the interval as a time.Duration
+func (i Interval) Duration() time.Duration {
+	return time.Duration(i)
+}
+
+// String returns the short form of the interval e.g. 15m, 4h, 1d or 1w
+func (i Interval) String() string {
+	switch {
+	case i >= OneWeek && i%OneWeek == 0:
+		return fmt.Sprintf("%dw", i/OneWeek)
+	case i >= OneDay && i%OneDay == 0:
+		return fmt.Sprintf("%dd", i/OneDay)
+	case i >= OneHour && i%OneHour == 0:
+		return fmt.Sprintf("%dh", i/OneHour)
+	case i >= OneMin && i%OneMin == 0:
+		return fmt.Sprintf("%dm", i/OneMin)
+	}
+	return time.Duration(i).String()
+}
+
+// MarshalJSON encodes the interval in its short form
+func (i Interval) MarshalJSON() ([]byte, error) {
+	return json.Marshal(i.String())
+}
+
+// UnmarshalJSON decodes the short form of a standard interval
+func (i *Interval) UnmarshalJSON(data []byte) error {
+	var s string
+	err := json.Unmarshal(data, &s)
+	if err != nil {
+		return err
+	}
+
+	*i, err = ParseInterval(s)
+	return err
+}
+
+// IsSupported returns whether or not the interval is one of the standard
+// candle intervals
+func (i Interval) IsSupported() bool {
+	for x := range SupportedIntervals {
+		if SupportedIntervals[x] == i {
+			return true
+		}
+	}
+	return false
+}
+
+// ParseInterval parses the short form of a standard interval e.g. 15m
+func ParseInterval(s string) (Interval, error) {
+	for x := range SupportedIntervals {
+		if SupportedIntervals[x].String() == s {
+			return SupportedIntervals[x], nil
+		}
+	}
+	return 0, ErrUnsupportedInterval
+}
+
+// CheckRequest returns an error if the interval is not a standard interval or
+// the time range is invalid
+func CheckRequest(start, end time.Time, interval Interval) error {
+	if !interval.IsSupported() {
+		return ErrUnsupportedInterval
+	}
+
+	if start.IsZero() || end.IsZero() || !start.Before(end) {
+		return ErrInvalidTimeRange
+	}
+	return nil
+}
+
+// CalculateCandleDateRanges splits the time range into date ranges which each
+// cover at most limit candles so they can be requested in pages
+func CalculateCandleDateRanges(start, end time.Time, interval Interval, limit int) []DateRange {
+	start = start.Truncate(interval.Duration())
+	if limit <= 0 {
+		return []DateRange{{Start: start, End: end}}
+	}
+
+	var ranges []DateRange
+	step := interval.Duration() * time.Duration(limit)
+	for s := start; s.Before(end); s = s.Add(step) {
+		e := s.Add(step)
+		if e.After(end) {
+			e = end
+		}
+		ranges = append(ranges, DateRange{Start: s, End: e})
+	}
+	return ranges
+}
+
+// CreateKline builds candles for the interval from trades, intervals without
+// any trades are skipped
+func CreateKline(trades []Trade, interval Interval, p currency.Pair, assetType asset.Item, exchName string) (Item, error) {
+	item := Item{
+		Exchange:  exchName,
+		Pair:      p,
+		AssetType: assetType,
+		Interval:  interval,
+	}
+
+	if interval <= 0 {
+		return item, ErrUnsupportedInterval
+	}
+
+	sorted := make([]Trade, len(trades))
+	copy(sorted, trades)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Timestamp.Before(sorted[j].Timestamp)
+	})
+
+	for x := range sorted {
+		t := sorted[x].Timestamp.Truncate(interval.Duration())
+		last := len(item.Candles) - 1
+		if last < 0 || !item.Candles[last].Time.Equal(t) {
+			item.Candles = append(item.Candles, Candle{
+				Time:   t,
+				Open:   sorted[x].Price,
+				High:   sorted[x].Price,
+				Low:    sorted[x].Price,
+				Close:  sorted[x].Price,
+				Volume: sorted[x].Amount,
+			})
+			continue
+		}
+
+		candle := &item.Candles[last]
+		if sorted[x].Price > candle.High {
+			candle.High = sorted[x].Price
+		}
+		if sorted[x].Price < candle.Low {
+			candle.Low = sorted[x].Price
+		}
+		candle.Close = sorted[x].Price
+		candle.Volume += sorted[x].Amount
+	}
+	return item, nil
+}
+
+// SortCandlesByTimestamp sorts the candles oldest first
+func (k *Item) SortCandlesByTimestamp() {
+	sort.SliceStable(k.Candles, func(i, j int) bool {
+		return k.Candles[i].Time.Before(k.Candles[j].Time)
+	})
+}
+
+// FilterCandles removes candles starting outside of the time range along with
+// candles repeated across pages, then sorts the remaining candles oldest first
+func (k *Item) FilterCandles(start, end time.Time) {
+	k.SortCandlesByTimestamp()
+	start = start.Truncate(k.Interval.Duration())
+	var filtered []Candle
+	for x := range k.Candles {
+		if k.Candles[x].Time.Before(start) || !k.Candles[x].Time.Before(end) {
+			continue
+		}
+
+		if len(filtered) > 0 &&
+			filtered[len(filtered)-1].Time.Equal(k.Candles[x].Time) {
+			continue
+		}
+		filtered = append(filtered, k.Candles[x])
+	}
+	k.Candles = filtered
+}
@@ -0,0 +1,172 @@
+package kline
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+)
+
+func TestIntervalString(t *testing.T) {
+	tests := map[Interval]string{
+		OneMin:     "1m",
+		FifteenMin: "15m",
+		FourHour:   "4h",
+		OneDay:     "1d",
+		ThreeDay:   "3d",
+		OneWeek:    "1w",
+	}
+
+	for i, expected := range tests {
+		if i.String() != expected {
+			t.Errorf("Test failed. Expected %s, received %s", expected, i)
+		}
+	}
+}
+
+func TestParseInterval(t *testing.T) {
+	for x := range SupportedIntervals {
+		i, err := ParseInterval(SupportedIntervals[x].String())
+		if err != nil || i != SupportedIntervals[x] {
+			t.Errorf("Test failed. Unable to parse %s: %v", SupportedIntervals[x],
+				err)
+		}
+	}
+
+	_, err := ParseInterval("2m")
+	if err != ErrUnsupportedInterval {
+		t.Errorf("Test failed. Expected %s, received %v", ErrUnsupportedInterval,
+			err)
+	}
+}
+
+func TestIntervalJSON(t *testing.T) {
+	data, err := json.Marshal(FourHour)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(data) != `"4h"` {
+		t.Errorf("Test failed. Unexpected JSON %s", data)
+	}
+
+	var i Interval
+	err = json.Unmarshal(data, &i)
+	if err != nil || i != FourHour {
+		t.Errorf("Test failed. Expected %s, received %s %v", FourHour, i, err)
+	}
+
+	err = json.Unmarshal([]byte(`"2m"`), &i)
+	if err != ErrUnsupportedInterval {
+		t.Errorf("Test failed. Expected %s, received %v", ErrUnsupportedInterval,
+			err)
+	}
+}
+
+func TestCheckRequest(t *testing.T) {
+	now := time.Now()
+	err := CheckRequest(now.Add(-time.Hour), now, OneMin)
+	if err != nil {
+		t.Errorf("Test failed. CheckRequest: %s", err)
+	}
+
+	err = CheckRequest(now.Add(-time.Hour), now, Interval(time.Second))
+	if err != ErrUnsupportedInterval {
+		t.Errorf("Test failed. Expected %s, received %v", ErrUnsupportedInterval,
+			err)
+	}
+
+	err = CheckRequest(now, now, OneMin)
+	if err != ErrInvalidTimeRange {
+		t.Errorf("Test failed. Expected %s, received %v", ErrInvalidTimeRange,
+			err)
+	}
+
+	err = CheckRequest(time.Time{}, now, OneMin)
+	if err != ErrInvalidTimeRange {
+		t.Errorf("Test failed. Expected %s, received %v", ErrInvalidTimeRange,
+			err)
+	}
+}
+
+func TestCalculateCandleDateRanges(t *testing.T) {
+	start := time.Unix(30, 0)
+	end := time.Unix(600, 0)
+
+	ranges := CalculateCandleDateRanges(start, end, OneMin, 4)
+	if len(ranges) != 3 {
+		t.Fatalf("Test failed. Expected 3 ranges, received %d", len(ranges))
+	}
+
+	if !ranges[0].Start.Equal(time.Unix(0, 0)) ||
+		!ranges[0].End.Equal(time.Unix(240, 0)) ||
+		!ranges[2].Start.Equal(time.Unix(480, 0)) ||
+		!ranges[2].End.Equal(end) {
+		t.Errorf("Test failed. Unexpected ranges %+v", ranges)
+	}
+
+	ranges = CalculateCandleDateRanges(start, end, OneMin, 0)
+	if len(ranges) != 1 {
+		t.Errorf("Test failed. Expected 1 range, received %d", len(ranges))
+	}
+}
+
+func TestCreateKline(t *testing.T) {
+	trades := []Trade{
+		{Timestamp: time.Unix(130, 0), Price: 6, Amount: 1},
+		{Timestamp: time.Unix(10, 0), Price: 2, Amount: 1},
+		{Timestamp: time.Unix(20, 0), Price: 4, Amount: 2},
+		{Timestamp: time.Unix(30, 0), Price: 1, Amount: 0.5},
+		{Timestamp: time.Unix(50, 0), Price: 3, Amount: 1},
+	}
+	p := currency.NewPairFromString("BTCUSD")
+
+	_, err := CreateKline(trades, 0, p, "SPOT", "test")
+	if err != ErrUnsupportedInterval {
+		t.Errorf("Test failed. Expected %s, received %v", ErrUnsupportedInterval,
+			err)
+	}
+
+	item, err := CreateKline(trades, OneMin, p, "SPOT", "test")
+	if err != nil {
+		t.Fatalf("Test failed. CreateKline error: %s", err)
+	}
+
+	// No trades occurred between 60 and 120 so there is no candle
+	if len(item.Candles) != 2 {
+		t.Fatalf("Test failed. Expected 2 candles, received %d",
+			len(item.Candles))
+	}
+
+	c := item.Candles[0]
+	if !c.Time.Equal(time.Unix(0, 0)) || c.Open != 2 || c.High != 4 ||
+		c.Low != 1 || c.Close != 3 || c.Volume != 4.5 {
+		t.Errorf("Test failed. Unexpected candle %+v", c)
+	}
+
+	c = item.Candles[1]
+	if !c.Time.Equal(time.Unix(120, 0)) || c.Open != 6 || c.Close != 6 {
+		t.Errorf("Test failed. Unexpected candle %+v", c)
+	}
+}
+
+func TestFilterCandles(t *testing.T) {
+	item := Item{
+		Interval: OneMin,
+		Candles: []Candle{
+			{Time: time.Unix(180, 0)},
+			{Time: time.Unix(60, 0)},
+			{Time: time.Unix(120, 0)},
+			{Time: time.Unix(0, 0)},
+			{Time: time.Unix(60, 0)},
+		},
+	}
+
+	item.FilterCandles(time.Unix(90, 0), time.Unix(180, 0))
+	if len(item.Candles) != 2 ||
+		!item.Candles[0].Time.Equal(time.Unix(60, 0)) ||
+		!item.Candles[1].Time.Equal(time.Unix(120, 0)) {
+		t.Errorf("Test failed. Unexpected candles %+v", item.Candles)
+	}
+}
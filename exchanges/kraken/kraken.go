@@ -14,8 +14,9 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
-	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/websocket/wshandler"
 	log "github.com/thrasher-corp/gocryptotrader/logger"
 )
@@ -51,16 +52,38 @@ const (
 	krakenWithdrawStatus   = "WithdrawStatus"
 	krakenWithdrawCancel   = "WithdrawCancel"
 
-	krakenAuthRate   = 0
-	krakenUnauthRate = 0
+	// Kraken private calls increase a counter which is capped at
+	// krakenCounterLimit and decays by one every krakenCounterDecay, public
+	// calls are limited to one per second
+	krakenCounterLimit = 15
+	krakenCounterDecay = time.Second * 3
+	krakenUnauthRate   = 1
+
+	// krakenOHLCLimit is the number of recent candles Kraken provides
+	krakenOHLCLimit = 720
 )
 
+// krakenOHLCIntervals maps candle intervals to the intervals in minutes Kraken
+// supports
+var krakenOHLCIntervals = map[kline.Interval]int64{
+	kline.OneMin:     1,
+	kline.FiveMin:    5,
+	kline.FifteenMin: 15,
+	kline.ThirtyMin:  30,
+	kline.OneHour:    60,
+	kline.FourHour:   240,
+	kline.OneDay:     1440,
+	kline.OneWeek:    10080,
+}
+
 // Kraken is the overarching type across the alphapoint package
 type Kraken struct {
 	exchange.Base
 	WebsocketConn      *wshandler.WebsocketConnection
 	CryptoFee, FiatFee float64
-	wsRequestMtx       sync.Mutex
+	wsRequestMtx       *sync.Mutex
+	wsPrecisionMtx     *sync.Mutex
+	wsPrecision        map[currency.Pair]wsOrderbookPrecision
 }
 
 // SetDefaults sets current default settings
@@ -69,6 +92,8 @@ func (k *Kraken) SetDefaults() {
 	k.Enabled = false
 	k.FiatFee = 0.35
 	k.CryptoFee = 0.10
+	k.wsRequestMtx = new(sync.Mutex)
+	k.wsPrecisionMtx = new(sync.Mutex)
 	k.Verbose = false
 	k.RESTPollingDelay = 10
 	k.APIWithdrawPermissions = exchange.AutoWithdrawCryptoWithSetup |
@@ -80,24 +105,47 @@ func (k *Kraken) SetDefaults() {
 	k.RequestCurrencyPairFormat.Separator = ","
 	k.ConfigCurrencyPairFormat.Delimiter = "-"
 	k.ConfigCurrencyPairFormat.Uppercase = true
-	k.AssetTypes = []string{ticker.Spot}
-	k.SupportsAutoPairUpdating = true
-	k.SupportsRESTTickerBatching = true
+	k.AssetTypes = asset.Items{asset.Spot}
+	k.Features = exchange.Features{
+		REST: exchange.ProtocolFeatures{
+			TickerFetching:    true,
+			TickerBatching:    true,
+			OrderbookFetching: true,
+			TradeFetching:     true,
+			HistoricTrades:    true,
+			KlineFetching:     true,
+			AutoPairUpdates:   true,
+			AccountInfo:       true,
+			ActiveOrders:      true,
+			OrderHistory:      true,
+			SubmitOrder:       true,
+			CancelOrder:       true,
+			CancelOrders:      true,
+			DepositAddress:    true,
+			CryptoWithdrawal:  true,
+			FiatWithdrawal:    true,
+		},
+		Websocket: exchange.ProtocolFeatures{
+			TickerFetching:     true,
+			OrderbookFetching:  true,
+			TradeFetching:      true,
+			KlineFetching:      true,
+			Subscribe:          true,
+			Unsubscribe:        true,
+			MessageCorrelation: true,
+		},
+		OrderTypes: []exchange.OrderType{exchange.LimitOrderType, exchange.MarketOrderType},
+	}
 	k.Requester = request.New(k.Name,
-		request.NewRateLimit(time.Second, krakenAuthRate),
+		request.NewRateLimit(time.Second, 0),
 		request.NewRateLimit(time.Second, krakenUnauthRate),
 		common.NewHTTPClientWithTimeout(exchange.DefaultHTTPTimeout))
+	k.setRateLimits()
 	k.APIUrlDefault = krakenAPIURL
 	k.APIUrl = k.APIUrlDefault
 	k.Websocket = wshandler.New()
 	k.WebsocketURL = krakenWSURL
-	k.Websocket.Functionality = wshandler.WebsocketTickerSupported |
-		wshandler.WebsocketTradeDataSupported |
-		wshandler.WebsocketKlineSupported |
-		wshandler.WebsocketOrderbookSupported |
-		wshandler.WebsocketSubscribeSupported |
-		wshandler.WebsocketUnsubscribeSupported |
-		wshandler.WebsocketMessageCorrelationSupported
+	k.Websocket.Functionality = k.Features.Websocket.Functionality()
 	k.WebsocketResponseMaxLimit = exchange.DefaultWebsocketResponseMaxLimit
 	k.WebsocketResponseCheckTimeout = exchange.DefaultWebsocketResponseCheckTimeout
 	k.WebsocketOrderbookBufferLimit = exchange.DefaultWebsocketOrderbookBufferLimit
@@ -113,6 +161,7 @@ func (k *Kraken) Setup(exch *config.ExchangeConfig) {
 		k.SetAPIKeys(exch.APIKey, exch.APISecret, "", false)
 		k.SetHTTPClientTimeout(exch.HTTPTimeout)
 		k.SetHTTPClientUserAgent(exch.HTTPUserAgent)
+		k.SetHTTPRetryPolicy(exch.HTTPRetry)
 		k.RESTPollingDelay = exch.RESTPollingDelay
 		k.Verbose = exch.Verbose
 		k.HTTPDebugging = exch.HTTPDebugging
@@ -168,6 +217,8 @@ func (k *Kraken) Setup(exch *config.ExchangeConfig) {
 			false,
 			false,
 			exch.Name)
+		k.Websocket.Orderbook.SetMaxDepth(krakenWsOrderbookDepth)
+		k.Websocket.Orderbook.SetupVerification(k.wsOrderbookChecksum, nil)
 	}
 }
 
@@ -300,8 +351,21 @@ func (k *Kraken) GetTickers(pairList string) (Tickers, error) {
 
 // GetOHLC returns an array of open high low close values of a currency pair
 func (k *Kraken) GetOHLC(symbol string) ([]OpenHighLowClose, error) {
+	return k.GetOHLCInterval(symbol, 0, 0)
+}
+
+// GetOHLCInterval returns up to 720 of the most recent open high low close
+// values of a currency pair for the interval in minutes, after the since unix
+// timestamp if it is set
+func (k *Kraken) GetOHLCInterval(symbol string, interval, since int64) ([]OpenHighLowClose, error) {
 	values := url.Values{}
 	values.Set("pair", symbol)
+	if interval != 0 {
+		values.Set("interval", strconv.FormatInt(interval, 10))
+	}
+	if since != 0 {
+		values.Set("since", strconv.FormatInt(since, 10))
+	}
 
 	type Response struct {
 		Error []interface{}          `json:"error"`
@@ -322,7 +386,17 @@ func (k *Kraken) GetOHLC(symbol string) ([]OpenHighLowClose, error) {
 		return OHLC, fmt.Errorf("getOHLC error: %s", result.Error)
 	}
 
-	for _, y := range result.Data[symbol].([]interface{}) {
+	data, ok := result.Data[symbol].([]interface{})
+	if !ok {
+		for key, value := range result.Data {
+			if key != "last" {
+				data, _ = value.([]interface{})
+				break
+			}
+		}
+	}
+
+	for _, y := range data {
 		o := OpenHighLowClose{}
 		for i, x := range y.([]interface{}) {
 			switch i {
@@ -406,8 +480,19 @@ func (k *Kraken) GetDepth(symbol string) (Orderbook, error) {
 
 // GetTrades returns current trades on Kraken
 func (k *Kraken) GetTrades(symbol string) ([]RecentTrades, error) {
+	trades, _, err := k.GetTradesSince(symbol, 0)
+	return trades, err
+}
+
+// GetTradesSince returns up to 1000 trades on Kraken after the since cursor,
+// which is a unix timestamp in nanoseconds, along with the cursor to use for
+// the next page. A since value of 0 returns the most recent trades
+func (k *Kraken) GetTradesSince(symbol string, since int64) ([]RecentTrades, int64, error) {
 	values := url.Values{}
 	values.Set("pair", symbol)
+	if since != 0 {
+		values.Set("since", strconv.FormatInt(since, 10))
+	}
 
 	var recentTrades []RecentTrades
 	var result interface{}
@@ -416,13 +501,42 @@ func (k *Kraken) GetTrades(symbol string) ([]RecentTrades, error) {
 
 	err := k.SendHTTPRequest(path, &result)
 	if err != nil {
-		return recentTrades, err
+		return recentTrades, 0, err
 	}
 
-	data := result.(map[string]interface{})
-	tradeInfo := data["result"].(map[string]interface{})
+	data, ok := result.(map[string]interface{})
+	if !ok {
+		return recentTrades, 0, errors.New("unable to parse trades response")
+	}
+
+	if errs, ok := data["error"].([]interface{}); ok && len(errs) > 0 {
+		return recentTrades, 0, fmt.Errorf("%v", errs[0])
+	}
+
+	tradeInfo, ok := data["result"].(map[string]interface{})
+	if !ok {
+		return recentTrades, 0, errors.New("unable to parse trades response")
+	}
+
+	var last int64
+	if cursor, ok := tradeInfo["last"].(string); ok {
+		last, err = strconv.ParseInt(cursor, 10, 64)
+		if err != nil {
+			return recentTrades, 0, err
+		}
+	}
+
+	trades, ok := tradeInfo[symbol].([]interface{})
+	if !ok {
+		for key, value := range tradeInfo {
+			if key != "last" {
+				trades, _ = value.([]interface{})
+				break
+			}
+		}
+	}
 
-	for _, x := range tradeInfo[symbol].([]interface{}) {
+	for _, x := range trades {
 		r := RecentTrades{}
 		for i, y := range x.([]interface{}) {
 			switch i {
@@ -442,7 +556,7 @@ func (k *Kraken) GetTrades(symbol string) ([]RecentTrades, error) {
 		}
 		recentTrades = append(recentTrades, r)
 	}
-	return recentTrades, nil
+	return recentTrades, last, nil
 }
 
 // GetSpread returns the full spread on Kraken
@@ -949,6 +1063,29 @@ func GetError(apiErrors []string) error {
 	return nil
 }
 
+// setRateLimits sets the decaying call counter of private endpoints, ledger
+// and trade history calls cost two while order placement and cancellation
+// are limited by the matching engine instead
+func (k *Kraken) setRateLimits() {
+	k.Requester.SetLimiter(true, request.NewTokenBucket(krakenCounterDecay, 1,
+		krakenCounterLimit))
+	for _, method := range []string{krakenLedgers, krakenQueryLedgers,
+		krakenTradeHistory, krakenQueryTrades} {
+		k.Requester.SetEndpoint("", krakenPrivatePath(method),
+			request.Endpoint{Weight: 2})
+	}
+
+	for _, method := range []string{krakenOrderPlace, krakenOrderCancel} {
+		k.Requester.SetEndpoint("", krakenPrivatePath(method),
+			request.Endpoint{})
+	}
+}
+
+// krakenPrivatePath returns the path of a private method
+func krakenPrivatePath(method string) string {
+	return fmt.Sprintf("/%s/private/%s", krakenAPIVersion, method)
+}
+
 // SendHTTPRequest sends an unauthenticated HTTP requests
 func (k *Kraken) SendHTTPRequest(path string, result interface{}) error {
 	return k.SendPayload(http.MethodGet,
@@ -970,7 +1107,7 @@ func (k *Kraken) SendAuthenticatedHTTPRequest(method string, params url.Values,
 			k.Name)
 	}
 
-	path := fmt.Sprintf("/%s/private/%s", krakenAPIVersion, method)
+	path := krakenPrivatePath(method)
 
 	n := k.Requester.GetNonce(true).String()
 	params.Set("nonce", n)
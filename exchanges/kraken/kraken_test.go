@@ -8,6 +8,8 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/sharedtestvalues"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/websocket/wshandler"
 )
@@ -430,7 +432,7 @@ func TestSubmitOrder(t *testing.T) {
 		Base:      currency.XBT,
 		Quote:     currency.CAD,
 	}
-	response, err := k.SubmitOrder(p, exchange.BuyOrderSide, exchange.MarketOrderType, 1, 10, "hi")
+	response, err := k.SubmitOrder(p, asset.Spot, exchange.BuyOrderSide, exchange.MarketOrderType, 1, 10, "hi")
 	if areTestAPIKeysSet() && (err != nil || !response.IsOrderPlaced) {
 		t.Errorf("Order failed to be placed: %v", err)
 	} else if !areTestAPIKeysSet() && err == nil {
@@ -682,3 +684,32 @@ func TestWebsocketSubscribe(t *testing.T) {
 		t.Error(err)
 	}
 }
+
+// TestWsOrderbookChecksum verifies the checksum of the documented orderbook
+// example
+func TestWsOrderbookChecksum(t *testing.T) {
+	var kr Kraken
+	kr.SetDefaults()
+	p := currency.NewPairFromStrings("ETH", "XBT")
+	kr.setWsPrecision(p, wsOrderbookPrecision{
+		price:  decimals("0.05005"),
+		volume: decimals("0.00000500"),
+	})
+
+	var bids, asks []orderbook.Item
+	for _, price := range []float64{0.05005, 0.05010, 0.05015, 0.05020,
+		0.05025, 0.05030, 0.05035, 0.05040, 0.05045, 0.05050} {
+		asks = append(asks, orderbook.Item{Price: price, Amount: 0.000005})
+	}
+	for _, price := range []float64{0.05000, 0.04995, 0.04990, 0.04980,
+		0.04975, 0.04970, 0.04965, 0.04960, 0.04955, 0.04950, 0.04945} {
+		bids = append(bids, orderbook.Item{Price: price, Amount: 0.000005})
+	}
+	d := orderbook.NewDepth(kr.Name, p, asset.Spot)
+	d.Load(&orderbook.Base{Bids: bids, Asks: asks})
+
+	if checksum := kr.wsOrderbookChecksum(d); checksum != 974947235 {
+		t.Errorf("Test failed - wsOrderbookChecksum() expected 974947235 received %d",
+			checksum)
+	}
+}
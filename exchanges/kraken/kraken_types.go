@@ -459,3 +459,10 @@ type WebsocketChannelData struct {
 	Pair         currency.Pair
 	ChannelID    int64
 }
+
+// wsOrderbookPrecision holds the number of decimals with which the prices and
+// volumes of a websocket orderbook are published, which its checksum covers
+type wsOrderbookPrecision struct {
+	price  int
+	volume int
+}
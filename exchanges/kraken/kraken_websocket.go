@@ -3,14 +3,17 @@ package kraken
 import (
 	"errors"
 	"fmt"
+	"hash/crc32"
 	"math"
 	"net/http"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/gorilla/websocket"
 	"github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/websocket/wshandler"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/websocket/wsorderbook"
@@ -38,8 +41,11 @@ const (
 	krakenWsTrade              = "trade"
 	krakenWsSpread             = "spread"
 	krakenWsOrderbook          = "book"
+	// krakenWsOrderbookDepth is the number of levels a side of the subscribed
+	// orderbooks, which their checksums cover
+	krakenWsOrderbookDepth = 10
 	// Only supported asset type
-	krakenWsAssetType    = orderbook.Spot
+	krakenWsAssetType    = asset.Spot
 	orderbookBufferLimit = 3
 	krakenWsRateLimit    = 50
 )
@@ -157,7 +163,20 @@ func (k *Kraken) WsHandleDataResponse(response WebsocketDataResponse) {
 			log.Debugf("%v Websocket Orderbook data received",
 				k.Name)
 		}
-		k.wsProcessOrderBook(&channelData, response[1])
+		obData := response[1]
+		// Updates to both sides are published as two objects, the
+		// second carrying the checksum
+		if len(response) > 2 {
+			if bids, ok := response[2].(map[string]interface{}); ok {
+				asks, ok := obData.(map[string]interface{})
+				if ok {
+					for key, value := range bids {
+						asks[key] = value
+					}
+				}
+			}
+		}
+		k.wsProcessOrderBook(&channelData, obData)
 	case krakenWsSpread:
 		if k.Verbose {
 			log.Debugf("%v Websocket Spread data received",
@@ -250,16 +269,25 @@ func (k *Kraken) wsProcessTickers(channelData *WebsocketChannelData, data interf
 	highPrice, _ := strconv.ParseFloat(highData[0].(string), 64)
 	lowPrice, _ := strconv.ParseFloat(lowData[0].(string), 64)
 	quantity, _ := strconv.ParseFloat(volumeData[0].(string), 64)
+	var bidPrice, askPrice float64
+	if bidData, ok := tickerData["b"].([]interface{}); ok && len(bidData) > 0 {
+		bidPrice, _ = strconv.ParseFloat(bidData[0].(string), 64)
+	}
+	if askData, ok := tickerData["a"].([]interface{}); ok && len(askData) > 0 {
+		askPrice, _ = strconv.ParseFloat(askData[0].(string), 64)
+	}
 
 	k.Websocket.DataHandler <- wshandler.TickerData{
 		Timestamp:  time.Now(),
 		Exchange:   k.Name,
-		AssetType:  orderbook.Spot,
+		AssetType:  asset.Spot,
 		Pair:       channelData.Pair,
 		ClosePrice: closePrice,
 		OpenPrice:  openPrice,
 		HighPrice:  highPrice,
 		LowPrice:   lowPrice,
+		BidPrice:   bidPrice,
+		AskPrice:   askPrice,
 		Quantity:   quantity,
 	}
 }
@@ -297,7 +325,7 @@ func (k *Kraken) wsProcessTrades(channelData *WebsocketChannelData, data interfa
 		amount, _ := strconv.ParseFloat(trade[1].(string), 64)
 
 		k.Websocket.DataHandler <- wshandler.TradeData{
-			AssetType:    orderbook.Spot,
+			AssetType:    asset.Spot,
 			CurrencyPair: channelData.Pair,
 			EventTime:    time.Now().Unix(),
 			Exchange:     k.Name,
@@ -337,7 +365,7 @@ func (k *Kraken) wsProcessOrderBook(channelData *WebsocketChannelData, data inte
 func (k *Kraken) wsProcessOrderBookPartial(channelData *WebsocketChannelData, obData map[string]interface{}) {
 	base := orderbook.Base{
 		Pair:      channelData.Pair,
-		AssetType: orderbook.Spot,
+		AssetType: asset.Spot,
 	}
 	// Kraken ob data is timestamped per price, GCT orderbook data is timestamped per entry
 	// Using the highest last update time, we can attempt to respect both within a reasonable degree
@@ -375,6 +403,13 @@ func (k *Kraken) wsProcessOrderBookPartial(channelData *WebsocketChannelData, ob
 		}
 	}
 	base.LastUpdated = highestLastUpdate
+	if len(askData) > 0 {
+		asks := askData[0].([]interface{})
+		k.setWsPrecision(channelData.Pair, wsOrderbookPrecision{
+			price:  decimals(asks[0].(string)),
+			volume: decimals(asks[1].(string)),
+		})
+	}
 	err := k.Websocket.Orderbook.LoadSnapshot(&base, true)
 	if err != nil {
 		k.Websocket.DataHandler <- err
@@ -382,7 +417,7 @@ func (k *Kraken) wsProcessOrderBookPartial(channelData *WebsocketChannelData, ob
 	}
 	k.Websocket.DataHandler <- wshandler.WebsocketOrderbookUpdate{
 		Exchange: k.Name,
-		Asset:    orderbook.Spot,
+		Asset:    asset.Spot,
 		Pair:     channelData.Pair,
 	}
 }
@@ -433,6 +468,14 @@ func (k *Kraken) wsProcessOrderBookUpdate(channelData *WebsocketChannelData, obD
 		}
 	}
 	update.UpdateTime = highestLastUpdate
+	if checksum, ok := obData["c"].(string); ok {
+		c, err := strconv.ParseUint(checksum, 10, 32)
+		if err != nil {
+			return err
+		}
+		update.Checksum = uint32(c)
+		update.HasChecksum = true
+	}
 	err := k.Websocket.Orderbook.Update(&update)
 	if err != nil {
 		k.Websocket.DataHandler <- err
@@ -440,12 +483,57 @@ func (k *Kraken) wsProcessOrderBookUpdate(channelData *WebsocketChannelData, obD
 	}
 	k.Websocket.DataHandler <- wshandler.WebsocketOrderbookUpdate{
 		Exchange: k.Name,
-		Asset:    orderbook.Spot,
+		Asset:    asset.Spot,
 		Pair:     channelData.Pair,
 	}
 	return nil
 }
 
+// setWsPrecision stores the decimals of the prices and volumes of a
+// websocket orderbook
+func (k *Kraken) setWsPrecision(p currency.Pair, precision wsOrderbookPrecision) {
+	k.wsPrecisionMtx.Lock()
+	if k.wsPrecision == nil {
+		k.wsPrecision = make(map[currency.Pair]wsOrderbookPrecision)
+	}
+	k.wsPrecision[p] = precision
+	k.wsPrecisionMtx.Unlock()
+}
+
+// wsOrderbookChecksum returns the CRC32 checksum of the top ten asks and bids
+// of a websocket orderbook, each price and volume is formatted as published
+// with its decimal point and leading zeros removed
+func (k *Kraken) wsOrderbookChecksum(ob *orderbook.Depth) uint32 {
+	k.wsPrecisionMtx.Lock()
+	precision := k.wsPrecision[ob.Pair()]
+	k.wsPrecisionMtx.Unlock()
+
+	bids, asks := ob.Levels(krakenWsOrderbookDepth)
+	var sb strings.Builder
+	for _, levels := range [][]orderbook.Item{asks, bids} {
+		for i := range levels {
+			sb.WriteString(checksumValue(levels[i].Price, precision.price))
+			sb.WriteString(checksumValue(levels[i].Amount, precision.volume))
+		}
+	}
+	return crc32.ChecksumIEEE([]byte(sb.String()))
+}
+
+// checksumValue formats a price or volume for the orderbook checksum
+func checksumValue(value float64, decimals int) string {
+	s := strconv.FormatFloat(value, 'f', decimals, 64)
+	return strings.TrimLeft(strings.Replace(s, ".", "", 1), "0")
+}
+
+// decimals returns the number of decimals of a published price or volume
+func decimals(value string) int {
+	i := strings.IndexByte(value, '.')
+	if i == -1 {
+		return 0
+	}
+	return len(value) - i - 1
+}
+
 // wsProcessCandles converts candle data and sends it to the data handler
 func (k *Kraken) wsProcessCandles(channelData *WebsocketChannelData, data interface{}) {
 	candleData := data.([]interface{})
@@ -460,7 +548,7 @@ func (k *Kraken) wsProcessCandles(channelData *WebsocketChannelData, data interf
 	volume, _ := strconv.ParseFloat(candleData[7].(string), 64)
 
 	k.Websocket.DataHandler <- wshandler.KlineData{
-		AssetType: orderbook.Spot,
+		AssetType: asset.Spot,
 		Pair:      channelData.Pair,
 		Timestamp: time.Now(),
 		Exchange:  k.Name,
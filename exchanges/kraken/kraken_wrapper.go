@@ -1,6 +1,7 @@
 package kraken
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"strings"
@@ -10,6 +11,8 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/websocket/wshandler"
@@ -25,6 +28,14 @@ func (k *Kraken) Start(wg *sync.WaitGroup) {
 	}()
 }
 
+// WithContext returns a copy of Kraken whose HTTP requests are abandoned once
+// the context is done
+func (k *Kraken) WithContext(ctx context.Context) exchange.IBotExchange {
+	cp := *k
+	cp.Base = k.ContextBase(ctx)
+	return &cp
+}
+
 // Run implements the Kraken wrapper
 func (k *Kraken) Run() {
 	if k.Verbose {
@@ -85,13 +96,10 @@ func (k *Kraken) Run() {
 }
 
 // UpdateTicker updates and returns the ticker for a currency pair
-func (k *Kraken) UpdateTicker(p currency.Pair, assetType string) (ticker.Price, error) {
+func (k *Kraken) UpdateTicker(p currency.Pair, assetType asset.Item) (ticker.Price, error) {
 	var tickerPrice ticker.Price
 	pairs := k.GetEnabledCurrencies()
-	pairsCollated, err := exchange.GetAndFormatExchangeCurrencies(k.Name, pairs)
-	if err != nil {
-		return tickerPrice, err
-	}
+	pairsCollated := k.GetAndFormatExchangeCurrencies(pairs)
 	tickers, err := k.GetTickers(pairsCollated)
 	if err != nil {
 		return tickerPrice, err
@@ -118,7 +126,7 @@ func (k *Kraken) UpdateTicker(p currency.Pair, assetType string) (ticker.Price,
 }
 
 // GetTickerPrice returns the ticker for a currency pair
-func (k *Kraken) GetTickerPrice(p currency.Pair, assetType string) (ticker.Price, error) {
+func (k *Kraken) GetTickerPrice(p currency.Pair, assetType asset.Item) (ticker.Price, error) {
 	tickerNew, err := ticker.GetTicker(k.GetName(), p, assetType)
 	if err != nil {
 		return k.UpdateTicker(p, assetType)
@@ -127,7 +135,7 @@ func (k *Kraken) GetTickerPrice(p currency.Pair, assetType string) (ticker.Price
 }
 
 // GetOrderbookEx returns orderbook base on the currency pair
-func (k *Kraken) GetOrderbookEx(p currency.Pair, assetType string) (orderbook.Base, error) {
+func (k *Kraken) GetOrderbookEx(p currency.Pair, assetType asset.Item) (orderbook.Base, error) {
 	ob, err := orderbook.Get(k.GetName(), p, assetType)
 	if err != nil {
 		return k.UpdateOrderbook(p, assetType)
@@ -136,9 +144,9 @@ func (k *Kraken) GetOrderbookEx(p currency.Pair, assetType string) (orderbook.Ba
 }
 
 // UpdateOrderbook updates and returns the orderbook for a currency pair
-func (k *Kraken) UpdateOrderbook(p currency.Pair, assetType string) (orderbook.Base, error) {
+func (k *Kraken) UpdateOrderbook(p currency.Pair, assetType asset.Item) (orderbook.Base, error) {
 	var orderBook orderbook.Base
-	orderbookNew, err := k.GetDepth(exchange.FormatExchangeCurrency(k.GetName(), p).String())
+	orderbookNew, err := k.GetDepth(k.FormatExchangeCurrency(p).String())
 	if err != nil {
 		return orderBook, err
 	}
@@ -196,15 +204,108 @@ func (k *Kraken) GetFundingHistory() ([]exchange.FundHistory, error) {
 	return fundHistory, common.ErrFunctionNotSupported
 }
 
-// GetExchangeHistory returns historic trade data since exchange opening.
-func (k *Kraken) GetExchangeHistory(p currency.Pair, assetType string) ([]exchange.TradeHistory, error) {
+// GetExchangeHistory returns the most recent trades for a currency pair
+func (k *Kraken) GetExchangeHistory(p currency.Pair, assetType asset.Item) ([]exchange.TradeHistory, error) {
+	trades, err := k.GetTrades(k.FormatExchangeCurrency(p).String())
+	if err != nil {
+		return nil, err
+	}
+	return k.convertTrades(trades), nil
+}
+
+// GetHistoricTrades returns the trades for a currency pair between the start
+// and end times, paging forward with the cursor returned by each request
+func (k *Kraken) GetHistoricTrades(p currency.Pair, assetType asset.Item, timestampStart, timestampEnd time.Time) ([]exchange.TradeHistory, error) {
+	err := exchange.CheckTimeRange(timestampStart, timestampEnd)
+	if err != nil {
+		return nil, err
+	}
+
+	symbol := k.FormatExchangeCurrency(p).String()
+	since := timestampStart.UnixNano()
 	var resp []exchange.TradeHistory
+	for {
+		trades, last, err := k.GetTradesSince(symbol, since)
+		if err != nil {
+			return nil, err
+		}
+
+		resp = append(resp, k.convertTrades(trades)...)
+		if len(trades) == 0 || last <= since || last > timestampEnd.UnixNano() {
+			break
+		}
+		since = last
+	}
+
+	exchange.FilterTradesByTimeRange(&resp, timestampStart, timestampEnd)
+	return resp, nil
+}
+
+// GetHistoricCandles returns candles between the start and end times. Kraken
+// only provides the most recent 720 candles for each interval, so older time
+// ranges and unsupported intervals are built from historic trades
+func (k *Kraken) GetHistoricCandles(p currency.Pair, assetType asset.Item, timestampStart, timestampEnd time.Time, interval kline.Interval) (kline.Item, error) {
+	err := kline.CheckRequest(timestampStart, timestampEnd, interval)
+	if err != nil {
+		return kline.Item{}, err
+	}
+
+	minutes, ok := krakenOHLCIntervals[interval]
+	oldest := time.Now().Add(-interval.Duration() * (krakenOHLCLimit - 1))
+	if !ok || timestampStart.Before(oldest.Truncate(interval.Duration())) {
+		return exchange.CreateKlineFromTrades(k, p, assetType, timestampStart,
+			timestampEnd, interval)
+	}
+
+	candles, err := k.GetOHLCInterval(k.FormatExchangeCurrency(p).String(), minutes, 0)
+	if err != nil {
+		return kline.Item{}, err
+	}
+
+	item := kline.Item{
+		Exchange:  k.Name,
+		Pair:      p,
+		AssetType: assetType,
+		Interval:  interval,
+	}
+
+	for i := range candles {
+		item.Candles = append(item.Candles, kline.Candle{
+			Time:   time.Unix(int64(candles[i].Time), 0),
+			Open:   candles[i].Open,
+			High:   candles[i].High,
+			Low:    candles[i].Low,
+			Close:  candles[i].Close,
+			Volume: candles[i].Volume,
+		})
+	}
+
+	item.FilterCandles(timestampStart, timestampEnd)
+	return item, nil
+}
+
+func (k *Kraken) convertTrades(trades []RecentTrades) []exchange.TradeHistory {
+	resp := make([]exchange.TradeHistory, len(trades))
+	for i := range trades {
+		side := exchange.BuyOrderSide
+		if trades[i].BuyOrSell == "s" {
+			side = exchange.SellOrderSide
+		}
 
-	return resp, common.ErrNotYetImplemented
+		resp[i] = exchange.TradeHistory{
+			Timestamp: time.Unix(0, int64(trades[i].Time*float64(time.Second))),
+			Price:     trades[i].Price,
+			Amount:    trades[i].Volume,
+			Exchange:  k.Name,
+			Side:      side,
+			Type:      trades[i].MarketOrLimit,
+		}
+	}
+	return resp
 }
 
 // SubmitOrder submits a new order
-func (k *Kraken) SubmitOrder(p currency.Pair, side exchange.OrderSide, orderType exchange.OrderType, amount, price float64, _ string) (exchange.SubmitOrderResponse, error) {
+func (k *Kraken) SubmitOrder(p currency.Pair, _ asset.Item, side exchange.OrderSide, orderType exchange.OrderType, amount, price float64, _ string) (exchange.SubmitOrderResponse, error) {
 	var submitOrderResponse exchange.SubmitOrderResponse
 	var args = AddOrderOptions{}
 
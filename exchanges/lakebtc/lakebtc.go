@@ -12,8 +12,8 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
-	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/websocket/wshandler"
 	log "github.com/thrasher-corp/gocryptotrader/logger"
 )
@@ -52,15 +52,35 @@ func (l *LakeBTC) SetDefaults() {
 	l.MakerFee = 0.15
 	l.Verbose = false
 	l.RESTPollingDelay = 10
-	l.APIWithdrawPermissions = exchange.AutoWithdrawCrypto |
-		exchange.WithdrawFiatViaWebsiteOnly
+	l.APIWithdrawPermissions = exchange.WithdrawFiatViaWebsiteOnly
 	l.RequestCurrencyPairFormat.Delimiter = ""
 	l.RequestCurrencyPairFormat.Uppercase = true
 	l.ConfigCurrencyPairFormat.Delimiter = ""
 	l.ConfigCurrencyPairFormat.Uppercase = true
-	l.AssetTypes = []string{ticker.Spot}
-	l.SupportsAutoPairUpdating = true
-	l.SupportsRESTTickerBatching = true
+	l.AssetTypes = asset.Items{asset.Spot}
+	l.Features = exchange.Features{
+		REST: exchange.ProtocolFeatures{
+			TickerFetching:    true,
+			TickerBatching:    true,
+			OrderbookFetching: true,
+			TradeFetching:     true,
+			AutoPairUpdates:   true,
+			AccountInfo:       true,
+			ActiveOrders:      true,
+			OrderHistory:      true,
+			SubmitOrder:       true,
+			CancelOrder:       true,
+			CancelOrders:      true,
+			DepositAddress:    true,
+			CryptoWithdrawal:  true,
+		},
+		Websocket: exchange.ProtocolFeatures{
+			OrderbookFetching: true,
+			TradeFetching:     true,
+			Subscribe:         true,
+		},
+		OrderTypes: []exchange.OrderType{exchange.LimitOrderType},
+	}
 	l.Requester = request.New(l.Name,
 		request.NewRateLimit(time.Second, lakeBTCAuthRate),
 		request.NewRateLimit(time.Second, lakeBTCUnauth),
@@ -68,9 +88,7 @@ func (l *LakeBTC) SetDefaults() {
 	l.APIUrlDefault = lakeBTCAPIURL
 	l.APIUrl = l.APIUrlDefault
 	l.Websocket = wshandler.New()
-	l.Websocket.Functionality = wshandler.WebsocketOrderbookSupported |
-		wshandler.WebsocketTradeDataSupported |
-		wshandler.WebsocketSubscribeSupported
+	l.Websocket.Functionality = l.Features.Websocket.Functionality()
 	l.WebsocketOrderbookBufferLimit = exchange.DefaultWebsocketOrderbookBufferLimit
 }
 
@@ -84,6 +102,7 @@ func (l *LakeBTC) Setup(exch *config.ExchangeConfig) {
 		l.SetAPIKeys(exch.APIKey, exch.APISecret, "", false)
 		l.SetHTTPClientTimeout(exch.HTTPTimeout)
 		l.SetHTTPClientUserAgent(exch.HTTPUserAgent)
+		l.SetHTTPRetryPolicy(exch.HTTPRetry)
 		l.RESTPollingDelay = exch.RESTPollingDelay
 		l.Verbose = exch.Verbose
 		l.HTTPDebugging = exch.HTTPDebugging
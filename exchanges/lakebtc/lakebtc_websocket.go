@@ -9,6 +9,7 @@ import (
 
 	"github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/websocket/wshandler"
 	log "github.com/thrasher-corp/gocryptotrader/logger"
@@ -139,9 +140,9 @@ func (l *LakeBTC) processTrades(data, channel string) error {
 		l.Websocket.DataHandler <- wshandler.TradeData{
 			Timestamp:    time.Unix(tradeData.Trades[i].Date, 0),
 			CurrencyPair: curr,
-			AssetType:    orderbook.Spot,
+			AssetType:    asset.Spot,
 			Exchange:     l.GetName(),
-			EventType:    orderbook.Spot,
+			EventType:    asset.Spot.String(),
 			EventTime:    tradeData.Trades[i].Date,
 			Price:        tradeData.Trades[i].Price,
 			Amount:       tradeData.Trades[i].Amount,
@@ -160,7 +161,7 @@ func (l *LakeBTC) processOrderbook(obUpdate, channel string) error {
 	book := orderbook.Base{
 		Pair:         l.getCurrencyFromChannel(channel),
 		LastUpdated:  time.Now(),
-		AssetType:    orderbook.Spot,
+		AssetType:    asset.Spot,
 		ExchangeName: l.Name,
 	}
 
@@ -237,7 +238,7 @@ func (l *LakeBTC) processTicker(ticker string) error {
 		l.Websocket.DataHandler <- wshandler.TickerData{
 			Timestamp: time.Now(),
 			Pair:      currency.NewPairFromString(k),
-			AssetType: orderbook.Spot,
+			AssetType: asset.Spot,
 			Exchange:  l.GetName(),
 			Quantity:  vol,
 			HighPrice: high,
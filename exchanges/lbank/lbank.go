@@ -19,8 +19,8 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/config"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
-	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/websocket/wshandler"
 	log "github.com/thrasher-corp/gocryptotrader/logger"
 )
@@ -38,6 +38,7 @@ const (
 	lbankAuthRateLimit   = 0
 	lbankUnAuthRateLimit = 0
 	lbankFeeNotFound     = 0.0
+	lbankTradesLimit     = 600
 
 	// Public endpoints
 	lbankTicker         = "ticker.do"
@@ -69,9 +70,27 @@ func (l *Lbank) SetDefaults() {
 	l.RESTPollingDelay = 10
 	l.RequestCurrencyPairFormat.Delimiter = "_"
 	l.ConfigCurrencyPairFormat.Delimiter = "_"
-	l.AssetTypes = []string{ticker.Spot}
-	l.SupportsAutoPairUpdating = true
-	l.APIWithdrawPermissions = exchange.AutoWithdrawCryptoWithAPIPermission | exchange.NoFiatWithdrawals
+	l.AssetTypes = asset.Items{asset.Spot}
+	l.Features = exchange.Features{
+		REST: exchange.ProtocolFeatures{
+			TickerFetching:    true,
+			OrderbookFetching: true,
+			TradeFetching:     true,
+			HistoricTrades:    true,
+			KlineFetching:     true,
+			AutoPairUpdates:   true,
+			AccountInfo:       true,
+			GetOrder:          true,
+			ActiveOrders:      true,
+			OrderHistory:      true,
+			SubmitOrder:       true,
+			CancelOrder:       true,
+			CancelOrders:      true,
+			CryptoWithdrawal:  true,
+		},
+		OrderTypes: []exchange.OrderType{exchange.LimitOrderType},
+	}
+	l.APIWithdrawPermissions = exchange.AutoWithdrawCryptoWithAPIPermission
 	l.Requester = request.New(l.Name,
 		request.NewRateLimit(time.Second, lbankAuthRateLimit),
 		request.NewRateLimit(time.Second, lbankUnAuthRateLimit),
@@ -92,6 +111,7 @@ func (l *Lbank) Setup(exch *config.ExchangeConfig) {
 		l.SetAPIKeys(exch.APIKey, exch.APISecret, "", false)
 		l.SetHTTPClientTimeout(exch.HTTPTimeout)
 		l.SetHTTPClientUserAgent(exch.HTTPUserAgent)
+		l.SetHTTPRetryPolicy(exch.HTTPRetry)
 		l.RESTPollingDelay = exch.RESTPollingDelay
 		l.Verbose = exch.Verbose
 		l.Websocket.SetWsStatusAndConnection(exch.Websocket)
@@ -10,6 +10,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 )
 
 // Please supply your own keys here for due diligence testing
@@ -103,7 +104,7 @@ func TestUpdateOrderbook(t *testing.T) {
 		Base:      currency.ETH,
 		Quote:     currency.BTC}
 
-	_, err := l.UpdateOrderbook(p.Lower(), "spot")
+	_, err := l.UpdateOrderbook(p.Lower(), asset.Spot)
 	if err != nil {
 		t.Errorf("Update for orderbook failed: %v", err)
 	}
@@ -295,7 +296,7 @@ func TestSubmitOrder(t *testing.T) {
 		t.Skip("API keys required but not set, skipping test")
 	}
 	cp := currency.NewPairWithDelimiter(currency.BTC.String(), currency.USDT.String(), "_")
-	_, err := l.SubmitOrder(cp.Lower(), "BUY", "ANY", 2, 1312, "")
+	_, err := l.SubmitOrder(cp.Lower(), asset.Spot, "BUY", "ANY", 2, 1312, "")
 	if err != nil {
 		t.Error(err)
 	}
@@ -1,6 +1,7 @@
 package lbank
 
 import (
+	"context"
 	"fmt"
 	"strconv"
 	"strings"
@@ -10,6 +11,8 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/websocket/wshandler"
@@ -25,6 +28,14 @@ func (l *Lbank) Start(wg *sync.WaitGroup) {
 	}()
 }
 
+// WithContext returns a copy of Lbank whose HTTP requests are abandoned once
+// the context is done
+func (l *Lbank) WithContext(ctx context.Context) exchange.IBotExchange {
+	cp := *l
+	cp.Base = l.ContextBase(ctx)
+	return &cp
+}
+
 // Run implements the Lbank wrapper
 func (l *Lbank) Run() {
 	if l.Verbose {
@@ -49,9 +60,9 @@ func (l *Lbank) Run() {
 }
 
 // UpdateTicker updates and returns the ticker for a currency pair
-func (l *Lbank) UpdateTicker(p currency.Pair, assetType string) (ticker.Price, error) {
+func (l *Lbank) UpdateTicker(p currency.Pair, assetType asset.Item) (ticker.Price, error) {
 	var tickerPrice ticker.Price
-	tickerInfo, err := l.GetTicker(exchange.FormatExchangeCurrency(l.Name, p).String())
+	tickerInfo, err := l.GetTicker(l.FormatExchangeCurrency(p).String())
 	if err != nil {
 		return tickerPrice, err
 	}
@@ -70,8 +81,8 @@ func (l *Lbank) UpdateTicker(p currency.Pair, assetType string) (ticker.Price, e
 }
 
 // GetTickerPrice returns the ticker for a currency pair
-func (l *Lbank) GetTickerPrice(p currency.Pair, assetType string) (ticker.Price, error) {
-	tickerNew, err := ticker.GetTicker(l.Name, exchange.FormatExchangeCurrency(l.Name, p), assetType)
+func (l *Lbank) GetTickerPrice(p currency.Pair, assetType asset.Item) (ticker.Price, error) {
+	tickerNew, err := ticker.GetTicker(l.Name, l.FormatExchangeCurrency(p), assetType)
 	if err != nil {
 		return l.UpdateTicker(p, assetType)
 	}
@@ -79,7 +90,7 @@ func (l *Lbank) GetTickerPrice(p currency.Pair, assetType string) (ticker.Price,
 }
 
 // GetOrderbookEx returns orderbook base on the currency pair
-func (l *Lbank) GetOrderbookEx(currency currency.Pair, assetType string) (orderbook.Base, error) {
+func (l *Lbank) GetOrderbookEx(currency currency.Pair, assetType asset.Item) (orderbook.Base, error) {
 	ob, err := orderbook.Get(l.Name, currency, assetType)
 	if err != nil {
 		return l.UpdateOrderbook(currency, assetType)
@@ -88,9 +99,9 @@ func (l *Lbank) GetOrderbookEx(currency currency.Pair, assetType string) (orderb
 }
 
 // UpdateOrderbook updates and returns the orderbook for a currency pair
-func (l *Lbank) UpdateOrderbook(p currency.Pair, assetType string) (orderbook.Base, error) {
+func (l *Lbank) UpdateOrderbook(p currency.Pair, assetType asset.Item) (orderbook.Base, error) {
 	var orderBook orderbook.Base
-	a, err := l.GetMarketDepths(exchange.FormatExchangeCurrency(l.Name, p).String(), "60", "1")
+	a, err := l.GetMarketDepths(l.FormatExchangeCurrency(p).String(), "60", "1")
 	if err != nil {
 		return orderBook, err
 	}
@@ -155,18 +166,91 @@ func (l *Lbank) GetFundingHistory() ([]exchange.FundHistory, error) {
 	return nil, common.ErrFunctionNotSupported
 }
 
-// GetExchangeHistory returns historic trade data since exchange opening.
-func (l *Lbank) GetExchangeHistory(p currency.Pair, assetType string) ([]exchange.TradeHistory, error) {
-	return nil, common.ErrFunctionNotSupported
+// GetExchangeHistory returns the most recent trades for a currency pair
+func (l *Lbank) GetExchangeHistory(p currency.Pair, assetType asset.Item) ([]exchange.TradeHistory, error) {
+	trades, err := l.GetTrades(l.FormatExchangeCurrency(p).String(), strconv.Itoa(lbankTradesLimit), "0")
+	if err != nil {
+		return nil, err
+	}
+	return l.convertTrades(trades), nil
+}
+
+// GetHistoricTrades returns the trades for a currency pair between the start
+// and end times, paging forward from the start time
+func (l *Lbank) GetHistoricTrades(p currency.Pair, assetType asset.Item, timestampStart, timestampEnd time.Time) ([]exchange.TradeHistory, error) {
+	err := exchange.CheckTimeRange(timestampStart, timestampEnd)
+	if err != nil {
+		return nil, err
+	}
+
+	symbol := l.FormatExchangeCurrency(p).String()
+	since := timestampStart.Unix()
+	var resp []exchange.TradeHistory
+	for {
+		trades, err := l.GetTrades(symbol, strconv.Itoa(lbankTradesLimit),
+			strconv.FormatInt(since, 10))
+		if err != nil {
+			return nil, err
+		}
+
+		var newest int64
+		for i := range trades {
+			if trades[i].DateMS > newest {
+				newest = trades[i].DateMS
+			}
+		}
+		resp = append(resp, l.convertTrades(trades)...)
+
+		if len(trades) < lbankTradesLimit || newest > common.UnixMillis(timestampEnd) {
+			break
+		}
+
+		// Trades can only be requested from the start of a second
+		next := newest / 1000
+		if next <= since {
+			next = since + 1
+		}
+		since = next
+	}
+
+	exchange.FilterTradesByTimeRange(&resp, timestampStart, timestampEnd)
+	return resp, nil
+}
+
+// GetHistoricCandles returns candles between the start and end times, built
+// from historic trades
+func (l *Lbank) GetHistoricCandles(p currency.Pair, assetType asset.Item, timestampStart, timestampEnd time.Time, interval kline.Interval) (kline.Item, error) {
+	return exchange.CreateKlineFromTrades(l, p, assetType, timestampStart,
+		timestampEnd, interval)
+}
+
+func (l *Lbank) convertTrades(trades []TradeResponse) []exchange.TradeHistory {
+	resp := make([]exchange.TradeHistory, len(trades))
+	for i := range trades {
+		side := exchange.BuyOrderSide
+		if trades[i].Type == "sell" {
+			side = exchange.SellOrderSide
+		}
+
+		resp[i] = exchange.TradeHistory{
+			Timestamp: time.Unix(0, trades[i].DateMS*int64(time.Millisecond)),
+			ID:        trades[i].TID,
+			Price:     trades[i].Price,
+			Amount:    trades[i].Amount,
+			Exchange:  l.Name,
+			Side:      side,
+		}
+	}
+	return resp
 }
 
 // SubmitOrder submits a new order
-func (l *Lbank) SubmitOrder(p currency.Pair, side exchange.OrderSide, _ exchange.OrderType, amount, price float64, clientID string) (exchange.SubmitOrderResponse, error) {
+func (l *Lbank) SubmitOrder(p currency.Pair, _ asset.Item, side exchange.OrderSide, _ exchange.OrderType, amount, price float64, clientID string) (exchange.SubmitOrderResponse, error) {
 	var resp exchange.SubmitOrderResponse
 	if side != exchange.BuyOrderSide && side != exchange.SellOrderSide {
 		return resp, fmt.Errorf("%s orderside is not supported by the exchange", side)
 	}
-	tempResp, err := l.CreateOrder(exchange.FormatExchangeCurrency(l.Name, p).String(), side.ToString(), amount, price)
+	tempResp, err := l.CreateOrder(l.FormatExchangeCurrency(p).String(), side.ToString(), amount, price)
 	if err != nil {
 		return resp, err
 	}
@@ -183,7 +267,7 @@ func (l *Lbank) ModifyOrder(action *exchange.ModifyOrder) (string, error) {
 
 // CancelOrder cancels an order by its corresponding ID number
 func (l *Lbank) CancelOrder(order *exchange.OrderCancellation) error {
-	_, err := l.RemoveOrder(exchange.FormatExchangeCurrency(l.Name, order.CurrencyPair).String(), order.OrderID)
+	_, err := l.RemoveOrder(l.FormatExchangeCurrency(order.CurrencyPair).String(), order.OrderID)
 	return err
 }
 
@@ -408,14 +492,14 @@ func (l *Lbank) GetOrderHistory(getOrdersRequest *exchange.GetOrdersRequest) ([]
 		tempCurr = getOrdersRequest.Currencies
 	}
 	for a := range tempCurr {
-		p := exchange.FormatExchangeCurrency(l.Name, tempCurr[a])
+		p := l.FormatExchangeCurrency(tempCurr[a])
 		b := int64(1)
-		tempResp, err := l.QueryOrderHistory(exchange.FormatExchangeCurrency(l.Name, p).String(), strconv.FormatInt(b, 10), "200")
+		tempResp, err := l.QueryOrderHistory(l.FormatExchangeCurrency(p).String(), strconv.FormatInt(b, 10), "200")
 		if err != nil {
 			return finalResp, err
 		}
 		for len(tempResp.Orders) != 0 {
-			tempResp, err = l.QueryOrderHistory(exchange.FormatExchangeCurrency(l.Name, p).String(), strconv.FormatInt(b, 10), "200")
+			tempResp, err = l.QueryOrderHistory(l.FormatExchangeCurrency(p).String(), strconv.FormatInt(b, 10), "200")
 			if err != nil {
 				return finalResp, err
 			}
@@ -493,15 +577,15 @@ func (l *Lbank) getAllOpenOrderID() (map[string][]string, error) {
 	allPairs := l.GetEnabledCurrencies()
 	resp := make(map[string][]string)
 	for a := range allPairs {
-		p := exchange.FormatExchangeCurrency(l.Name, allPairs[a])
+		p := l.FormatExchangeCurrency(allPairs[a])
 		b := int64(1)
-		tempResp, err := l.GetOpenOrders(exchange.FormatExchangeCurrency(l.Name, p).String(), strconv.FormatInt(b, 10), "200")
+		tempResp, err := l.GetOpenOrders(l.FormatExchangeCurrency(p).String(), strconv.FormatInt(b, 10), "200")
 		if err != nil {
 			return resp, err
 		}
 		tempData := len(tempResp.Orders)
 		for tempData != 0 {
-			tempResp, err = l.GetOpenOrders(exchange.FormatExchangeCurrency(l.Name, p).String(), strconv.FormatInt(b, 10), "200")
+			tempResp, err = l.GetOpenOrders(l.FormatExchangeCurrency(p).String(), strconv.FormatInt(b, 10), "200")
 			if err != nil {
 				return resp, err
 			}
@@ -511,7 +595,7 @@ func (l *Lbank) getAllOpenOrderID() (map[string][]string, error) {
 			}
 
 			for c := 0; c < tempData; c++ {
-				resp[exchange.FormatExchangeCurrency(l.Name, p).String()] = append(resp[exchange.FormatExchangeCurrency(l.Name, p).String()], tempResp.Orders[c].OrderID)
+				resp[l.FormatExchangeCurrency(p).String()] = append(resp[l.FormatExchangeCurrency(p).String()], tempResp.Orders[c].OrderID)
 			}
 			tempData = len(tempResp.Orders)
 			b++
@@ -122,14 +122,29 @@ func (l *LocalBitcoins) SetDefaults() {
 	l.Verbose = false
 	l.Verbose = false
 	l.RESTPollingDelay = 10
-	l.APIWithdrawPermissions = exchange.AutoWithdrawCrypto |
-		exchange.WithdrawFiatViaWebsiteOnly
+	l.APIWithdrawPermissions = exchange.WithdrawFiatViaWebsiteOnly
 	l.RequestCurrencyPairFormat.Delimiter = ""
 	l.RequestCurrencyPairFormat.Uppercase = true
 	l.ConfigCurrencyPairFormat.Delimiter = ""
 	l.ConfigCurrencyPairFormat.Uppercase = true
-	l.SupportsAutoPairUpdating = true
-	l.SupportsRESTTickerBatching = true
+	l.Features = exchange.Features{
+		REST: exchange.ProtocolFeatures{
+			TickerFetching:    true,
+			TickerBatching:    true,
+			OrderbookFetching: true,
+			TradeFetching:     true,
+			AutoPairUpdates:   true,
+			AccountInfo:       true,
+			ActiveOrders:      true,
+			OrderHistory:      true,
+			SubmitOrder:       true,
+			CancelOrder:       true,
+			CancelOrders:      true,
+			DepositAddress:    true,
+			CryptoWithdrawal:  true,
+		},
+		OrderTypes: []exchange.OrderType{exchange.MarketOrderType},
+	}
 	l.Requester = request.New(l.Name,
 		request.NewRateLimit(time.Millisecond*500, localbitcoinsAuthRate),
 		request.NewRateLimit(time.Millisecond*500, localbitcoinsUnauthRate),
@@ -149,6 +164,7 @@ func (l *LocalBitcoins) Setup(exch *config.ExchangeConfig) {
 		l.SetAPIKeys(exch.APIKey, exch.APISecret, "", false)
 		l.SetHTTPClientTimeout(exch.HTTPTimeout)
 		l.SetHTTPClientUserAgent(exch.HTTPUserAgent)
+		l.SetHTTPRetryPolicy(exch.HTTPRetry)
 		l.RESTPollingDelay = exch.RESTPollingDelay
 		l.Verbose = exch.Verbose
 		l.HTTPDebugging = exch.HTTPDebugging
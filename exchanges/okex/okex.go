@@ -1,16 +1,18 @@
 package okex
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"strconv"
 	"time"
 
 	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/config"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/okgroup"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
-	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/websocket/wshandler"
 )
 
@@ -59,37 +61,78 @@ func (o *OKEX) SetDefaults() {
 	o.Enabled = false
 	o.Verbose = false
 	o.RESTPollingDelay = 10
-	o.APIWithdrawPermissions = exchange.AutoWithdrawCrypto |
-		exchange.NoFiatWithdrawals
 	o.RequestCurrencyPairFormat.Delimiter = "_"
 	o.RequestCurrencyPairFormat.Uppercase = false
 	o.ConfigCurrencyPairFormat.Delimiter = "_"
 	o.ConfigCurrencyPairFormat.Uppercase = true
-	o.SupportsAutoPairUpdating = true
-	o.SupportsRESTTickerBatching = false
+	o.Features = exchange.Features{
+		REST: exchange.ProtocolFeatures{
+			TickerFetching:    true,
+			OrderbookFetching: true,
+			AutoPairUpdates:   true,
+			AccountInfo:       true,
+			GetOrder:          true,
+			ActiveOrders:      true,
+			OrderHistory:      true,
+			SubmitOrder:       true,
+			CancelOrder:       true,
+			CancelOrders:      true,
+			FundingHistory:    true,
+			DepositAddress:    true,
+			CryptoWithdrawal:  true,
+		},
+		Websocket: exchange.ProtocolFeatures{
+			TickerFetching:         true,
+			OrderbookFetching:      true,
+			TradeFetching:          true,
+			KlineFetching:          true,
+			Subscribe:              true,
+			Unsubscribe:            true,
+			AuthenticatedEndpoints: true,
+			MessageCorrelation:     true,
+		},
+		OrderTypes: []exchange.OrderType{exchange.LimitOrderType},
+	}
 	o.Requester = request.New(o.Name,
 		request.NewRateLimit(time.Second, okExAuthRate),
 		request.NewRateLimit(time.Second, okExUnauthRate),
 		common.NewHTTPClientWithTimeout(exchange.DefaultHTTPTimeout))
 	o.APIUrlDefault = okExAPIURL
 	o.APIUrl = okExAPIURL
-	o.AssetTypes = []string{ticker.Spot}
+	o.AssetTypes = asset.Items{asset.Spot, asset.Futures, asset.PerpetualSwap}
+	// Futures and perpetual swap instruments carry their contract in the
+	// quote e.g. BTC-USD-191227 and BTC-USD-SWAP
+	contractFormat := config.CurrencyPairFormatConfig{
+		Delimiter: "-",
+		Uppercase: true,
+	}
+	o.AssetPairs = map[asset.Item]*exchange.AssetPairs{
+		asset.Futures: {
+			RequestFormat: contractFormat,
+			ConfigFormat:  contractFormat,
+		},
+		asset.PerpetualSwap: {
+			RequestFormat: contractFormat,
+			ConfigFormat:  contractFormat,
+		},
+	}
 	o.Websocket = wshandler.New()
 	o.APIVersion = okExAPIVersion
 	o.WebsocketURL = OkExWebsocketURL
-	o.Websocket.Functionality = wshandler.WebsocketTickerSupported |
-		wshandler.WebsocketTradeDataSupported |
-		wshandler.WebsocketKlineSupported |
-		wshandler.WebsocketOrderbookSupported |
-		wshandler.WebsocketSubscribeSupported |
-		wshandler.WebsocketUnsubscribeSupported |
-		wshandler.WebsocketAuthenticatedEndpointsSupported |
-		wshandler.WebsocketMessageCorrelationSupported
+	o.Websocket.Functionality = o.Features.Websocket.Functionality()
 	o.WebsocketResponseMaxLimit = exchange.DefaultWebsocketResponseMaxLimit
 	o.WebsocketResponseCheckTimeout = exchange.DefaultWebsocketResponseCheckTimeout
 	o.WebsocketOrderbookBufferLimit = exchange.DefaultWebsocketOrderbookBufferLimit
 }
 
+// WithContext returns a copy of OKEX whose HTTP requests are abandoned once
+// the context is done
+func (o *OKEX) WithContext(ctx context.Context) exchange.IBotExchange {
+	cp := *o
+	cp.Base = o.ContextBase(ctx)
+	return &cp
+}
+
 // GetFuturesPostions Get the information of all holding positions in futures trading.
 // Due to high energy consumption, you are advised to capture data with the "Futures Account of a Currency" API instead.
 func (o *OKEX) GetFuturesPostions() (resp okgroup.GetFuturesPositionsResponse, _ error) {
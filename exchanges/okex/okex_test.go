@@ -13,8 +13,8 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/okgroup"
-	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/sharedtestvalues"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/websocket/wshandler"
 )
@@ -1615,7 +1615,7 @@ func TestSendWsMessages(t *testing.T) {
 func TestGetAssetTypeFromTableName(t *testing.T) {
 	str := "spot/candle300s:BTC-USDT"
 	spot := o.GetAssetTypeFromTableName(str)
-	if spot != orderbook.Spot {
+	if spot != asset.Spot {
 		t.Errorf("Error, expected 'SPOT', received: '%v'", spot)
 	}
 }
@@ -1824,7 +1824,7 @@ func TestSubmitOrder(t *testing.T) {
 		Base:      currency.BTC,
 		Quote:     currency.USDT,
 	}
-	response, err := o.SubmitOrder(p, exchange.BuyOrderSide, exchange.MarketOrderType, 1, 10, "hi")
+	response, err := o.SubmitOrder(p, asset.Spot, exchange.BuyOrderSide, exchange.MarketOrderType, 1, 10, "hi")
 	if areTestAPIKeysSet() && (err != nil || !response.IsOrderPlaced) {
 		t.Errorf("Order failed to be placed: %v", err)
 	} else if !areTestAPIKeysSet() && err == nil {
@@ -1923,3 +1923,48 @@ func TestWithdrawInternationalBank(t *testing.T) {
 		t.Errorf("Expected '%v', received: '%v'", common.ErrFunctionNotSupported, err)
 	}
 }
+
+func TestUnsupportedAssetType(t *testing.T) {
+	TestSetDefaults(t)
+	p := currency.NewPairDelimiter("BTC-USD-SWAP", "-")
+	_, err := o.UpdateTicker(p, asset.Margin)
+	if err != asset.ErrNotSupported {
+		t.Errorf("Test failed - UpdateTicker() expected %s got %v",
+			asset.ErrNotSupported, err)
+	}
+
+	_, err = o.UpdateOrderbook(p, asset.Index)
+	if err != asset.ErrNotSupported {
+		t.Errorf("Test failed - UpdateOrderbook() expected %s got %v",
+			asset.ErrNotSupported, err)
+	}
+
+	_, err = o.OKGroup.UpdateTicker(p, asset.PerpetualSwap)
+	if err != asset.ErrNotSupported {
+		t.Errorf("Test failed - OKGroup UpdateTicker() expected %s got %v",
+			asset.ErrNotSupported, err)
+	}
+
+	f, err := o.FormatExchangePair(p, asset.PerpetualSwap)
+	if err != nil || f.String() != "BTC-USD-SWAP" {
+		t.Errorf("Test failed - FormatExchangePair() unexpected %s %v", f, err)
+	}
+}
+
+func TestSwapOrderbookItems(t *testing.T) {
+	items, err := swapOrderbookItems([][]interface{}{
+		{"411.3", "16", 5.0, 4.0},
+		{"411.2", "2", 0.0, 1.0},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(items) != 2 || items[0].Price != 411.3 || items[1].Amount != 2 {
+		t.Errorf("Test failed - swapOrderbookItems() unexpected %+v", items)
+	}
+
+	_, err = swapOrderbookItems([][]interface{}{{411.3, "16"}})
+	if err == nil {
+		t.Error("Test failed - swapOrderbookItems() expected a price error")
+	}
+}
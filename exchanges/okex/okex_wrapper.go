@@ -0,0 +1,261 @@
+package okex
+
+import (
+	"fmt"
+	"strconv"
+	"sync"
+
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/okgroup"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+	log "github.com/thrasher-corp/gocryptotrader/logger"
+)
+
+// Note: OKEX routes the futures and perpetual swap asset types to their own
+// endpoints, spot is handled by the shared OKGroup wrapper funcs
+
+// Setup takes in the supplied exchange configuration details and sets params,
+// websocket orderbooks of every asset type are resynced through OKEX
+func (o *OKEX) Setup(exch *config.ExchangeConfig) {
+	o.OKGroup.Setup(exch)
+	if exch.Enabled {
+		o.Websocket.Orderbook.SetupVerification(o.WsOrderbookChecksum,
+			o.UpdateOrderbook)
+	}
+}
+
+// Start starts the OKEX go routine
+func (o *OKEX) Start(wg *sync.WaitGroup) {
+	wg.Add(1)
+	go func() {
+		o.Run()
+		wg.Done()
+	}()
+}
+
+// Run implements the OKEX wrapper, updating the spot instruments and those of
+// the enabled futures and perpetual swap asset types
+func (o *OKEX) Run() {
+	o.OKGroup.Run()
+
+	for _, a := range []asset.Item{asset.Futures, asset.PerpetualSwap} {
+		if !o.SupportsAsset(a) {
+			continue
+		}
+
+		pairs, err := o.fetchContracts(a)
+		if err != nil {
+			log.Errorf("%v failed to obtain available %s instruments. Err: %s\n", o.Name, a, err)
+			continue
+		}
+
+		err = o.UpdateAssetPairs(a, pairs, false, false)
+		if err != nil {
+			log.Errorf("%v failed to update available %s currencies. Err: %s\n", o.Name, a, err)
+		}
+	}
+}
+
+// fetchContracts returns the instruments of the futures or perpetual swap
+// asset type
+func (o *OKEX) fetchContracts(assetType asset.Item) (currency.Pairs, error) {
+	var pairs currency.Pairs
+	switch assetType {
+	case asset.Futures:
+		contracts, err := o.GetFuturesContractInformation()
+		if err != nil {
+			return nil, err
+		}
+		for x := range contracts {
+			pairs = append(pairs, currency.NewPairDelimiter(contracts[x].InstrumentID, "-"))
+		}
+	case asset.PerpetualSwap:
+		contracts, err := o.GetSwapContractInformation()
+		if err != nil {
+			return nil, err
+		}
+		for x := range contracts {
+			pairs = append(pairs, currency.NewPairDelimiter(contracts[x].InstrumentID, "-"))
+		}
+	default:
+		return nil, asset.ErrNotSupported
+	}
+	return pairs, nil
+}
+
+// UpdateTicker updates and returns the ticker for a currency pair
+func (o *OKEX) UpdateTicker(p currency.Pair, assetType asset.Item) (ticker.Price, error) {
+	var tickerData ticker.Price
+	switch assetType {
+	case asset.Spot:
+		return o.OKGroup.UpdateTicker(p, assetType)
+	case asset.Futures:
+		instrument, err := o.FormatExchangePair(p, assetType)
+		if err != nil {
+			return tickerData, err
+		}
+
+		resp, err := o.GetFuturesTokenInfoForCurrency(instrument.String())
+		if err != nil {
+			return tickerData, err
+		}
+
+		tickerData = ticker.Price{
+			Ask:         resp.BestAsk,
+			Bid:         resp.BestBid,
+			High:        resp.High24h,
+			Last:        resp.Last,
+			LastUpdated: resp.Timestamp,
+			Low:         resp.Low24h,
+			Pair:        p,
+			Volume:      float64(resp.Volume24h),
+		}
+	case asset.PerpetualSwap:
+		instrument, err := o.FormatExchangePair(p, assetType)
+		if err != nil {
+			return tickerData, err
+		}
+
+		resp, err := o.GetSwapTokensInformationForCurrency(instrument.String())
+		if err != nil {
+			return tickerData, err
+		}
+
+		tickerData = ticker.Price{
+			Ask:         resp.BestAsk,
+			Bid:         resp.BestBid,
+			High:        resp.High24H,
+			Last:        resp.Last,
+			LastUpdated: resp.Timestamp,
+			Low:         resp.Low24H,
+			Pair:        p,
+			Volume:      resp.Volume24H,
+		}
+	default:
+		return tickerData, asset.ErrNotSupported
+	}
+
+	return tickerData, ticker.ProcessTicker(o.Name, &tickerData, assetType)
+}
+
+// GetTickerPrice returns the ticker for a currency pair
+func (o *OKEX) GetTickerPrice(p currency.Pair, assetType asset.Item) (ticker.Price, error) {
+	tickerData, err := ticker.GetTicker(o.GetName(), p, assetType)
+	if err != nil {
+		return o.UpdateTicker(p, assetType)
+	}
+	return tickerData, nil
+}
+
+// GetOrderbookEx returns orderbook base on the currency pair
+func (o *OKEX) GetOrderbookEx(p currency.Pair, assetType asset.Item) (orderbook.Base, error) {
+	ob, err := orderbook.Get(o.GetName(), p, assetType)
+	if err != nil {
+		return o.UpdateOrderbook(p, assetType)
+	}
+	return ob, nil
+}
+
+// UpdateOrderbook updates and returns the orderbook for a currency pair
+func (o *OKEX) UpdateOrderbook(p currency.Pair, assetType asset.Item) (orderbook.Base, error) {
+	var resp orderbook.Base
+	switch assetType {
+	case asset.Spot:
+		return o.OKGroup.UpdateOrderbook(p, assetType)
+	case asset.Futures:
+		instrument, err := o.FormatExchangePair(p, assetType)
+		if err != nil {
+			return resp, err
+		}
+
+		ob, err := o.GetFuturesOrderBook(okgroup.GetFuturesOrderBookRequest{
+			InstrumentID: instrument.String(),
+		})
+		if err != nil {
+			return resp, err
+		}
+
+		for x := range ob.Bids {
+			resp.Bids = append(resp.Bids, orderbook.Item{
+				Amount: float64(ob.Bids[x].Size),
+				Price:  ob.Bids[x].Price,
+			})
+		}
+		for x := range ob.Asks {
+			resp.Asks = append(resp.Asks, orderbook.Item{
+				Amount: float64(ob.Asks[x].Size),
+				Price:  ob.Asks[x].Price,
+			})
+		}
+	case asset.PerpetualSwap:
+		instrument, err := o.FormatExchangePair(p, assetType)
+		if err != nil {
+			return resp, err
+		}
+
+		ob, err := o.GetSwapOrderBook(okgroup.GetSwapOrderBookRequest{
+			InstrumentID: instrument.String(),
+		})
+		if err != nil {
+			return resp, err
+		}
+
+		resp.Bids, err = swapOrderbookItems(ob.Bids)
+		if err != nil {
+			return resp, err
+		}
+		resp.Asks, err = swapOrderbookItems(ob.Asks)
+		if err != nil {
+			return resp, err
+		}
+	default:
+		return resp, asset.ErrNotSupported
+	}
+
+	resp.Pair = p
+	resp.AssetType = assetType
+	resp.ExchangeName = o.Name
+
+	err := resp.Process()
+	if err != nil {
+		return resp, err
+	}
+
+	return orderbook.Get(o.Name, p, assetType)
+}
+
+// swapOrderbookItems converts the price and size of perpetual swap orderbook
+// levels
+func swapOrderbookItems(levels [][]interface{}) ([]orderbook.Item, error) {
+	items := make([]orderbook.Item, 0, len(levels))
+	for x := range levels {
+		if len(levels[x]) < 2 {
+			return nil, fmt.Errorf("unexpected orderbook level %v", levels[x])
+		}
+
+		price, ok := levels[x][0].(string)
+		if !ok {
+			return nil, fmt.Errorf("unexpected orderbook price %v", levels[x][0])
+		}
+		size, ok := levels[x][1].(string)
+		if !ok {
+			return nil, fmt.Errorf("unexpected orderbook size %v", levels[x][1])
+		}
+
+		var item orderbook.Item
+		var err error
+		item.Price, err = strconv.ParseFloat(price, 64)
+		if err != nil {
+			return nil, err
+		}
+		item.Amount, err = strconv.ParseFloat(size, 64)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+	return items, nil
+}
@@ -112,6 +112,7 @@ func (o *OKGroup) Setup(exch *config.ExchangeConfig) {
 		o.SetAPIKeys(exch.APIKey, exch.APISecret, exch.ClientID, false)
 		o.SetHTTPClientTimeout(exch.HTTPTimeout)
 		o.SetHTTPClientUserAgent(exch.HTTPUserAgent)
+		o.SetHTTPRetryPolicy(exch.HTTPRetry)
 		o.RESTPollingDelay = exch.RESTPollingDelay
 		o.Verbose = exch.Verbose
 		o.HTTPDebugging = exch.HTTPDebugging
@@ -167,6 +168,8 @@ func (o *OKGroup) Setup(exch *config.ExchangeConfig) {
 			false,
 			false,
 			exch.Name)
+		o.Websocket.Orderbook.SetupVerification(o.WsOrderbookChecksum,
+			o.UpdateOrderbook)
 	}
 }
 
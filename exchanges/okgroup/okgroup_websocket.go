@@ -14,6 +14,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/websocket/wshandler"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/websocket/wsorderbook"
@@ -304,10 +305,14 @@ func (o *OKGroup) GetWsChannelWithoutOrderType(table string) string {
 }
 
 // GetAssetTypeFromTableName gets the asset type from the table name
-// eg "spot/ticker:BTCUSD" results in "SPOT"
-func (o *OKGroup) GetAssetTypeFromTableName(table string) string {
+// eg "spot/ticker:BTCUSD" results in "SPOT" and "swap/ticker:BTC-USD-SWAP"
+// results in "PERPETUALSWAP"
+func (o *OKGroup) GetAssetTypeFromTableName(table string) asset.Item {
 	assetIndex := strings.Index(table, "/")
-	return strings.ToUpper(table[:assetIndex])
+	if strings.EqualFold(table[:assetIndex], "swap") {
+		return asset.PerpetualSwap
+	}
+	return asset.Item(strings.ToUpper(table[:assetIndex]))
 }
 
 // WsHandleDataResponse classifies the WS response and sends to appropriate handler
@@ -362,6 +367,8 @@ func (o *OKGroup) wsProcessTickers(response *WebsocketDataResponse) {
 			HighPrice:  response.Data[i].High24H,
 			LowPrice:   response.Data[i].Low24H,
 			ClosePrice: response.Data[i].Last,
+			BidPrice:   response.Data[i].BestBid,
+			AskPrice:   response.Data[i].BestAsk,
 			Pair:       instrument,
 		}
 	}
@@ -477,39 +484,40 @@ func (o *OKGroup) WsProcessPartialOrderBook(wsEventData *WebsocketDataWrapper, i
 }
 
 // WsProcessUpdateOrderbook updates an existing orderbook using websocket data
-// After merging WS data, it will sort, validate and finally update the existing orderbook
+// After merging WS data, the orderbook checksum is verified before the
+// existing orderbook is updated, a mismatch resyncs it from a REST snapshot
 func (o *OKGroup) WsProcessUpdateOrderbook(wsEventData *WebsocketDataWrapper, instrument currency.Pair, tableName string) error {
 	update := wsorderbook.WebsocketOrderbookUpdate{
-		AssetType:    orderbook.Spot,
+		AssetType:    o.GetAssetTypeFromTableName(tableName),
 		CurrencyPair: instrument,
 		UpdateTime:   wsEventData.Timestamp,
+		Checksum:     uint32(wsEventData.Checksum),
+		HasChecksum:  true,
 	}
 	update.Asks = o.AppendWsOrderbookItems(wsEventData.Asks)
 	update.Bids = o.AppendWsOrderbookItems(wsEventData.Bids)
 	err := o.Websocket.Orderbook.Update(&update)
 	if err != nil {
-		log.Error(err)
+		return fmt.Errorf("channel: %v. Orderbook update for %v: %s", tableName, instrument, err)
 	}
-	updatedOb := o.Websocket.Orderbook.GetOrderbook(instrument, orderbook.Spot)
-	checksum := o.CalculateUpdateOrderbookChecksum(updatedOb)
-	if checksum == wsEventData.Checksum {
-		if o.Verbose {
-			log.Debug("Orderbook valid")
-		}
-		o.Websocket.DataHandler <- wshandler.WebsocketOrderbookUpdate{
-			Exchange: o.GetName(),
-			Asset:    o.GetAssetTypeFromTableName(tableName),
-			Pair:     instrument,
-		}
-	} else {
-		if o.Verbose {
-			log.Debug("Orderbook invalid")
-		}
-		return fmt.Errorf("channel: %v. Orderbook update for %v checksum invalid. Received %v Calculated %v", tableName, instrument, wsEventData.Checksum, checksum)
+	o.Websocket.DataHandler <- wshandler.WebsocketOrderbookUpdate{
+		Exchange: o.GetName(),
+		Asset:    o.GetAssetTypeFromTableName(tableName),
+		Pair:     instrument,
 	}
 	return nil
 }
 
+// WsOrderbookChecksum returns the checksum of a merged orderbook in the form
+// the exchange publishes it, only the top 25 levels are copied
+func (o *OKGroup) WsOrderbookChecksum(ob *orderbook.Depth) uint32 {
+	bids, asks := ob.Levels(25)
+	return uint32(o.CalculateUpdateOrderbookChecksum(&orderbook.Base{
+		Bids: bids,
+		Asks: asks,
+	}))
+}
+
 // CalculatePartialOrderbookChecksum alternates over the first 25 bid and ask entries from websocket data
 // The checksum is made up of the price and the quantity with a semicolon (:) deliminating them
 // This will also work when there are less than 25 entries (for whatever reason)
@@ -5,19 +5,22 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/websocket/wshandler"
 	log "github.com/thrasher-corp/gocryptotrader/logger"
 )
 
-// Note: GoCryptoTrader wrapper funcs currently only support SPOT trades.
-// Therefore this OKGroup_Wrapper can be shared between OKEX and OKCoin.
-// When circumstances change, wrapper funcs can be split appropriately
+// Note: The OKGroup wrapper funcs only support SPOT and are shared between
+// OKEX and OKCoin. OKEX routes its futures and perpetual swap asset types
+// itself, other asset types return asset.ErrNotSupported
 
 // Start starts the OKGroup go routine
 func (o *OKGroup) Start(wg *sync.WaitGroup) {
@@ -55,8 +58,12 @@ func (o *OKGroup) Run() {
 }
 
 // UpdateTicker updates and returns the ticker for a currency pair
-func (o *OKGroup) UpdateTicker(p currency.Pair, assetType string) (tickerData ticker.Price, err error) {
-	resp, err := o.GetSpotAllTokenPairsInformationForCurrency(exchange.FormatExchangeCurrency(o.Name, p).String())
+func (o *OKGroup) UpdateTicker(p currency.Pair, assetType asset.Item) (tickerData ticker.Price, err error) {
+	if assetType != asset.Spot {
+		return tickerData, asset.ErrNotSupported
+	}
+
+	resp, err := o.GetSpotAllTokenPairsInformationForCurrency(o.FormatExchangeCurrency(p).String())
 	if err != nil {
 		return
 	}
@@ -67,7 +74,7 @@ func (o *OKGroup) UpdateTicker(p currency.Pair, assetType string) (tickerData ti
 		Last:        resp.Last,
 		LastUpdated: resp.Timestamp,
 		Low:         resp.Low24h,
-		Pair:        exchange.FormatExchangeCurrency(o.Name, p),
+		Pair:        o.FormatExchangeCurrency(p),
 		Volume:      resp.BaseVolume24h,
 	}
 
@@ -76,7 +83,7 @@ func (o *OKGroup) UpdateTicker(p currency.Pair, assetType string) (tickerData ti
 }
 
 // GetTickerPrice returns the ticker for a currency pair
-func (o *OKGroup) GetTickerPrice(p currency.Pair, assetType string) (tickerData ticker.Price, err error) {
+func (o *OKGroup) GetTickerPrice(p currency.Pair, assetType asset.Item) (tickerData ticker.Price, err error) {
 	tickerData, err = ticker.GetTicker(o.GetName(), p, assetType)
 	if err != nil {
 		return o.UpdateTicker(p, assetType)
@@ -85,7 +92,7 @@ func (o *OKGroup) GetTickerPrice(p currency.Pair, assetType string) (tickerData
 }
 
 // GetOrderbookEx returns orderbook base on the currency pair
-func (o *OKGroup) GetOrderbookEx(p currency.Pair, assetType string) (resp orderbook.Base, err error) {
+func (o *OKGroup) GetOrderbookEx(p currency.Pair, assetType asset.Item) (resp orderbook.Base, err error) {
 	ob, err := orderbook.Get(o.GetName(), p, assetType)
 	if err != nil {
 		return o.UpdateOrderbook(p, assetType)
@@ -94,9 +101,13 @@ func (o *OKGroup) GetOrderbookEx(p currency.Pair, assetType string) (resp orderb
 }
 
 // UpdateOrderbook updates and returns the orderbook for a currency pair
-func (o *OKGroup) UpdateOrderbook(p currency.Pair, assetType string) (resp orderbook.Base, err error) {
+func (o *OKGroup) UpdateOrderbook(p currency.Pair, assetType asset.Item) (resp orderbook.Base, err error) {
+	if assetType != asset.Spot {
+		return resp, asset.ErrNotSupported
+	}
+
 	orderbookNew, err := o.GetSpotOrderBook(GetSpotOrderBookRequest{
-		InstrumentID: exchange.FormatExchangeCurrency(o.Name, p).String(),
+		InstrumentID: o.FormatExchangeCurrency(p).String(),
 	})
 	if err != nil {
 		return
@@ -214,15 +225,25 @@ func (o *OKGroup) GetFundingHistory() (resp []exchange.FundHistory, err error) {
 }
 
 // GetExchangeHistory returns historic trade data since exchange opening.
-func (o *OKGroup) GetExchangeHistory(p currency.Pair, assetType string) ([]exchange.TradeHistory, error) {
+func (o *OKGroup) GetExchangeHistory(p currency.Pair, assetType asset.Item) ([]exchange.TradeHistory, error) {
 	return nil, common.ErrNotYetImplemented
 }
 
+// GetHistoricTrades returns historic trade data within the timeframe provided.
+func (o *OKGroup) GetHistoricTrades(p currency.Pair, assetType asset.Item, timestampStart, timestampEnd time.Time) ([]exchange.TradeHistory, error) {
+	return nil, common.ErrFunctionNotSupported
+}
+
+// GetHistoricCandles returns candles between the start and end times
+func (o *OKGroup) GetHistoricCandles(p currency.Pair, assetType asset.Item, timestampStart, timestampEnd time.Time, interval kline.Interval) (kline.Item, error) {
+	return kline.Item{}, common.ErrFunctionNotSupported
+}
+
 // SubmitOrder submits a new order
-func (o *OKGroup) SubmitOrder(p currency.Pair, side exchange.OrderSide, orderType exchange.OrderType, amount, price float64, clientID string) (resp exchange.SubmitOrderResponse, err error) {
+func (o *OKGroup) SubmitOrder(p currency.Pair, _ asset.Item, side exchange.OrderSide, orderType exchange.OrderType, amount, price float64, clientID string) (resp exchange.SubmitOrderResponse, err error) {
 	request := PlaceSpotOrderRequest{
 		ClientOID:    clientID,
-		InstrumentID: exchange.FormatExchangeCurrency(o.Name, p).String(),
+		InstrumentID: o.FormatExchangeCurrency(p).String(),
 		Side:         strings.ToLower(side.ToString()),
 		Type:         strings.ToLower(orderType.ToString()),
 		Size:         strconv.FormatFloat(amount, 'f', -1, 64),
@@ -254,7 +275,7 @@ func (o *OKGroup) CancelOrder(orderCancellation *exchange.OrderCancellation) (er
 		return
 	}
 	orderCancellationResponse, err := o.CancelSpotOrder(CancelSpotOrderRequest{
-		InstrumentID: exchange.FormatExchangeCurrency(o.Name, orderCancellation.CurrencyPair).String(),
+		InstrumentID: o.FormatExchangeCurrency(orderCancellation.CurrencyPair).String(),
 		OrderID:      orderID,
 	})
 	if !orderCancellationResponse.Result {
@@ -279,7 +300,7 @@ func (o *OKGroup) CancelAllOrders(orderCancellation *exchange.OrderCancellation)
 	}
 
 	cancelOrdersResponse, err := o.CancelMultipleSpotOrders(CancelMultipleSpotOrdersRequest{
-		InstrumentID: exchange.FormatExchangeCurrency(o.Name, orderCancellation.CurrencyPair).String(),
+		InstrumentID: o.FormatExchangeCurrency(orderCancellation.CurrencyPair).String(),
 		OrderIDs:     orderIDNumbers,
 	})
 	if err != nil {
@@ -360,7 +381,7 @@ func (o *OKGroup) WithdrawFiatFundsToInternationalBank(withdrawRequest *exchange
 func (o *OKGroup) GetActiveOrders(getOrdersRequest *exchange.GetOrdersRequest) (resp []exchange.OrderDetail, err error) {
 	for _, currency := range getOrdersRequest.Currencies {
 		spotOpenOrders, err := o.GetSpotOpenOrders(GetSpotOpenOrdersRequest{
-			InstrumentID: exchange.FormatExchangeCurrency(o.Name, currency).String(),
+			InstrumentID: o.FormatExchangeCurrency(currency).String(),
 		})
 		if err != nil {
 			return resp, err
@@ -390,7 +411,7 @@ func (o *OKGroup) GetOrderHistory(getOrdersRequest *exchange.GetOrdersRequest) (
 	for _, currency := range getOrdersRequest.Currencies {
 		spotOpenOrders, err := o.GetSpotOrders(GetSpotOrdersRequest{
 			Status:       strings.Join([]string{"filled", "cancelled", "failure"}, "|"),
-			InstrumentID: exchange.FormatExchangeCurrency(o.Name, currency).String(),
+			InstrumentID: o.FormatExchangeCurrency(currency).String(),
 		})
 		if err != nil {
 			return resp, err
@@ -0,0 +1,196 @@
+package orderbook
+
+import (
+	"errors"
+)
+
+// Orderbook analytics errors
+var (
+	ErrNoLiquidity    = errors.New("orderbook has no liquidity on the required side")
+	ErrInvalidAmount  = errors.New("amount must be greater than zero")
+	ErrInvalidPercent = errors.New("percentage must be greater than zero")
+)
+
+// Fill is the result of filling an amount against one side of an orderbook
+type Fill struct {
+	// BaseAmount and QuoteAmount are the amounts filled
+	BaseAmount  float64 `json:"baseAmount"`
+	QuoteAmount float64 `json:"quoteAmount"`
+	// AveragePrice is the volume weighted average price of the fill
+	AveragePrice float64 `json:"averagePrice"`
+	// BestPrice is the price of the first level filled and WorstPrice that
+	// of the last, the limit price needed to fill the amount
+	BestPrice  float64 `json:"bestPrice"`
+	WorstPrice float64 `json:"worstPrice"`
+	// SlippagePercent is how far the average price is from the best price
+	// and ImpactPercent how far the worst price is from the mid price, both
+	// positive against the taker
+	SlippagePercent float64 `json:"slippagePercent"`
+	ImpactPercent   float64 `json:"impactPercent"`
+	// Levels is the number of price levels filled against
+	Levels int `json:"levels"`
+	// FullyFilled is false when the side holds less than the amount
+	FullyFilled bool `json:"fullyFilled"`
+}
+
+// Liquidity is the amount and value available on each side of an orderbook
+// within a percentage of the mid price
+type Liquidity struct {
+	Percent   float64 `json:"percent"`
+	BidAmount float64 `json:"bidAmount"`
+	BidValue  float64 `json:"bidValue"`
+	AskAmount float64 `json:"askAmount"`
+	AskValue  float64 `json:"askValue"`
+}
+
+// The analytics below expect bids and asks to be ordered from the best
+// price, as orderbooks retrieved from the orderbook store are
+
+// MidPrice returns the price halfway between the best bid and ask
+func (o *Base) MidPrice() (float64, error) {
+	if len(o.Bids) == 0 || len(o.Asks) == 0 {
+		return 0, ErrNoLiquidity
+	}
+	return (o.Bids[0].Price + o.Asks[0].Price) / 2, nil
+}
+
+// MicroPrice returns the best bid and ask prices weighted by the amount on
+// the opposite side, which leans towards the side more likely to trade
+// through
+func (o *Base) MicroPrice() (float64, error) {
+	if len(o.Bids) == 0 || len(o.Asks) == 0 {
+		return 0, ErrNoLiquidity
+	}
+	bid, ask := o.Bids[0], o.Asks[0]
+	if bid.Amount+ask.Amount == 0 {
+		return (bid.Price + ask.Price) / 2, nil
+	}
+	return (bid.Price*ask.Amount + ask.Price*bid.Amount) /
+		(bid.Amount + ask.Amount), nil
+}
+
+// SpreadBPS returns the spread between the best bid and ask in basis points
+// of the mid price
+func (o *Base) SpreadBPS() (float64, error) {
+	mid, err := o.MidPrice()
+	if err != nil {
+		return 0, err
+	}
+	if mid == 0 {
+		return 0, nil
+	}
+	return (o.Asks[0].Price - o.Bids[0].Price) / mid * 10000, nil
+}
+
+// SimulateBuy fills an amount against the asks, the amount is in the quote
+// currency when quote is set and in the base currency otherwise
+func (o *Base) SimulateBuy(amount float64, quote bool) (Fill, error) {
+	return o.simulate(o.Asks, amount, quote, true)
+}
+
+// SimulateSell fills an amount against the bids, the amount is in the quote
+// currency when quote is set and in the base currency otherwise
+func (o *Base) SimulateSell(amount float64, quote bool) (Fill, error) {
+	return o.simulate(o.Bids, amount, quote, false)
+}
+
+func (o *Base) simulate(levels []Item, amount float64, quote, buy bool) (Fill, error) {
+	var f Fill
+	if amount <= 0 {
+		return f, ErrInvalidAmount
+	}
+	if len(levels) == 0 {
+		return f, ErrNoLiquidity
+	}
+
+	remaining := amount
+	for x := range levels {
+		if remaining <= 0 {
+			break
+		}
+		if levels[x].Amount <= 0 {
+			continue
+		}
+		take := levels[x].Amount
+		available := take
+		if quote {
+			available = take * levels[x].Price
+		}
+		if available >= remaining {
+			if quote {
+				take = remaining / levels[x].Price
+			} else {
+				take = remaining
+			}
+			remaining = 0
+		} else {
+			remaining -= available
+		}
+
+		if f.Levels == 0 {
+			f.BestPrice = levels[x].Price
+		}
+		f.WorstPrice = levels[x].Price
+		f.BaseAmount += take
+		f.QuoteAmount += take * levels[x].Price
+		f.Levels++
+	}
+
+	if f.Levels == 0 {
+		return f, ErrNoLiquidity
+	}
+	f.FullyFilled = remaining <= 0
+	f.AveragePrice = f.QuoteAmount / f.BaseAmount
+
+	reference, err := o.MidPrice()
+	if err != nil {
+		reference = f.BestPrice
+	}
+	f.SlippagePercent = adversePercent(f.AveragePrice, f.BestPrice, buy)
+	f.ImpactPercent = adversePercent(f.WorstPrice, reference, buy)
+	return f, nil
+}
+
+// adversePercent returns the percentage a price has moved from a reference
+// price, positive when it moved against the taker
+func adversePercent(price, reference float64, buy bool) float64 {
+	if reference == 0 {
+		return 0
+	}
+	if buy {
+		return (price - reference) / reference * 100
+	}
+	return (reference - price) / reference * 100
+}
+
+// LiquidityWithin returns the amount and value of the bids and asks within a
+// percentage of the mid price
+func (o *Base) LiquidityWithin(percent float64) (Liquidity, error) {
+	l := Liquidity{Percent: percent}
+	if percent <= 0 {
+		return l, ErrInvalidPercent
+	}
+	mid, err := o.MidPrice()
+	if err != nil {
+		return l, err
+	}
+
+	floor := mid * (1 - percent/100)
+	for x := range o.Bids {
+		if o.Bids[x].Price < floor {
+			break
+		}
+		l.BidAmount += o.Bids[x].Amount
+		l.BidValue += o.Bids[x].Amount * o.Bids[x].Price
+	}
+
+	ceiling := mid * (1 + percent/100)
+	for x := range o.Asks {
+		if o.Asks[x].Price > ceiling {
+			break
+		}
+		l.AskAmount += o.Asks[x].Amount
+		l.AskValue += o.Asks[x].Amount * o.Asks[x].Price
+	}
+	return l, nil
+}
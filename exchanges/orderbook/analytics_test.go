@@ -0,0 +1,105 @@
+package orderbook
+
+import (
+	"math"
+	"testing"
+)
+
+func analyticsTestBook() Base {
+	return Base{
+		Bids: []Item{{Price: 99, Amount: 1}, {Price: 98, Amount: 2}, {Price: 90, Amount: 5}},
+		Asks: []Item{{Price: 101, Amount: 3}, {Price: 102, Amount: 2}, {Price: 110, Amount: 5}},
+	}
+}
+
+func floatEquals(a, b float64) bool {
+	return math.Abs(a-b) < 1e-9
+}
+
+func TestPrices(t *testing.T) {
+	t.Parallel()
+	ob := analyticsTestBook()
+
+	mid, err := ob.MidPrice()
+	if err != nil || mid != 100 {
+		t.Errorf("Test failed. Expected a mid price of 100 got %v %v", mid, err)
+	}
+
+	micro, err := ob.MicroPrice()
+	if err != nil || !floatEquals(micro, 99.5) {
+		t.Errorf("Test failed. Expected a micro price of 99.5 got %v %v", micro, err)
+	}
+
+	spread, err := ob.SpreadBPS()
+	if err != nil || !floatEquals(spread, 200) {
+		t.Errorf("Test failed. Expected a spread of 200 bps got %v %v", spread, err)
+	}
+
+	var empty Base
+	_, err = empty.MidPrice()
+	if err != ErrNoLiquidity {
+		t.Errorf("Test failed. Expected %s got %v", ErrNoLiquidity, err)
+	}
+}
+
+func TestSimulate(t *testing.T) {
+	t.Parallel()
+	ob := analyticsTestBook()
+
+	buy, err := ob.SimulateBuy(4, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !buy.FullyFilled || buy.Levels != 2 || buy.WorstPrice != 102 ||
+		!floatEquals(buy.AveragePrice, 101.25) || !floatEquals(buy.QuoteAmount, 405) {
+		t.Errorf("Test failed. Unexpected buy %+v", buy)
+	}
+	if !floatEquals(buy.SlippagePercent, 0.25/101*100) || !floatEquals(buy.ImpactPercent, 2) {
+		t.Errorf("Test failed. Unexpected buy slippage %+v", buy)
+	}
+
+	sell, err := ob.SimulateSell(197, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !sell.FullyFilled || sell.Levels != 2 || !floatEquals(sell.BaseAmount, 2) ||
+		!floatEquals(sell.AveragePrice, 98.5) || !floatEquals(sell.ImpactPercent, 2) {
+		t.Errorf("Test failed. Unexpected sell %+v", sell)
+	}
+
+	sell, err = ob.SimulateSell(100, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sell.FullyFilled || sell.BaseAmount != 8 || sell.WorstPrice != 90 {
+		t.Errorf("Test failed. Expected a partial fill %+v", sell)
+	}
+
+	_, err = ob.SimulateBuy(0, false)
+	if err != ErrInvalidAmount {
+		t.Errorf("Test failed. Expected %s got %v", ErrInvalidAmount, err)
+	}
+
+	_, err = (&Base{}).SimulateBuy(1, false)
+	if err != ErrNoLiquidity {
+		t.Errorf("Test failed. Expected %s got %v", ErrNoLiquidity, err)
+	}
+}
+
+func TestLiquidityWithin(t *testing.T) {
+	t.Parallel()
+	ob := analyticsTestBook()
+
+	l, err := ob.LiquidityWithin(2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if l.BidAmount != 3 || l.BidValue != 295 || l.AskAmount != 5 || l.AskValue != 507 {
+		t.Errorf("Test failed. Unexpected liquidity %+v", l)
+	}
+
+	_, err = ob.LiquidityWithin(0)
+	if err != ErrInvalidPercent {
+		t.Errorf("Test failed. Expected %s got %v", ErrInvalidPercent, err)
+	}
+}
@@ -0,0 +1,175 @@
+package orderbook
+
+import (
+	"sort"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+)
+
+// Venue is an exchange orderbook to be merged into a consolidated orderbook
+type Venue struct {
+	Book *Base
+	// FeeRate is the taker fee of the exchange as a fraction of the value
+	// traded e.g. 0.002 for 0.2%
+	FeeRate float64
+	// ConversionRate converts the prices of the orderbook into the quote
+	// currency of the consolidated orderbook, zero is treated as one
+	ConversionRate float64
+}
+
+// ConsolidatedItem is a price level of a consolidated orderbook tagged with
+// its exchange. Price is converted into the quote currency of the
+// consolidated orderbook and FeeAdjustedPrice is the price paid for a bid or
+// received for an ask once the exchange taker fee is applied
+type ConsolidatedItem struct {
+	Exchange         string        `json:"exchange"`
+	Pair             currency.Pair `json:"pair"`
+	Amount           float64       `json:"amount"`
+	Price            float64       `json:"price"`
+	FeeAdjustedPrice float64       `json:"feeAdjustedPrice"`
+}
+
+// Consolidated is the orderbook of a currency pair merged across exchanges,
+// bids and asks are ordered from the best fee adjusted price
+type Consolidated struct {
+	Pair        currency.Pair      `json:"pair"`
+	AssetType   asset.Item         `json:"assetType"`
+	Bids        []ConsolidatedItem `json:"bids"`
+	Asks        []ConsolidatedItem `json:"asks"`
+	LastUpdated time.Time          `json:"lastUpdated"`
+}
+
+// RouteFill is the part of a routed fill taken from one exchange orderbook
+type RouteFill struct {
+	Exchange               string        `json:"exchange"`
+	Pair                   currency.Pair `json:"pair"`
+	Amount                 float64       `json:"amount"`
+	QuoteAmount            float64       `json:"quoteAmount"`
+	FeeAdjustedQuoteAmount float64       `json:"feeAdjustedQuoteAmount"`
+	WorstPrice             float64       `json:"worstPrice"`
+}
+
+// Route is the result of filling an amount in the base currency against
+// the best fee adjusted prices of a consolidated orderbook, quote amounts
+// and prices are in the quote currency of the consolidated orderbook
+type Route struct {
+	Fills                   []RouteFill `json:"fills"`
+	BaseAmount              float64     `json:"baseAmount"`
+	QuoteAmount             float64     `json:"quoteAmount"`
+	FeeAdjustedQuoteAmount  float64     `json:"feeAdjustedQuoteAmount"`
+	AveragePrice            float64     `json:"averagePrice"`
+	FeeAdjustedAveragePrice float64     `json:"feeAdjustedAveragePrice"`
+	FullyFilled             bool        `json:"fullyFilled"`
+}
+
+// Consolidate merges the orderbooks of the venues into a consolidated
+// orderbook for a currency pair and asset type
+func Consolidate(p currency.Pair, assetType asset.Item, venues []Venue) *Consolidated {
+	c := &Consolidated{
+		Pair:      p,
+		AssetType: assetType,
+	}
+	for x := range venues {
+		ob := venues[x].Book
+		if ob == nil {
+			continue
+		}
+		rate := venues[x].ConversionRate
+		if rate == 0 {
+			rate = 1
+		}
+		for y := range ob.Bids {
+			price := ob.Bids[y].Price * rate
+			c.Bids = append(c.Bids, ConsolidatedItem{
+				Exchange:         ob.ExchangeName,
+				Pair:             ob.Pair,
+				Amount:           ob.Bids[y].Amount,
+				Price:            price,
+				FeeAdjustedPrice: price * (1 - venues[x].FeeRate),
+			})
+		}
+		for y := range ob.Asks {
+			price := ob.Asks[y].Price * rate
+			c.Asks = append(c.Asks, ConsolidatedItem{
+				Exchange:         ob.ExchangeName,
+				Pair:             ob.Pair,
+				Amount:           ob.Asks[y].Amount,
+				Price:            price,
+				FeeAdjustedPrice: price * (1 + venues[x].FeeRate),
+			})
+		}
+		if ob.LastUpdated.After(c.LastUpdated) {
+			c.LastUpdated = ob.LastUpdated
+		}
+	}
+
+	sort.SliceStable(c.Bids, func(i, j int) bool {
+		return c.Bids[i].FeeAdjustedPrice > c.Bids[j].FeeAdjustedPrice
+	})
+	sort.SliceStable(c.Asks, func(i, j int) bool {
+		return c.Asks[i].FeeAdjustedPrice < c.Asks[j].FeeAdjustedPrice
+	})
+	return c
+}
+
+// RouteBuy fills an amount in the base currency against the asks
+func (c *Consolidated) RouteBuy(amount float64) (Route, error) {
+	return route(c.Asks, amount)
+}
+
+// RouteSell fills an amount in the base currency against the bids
+func (c *Consolidated) RouteSell(amount float64) (Route, error) {
+	return route(c.Bids, amount)
+}
+
+func route(levels []ConsolidatedItem, amount float64) (Route, error) {
+	var r Route
+	if amount <= 0 {
+		return r, ErrInvalidAmount
+	}
+
+	fills := make(map[string]int)
+	remaining := amount
+	for x := range levels {
+		if remaining <= 0 {
+			break
+		}
+		if levels[x].Amount <= 0 {
+			continue
+		}
+		take := levels[x].Amount
+		if take > remaining {
+			take = remaining
+		}
+		remaining -= take
+
+		key := levels[x].Exchange + levels[x].Pair.String()
+		i, ok := fills[key]
+		if !ok {
+			i = len(r.Fills)
+			fills[key] = i
+			r.Fills = append(r.Fills, RouteFill{
+				Exchange: levels[x].Exchange,
+				Pair:     levels[x].Pair,
+			})
+		}
+		r.Fills[i].Amount += take
+		r.Fills[i].QuoteAmount += take * levels[x].Price
+		r.Fills[i].FeeAdjustedQuoteAmount += take * levels[x].FeeAdjustedPrice
+		r.Fills[i].WorstPrice = levels[x].Price
+
+		r.BaseAmount += take
+		r.QuoteAmount += take * levels[x].Price
+		r.FeeAdjustedQuoteAmount += take * levels[x].FeeAdjustedPrice
+	}
+
+	if r.BaseAmount == 0 {
+		return r, ErrNoLiquidity
+	}
+	r.FullyFilled = remaining <= 0
+	r.AveragePrice = r.QuoteAmount / r.BaseAmount
+	r.FeeAdjustedAveragePrice = r.FeeAdjustedQuoteAmount / r.BaseAmount
+	return r, nil
+}
@@ -0,0 +1,99 @@
+package orderbook
+
+import (
+	"testing"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+)
+
+func consolidatedTestBook() *Consolidated {
+	usd := currency.NewPairFromStrings("BTC", "USD")
+	eur := currency.NewPairFromStrings("BTC", "EUR")
+	return Consolidate(usd, asset.Spot, []Venue{
+		{
+			Book: &Base{
+				ExchangeName: "cheap",
+				Pair:         usd,
+				Bids:         []Item{{Price: 99, Amount: 1}},
+				Asks:         []Item{{Price: 101, Amount: 1}, {Price: 103, Amount: 1}},
+			},
+		},
+		{
+			Book: &Base{
+				ExchangeName: "costly",
+				Pair:         usd,
+				Bids:         []Item{{Price: 99.5, Amount: 2}},
+				Asks:         []Item{{Price: 100.5, Amount: 1}},
+			},
+			FeeRate: 0.01,
+		},
+		{
+			Book: &Base{
+				ExchangeName: "euro",
+				Pair:         eur,
+				Bids:         []Item{{Price: 80, Amount: 1}},
+				Asks:         []Item{{Price: 81, Amount: 1}},
+			},
+			ConversionRate: 1.25,
+		},
+	})
+}
+
+func TestConsolidate(t *testing.T) {
+	t.Parallel()
+	c := consolidatedTestBook()
+
+	if len(c.Bids) != 3 || len(c.Asks) != 4 {
+		t.Fatalf("Test failed. Unexpected levels %d bids %d asks", len(c.Bids), len(c.Asks))
+	}
+
+	// The costly bid is the highest price but the lowest once fees are paid
+	if c.Bids[0].Exchange != "euro" || c.Bids[0].Price != 100 ||
+		c.Bids[1].Exchange != "cheap" || c.Bids[2].Exchange != "costly" ||
+		!floatEquals(c.Bids[2].FeeAdjustedPrice, 98.505) {
+		t.Errorf("Test failed. Unexpected bids %+v", c.Bids)
+	}
+
+	if c.Asks[0].Exchange != "cheap" || c.Asks[1].Exchange != "euro" ||
+		c.Asks[1].Price != 101.25 || c.Asks[2].Exchange != "costly" ||
+		!c.Asks[1].Pair.Equal(currency.NewPairFromStrings("BTC", "EUR")) {
+		t.Errorf("Test failed. Unexpected asks %+v", c.Asks)
+	}
+}
+
+func TestRoute(t *testing.T) {
+	t.Parallel()
+	c := consolidatedTestBook()
+
+	r, err := c.RouteBuy(3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !r.FullyFilled || len(r.Fills) != 3 || r.BaseAmount != 3 ||
+		!floatEquals(r.QuoteAmount, 302.75) ||
+		!floatEquals(r.FeeAdjustedQuoteAmount, 303.755) {
+		t.Fatalf("Test failed. Unexpected route %+v", r)
+	}
+	if r.Fills[1].Exchange != "euro" || r.Fills[1].Amount != 1 {
+		t.Errorf("Test failed. Unexpected fill %+v", r.Fills[1])
+	}
+
+	r, err = c.RouteSell(10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.FullyFilled || r.BaseAmount != 4 || len(r.Fills) != 3 {
+		t.Errorf("Test failed. Expected a partial route %+v", r)
+	}
+
+	_, err = c.RouteSell(0)
+	if err != ErrInvalidAmount {
+		t.Errorf("Test failed. Expected %s got %v", ErrInvalidAmount, err)
+	}
+
+	_, err = Consolidate(c.Pair, c.AssetType, nil).RouteBuy(1)
+	if err != ErrNoLiquidity {
+		t.Errorf("Test failed. Expected %s got %v", ErrNoLiquidity, err)
+	}
+}
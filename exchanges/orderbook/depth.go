@@ -0,0 +1,245 @@
+package orderbook
+
+import (
+	"sync"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+)
+
+// Depth is the live orderbook of an exchange currency pair and asset type.
+// Its price levels are held in skip lists so that an update inserts, amends
+// or removes a level in O(log n) under the lock of the book alone, and
+// readers walk the levels in place without copying the book
+type Depth struct {
+	m            sync.RWMutex
+	bids         levels
+	asks         levels
+	exchangeName string
+	pair         currency.Pair
+	assetType    asset.Item
+	lastUpdated  time.Time
+	lastUpdateID int64
+	// websocket is set while the orderbook is maintained by a websocket feed
+	websocket bool
+}
+
+// NewDepth returns an empty orderbook
+func NewDepth(exchangeName string, p currency.Pair, assetType asset.Item) *Depth {
+	d := &Depth{
+		exchangeName: exchangeName,
+		pair:         p,
+		assetType:    assetType,
+	}
+	d.bids.bids = true
+	d.bids.reset()
+	d.asks.reset()
+	return d
+}
+
+// Load replaces the price levels of the orderbook with those of a snapshot,
+// the snapshot levels are copied and need not be sorted
+func (d *Depth) Load(b *Base) {
+	d.m.Lock()
+	d.load(b)
+	d.m.Unlock()
+}
+
+// loadUnlessWebsocket loads a snapshot unless the orderbook is maintained by
+// a websocket feed
+func (d *Depth) loadUnlessWebsocket(b *Base) {
+	d.m.Lock()
+	if !d.websocket {
+		d.load(b)
+	}
+	d.m.Unlock()
+}
+
+// loadWebsocket loads a websocket snapshot and marks the orderbook as
+// maintained by the websocket feed
+func (d *Depth) loadWebsocket(b *Base) {
+	d.m.Lock()
+	d.load(b)
+	d.websocket = true
+	d.m.Unlock()
+}
+
+// load replaces the price levels of the orderbook, the caller must hold the
+// lock
+func (d *Depth) load(b *Base) {
+	d.bids.load(b.Bids)
+	d.asks.load(b.Asks)
+	d.lastUpdateID = b.LastUpdateID
+	d.setLastUpdated(b.LastUpdated)
+}
+
+// ReleaseWebsocket marks the orderbook as no longer maintained by a
+// websocket feed, so that REST snapshots replace it again
+func (d *Depth) ReleaseWebsocket() {
+	d.m.Lock()
+	d.websocket = false
+	d.m.Unlock()
+}
+
+// IsWebsocket returns whether the orderbook is maintained by a websocket
+// feed
+func (d *Depth) IsWebsocket() bool {
+	d.m.RLock()
+	defer d.m.RUnlock()
+	return d.websocket
+}
+
+// UpdateByPrice amends the amount of the levels at the price of each bid and
+// ask, inserting levels which are not found and removing those whose amount
+// is zero
+func (d *Depth) UpdateByPrice(bids, asks []Item, updated time.Time) {
+	d.m.Lock()
+	for i := range bids {
+		d.bids.set(&bids[i])
+	}
+	for i := range asks {
+		d.asks.set(&asks[i])
+	}
+	d.setLastUpdated(updated)
+	d.m.Unlock()
+}
+
+// UpdateByID amends the amount of the levels with the ID of each bid and ask
+func (d *Depth) UpdateByID(bids, asks []Item, updated time.Time) {
+	d.m.Lock()
+	for i := range bids {
+		d.bids.amend(&bids[i])
+	}
+	for i := range asks {
+		d.asks.amend(&asks[i])
+	}
+	d.setLastUpdated(updated)
+	d.m.Unlock()
+}
+
+// Truncate removes every bid and ask level after the first depth levels from
+// the best price, for feeds which publish a fixed number of levels and leave
+// the levels falling out of it to be dropped
+func (d *Depth) Truncate(depth int) {
+	d.m.Lock()
+	d.bids.truncate(depth)
+	d.asks.truncate(depth)
+	d.m.Unlock()
+}
+
+// DeleteByID removes the levels with the ID of each bid and ask
+func (d *Depth) DeleteByID(bids, asks []Item, updated time.Time) {
+	d.m.Lock()
+	for i := range bids {
+		d.bids.remove(bids[i].ID)
+	}
+	for i := range asks {
+		d.asks.remove(asks[i].ID)
+	}
+	d.setLastUpdated(updated)
+	d.m.Unlock()
+}
+
+// Insert inserts a level for each bid and ask
+func (d *Depth) Insert(bids, asks []Item, updated time.Time) {
+	d.m.Lock()
+	for i := range bids {
+		d.bids.insert(&bids[i])
+	}
+	for i := range asks {
+		d.asks.insert(&asks[i])
+	}
+	d.setLastUpdated(updated)
+	d.m.Unlock()
+}
+
+// setLastUpdated sets the update time, the caller must hold the lock
+func (d *Depth) setLastUpdated(updated time.Time) {
+	if updated.IsZero() {
+		updated = time.Now()
+	}
+	d.lastUpdated = updated
+}
+
+// WalkBids calls fn with each bid from the highest price until it returns
+// false. The bids are not copied, the book is read locked until WalkBids
+// returns so fn must not call any other method of the book
+func (d *Depth) WalkBids(fn func(Item) bool) {
+	d.m.RLock()
+	d.bids.walk(fn)
+	d.m.RUnlock()
+}
+
+// WalkAsks calls fn with each ask from the lowest price until it returns
+// false. The asks are not copied, the book is read locked until WalkAsks
+// returns so fn must not call any other method of the book
+func (d *Depth) WalkAsks(fn func(Item) bool) {
+	d.m.RLock()
+	d.asks.walk(fn)
+	d.m.RUnlock()
+}
+
+// BestBid returns the highest bid
+func (d *Depth) BestBid() (Item, bool) {
+	d.m.RLock()
+	defer d.m.RUnlock()
+	if n := d.bids.head.next[0]; n != nil {
+		return n.Item, true
+	}
+	return Item{}, false
+}
+
+// BestAsk returns the lowest ask
+func (d *Depth) BestAsk() (Item, bool) {
+	d.m.RLock()
+	defer d.m.RUnlock()
+	if n := d.asks.head.next[0]; n != nil {
+		return n.Item, true
+	}
+	return Item{}, false
+}
+
+// Len returns the number of bid and ask levels
+func (d *Depth) Len() (bids, asks int) {
+	d.m.RLock()
+	bids, asks = d.bids.length, d.asks.length
+	d.m.RUnlock()
+	return
+}
+
+// Pair returns the currency pair of the orderbook
+func (d *Depth) Pair() currency.Pair {
+	return d.pair
+}
+
+// LastUpdated returns when the orderbook was last updated
+func (d *Depth) LastUpdated() time.Time {
+	d.m.RLock()
+	defer d.m.RUnlock()
+	return d.lastUpdated
+}
+
+// Levels returns a copy of up to depth bid and ask levels from the best
+// price, or of every level when depth is zero
+func (d *Depth) Levels(depth int) (bids, asks []Item) {
+	d.m.RLock()
+	bids, asks = d.bids.items(depth), d.asks.items(depth)
+	d.m.RUnlock()
+	return
+}
+
+// Retrieve returns a copy of the orderbook
+func (d *Depth) Retrieve() Base {
+	d.m.RLock()
+	defer d.m.RUnlock()
+	return Base{
+		Pair:         d.pair,
+		Bids:         d.bids.items(0),
+		Asks:         d.asks.items(0),
+		LastUpdated:  d.lastUpdated,
+		AssetType:    d.assetType,
+		ExchangeName: d.exchangeName,
+		LastUpdateID: d.lastUpdateID,
+	}
+}
@@ -0,0 +1,231 @@
+package orderbook
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+)
+
+func TestDepthUpdateByPrice(t *testing.T) {
+	t.Parallel()
+	d := NewDepth("depthtest", currency.NewPairFromStrings("BTC", "USD"), asset.Spot)
+	r := rand.New(rand.NewSource(1))
+	expected := [2]map[float64]float64{{}, {}}
+	for i := 0; i < 5000; i++ {
+		var bids, asks []Item
+		for j := 0; j < 5; j++ {
+			item := Item{Price: float64(r.Intn(500)), Amount: float64(r.Intn(4))}
+			side := r.Intn(2)
+			if side == 0 {
+				bids = append(bids, item)
+			} else {
+				asks = append(asks, item)
+			}
+			if item.Amount == 0 {
+				delete(expected[side], item.Price)
+				continue
+			}
+			expected[side][item.Price] = item.Amount
+		}
+		d.UpdateByPrice(bids, asks, time.Time{})
+	}
+
+	ob := d.Retrieve()
+	for side, items := range [][]Item{ob.Bids, ob.Asks} {
+		if len(items) != len(expected[side]) {
+			t.Fatalf("Test failed. Expected %d levels got %d", len(expected[side]), len(items))
+		}
+		sorted := sort.SliceIsSorted(items, func(i, j int) bool {
+			if side == 0 {
+				return items[i].Price > items[j].Price
+			}
+			return items[i].Price < items[j].Price
+		})
+		if !sorted {
+			t.Error("Test failed. Levels are not sorted")
+		}
+		for x := range items {
+			if expected[side][items[x].Price] != items[x].Amount {
+				t.Errorf("Test failed. Unexpected level %+v", items[x])
+			}
+		}
+	}
+
+	if d.LastUpdated().IsZero() {
+		t.Error("Test failed. Expected the update time to be set")
+	}
+}
+
+func TestDepthByID(t *testing.T) {
+	t.Parallel()
+	d := NewDepth("depthtest", currency.NewPairFromStrings("BTC", "USD"), asset.Spot)
+	d.Load(&Base{
+		Bids: []Item{{Price: 98, Amount: 1, ID: 3}, {Price: 99, Amount: 1, ID: 2}},
+		Asks: []Item{{Price: 102, Amount: 1, ID: 5}, {Price: 101, Amount: 1, ID: 4}},
+	})
+
+	bid, ok := d.BestBid()
+	if !ok || bid.ID != 2 {
+		t.Errorf("Test failed. Expected an unsorted snapshot to be sorted %+v", bid)
+	}
+
+	d.Insert([]Item{{Price: 100, Amount: 2, ID: 1}}, []Item{{Price: 100.5, Amount: 2, ID: 6}}, time.Time{})
+	d.UpdateByID([]Item{{ID: 3, Amount: 7}}, []Item{{ID: 99, Amount: 7}}, time.Time{})
+	d.DeleteByID(nil, []Item{{ID: 6}, {ID: 5}}, time.Time{})
+
+	bids, asks := d.Levels(2)
+	if len(bids) != 2 || bids[0].ID != 1 || bids[1].ID != 2 {
+		t.Errorf("Test failed. Unexpected bids %+v", bids)
+	}
+	if len(asks) != 1 || asks[0].ID != 4 {
+		t.Errorf("Test failed. Unexpected asks %+v", asks)
+	}
+
+	var amounts []float64
+	d.WalkBids(func(i Item) bool {
+		amounts = append(amounts, i.Amount)
+		return true
+	})
+	if len(amounts) != 3 || amounts[2] != 7 {
+		t.Errorf("Test failed. Expected the bid to be amended by ID %v", amounts)
+	}
+
+	var walked int
+	d.WalkAsks(func(Item) bool {
+		walked++
+		return false
+	})
+	if walked != 1 {
+		t.Errorf("Test failed. Expected the walk to stop got %d levels", walked)
+	}
+
+	if b, a := d.Len(); b != 3 || a != 1 {
+		t.Errorf("Test failed. Unexpected levels %d bids %d asks", b, a)
+	}
+}
+
+func TestGetDepth(t *testing.T) {
+	t.Parallel()
+	c := currency.NewPairFromStrings("DEPTH", "USD")
+	base := Base{
+		Pair:         c,
+		Asks:         []Item{{Price: 101, Amount: 1}},
+		Bids:         []Item{{Price: 100, Amount: 1}},
+		ExchangeName: "depthstore",
+		AssetType:    asset.Spot,
+	}
+	err := base.Process()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	d, err := GetDepth("depthstore", c, asset.Spot)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	d.UpdateByPrice(nil, []Item{{Price: 100.5, Amount: 1}}, time.Time{})
+	result, err := Get("depthstore", c, asset.Spot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(result.Asks) != 2 || result.Asks[0].Price != 100.5 ||
+		result.ExchangeName != "depthstore" || !result.Pair.Equal(c) {
+		t.Errorf("Test failed. Expected the live orderbook to be stored %+v", result)
+	}
+
+	_, err = GetDepth("depthstore", c, asset.Futures)
+	if err == nil {
+		t.Error("Test failed. Expected an error for an unknown asset type")
+	}
+
+	Remove(NewDepth("depthstore", c, asset.Spot))
+	_, err = GetDepth("depthstore", c, asset.Spot)
+	if err != nil {
+		t.Error("Test failed. Expected a different orderbook to be left stored")
+	}
+
+	Remove(d)
+	_, err = GetDepth("depthstore", c, asset.Spot)
+	if err == nil {
+		t.Error("Test failed. Expected the removed orderbook to no longer be stored")
+	}
+}
+
+func TestDepthTruncate(t *testing.T) {
+	t.Parallel()
+	d := NewDepth("truncate", currency.NewPairFromStrings("BTC", "USD"), asset.Spot)
+	var bids, asks []Item
+	for i := 1; i <= 50; i++ {
+		bids = append(bids, Item{Price: float64(100 - i), Amount: 1, ID: int64(i)})
+		asks = append(asks, Item{Price: float64(100 + i), Amount: 1, ID: int64(100 + i)})
+	}
+	d.Load(&Base{Bids: bids, Asks: asks})
+
+	d.Truncate(10)
+	if b, a := d.Len(); b != 10 || a != 10 {
+		t.Fatalf("Test failed. Unexpected levels %d bids %d asks", b, a)
+	}
+	b, a := d.Levels(0)
+	if b[9].Price != 90 || a[9].Price != 110 {
+		t.Errorf("Test failed. Expected the best levels to be kept %v %v", b, a)
+	}
+
+	d.UpdateByPrice([]Item{{Price: 95, Amount: 0}}, []Item{{Price: 111, Amount: 1}}, time.Time{})
+	b, a = d.Levels(0)
+	if len(b) != 9 || len(a) != 11 || a[10].Price != 111 {
+		t.Errorf("Test failed. Expected the truncated levels to be updated %v %v", b, a)
+	}
+
+	d.DeleteByID(nil, []Item{{ID: 145}}, time.Time{})
+	if _, a := d.Len(); a != 11 {
+		t.Errorf("Test failed. Expected truncated IDs to be dropped, %d asks", a)
+	}
+}
+
+func TestProcessWebsocket(t *testing.T) {
+	t.Parallel()
+	c := currency.NewPairFromStrings("WSDEPTH", "USD")
+	ws := Base{
+		Pair:         c,
+		Asks:         []Item{{Price: 101, Amount: 1}},
+		Bids:         []Item{{Price: 100, Amount: 1}},
+		ExchangeName: "depthstore",
+		AssetType:    asset.Spot,
+	}
+	d, err := ws.ProcessWebsocket()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !d.IsWebsocket() {
+		t.Fatal("Test failed. Expected the orderbook to be maintained by the websocket")
+	}
+
+	rest := ws
+	rest.Asks = []Item{{Price: 110, Amount: 1}}
+	err = rest.Process()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ask, _ := d.BestAsk(); ask.Price != 101 {
+		t.Errorf("Test failed. Expected the REST snapshot to be skipped %v", ask)
+	}
+
+	d.ReleaseWebsocket()
+	err = rest.Process()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ask, _ := d.BestAsk(); ask.Price != 110 {
+		t.Errorf("Test failed. Expected the REST snapshot to be loaded %v", ask)
+	}
+
+	_, err = (&Base{AssetType: asset.Spot}).ProcessWebsocket()
+	if err == nil {
+		t.Error("Test failed. Expected an error for an unset pair")
+	}
+}
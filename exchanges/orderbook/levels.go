@@ -0,0 +1,243 @@
+package orderbook
+
+import (
+	"math/bits"
+)
+
+// maxLevelHeight is the maximum number of links of a price level, with a one
+// in four chance of each extra link it keeps lookups O(log n) for books of up
+// to 4^16 levels
+const maxLevelHeight = 16
+
+// level is a price level of one side of an orderbook
+type level struct {
+	Item
+	next []*level
+}
+
+// levels holds one side of an orderbook as a skip list ordered by price,
+// ascending for asks and descending for bids. Levels are inserted, amended
+// and removed in O(log n) and levels with an ID are indexed by it
+type levels struct {
+	head   level
+	height int
+	length int
+	bids   bool
+	ids    map[int64]*level
+	seed   uint64
+}
+
+// reset removes every level
+func (l *levels) reset() {
+	l.head.next = make([]*level, maxLevelHeight)
+	l.height = 1
+	l.length = 0
+	l.ids = nil
+}
+
+// less returns whether an item is ordered before a price, and before an ID
+// at the same price when byID is set
+func (l *levels) less(item *Item, price float64, id int64, byID bool) bool {
+	if item.Price != price {
+		if l.bids {
+			return item.Price > price
+		}
+		return item.Price < price
+	}
+	return byID && item.ID < id
+}
+
+// search returns the first level which is not ordered before the price and
+// fills path with the last level before it at each height
+func (l *levels) search(price float64, id int64, byID bool, path *[maxLevelHeight]*level) *level {
+	n := &l.head
+	for h := l.height - 1; h >= 0; h-- {
+		for n.next[h] != nil && l.less(&n.next[h].Item, price, id, byID) {
+			n = n.next[h]
+		}
+		path[h] = n
+	}
+	return n.next[0]
+}
+
+// randomHeight returns the number of links of a new level
+func (l *levels) randomHeight() int {
+	if l.seed == 0 {
+		l.seed = 0x9e3779b97f4a7c15
+	}
+	// xorshift64
+	l.seed ^= l.seed << 13
+	l.seed ^= l.seed >> 7
+	l.seed ^= l.seed << 17
+	h := bits.TrailingZeros64(l.seed)/2 + 1
+	if h > maxLevelHeight {
+		return maxLevelHeight
+	}
+	return h
+}
+
+// insertAt links a new level after the levels of path
+func (l *levels) insertAt(item *Item, path *[maxLevelHeight]*level) *level {
+	h := l.randomHeight()
+	for ; l.height < h; l.height++ {
+		path[l.height] = &l.head
+	}
+	n := &level{Item: *item, next: make([]*level, h)}
+	for i := 0; i < h; i++ {
+		n.next[i] = path[i].next[i]
+		path[i].next[i] = n
+	}
+	l.length++
+	if item.ID != 0 {
+		if l.ids == nil {
+			l.ids = make(map[int64]*level)
+		}
+		l.ids[item.ID] = n
+	}
+	return n
+}
+
+// unlink removes a level, path holds the last level before it at each height
+func (l *levels) unlink(n *level, path *[maxLevelHeight]*level) {
+	for i := range n.next {
+		p := path[i]
+		// Levels with the same price and ID may sit between path and n
+		for p.next[i] != n {
+			p = p.next[i]
+		}
+		p.next[i] = n.next[i]
+	}
+	for l.height > 1 && l.head.next[l.height-1] == nil {
+		l.height--
+	}
+	l.length--
+	if n.ID != 0 && l.ids[n.ID] == n {
+		delete(l.ids, n.ID)
+	}
+}
+
+// load replaces the levels with the items, items already in order are
+// appended without a search
+func (l *levels) load(items []Item) {
+	l.reset()
+	var path [maxLevelHeight]*level
+	for i := range path {
+		path[i] = &l.head
+	}
+	var last *level
+	for i := range items {
+		if last != nil && l.less(&items[i], last.Price, last.ID, true) {
+			l.search(items[i].Price, items[i].ID, true, &path)
+			l.insertAt(&items[i], &path)
+			continue
+		}
+		for h := 0; h < l.height; h++ {
+			for path[h].next[h] != nil {
+				path[h] = path[h].next[h]
+			}
+		}
+		last = l.insertAt(&items[i], &path)
+		for h := range last.next {
+			path[h] = last
+		}
+	}
+}
+
+// set amends the amount of the level at the price of the item, removing it
+// when the amount is zero, or inserts a new level
+func (l *levels) set(item *Item) {
+	var path [maxLevelHeight]*level
+	n := l.search(item.Price, 0, false, &path)
+	if n != nil && n.Price == item.Price {
+		if item.Amount == 0 {
+			l.unlink(n, &path)
+			return
+		}
+		n.Amount = item.Amount
+		return
+	}
+	if item.Amount != 0 {
+		l.insertAt(item, &path)
+	}
+}
+
+// insert inserts a new level ordered by price and ID
+func (l *levels) insert(item *Item) {
+	var path [maxLevelHeight]*level
+	l.search(item.Price, item.ID, true, &path)
+	l.insertAt(item, &path)
+}
+
+// amend amends the amount of the level with the ID of the item
+func (l *levels) amend(item *Item) {
+	if n, ok := l.ids[item.ID]; ok {
+		n.Amount = item.Amount
+	}
+}
+
+// remove removes the level with the ID
+func (l *levels) remove(id int64) {
+	n, ok := l.ids[id]
+	if !ok {
+		return
+	}
+	var path [maxLevelHeight]*level
+	l.search(n.Price, n.ID, true, &path)
+	l.unlink(n, &path)
+}
+
+// truncate removes every level after the first depth levels from the best
+// price
+func (l *levels) truncate(depth int) {
+	if depth <= 0 || depth >= l.length {
+		return
+	}
+	var path [maxLevelHeight]*level
+	for i := range path {
+		path[i] = &l.head
+	}
+	n := &l.head
+	for i := 0; i < depth; i++ {
+		n = n.next[0]
+		for h := range n.next {
+			path[h] = n
+		}
+	}
+	for r := n.next[0]; r != nil && l.ids != nil; r = r.next[0] {
+		if r.ID != 0 && l.ids[r.ID] == r {
+			delete(l.ids, r.ID)
+		}
+	}
+	for h := 0; h < l.height; h++ {
+		path[h].next[h] = nil
+	}
+	for l.height > 1 && l.head.next[l.height-1] == nil {
+		l.height--
+	}
+	l.length = depth
+}
+
+// walk calls fn with each level from the best price until it returns false
+func (l *levels) walk(fn func(Item) bool) {
+	for n := l.head.next[0]; n != nil; n = n.next[0] {
+		if !fn(n.Item) {
+			return
+		}
+	}
+}
+
+// items returns a copy of up to depth levels from the best price, or of
+// every level when depth is zero
+func (l *levels) items(depth int) []Item {
+	if depth <= 0 || depth > l.length {
+		depth = l.length
+	}
+	if depth == 0 {
+		return nil
+	}
+	result := make([]Item, 0, depth)
+	for n := l.head.next[0]; n != nil && len(result) < depth; n = n.next[0] {
+		result = append(result, n.Item)
+	}
+	return result
+}
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 )
 
 // const values for orderbook package
@@ -15,15 +16,13 @@ const (
 	errAssetTypeNotSet           = "orderbook asset type not set"
 	errBaseCurrencyNotFound      = "orderbook base currency not found"
 	errQuoteCurrencyNotFound     = "orderbook quote currency not found"
-
-	Spot = "SPOT"
-	Swap = "SWAP"
+	errAssetTypeNotFound         = "orderbook asset type not found"
 )
 
 // Vars for the orderbook package
 var (
 	Orderbooks []Orderbook
-	m          sync.Mutex
+	m          sync.RWMutex
 )
 
 // Item stores the amount and price values
@@ -39,13 +38,17 @@ type Base struct {
 	Bids         []Item        `json:"bids"`
 	Asks         []Item        `json:"asks"`
 	LastUpdated  time.Time     `json:"lastUpdated"`
-	AssetType    string        `json:"assetType"`
+	AssetType    asset.Item    `json:"assetType"`
 	ExchangeName string        `json:"exchangeName"`
+	// LastUpdateID is the exchange sequence number of the orderbook, zero
+	// when the exchange does not publish them
+	LastUpdateID int64 `json:"lastUpdateID,omitempty"`
 }
 
-// Orderbook holds the orderbook information for a currency pair and type
+// Orderbook holds the orderbooks of an exchange by currency pair and type,
+// the package lock guards the maps and each orderbook is locked by itself
 type Orderbook struct {
-	Orderbook    map[*currency.Item]map[*currency.Item]map[string]Base
+	Orderbook    map[*currency.Item]map[*currency.Item]map[asset.Item]*Depth
 	ExchangeName string
 }
 
@@ -76,29 +79,64 @@ func (o *Base) Update(bids, asks []Item) {
 	o.LastUpdated = time.Now()
 }
 
-// Get checks and returns the orderbook given an exchange name and currency pair
-// if it exists
-func Get(exchange string, p currency.Pair, orderbookType string) (Base, error) {
-	orderbook, err := GetByExchange(exchange)
+// Get checks and returns a copy of the orderbook given an exchange name and
+// currency pair if it exists
+func Get(exchange string, p currency.Pair, orderbookType asset.Item) (Base, error) {
+	d, err := GetDepth(exchange, p, orderbookType)
 	if err != nil {
 		return Base{}, err
 	}
+	return d.Retrieve(), nil
+}
+
+// GetDepth checks and returns the live orderbook given an exchange name and
+// currency pair if it exists. Its levels can be read in place, without the
+// copy made by Get
+func GetDepth(exchange string, p currency.Pair, orderbookType asset.Item) (*Depth, error) {
+	orderbook, err := GetByExchange(exchange)
+	if err != nil {
+		return nil, err
+	}
 
 	if !BaseCurrencyExists(exchange, p.Base) {
-		return Base{}, errors.New(errBaseCurrencyNotFound)
+		return nil, errors.New(errBaseCurrencyNotFound)
 	}
 
 	if !QuoteCurrencyExists(exchange, p) {
-		return Base{}, errors.New(errQuoteCurrencyNotFound)
+		return nil, errors.New(errQuoteCurrencyNotFound)
 	}
 
-	return orderbook.Orderbook[p.Base.Item][p.Quote.Item][orderbookType], nil
+	m.RLock()
+	defer m.RUnlock()
+	d, ok := orderbook.Orderbook[p.Base.Item][p.Quote.Item][orderbookType]
+	if !ok {
+		return nil, errors.New(errAssetTypeNotFound)
+	}
+	return d, nil
 }
 
-// GetByExchange returns an exchange orderbook
-func GetByExchange(exchange string) (*Orderbook, error) {
+// Remove removes a live orderbook from the orderbook list, so that it is no
+// longer served until a new snapshot is processed for its currency pair. A
+// different orderbook stored since for the currency pair is left as is
+func Remove(d *Depth) {
 	m.Lock()
 	defer m.Unlock()
+	for x := range Orderbooks {
+		if Orderbooks[x].ExchangeName != d.exchangeName {
+			continue
+		}
+		a := Orderbooks[x].Orderbook[d.pair.Base.Item][d.pair.Quote.Item]
+		if a[d.assetType] == d {
+			delete(a, d.assetType)
+		}
+		return
+	}
+}
+
+// GetByExchange returns an exchange orderbook
+func GetByExchange(exchange string) (*Orderbook, error) {
+	m.RLock()
+	defer m.RUnlock()
 	for x := range Orderbooks {
 		if Orderbooks[x].ExchangeName == exchange {
 			return &Orderbooks[x], nil
@@ -110,8 +148,8 @@ func GetByExchange(exchange string) (*Orderbook, error) {
 // BaseCurrencyExists checks to see if the base currency of the orderbook map
 // exists
 func BaseCurrencyExists(exchange string, currency currency.Code) bool {
-	m.Lock()
-	defer m.Unlock()
+	m.RLock()
+	defer m.RUnlock()
 	for _, y := range Orderbooks {
 		if y.ExchangeName == exchange {
 			if _, ok := y.Orderbook[currency.Item]; ok {
@@ -125,8 +163,8 @@ func BaseCurrencyExists(exchange string, currency currency.Code) bool {
 // QuoteCurrencyExists checks to see if the quote currency of the orderbook
 // map exists
 func QuoteCurrencyExists(exchange string, p currency.Pair) bool {
-	m.Lock()
-	defer m.Unlock()
+	m.RLock()
+	defer m.RUnlock()
 	for _, y := range Orderbooks {
 		if y.ExchangeName == exchange {
 			if _, ok := y.Orderbook[p.Base.Item]; ok {
@@ -140,24 +178,101 @@ func QuoteCurrencyExists(exchange string, p currency.Pair) bool {
 }
 
 // CreateNewOrderbook creates a new orderbook
-func CreateNewOrderbook(exchangeName string, orderbookNew *Base, orderbookType string) *Orderbook {
+func CreateNewOrderbook(exchangeName string, orderbookNew *Base, orderbookType asset.Item) *Orderbook {
+	d := NewDepth(exchangeName, orderbookNew.Pair, orderbookType)
+	d.Load(orderbookNew)
 	m.Lock()
 	defer m.Unlock()
 	orderbook := Orderbook{}
 	orderbook.ExchangeName = exchangeName
-	orderbook.Orderbook = make(map[*currency.Item]map[*currency.Item]map[string]Base)
-	a := make(map[*currency.Item]map[string]Base)
-	b := make(map[string]Base)
-	b[orderbookType] = *orderbookNew
+	orderbook.Orderbook = make(map[*currency.Item]map[*currency.Item]map[asset.Item]*Depth)
+	a := make(map[*currency.Item]map[asset.Item]*Depth)
+	b := make(map[asset.Item]*Depth)
+	b[orderbookType] = d
 	a[orderbookNew.Pair.Quote.Item] = b
 	orderbook.Orderbook[orderbookNew.Pair.Base.Item] = a
 	Orderbooks = append(Orderbooks, orderbook)
 	return &orderbook
 }
 
+// depth returns the live orderbook of an exchange currency pair and type,
+// creating it when it does not exist
+func depth(exchangeName string, p currency.Pair, orderbookType asset.Item) *Depth {
+	m.RLock()
+	for x := range Orderbooks {
+		if Orderbooks[x].ExchangeName == exchangeName {
+			d, ok := Orderbooks[x].Orderbook[p.Base.Item][p.Quote.Item][orderbookType]
+			if ok {
+				m.RUnlock()
+				return d
+			}
+			break
+		}
+	}
+	m.RUnlock()
+
+	m.Lock()
+	defer m.Unlock()
+	var orderbook *Orderbook
+	for x := range Orderbooks {
+		if Orderbooks[x].ExchangeName == exchangeName {
+			orderbook = &Orderbooks[x]
+			break
+		}
+	}
+	if orderbook == nil {
+		Orderbooks = append(Orderbooks, Orderbook{
+			ExchangeName: exchangeName,
+			Orderbook:    make(map[*currency.Item]map[*currency.Item]map[asset.Item]*Depth),
+		})
+		orderbook = &Orderbooks[len(Orderbooks)-1]
+	}
+	quotes, ok := orderbook.Orderbook[p.Base.Item]
+	if !ok {
+		quotes = make(map[*currency.Item]map[asset.Item]*Depth)
+		orderbook.Orderbook[p.Base.Item] = quotes
+	}
+	a, ok := quotes[p.Quote.Item]
+	if !ok {
+		a = make(map[asset.Item]*Depth)
+		quotes[p.Quote.Item] = a
+	}
+	d, ok := a[orderbookType]
+	if !ok {
+		d = NewDepth(exchangeName, p, orderbookType)
+		a[orderbookType] = d
+	}
+	return d
+}
+
 // Process processes incoming orderbooks, creating or updating the orderbook
-// list
+// list. Only the lock of the orderbook itself is held while its levels are
+// replaced. An orderbook maintained by a websocket feed is left as is, as it
+// is more recent than a REST snapshot
 func (o *Base) Process() error {
+	err := o.validate()
+	if err != nil {
+		return err
+	}
+	depth(o.ExchangeName, o.Pair, o.AssetType).loadUnlessWebsocket(o)
+	return nil
+}
+
+// ProcessWebsocket loads a websocket snapshot into the orderbook list and
+// returns the live orderbook, which REST snapshots no longer replace until
+// the websocket feed releases it
+func (o *Base) ProcessWebsocket() (*Depth, error) {
+	err := o.validate()
+	if err != nil {
+		return nil, err
+	}
+	d := depth(o.ExchangeName, o.Pair, o.AssetType)
+	d.loadWebsocket(o)
+	return d, nil
+}
+
+// validate checks an incoming orderbook and sets its update time if unset
+func (o *Base) validate() error {
 	if o.Pair.IsEmpty() {
 		return errors.New(errPairNotSet)
 	}
@@ -166,31 +281,12 @@ func (o *Base) Process() error {
 		return errors.New(errAssetTypeNotSet)
 	}
 
-	if o.LastUpdated.IsZero() {
-		o.LastUpdated = time.Now()
-	}
-
-	orderbook, err := GetByExchange(o.ExchangeName)
-	if err != nil {
-		CreateNewOrderbook(o.ExchangeName, o, o.AssetType)
-		return nil
+	if !asset.IsValid(o.AssetType) {
+		return asset.ErrNotSupported
 	}
 
-	if BaseCurrencyExists(o.ExchangeName, o.Pair.Base) {
-		m.Lock()
-		a := make(map[string]Base)
-		a[o.AssetType] = *o
-		orderbook.Orderbook[o.Pair.Base.Item][o.Pair.Quote.Item] = a
-		m.Unlock()
-		return nil
+	if o.LastUpdated.IsZero() {
+		o.LastUpdated = time.Now()
 	}
-
-	m.Lock()
-	a := make(map[*currency.Item]map[string]Base)
-	b := make(map[string]Base)
-	b[o.AssetType] = *o
-	a[o.Pair.Quote.Item] = b
-	orderbook.Orderbook[o.Pair.Base.Item] = a
-	m.Unlock()
 	return nil
 }
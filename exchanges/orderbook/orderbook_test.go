@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	log "github.com/thrasher-corp/gocryptotrader/logger"
 )
 
@@ -79,9 +80,9 @@ func TestGetOrderbook(t *testing.T) {
 		Bids: []Item{{Price: 200, Amount: 10}},
 	}
 
-	CreateNewOrderbook("Exchange", &base, Spot)
+	CreateNewOrderbook("Exchange", &base, asset.Spot)
 
-	result, err := Get("Exchange", c, Spot)
+	result, err := Get("Exchange", c, asset.Spot)
 	if err != nil {
 		t.Fatalf("Test failed. TestGetOrderbook failed to get orderbook. Error %s",
 			err)
@@ -90,19 +91,19 @@ func TestGetOrderbook(t *testing.T) {
 		t.Fatal("Test failed. TestGetOrderbook failed. Mismatched pairs")
 	}
 
-	_, err = Get("nonexistent", c, Spot)
+	_, err = Get("nonexistent", c, asset.Spot)
 	if err == nil {
 		t.Fatal("Test failed. TestGetOrderbook retrieved non-existent orderbook")
 	}
 
 	c.Base = currency.NewCode("blah")
-	_, err = Get("Exchange", c, Spot)
+	_, err = Get("Exchange", c, asset.Spot)
 	if err == nil {
 		t.Fatal("Test failed. TestGetOrderbook retrieved non-existent orderbook using invalid first currency")
 	}
 
 	newCurrency := currency.NewPairFromStrings("BTC", "AUD")
-	_, err = Get("Exchange", newCurrency, Spot)
+	_, err = Get("Exchange", newCurrency, asset.Spot)
 	if err == nil {
 		t.Fatal("Test failed. TestGetOrderbook retrieved non-existent orderbook using invalid second currency")
 	}
@@ -116,7 +117,7 @@ func TestGetOrderbookByExchange(t *testing.T) {
 		Bids: []Item{{Price: 200, Amount: 10}},
 	}
 
-	CreateNewOrderbook("Exchange", &base, Spot)
+	CreateNewOrderbook("Exchange", &base, asset.Spot)
 
 	_, err := GetByExchange("Exchange")
 	if err != nil {
@@ -138,7 +139,7 @@ func TestFirstCurrencyExists(t *testing.T) {
 		Bids: []Item{{Price: 200, Amount: 10}},
 	}
 
-	CreateNewOrderbook("Exchange", &base, Spot)
+	CreateNewOrderbook("Exchange", &base, asset.Spot)
 
 	if !BaseCurrencyExists("Exchange", c.Base) {
 		t.Fatal("Test failed. TestFirstCurrencyExists expected first currency doesn't exist")
@@ -158,7 +159,7 @@ func TestSecondCurrencyExists(t *testing.T) {
 		Bids: []Item{{Price: 200, Amount: 10}},
 	}
 
-	CreateNewOrderbook("Exchange", &base, Spot)
+	CreateNewOrderbook("Exchange", &base, asset.Spot)
 
 	if !QuoteCurrencyExists("Exchange", c) {
 		t.Fatal("Test failed. TestSecondCurrencyExists expected first currency doesn't exist")
@@ -178,9 +179,9 @@ func TestCreateNewOrderbook(t *testing.T) {
 		Bids: []Item{{Price: 200, Amount: 10}},
 	}
 
-	CreateNewOrderbook("Exchange", &base, Spot)
+	CreateNewOrderbook("Exchange", &base, asset.Spot)
 
-	result, err := Get("Exchange", c, Spot)
+	result, err := Get("Exchange", c, asset.Spot)
 	if err != nil {
 		t.Fatal("Test failed. TestCreateNewOrderbook failed to create new orderbook")
 	}
@@ -224,12 +225,12 @@ func TestProcessOrderbook(t *testing.T) {
 	}
 
 	// now process a valid orderbook
-	base.AssetType = Spot
+	base.AssetType = asset.Spot
 	err = base.Process()
 	if err != nil {
 		t.Error("unexpcted result: ", err)
 	}
-	result, err := Get("Exchange", c, Spot)
+	result, err := Get("Exchange", c, asset.Spot)
 	if err != nil {
 		t.Fatal("Test failed. TestProcessOrderbook failed to create new orderbook")
 	}
@@ -244,7 +245,7 @@ func TestProcessOrderbook(t *testing.T) {
 	if err != nil {
 		t.Error("Test Failed - Process() error", err)
 	}
-	result, err = Get("Exchange", c, Spot)
+	result, err = Get("Exchange", c, asset.Spot)
 	if err != nil {
 		t.Fatal("Test failed. TestProcessOrderbook failed to retrieve new orderbook")
 	}
@@ -259,7 +260,7 @@ func TestProcessOrderbook(t *testing.T) {
 	if err != nil {
 		t.Error("Test Failed - Process() error", err)
 	}
-	result, err = Get("Exchange", c, Spot)
+	result, err = Get("Exchange", c, asset.Spot)
 	if err != nil {
 		t.Fatal("Test failed. TestProcessOrderbook failed to retrieve new orderbook")
 	}
@@ -267,14 +268,20 @@ func TestProcessOrderbook(t *testing.T) {
 		t.Fatal("Test failed. TestProcessOrderbook result pair is incorrect")
 	}
 
-	base.Asks = []Item{{Price: 200, Amount: 200}}
 	base.AssetType = "monthly"
 	err = base.Process()
+	if err != asset.ErrNotSupported {
+		t.Errorf("Test failed. Expected %s got %v", asset.ErrNotSupported, err)
+	}
+
+	base.Asks = []Item{{Price: 200, Amount: 200}}
+	base.AssetType = asset.Futures
+	err = base.Process()
 	if err != nil {
 		t.Error("Test Failed - Process() error", err)
 	}
 
-	result, err = Get("Exchange", c, "monthly")
+	result, err = Get("Exchange", c, asset.Futures)
 	if err != nil {
 		t.Fatal("Test failed. TestProcessOrderbook failed to retrieve new orderbook")
 	}
@@ -286,13 +293,13 @@ func TestProcessOrderbook(t *testing.T) {
 
 	base.Bids = []Item{{Price: 420, Amount: 200}}
 	base.ExchangeName = "Blah"
-	base.AssetType = "quarterly"
+	base.AssetType = asset.Margin
 	err = base.Process()
 	if err != nil {
 		t.Error("Test Failed - Process() error", err)
 	}
 
-	result, err = Get("Blah", c, "quarterly")
+	result, err = Get("Blah", c, asset.Margin)
 	if err != nil {
 		t.Fatal("Test failed. TestProcessOrderbook failed to create new orderbook")
 	}
@@ -335,7 +342,7 @@ func TestProcessOrderbook(t *testing.T) {
 				Asks:         asks,
 				Bids:         bids,
 				ExchangeName: newName,
-				AssetType:    Spot,
+				AssetType:    asset.Spot,
 			}
 
 			m.Lock()
@@ -362,7 +369,7 @@ func TestProcessOrderbook(t *testing.T) {
 		wg.Add(1)
 		fatalErr := false
 		go func(test quick) {
-			result, err := Get(test.Name, test.P, Spot)
+			result, err := Get(test.Name, test.P, asset.Spot)
 			if err != nil {
 				fatalErr = true
 				return
@@ -0,0 +1,276 @@
+package paper
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	log "github.com/thrasher-corp/gocryptotrader/logger"
+)
+
+// New returns a paper trading exchange which trades the starting balances,
+// keyed by currency code, against the live market data of exch
+func New(exch exchange.IBotExchange, balances map[string]float64) *Exchange {
+	e := &Exchange{
+		IBotExchange: exch,
+		balances:     make(map[string]float64),
+	}
+
+	for code, amount := range balances {
+		e.balances[strings.ToUpper(code)] += amount
+	}
+	return e
+}
+
+// IsPaperTrading returns whether or not the exchange is simulated
+func IsPaperTrading(exch exchange.IBotExchange) bool {
+	_, ok := exch.(*Exchange)
+	return ok
+}
+
+// isOpen returns whether or not the order can still be filled
+func (o *order) isOpen() bool {
+	return o.detail.Status == string(exchange.ActiveOrderStatus) ||
+		o.detail.Status == string(exchange.PartiallyFilledOrderStatus)
+}
+
+// holdCurrency returns the currency an order holds, the quote currency for
+// buys and the base currency for sells
+func (o *order) holdCurrency() string {
+	if o.detail.OrderSide == exchange.BuyOrderSide {
+		return code(o.detail.CurrencyPair.Quote)
+	}
+	return code(o.detail.CurrencyPair.Base)
+}
+
+// code returns the balance key for a currency
+func code(c currency.Code) string {
+	return c.Upper().String()
+}
+
+// findOrder returns the order with the ID
+func (e *Exchange) findOrder(id string) *order {
+	for x := range e.orders {
+		if e.orders[x].detail.ID == id {
+			return e.orders[x]
+		}
+	}
+	return nil
+}
+
+// available returns the balance of the currency not held by open orders
+func (e *Exchange) available(c string) float64 {
+	available := e.balances[c]
+	for x := range e.orders {
+		if e.orders[x].isOpen() && e.orders[x].holdCurrency() == c {
+			available -= e.orders[x].hold
+		}
+	}
+	return available
+}
+
+// fee returns the fee charged by the wrapped exchange for the trade
+func (e *Exchange) fee(p currency.Pair, price, amount float64, isMaker bool) (float64, error) {
+	return e.IBotExchange.GetFeeByType(&exchange.FeeBuilder{
+		FeeType:       exchange.CryptocurrencyTradeFee,
+		IsMaker:       isMaker,
+		PurchasePrice: price,
+		Amount:        amount,
+		Pair:          p,
+	})
+}
+
+// required returns the funds needed to fill the remaining amount of an order
+// at the price
+func (e *Exchange) required(o *order, price float64, isMaker bool) (float64, error) {
+	remaining := o.detail.RemainingAmount
+	if o.detail.OrderSide == exchange.SellOrderSide {
+		return remaining, nil
+	}
+
+	fee, err := e.fee(o.detail.CurrencyPair, price, remaining, isMaker)
+	if err != nil {
+		return 0, err
+	}
+	return remaining*price + fee, nil
+}
+
+// fill fills the amount of the order at the price, releasing the funds held
+// for the filled amount and updating the balances
+func (e *Exchange) fill(o *order, amount, price float64, isMaker bool) error {
+	fee, err := e.fee(o.detail.CurrencyPair, price, amount, isMaker)
+	if err != nil {
+		return err
+	}
+
+	var release float64
+	if o.isOpen() && o.detail.RemainingAmount > 0 {
+		release = o.hold * amount / o.detail.RemainingAmount
+	}
+
+	base := code(o.detail.CurrencyPair.Base)
+	quote := code(o.detail.CurrencyPair.Quote)
+	cost := amount * price
+	if o.detail.OrderSide == exchange.BuyOrderSide {
+		if cost+fee > e.available(quote)+release {
+			return ErrInsufficientFunds
+		}
+		e.balances[quote] -= cost + fee
+		e.balances[base] += amount
+	} else {
+		if amount > e.available(base)+release {
+			return ErrInsufficientFunds
+		}
+		e.balances[base] -= amount
+		e.balances[quote] += cost - fee
+	}
+
+	o.hold -= release
+	o.detail.ExecutedAmount += amount
+	o.detail.RemainingAmount -= amount
+	o.detail.Fee += fee
+	o.detail.Trades = append(o.detail.Trades, exchange.TradeHistory{
+		Timestamp: time.Now(),
+		ID:        fmt.Sprintf("%s-%d", o.detail.ID, len(o.detail.Trades)+1),
+		Price:     price,
+		Amount:    amount,
+		Exchange:  o.detail.Exchange,
+		Side:      o.detail.OrderSide,
+		Type:      string(o.detail.OrderType),
+		Fee:       fee,
+	})
+
+	if o.detail.RemainingAmount <= 0 {
+		o.detail.RemainingAmount = 0
+		o.detail.Status = string(exchange.FilledOrderStatus)
+		o.hold = 0
+		return nil
+	}
+	o.detail.Status = string(exchange.PartiallyFilledOrderStatus)
+	return nil
+}
+
+// depth returns the amount the orderbook can fill for the side without going
+// through the limit price and its volume weighted average price, a zero limit
+// walks the whole side of the orderbook
+func depth(ob *orderbook.Base, side exchange.OrderSide, amount, limit float64) (filled, price float64) {
+	levels := ob.Bids
+	if side == exchange.BuyOrderSide {
+		levels = ob.Asks
+	}
+
+	var total float64
+	for x := range levels {
+		if limit > 0 &&
+			((side == exchange.BuyOrderSide && levels[x].Price > limit) ||
+				(side == exchange.SellOrderSide && levels[x].Price < limit)) {
+			break
+		}
+
+		amt := levels[x].Amount
+		if amt > amount-filled {
+			amt = amount - filled
+		}
+		filled += amt
+		total += amt * levels[x].Price
+		if filled >= amount {
+			break
+		}
+	}
+
+	if filled == 0 {
+		return 0, 0
+	}
+	return filled, total / filled
+}
+
+// match fills the open limit orders for the orderbook pair which the
+// orderbook trades through, each orderbook update is only matched once per
+// order so the same liquidity is not filled twice
+func (e *Exchange) match(ob *orderbook.Base) {
+	for _, o := range e.orders {
+		if !o.isOpen() || !o.detail.CurrencyPair.Equal(ob.Pair) ||
+			o.detail.AssetType != ob.AssetType ||
+			(!ob.LastUpdated.IsZero() && !ob.LastUpdated.After(o.matched)) {
+			continue
+		}
+		o.matched = ob.LastUpdated
+
+		filled, _ := depth(ob, o.detail.OrderSide, o.detail.RemainingAmount,
+			o.detail.Price)
+		if filled == 0 {
+			continue
+		}
+
+		err := e.fill(o, filled, o.detail.Price, true)
+		if err != nil {
+			log.Warnf("%s paper trading order %s cancelled, unable to fill: %s",
+				o.detail.Exchange, o.detail.ID, err)
+			o.detail.Status = string(exchange.CancelledOrderStatus)
+			o.hold = 0
+		}
+	}
+}
+
+// matchAll matches the open orders against the latest orderbooks of their
+// pairs and asset types
+func (e *Exchange) matchAll() {
+	var markets []*order
+	for _, o := range e.orders {
+		if !o.isOpen() {
+			continue
+		}
+
+		found := false
+		for x := range markets {
+			if markets[x].detail.CurrencyPair.Equal(o.detail.CurrencyPair) &&
+				markets[x].detail.AssetType == o.detail.AssetType {
+				found = true
+				break
+			}
+		}
+		if !found {
+			markets = append(markets, o)
+		}
+	}
+
+	for x := range markets {
+		ob, err := e.IBotExchange.GetOrderbookEx(markets[x].detail.CurrencyPair,
+			markets[x].detail.AssetType)
+		if err != nil {
+			continue
+		}
+		e.match(&ob)
+	}
+}
+
+// getOrders returns copies of the open or closed orders matching the request
+func (e *Exchange) getOrders(req *exchange.GetOrdersRequest, open bool) []exchange.OrderDetail {
+	e.matchAll()
+
+	var orders []exchange.OrderDetail
+	for _, o := range e.orders {
+		if o.isOpen() == open {
+			orders = append(orders, o.copyDetail())
+		}
+	}
+
+	if req != nil {
+		exchange.FilterOrdersByType(&orders, req.OrderType)
+		exchange.FilterOrdersBySide(&orders, req.OrderSide)
+		exchange.FilterOrdersByTickRange(&orders, req.StartTicks, req.EndTicks)
+		exchange.FilterOrdersByCurrencies(&orders, req.Currencies)
+	}
+	return orders
+}
+
+// copyDetail returns a copy of the order detail which does not share its
+// trades
+func (o *order) copyDetail() exchange.OrderDetail {
+	d := o.detail
+	d.Trades = append([]exchange.TradeHistory(nil), o.detail.Trades...)
+	return d
+}
@@ -0,0 +1,336 @@
+package paper
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+)
+
+// testExchange serves a fixed orderbook and charges a 1% taker and 0.5% maker
+// fee
+type testExchange struct {
+	exchange.IBotExchange
+	ob    orderbook.Base
+	setup config.ExchangeConfig
+}
+
+func (t *testExchange) GetName() string { return "PaperTest" }
+
+func (t *testExchange) Setup(exch *config.ExchangeConfig) { t.setup = *exch }
+
+func (t *testExchange) UpdateOrderbook(p currency.Pair, a asset.Item) (orderbook.Base, error) {
+	t.ob.Pair = p
+	t.ob.AssetType = a
+	t.ob.LastUpdated = t.ob.LastUpdated.Add(time.Second)
+	return t.ob, nil
+}
+
+func (t *testExchange) GetOrderbookEx(p currency.Pair, a asset.Item) (orderbook.Base, error) {
+	t.ob.Pair = p
+	t.ob.AssetType = a
+	return t.ob, nil
+}
+
+func (t *testExchange) GetFeatures() exchange.Features {
+	return exchange.Features{
+		REST: exchange.ProtocolFeatures{
+			OrderbookFetching: true,
+			CryptoWithdrawal:  true,
+		},
+		Websocket: exchange.ProtocolFeatures{
+			OrderbookFetching: true,
+			SubmitOrder:       true,
+		},
+	}
+}
+
+func (t *testExchange) GetFeeByType(f *exchange.FeeBuilder) (float64, error) {
+	if f.IsMaker {
+		return f.PurchasePrice * f.Amount * 0.005, nil
+	}
+	return f.PurchasePrice * f.Amount * 0.01, nil
+}
+
+var testPair = currency.NewPairFromString("BTC-USD")
+
+func newTestExchange() (*Exchange, *testExchange) {
+	inner := &testExchange{
+		ob: orderbook.Base{
+			Bids: []orderbook.Item{{Price: 99, Amount: 1}, {Price: 98, Amount: 2}},
+			Asks: []orderbook.Item{{Price: 101, Amount: 1}, {Price: 102, Amount: 2}},
+		},
+	}
+	return New(inner, map[string]float64{"usd": 1000, "BTC": 1}), inner
+}
+
+func balance(t *testing.T, e *Exchange, code string) (total, hold float64) {
+	info, err := e.GetAccountInfo()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, c := range info.Accounts[0].Currencies {
+		if c.CurrencyName.String() == code {
+			return c.TotalValue, c.Hold
+		}
+	}
+	return 0, 0
+}
+
+func closeTo(a, b float64) bool {
+	return math.Abs(a-b) < 1e-9
+}
+
+func TestSetup(t *testing.T) {
+	e, inner := newTestExchange()
+	e.Setup(&config.ExchangeConfig{
+		Name:                    "PaperTest",
+		APIKey:                  "key",
+		APISecret:               "secret",
+		AuthenticatedAPISupport: true,
+	})
+
+	if inner.setup.APIKey != "" || inner.setup.APISecret != "" ||
+		inner.setup.AuthenticatedAPISupport || inner.setup.Name != "PaperTest" {
+		t.Errorf("Test failed. Credentials passed to wrapped exchange %+v",
+			inner.setup)
+	}
+
+	if !e.GetAuthenticatedAPISupport(exchange.RestAuthentication) {
+		t.Error("Test failed. Expected authenticated API support")
+	}
+
+	if !IsPaperTrading(e) || IsPaperTrading(inner) {
+		t.Error("Test failed. IsPaperTrading returned an unexpected result")
+	}
+}
+
+func TestSubmitMarketOrder(t *testing.T) {
+	e, _ := newTestExchange()
+
+	_, err := e.SubmitOrder(testPair, asset.Spot, exchange.BuyOrderSide,
+		exchange.LimitOrderType, 1, 0, "")
+	if err != ErrInvalidOrder {
+		t.Errorf("Test failed. Expected %s, received %v", ErrInvalidOrder, err)
+	}
+
+	_, err = e.SubmitOrder(testPair, asset.Spot, exchange.BuyOrderSide,
+		exchange.MarketOrderType, 4, 0, "")
+	if err != ErrInsufficientLiquidity {
+		t.Errorf("Test failed. Expected %s, received %v",
+			ErrInsufficientLiquidity, err)
+	}
+
+	resp, err := e.SubmitOrder(testPair, asset.Spot, exchange.BidOrderSide,
+		exchange.MarketOrderType, 2, 0, "")
+	if err != nil || !resp.IsOrderPlaced {
+		t.Fatalf("Test failed. SubmitOrder: %v %v", resp, err)
+	}
+
+	// Walks 1 at 101 and 1 at 102 for 203 plus a 2.03 taker fee
+	order, err := e.GetOrderInfo(resp.OrderID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if order.Status != string(exchange.FilledOrderStatus) ||
+		order.ExecutedAmount != 2 || !closeTo(order.Fee, 2.03) ||
+		len(order.Trades) != 1 || order.Trades[0].Price != 101.5 {
+		t.Errorf("Test failed. Unexpected order %+v", order)
+	}
+
+	usd, _ := balance(t, e, "USD")
+	btc, _ := balance(t, e, "BTC")
+	if !closeTo(usd, 1000-203-2.03) || btc != 3 {
+		t.Errorf("Test failed. Unexpected balances USD %f BTC %f", usd, btc)
+	}
+
+	_, err = e.SubmitOrder(testPair, asset.Spot, exchange.SellOrderSide,
+		exchange.MarketOrderType, 3.5, 0, "")
+	if err != ErrInsufficientLiquidity {
+		t.Errorf("Test failed. Expected %s, received %v",
+			ErrInsufficientLiquidity, err)
+	}
+
+	_, err = e.SubmitOrder(testPair, asset.Spot, exchange.BuyOrderSide,
+		exchange.MarketOrderType, 3.5, 0, "")
+	if err != ErrInsufficientLiquidity {
+		t.Errorf("Test failed. Expected %s, received %v",
+			ErrInsufficientLiquidity, err)
+	}
+
+	history, err := e.GetOrderHistory(&exchange.GetOrdersRequest{
+		OrderSide: exchange.BuyOrderSide,
+	})
+	if err != nil || len(history) != 3 {
+		t.Errorf("Test failed. Unexpected order history %+v %v", history, err)
+	}
+}
+
+func TestSubmitLimitOrder(t *testing.T) {
+	e, inner := newTestExchange()
+
+	_, err := e.SubmitOrder(testPair, asset.Spot, exchange.BuyOrderSide,
+		exchange.LimitOrderType, 10, 100, "")
+	if err != ErrInsufficientFunds {
+		t.Errorf("Test failed. Expected %s, received %v", ErrInsufficientFunds,
+			err)
+	}
+
+	// Crosses the 101 ask for 1 and rests for 2 more at 101
+	resp, err := e.SubmitOrder(testPair, asset.Spot, exchange.BuyOrderSide,
+		exchange.LimitOrderType, 3, 101, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	active, err := e.GetActiveOrders(&exchange.GetOrdersRequest{
+		Currencies: []currency.Pair{testPair},
+	})
+	if err != nil || len(active) != 1 ||
+		active[0].Status != string(exchange.PartiallyFilledOrderStatus) ||
+		active[0].RemainingAmount != 2 {
+		t.Fatalf("Test failed. Unexpected active orders %+v %v", active, err)
+	}
+
+	// Holds 202 plus a 1.01 maker fee for the remainder
+	usd, hold := balance(t, e, "USD")
+	if !closeTo(usd, 1000-101-1.01) || !closeTo(hold, 203.01) {
+		t.Errorf("Test failed. Unexpected USD balance %f hold %f", usd, hold)
+	}
+
+	inner.ob.Asks = []orderbook.Item{{Price: 100, Amount: 5}}
+	_, err = e.UpdateOrderbook(testPair, asset.Spot)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	order, err := e.GetOrderInfo(resp.OrderID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The remainder fills at the order price as a maker
+	if order.Status != string(exchange.FilledOrderStatus) ||
+		len(order.Trades) != 2 || order.Trades[1].Price != 101 ||
+		!closeTo(order.Trades[1].Fee, 1.01) {
+		t.Errorf("Test failed. Unexpected order %+v", order)
+	}
+
+	usd, hold = balance(t, e, "USD")
+	btc, _ := balance(t, e, "BTC")
+	if !closeTo(usd, 1000-101-1.01-202-1.01) || hold != 0 || btc != 4 {
+		t.Errorf("Test failed. Unexpected balances USD %f hold %f BTC %f", usd,
+			hold, btc)
+	}
+}
+
+func TestModifyAndCancelOrder(t *testing.T) {
+	e, _ := newTestExchange()
+
+	resp, err := e.SubmitOrder(testPair, asset.Spot, exchange.SellOrderSide,
+		exchange.LimitOrderType, 0.5, 110, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = e.ModifyOrder(&exchange.ModifyOrder{OrderID: resp.OrderID,
+		Amount: 2})
+	if err != ErrInsufficientFunds {
+		t.Errorf("Test failed. Expected %s, received %v", ErrInsufficientFunds,
+			err)
+	}
+
+	_, err = e.ModifyOrder(&exchange.ModifyOrder{OrderID: resp.OrderID,
+		Amount: 1, Price: 120})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, hold := balance(t, e, "BTC")
+	if hold != 1 {
+		t.Errorf("Test failed. Expected 1 BTC held, received %f", hold)
+	}
+
+	_, err = e.ModifyOrder(&exchange.ModifyOrder{OrderID: "rawr"})
+	if err != ErrOrderNotFound {
+		t.Errorf("Test failed. Expected %s, received %v", ErrOrderNotFound, err)
+	}
+
+	err = e.CancelOrder(&exchange.OrderCancellation{OrderID: resp.OrderID})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = e.CancelOrder(&exchange.OrderCancellation{OrderID: resp.OrderID})
+	if err != ErrOrderNotOpen {
+		t.Errorf("Test failed. Expected %s, received %v", ErrOrderNotOpen, err)
+	}
+
+	btc, hold := balance(t, e, "BTC")
+	if btc != 1 || hold != 0 {
+		t.Errorf("Test failed. Unexpected BTC balance %f hold %f", btc, hold)
+	}
+
+	for x := 0; x < 2; x++ {
+		_, err = e.SubmitOrder(testPair, asset.Spot, exchange.BuyOrderSide,
+			exchange.LimitOrderType, 1, 50, "")
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	_, err = e.CancelAllOrders(&exchange.OrderCancellation{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	active, err := e.GetActiveOrders(&exchange.GetOrdersRequest{})
+	if err != nil || len(active) != 0 {
+		t.Errorf("Test failed. Unexpected active orders %+v %v", active, err)
+	}
+}
+
+func TestUnsupportedFunctions(t *testing.T) {
+	e, _ := newTestExchange()
+	_, err := e.WithdrawCryptocurrencyFunds(&exchange.WithdrawRequest{})
+	if err == nil {
+		t.Error("Test failed. Expected withdrawals to be unsupported")
+	}
+
+	_, err = e.GetDepositAddress(currency.BTC, "")
+	if err == nil {
+		t.Error("Test failed. Expected deposit addresses to be unsupported")
+	}
+
+	history, err := e.GetFundingHistory()
+	if err != nil || len(history) != 0 {
+		t.Errorf("Test failed. Unexpected funding history %v %v", history, err)
+	}
+}
+
+func TestGetFeatures(t *testing.T) {
+	e, _ := newTestExchange()
+	f := e.GetFeatures()
+	if !f.REST.OrderbookFetching || !f.Websocket.OrderbookFetching {
+		t.Error("Test failed. Expected wrapped exchange market data features")
+	}
+
+	if !f.REST.SubmitOrder || !f.REST.ModifyOrder || !f.REST.AccountInfo {
+		t.Error("Test failed. Expected simulated order features")
+	}
+
+	if f.REST.CryptoWithdrawal || f.Websocket.SubmitOrder {
+		t.Error("Test failed. Expected real account features to be unsupported")
+	}
+
+	if !f.SupportsOrderType(exchange.MarketOrderType) {
+		t.Error("Test failed. Expected market orders to be supported")
+	}
+}
@@ -0,0 +1,41 @@
+package paper
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+)
+
+// accountID is the account ID reported for simulated balances
+const accountID = "paper"
+
+// Error declarations
+var (
+	ErrOrderNotFound         = errors.New("paper trading order not found")
+	ErrOrderNotOpen          = errors.New("paper trading order is not open")
+	ErrInvalidOrder          = errors.New("invalid order, amount must be above zero and limit orders require a price")
+	ErrInsufficientFunds     = errors.New("insufficient paper trading funds")
+	ErrInsufficientLiquidity = errors.New("insufficient orderbook liquidity to fill market order")
+)
+
+// Exchange simulates trading on the wrapped exchange, public market data comes
+// from the wrapped exchange while balances, orders and fills are kept locally
+type Exchange struct {
+	exchange.IBotExchange
+
+	// balances are the total balances keyed by upper case currency code,
+	// including the amounts held for open orders
+	balances map[string]float64
+	orders   []*order
+	nextID   int64
+	m        sync.Mutex
+}
+
+// order is an open or closed simulated order and the funds it holds
+type order struct {
+	detail  exchange.OrderDetail
+	hold    float64
+	matched time.Time
+}
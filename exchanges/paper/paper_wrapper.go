@@ -0,0 +1,349 @@
+package paper
+
+import (
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+)
+
+// Setup sets up the wrapped exchange without API credentials so only its
+// public market data is used
+func (e *Exchange) Setup(exch *config.ExchangeConfig) {
+	cfg := *exch
+	cfg.APIKey = ""
+	cfg.APISecret = ""
+	cfg.ClientID = ""
+	cfg.APIAuthPEMKey = ""
+	cfg.AuthenticatedAPISupport = false
+	cfg.AuthenticatedWebsocketAPISupport = false
+	e.IBotExchange.Setup(&cfg)
+}
+
+// GetAuthenticatedAPISupport returns true as account and order functions are
+// simulated
+func (e *Exchange) GetAuthenticatedAPISupport(_ uint8) bool {
+	return true
+}
+
+// UpdateOrderbook updates the orderbook from the wrapped exchange and fills
+// any open orders it trades through
+func (e *Exchange) UpdateOrderbook(p currency.Pair, assetType asset.Item) (orderbook.Base, error) {
+	ob, err := e.IBotExchange.UpdateOrderbook(p, assetType)
+	if err != nil {
+		return ob, err
+	}
+
+	e.m.Lock()
+	e.match(&ob)
+	e.m.Unlock()
+	return ob, nil
+}
+
+// GetAccountInfo returns the simulated balances, amounts held by open orders
+// are reported as held
+func (e *Exchange) GetAccountInfo() (exchange.AccountInfo, error) {
+	e.m.Lock()
+	defer e.m.Unlock()
+	e.matchAll()
+
+	codes := make([]string, 0, len(e.balances))
+	for c := range e.balances {
+		codes = append(codes, c)
+	}
+	sort.Strings(codes)
+
+	account := exchange.Account{ID: accountID}
+	for _, c := range codes {
+		account.Currencies = append(account.Currencies,
+			exchange.AccountCurrencyInfo{
+				CurrencyName: currency.NewCode(c),
+				TotalValue:   e.balances[c],
+				Hold:         e.balances[c] - e.available(c),
+			})
+	}
+
+	return exchange.AccountInfo{
+		Exchange: e.GetName(),
+		Accounts: []exchange.Account{account},
+	}, nil
+}
+
+// GetFundingHistory returns an empty funding history as simulated balances
+// are never deposited or withdrawn
+func (e *Exchange) GetFundingHistory() ([]exchange.FundHistory, error) {
+	return []exchange.FundHistory{}, nil
+}
+
+// SubmitOrder fills market orders against the live orderbook and places limit
+// orders, filling any part of them which crosses the orderbook immediately
+func (e *Exchange) SubmitOrder(p currency.Pair, assetType asset.Item, side exchange.OrderSide, orderType exchange.OrderType, amount, price float64, clientID string) (exchange.SubmitOrderResponse, error) {
+	var resp exchange.SubmitOrderResponse
+	switch side {
+	case exchange.BidOrderSide:
+		side = exchange.BuyOrderSide
+	case exchange.AskOrderSide:
+		side = exchange.SellOrderSide
+	}
+
+	if amount <= 0 ||
+		(side != exchange.BuyOrderSide && side != exchange.SellOrderSide) ||
+		(orderType != exchange.MarketOrderType && orderType != exchange.LimitOrderType) ||
+		(orderType == exchange.LimitOrderType && price <= 0) {
+		return resp, ErrInvalidOrder
+	}
+
+	ob, err := e.IBotExchange.UpdateOrderbook(p, assetType)
+	if err != nil {
+		return resp, err
+	}
+
+	e.m.Lock()
+	defer e.m.Unlock()
+	e.match(&ob)
+
+	e.nextID++
+	o := &order{
+		detail: exchange.OrderDetail{
+			Exchange:        e.GetName(),
+			AccountID:       accountID,
+			ID:              strconv.FormatInt(e.nextID, 10),
+			CurrencyPair:    p,
+			AssetType:       assetType,
+			OrderSide:       side,
+			OrderType:       orderType,
+			OrderDate:       time.Now(),
+			Status:          string(exchange.ActiveOrderStatus),
+			Price:           price,
+			Amount:          amount,
+			RemainingAmount: amount,
+		},
+		matched: ob.LastUpdated,
+	}
+	e.orders = append(e.orders, o)
+
+	err = e.execute(o, &ob)
+	if err != nil {
+		o.detail.Status = string(exchange.RejectedOrderStatus)
+		o.hold = 0
+		return resp, err
+	}
+
+	resp.IsOrderPlaced = true
+	resp.OrderID = o.detail.ID
+	return resp, nil
+}
+
+// execute fills the part of a new order which crosses the orderbook and holds
+// the funds for the remainder of limit orders
+func (e *Exchange) execute(o *order, ob *orderbook.Base) error {
+	if o.detail.OrderType == exchange.MarketOrderType {
+		filled, avgPrice := depth(ob, o.detail.OrderSide, o.detail.Amount, 0)
+		if filled < o.detail.Amount {
+			return ErrInsufficientLiquidity
+		}
+		return e.fill(o, filled, avgPrice, false)
+	}
+
+	required, err := e.required(o, o.detail.Price, false)
+	if err != nil {
+		return err
+	}
+
+	if required > e.available(o.holdCurrency()) {
+		return ErrInsufficientFunds
+	}
+
+	filled, avgPrice := depth(ob, o.detail.OrderSide, o.detail.Amount,
+		o.detail.Price)
+	if filled > 0 {
+		err = e.fill(o, filled, avgPrice, false)
+		if err != nil {
+			return err
+		}
+	}
+
+	if !o.isOpen() {
+		return nil
+	}
+
+	o.hold, err = e.required(o, o.detail.Price, true)
+	return err
+}
+
+// ModifyOrder changes the price and/or amount of an open limit order, a zero
+// price or amount leaves it unchanged
+func (e *Exchange) ModifyOrder(action *exchange.ModifyOrder) (string, error) {
+	e.m.Lock()
+	defer e.m.Unlock()
+
+	o := e.findOrder(action.OrderID)
+	if o == nil {
+		return "", ErrOrderNotFound
+	}
+
+	if !o.isOpen() {
+		return "", ErrOrderNotOpen
+	}
+
+	modified := *o
+	if action.Price > 0 {
+		modified.detail.Price = action.Price
+	}
+
+	if action.Amount > 0 {
+		if action.Amount <= o.detail.ExecutedAmount {
+			return "", ErrInvalidOrder
+		}
+		modified.detail.Amount = action.Amount
+		modified.detail.RemainingAmount = action.Amount - o.detail.ExecutedAmount
+	}
+
+	hold, err := e.required(&modified, modified.detail.Price, true)
+	if err != nil {
+		return "", err
+	}
+
+	if hold > e.available(o.holdCurrency())+o.hold {
+		return "", ErrInsufficientFunds
+	}
+
+	o.detail.Price = modified.detail.Price
+	o.detail.Amount = modified.detail.Amount
+	o.detail.RemainingAmount = modified.detail.RemainingAmount
+	o.hold = hold
+	// Match the modified order against the current orderbook
+	o.matched = time.Time{}
+	return o.detail.ID, nil
+}
+
+// CancelOrder cancels an open order
+func (e *Exchange) CancelOrder(cancel *exchange.OrderCancellation) error {
+	e.m.Lock()
+	defer e.m.Unlock()
+
+	o := e.findOrder(cancel.OrderID)
+	if o == nil {
+		return ErrOrderNotFound
+	}
+
+	if !o.isOpen() {
+		return ErrOrderNotOpen
+	}
+
+	o.detail.Status = string(exchange.CancelledOrderStatus)
+	o.hold = 0
+	return nil
+}
+
+// CancelAllOrders cancels all open orders, or all open orders for the pair if
+// one is set
+func (e *Exchange) CancelAllOrders(cancel *exchange.OrderCancellation) (exchange.CancelAllOrdersResponse, error) {
+	e.m.Lock()
+	defer e.m.Unlock()
+
+	resp := exchange.CancelAllOrdersResponse{
+		OrderStatus: make(map[string]string),
+	}
+
+	for _, o := range e.orders {
+		if !o.isOpen() || (cancel != nil && !cancel.CurrencyPair.IsEmpty() &&
+			!o.detail.CurrencyPair.Equal(cancel.CurrencyPair)) {
+			continue
+		}
+		o.detail.Status = string(exchange.CancelledOrderStatus)
+		o.hold = 0
+	}
+	return resp, nil
+}
+
+// GetOrderInfo returns the order with the ID
+func (e *Exchange) GetOrderInfo(orderID string) (exchange.OrderDetail, error) {
+	e.m.Lock()
+	defer e.m.Unlock()
+	e.matchAll()
+
+	o := e.findOrder(orderID)
+	if o == nil {
+		return exchange.OrderDetail{}, ErrOrderNotFound
+	}
+	return o.copyDetail(), nil
+}
+
+// GetActiveOrders returns the open orders matching the request
+func (e *Exchange) GetActiveOrders(getOrdersRequest *exchange.GetOrdersRequest) ([]exchange.OrderDetail, error) {
+	e.m.Lock()
+	defer e.m.Unlock()
+	return e.getOrders(getOrdersRequest, true), nil
+}
+
+// GetOrderHistory returns the filled, cancelled and rejected orders matching
+// the request
+func (e *Exchange) GetOrderHistory(getOrdersRequest *exchange.GetOrdersRequest) ([]exchange.OrderDetail, error) {
+	e.m.Lock()
+	defer e.m.Unlock()
+	return e.getOrders(getOrdersRequest, false), nil
+}
+
+// GetDepositAddress is not supported when paper trading
+func (e *Exchange) GetDepositAddress(_ currency.Code, _ string) (string, error) {
+	return "", common.ErrFunctionNotSupported
+}
+
+// WithdrawCryptocurrencyFunds is not supported when paper trading
+func (e *Exchange) WithdrawCryptocurrencyFunds(_ *exchange.WithdrawRequest) (string, error) {
+	return "", common.ErrFunctionNotSupported
+}
+
+// WithdrawFiatFunds is not supported when paper trading
+func (e *Exchange) WithdrawFiatFunds(_ *exchange.WithdrawRequest) (string, error) {
+	return "", common.ErrFunctionNotSupported
+}
+
+// WithdrawFiatFundsToInternationalBank is not supported when paper trading
+func (e *Exchange) WithdrawFiatFundsToInternationalBank(_ *exchange.WithdrawRequest) (string, error) {
+	return "", common.ErrFunctionNotSupported
+}
+
+// AuthenticateWebsocket is not supported when paper trading
+func (e *Exchange) AuthenticateWebsocket() error {
+	return common.ErrFunctionNotSupported
+}
+
+// GetFeatures returns the market data features of the wrapped exchange along
+// with the simulated account and order features
+func (e *Exchange) GetFeatures() exchange.Features {
+	f := e.IBotExchange.GetFeatures()
+	rest := &f.REST
+	rest.AccountInfo = true
+	rest.GetOrder = true
+	rest.ActiveOrders = true
+	rest.OrderHistory = true
+	rest.SubmitOrder = true
+	rest.BatchOrders = false
+	rest.ModifyOrder = true
+	rest.CancelOrder = true
+	rest.CancelOrders = true
+	rest.FundingHistory = true
+	rest.DepositAddress = false
+	rest.CryptoWithdrawal = false
+	rest.FiatWithdrawal = false
+
+	// Websocket account and order updates would come from the real account
+	ws := &f.Websocket
+	ws.AccountInfo = false
+	ws.SubmitOrder = false
+	ws.CancelOrder = false
+	ws.CryptoWithdrawal = false
+	ws.AuthenticatedEndpoints = false
+
+	f.OrderTypes = []exchange.OrderType{exchange.LimitOrderType,
+		exchange.MarketOrderType}
+	return f
+}
@@ -14,8 +14,9 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
-	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/websocket/wshandler"
 	log "github.com/thrasher-corp/gocryptotrader/logger"
 )
@@ -56,8 +57,21 @@ const (
 	poloniexUnauthRate = 6
 
 	poloniexDateLayout = "2006-01-02 15:04:05"
+
+	poloniexTradesLimit = 1000
 )
 
+// poloniexChartPeriods maps candle intervals to the chart periods in seconds
+// Poloniex supports
+var poloniexChartPeriods = map[kline.Interval]string{
+	kline.FiveMin:    "300",
+	kline.FifteenMin: "900",
+	kline.ThirtyMin:  "1800",
+	kline.TwoHour:    "7200",
+	kline.FourHour:   "14400",
+	kline.OneDay:     "86400",
+}
+
 // Poloniex is the overarching type across the poloniex package
 type Poloniex struct {
 	exchange.Base
@@ -71,15 +85,41 @@ func (p *Poloniex) SetDefaults() {
 	p.Fee = 0
 	p.Verbose = false
 	p.RESTPollingDelay = 10
-	p.APIWithdrawPermissions = exchange.AutoWithdrawCryptoWithAPIPermission |
-		exchange.NoFiatWithdrawals
+	p.APIWithdrawPermissions = exchange.AutoWithdrawCryptoWithAPIPermission
 	p.RequestCurrencyPairFormat.Delimiter = "_"
 	p.RequestCurrencyPairFormat.Uppercase = true
 	p.ConfigCurrencyPairFormat.Delimiter = "_"
 	p.ConfigCurrencyPairFormat.Uppercase = true
-	p.AssetTypes = []string{ticker.Spot}
-	p.SupportsAutoPairUpdating = true
-	p.SupportsRESTTickerBatching = true
+	p.AssetTypes = asset.Items{asset.Spot}
+	p.Features = exchange.Features{
+		REST: exchange.ProtocolFeatures{
+			TickerFetching:    true,
+			TickerBatching:    true,
+			OrderbookFetching: true,
+			TradeFetching:     true,
+			HistoricTrades:    true,
+			KlineFetching:     true,
+			AutoPairUpdates:   true,
+			AccountInfo:       true,
+			ActiveOrders:      true,
+			OrderHistory:      true,
+			SubmitOrder:       true,
+			ModifyOrder:       true,
+			CancelOrder:       true,
+			CancelOrders:      true,
+			DepositAddress:    true,
+			CryptoWithdrawal:  true,
+		},
+		Websocket: exchange.ProtocolFeatures{
+			TickerFetching:         true,
+			OrderbookFetching:      true,
+			TradeFetching:          true,
+			Subscribe:              true,
+			Unsubscribe:            true,
+			AuthenticatedEndpoints: true,
+		},
+		OrderTypes: []exchange.OrderType{exchange.LimitOrderType, exchange.MarketOrderType},
+	}
 	p.Requester = request.New(p.Name,
 		request.NewRateLimit(time.Second, poloniexAuthRate),
 		request.NewRateLimit(time.Second, poloniexUnauthRate),
@@ -87,12 +127,7 @@ func (p *Poloniex) SetDefaults() {
 	p.APIUrlDefault = poloniexAPIURL
 	p.APIUrl = p.APIUrlDefault
 	p.Websocket = wshandler.New()
-	p.Websocket.Functionality = wshandler.WebsocketTradeDataSupported |
-		wshandler.WebsocketOrderbookSupported |
-		wshandler.WebsocketTickerSupported |
-		wshandler.WebsocketSubscribeSupported |
-		wshandler.WebsocketUnsubscribeSupported |
-		wshandler.WebsocketAuthenticatedEndpointsSupported
+	p.Websocket.Functionality = p.Features.Websocket.Functionality()
 	p.WebsocketResponseMaxLimit = exchange.DefaultWebsocketResponseMaxLimit
 	p.WebsocketResponseCheckTimeout = exchange.DefaultWebsocketResponseCheckTimeout
 	p.WebsocketOrderbookBufferLimit = exchange.DefaultWebsocketOrderbookBufferLimit
@@ -109,6 +144,7 @@ func (p *Poloniex) Setup(exch *config.ExchangeConfig) {
 		p.SetAPIKeys(exch.APIKey, exch.APISecret, "", false)
 		p.SetHTTPClientTimeout(exch.HTTPTimeout)
 		p.SetHTTPClientUserAgent(exch.HTTPUserAgent)
+		p.SetHTTPRetryPolicy(exch.HTTPRetry)
 		p.RESTPollingDelay = exch.RESTPollingDelay
 		p.Verbose = exch.Verbose
 		p.HTTPDebugging = exch.HTTPDebugging
@@ -9,6 +9,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/sharedtestvalues"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/websocket/wshandler"
 )
@@ -263,8 +264,7 @@ func TestSubmitOrder(t *testing.T) {
 		Quote:     currency.LTC,
 	}
 
-	response, err := p.SubmitOrder(pair,
-		exchange.BuyOrderSide,
+	response, err := p.SubmitOrder(pair, asset.Spot, exchange.BuyOrderSide,
 		exchange.MarketOrderType,
 		1,
 		10,
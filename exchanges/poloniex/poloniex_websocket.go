@@ -12,6 +12,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/websocket/wshandler"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/websocket/wsorderbook"
@@ -151,7 +152,7 @@ func (p *Poloniex) WsHandleData() {
 
 								p.Websocket.DataHandler <- wshandler.WebsocketOrderbookUpdate{
 									Exchange: p.GetName(),
-									Asset:    orderbook.Spot,
+									Asset:    asset.Spot,
 									Pair:     currency.NewPairFromString(currencyPair),
 								}
 							case "o":
@@ -164,7 +165,7 @@ func (p *Poloniex) WsHandleData() {
 
 								p.Websocket.DataHandler <- wshandler.WebsocketOrderbookUpdate{
 									Exchange: p.GetName(),
-									Asset:    orderbook.Spot,
+									Asset:    asset.Spot,
 									Pair:     currency.NewPairFromString(currencyPair),
 								}
 							case "t":
@@ -215,12 +216,21 @@ func (p *Poloniex) wsHandleTickerData(data []interface{}) {
 	t.HighestTradeIn24H, _ = strconv.ParseFloat(tickerData[8].(string), 64)
 	t.LowestTradePrice24H, _ = strconv.ParseFloat(tickerData[9].(string), 64)
 
+	var pair currency.Pair
+	if id, ok := tickerData[0].(float64); ok {
+		pair = currency.NewPairFromString(CurrencyPairID[int(id)])
+	}
+
 	p.Websocket.DataHandler <- wshandler.TickerData{
-		Timestamp: time.Now(),
-		Exchange:  p.GetName(),
-		AssetType: orderbook.Spot,
-		LowPrice:  t.LowestAsk,
-		HighPrice: t.HighestBid,
+		Timestamp:  time.Now(),
+		Exchange:   p.GetName(),
+		AssetType:  asset.Spot,
+		Pair:       pair,
+		ClosePrice: t.LastPrice,
+		LowPrice:   t.LowestTradePrice24H,
+		HighPrice:  t.HighestTradeIn24H,
+		BidPrice:   t.HighestBid,
+		AskPrice:   t.LowestAsk,
 	}
 }
 
@@ -322,7 +332,7 @@ func (p *Poloniex) WsProcessOrderbookSnapshot(ob []interface{}, symbol string) e
 	var newOrderBook orderbook.Base
 	newOrderBook.Asks = asks
 	newOrderBook.Bids = bids
-	newOrderBook.AssetType = orderbook.Spot
+	newOrderBook.AssetType = asset.Spot
 	newOrderBook.Pair = currency.NewPairFromString(symbol)
 
 	return p.Websocket.Orderbook.LoadSnapshot(&newOrderBook, false)
@@ -342,7 +352,7 @@ func (p *Poloniex) WsProcessOrderbookUpdate(sequenceNumber int64, target []inter
 	}
 	update := &wsorderbook.WebsocketOrderbookUpdate{
 		CurrencyPair: cP,
-		AssetType:    orderbook.Spot,
+		AssetType:    asset.Spot,
 		UpdateID:     sequenceNumber,
 	}
 	if sideCheck == 0 {
@@ -1,6 +1,8 @@
 package poloniex
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"strconv"
 	"strings"
@@ -10,6 +12,8 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/websocket/wshandler"
@@ -25,6 +29,14 @@ func (p *Poloniex) Start(wg *sync.WaitGroup) {
 	}()
 }
 
+// WithContext returns a copy of Poloniex whose HTTP requests are abandoned once
+// the context is done
+func (p *Poloniex) WithContext(ctx context.Context) exchange.IBotExchange {
+	cp := *p
+	cp.Base = p.ContextBase(ctx)
+	return &cp
+}
+
 // Run implements the Poloniex wrapper
 func (p *Poloniex) Run() {
 	if p.Verbose {
@@ -58,7 +70,7 @@ func (p *Poloniex) Run() {
 }
 
 // UpdateTicker updates and returns the ticker for a currency pair
-func (p *Poloniex) UpdateTicker(currencyPair currency.Pair, assetType string) (ticker.Price, error) {
+func (p *Poloniex) UpdateTicker(currencyPair currency.Pair, assetType asset.Item) (ticker.Price, error) {
 	var tickerPrice ticker.Price
 	tick, err := p.GetTicker()
 	if err != nil {
@@ -67,7 +79,7 @@ func (p *Poloniex) UpdateTicker(currencyPair currency.Pair, assetType string) (t
 
 	for _, x := range p.GetEnabledCurrencies() {
 		var tp ticker.Price
-		curr := exchange.FormatExchangeCurrency(p.GetName(), x).String()
+		curr := p.FormatExchangeCurrency(x).String()
 		tp.Pair = x
 		tp.Ask = tick[curr].LowestAsk
 		tp.Bid = tick[curr].HighestBid
@@ -85,7 +97,7 @@ func (p *Poloniex) UpdateTicker(currencyPair currency.Pair, assetType string) (t
 }
 
 // GetTickerPrice returns the ticker for a currency pair
-func (p *Poloniex) GetTickerPrice(currencyPair currency.Pair, assetType string) (ticker.Price, error) {
+func (p *Poloniex) GetTickerPrice(currencyPair currency.Pair, assetType asset.Item) (ticker.Price, error) {
 	tickerNew, err := ticker.GetTicker(p.GetName(), currencyPair, assetType)
 	if err != nil {
 		return p.UpdateTicker(currencyPair, assetType)
@@ -94,7 +106,7 @@ func (p *Poloniex) GetTickerPrice(currencyPair currency.Pair, assetType string)
 }
 
 // GetOrderbookEx returns orderbook base on the currency pair
-func (p *Poloniex) GetOrderbookEx(currencyPair currency.Pair, assetType string) (orderbook.Base, error) {
+func (p *Poloniex) GetOrderbookEx(currencyPair currency.Pair, assetType asset.Item) (orderbook.Base, error) {
 	ob, err := orderbook.Get(p.GetName(), currencyPair, assetType)
 	if err != nil {
 		return p.UpdateOrderbook(currencyPair, assetType)
@@ -103,7 +115,7 @@ func (p *Poloniex) GetOrderbookEx(currencyPair currency.Pair, assetType string)
 }
 
 // UpdateOrderbook updates and returns the orderbook for a currency pair
-func (p *Poloniex) UpdateOrderbook(currencyPair currency.Pair, assetType string) (orderbook.Base, error) {
+func (p *Poloniex) UpdateOrderbook(currencyPair currency.Pair, assetType asset.Item) (orderbook.Base, error) {
 	var orderBook orderbook.Base
 	orderbookNew, err := p.GetOrderbook("", 1000)
 	if err != nil {
@@ -111,7 +123,7 @@ func (p *Poloniex) UpdateOrderbook(currencyPair currency.Pair, assetType string)
 	}
 
 	for _, x := range p.GetEnabledCurrencies() {
-		currency := exchange.FormatExchangeCurrency(p.Name, x).String()
+		currency := p.FormatExchangeCurrency(x).String()
 		data, ok := orderbookNew.Data[currency]
 		if !ok {
 			continue
@@ -177,15 +189,135 @@ func (p *Poloniex) GetFundingHistory() ([]exchange.FundHistory, error) {
 	return fundHistory, common.ErrFunctionNotSupported
 }
 
-// GetExchangeHistory returns historic trade data since exchange opening.
-func (p *Poloniex) GetExchangeHistory(currencyPair currency.Pair, assetType string) ([]exchange.TradeHistory, error) {
+// GetExchangeHistory returns the most recent trades for a currency pair
+func (p *Poloniex) GetExchangeHistory(currencyPair currency.Pair, assetType asset.Item) ([]exchange.TradeHistory, error) {
+	trades, err := p.GetTradeHistory(p.FormatExchangeCurrency(currencyPair).String(), "", "")
+	if err != nil {
+		return nil, err
+	}
+	return p.convertTrades(trades)
+}
+
+// GetHistoricTrades returns the trades for a currency pair between the start
+// and end times. Trades are returned newest first so the end time is moved
+// back to the oldest trade after each page
+func (p *Poloniex) GetHistoricTrades(currencyPair currency.Pair, assetType asset.Item, timestampStart, timestampEnd time.Time) ([]exchange.TradeHistory, error) {
+	err := exchange.CheckTimeRange(timestampStart, timestampEnd)
+	if err != nil {
+		return nil, err
+	}
+
+	symbol := p.FormatExchangeCurrency(currencyPair).String()
+	start := strconv.FormatInt(timestampStart.Unix(), 10)
+	end := timestampEnd.Unix()
 	var resp []exchange.TradeHistory
+	for {
+		trades, err := p.GetTradeHistory(symbol, start,
+			strconv.FormatInt(end, 10))
+		if err != nil {
+			return nil, err
+		}
 
-	return resp, common.ErrNotYetImplemented
+		converted, err := p.convertTrades(trades)
+		if err != nil {
+			return nil, err
+		}
+		resp = append(resp, converted...)
+
+		if len(trades) < poloniexTradesLimit {
+			break
+		}
+
+		oldest := converted[len(converted)-1].Timestamp.Unix()
+		if oldest >= end {
+			oldest = end - 1
+		}
+		if oldest < timestampStart.Unix() {
+			break
+		}
+		end = oldest
+	}
+
+	exchange.FilterTradesByTimeRange(&resp, timestampStart, timestampEnd)
+	return resp, nil
+}
+
+// GetHistoricCandles returns candles between the start and end times. Periods
+// Poloniex does not provide are built from historic trades
+func (p *Poloniex) GetHistoricCandles(currencyPair currency.Pair, assetType asset.Item, timestampStart, timestampEnd time.Time, interval kline.Interval) (kline.Item, error) {
+	err := kline.CheckRequest(timestampStart, timestampEnd, interval)
+	if err != nil {
+		return kline.Item{}, err
+	}
+
+	period, ok := poloniexChartPeriods[interval]
+	if !ok {
+		return exchange.CreateKlineFromTrades(p, currencyPair, assetType,
+			timestampStart, timestampEnd, interval)
+	}
+
+	candles, err := p.GetChartData(p.FormatExchangeCurrency(currencyPair).String(),
+		strconv.FormatInt(timestampStart.Truncate(interval.Duration()).Unix(), 10),
+		strconv.FormatInt(timestampEnd.Unix(), 10),
+		period)
+	if err != nil {
+		return kline.Item{}, err
+	}
+
+	item := kline.Item{
+		Exchange:  p.Name,
+		Pair:      currencyPair,
+		AssetType: assetType,
+		Interval:  interval,
+	}
+
+	for i := range candles {
+		if candles[i].Error != "" {
+			return kline.Item{}, errors.New(candles[i].Error)
+		}
+
+		// A single candle with a zero date is returned when there is no data
+		if candles[i].Date == 0 {
+			continue
+		}
+
+		item.Candles = append(item.Candles, kline.Candle{
+			Time:   time.Unix(int64(candles[i].Date), 0),
+			Open:   candles[i].Open,
+			High:   candles[i].High,
+			Low:    candles[i].Low,
+			Close:  candles[i].Close,
+			Volume: candles[i].QuoteVolume,
+		})
+	}
+
+	item.FilterCandles(timestampStart, timestampEnd)
+	return item, nil
+}
+
+func (p *Poloniex) convertTrades(trades []TradeHistory) ([]exchange.TradeHistory, error) {
+	resp := make([]exchange.TradeHistory, len(trades))
+	for i := range trades {
+		t, err := time.Parse(poloniexDateLayout, trades[i].Date)
+		if err != nil {
+			return nil, err
+		}
+
+		resp[i] = exchange.TradeHistory{
+			Timestamp: t,
+			TID:       trades[i].GlobalTradeID,
+			ID:        strconv.FormatInt(trades[i].GlobalTradeID, 10),
+			Price:     trades[i].Rate,
+			Amount:    trades[i].Amount,
+			Exchange:  p.Name,
+			Side:      exchange.OrderSide(strings.ToUpper(trades[i].Type)),
+		}
+	}
+	return resp, nil
 }
 
 // SubmitOrder submits a new order
-func (p *Poloniex) SubmitOrder(currencyPair currency.Pair, side exchange.OrderSide, orderType exchange.OrderType, amount, price float64, _ string) (exchange.SubmitOrderResponse, error) {
+func (p *Poloniex) SubmitOrder(currencyPair currency.Pair, _ asset.Item, side exchange.OrderSide, orderType exchange.OrderType, amount, price float64, _ string) (exchange.SubmitOrderResponse, error) {
 	var submitOrderResponse exchange.SubmitOrderResponse
 	fillOrKill := orderType == exchange.MarketOrderType
 	isBuyOrder := side == exchange.BuyOrderSide
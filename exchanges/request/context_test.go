@@ -0,0 +1,123 @@
+package request
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+// blockingServer holds each request until the client abandons it or the
+// server is closed
+func blockingServer() (*httptest.Server, chan struct{}) {
+	release := make(chan struct{})
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-release:
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte("{}"))
+	})), release
+}
+
+func TestSendPayloadContextDeadline(t *testing.T) {
+	server, release := blockingServer()
+	defer server.Close()
+	defer close(release)
+
+	r := testRequester()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*50)
+	defer cancel()
+
+	start := time.Now()
+	err := r.SendPayloadContext(ctx, http.MethodGet, server.URL, nil, nil, nil,
+		false, false, false, false, false)
+	if err != context.DeadlineExceeded {
+		t.Errorf("Test failed. Expected deadline exceeded got %v", err)
+	}
+
+	if time.Since(start) > time.Second {
+		t.Error("Test failed. Expected the request to be abandoned at the deadline")
+	}
+
+	// A request abandoned by its caller is not a timeout to retry
+	if stats := r.GetRequestStats(); stats.Requests != 1 || stats.Timeouts != 0 {
+		t.Errorf("Test failed. Unexpected stats %+v", stats)
+	}
+}
+
+func TestSendPayloadContextQueued(t *testing.T) {
+	server, release := blockingServer()
+	defer server.Close()
+
+	r := testRequester()
+	r.SetLimiter(false, NewTokenBucket(time.Minute, 1, 1))
+
+	first := make(chan error, 1)
+	go func() {
+		first <- r.SendPayload(http.MethodGet, server.URL, nil, nil, nil, false,
+			false, false, false, false)
+	}()
+
+	for r.GetRequestStats().Requests == 0 {
+		time.Sleep(time.Millisecond)
+	}
+
+	// The second request waits in the queue behind the first, cancelling it
+	// returns straight away and the worker skips it
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(time.Millisecond*50, cancel)
+	err := r.SendPayloadContext(ctx, http.MethodGet, server.URL, nil, nil, nil,
+		false, false, false, false, false)
+	if err != context.Canceled {
+		t.Errorf("Test failed. Expected cancelled got %v", err)
+	}
+
+	close(release)
+	if err = <-first; err != nil {
+		t.Error(err)
+	}
+
+	time.Sleep(time.Millisecond * 50)
+	if stats := r.GetRequestStats(); stats.Requests != 1 {
+		t.Errorf("Test failed. Expected the cancelled job to be skipped %+v",
+			stats)
+	}
+}
+
+func TestCancelRequests(t *testing.T) {
+	server, release := blockingServer()
+	defer server.Close()
+	defer close(release)
+
+	r := testRequester()
+	time.AfterFunc(time.Millisecond*50, r.CancelRequests)
+	err := r.SendPayload(http.MethodGet, server.URL, nil, nil, nil, false,
+		false, false, false, false)
+	if err != context.Canceled {
+		t.Errorf("Test failed. Expected cancelled got %v", err)
+	}
+
+	// Requests sent after cancelling are unaffected
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*50)
+	defer cancel()
+	err = r.SendPayloadContext(ctx, http.MethodGet, server.URL, nil, nil, nil,
+		false, false, false, false, false)
+	if err != context.DeadlineExceeded {
+		t.Errorf("Test failed. Expected deadline exceeded got %v", err)
+	}
+}
+
+func TestSleep(t *testing.T) {
+	if err := sleep(context.Background(), time.Millisecond); err != nil {
+		t.Error(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := sleep(ctx, time.Hour); err != context.Canceled {
+		t.Errorf("Test failed. Expected cancelled got %v", err)
+	}
+}
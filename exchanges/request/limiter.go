@@ -0,0 +1,210 @@
+package request
+
+import (
+	"fmt"
+	"math"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// Limiter throttles the requests sent to an exchange. Each request costs a
+// weight, which is one unless an endpoint defines otherwise. A limiter
+// serves the single worker of a requester, so Delay followed by Take is not
+// raced by other requests
+type Limiter interface {
+	// Delay returns how long to wait until the weight is available
+	Delay(weight int) time.Duration
+	// Take spends the weight on a request
+	Take(weight int)
+	// Update adapts the limiter to the remaining weight reported by the
+	// exchange
+	Update(remaining int)
+	String() string
+}
+
+// HeaderFunc reads the remaining weight of a limiter from the headers of a
+// response, ok is false when the headers do not contain it
+type HeaderFunc func(h http.Header) (remaining int, ok bool)
+
+// Endpoint is the rate limit definition of a request path
+type Endpoint struct {
+	// Weight is the cost of a request to the endpoint against the auth or
+	// unauth limiter and Limiter. A weight of zero makes the endpoint free
+	Weight int
+	// WeightFunc optionally returns the weight of a request in place of
+	// Weight, for endpoints whose weight depends on the request parameters
+	WeightFunc func(req *http.Request) int
+	// Limiter is an optional limiter requests to the endpoint must also
+	// pass, such as an order rate limit
+	Limiter Limiter
+	// Remaining optionally reads the remaining weight of Limiter from
+	// responses
+	Remaining HeaderFunc
+	// Idempotency overrides whether requests to the endpoint can be retried
+	// after they may have reached the exchange
+	Idempotency Idempotency
+}
+
+// TokenBucket is a limiter which holds up to burst tokens and refills at a
+// rate of tokens per interval. Weight is taken from the bucket as requests
+// are sent and requests wait once it has run dry, so bursts can never
+// exceed the bucket size. This matches decaying counter limits such as
+// Kraken's
+type TokenBucket struct {
+	interval time.Duration
+	rate     int
+	burst    int
+	tokens   float64
+	last     time.Time
+	m        sync.Mutex
+}
+
+// NewTokenBucket returns a full token bucket which refills rate tokens per
+// interval and holds at most burst tokens
+func NewTokenBucket(interval time.Duration, rate, burst int) *TokenBucket {
+	if burst < 1 {
+		burst = 1
+	}
+	return &TokenBucket{
+		interval: interval,
+		rate:     rate,
+		burst:    burst,
+		tokens:   float64(burst),
+		last:     time.Now(),
+	}
+}
+
+// refill adds the tokens accrued since the last refill
+func (t *TokenBucket) refill() {
+	now := time.Now()
+	if t.interval > 0 {
+		t.tokens += float64(now.Sub(t.last)) / float64(t.interval) *
+			float64(t.rate)
+		t.tokens = math.Min(t.tokens, float64(t.burst))
+	}
+	t.last = now
+}
+
+// Delay returns how long to wait until the weight is available, a weight
+// larger than the bucket waits for a full bucket
+func (t *TokenBucket) Delay(weight int) time.Duration {
+	t.m.Lock()
+	defer t.m.Unlock()
+	t.refill()
+
+	need := math.Min(float64(weight), float64(t.burst))
+	if t.tokens >= need {
+		return 0
+	}
+
+	if t.rate <= 0 {
+		return t.interval
+	}
+	return time.Duration(math.Ceil((need - t.tokens) / float64(t.rate) *
+		float64(t.interval)))
+}
+
+// Take spends the weight, the bucket can go into debt for weights larger
+// than it can hold
+func (t *TokenBucket) Take(weight int) {
+	t.m.Lock()
+	t.refill()
+	t.tokens -= float64(weight)
+	t.m.Unlock()
+}
+
+// Update lowers the tokens to the remaining weight. Update only ever
+// tightens the limiter as a response can report a remaining weight which is
+// already stale
+func (t *TokenBucket) Update(remaining int) {
+	t.m.Lock()
+	t.refill()
+	t.tokens = math.Min(t.tokens, float64(remaining))
+	t.m.Unlock()
+}
+
+// String returns the limiter in string notation
+func (t *TokenBucket) String() string {
+	return fmt.Sprintf("Token bucket of %d refilling %d per %v", t.burst,
+		t.rate, t.interval)
+}
+
+// WeightedWindow is a limiter which allows a total weight per fixed window
+// of time, matching weight limits such as Binance's
+type WeightedWindow struct {
+	interval time.Duration
+	limit    int
+	used     int
+	start    time.Time
+	m        sync.Mutex
+}
+
+// NewWeightedWindow returns a limiter allowing limit weight per interval
+func NewWeightedWindow(interval time.Duration, limit int) *WeightedWindow {
+	return &WeightedWindow{interval: interval, limit: limit}
+}
+
+// roll starts a new window once the current one has ended
+func (w *WeightedWindow) roll() {
+	if time.Since(w.start) >= w.interval {
+		w.start = time.Now()
+		w.used = 0
+	}
+}
+
+// Delay returns how long to wait until the weight fits in a window, a
+// weight larger than the limit waits for an empty window
+func (w *WeightedWindow) Delay(weight int) time.Duration {
+	w.m.Lock()
+	defer w.m.Unlock()
+	w.roll()
+	if w.used == 0 || w.used+weight <= w.limit {
+		return 0
+	}
+	return w.interval - time.Since(w.start)
+}
+
+// Take spends the weight in the current window
+func (w *WeightedWindow) Take(weight int) {
+	w.m.Lock()
+	w.roll()
+	w.used += weight
+	w.m.Unlock()
+}
+
+// Update raises the used weight of the current window to match the
+// remaining weight. Update only ever tightens the limiter as a response can
+// report a remaining weight which is already stale
+func (w *WeightedWindow) Update(remaining int) {
+	w.m.Lock()
+	w.roll()
+	if used := w.limit - remaining; used > w.used {
+		w.used = used
+	}
+	w.m.Unlock()
+}
+
+// String returns the limiter in string notation
+func (w *WeightedWindow) String() string {
+	return fmt.Sprintf("Weight limit of %d per %v", w.limit, w.interval)
+}
+
+// RemainingHeader returns a HeaderFunc reading the remaining weight from the
+// header key
+func RemainingHeader(key string) HeaderFunc {
+	return func(h http.Header) (int, bool) {
+		remaining, err := strconv.Atoi(h.Get(key))
+		return remaining, err == nil
+	}
+}
+
+// UsedHeader returns a HeaderFunc reading the used weight of the limit from
+// the header key
+func UsedHeader(key string, limit int) HeaderFunc {
+	return func(h http.Header) (int, bool) {
+		used, err := strconv.Atoi(h.Get(key))
+		return limit - used, err == nil
+	}
+}
@@ -0,0 +1,168 @@
+package request
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestTokenBucket(t *testing.T) {
+	b := NewTokenBucket(time.Second, 10, 2)
+	if b.String() != "Token bucket of 2 refilling 10 per 1s" {
+		t.Errorf("Test failed. Unexpected string %s", b.String())
+	}
+
+	if b.Delay(2) != 0 {
+		t.Error("Test failed. Expected a full bucket")
+	}
+
+	b.Take(2)
+	if d := b.Delay(1); d <= 0 || d > time.Millisecond*100 {
+		t.Errorf("Test failed. Unexpected delay %v", d)
+	}
+
+	// A weight larger than the bucket waits for a full bucket and puts the
+	// bucket into debt
+	if d := b.Delay(5); d <= time.Millisecond*100 || d > time.Millisecond*200 {
+		t.Errorf("Test failed. Unexpected delay %v", d)
+	}
+
+	time.Sleep(time.Millisecond * 200)
+	b.Take(5)
+	if d := b.Delay(1); d <= time.Millisecond*300 {
+		t.Errorf("Test failed. Expected the bucket to be in debt, delay %v", d)
+	}
+
+	b = NewTokenBucket(time.Minute, 10, 10)
+	b.Update(20)
+	if b.Delay(10) != 0 {
+		t.Error("Test failed. Update should not loosen the bucket")
+	}
+
+	b.Update(1)
+	if b.Delay(1) != 0 || b.Delay(2) == 0 {
+		t.Error("Test failed. Expected the bucket to be updated")
+	}
+}
+
+func TestWeightedWindow(t *testing.T) {
+	w := NewWeightedWindow(time.Millisecond*100, 10)
+	if w.String() != "Weight limit of 10 per 100ms" {
+		t.Errorf("Test failed. Unexpected string %s", w.String())
+	}
+
+	w.Take(8)
+	if w.Delay(2) != 0 {
+		t.Error("Test failed. Expected weight to fit in the window")
+	}
+
+	if d := w.Delay(3); d <= 0 || d > time.Millisecond*100 {
+		t.Errorf("Test failed. Unexpected delay %v", d)
+	}
+
+	time.Sleep(time.Millisecond * 100)
+	if w.Delay(10) != 0 {
+		t.Error("Test failed. Expected a new window")
+	}
+
+	// An empty window always allows a request
+	if w.Delay(20) != 0 {
+		t.Error("Test failed. Expected an oversized weight to pass an empty window")
+	}
+
+	w.Update(1)
+	if w.Delay(1) != 0 || w.Delay(2) == 0 {
+		t.Error("Test failed. Expected the window to be updated")
+	}
+
+	w.Update(5)
+	if w.Delay(2) == 0 {
+		t.Error("Test failed. Update should not loosen the window")
+	}
+}
+
+func TestHeaderFuncs(t *testing.T) {
+	h := make(http.Header)
+	h.Set("X-Ratelimit-Remaining", "7")
+	h.Set("X-Used-Weight", "30")
+
+	remaining, ok := RemainingHeader("x-ratelimit-remaining")(h)
+	if !ok || remaining != 7 {
+		t.Errorf("Test failed. Unexpected remaining %d %v", remaining, ok)
+	}
+
+	remaining, ok = UsedHeader("X-Used-Weight", 100)(h)
+	if !ok || remaining != 70 {
+		t.Errorf("Test failed. Unexpected remaining %d %v", remaining, ok)
+	}
+
+	if _, ok = RemainingHeader("X-Missing")(h); ok {
+		t.Error("Test failed. Expected missing header to be ignored")
+	}
+}
+
+func TestEndpointLimits(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-Used-Weight", "90")
+		w.Write([]byte("{}"))
+	}))
+	defer server.Close()
+
+	r := New("test", NewRateLimit(time.Second, 0), NewRateLimit(time.Second, 0),
+		new(http.Client))
+	if r.RequiresRateLimiter() {
+		t.Error("Test failed. Expected no rate limiter")
+	}
+
+	// Authenticated and unauthenticated requests share the weight limit
+	weight := NewWeightedWindow(time.Minute, 100)
+	orders := NewWeightedWindow(time.Minute, 1)
+	r.SetLimiter(true, weight)
+	r.SetLimiter(false, weight)
+	r.SetRemainingHeader(false, UsedHeader("X-Used-Weight", 100))
+	r.SetEndpoint("", "/depth", Endpoint{WeightFunc: func(req *http.Request) int {
+		if req.URL.Query().Get("limit") == "1000" {
+			return 5
+		}
+		return 1
+	}})
+	r.SetEndpoint(http.MethodPost, "/order", Endpoint{Weight: 1, Limiter: orders})
+	r.SetEndpoint(http.MethodDelete, "/order", Endpoint{})
+
+	if !r.RequiresRateLimiter() || r.GetLimiter(true) != weight {
+		t.Fatal("Test failed. Expected limiters to be set")
+	}
+
+	err := r.SendPayload(http.MethodGet, server.URL+"/depth?limit=1000", nil, nil, nil,
+		false, false, false, false, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The response reports more weight used than the 5 the requester spent
+	if weight.Delay(10) != 0 || weight.Delay(11) == 0 {
+		t.Error("Test failed. Expected the limiter to adapt to the response")
+	}
+
+	err = r.SendPayload(http.MethodPost, server.URL+"/order", nil, nil, nil,
+		true, false, false, false, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if orders.Delay(1) == 0 || weight.Delay(9) != 0 || weight.Delay(10) == 0 {
+		t.Error("Test failed. Expected order to take from both limiters")
+	}
+
+	err = r.SendPayload(http.MethodDelete, server.URL+"/order", nil, nil, nil,
+		true, false, false, false, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if weight.Delay(9) != 0 {
+		t.Error("Test failed. Expected a free endpoint to take no weight")
+	}
+}
@@ -2,6 +2,7 @@ package request
 
 import (
 	"compress/gzip"
+	"context"
 	"errors"
 	"fmt"
 	"io"
@@ -11,6 +12,7 @@ import (
 	"net/http/httputil"
 	"net/url"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/thrasher-corp/gocryptotrader/common"
@@ -30,29 +32,44 @@ const (
 
 // Requester struct for the request client
 type Requester struct {
-	HTTPClient           *http.Client
-	UnauthLimit          *RateLimit
-	AuthLimit            *RateLimit
-	Name                 string
-	UserAgent            string
-	Cycle                time.Time
-	timeoutRetryAttempts int
-	m                    sync.Mutex
-	Jobs                 chan Job
-	disengage            chan struct{}
-	WorkerStarted        bool
-	Nonce                nonce.Nonce
-	fifoLock             sync.Mutex
-}
-
-// RateLimit struct
+	stats           Stats
+	HTTPClient      *http.Client
+	UnauthLimit     *RateLimit
+	AuthLimit       *RateLimit
+	Name            string
+	UserAgent       string
+	retryPolicy     RetryPolicy
+	pausedUntil     time.Time
+	m               sync.Mutex
+	Jobs            chan Job
+	disengage       chan struct{}
+	WorkerStarted   bool
+	Nonce           nonce.Nonce
+	fifoLock        sync.Mutex
+	authLimiter     Limiter
+	unauthLimiter   Limiter
+	authRemaining   HeaderFunc
+	unauthRemaining HeaderFunc
+	endpoints       map[string]Endpoint
+	ctx             context.Context
+	cancel          context.CancelFunc
+}
+
+// RateLimit defines a token bucket limiter allowing Rate requests per
+// Duration
 type RateLimit struct {
 	Duration time.Duration
 	Rate     int
-	Requests int
 	Mutex    sync.Mutex
 }
 
+// rule is the weight a request takes from a limiter
+type rule struct {
+	limiter   Limiter
+	weight    int
+	remaining HeaderFunc
+}
+
 // JobResult holds a request job result
 type JobResult struct {
 	Error  error
@@ -98,20 +115,6 @@ func (r *RateLimit) SetRate(rate int) {
 	r.Rate = rate
 }
 
-// GetRequests returns the number of requests for the ratelimit
-func (r *RateLimit) GetRequests() int {
-	r.Mutex.Lock()
-	defer r.Mutex.Unlock()
-	return r.Requests
-}
-
-// SetRequests sets requests counter for the rateliit
-func (r *RateLimit) SetRequests(l int) {
-	r.Mutex.Lock()
-	defer r.Mutex.Unlock()
-	r.Requests = l
-}
-
 // SetDuration sets the duration for the ratelimit
 func (r *RateLimit) SetDuration(d time.Duration) {
 	r.Mutex.Lock()
@@ -126,104 +129,200 @@ func (r *RateLimit) GetDuration() time.Duration {
 	return r.Duration
 }
 
-// StartCycle restarts the cycle time and requests counters
-func (r *Requester) StartCycle() {
-	r.Cycle = time.Now()
-	r.AuthLimit.SetRequests(0)
-	r.UnauthLimit.SetRequests(0)
-}
-
-// IsRateLimited returns whether or not the request Requester is rate limited
-func (r *Requester) IsRateLimited(auth bool) bool {
-	if auth {
-		if r.AuthLimit.GetRequests() >= r.AuthLimit.GetRate() && r.IsValidCycle(auth) {
-			return true
-		}
-	} else {
-		if r.UnauthLimit.GetRequests() >= r.UnauthLimit.GetRate() && r.IsValidCycle(auth) {
-			return true
-		}
+// limiter returns the token bucket limiter defined by the RateLimit, which
+// refills Rate tokens per Duration and bursts up to Rate requests, or nil if
+// the rate is zero
+func (r *RateLimit) limiter() Limiter {
+	if r == nil || r.GetRate() <= 0 {
+		return nil
 	}
-	return false
+	return NewTokenBucket(r.GetDuration(), r.GetRate(), r.GetRate())
 }
 
 // RequiresRateLimiter returns whether or not the request Requester requires a rate limiter
 func (r *Requester) RequiresRateLimiter() bool {
-	if r.AuthLimit.GetRate() != 0 || r.UnauthLimit.GetRate() != 0 {
-		return true
+	r.m.Lock()
+	defer r.m.Unlock()
+	return r.authLimiter != nil || r.unauthLimiter != nil || len(r.endpoints) > 0
+}
+
+// SetRateLimit sets the request Requester ratelimiter to a token bucket
+// allowing rate requests per duration, a rate of zero disables it
+func (r *Requester) SetRateLimit(auth bool, duration time.Duration, rate int) {
+	limit := r.GetRateLimit(auth)
+	limit.SetRate(rate)
+	limit.SetDuration(duration)
+	r.SetLimiter(auth, limit.limiter())
+}
+
+// GetRateLimit gets the request Requester ratelimiter
+func (r *Requester) GetRateLimit(auth bool) *RateLimit {
+	if auth {
+		return r.AuthLimit
 	}
-	return false
+	return r.UnauthLimit
 }
 
-// IncrementRequests increments the ratelimiter request counter for either auth or unauth
-// requests
-func (r *Requester) IncrementRequests(auth bool) {
+// SetLimiter sets the limiter of authenticated or unauthenticated requests,
+// the same limiter can be set for both when an exchange limits them
+// together. A nil limiter disables it
+func (r *Requester) SetLimiter(auth bool, l Limiter) {
+	r.m.Lock()
+	defer r.m.Unlock()
 	if auth {
-		reqs := r.AuthLimit.GetRequests()
-		reqs++
-		r.AuthLimit.SetRequests(reqs)
+		r.authLimiter = l
 		return
 	}
-
-	reqs := r.UnauthLimit.GetRequests()
-	reqs++
-	r.UnauthLimit.SetRequests(reqs)
+	r.unauthLimiter = l
 }
 
-// DecrementRequests decrements the ratelimiter request counter for either auth or unauth
+// GetLimiter returns the limiter of authenticated or unauthenticated
 // requests
-func (r *Requester) DecrementRequests(auth bool) {
+func (r *Requester) GetLimiter(auth bool) Limiter {
+	r.m.Lock()
+	defer r.m.Unlock()
 	if auth {
-		reqs := r.AuthLimit.GetRequests()
-		reqs--
-		r.AuthLimit.SetRequests(reqs)
-		return
+		return r.authLimiter
 	}
-
-	reqs := r.AuthLimit.GetRequests()
-	reqs--
-	r.UnauthLimit.SetRequests(reqs)
+	return r.unauthLimiter
 }
 
-// SetRateLimit sets the request Requester ratelimiter
-func (r *Requester) SetRateLimit(auth bool, duration time.Duration, rate int) {
+// SetRemainingHeader sets how the remaining weight of the authenticated or
+// unauthenticated limiter is read from responses, so the limiter adapts to
+// requests made outside of the bot
+func (r *Requester) SetRemainingHeader(auth bool, fn HeaderFunc) {
+	r.m.Lock()
+	defer r.m.Unlock()
 	if auth {
-		r.AuthLimit.SetRate(rate)
-		r.AuthLimit.SetDuration(duration)
+		r.authRemaining = fn
 		return
 	}
-	r.UnauthLimit.SetRate(rate)
-	r.UnauthLimit.SetDuration(duration)
+	r.unauthRemaining = fn
 }
 
-// GetRateLimit gets the request Requester ratelimiter
-func (r *Requester) GetRateLimit(auth bool) *RateLimit {
+// SetEndpoint sets the rate limit definition of requests to the path, an
+// empty method matches requests of any method
+func (r *Requester) SetEndpoint(method, path string, e Endpoint) {
+	r.m.Lock()
+	defer r.m.Unlock()
+	if r.endpoints == nil {
+		r.endpoints = make(map[string]Endpoint)
+	}
+	r.endpoints[method+" "+path] = e
+}
+
+// endpoint returns the definition of the endpoint a request is sent to
+func (r *Requester) endpoint(req *http.Request) (Endpoint, bool) {
+	r.m.Lock()
+	defer r.m.Unlock()
+	e, ok := r.endpoints[req.Method+" "+req.URL.Path]
+	if !ok {
+		e, ok = r.endpoints[" "+req.URL.Path]
+	}
+	return e, ok
+}
+
+// rules returns the limiters and weights a request has to pass
+func (r *Requester) rules(req *http.Request, auth bool) []rule {
+	e, ok := r.endpoint(req)
+
+	r.m.Lock()
+	defer r.m.Unlock()
+	def := rule{limiter: r.unauthLimiter, weight: 1, remaining: r.unauthRemaining}
 	if auth {
-		return r.AuthLimit
+		def.limiter, def.remaining = r.authLimiter, r.authRemaining
+	}
+
+	var rules []rule
+	if ok {
+		def.weight = e.Weight
+		if e.WeightFunc != nil {
+			def.weight = e.WeightFunc(req)
+		}
+	}
+
+	if def.limiter != nil {
+		rules = append(rules, def)
+	}
+
+	if ok && e.Limiter != nil {
+		rules = append(rules, rule{limiter: e.Limiter, weight: def.weight,
+			remaining: e.Remaining})
+	}
+	return rules
+}
+
+// wait blocks until every limiter of the request has its weight available,
+// then takes it
+func (r *Requester) wait(req *http.Request, auth, verbose bool) error {
+	rules := r.rules(req, auth)
+	for {
+		var delay time.Duration
+		for x := range rules {
+			if d := rules[x].limiter.Delay(rules[x].weight); d > delay {
+				delay = d
+			}
+		}
+
+		if delay <= 0 {
+			break
+		}
+
+		if verbose {
+			log.Debugf("%s request. Rate limited! Sleeping for %v", r.Name, delay)
+		}
+
+		err := sleep(req.Context(), delay)
+		if err != nil {
+			return err
+		}
+	}
+
+	for x := range rules {
+		rules[x].limiter.Take(rules[x].weight)
+	}
+	return nil
+}
+
+// updateLimits adapts the limiters of a request to the remaining weight
+// reported in its response headers
+func (r *Requester) updateLimits(req *http.Request, auth bool, h http.Header) {
+	rules := r.rules(req, auth)
+	for x := range rules {
+		if rules[x].remaining == nil {
+			continue
+		}
+
+		if remaining, ok := rules[x].remaining(h); ok {
+			rules[x].limiter.Update(remaining)
+		}
 	}
-	return r.UnauthLimit
 }
 
 // SetTimeoutRetryAttempts sets the amount of times the job will be retried
-// if it times out
+// if it fails, see RetryPolicy for which failures are retried
 func (r *Requester) SetTimeoutRetryAttempts(n int) error {
 	if n < 0 {
 		return errors.New("routines.go error - timeout retry attempts cannot be less than zero")
 	}
-	r.timeoutRetryAttempts = n
+	r.m.Lock()
+	r.retryPolicy.MaxRetries = n
+	r.m.Unlock()
 	return nil
 }
 
 // New returns a new Requester
 func New(name string, authLimit, unauthLimit *RateLimit, httpRequester *http.Client) *Requester {
 	return &Requester{
-		HTTPClient:           httpRequester,
-		UnauthLimit:          unauthLimit,
-		AuthLimit:            authLimit,
-		Name:                 name,
-		Jobs:                 make(chan Job, maxRequestJobs),
-		disengage:            make(chan struct{}, 1),
-		timeoutRetryAttempts: defaultTimeoutRetryAttempts,
+		HTTPClient:    httpRequester,
+		UnauthLimit:   unauthLimit,
+		AuthLimit:     authLimit,
+		Name:          name,
+		Jobs:          make(chan Job, maxRequestJobs),
+		disengage:     make(chan struct{}, 1),
+		retryPolicy:   DefaultRetryPolicy,
+		authLimiter:   authLimit.limiter(),
+		unauthLimiter: unauthLimit.limiter(),
 	}
 }
 
@@ -232,22 +331,6 @@ func IsValidMethod(method string) bool {
 	return common.StringDataCompareInsensitive(supportedMethods, method)
 }
 
-// IsValidCycle checks to see whether the current request cycle is valid or not
-func (r *Requester) IsValidCycle(auth bool) bool {
-	if auth {
-		if time.Since(r.Cycle) < r.AuthLimit.GetDuration() {
-			return true
-		}
-	} else {
-		if time.Since(r.Cycle) < r.UnauthLimit.GetDuration() {
-			return true
-		}
-	}
-
-	r.StartCycle()
-	return false
-}
-
 func (r *Requester) checkRequest(method, path string, body io.Reader, headers map[string]string) (*http.Request, error) {
 	req, err := http.NewRequest(method, path, body)
 	if err != nil {
@@ -265,7 +348,8 @@ func (r *Requester) checkRequest(method, path string, body io.Reader, headers ma
 	return req, nil
 }
 
-// DoRequest performs a HTTP/HTTPS request with the supplied params
+// DoRequest performs a HTTP/HTTPS request with the supplied params, the
+// request is abandoned along with any retries once its context is done
 func (r *Requester) DoRequest(req *http.Request, path string, body io.Reader, result interface{}, authRequest, verbose, httpDebug, httpRecord bool) error {
 	if verbose {
 		log.Debugf("%s exchange request path: %s requires rate limiter: %v",
@@ -280,38 +364,60 @@ func (r *Requester) DoRequest(req *http.Request, path string, body io.Reader, re
 		log.Debugf("%s exchange request body: %v", r.Name, body)
 	}
 
-	var timeoutError error
-	for i := 0; i < r.timeoutRetryAttempts+1; i++ {
+	ctx := req.Context()
+	for attempt := 0; ; attempt++ {
+		if attempt > 0 {
+			err := rewind(req)
+			if err != nil {
+				return err
+			}
+
+			err = r.wait(req, authRequest, verbose)
+			if err != nil {
+				return err
+			}
+		}
+
+		err := r.waitPause(ctx, verbose)
+		if err != nil {
+			return err
+		}
+
+		atomic.AddInt64(&r.stats.Requests, 1)
 		resp, err := r.HTTPClient.Do(req)
 		if err != nil {
+			if ctx.Err() != nil {
+				// The caller gave up on the request, it is not a timeout
+				return ctx.Err()
+			}
+
 			if timeoutErr, ok := err.(net.Error); ok && timeoutErr.Timeout() {
-				if verbose {
-					log.Errorf("%s request has timed-out retrying request, count %d",
-						r.Name,
-						i)
+				delay, retry := r.retryDelay(req, authRequest, failureTimeout, attempt, nil)
+				if !retry {
+					return fmt.Errorf("request.go error - failed to retry request %s: %w",
+						err, ErrOutcomeUnknown)
 				}
-				timeoutError = err
-				continue
-			}
 
-			if r.RequiresRateLimiter() {
-				r.DecrementRequests(authRequest)
+				err = sleep(ctx, delay)
+				if err != nil {
+					return err
+				}
+				continue
 			}
 			return err
 		}
 		if resp == nil {
-			if r.RequiresRateLimiter() {
-				r.DecrementRequests(authRequest)
-			}
 			return errors.New("resp is nil")
 		}
 
+		r.updateLimits(req, authRequest, resp.Header)
+
 		var reader io.ReadCloser
 		switch resp.Header.Get("Content-Encoding") {
 		case "gzip":
 			reader, err = gzip.NewReader(resp.Body)
-			defer reader.Close()
 			if err != nil {
+				resp.Body.Close()
 				return err
 			}
 
@@ -331,6 +437,8 @@ func (r *Requester) DoRequest(req *http.Request, path string, body io.Reader, re
 		}
 
 		contents, err := ioutil.ReadAll(reader)
+		reader.Close()
+		resp.Body.Close()
 		if err != nil {
 			return err
 		}
@@ -344,12 +452,29 @@ func (r *Requester) DoRequest(req *http.Request, path string, body io.Reader, re
 		}
 
 		if resp.StatusCode != 200 && resp.StatusCode != 201 && resp.StatusCode != 202 {
+			failure := classify(resp.StatusCode)
 			err = fmt.Errorf("unsuccessful HTTP status code: %d", resp.StatusCode)
+			if failure == failureUnavailable {
+				err = fmt.Errorf("%s: %w", err, ErrOutcomeUnknown)
+			}
 			if verbose {
-				err = fmt.Errorf("%s\n%s", err.Error(),
+				err = fmt.Errorf("%w\n%s", err,
 					fmt.Sprintf("%s exchange raw response: %s", r.Name, string(contents)))
 			}
 
+			if failure != failureNone {
+				delay, retry := r.retryDelay(req, authRequest, failure, attempt, resp.Header)
+				if retry {
+					// Rate limited requests wait out the pause set for
+					// every request instead
+					if failure != failureRateLimited {
+						if sleep(ctx, delay) != nil {
+							return err
+						}
+					}
+					continue
+				}
+			}
 			return err
 		}
 
@@ -362,7 +487,6 @@ func (r *Requester) DoRequest(req *http.Request, path string, body io.Reader, re
 			log.Debugf("DumpResponse Body (%v):\n %s", path, string(contents))
 		}
 
-		resp.Body.Close()
 		if verbose {
 			log.Debugf("HTTP status: %s, Code: %v", resp.Status, resp.StatusCode)
 			if !httpDebug {
@@ -376,54 +500,37 @@ func (r *Requester) DoRequest(req *http.Request, path string, body io.Reader, re
 
 		return nil
 	}
-	return fmt.Errorf("request.go error - failed to retry request %s",
-		timeoutError)
 }
 
+// worker sends the queued jobs in turn, jobs whose request context is done
+// are skipped without taking from the rate limiters
 func (r *Requester) worker() {
-	for {
-		for x := range r.Jobs {
-			if !r.IsRateLimited(x.AuthRequest) {
-				r.IncrementRequests(x.AuthRequest)
-
-				err := r.DoRequest(x.Request, x.Path, x.Body, x.Result, x.AuthRequest, x.Verbose, x.HTTPDebugging, x.Record)
-				x.JobResult <- &JobResult{
-					Error:  err,
-					Result: x.Result,
-				}
-			} else {
-				limit := r.GetRateLimit(x.AuthRequest)
-				diff := limit.GetDuration() - time.Since(r.Cycle)
-				if x.Verbose {
-					log.Debugf("%s request. Rate limited! Sleeping for %v", r.Name, diff)
-				}
-				time.Sleep(diff)
-
-				for {
-					if r.IsRateLimited(x.AuthRequest) {
-						time.Sleep(time.Millisecond)
-						continue
-					}
-					r.IncrementRequests(x.AuthRequest)
-
-					if x.Verbose {
-						log.Debugf("%s request. No longer rate limited! Doing request", r.Name)
-					}
+	for x := range r.Jobs {
+		err := x.Request.Context().Err()
+		if err == nil {
+			err = r.wait(x.Request, x.AuthRequest, x.Verbose)
+		}
 
-					err := r.DoRequest(x.Request, x.Path, x.Body, x.Result, x.AuthRequest, x.Verbose, x.HTTPDebugging, x.Record)
-					x.JobResult <- &JobResult{
-						Error:  err,
-						Result: x.Result,
-					}
-					break
-				}
-			}
+		if err == nil {
+			err = r.DoRequest(x.Request, x.Path, x.Body, x.Result, x.AuthRequest, x.Verbose, x.HTTPDebugging, x.Record)
+		}
+		x.JobResult <- &JobResult{
+			Error:  err,
+			Result: x.Result,
 		}
 	}
 }
 
 // SendPayload handles sending HTTP/HTTPS requests
 func (r *Requester) SendPayload(method, path string, headers map[string]string, body io.Reader, result interface{}, authRequest, nonceEnabled, verbose, httpDebugging, record bool) error {
+	return r.SendPayloadContext(context.Background(), method, path, headers,
+		body, result, authRequest, nonceEnabled, verbose, httpDebugging, record)
+}
+
+// SendPayloadContext handles sending HTTP/HTTPS requests which are abandoned
+// once the context is done or CancelRequests is called, whether they are
+// queued, waiting on the rate limiter or in flight
+func (r *Requester) SendPayloadContext(ctx context.Context, method, path string, headers map[string]string, body io.Reader, result interface{}, authRequest, nonceEnabled, verbose, httpDebugging, record bool) error {
 	if !nonceEnabled {
 		r.lock()
 	}
@@ -449,6 +556,10 @@ func (r *Requester) SendPayload(method, path string, headers map[string]string,
 		return err
 	}
 
+	ctx, cancel := r.requestContext(ctx)
+	defer cancel()
+	req = req.WithContext(ctx)
+
 	if httpDebugging {
 		dump, err := httputil.DumpRequestOut(req, true)
 		if err != nil {
@@ -469,13 +580,13 @@ func (r *Requester) SendPayload(method, path string, headers map[string]string,
 
 	r.m.Lock()
 	if !r.WorkerStarted {
-		r.StartCycle()
 		r.WorkerStarted = true
 		go r.worker()
 	}
 	r.m.Unlock()
 
-	jobResult := make(chan *JobResult)
+	// The worker must never block on a caller which has given up
+	jobResult := make(chan *JobResult, 1)
 
 	newJob := Job{
 		Request:       req,
@@ -500,13 +611,54 @@ func (r *Requester) SendPayload(method, path string, headers map[string]string,
 	if verbose {
 		log.Debugf("%s request. Waiting for job to complete.", r.Name)
 	}
-	resp := <-newJob.JobResult
+	select {
+	case resp := <-newJob.JobResult:
+		if verbose {
+			log.Debugf("%s request. Job complete.", r.Name)
+		}
+		return resp.Error
+	case <-ctx.Done():
+		if verbose {
+			log.Debugf("%s request. Job abandoned: %s", r.Name, ctx.Err())
+		}
+		return ctx.Err()
+	}
+}
 
-	if verbose {
-		log.Debugf("%s request. Job complete.", r.Name)
+// CancelRequests abandons the requests which are queued or in flight,
+// requests sent afterwards are unaffected
+func (r *Requester) CancelRequests() {
+	if r == nil {
+		return
 	}
 
-	return resp.Error
+	r.m.Lock()
+	if r.cancel != nil {
+		r.cancel()
+	}
+	r.ctx, r.cancel = nil, nil
+	r.m.Unlock()
+}
+
+// requestContext returns the context of a request, which is done once
+// either the caller's context is done or CancelRequests is called
+func (r *Requester) requestContext(parent context.Context) (context.Context, context.CancelFunc) {
+	r.m.Lock()
+	if r.ctx == nil {
+		r.ctx, r.cancel = context.WithCancel(context.Background())
+	}
+	base := r.ctx
+	r.m.Unlock()
+
+	ctx, cancel := context.WithCancel(parent)
+	go func() {
+		select {
+		case <-base.Done():
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+	return ctx, cancel
 }
 
 // GetNonce returns a nonce for requests. This locks and enforces concurrent
@@ -33,94 +33,14 @@ func TestSetDuration(t *testing.T) {
 	}
 }
 
-func TestDecerementRequests(t *testing.T) {
-	r := New("bitfinex", NewRateLimit(time.Second*10, 5), NewRateLimit(time.Second*20, 100), new(http.Client))
-
-	r.AuthLimit.SetRequests(99)
-	r.DecrementRequests(true)
-
-	if r.AuthLimit.GetRequests() != 98 {
-		t.Fatal("unexpected values")
-	}
-}
-func TestStartCycle(t *testing.T) {
-	r := New("bitfinex", NewRateLimit(time.Second*10, 5), NewRateLimit(time.Second*20, 100), new(http.Client))
-
-	if r.AuthLimit.Duration != time.Second*10 && r.AuthLimit.Rate != 5 {
-		t.Fatal("unexpected values")
-	}
-
-	if r.UnauthLimit.Duration != time.Second*20 && r.UnauthLimit.Rate != 100 {
-		t.Fatal("unexpected values")
-	}
-
-	r.AuthLimit.SetRequests(1)
-	r.UnauthLimit.SetRequests(1)
-	r.StartCycle()
-	if r.Cycle.IsZero() || r.AuthLimit.GetRequests() != 0 || r.UnauthLimit.GetRequests() != 0 {
-		t.Fatal("unexpcted values")
-	}
-}
-
-func TestIsRateLimited(t *testing.T) {
-	r := New("bitfinex", NewRateLimit(time.Second*10, 5), NewRateLimit(time.Second*20, 100), new(http.Client))
-	r.StartCycle()
-
-	if r.AuthLimit.ToString() != "Rate limiter set to 5 requests per 10s" {
-		t.Fatal("unexcpted values")
-	}
-
-	if r.UnauthLimit.ToString() != "Rate limiter set to 100 requests per 20s" {
-		t.Fatal("unexpected values")
-	}
-
-	if r.AuthLimit.ToString() != "Rate limiter set to 5 requests per 10s" {
-		t.Fatal("unexcpted values")
-	}
-
-	// FIXME: Need to account for unauth/auth/total requests
-	r.AuthLimit.SetRequests(4)
-	if r.AuthLimit.GetRequests() != 4 {
-		t.Fatal("unexpected values")
-	}
-
-	// test that we're not rate limited since 4 < 5
-	if r.IsRateLimited(true) {
-		t.Fatal("unexpected values")
-	}
-
-	// bump requests counter to 6 which would exceed the rate limiter
-	r.AuthLimit.SetRequests(6)
-	if !r.IsRateLimited(true) {
-		t.Fatal("unexpected values")
-	}
-
-	// FIXME: Need to account for unauth/auth/total requests
-	r.UnauthLimit.SetRequests(99)
-	if r.UnauthLimit.GetRequests() != 99 {
-		t.Fatal("unexpected values")
-	}
-
-	// test that we're not rate limited since 99 < 100
-	if r.IsRateLimited(false) {
-		t.Fatal("unexpected values")
-	}
-
-	// bump requests counter to 100 which would exceed the rate limiter
-	r.UnauthLimit.SetRequests(100)
-	if !r.IsRateLimited(false) {
-		t.Fatal("unexpected values")
-	}
-}
-
 func TestRequiresRateLimiter(t *testing.T) {
 	r := New("bitfinex", NewRateLimit(time.Second*10, 5), NewRateLimit(time.Second*20, 100), new(http.Client))
 	if !r.RequiresRateLimiter() {
 		t.Fatal("unexpected values")
 	}
 
-	r.AuthLimit.Rate = 0
-	r.UnauthLimit.Rate = 0
+	r.SetRateLimit(true, time.Second, 0)
+	r.SetRateLimit(false, time.Second, 0)
 
 	if r.RequiresRateLimiter() {
 		t.Fatal("unexpected values")
@@ -165,31 +85,6 @@ func TestIsValidMethod(t *testing.T) {
 	}
 }
 
-func TestIsValidCycle(t *testing.T) {
-	r := New("bitfinex", NewRateLimit(time.Second*10, 5), NewRateLimit(time.Second*20, 100), new(http.Client))
-	r.Cycle = time.Now().Add(-9 * time.Second)
-
-	if !r.IsValidCycle(true) {
-		t.Fatal("unexpected values")
-	}
-
-	r.Cycle = time.Now().Add(-11 * time.Second)
-	if r.IsValidCycle(true) {
-		t.Fatal("unexpected values")
-	}
-
-	r.Cycle = time.Now().Add(-19 * time.Second)
-
-	if !r.IsValidCycle(false) {
-		t.Fatal("unexpected values")
-	}
-
-	r.Cycle = time.Now().Add(-21 * time.Second)
-	if r.IsValidCycle(false) {
-		t.Fatal("unexpected values")
-	}
-}
-
 func TestCheckRequest(t *testing.T) {
 	r := New("", NewRateLimit(time.Second*10, 5), NewRateLimit(time.Second*20, 100), new(http.Client))
 	_, err := r.checkRequest("bad method, bad", "http://www.google.com", nil, nil)
@@ -244,23 +139,12 @@ func TestDoRequest(t *testing.T) {
 
 	r.SetRateLimit(false, time.Millisecond*200, 100)
 	r.SetRateLimit(true, time.Millisecond*100, 100)
-	r.Cycle = time.Now().Add(time.Millisecond * -201)
-
-	if r.IsValidCycle(false) {
-		t.Fatal("unexepcted values")
-	}
 
 	err = r.SendPayload(http.MethodGet, "https://www.google.com", nil, nil, nil, false, false, true, false, false)
 	if err != nil {
 		t.Fatal("unexpected values")
 	}
 
-	r.Cycle = time.Now().Add(time.Millisecond * -101)
-
-	if r.IsValidCycle(true) {
-		t.Fatal("unexepcted values")
-	}
-
 	err = r.SendPayload(http.MethodGet, "https://www.google.com", nil, nil, nil, true, false, true, false, false)
 	if err != nil {
 		t.Fatal("unexpected values")
@@ -279,8 +163,6 @@ func TestDoRequest(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	r.StartCycle()
-	r.UnauthLimit.SetRequests(100)
 	err = r.SendPayload(http.MethodGet, "https://www.google.com", nil, nil, result, false, false, false, false, false)
 	if err != nil {
 		t.Fatal("unexpected values")
@@ -0,0 +1,313 @@
+package request
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	log "github.com/thrasher-corp/gocryptotrader/logger"
+)
+
+// Idempotency determines whether a request can be sent again after it may
+// have already reached the exchange
+type Idempotency uint8
+
+// Idempotency values, by default unauthenticated GET, HEAD, OPTIONS, PUT and
+// DELETE requests are idempotent. POST requests and authenticated requests
+// are not, as several exchanges place orders over authenticated GET, and
+// have to opt in per endpoint
+const (
+	DefaultIdempotency Idempotency = iota
+	Idempotent
+	NotIdempotent
+)
+
+const (
+	defaultMinBackoff = time.Millisecond * 250
+	defaultMaxBackoff = time.Second * 30
+)
+
+// ErrOutcomeUnknown is wrapped by the errors of requests which may have been
+// processed by the exchange, such as those which timed out or were answered
+// with HTTP 502, 503 or 504
+var ErrOutcomeUnknown = errors.New("request outcome is unknown")
+
+// DefaultRetryPolicy is the retry policy requesters start with
+var DefaultRetryPolicy = RetryPolicy{
+	MaxRetries: defaultTimeoutRetryAttempts,
+	MinBackoff: defaultMinBackoff,
+	MaxBackoff: defaultMaxBackoff,
+}
+
+// RetryPolicy determines how failed requests are retried. Requests rejected
+// with HTTP 429 were not processed by the exchange and are always retried
+// after the Retry-After duration. Timeouts and HTTP 502, 503 and 504
+// responses may have been processed, so only idempotent requests are retried
+// after them. HTTP 418 means the exchange has banned the client, it pauses
+// all requests for the Retry-After duration and is never retried
+type RetryPolicy struct {
+	// MaxRetries is the number of times a request is retried, zero disables
+	// retries
+	MaxRetries int
+	// MinBackoff is the backoff before the first retry, it doubles with each
+	// retry and is jittered by up to half
+	MinBackoff time.Duration
+	// MaxBackoff caps the backoff, requests are not retried when the
+	// exchange asks to wait longer than it
+	MaxBackoff time.Duration
+}
+
+// Stats counts the requests sent by a requester and the retry decisions
+// made
+type Stats struct {
+	// Requests is the number of requests sent including retries
+	Requests int64 `json:"requests"`
+	// Retries is the number of requests which were retried
+	Retries int64 `json:"retries"`
+	// Timeouts is the number of requests which timed out
+	Timeouts int64 `json:"timeouts"`
+	// RateLimited is the number of HTTP 429 and 418 responses
+	RateLimited int64 `json:"rateLimited"`
+	// Unavailable is the number of HTTP 502, 503 and 504 responses
+	Unavailable int64 `json:"unavailable"`
+	// NotRetried is the number of retryable failures which were returned
+	// instead, as retries ran out or the request is not idempotent
+	NotRetried int64 `json:"notRetried"`
+}
+
+// failure classes of requests which can be retried
+const (
+	failureNone = iota
+	failureTimeout
+	failureRateLimited
+	failureBanned
+	failureUnavailable
+)
+
+// classify returns the failure class of a response status code
+func classify(statusCode int) int {
+	switch statusCode {
+	case http.StatusTooManyRequests:
+		return failureRateLimited
+	case http.StatusTeapot:
+		return failureBanned
+	case http.StatusBadGateway, http.StatusServiceUnavailable,
+		http.StatusGatewayTimeout:
+		return failureUnavailable
+	}
+	return failureNone
+}
+
+// failureName returns the log name of a failure class
+func failureName(failure int) string {
+	switch failure {
+	case failureTimeout:
+		return "timed out"
+	case failureRateLimited:
+		return "was rate limited"
+	case failureBanned:
+		return "was banned"
+	}
+	return "found the exchange unavailable"
+}
+
+// backoff returns the jittered exponential backoff of a retry attempt
+func (p *RetryPolicy) backoff(attempt int) time.Duration {
+	d := p.MinBackoff
+	for x := 0; x < attempt && d < p.MaxBackoff; x++ {
+		d *= 2
+	}
+
+	if d > p.MaxBackoff {
+		d = p.MaxBackoff
+	}
+
+	if d <= 0 {
+		return 0
+	}
+	return d/2 + time.Duration(rand.Int63n(int64(d/2)+1))
+}
+
+// parseRetryAfter returns the duration of a Retry-After header in either
+// seconds or HTTP date form
+func parseRetryAfter(h http.Header) (time.Duration, bool) {
+	v := h.Get("Retry-After")
+	if v == "" {
+		return 0, false
+	}
+
+	if secs, err := strconv.Atoi(v); err == nil {
+		return time.Duration(secs) * time.Second, true
+	}
+
+	t, err := http.ParseTime(v)
+	if err != nil {
+		return 0, false
+	}
+	return time.Until(t), true
+}
+
+// SetRetryPolicy sets how failed requests are retried
+func (r *Requester) SetRetryPolicy(p RetryPolicy) {
+	r.m.Lock()
+	r.retryPolicy = p
+	r.m.Unlock()
+}
+
+// GetRetryPolicy returns how failed requests are retried
+func (r *Requester) GetRetryPolicy() RetryPolicy {
+	r.m.Lock()
+	defer r.m.Unlock()
+	return r.retryPolicy
+}
+
+// GetRequestStats returns the request and retry counters of the requester
+func (r *Requester) GetRequestStats() Stats {
+	if r == nil {
+		return Stats{}
+	}
+	return Stats{
+		Requests:    atomic.LoadInt64(&r.stats.Requests),
+		Retries:     atomic.LoadInt64(&r.stats.Retries),
+		Timeouts:    atomic.LoadInt64(&r.stats.Timeouts),
+		RateLimited: atomic.LoadInt64(&r.stats.RateLimited),
+		Unavailable: atomic.LoadInt64(&r.stats.Unavailable),
+		NotRetried:  atomic.LoadInt64(&r.stats.NotRetried),
+	}
+}
+
+// idempotent returns whether the request can be sent again after it may
+// have reached the exchange
+func (r *Requester) idempotent(req *http.Request, authRequest bool) bool {
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		// The body has been consumed and cannot be sent again
+		return false
+	}
+
+	if e, ok := r.endpoint(req); ok && e.Idempotency != DefaultIdempotency {
+		return e.Idempotency == Idempotent
+	}
+
+	if authRequest {
+		return false
+	}
+
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut,
+		http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// pause holds back all requests until the duration has passed
+func (r *Requester) pause(d time.Duration) {
+	r.m.Lock()
+	if until := time.Now().Add(d); until.After(r.pausedUntil) {
+		r.pausedUntil = until
+	}
+	r.m.Unlock()
+}
+
+// waitPause blocks until requests are no longer paused, it returns an error
+// instead when the pause is longer than the maximum backoff
+func (r *Requester) waitPause(ctx context.Context, verbose bool) error {
+	r.m.Lock()
+	d := time.Until(r.pausedUntil)
+	maxBackoff := r.retryPolicy.MaxBackoff
+	r.m.Unlock()
+
+	if d <= 0 {
+		return nil
+	}
+
+	if d > maxBackoff {
+		return fmt.Errorf("%s requests are paused for %v after the exchange rate limited them",
+			r.Name, d.Round(time.Second))
+	}
+
+	if verbose {
+		log.Debugf("%s request. Paused by the exchange! Sleeping for %v", r.Name, d)
+	}
+	return sleep(ctx, d)
+}
+
+// sleep blocks for the duration, it returns the context error instead if the
+// context is done first
+func sleep(ctx context.Context, d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-t.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// retryDelay decides whether a failed request is retried and returns the
+// delay before it is, logging and counting the decision
+func (r *Requester) retryDelay(req *http.Request, authRequest bool, failure, attempt int, h http.Header) (time.Duration, bool) {
+	switch failure {
+	case failureTimeout:
+		atomic.AddInt64(&r.stats.Timeouts, 1)
+	case failureRateLimited, failureBanned:
+		atomic.AddInt64(&r.stats.RateLimited, 1)
+	case failureUnavailable:
+		atomic.AddInt64(&r.stats.Unavailable, 1)
+	}
+
+	policy := r.GetRetryPolicy()
+	delay := policy.backoff(attempt)
+	if failure == failureRateLimited || failure == failureBanned {
+		if after, ok := parseRetryAfter(h); ok && after > delay {
+			delay = after
+		}
+		// Rate limits apply to every request sent, not just this one
+		r.pause(delay)
+	}
+
+	var reason string
+	switch {
+	case failure == failureBanned:
+		reason = "the exchange has banned requests"
+	case attempt >= policy.MaxRetries:
+		reason = "retries are exhausted"
+	case failure != failureRateLimited && !r.idempotent(req, authRequest):
+		reason = "the request is not idempotent"
+	case delay > policy.MaxBackoff:
+		reason = "the exchange asked to wait longer than the maximum backoff"
+	}
+
+	if reason != "" {
+		atomic.AddInt64(&r.stats.NotRetried, 1)
+		log.Warnf("%s request %s %s %s, not retrying as %s", r.Name,
+			req.Method, req.URL.Path, failureName(failure), reason)
+		return 0, false
+	}
+
+	atomic.AddInt64(&r.stats.Retries, 1)
+	log.Warnf("%s request %s %s %s, retrying in %v attempt %d of %d", r.Name,
+		req.Method, req.URL.Path, failureName(failure), delay, attempt+1,
+		policy.MaxRetries)
+	return delay, true
+}
+
+// rewind resets the body of a request so it can be sent again
+func rewind(req *http.Request) error {
+	if req.GetBody == nil {
+		return nil
+	}
+
+	body, err := req.GetBody()
+	if err != nil {
+		return err
+	}
+	req.Body = body
+	return nil
+}
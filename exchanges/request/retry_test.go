@@ -0,0 +1,196 @@
+package request
+
+import (
+	"bytes"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// testServer responds with each status code in turn then with 200
+func testServer(headers map[string]string, statusCodes ...int) (*httptest.Server, *int32) {
+	var calls int32
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&calls, 1)
+		w.Header().Set("Content-Type", "application/json")
+		for k, v := range headers {
+			w.Header().Set(k, v)
+		}
+
+		if int(n) <= len(statusCodes) {
+			w.WriteHeader(statusCodes[n-1])
+		}
+		w.Write([]byte("{}"))
+	})), &calls
+}
+
+func testRequester() *Requester {
+	r := New("test", NewRateLimit(time.Second, 0), NewRateLimit(time.Second, 0),
+		new(http.Client))
+	r.SetRetryPolicy(RetryPolicy{
+		MaxRetries: 2,
+		MinBackoff: time.Millisecond,
+		MaxBackoff: time.Millisecond * 100,
+	})
+	return r
+}
+
+func TestBackoff(t *testing.T) {
+	p := RetryPolicy{MinBackoff: time.Second, MaxBackoff: time.Second * 5}
+	for attempt, max := range []time.Duration{time.Second, time.Second * 2,
+		time.Second * 4, time.Second * 5, time.Second * 5} {
+		d := p.backoff(attempt)
+		if d < max/2 || d > max {
+			t.Errorf("Test failed. Attempt %d expected backoff between %v and %v, received %v",
+				attempt, max/2, max, d)
+		}
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	h := make(http.Header)
+	if _, ok := parseRetryAfter(h); ok {
+		t.Error("Test failed. Expected no Retry-After")
+	}
+
+	h.Set("Retry-After", "120")
+	if d, ok := parseRetryAfter(h); !ok || d != time.Minute*2 {
+		t.Errorf("Test failed. Unexpected Retry-After %v", d)
+	}
+
+	h.Set("Retry-After", time.Now().Add(time.Hour).UTC().Format(http.TimeFormat))
+	if d, ok := parseRetryAfter(h); !ok || d < time.Minute*59 || d > time.Hour {
+		t.Errorf("Test failed. Unexpected Retry-After %v", d)
+	}
+}
+
+func TestRetryUnavailable(t *testing.T) {
+	server, calls := testServer(nil, http.StatusServiceUnavailable,
+		http.StatusBadGateway)
+	defer server.Close()
+
+	r := testRequester()
+	err := r.SendPayload(http.MethodGet, server.URL, nil, nil, nil, false,
+		false, false, false, false)
+	if err != nil || atomic.LoadInt32(calls) != 3 {
+		t.Fatalf("Test failed. Expected idempotent request to be retried, error %v calls %d",
+			err, atomic.LoadInt32(calls))
+	}
+
+	// Order placement must not be sent twice when it may have been processed
+	server, calls = testServer(nil, http.StatusGatewayTimeout)
+	defer server.Close()
+	err = r.SendPayload(http.MethodPost, server.URL, nil,
+		bytes.NewBufferString("order"), nil, false, false, false, false, false)
+	if !errors.Is(err, ErrOutcomeUnknown) || atomic.LoadInt32(calls) != 1 {
+		t.Errorf("Test failed. Expected POST request not to be retried, error %v calls %d",
+			err, atomic.LoadInt32(calls))
+	}
+
+	r.SetEndpoint(http.MethodPost, "/", Endpoint{Idempotency: Idempotent})
+	server, calls = testServer(nil, http.StatusGatewayTimeout)
+	defer server.Close()
+	err = r.SendPayload(http.MethodPost, server.URL+"/", nil,
+		bytes.NewBufferString("query"), nil, false, false, false, false, false)
+	if err != nil || atomic.LoadInt32(calls) != 2 {
+		t.Errorf("Test failed. Expected idempotent POST request to be retried, error %v calls %d",
+			err, atomic.LoadInt32(calls))
+	}
+
+	// Authenticated requests may place orders even over GET
+	server, calls = testServer(nil, http.StatusBadGateway)
+	defer server.Close()
+	err = r.SendPayload(http.MethodGet, server.URL+"/order", nil, nil, nil,
+		true, false, false, false, false)
+	if err == nil || atomic.LoadInt32(calls) != 1 {
+		t.Errorf("Test failed. Expected authenticated request not to be retried, error %v calls %d",
+			err, atomic.LoadInt32(calls))
+	}
+
+	r.SetEndpoint(http.MethodGet, "/balance", Endpoint{Weight: 1, Idempotency: Idempotent})
+	server, calls = testServer(nil, http.StatusBadGateway)
+	defer server.Close()
+	err = r.SendPayload(http.MethodGet, server.URL+"/balance", nil, nil, nil,
+		true, false, false, false, false)
+	if err != nil || atomic.LoadInt32(calls) != 2 {
+		t.Errorf("Test failed. Expected idempotent authenticated request to be retried, error %v calls %d",
+			err, atomic.LoadInt32(calls))
+	}
+
+	stats := r.GetRequestStats()
+	if stats.Requests != 9 || stats.Retries != 4 || stats.Unavailable != 6 ||
+		stats.NotRetried != 2 {
+		t.Errorf("Test failed. Unexpected stats %+v", stats)
+	}
+}
+
+func TestRetryRateLimited(t *testing.T) {
+	server, calls := testServer(map[string]string{"Retry-After": "0"},
+		http.StatusTooManyRequests, http.StatusTooManyRequests,
+		http.StatusTooManyRequests)
+	defer server.Close()
+
+	// Rate limited requests were not processed so order placement is retried
+	r := testRequester()
+	err := r.SendPayload(http.MethodPost, server.URL, nil,
+		bytes.NewBufferString("order"), nil, false, false, false, false, false)
+	if err == nil || atomic.LoadInt32(calls) != 3 {
+		t.Errorf("Test failed. Expected retries to run out, error %v calls %d",
+			err, atomic.LoadInt32(calls))
+	}
+
+	stats := r.GetRequestStats()
+	if stats.RateLimited != 3 || stats.Retries != 2 || stats.NotRetried != 1 {
+		t.Errorf("Test failed. Unexpected stats %+v", stats)
+	}
+}
+
+func TestRetryBanned(t *testing.T) {
+	server, calls := testServer(map[string]string{"Retry-After": "60"},
+		http.StatusTeapot)
+	defer server.Close()
+
+	r := testRequester()
+	err := r.SendPayload(http.MethodGet, server.URL, nil, nil, nil, false,
+		false, false, false, false)
+	if err == nil || atomic.LoadInt32(calls) != 1 {
+		t.Errorf("Test failed. Expected banned request not to be retried, error %v calls %d",
+			err, atomic.LoadInt32(calls))
+	}
+
+	// Every request is held back for the ban
+	err = r.SendPayload(http.MethodGet, server.URL, nil, nil, nil, false,
+		false, false, false, false)
+	if err == nil || atomic.LoadInt32(calls) != 1 {
+		t.Errorf("Test failed. Expected requests to be paused, error %v calls %d",
+			err, atomic.LoadInt32(calls))
+	}
+}
+
+func TestRetryTimeout(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) == 1 {
+			time.Sleep(time.Millisecond * 200)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte("{}"))
+	}))
+	defer server.Close()
+
+	r := testRequester()
+	r.HTTPClient.Timeout = time.Millisecond * 50
+	err := r.SendPayload(http.MethodGet, server.URL, nil, nil, nil, false,
+		false, false, false, false)
+	if err != nil || atomic.LoadInt32(&calls) != 2 {
+		t.Errorf("Test failed. Expected timed out request to be retried, error %v calls %d",
+			err, atomic.LoadInt32(&calls))
+	}
+
+	if stats := r.GetRequestStats(); stats.Timeouts != 1 || stats.Retries != 1 {
+		t.Errorf("Test failed. Unexpected stats %+v", stats)
+	}
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 )
 
 // const values for the ticker package
@@ -17,8 +18,6 @@ const (
 	errAssetTypeNotSet        = "ticker asset type not set"
 	errBaseCurrencyNotFound   = "ticker base currency not found"
 	errQuoteCurrencyNotFound  = "ticker quote currency not found"
-
-	Spot = "SPOT"
 )
 
 // Vars for the ticker package
@@ -42,12 +41,12 @@ type Price struct {
 
 // Ticker struct holds the ticker information for a currency pair and type
 type Ticker struct {
-	Price        map[string]map[string]map[string]Price
+	Price        map[string]map[string]map[asset.Item]Price
 	ExchangeName string
 }
 
 // PriceToString returns the string version of a stored price field
-func (t *Ticker) PriceToString(p currency.Pair, priceType, tickerType string) string {
+func (t *Ticker) PriceToString(p currency.Pair, priceType string, tickerType asset.Item) string {
 	priceType = common.StringToLower(priceType)
 
 	switch priceType {
@@ -71,7 +70,7 @@ func (t *Ticker) PriceToString(p currency.Pair, priceType, tickerType string) st
 }
 
 // GetTicker checks and returns a requested ticker if it exists
-func GetTicker(exchange string, p currency.Pair, tickerType string) (Price, error) {
+func GetTicker(exchange string, p currency.Pair, tickerType asset.Item) (Price, error) {
 	ticker, err := GetTickerByExchange(exchange)
 	if err != nil {
 		return Price{}, err
@@ -133,14 +132,14 @@ func QuoteCurrencyExists(exchange string, p currency.Pair) bool {
 }
 
 // CreateNewTicker creates a new Ticker
-func CreateNewTicker(exchangeName string, tickerNew *Price, tickerType string) Ticker {
+func CreateNewTicker(exchangeName string, tickerNew *Price, tickerType asset.Item) Ticker {
 	m.Lock()
 	defer m.Unlock()
 	ticker := Ticker{}
 	ticker.ExchangeName = exchangeName
-	ticker.Price = make(map[string]map[string]map[string]Price)
-	a := make(map[string]map[string]Price)
-	b := make(map[string]Price)
+	ticker.Price = make(map[string]map[string]map[asset.Item]Price)
+	a := make(map[string]map[asset.Item]Price)
+	b := make(map[asset.Item]Price)
 	b[tickerType] = *tickerNew
 	a[tickerNew.Pair.Quote.Upper().String()] = b
 	ticker.Price[tickerNew.Pair.Base.Upper().String()] = a
@@ -150,7 +149,7 @@ func CreateNewTicker(exchangeName string, tickerNew *Price, tickerType string) T
 
 // ProcessTicker processes incoming tickers, creating or updating the Tickers
 // list
-func ProcessTicker(exchangeName string, tickerNew *Price, assetType string) error {
+func ProcessTicker(exchangeName string, tickerNew *Price, assetType asset.Item) error {
 	if tickerNew.Pair.IsEmpty() {
 		return errors.New(errPairNotSet)
 	}
@@ -159,6 +158,10 @@ func ProcessTicker(exchangeName string, tickerNew *Price, assetType string) erro
 		return errors.New(errAssetTypeNotSet)
 	}
 
+	if !asset.IsValid(assetType) {
+		return asset.ErrNotSupported
+	}
+
 	if tickerNew.LastUpdated.IsZero() {
 		tickerNew.LastUpdated = time.Now()
 	}
@@ -171,16 +174,20 @@ func ProcessTicker(exchangeName string, tickerNew *Price, assetType string) erro
 
 	if BaseCurrencyExists(exchangeName, tickerNew.Pair.Base) {
 		m.Lock()
-		a := make(map[string]Price)
+		quotes := ticker.Price[tickerNew.Pair.Base.Upper().String()]
+		a, ok := quotes[tickerNew.Pair.Quote.Upper().String()]
+		if !ok {
+			a = make(map[asset.Item]Price)
+			quotes[tickerNew.Pair.Quote.Upper().String()] = a
+		}
 		a[assetType] = *tickerNew
-		ticker.Price[tickerNew.Pair.Base.Upper().String()][tickerNew.Pair.Quote.Upper().String()] = a
 		m.Unlock()
 		return nil
 	}
 
 	m.Lock()
-	a := make(map[string]map[string]Price)
-	b := make(map[string]Price)
+	a := make(map[string]map[asset.Item]Price)
+	b := make(map[asset.Item]Price)
 	b[assetType] = *tickerNew
 	a[tickerNew.Pair.Quote.Upper().String()] = b
 	ticker.Price[tickerNew.Pair.Base.Upper().String()] = a
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	log "github.com/thrasher-corp/gocryptotrader/logger"
 )
 
@@ -25,30 +26,30 @@ func TestPriceToString(t *testing.T) {
 		PriceATH: 1337,
 	}
 
-	newTicker := CreateNewTicker("ANX", &priceStruct, Spot)
+	newTicker := CreateNewTicker("ANX", &priceStruct, asset.Spot)
 
-	if newTicker.PriceToString(newPair, "last", Spot) != "1200" {
+	if newTicker.PriceToString(newPair, "last", asset.Spot) != "1200" {
 		t.Error("Test Failed - ticker PriceToString last value is incorrect")
 	}
-	if newTicker.PriceToString(newPair, "high", Spot) != "1298" {
+	if newTicker.PriceToString(newPair, "high", asset.Spot) != "1298" {
 		t.Error("Test Failed - ticker PriceToString high value is incorrect")
 	}
-	if newTicker.PriceToString(newPair, "low", Spot) != "1148" {
+	if newTicker.PriceToString(newPair, "low", asset.Spot) != "1148" {
 		t.Error("Test Failed - ticker PriceToString low value is incorrect")
 	}
-	if newTicker.PriceToString(newPair, "bid", Spot) != "1195" {
+	if newTicker.PriceToString(newPair, "bid", asset.Spot) != "1195" {
 		t.Error("Test Failed - ticker PriceToString bid value is incorrect")
 	}
-	if newTicker.PriceToString(newPair, "ask", Spot) != "1220" {
+	if newTicker.PriceToString(newPair, "ask", asset.Spot) != "1220" {
 		t.Error("Test Failed - ticker PriceToString ask value is incorrect")
 	}
-	if newTicker.PriceToString(newPair, "volume", Spot) != "5" {
+	if newTicker.PriceToString(newPair, "volume", asset.Spot) != "5" {
 		t.Error("Test Failed - ticker PriceToString volume value is incorrect")
 	}
-	if newTicker.PriceToString(newPair, "ath", Spot) != "1337" {
+	if newTicker.PriceToString(newPair, "ath", asset.Spot) != "1337" {
 		t.Error("Test Failed - ticker PriceToString ath value is incorrect")
 	}
-	if newTicker.PriceToString(newPair, "obtuse", Spot) != "" {
+	if newTicker.PriceToString(newPair, "obtuse", asset.Spot) != "" {
 		t.Error("Test Failed - ticker PriceToString obtuse value is incorrect")
 	}
 }
@@ -66,12 +67,12 @@ func TestGetTicker(t *testing.T) {
 		PriceATH: 1337,
 	}
 
-	err := ProcessTicker("bitfinex", &priceStruct, Spot)
+	err := ProcessTicker("bitfinex", &priceStruct, asset.Spot)
 	if err != nil {
 		t.Fatal("Test failed. ProcessTicker error", err)
 	}
 
-	tickerPrice, err := GetTicker("bitfinex", newPair, Spot)
+	tickerPrice, err := GetTicker("bitfinex", newPair, asset.Spot)
 	if err != nil {
 		t.Errorf("Test Failed - Ticker GetTicker init error: %s", err)
 	}
@@ -79,31 +80,31 @@ func TestGetTicker(t *testing.T) {
 		t.Error("Test Failed - ticker tickerPrice.CurrencyPair value is incorrect")
 	}
 
-	_, err = GetTicker("blah", newPair, Spot)
+	_, err = GetTicker("blah", newPair, asset.Spot)
 	if err == nil {
 		t.Fatal("Test Failed. TestGetTicker returned nil error on invalid exchange")
 	}
 
 	newPair.Base = currency.ETH
-	_, err = GetTicker("bitfinex", newPair, Spot)
+	_, err = GetTicker("bitfinex", newPair, asset.Spot)
 	if err == nil {
 		t.Fatal("Test Failed. TestGetTicker returned ticker for invalid first currency")
 	}
 
 	btcltcPair := currency.NewPairFromStrings("BTC", "LTC")
-	_, err = GetTicker("bitfinex", btcltcPair, Spot)
+	_, err = GetTicker("bitfinex", btcltcPair, asset.Spot)
 	if err == nil {
 		t.Fatal("Test Failed. TestGetTicker returned ticker for invalid second currency")
 	}
 
 	priceStruct.PriceATH = 9001
 	priceStruct.Pair.Base = currency.ETH
-	err = ProcessTicker("bitfinex", &priceStruct, "futures_3m")
+	err = ProcessTicker("bitfinex", &priceStruct, asset.Futures)
 	if err != nil {
 		t.Fatal("Test failed. ProcessTicker error", err)
 	}
 
-	tickerPrice, err = GetTicker("bitfinex", newPair, "futures_3m")
+	tickerPrice, err = GetTicker("bitfinex", newPair, asset.Futures)
 	if err != nil {
 		t.Errorf("Test Failed - Ticker GetTicker init error: %s", err)
 	}
@@ -126,7 +127,7 @@ func TestGetTickerByExchange(t *testing.T) {
 		PriceATH: 1337,
 	}
 
-	anxTicker := CreateNewTicker("ANX", &priceStruct, Spot)
+	anxTicker := CreateNewTicker("ANX", &priceStruct, asset.Spot)
 	Tickers = append(Tickers, anxTicker)
 
 	tickerPtr, err := GetTickerByExchange("ANX")
@@ -151,7 +152,7 @@ func TestBaseCurrencyExists(t *testing.T) {
 		PriceATH: 1337,
 	}
 
-	alphaTicker := CreateNewTicker("alphapoint", &priceStruct, Spot)
+	alphaTicker := CreateNewTicker("alphapoint", &priceStruct, asset.Spot)
 	Tickers = append(Tickers, alphaTicker)
 
 	if !BaseCurrencyExists("alphapoint", currency.BTC) {
@@ -177,7 +178,7 @@ func TestQuoteCurrencyExists(t *testing.T) {
 		PriceATH: 1337,
 	}
 
-	bitstampTicker := CreateNewTicker("bitstamp", &priceStruct, "SPOT")
+	bitstampTicker := CreateNewTicker("bitstamp", &priceStruct, asset.Spot)
 	Tickers = append(Tickers, bitstampTicker)
 
 	if !QuoteCurrencyExists("bitstamp", newPair) {
@@ -204,7 +205,7 @@ func TestCreateNewTicker(t *testing.T) {
 		PriceATH: 1337,
 	}
 
-	newTicker := CreateNewTicker("ANX", &priceStruct, Spot)
+	newTicker := CreateNewTicker("ANX", &priceStruct, asset.Spot)
 
 	if reflect.ValueOf(newTicker).NumField() != 2 {
 		t.Error("Test Failed - ticker CreateNewTicker struct change/or updated")
@@ -216,31 +217,31 @@ func TestCreateNewTicker(t *testing.T) {
 		t.Error("Test Failed - ticker CreateNewTicker.ExchangeName value is not ANX")
 	}
 
-	if !newTicker.Price[currency.BTC.Upper().String()][currency.USD.Upper().String()][Spot].Pair.Equal(newPair) {
+	if !newTicker.Price[currency.BTC.Upper().String()][currency.USD.Upper().String()][asset.Spot].Pair.Equal(newPair) {
 		t.Error("Test Failed - ticker newTicker.Price[BTC][USD].Pair.Pair().String() value is not expected 'BTCUSD'")
 	}
-	if reflect.TypeOf(newTicker.Price["BTC"]["USD"][Spot].Ask).String() != float64Type {
+	if reflect.TypeOf(newTicker.Price["BTC"]["USD"][asset.Spot].Ask).String() != float64Type {
 		t.Error("Test Failed - ticker newTicker.Price[BTC][USD].Ask value is not a float64")
 	}
-	if reflect.TypeOf(newTicker.Price["BTC"]["USD"][Spot].Bid).String() != float64Type {
+	if reflect.TypeOf(newTicker.Price["BTC"]["USD"][asset.Spot].Bid).String() != float64Type {
 		t.Error("Test Failed - ticker newTicker.Price[BTC][USD].Bid value is not a float64")
 	}
-	if reflect.TypeOf(newTicker.Price["BTC"]["USD"][Spot].Pair).String() != "currency.Pair" {
+	if reflect.TypeOf(newTicker.Price["BTC"]["USD"][asset.Spot].Pair).String() != "currency.Pair" {
 		t.Error("Test Failed - ticker newTicker.Price[BTC][USD].CurrencyPair value is not a currency.Pair")
 	}
-	if reflect.TypeOf(newTicker.Price["BTC"]["USD"][Spot].High).String() != float64Type {
+	if reflect.TypeOf(newTicker.Price["BTC"]["USD"][asset.Spot].High).String() != float64Type {
 		t.Error("Test Failed - ticker newTicker.Price[BTC][USD].High value is not a float64")
 	}
-	if reflect.TypeOf(newTicker.Price["BTC"]["USD"][Spot].Last).String() != float64Type {
+	if reflect.TypeOf(newTicker.Price["BTC"]["USD"][asset.Spot].Last).String() != float64Type {
 		t.Error("Test Failed - ticker newTicker.Price[BTC][USD].Last value is not a float64")
 	}
-	if reflect.TypeOf(newTicker.Price["BTC"]["USD"][Spot].Low).String() != float64Type {
+	if reflect.TypeOf(newTicker.Price["BTC"]["USD"][asset.Spot].Low).String() != float64Type {
 		t.Error("Test Failed - ticker newTicker.Price[BTC][USD].Low value is not a float64")
 	}
-	if reflect.TypeOf(newTicker.Price["BTC"]["USD"][Spot].PriceATH).String() != float64Type {
+	if reflect.TypeOf(newTicker.Price["BTC"]["USD"][asset.Spot].PriceATH).String() != float64Type {
 		t.Error("Test Failed - ticker newTicker.Price[BTC][USD].PriceATH value is not a float64")
 	}
-	if reflect.TypeOf(newTicker.Price["BTC"]["USD"][Spot].Volume).String() != float64Type {
+	if reflect.TypeOf(newTicker.Price["BTC"]["USD"][asset.Spot].Volume).String() != float64Type {
 		t.Error("Test Failed - ticker newTicker.Price[BTC][USD].Volume value is not a float64")
 	}
 }
@@ -260,7 +261,7 @@ func TestProcessTicker(t *testing.T) { // non-appending function to tickers
 	}
 
 	// test for empty pair
-	err := ProcessTicker(exchName, &priceStruct, Spot)
+	err := ProcessTicker(exchName, &priceStruct, asset.Spot)
 	if err == nil {
 		t.Fatal("empty pair should throw an err")
 	}
@@ -272,12 +273,18 @@ func TestProcessTicker(t *testing.T) { // non-appending function to tickers
 		t.Fatal("Test failed. ProcessTicker error cannot be nil")
 	}
 
+	// test for unsupported asset type
+	err = ProcessTicker(exchName, &priceStruct, "futures_3m")
+	if err != asset.ErrNotSupported {
+		t.Fatalf("Test failed. Expected %s got %v", asset.ErrNotSupported, err)
+	}
+
 	// now process a valid ticker
-	err = ProcessTicker(exchName, &priceStruct, Spot)
+	err = ProcessTicker(exchName, &priceStruct, asset.Spot)
 	if err != nil {
 		t.Fatal("Test failed. ProcessTicker error", err)
 	}
-	result, err := GetTicker(exchName, newPair, Spot)
+	result, err := GetTicker(exchName, newPair, asset.Spot)
 	if err != nil {
 		t.Fatal("Test failed. TestProcessTicker failed to create and return a new ticker")
 	}
@@ -288,15 +295,15 @@ func TestProcessTicker(t *testing.T) { // non-appending function to tickers
 	// now test for processing a pair with a different quote currency
 	newPair = currency.NewPairFromStrings("BTC", "AUD")
 	priceStruct.Pair = newPair
-	err = ProcessTicker(exchName, &priceStruct, Spot)
+	err = ProcessTicker(exchName, &priceStruct, asset.Spot)
 	if err != nil {
 		t.Fatal("Test failed. ProcessTicker error", err)
 	}
-	result, err = GetTicker(exchName, newPair, Spot)
+	result, err = GetTicker(exchName, newPair, asset.Spot)
 	if err != nil {
 		t.Fatal("Test failed. TestProcessTicker failed to create and return a new ticker")
 	}
-	result, err = GetTicker(exchName, newPair, Spot)
+	result, err = GetTicker(exchName, newPair, asset.Spot)
 	if err != nil {
 		t.Fatal("Test failed. TestProcessTicker failed to return an existing ticker")
 	}
@@ -304,15 +311,15 @@ func TestProcessTicker(t *testing.T) { // non-appending function to tickers
 	// now test for processing a pair which has a different base currency
 	newPair = currency.NewPairFromStrings("LTC", "AUD")
 	priceStruct.Pair = newPair
-	err = ProcessTicker(exchName, &priceStruct, Spot)
+	err = ProcessTicker(exchName, &priceStruct, asset.Spot)
 	if err != nil {
 		t.Fatal("Test failed. ProcessTicker error", err)
 	}
-	result, err = GetTicker(exchName, newPair, Spot)
+	result, err = GetTicker(exchName, newPair, asset.Spot)
 	if err != nil {
 		t.Fatal("Test failed. TestProcessTicker failed to create and return a new ticker")
 	}
-	result, err = GetTicker(exchName, newPair, Spot)
+	result, err = GetTicker(exchName, newPair, asset.Spot)
 	if err != nil {
 		t.Fatal("Test failed. TestProcessTicker failed to return an existing ticker")
 	}
@@ -348,7 +355,7 @@ func TestProcessTicker(t *testing.T) { // non-appending function to tickers
 			}
 
 			sm.Lock()
-			err = ProcessTicker(newName, &tp, Spot)
+			err = ProcessTicker(newName, &tp, asset.Spot)
 			if err != nil {
 				log.Error(err)
 				catastrophicFailure = true
@@ -371,7 +378,7 @@ func TestProcessTicker(t *testing.T) { // non-appending function to tickers
 		wg.Add(1)
 		fatalErr := false
 		go func(test quick) {
-			result, err := GetTicker(test.Name, test.P, Spot)
+			result, err := GetTicker(test.Name, test.P, asset.Spot)
 			if err != nil {
 				fatalErr = true
 				return
@@ -65,6 +65,14 @@ func (w *Websocket) Setup(connector func() error,
 	return nil
 }
 
+// Reinitialise returns the websocket to its initial state so that the
+// exchange can be set up again, the websocket should be shut down first
+func (w *Websocket) Reinitialise() {
+	w.m.Lock()
+	defer w.m.Unlock()
+	w.init = true
+}
+
 // Connect intiates a websocket connection by using a package defined connection
 // function
 func (w *Websocket) Connect() error {
@@ -674,6 +682,15 @@ func (w *Websocket) SubscribeToChannels(channels []WebsocketChannelSubscription)
 	w.noConnectionChecks = 0
 }
 
+// ClearChannelsToSubscribe removes every channel from channelsToSubscribe so
+// that the exchange generates its subscriptions again from its enabled pairs
+// when it next connects
+func (w *Websocket) ClearChannelsToSubscribe() {
+	w.subscriptionLock.Lock()
+	defer w.subscriptionLock.Unlock()
+	w.channelsToSubscribe = nil
+}
+
 // Equal two WebsocketChannelSubscription to determine equality
 func (w *WebsocketChannelSubscription) Equal(subscribedChannel *WebsocketChannelSubscription) bool {
 	return strings.EqualFold(w.Channel, subscribedChannel.Channel) &&
@@ -342,6 +342,46 @@ func TestResubscribeToChannel(t *testing.T) {
 	w.ResubscribeToChannel(subscription)
 }
 
+// TestReinitialise logic test
+func TestReinitialise(t *testing.T) {
+	w := New()
+	err := w.Setup(func() error { return nil },
+		placeholderSubscriber,
+		placeholderSubscriber,
+		"test",
+		false,
+		false,
+		"",
+		"",
+		false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = w.SetWsStatusAndConnection(false)
+	if err == nil {
+		t.Error("Expected an error setting the same status after setup")
+	}
+
+	w.Reinitialise()
+	err = w.SetWsStatusAndConnection(false)
+	if err != nil {
+		t.Errorf("Expected no error setting the status once reinitialised %s", err)
+	}
+}
+
+// TestClearChannelsToSubscribe logic test
+func TestClearChannelsToSubscribe(t *testing.T) {
+	w := Websocket{}
+	w.SubscribeToChannels([]WebsocketChannelSubscription{
+		{Channel: "hello"},
+	})
+	w.ClearChannelsToSubscribe()
+	if len(w.channelsToSubscribe) != 0 {
+		t.Errorf("Channels to subscribe were not cleared")
+	}
+}
+
 // TestSliceCopyDoesntImpactBoth logic test
 func TestSliceCopyDoesntImpactBoth(t *testing.T) {
 	w := Websocket{
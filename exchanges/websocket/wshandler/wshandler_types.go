@@ -6,6 +6,7 @@ import (
 
 	"github.com/gorilla/websocket"
 	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/websocket/wsorderbook"
 )
 
@@ -124,7 +125,7 @@ type WebsocketResponse struct {
 // has been updated in the orderbook package
 type WebsocketOrderbookUpdate struct {
 	Pair     currency.Pair
-	Asset    string
+	Asset    asset.Item
 	Exchange string
 }
 
@@ -132,7 +133,7 @@ type WebsocketOrderbookUpdate struct {
 type TradeData struct {
 	Timestamp    time.Time
 	CurrencyPair currency.Pair
-	AssetType    string
+	AssetType    asset.Item
 	Exchange     string
 	EventType    string
 	EventTime    int64
@@ -145,20 +146,22 @@ type TradeData struct {
 type TickerData struct {
 	Timestamp  time.Time
 	Pair       currency.Pair
-	AssetType  string
+	AssetType  asset.Item
 	Exchange   string
 	ClosePrice float64
 	Quantity   float64
 	OpenPrice  float64
 	HighPrice  float64
 	LowPrice   float64
+	BidPrice   float64
+	AskPrice   float64
 }
 
 // KlineData defines kline feed
 type KlineData struct {
 	Timestamp  time.Time
 	Pair       currency.Pair
-	AssetType  string
+	AssetType  asset.Item
 	Exchange   string
 	StartTime  time.Time
 	CloseTime  time.Time
@@ -174,7 +177,7 @@ type KlineData struct {
 type WebsocketPositionUpdated struct {
 	Timestamp time.Time
 	Pair      currency.Pair
-	AssetType string
+	AssetType asset.Item
 	Exchange  string
 }
 
@@ -1,12 +1,29 @@
 package wsorderbook
 
 import (
+	"errors"
 	"fmt"
 	"sort"
-	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	log "github.com/thrasher-corp/gocryptotrader/logger"
+)
+
+// Orderbook verification errors
+var (
+	ErrChecksumMismatch = errors.New("orderbook checksum mismatch")
+	ErrSequenceGap      = errors.New("orderbook sequence gap")
+	ErrResyncFailed     = errors.New("orderbook resync failed, resubscribe required")
+)
+
+const (
+	resyncAttempts        = 3
+	defaultResyncBackoff  = time.Second
+	defaultMaxHeldUpdates = 10000
 )
 
 // Setup sets private variables
@@ -19,11 +36,34 @@ func (w *WebsocketOrderbookLocal) Setup(obBufferLimit int, bufferEnabled, sortBu
 	w.exchangeName = exchangeName
 }
 
+// SetupVerification sets the checksum function used to verify orderbook
+// updates which carry a checksum, and the REST snapshot function used to
+// resync an orderbook which fails its checksum or sequence verification.
+// Either may be nil
+func (w *WebsocketOrderbookLocal) SetupVerification(checksum ChecksumFunc, snapshot SnapshotFunc) {
+	w.m.Lock()
+	w.checksum = checksum
+	w.snapshot = snapshot
+	w.m.Unlock()
+}
+
+// SetMaxDepth truncates orderbooks to depth levels a side after each update,
+// for exchanges which publish a fixed number of levels and expect the levels
+// falling out of it to be dropped. Zero keeps every level
+func (w *WebsocketOrderbookLocal) SetMaxDepth(depth int) {
+	w.m.Lock()
+	w.maxDepth = depth
+	w.m.Unlock()
+}
+
 // Update updates a local cache using bid targets and ask targets then updates
 // main orderbook
 // Volume == 0; deletion at price target
 // Price target not found; append of price target
 // Price target found; amend volume of price target
+// Updates with sequence numbers must follow the previous update and updates
+// with a checksum must match the updated orderbook, otherwise the orderbook
+// is dropped and resynced from a REST snapshot in the background
 func (w *WebsocketOrderbookLocal) Update(orderbookUpdate *WebsocketOrderbookUpdate) error {
 	if (orderbookUpdate.Bids == nil && orderbookUpdate.Asks == nil) ||
 		(len(orderbookUpdate.Bids) == 0 && len(orderbookUpdate.Asks) == 0) {
@@ -31,22 +71,66 @@ func (w *WebsocketOrderbookLocal) Update(orderbookUpdate *WebsocketOrderbookUpda
 	}
 	w.m.Lock()
 	defer w.m.Unlock()
-	if _, ok := w.ob[orderbookUpdate.CurrencyPair][orderbookUpdate.AssetType]; !ok {
+	err := w.update(orderbookUpdate)
+	return w.scheduleResync(orderbookUpdate.CurrencyPair,
+		orderbookUpdate.AssetType,
+		err)
+}
+
+// VerifyChecksum verifies an orderbook against a checksum which the exchange
+// publishes apart from its updates, buffered updates are applied first. An
+// orderbook which does not match is dropped and resynced from a REST
+// snapshot in the background
+func (w *WebsocketOrderbookLocal) VerifyChecksum(p currency.Pair, assetType asset.Item, checksum uint32) error {
+	w.m.Lock()
+	defer w.m.Unlock()
+	err := w.verify(p, assetType, checksum)
+	return w.scheduleResync(p, assetType, err)
+}
+
+// update applies an orderbook update, the caller must hold the lock
+func (w *WebsocketOrderbookLocal) update(orderbookUpdate *WebsocketOrderbookUpdate) error {
+	if w.failed[orderbookUpdate.CurrencyPair][orderbookUpdate.AssetType] {
+		return fmt.Errorf("%s %s %s %w", w.exchangeName,
+			orderbookUpdate.CurrencyPair, orderbookUpdate.AssetType,
+			ErrResyncFailed)
+	}
+	if w.resyncing[orderbookUpdate.CurrencyPair][orderbookUpdate.AssetType] {
+		return w.holdUpdate(orderbookUpdate)
+	}
+	_, ok := w.ob[orderbookUpdate.CurrencyPair][orderbookUpdate.AssetType]
+	if !ok {
 		return fmt.Errorf("ob.Base could not be found for Exchange %s CurrencyPair: %s AssetType: %s",
 			w.exchangeName,
 			orderbookUpdate.CurrencyPair.String(),
 			orderbookUpdate.AssetType)
 	}
+	atomic.AddInt64(&w.stats.Updates, 1)
 	if w.bufferEnabled {
-		overBufferLimit := w.processBufferUpdate(orderbookUpdate)
+		overBufferLimit, err := w.processBufferUpdate(orderbookUpdate)
+		if err != nil {
+			w.drop(orderbookUpdate.CurrencyPair, orderbookUpdate.AssetType)
+			return err
+		}
 		if !overBufferLimit {
 			return nil
 		}
 	} else {
+		apply, err := w.checkSequence(orderbookUpdate)
+		if err != nil {
+			w.drop(orderbookUpdate.CurrencyPair, orderbookUpdate.AssetType)
+			return err
+		}
+		if !apply {
+			return nil
+		}
 		w.processObUpdate(orderbookUpdate)
 	}
-	err := w.ob[orderbookUpdate.CurrencyPair][orderbookUpdate.AssetType].Process()
+	// The orderbook holds every update received so far, which the checksum
+	// of the latest update covers
+	err := w.verifyChecksum(orderbookUpdate)
 	if err != nil {
+		w.drop(orderbookUpdate.CurrencyPair, orderbookUpdate.AssetType)
 		return err
 	}
 	if w.bufferEnabled {
@@ -56,187 +140,406 @@ func (w *WebsocketOrderbookLocal) Update(orderbookUpdate *WebsocketOrderbookUpda
 	return nil
 }
 
-func (w *WebsocketOrderbookLocal) processBufferUpdate(orderbookUpdate *WebsocketOrderbookUpdate) bool {
+// verify applies the buffered updates of an orderbook and verifies it
+// against a checksum, the caller must hold the lock
+func (w *WebsocketOrderbookLocal) verify(p currency.Pair, assetType asset.Item, checksum uint32) error {
+	if w.failed[p][assetType] {
+		return fmt.Errorf("%s %s %s %w", w.exchangeName, p, assetType,
+			ErrResyncFailed)
+	}
+	if w.resyncing[p][assetType] {
+		return nil
+	}
+	if _, ok := w.ob[p][assetType]; !ok {
+		return fmt.Errorf("ob.Base could not be found for Exchange %s CurrencyPair: %s AssetType: %s",
+			w.exchangeName,
+			p.String(),
+			assetType)
+	}
+	if len(w.buffer[p][assetType]) > 0 {
+		err := w.applyBuffer(p, assetType)
+		if err != nil {
+			w.drop(p, assetType)
+			return err
+		}
+		w.buffer[p][assetType] = nil
+	}
+	err := w.verifyChecksum(&WebsocketOrderbookUpdate{
+		CurrencyPair: p,
+		AssetType:    assetType,
+		Checksum:     checksum,
+		HasChecksum:  true,
+	})
+	if err != nil {
+		w.drop(p, assetType)
+	}
+	return err
+}
+
+func (w *WebsocketOrderbookLocal) processBufferUpdate(orderbookUpdate *WebsocketOrderbookUpdate) (bool, error) {
 	if w.buffer == nil {
-		w.buffer = make(map[currency.Pair]map[string][]WebsocketOrderbookUpdate)
+		w.buffer = make(map[currency.Pair]map[asset.Item][]WebsocketOrderbookUpdate)
 	}
 	if w.buffer[orderbookUpdate.CurrencyPair] == nil {
-		w.buffer[orderbookUpdate.CurrencyPair] = make(map[string][]WebsocketOrderbookUpdate)
+		w.buffer[orderbookUpdate.CurrencyPair] = make(map[asset.Item][]WebsocketOrderbookUpdate)
 	}
 	if len(w.buffer[orderbookUpdate.CurrencyPair][orderbookUpdate.AssetType]) <= w.obBufferLimit {
 		w.buffer[orderbookUpdate.CurrencyPair][orderbookUpdate.AssetType] = append(w.buffer[orderbookUpdate.CurrencyPair][orderbookUpdate.AssetType], *orderbookUpdate)
 		if len(w.buffer[orderbookUpdate.CurrencyPair][orderbookUpdate.AssetType]) < w.obBufferLimit {
-			return false
+			return false, nil
 		}
 	}
+	return true, w.applyBuffer(orderbookUpdate.CurrencyPair, orderbookUpdate.AssetType)
+}
+
+// applyBuffer applies the buffered updates of an orderbook, the caller must
+// hold the lock
+func (w *WebsocketOrderbookLocal) applyBuffer(p currency.Pair, assetType asset.Item) error {
+	buffer := w.buffer[p][assetType]
 	if w.sortBuffer {
 		// sort by last updated to ensure each update is in order
 		if w.sortBufferByUpdateIDs {
-			sort.Slice(w.buffer[orderbookUpdate.CurrencyPair][orderbookUpdate.AssetType], func(i, j int) bool {
-				return w.buffer[orderbookUpdate.CurrencyPair][orderbookUpdate.AssetType][i].UpdateID < w.buffer[orderbookUpdate.CurrencyPair][orderbookUpdate.AssetType][j].UpdateID
+			sort.Slice(buffer, func(i, j int) bool {
+				return buffer[i].UpdateID < buffer[j].UpdateID
 			})
 		} else {
-			sort.Slice(w.buffer[orderbookUpdate.CurrencyPair][orderbookUpdate.AssetType], func(i, j int) bool {
-				return w.buffer[orderbookUpdate.CurrencyPair][orderbookUpdate.AssetType][i].UpdateTime.Before(w.buffer[orderbookUpdate.CurrencyPair][orderbookUpdate.AssetType][j].UpdateTime)
+			sort.Slice(buffer, func(i, j int) bool {
+				return buffer[i].UpdateTime.Before(buffer[j].UpdateTime)
 			})
 		}
 	}
-	for i := 0; i < len(w.buffer[orderbookUpdate.CurrencyPair][orderbookUpdate.AssetType]); i++ {
-		w.processObUpdate(&w.buffer[orderbookUpdate.CurrencyPair][orderbookUpdate.AssetType][i])
+	for i := range buffer {
+		apply, err := w.checkSequence(&buffer[i])
+		if err != nil {
+			return err
+		}
+		if apply {
+			w.processObUpdate(&buffer[i])
+		}
 	}
-	return true
+	return nil
 }
 
-func (w *WebsocketOrderbookLocal) processObUpdate(orderbookUpdate *WebsocketOrderbookUpdate) {
-	if w.updateEntriesByID {
-		w.updateByIDAndAction(orderbookUpdate)
-	} else {
-		var wg sync.WaitGroup
-		wg.Add(2)
-		go w.updateAsksByPrice(orderbookUpdate, &wg)
-		go w.updateBidsByPrice(orderbookUpdate, &wg)
-		wg.Wait()
-	}
-}
-
-func (w *WebsocketOrderbookLocal) updateAsksByPrice(base *WebsocketOrderbookUpdate, wg *sync.WaitGroup) {
-	for j := 0; j < len(base.Asks); j++ {
-		found := false
-		for k := 0; k < len(w.ob[base.CurrencyPair][base.AssetType].Asks); k++ {
-			if w.ob[base.CurrencyPair][base.AssetType].Asks[k].Price == base.Asks[j].Price {
-				found = true
-				if base.Asks[j].Amount == 0 {
-					w.ob[base.CurrencyPair][base.AssetType].Asks = append(w.ob[base.CurrencyPair][base.AssetType].Asks[:k],
-						w.ob[base.CurrencyPair][base.AssetType].Asks[k+1:]...)
-					break
-				}
-				w.ob[base.CurrencyPair][base.AssetType].Asks[k].Amount = base.Asks[j].Amount
-				break
+// checkSequence returns whether an update is to be applied. Updates whose
+// sequence number has already been applied are dropped and an update which
+// does not follow the previous sequence number is a gap, the caller must hold
+// the lock
+func (w *WebsocketOrderbookLocal) checkSequence(orderbookUpdate *WebsocketOrderbookUpdate) (bool, error) {
+	if orderbookUpdate.Sequence == 0 {
+		return true, nil
+	}
+	if w.sequences == nil {
+		w.sequences = make(map[currency.Pair]map[asset.Item]int64)
+	}
+	if w.sequences[orderbookUpdate.CurrencyPair] == nil {
+		w.sequences[orderbookUpdate.CurrencyPair] = make(map[asset.Item]int64)
+	}
+
+	previous := w.sequences[orderbookUpdate.CurrencyPair][orderbookUpdate.AssetType]
+	first := orderbookUpdate.FirstSequence
+	if first == 0 {
+		first = orderbookUpdate.Sequence
+	}
+	switch {
+	case previous != 0 && orderbookUpdate.Sequence <= previous:
+		atomic.AddInt64(&w.stats.StaleUpdates, 1)
+		return false, nil
+	case previous != 0 && first > previous+1:
+		atomic.AddInt64(&w.stats.SequenceGaps, 1)
+		log.Warnf("%s %s %s orderbook sequence gap, expected %d received %d.\n",
+			w.exchangeName, orderbookUpdate.CurrencyPair,
+			orderbookUpdate.AssetType, previous+1, first)
+		return false, ErrSequenceGap
+	}
+	w.sequences[orderbookUpdate.CurrencyPair][orderbookUpdate.AssetType] = orderbookUpdate.Sequence
+	return true, nil
+}
+
+// verifyChecksum verifies the checksum of an update against the updated
+// orderbook, the caller must hold the lock
+func (w *WebsocketOrderbookLocal) verifyChecksum(orderbookUpdate *WebsocketOrderbookUpdate) error {
+	if !orderbookUpdate.HasChecksum || w.checksum == nil {
+		return nil
+	}
+	checksum := w.checksum(w.ob[orderbookUpdate.CurrencyPair][orderbookUpdate.AssetType])
+	if checksum == orderbookUpdate.Checksum {
+		return nil
+	}
+	atomic.AddInt64(&w.stats.ChecksumMismatches, 1)
+	log.Warnf("%s %s %s orderbook checksum mismatch, received %d calculated %d.\n",
+		w.exchangeName, orderbookUpdate.CurrencyPair, orderbookUpdate.AssetType,
+		orderbookUpdate.Checksum, checksum)
+	return ErrChecksumMismatch
+}
+
+// drop removes an orderbook which failed verification, from the local cache
+// and the orderbook store, so that no further updates are applied to it and
+// it is not served until a snapshot is loaded. The caller must hold the lock
+func (w *WebsocketOrderbookLocal) drop(p currency.Pair, assetType asset.Item) {
+	if d, ok := w.ob[p][assetType]; ok {
+		orderbook.Remove(d)
+	}
+	delete(w.ob[p], assetType)
+	delete(w.buffer[p], assetType)
+	delete(w.sequences[p], assetType)
+}
+
+// scheduleResync starts a background resync of an orderbook dropped by a
+// checksum or sequence verification failure and returns any other error.
+// The caller must hold the lock
+func (w *WebsocketOrderbookLocal) scheduleResync(p currency.Pair, assetType asset.Item, err error) error {
+	if err != ErrChecksumMismatch && err != ErrSequenceGap {
+		return err
+	}
+	if w.snapshot == nil {
+		return fmt.Errorf("%s %s %s %s, no REST snapshot to resync from",
+			w.exchangeName, p, assetType, err)
+	}
+	if w.resyncing == nil {
+		w.resyncing = make(map[currency.Pair]map[asset.Item]bool)
+	}
+	if w.resyncing[p] == nil {
+		w.resyncing[p] = make(map[asset.Item]bool)
+	}
+	w.resyncing[p][assetType] = true
+	atomic.AddInt64(&w.stats.Resyncs, 1)
+	w.resyncs.Add(1)
+	go w.resync(p, assetType, err, w.snapshot)
+	return nil
+}
+
+// holdUpdate holds an update received while its orderbook is resynced. A
+// resync which falls too far behind is abandoned, the caller must hold the
+// lock
+func (w *WebsocketOrderbookLocal) holdUpdate(orderbookUpdate *WebsocketOrderbookUpdate) error {
+	p, assetType := orderbookUpdate.CurrencyPair, orderbookUpdate.AssetType
+	maxHeld := w.maxHeldUpdates
+	if maxHeld <= 0 {
+		maxHeld = defaultMaxHeldUpdates
+	}
+	if len(w.held[p][assetType]) >= maxHeld {
+		w.failResync(p, assetType)
+		return fmt.Errorf("%s %s %s %d updates held, %w", w.exchangeName, p,
+			assetType, maxHeld, ErrResyncFailed)
+	}
+	if w.held == nil {
+		w.held = make(map[currency.Pair]map[asset.Item][]WebsocketOrderbookUpdate)
+	}
+	if w.held[p] == nil {
+		w.held[p] = make(map[asset.Item][]WebsocketOrderbookUpdate)
+	}
+	w.held[p][assetType] = append(w.held[p][assetType], *orderbookUpdate)
+	return nil
+}
+
+// failResync abandons the resync of an orderbook and discards its held
+// updates, further updates are rejected until a snapshot is loaded on
+// resubscription. The caller must hold the lock
+func (w *WebsocketOrderbookLocal) failResync(p currency.Pair, assetType asset.Item) {
+	delete(w.resyncing[p], assetType)
+	delete(w.held[p], assetType)
+	if w.failed == nil {
+		w.failed = make(map[currency.Pair]map[asset.Item]bool)
+	}
+	if w.failed[p] == nil {
+		w.failed[p] = make(map[asset.Item]bool)
+	}
+	w.failed[p][assetType] = true
+}
+
+// resync reloads an orderbook which failed verification from a REST
+// snapshot, retrying with a backoff. It runs apart from the websocket read
+// loop, which holds the updates received meanwhile to be applied over the
+// snapshot
+func (w *WebsocketOrderbookLocal) resync(p currency.Pair, assetType asset.Item, cause error, snapshot SnapshotFunc) {
+	defer w.resyncs.Done()
+	backoff := w.resyncBackoff
+	if backoff <= 0 {
+		backoff = defaultResyncBackoff
+	}
+	for attempt := 1; attempt <= resyncAttempts; attempt++ {
+		ob, err := snapshot(p, assetType)
+		if err == nil {
+			ob.Pair = p
+			ob.AssetType = assetType
+			if ob.ExchangeName == "" {
+				ob.ExchangeName = w.exchangeName
 			}
+			err = w.loadResync(&ob)
+		}
+		if err == nil {
+			log.Debugf("%s %s %s orderbook resynced from REST snapshot.\n",
+				w.exchangeName, p, assetType)
+			return
+		}
+		atomic.AddInt64(&w.stats.ResyncFailures, 1)
+		log.Warnf("%s %s %s %s, unable to resync from REST snapshot, attempt %d/%d: %s\n",
+			w.exchangeName, p, assetType, cause, attempt, resyncAttempts, err)
+		if attempt < resyncAttempts {
+			time.Sleep(backoff << uint(attempt-1))
 		}
-		if !found {
-			w.ob[base.CurrencyPair][base.AssetType].Asks = append(w.ob[base.CurrencyPair][base.AssetType].Asks, base.Asks[j])
+		if !w.isResyncing(p, assetType) {
+			// The cache was flushed or the resync abandoned meanwhile
+			return
 		}
 	}
-	sort.Slice(w.ob[base.CurrencyPair][base.AssetType].Asks, func(i, j int) bool {
-		return w.ob[base.CurrencyPair][base.AssetType].Asks[i].Price < w.ob[base.CurrencyPair][base.AssetType].Asks[j].Price
-	})
-	wg.Done()
-}
-
-func (w *WebsocketOrderbookLocal) updateBidsByPrice(base *WebsocketOrderbookUpdate, wg *sync.WaitGroup) {
-	for j := 0; j < len(base.Bids); j++ {
-		found := false
-		for k := 0; k < len(w.ob[base.CurrencyPair][base.AssetType].Bids); k++ {
-			if w.ob[base.CurrencyPair][base.AssetType].Bids[k].Price == base.Bids[j].Price {
-				found = true
-				if base.Bids[j].Amount == 0 {
-					w.ob[base.CurrencyPair][base.AssetType].Bids = append(w.ob[base.CurrencyPair][base.AssetType].Bids[:k],
-						w.ob[base.CurrencyPair][base.AssetType].Bids[k+1:]...)
-					break
-				}
-				w.ob[base.CurrencyPair][base.AssetType].Bids[k].Amount = base.Bids[j].Amount
-				break
-			}
+
+	w.m.Lock()
+	if w.resyncing[p][assetType] {
+		w.failResync(p, assetType)
+	}
+	w.m.Unlock()
+	log.Errorf("%s %s %s %s.\n", w.exchangeName, p, assetType, ErrResyncFailed)
+}
+
+// isResyncing returns whether an orderbook is still waiting on its resync
+func (w *WebsocketOrderbookLocal) isResyncing(p currency.Pair, assetType asset.Item) bool {
+	w.m.Lock()
+	defer w.m.Unlock()
+	return w.resyncing[p][assetType]
+}
+
+// loadResync loads the snapshot of a resynced orderbook and applies the
+// updates held since, updates already covered by the snapshot sequence
+// number are dropped. The updates stay held until they are applied, so that
+// a failed load can be retried with a later snapshot
+func (w *WebsocketOrderbookLocal) loadResync(newOrderbook *orderbook.Base) error {
+	w.m.Lock()
+	defer w.m.Unlock()
+	p, assetType := newOrderbook.Pair, newOrderbook.AssetType
+	if !w.resyncing[p][assetType] {
+		// The cache was flushed while the snapshot was fetched
+		return nil
+	}
+	held := w.held[p][assetType]
+	err := w.loadSnapshot(newOrderbook, true)
+	if err != nil {
+		return err
+	}
+	for i := range held {
+		apply, err := w.checkSequence(&held[i])
+		if err != nil {
+			w.drop(p, assetType)
+			return err
 		}
-		if !found {
-			w.ob[base.CurrencyPair][base.AssetType].Bids = append(w.ob[base.CurrencyPair][base.AssetType].Bids, base.Bids[j])
+		if apply {
+			w.processObUpdate(&held[i])
 		}
 	}
-	sort.Slice(w.ob[base.CurrencyPair][base.AssetType].Bids, func(i, j int) bool {
-		return w.ob[base.CurrencyPair][base.AssetType].Bids[i].Price > w.ob[base.CurrencyPair][base.AssetType].Bids[j].Price
-	})
-	wg.Done()
+	delete(w.resyncing[p], assetType)
+	delete(w.held[p], assetType)
+	return nil
+}
+
+// GetStats returns the orderbook update and verification counters
+func (w *WebsocketOrderbookLocal) GetStats() Stats {
+	return Stats{
+		Updates:            atomic.LoadInt64(&w.stats.Updates),
+		ChecksumMismatches: atomic.LoadInt64(&w.stats.ChecksumMismatches),
+		SequenceGaps:       atomic.LoadInt64(&w.stats.SequenceGaps),
+		StaleUpdates:       atomic.LoadInt64(&w.stats.StaleUpdates),
+		Resyncs:            atomic.LoadInt64(&w.stats.Resyncs),
+		ResyncFailures:     atomic.LoadInt64(&w.stats.ResyncFailures),
+	}
+}
+
+// processObUpdate applies an update to the live orderbook, the caller must
+// hold the lock
+func (w *WebsocketOrderbookLocal) processObUpdate(orderbookUpdate *WebsocketOrderbookUpdate) {
+	d := w.ob[orderbookUpdate.CurrencyPair][orderbookUpdate.AssetType]
+	if w.updateEntriesByID {
+		w.updateByIDAndAction(d, orderbookUpdate)
+	} else {
+		d.UpdateByPrice(orderbookUpdate.Bids, orderbookUpdate.Asks,
+			orderbookUpdate.UpdateTime)
+	}
+	if w.maxDepth > 0 {
+		d.Truncate(w.maxDepth)
+	}
 }
 
 // updateByIDAndAction will receive an action to execute against the orderbook
 // it will then match by IDs instead of price to perform the action
-func (w *WebsocketOrderbookLocal) updateByIDAndAction(orderbookUpdate *WebsocketOrderbookUpdate) {
+func (w *WebsocketOrderbookLocal) updateByIDAndAction(d *orderbook.Depth, orderbookUpdate *WebsocketOrderbookUpdate) {
 	switch orderbookUpdate.Action {
 	case "update":
-		for _, target := range orderbookUpdate.Bids {
-			for i := range w.ob[orderbookUpdate.CurrencyPair][orderbookUpdate.AssetType].Bids {
-				if w.ob[orderbookUpdate.CurrencyPair][orderbookUpdate.AssetType].Bids[i].ID == target.ID {
-					w.ob[orderbookUpdate.CurrencyPair][orderbookUpdate.AssetType].Bids[i].Amount = target.Amount
-					break
-				}
-			}
-		}
-		for _, target := range orderbookUpdate.Asks {
-			for i := range w.ob[orderbookUpdate.CurrencyPair][orderbookUpdate.AssetType].Asks {
-				if w.ob[orderbookUpdate.CurrencyPair][orderbookUpdate.AssetType].Asks[i].ID == target.ID {
-					w.ob[orderbookUpdate.CurrencyPair][orderbookUpdate.AssetType].Asks[i].Amount = target.Amount
-					break
-				}
-			}
-		}
+		d.UpdateByID(orderbookUpdate.Bids, orderbookUpdate.Asks,
+			orderbookUpdate.UpdateTime)
 	case "delete":
-		for _, target := range orderbookUpdate.Bids {
-			for i := 0; i < len(w.ob[orderbookUpdate.CurrencyPair][orderbookUpdate.AssetType].Bids); i++ {
-				if w.ob[orderbookUpdate.CurrencyPair][orderbookUpdate.AssetType].Bids[i].ID == target.ID {
-					w.ob[orderbookUpdate.CurrencyPair][orderbookUpdate.AssetType].Bids = append(w.ob[orderbookUpdate.CurrencyPair][orderbookUpdate.AssetType].Bids[:i],
-						w.ob[orderbookUpdate.CurrencyPair][orderbookUpdate.AssetType].Bids[i+1:]...)
-					i--
-					break
-				}
-			}
-		}
-		for _, target := range orderbookUpdate.Asks {
-			for i := 0; i < len(w.ob[orderbookUpdate.CurrencyPair][orderbookUpdate.AssetType].Asks); i++ {
-				if w.ob[orderbookUpdate.CurrencyPair][orderbookUpdate.AssetType].Asks[i].ID == target.ID {
-					w.ob[orderbookUpdate.CurrencyPair][orderbookUpdate.AssetType].Asks = append(w.ob[orderbookUpdate.CurrencyPair][orderbookUpdate.AssetType].Asks[:i],
-						w.ob[orderbookUpdate.CurrencyPair][orderbookUpdate.AssetType].Asks[i+1:]...)
-					i--
-					break
-				}
-			}
-		}
+		d.DeleteByID(orderbookUpdate.Bids, orderbookUpdate.Asks,
+			orderbookUpdate.UpdateTime)
 	case "insert":
-		w.ob[orderbookUpdate.CurrencyPair][orderbookUpdate.AssetType].Bids = append(w.ob[orderbookUpdate.CurrencyPair][orderbookUpdate.AssetType].Bids, orderbookUpdate.Bids...)
-		w.ob[orderbookUpdate.CurrencyPair][orderbookUpdate.AssetType].Asks = append(w.ob[orderbookUpdate.CurrencyPair][orderbookUpdate.AssetType].Asks, orderbookUpdate.Asks...)
+		d.Insert(orderbookUpdate.Bids, orderbookUpdate.Asks,
+			orderbookUpdate.UpdateTime)
 	}
 }
 
 // LoadSnapshot loads initial snapshot of ob data, overwrite allows full
 // ob to be completely rewritten because the exchange is a doing a full
-// update not an incremental one
+// update not an incremental one. The snapshot is loaded into the live
+// orderbook of the orderbook store, which later updates amend in place and
+// REST snapshots no longer replace until the cache is flushed
 func (w *WebsocketOrderbookLocal) LoadSnapshot(newOrderbook *orderbook.Base, overwrite bool) error {
+	w.m.Lock()
+	defer w.m.Unlock()
+	return w.loadSnapshot(newOrderbook, overwrite)
+}
+
+// loadSnapshot loads a snapshot, the caller must hold the lock
+func (w *WebsocketOrderbookLocal) loadSnapshot(newOrderbook *orderbook.Base, overwrite bool) error {
 	if len(newOrderbook.Asks) == 0 || len(newOrderbook.Bids) == 0 {
 		return fmt.Errorf("%v snapshot ask and bids are nil", w.exchangeName)
 	}
-	w.m.Lock()
-	defer w.m.Unlock()
 	if w.ob == nil {
-		w.ob = make(map[currency.Pair]map[string]*orderbook.Base)
+		w.ob = make(map[currency.Pair]map[asset.Item]*orderbook.Depth)
 	}
 	if w.ob[newOrderbook.Pair] == nil {
-		w.ob[newOrderbook.Pair] = make(map[string]*orderbook.Base)
+		w.ob[newOrderbook.Pair] = make(map[asset.Item]*orderbook.Depth)
 	}
-	if w.ob[newOrderbook.Pair][newOrderbook.AssetType] != nil &&
-		(len(w.ob[newOrderbook.Pair][newOrderbook.AssetType].Asks) > 0 ||
-			len(w.ob[newOrderbook.Pair][newOrderbook.AssetType].Bids) > 0) {
-		if overwrite {
-			w.ob[newOrderbook.Pair][newOrderbook.AssetType] = newOrderbook
-			return newOrderbook.Process()
-		}
+	if w.ob[newOrderbook.Pair][newOrderbook.AssetType] != nil && !overwrite {
 		return fmt.Errorf("%v snapshot instance already found", w.exchangeName)
 	}
-	w.ob[newOrderbook.Pair][newOrderbook.AssetType] = newOrderbook
-	return newOrderbook.Process()
+	d, err := newOrderbook.ProcessWebsocket()
+	if err != nil {
+		return err
+	}
+	if w.maxDepth > 0 {
+		d.Truncate(w.maxDepth)
+	}
+	w.ob[newOrderbook.Pair][newOrderbook.AssetType] = d
+	delete(w.failed[newOrderbook.Pair], newOrderbook.AssetType)
+	if w.sequences == nil {
+		w.sequences = make(map[currency.Pair]map[asset.Item]int64)
+	}
+	if w.sequences[newOrderbook.Pair] == nil {
+		w.sequences[newOrderbook.Pair] = make(map[asset.Item]int64)
+	}
+	w.sequences[newOrderbook.Pair][newOrderbook.AssetType] = newOrderbook.LastUpdateID
+	return nil
 }
 
 // GetOrderbook use sparingly. Modifying anything here will ruin hash calculation and cause problems
-func (w *WebsocketOrderbookLocal) GetOrderbook(p currency.Pair, assetType string) *orderbook.Base {
+func (w *WebsocketOrderbookLocal) GetOrderbook(p currency.Pair, assetType asset.Item) *orderbook.Depth {
 	w.m.Lock()
 	defer w.m.Unlock()
 	return w.ob[p][assetType]
 }
 
 // FlushCache flushes w.ob data to be garbage collected and refreshed when a
-// connection is lost and reconnected
+// connection is lost and reconnected, handing the orderbooks back to REST
+// polling in the meantime
 func (w *WebsocketOrderbookLocal) FlushCache() {
 	w.m.Lock()
+	for _, books := range w.ob {
+		for _, d := range books {
+			d.ReleaseWebsocket()
+		}
+	}
 	w.ob = nil
 	w.buffer = nil
+	w.sequences = nil
+	w.resyncing = nil
+	w.held = nil
+	w.failed = nil
 	w.m.Unlock()
 }
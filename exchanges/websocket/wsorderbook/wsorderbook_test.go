@@ -1,12 +1,17 @@
 package wsorderbook
 
 import (
+	"errors"
 	"fmt"
 	"math/rand"
+	"sort"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 )
 
@@ -21,7 +26,7 @@ var itemArray = [][]orderbook.Item{
 
 const (
 	exchangeName = "exchangeTest"
-	spot         = orderbook.Spot
+	spot         = asset.Spot
 )
 
 func createSnapshot() (obl *WebsocketOrderbookLocal, curr currency.Pair, asks, bids []orderbook.Item, err error) {
@@ -144,12 +149,12 @@ func TestHittingTheBuffer(t *testing.T) {
 			t.Fatal(err)
 		}
 	}
-	if len(obl.ob[curr][spot].Asks) != 3 {
+	if len(obl.ob[curr][spot].Retrieve().Asks) != 3 {
 		t.Log(obl.ob[curr][spot])
-		t.Errorf("expected 3 entries, received: %v", len(obl.ob[curr][spot].Asks))
+		t.Errorf("expected 3 entries, received: %v", len(obl.ob[curr][spot].Retrieve().Asks))
 	}
-	if len(obl.ob[curr][spot].Bids) != 3 {
-		t.Errorf("expected 3 entries, received: %v", len(obl.ob[curr][spot].Bids))
+	if len(obl.ob[curr][spot].Retrieve().Bids) != 3 {
+		t.Errorf("expected 3 entries, received: %v", len(obl.ob[curr][spot].Retrieve().Bids))
 	}
 }
 
@@ -178,11 +183,11 @@ func TestInsertWithIDs(t *testing.T) {
 			t.Fatal(err)
 		}
 	}
-	if len(obl.ob[curr][spot].Asks) != 6 {
-		t.Errorf("expected 6 entries, received: %v", len(obl.ob[curr][spot].Asks))
+	if len(obl.ob[curr][spot].Retrieve().Asks) != 6 {
+		t.Errorf("expected 6 entries, received: %v", len(obl.ob[curr][spot].Retrieve().Asks))
 	}
-	if len(obl.ob[curr][spot].Bids) != 6 {
-		t.Errorf("expected 6 entries, received: %v", len(obl.ob[curr][spot].Bids))
+	if len(obl.ob[curr][spot].Retrieve().Bids) != 6 {
+		t.Errorf("expected 6 entries, received: %v", len(obl.ob[curr][spot].Retrieve().Bids))
 	}
 }
 
@@ -211,11 +216,11 @@ func TestSortIDs(t *testing.T) {
 			t.Fatal(err)
 		}
 	}
-	if len(obl.ob[curr][spot].Asks) != 3 {
-		t.Errorf("expected 6 entries, received: %v", len(obl.ob[curr][spot].Asks))
+	if len(obl.ob[curr][spot].Retrieve().Asks) != 3 {
+		t.Errorf("expected 6 entries, received: %v", len(obl.ob[curr][spot].Retrieve().Asks))
 	}
-	if len(obl.ob[curr][spot].Bids) != 3 {
-		t.Errorf("expected 6 entries, received: %v", len(obl.ob[curr][spot].Bids))
+	if len(obl.ob[curr][spot].Retrieve().Bids) != 3 {
+		t.Errorf("expected 6 entries, received: %v", len(obl.ob[curr][spot].Retrieve().Bids))
 	}
 }
 
@@ -242,11 +247,11 @@ func TestDeleteWithIDs(t *testing.T) {
 			t.Fatal(err)
 		}
 	}
-	if len(obl.ob[curr][spot].Asks) != 0 {
-		t.Errorf("expected 0 entries, received: %v", len(obl.ob[curr][spot].Asks))
+	if len(obl.ob[curr][spot].Retrieve().Asks) != 0 {
+		t.Errorf("expected 0 entries, received: %v", len(obl.ob[curr][spot].Retrieve().Asks))
 	}
-	if len(obl.ob[curr][spot].Bids) != 0 {
-		t.Errorf("expected 0 entries, received: %v", len(obl.ob[curr][spot].Bids))
+	if len(obl.ob[curr][spot].Retrieve().Bids) != 0 {
+		t.Errorf("expected 0 entries, received: %v", len(obl.ob[curr][spot].Retrieve().Bids))
 	}
 }
 
@@ -273,12 +278,12 @@ func TestUpdateWithIDs(t *testing.T) {
 			t.Fatal(err)
 		}
 	}
-	if len(obl.ob[curr][spot].Asks) != 1 {
+	if len(obl.ob[curr][spot].Retrieve().Asks) != 1 {
 		t.Log(obl.ob[curr][spot])
-		t.Errorf("expected 1 entries, received: %v", len(obl.ob[curr][spot].Asks))
+		t.Errorf("expected 1 entries, received: %v", len(obl.ob[curr][spot].Retrieve().Asks))
 	}
-	if len(obl.ob[curr][spot].Bids) != 1 {
-		t.Errorf("expected 1 entries, received: %v", len(obl.ob[curr][spot].Bids))
+	if len(obl.ob[curr][spot].Retrieve().Bids) != 1 {
+		t.Errorf("expected 1 entries, received: %v", len(obl.ob[curr][spot].Retrieve().Bids))
 	}
 }
 
@@ -309,8 +314,8 @@ func TestOutOfOrderIDs(t *testing.T) {
 		}
 	}
 	// Index 1 since index 0 is price 7000
-	if obl.ob[curr][spot].Asks[1].Price != 2000 {
-		t.Errorf("expected sorted price to be 3000, received: %v", obl.ob[curr][spot].Asks[1].Price)
+	if obl.ob[curr][spot].Retrieve().Asks[1].Price != 2000 {
+		t.Errorf("expected sorted price to be 3000, received: %v", obl.ob[curr][spot].Retrieve().Asks[1].Price)
 	}
 }
 
@@ -430,10 +435,17 @@ func TestFlushCache(t *testing.T) {
 	if obl.ob[curr][spot] == nil {
 		t.Error("expected ob to have ask entries")
 	}
+	d := obl.ob[curr][spot]
+	if !d.IsWebsocket() {
+		t.Error("expected ob to be maintained by the websocket")
+	}
 	obl.FlushCache()
 	if obl.ob[curr][spot] != nil {
 		t.Error("expected ob be flushed")
 	}
+	if d.IsWebsocket() {
+		t.Error("expected ob to be handed back to REST polling")
+	}
 }
 
 // TestInsertingSnapShots logic test
@@ -550,14 +562,16 @@ func TestInsertingSnapShots(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
-	if obl.ob[snapShot1.Pair][snapShot1.AssetType].Asks[0] != snapShot1.Asks[0] {
-		t.Errorf("loaded data mismatch. Expected %v, received %v", snapShot1.Asks[0], obl.ob[snapShot1.Pair][snapShot1.AssetType].Asks[0])
+	if obl.ob[snapShot1.Pair][snapShot1.AssetType].Retrieve().Asks[0] != snapShot1.Asks[0] {
+		t.Errorf("loaded data mismatch. Expected %v, received %v", snapShot1.Asks[0], obl.ob[snapShot1.Pair][snapShot1.AssetType].Retrieve().Asks[0])
 	}
-	if obl.ob[snapShot2.Pair][snapShot2.AssetType].Asks[0] != snapShot2.Asks[0] {
-		t.Errorf("loaded data mismatch. Expected %v, received %v", snapShot2.Asks[0], obl.ob[snapShot2.Pair][snapShot2.AssetType].Asks[0])
+	// Snapshots are sorted into price order, the lowest ask of the second and
+	// third snapshots is their tenth
+	if obl.ob[snapShot2.Pair][snapShot2.AssetType].Retrieve().Asks[0] != snapShot2.Asks[9] {
+		t.Errorf("loaded data mismatch. Expected %v, received %v", snapShot2.Asks[9], obl.ob[snapShot2.Pair][snapShot2.AssetType].Retrieve().Asks[0])
 	}
-	if obl.ob[snapShot3.Pair][snapShot3.AssetType].Asks[0] != snapShot3.Asks[0] {
-		t.Errorf("loaded data mismatch. Expected %v, received %v", snapShot3.Asks[0], obl.ob[snapShot3.Pair][snapShot3.AssetType].Asks[0])
+	if obl.ob[snapShot3.Pair][snapShot3.AssetType].Retrieve().Asks[0] != snapShot3.Asks[9] {
+		t.Errorf("loaded data mismatch. Expected %v, received %v", snapShot3.Asks[9], obl.ob[snapShot3.Pair][snapShot3.AssetType].Retrieve().Asks[0])
 	}
 }
 
@@ -579,3 +593,519 @@ func TestSetup(t *testing.T) {
 		t.Errorf("Setup incorrectly loaded %s", w.exchangeName)
 	}
 }
+
+func TestSequenceVerification(t *testing.T) {
+	obl, curr, asks, bids, err := createSnapshot()
+	if err != nil {
+		t.Fatal(err)
+	}
+	obl.exchangeName = exchangeName
+	obl.sequences[curr][spot] = 10
+
+	update := func(first, last int64) error {
+		return obl.Update(&WebsocketOrderbookUpdate{
+			Bids:          []orderbook.Item{{Price: 3000, Amount: 1}},
+			CurrencyPair:  curr,
+			AssetType:     spot,
+			FirstSequence: first,
+			Sequence:      last,
+		})
+	}
+
+	err = update(5, 9)
+	if err != nil || len(obl.ob[curr][spot].Retrieve().Bids) != 1 {
+		t.Errorf("Test failed. Expected a stale update to be dropped %v", err)
+	}
+
+	err = update(8, 12)
+	if err != nil || len(obl.ob[curr][spot].Retrieve().Bids) != 2 || obl.sequences[curr][spot] != 12 {
+		t.Errorf("Test failed. Expected an overlapping update to be applied %v", err)
+	}
+
+	var snapshots int
+	obl.SetupVerification(nil, func(p currency.Pair, a asset.Item) (orderbook.Base, error) {
+		snapshots++
+		return orderbook.Base{Asks: asks, Bids: bids, LastUpdateID: 20}, nil
+	})
+	err = update(14, 15)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Updates received during the resync are applied over the snapshot
+	err = update(19, 21)
+	if err != nil {
+		t.Fatal(err)
+	}
+	obl.resyncs.Wait()
+
+	if snapshots != 1 || len(obl.ob[curr][spot].Retrieve().Bids) != 2 || obl.sequences[curr][spot] != 21 {
+		t.Errorf("Test failed. Expected the orderbook to be resynced after a gap %v",
+			obl.ob[curr][spot])
+	}
+
+	stats := obl.GetStats()
+	if stats.Updates != 3 || stats.StaleUpdates != 1 || stats.SequenceGaps != 1 ||
+		stats.Resyncs != 1 || stats.ResyncFailures != 0 {
+		t.Errorf("Test failed. Unexpected stats %+v", stats)
+	}
+}
+
+func TestChecksumVerification(t *testing.T) {
+	obl, curr, _, _, err := createSnapshot()
+	if err != nil {
+		t.Fatal(err)
+	}
+	obl.exchangeName = exchangeName
+	checksum := func(ob *orderbook.Depth) uint32 {
+		bids, _ := ob.Len()
+		return uint32(bids)
+	}
+	obl.SetupVerification(checksum, nil)
+
+	err = obl.Update(&WebsocketOrderbookUpdate{
+		Bids:         []orderbook.Item{{Price: 3000, Amount: 1}},
+		CurrencyPair: curr,
+		AssetType:    spot,
+		Checksum:     2,
+		HasChecksum:  true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = obl.Update(&WebsocketOrderbookUpdate{
+		Bids:         []orderbook.Item{{Price: 2000, Amount: 1}},
+		CurrencyPair: curr,
+		AssetType:    spot,
+		Checksum:     2,
+		HasChecksum:  true,
+	})
+	if err == nil {
+		t.Error("Test failed. Expected a checksum mismatch without a snapshot to resync from")
+	}
+
+	if obl.GetOrderbook(curr, spot) != nil {
+		t.Error("Test failed. Expected the corrupted orderbook to be dropped")
+	}
+
+	var snapshots int32
+	obl.resyncBackoff = time.Millisecond
+	obl.SetupVerification(checksum, func(p currency.Pair, a asset.Item) (orderbook.Base, error) {
+		atomic.AddInt32(&snapshots, 1)
+		return orderbook.Base{}, errors.New("unavailable")
+	})
+	_, curr, asks, bids, err := createSnapshot()
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = obl.LoadSnapshot(&orderbook.Base{Pair: curr, AssetType: spot, Asks: asks, Bids: bids, ExchangeName: exchangeName}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = obl.Update(&WebsocketOrderbookUpdate{
+		Bids:         []orderbook.Item{{Price: 3000, Amount: 1}},
+		CurrencyPair: curr,
+		AssetType:    spot,
+		Checksum:     5,
+		HasChecksum:  true,
+	})
+	if err != nil {
+		t.Errorf("Test failed. Expected the resync to run in the background %v", err)
+	}
+	obl.resyncs.Wait()
+
+	if obl.GetOrderbook(curr, spot) != nil {
+		t.Error("Test failed. Expected the orderbook to stay dropped when the resync fails")
+	}
+	if _, err = orderbook.GetDepth(exchangeName, curr, spot); err == nil {
+		t.Error("Test failed. Expected the orderbook to be removed from the store")
+	}
+	if atomic.LoadInt32(&snapshots) != resyncAttempts {
+		t.Errorf("Test failed. Expected the snapshot to be retried %d times, fetched %d",
+			resyncAttempts, atomic.LoadInt32(&snapshots))
+	}
+	if len(obl.resyncing[curr]) != 0 || len(obl.held[curr]) != 0 {
+		t.Error("Test failed. Expected the failed resync to be cleared")
+	}
+
+	err = obl.Update(&WebsocketOrderbookUpdate{
+		Bids:         []orderbook.Item{{Price: 3000, Amount: 1}},
+		CurrencyPair: curr,
+		AssetType:    spot,
+	})
+	if !errors.Is(err, ErrResyncFailed) {
+		t.Errorf("Test failed. Expected a resubscribe to be required %v", err)
+	}
+
+	err = obl.LoadSnapshot(&orderbook.Base{Pair: curr, AssetType: spot, Asks: asks, Bids: bids, ExchangeName: exchangeName}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = obl.Update(&WebsocketOrderbookUpdate{
+		Bids:         []orderbook.Item{{Price: 3000, Amount: 1}},
+		CurrencyPair: curr,
+		AssetType:    spot,
+	})
+	if err != nil {
+		t.Errorf("Test failed. Expected updates to be applied after resubscribing %v", err)
+	}
+
+	stats := obl.GetStats()
+	if stats.ChecksumMismatches != 2 || stats.Resyncs != 1 ||
+		stats.ResyncFailures != resyncAttempts {
+		t.Errorf("Test failed. Unexpected stats %+v", stats)
+	}
+}
+
+func TestHeldUpdateLimit(t *testing.T) {
+	obl, curr, _, _, err := createSnapshot()
+	if err != nil {
+		t.Fatal(err)
+	}
+	obl.exchangeName = exchangeName
+	obl.maxHeldUpdates = 2
+	obl.resyncing = map[currency.Pair]map[asset.Item]bool{curr: {spot: true}}
+
+	update := &WebsocketOrderbookUpdate{
+		Bids:         []orderbook.Item{{Price: 3000, Amount: 1}},
+		CurrencyPair: curr,
+		AssetType:    spot,
+	}
+	for i := 0; i < 2; i++ {
+		err = obl.Update(update)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	err = obl.Update(update)
+	if !errors.Is(err, ErrResyncFailed) {
+		t.Errorf("Test failed. Expected the resync to be abandoned %v", err)
+	}
+	if obl.resyncing[curr][spot] || len(obl.held[curr][spot]) != 0 {
+		t.Error("Test failed. Expected the held updates to be discarded")
+	}
+}
+
+func TestBufferedSequenceGap(t *testing.T) {
+	obl, curr, _, _, err := createSnapshot()
+	if err != nil {
+		t.Fatal(err)
+	}
+	obl.exchangeName = exchangeName
+	obl.bufferEnabled = true
+	obl.sortBuffer = true
+	obl.sortBufferByUpdateIDs = true
+	obl.obBufferLimit = 3
+	obl.sequences[curr][spot] = 1
+
+	for _, seq := range []int64{4, 2, 3} {
+		err = obl.Update(&WebsocketOrderbookUpdate{
+			Bids:         []orderbook.Item{{Price: float64(seq), Amount: 1}},
+			CurrencyPair: curr,
+			AssetType:    spot,
+			UpdateID:     seq,
+			Sequence:     seq,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if len(obl.ob[curr][spot].Retrieve().Bids) != 4 || obl.sequences[curr][spot] != 4 {
+		t.Errorf("Test failed. Expected sorted buffered updates to apply %v",
+			obl.ob[curr][spot])
+	}
+
+	for _, seq := range []int64{5, 7, 8} {
+		err = obl.Update(&WebsocketOrderbookUpdate{
+			Bids:         []orderbook.Item{{Price: float64(seq), Amount: 1}},
+			CurrencyPair: curr,
+			AssetType:    spot,
+			UpdateID:     seq,
+			Sequence:     seq,
+		})
+	}
+	if err == nil || obl.GetOrderbook(curr, spot) != nil {
+		t.Error("Test failed. Expected a buffered sequence gap to drop the orderbook")
+	}
+}
+
+func TestVerifyChecksum(t *testing.T) {
+	obl, curr, _, _, err := createSnapshot()
+	if err != nil {
+		t.Fatal(err)
+	}
+	obl.exchangeName = exchangeName
+	obl.bufferEnabled = true
+	obl.obBufferLimit = 5
+	obl.SetMaxDepth(2)
+	obl.SetupVerification(func(ob *orderbook.Depth) uint32 {
+		bids, _ := ob.Len()
+		return uint32(bids)
+	}, nil)
+
+	for _, price := range []float64{3000, 2000, 1000} {
+		err = obl.Update(&WebsocketOrderbookUpdate{
+			Bids:         []orderbook.Item{{Price: price, Amount: 1}},
+			CurrencyPair: curr,
+			AssetType:    spot,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	err = obl.VerifyChecksum(curr, spot, 2)
+	if err != nil {
+		t.Errorf("Test failed. Expected the buffered and truncated orderbook to match %v", err)
+	}
+	if bids := obl.GetOrderbook(curr, spot).Retrieve().Bids; len(bids) != 2 || bids[1].Price != 3000 {
+		t.Errorf("Test failed. Expected the best two bids %v", bids)
+	}
+
+	err = obl.VerifyChecksum(curr, spot, 3)
+	if err == nil || obl.GetOrderbook(curr, spot) != nil {
+		t.Error("Test failed. Expected a checksum mismatch to drop the orderbook")
+	}
+}
+
+// legacyBook reproduces the orderbook handling which orderbook.Depth
+// replaced to benchmark against, each level is found by a linear search, the
+// sides are sorted after each update and the book is copied into a store
+// under a global lock
+type legacyBook struct {
+	ob    orderbook.Base
+	m     sync.Mutex
+	store map[currency.Pair]map[asset.Item]orderbook.Base
+}
+
+func newLegacyBook(bids, asks []orderbook.Item) *legacyBook {
+	l := &legacyBook{store: make(map[currency.Pair]map[asset.Item]orderbook.Base)}
+	l.ob.Bids = append(l.ob.Bids, bids...)
+	l.ob.Asks = append(l.ob.Asks, asks...)
+	l.ob.Pair = currency.NewPairFromString("BTCUSD")
+	l.ob.AssetType = spot
+	return l
+}
+
+func (l *legacyBook) process() {
+	l.m.Lock()
+	if l.store[l.ob.Pair] == nil {
+		l.store[l.ob.Pair] = make(map[asset.Item]orderbook.Base)
+	}
+	l.store[l.ob.Pair][l.ob.AssetType] = l.ob
+	l.m.Unlock()
+}
+
+func legacySetByPrice(items []orderbook.Item, updates []orderbook.Item, bids bool, wg *sync.WaitGroup) []orderbook.Item {
+	for j := range updates {
+		found := false
+		for k := range items {
+			if items[k].Price == updates[j].Price {
+				found = true
+				if updates[j].Amount == 0 {
+					items = append(items[:k], items[k+1:]...)
+					break
+				}
+				items[k].Amount = updates[j].Amount
+				break
+			}
+		}
+		if !found {
+			items = append(items, updates[j])
+		}
+	}
+	sort.Slice(items, func(i, j int) bool {
+		if bids {
+			return items[i].Price > items[j].Price
+		}
+		return items[i].Price < items[j].Price
+	})
+	wg.Done()
+	return items
+}
+
+func (l *legacyBook) updateByPrice(u *WebsocketOrderbookUpdate) {
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() { l.ob.Asks = legacySetByPrice(l.ob.Asks, u.Asks, false, &wg) }()
+	go func() { l.ob.Bids = legacySetByPrice(l.ob.Bids, u.Bids, true, &wg) }()
+	wg.Wait()
+	l.process()
+}
+
+func legacySetByID(items []orderbook.Item, updates []orderbook.Item, action string) []orderbook.Item {
+	switch action {
+	case "update":
+		for _, target := range updates {
+			for i := range items {
+				if items[i].ID == target.ID {
+					items[i].Amount = target.Amount
+					break
+				}
+			}
+		}
+	case "delete":
+		for _, target := range updates {
+			for i := range items {
+				if items[i].ID == target.ID {
+					items = append(items[:i], items[i+1:]...)
+					break
+				}
+			}
+		}
+	case "insert":
+		items = append(items, updates...)
+	}
+	return items
+}
+
+func (l *legacyBook) updateByID(u *WebsocketOrderbookUpdate) {
+	l.ob.Bids = legacySetByID(l.ob.Bids, u.Bids, u.Action)
+	l.ob.Asks = legacySetByID(l.ob.Asks, u.Asks, u.Action)
+	l.process()
+}
+
+// benchmarkLevels returns a book of n bid and ask levels either side of a
+// mid price of 10000 at a tick size of 0.5
+func benchmarkLevels(n int) (bids, asks []orderbook.Item) {
+	for i := 0; i < n; i++ {
+		bids = append(bids, orderbook.Item{Price: 10000 - float64(i+1)*0.5, Amount: 1, ID: int64(2*i + 1)})
+		asks = append(asks, orderbook.Item{Price: 10000 + float64(i)*0.5, Amount: 1, ID: int64(2*i + 2)})
+	}
+	return
+}
+
+// binanceUpdates returns diff depth updates of the size Binance publishes for
+// a 1000 level book, 20 bid and ask changes of which a quarter remove a level
+func binanceUpdates(n int) []WebsocketOrderbookUpdate {
+	r := rand.New(rand.NewSource(1))
+	curr := currency.NewPairFromString("BTCUSD")
+	updates := make([]WebsocketOrderbookUpdate, n)
+	for i := range updates {
+		updates[i] = WebsocketOrderbookUpdate{
+			CurrencyPair: curr,
+			AssetType:    spot,
+			UpdateTime:   time.Now(),
+		}
+		for j := 0; j < 20; j++ {
+			amount := float64(r.Intn(4))
+			tick := float64(r.Intn(1000)) * 0.5
+			updates[i].Bids = append(updates[i].Bids, orderbook.Item{Price: 10000 - 0.5 - tick, Amount: amount})
+			updates[i].Asks = append(updates[i].Asks, orderbook.Item{Price: 10000 + tick, Amount: amount})
+		}
+	}
+	return updates
+}
+
+// bitmexUpdates returns L2 updates of the size Bitmex publishes for a 2500
+// level book, single level changes by ID where a level is amended, removed
+// and then inserted again
+func bitmexUpdates(n int, bids, asks []orderbook.Item) []WebsocketOrderbookUpdate {
+	r := rand.New(rand.NewSource(1))
+	curr := currency.NewPairFromString("BTCUSD")
+	var updates []WebsocketOrderbookUpdate
+	for len(updates) < n {
+		side := bids
+		if r.Intn(2) == 0 {
+			side = asks
+		}
+		level := side[r.Intn(len(side))]
+		amended := level
+		amended.Amount = float64(r.Intn(100) + 1)
+		for _, u := range []struct {
+			action string
+			item   orderbook.Item
+		}{
+			{"update", orderbook.Item{ID: level.ID, Amount: amended.Amount}},
+			{"delete", orderbook.Item{ID: level.ID}},
+			{"insert", amended},
+		} {
+			update := WebsocketOrderbookUpdate{
+				CurrencyPair: curr,
+				AssetType:    spot,
+				UpdateTime:   time.Now(),
+				Action:       u.action,
+			}
+			if level.ID%2 == 1 {
+				update.Bids = []orderbook.Item{u.item}
+			} else {
+				update.Asks = []orderbook.Item{u.item}
+			}
+			updates = append(updates, update)
+		}
+	}
+	return updates
+}
+
+func benchmarkDepthUpdate(b *testing.B, levels int, byID bool, updates func([]orderbook.Item, []orderbook.Item) []WebsocketOrderbookUpdate) {
+	bids, asks := benchmarkLevels(levels)
+	obl := &WebsocketOrderbookLocal{exchangeName: exchangeName, updateEntriesByID: byID}
+	err := obl.LoadSnapshot(&orderbook.Base{
+		Pair:         currency.NewPairFromString("BTCUSD"),
+		AssetType:    spot,
+		ExchangeName: exchangeName,
+		Bids:         bids,
+		Asks:         asks,
+	}, true)
+	if err != nil {
+		b.Fatal(err)
+	}
+	u := updates(bids, asks)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		err = obl.Update(&u[i%len(u)])
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func benchmarkLegacyDepthUpdate(b *testing.B, levels int, byID bool, updates func([]orderbook.Item, []orderbook.Item) []WebsocketOrderbookUpdate) {
+	bids, asks := benchmarkLevels(levels)
+	l := newLegacyBook(bids, asks)
+	u := updates(bids, asks)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if byID {
+			l.updateByID(&u[i%len(u)])
+			continue
+		}
+		l.updateByPrice(&u[i%len(u)])
+	}
+}
+
+// BenchmarkBinanceDepthUpdate applies Binance sized updates to a 1000 level
+// book
+func BenchmarkBinanceDepthUpdate(b *testing.B) {
+	benchmarkDepthUpdate(b, 1000, false, func(_, _ []orderbook.Item) []WebsocketOrderbookUpdate {
+		return binanceUpdates(1000)
+	})
+}
+
+// BenchmarkBinanceDepthUpdateLegacy applies Binance sized updates to a 1000
+// level book with the replaced linear search and sort
+func BenchmarkBinanceDepthUpdateLegacy(b *testing.B) {
+	benchmarkLegacyDepthUpdate(b, 1000, false, func(_, _ []orderbook.Item) []WebsocketOrderbookUpdate {
+		return binanceUpdates(1000)
+	})
+}
+
+// BenchmarkBitmexDepthUpdate applies Bitmex sized updates to a 2500 level
+// book
+func BenchmarkBitmexDepthUpdate(b *testing.B) {
+	benchmarkDepthUpdate(b, 2500, true, func(bids, asks []orderbook.Item) []WebsocketOrderbookUpdate {
+		return bitmexUpdates(3000, bids, asks)
+	})
+}
+
+// BenchmarkBitmexDepthUpdateLegacy applies Bitmex sized updates to a 2500
+// level book with the replaced linear search by ID
+func BenchmarkBitmexDepthUpdateLegacy(b *testing.B) {
+	benchmarkLegacyDepthUpdate(b, 2500, true, func(bids, asks []orderbook.Item) []WebsocketOrderbookUpdate {
+		return bitmexUpdates(3000, bids, asks)
+	})
+}
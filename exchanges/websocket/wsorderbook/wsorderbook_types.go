@@ -5,14 +5,53 @@ import (
 	"time"
 
 	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 )
 
+// ChecksumFunc calculates the exchange checksum of an orderbook
+type ChecksumFunc func(ob *orderbook.Depth) uint32
+
+// SnapshotFunc fetches an orderbook snapshot over REST
+type SnapshotFunc func(p currency.Pair, assetType asset.Item) (orderbook.Base, error)
+
+// Stats counts the orderbook updates received and the verification failures
+// of their checksums and sequence numbers
+type Stats struct {
+	// Updates is the number of orderbook updates received
+	Updates int64 `json:"updates"`
+	// ChecksumMismatches is the number of updates whose checksum did not
+	// match the checksum of the updated orderbook
+	ChecksumMismatches int64 `json:"checksumMismatches"`
+	// SequenceGaps is the number of updates which did not follow the
+	// sequence number of the previous update
+	SequenceGaps int64 `json:"sequenceGaps"`
+	// StaleUpdates is the number of updates dropped as their sequence number
+	// was already applied
+	StaleUpdates int64 `json:"staleUpdates"`
+	// Resyncs is the number of orderbooks reloaded from a REST snapshot
+	Resyncs int64 `json:"resyncs"`
+	// ResyncFailures is the number of REST snapshots which could not be
+	// fetched or loaded, including retried attempts
+	ResyncFailures int64 `json:"resyncFailures"`
+}
+
 // WebsocketOrderbookLocal defines a local cache of orderbooks for amending,
 // appending and deleting changes and updates the main store in wsorderbook.go
 type WebsocketOrderbookLocal struct {
-	ob                    map[currency.Pair]map[string]*orderbook.Base
-	buffer                map[currency.Pair]map[string][]WebsocketOrderbookUpdate
+	stats                 Stats
+	ob                    map[currency.Pair]map[asset.Item]*orderbook.Depth
+	sequences             map[currency.Pair]map[asset.Item]int64
+	checksum              ChecksumFunc
+	snapshot              SnapshotFunc
+	buffer                map[currency.Pair]map[asset.Item][]WebsocketOrderbookUpdate
+	resyncing             map[currency.Pair]map[asset.Item]bool                       // Orderbooks reloaded from a REST snapshot in the background
+	held                  map[currency.Pair]map[asset.Item][]WebsocketOrderbookUpdate // Updates received while an orderbook is resynced
+	failed                map[currency.Pair]map[asset.Item]bool                       // Orderbooks whose resync failed until resubscribed
+	resyncs               sync.WaitGroup
+	resyncBackoff         time.Duration
+	maxHeldUpdates        int
+	maxDepth              int
 	obBufferLimit         int
 	bufferEnabled         bool
 	sortBuffer            bool
@@ -26,9 +65,19 @@ type WebsocketOrderbookLocal struct {
 type WebsocketOrderbookUpdate struct {
 	UpdateID     int64 // Used when no time is provided
 	UpdateTime   time.Time
-	AssetType    string
+	AssetType    asset.Item
 	Action       string // Used in conjunction with UpdateEntriesByID
 	Bids         []orderbook.Item
 	Asks         []orderbook.Item
 	CurrencyPair currency.Pair
+	// FirstSequence and Sequence are the exchange sequence numbers of the
+	// first and last change in the update, FirstSequence is zero when an
+	// update holds a single sequence number and Sequence is zero when the
+	// exchange does not publish them
+	FirstSequence int64
+	Sequence      int64
+	// Checksum is the exchange checksum of the orderbook after the update,
+	// verified when HasChecksum is set
+	Checksum    uint32
+	HasChecksum bool
 }
@@ -13,8 +13,8 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
-	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/websocket/wshandler"
 	log "github.com/thrasher-corp/gocryptotrader/logger"
 )
@@ -64,9 +64,24 @@ func (y *Yobit) SetDefaults() {
 	y.RequestCurrencyPairFormat.Separator = "-"
 	y.ConfigCurrencyPairFormat.Delimiter = "_"
 	y.ConfigCurrencyPairFormat.Uppercase = true
-	y.AssetTypes = []string{ticker.Spot}
-	y.SupportsAutoPairUpdating = false
-	y.SupportsRESTTickerBatching = true
+	y.AssetTypes = asset.Items{asset.Spot}
+	y.Features = exchange.Features{
+		REST: exchange.ProtocolFeatures{
+			TickerFetching:    true,
+			TickerBatching:    true,
+			OrderbookFetching: true,
+			TradeFetching:     true,
+			AccountInfo:       true,
+			ActiveOrders:      true,
+			OrderHistory:      true,
+			SubmitOrder:       true,
+			CancelOrder:       true,
+			CancelOrders:      true,
+			DepositAddress:    true,
+			CryptoWithdrawal:  true,
+		},
+		OrderTypes: []exchange.OrderType{exchange.LimitOrderType},
+	}
 	y.Requester = request.New(y.Name,
 		request.NewRateLimit(time.Second, yobitAuthRate),
 		request.NewRateLimit(time.Second, yobitUnauthRate),
@@ -95,6 +110,7 @@ func (y *Yobit) Setup(exch *config.ExchangeConfig) {
 		y.EnabledPairs = exch.EnabledPairs
 		y.SetHTTPClientTimeout(exch.HTTPTimeout)
 		y.SetHTTPClientUserAgent(exch.HTTPUserAgent)
+		y.SetHTTPRetryPolicy(exch.HTTPRetry)
 		err := y.SetCurrencyPairFormat()
 		if err != nil {
 			log.Fatal(err)
@@ -1,6 +1,7 @@
 package yobit
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"math"
@@ -12,6 +13,8 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/websocket/wshandler"
@@ -27,6 +30,14 @@ func (y *Yobit) Start(wg *sync.WaitGroup) {
 	}()
 }
 
+// WithContext returns a copy of Yobit whose HTTP requests are abandoned once
+// the context is done
+func (y *Yobit) WithContext(ctx context.Context) exchange.IBotExchange {
+	cp := *y
+	cp.Base = y.ContextBase(ctx)
+	return &cp
+}
+
 // Run implements the Yobit wrapper
 func (y *Yobit) Run() {
 	if y.Verbose {
@@ -37,12 +48,9 @@ func (y *Yobit) Run() {
 }
 
 // UpdateTicker updates and returns the ticker for a currency pair
-func (y *Yobit) UpdateTicker(p currency.Pair, assetType string) (ticker.Price, error) {
+func (y *Yobit) UpdateTicker(p currency.Pair, assetType asset.Item) (ticker.Price, error) {
 	var tickerPrice ticker.Price
-	pairsCollated, err := exchange.GetAndFormatExchangeCurrencies(y.Name, y.GetEnabledCurrencies())
-	if err != nil {
-		return tickerPrice, err
-	}
+	pairsCollated := y.GetAndFormatExchangeCurrencies(y.GetEnabledCurrencies())
 
 	result, err := y.GetTicker(pairsCollated)
 	if err != nil {
@@ -50,7 +58,7 @@ func (y *Yobit) UpdateTicker(p currency.Pair, assetType string) (ticker.Price, e
 	}
 
 	for _, x := range y.GetEnabledCurrencies() {
-		currency := exchange.FormatExchangeCurrency(y.Name, x).Lower().String()
+		currency := y.FormatExchangeCurrency(x).Lower().String()
 		var tickerPrice ticker.Price
 		tickerPrice.Pair = x
 		tickerPrice.Last = result[currency].Last
@@ -69,7 +77,7 @@ func (y *Yobit) UpdateTicker(p currency.Pair, assetType string) (ticker.Price, e
 }
 
 // GetTickerPrice returns the ticker for a currency pair
-func (y *Yobit) GetTickerPrice(p currency.Pair, assetType string) (ticker.Price, error) {
+func (y *Yobit) GetTickerPrice(p currency.Pair, assetType asset.Item) (ticker.Price, error) {
 	tick, err := ticker.GetTicker(y.GetName(), p, assetType)
 	if err != nil {
 		return y.UpdateTicker(p, assetType)
@@ -78,7 +86,7 @@ func (y *Yobit) GetTickerPrice(p currency.Pair, assetType string) (ticker.Price,
 }
 
 // GetOrderbookEx returns the orderbook for a currency pair
-func (y *Yobit) GetOrderbookEx(p currency.Pair, assetType string) (orderbook.Base, error) {
+func (y *Yobit) GetOrderbookEx(p currency.Pair, assetType asset.Item) (orderbook.Base, error) {
 	ob, err := orderbook.Get(y.GetName(), p, assetType)
 	if err != nil {
 		return y.UpdateOrderbook(p, assetType)
@@ -87,9 +95,9 @@ func (y *Yobit) GetOrderbookEx(p currency.Pair, assetType string) (orderbook.Bas
 }
 
 // UpdateOrderbook updates and returns the orderbook for a currency pair
-func (y *Yobit) UpdateOrderbook(p currency.Pair, assetType string) (orderbook.Base, error) {
+func (y *Yobit) UpdateOrderbook(p currency.Pair, assetType asset.Item) (orderbook.Base, error) {
 	var orderBook orderbook.Base
-	orderbookNew, err := y.GetDepth(exchange.FormatExchangeCurrency(y.Name, p).String())
+	orderbookNew, err := y.GetDepth(y.FormatExchangeCurrency(p).String())
 	if err != nil {
 		return orderBook, err
 	}
@@ -155,16 +163,47 @@ func (y *Yobit) GetFundingHistory() ([]exchange.FundHistory, error) {
 	return fundHistory, common.ErrFunctionNotSupported
 }
 
-// GetExchangeHistory returns historic trade data since exchange opening.
-func (y *Yobit) GetExchangeHistory(p currency.Pair, assetType string) ([]exchange.TradeHistory, error) {
-	var resp []exchange.TradeHistory
+// GetExchangeHistory returns the most recent trades for a currency pair
+func (y *Yobit) GetExchangeHistory(p currency.Pair, assetType asset.Item) ([]exchange.TradeHistory, error) {
+	trades, err := y.GetTrades(y.FormatExchangeCurrency(p).Lower().String())
+	if err != nil {
+		return nil, err
+	}
+
+	resp := make([]exchange.TradeHistory, len(trades))
+	for i := range trades {
+		side := exchange.BuyOrderSide
+		if trades[i].Type == "ask" {
+			side = exchange.SellOrderSide
+		}
+
+		resp[i] = exchange.TradeHistory{
+			Timestamp: time.Unix(trades[i].Timestamp, 0),
+			TID:       trades[i].TID,
+			ID:        strconv.FormatInt(trades[i].TID, 10),
+			Price:     trades[i].Price,
+			Amount:    trades[i].Amount,
+			Exchange:  y.Name,
+			Side:      side,
+		}
+	}
+	return resp, nil
+}
+
+// GetHistoricTrades returns historic trade data within the timeframe provided.
+// Yobit only provides the most recent trades
+func (y *Yobit) GetHistoricTrades(p currency.Pair, assetType asset.Item, timestampStart, timestampEnd time.Time) ([]exchange.TradeHistory, error) {
+	return nil, common.ErrFunctionNotSupported
+}
 
-	return resp, common.ErrNotYetImplemented
+// GetHistoricCandles returns candles between the start and end times
+func (y *Yobit) GetHistoricCandles(p currency.Pair, assetType asset.Item, timestampStart, timestampEnd time.Time, interval kline.Interval) (kline.Item, error) {
+	return kline.Item{}, common.ErrFunctionNotSupported
 }
 
 // SubmitOrder submits a new order
 // Yobit only supports limit orders
-func (y *Yobit) SubmitOrder(p currency.Pair, side exchange.OrderSide, orderType exchange.OrderType, amount, price float64, _ string) (exchange.SubmitOrderResponse, error) {
+func (y *Yobit) SubmitOrder(p currency.Pair, _ asset.Item, side exchange.OrderSide, orderType exchange.OrderType, amount, price float64, _ string) (exchange.SubmitOrderResponse, error) {
 	var submitOrderResponse exchange.SubmitOrderResponse
 
 	if orderType != exchange.LimitOrderType {
@@ -293,8 +332,7 @@ func (y *Yobit) GetFeeByType(feeBuilder *exchange.FeeBuilder) (float64, error) {
 func (y *Yobit) GetActiveOrders(getOrdersRequest *exchange.GetOrdersRequest) ([]exchange.OrderDetail, error) {
 	var orders []exchange.OrderDetail
 	for _, c := range getOrdersRequest.Currencies {
-		resp, err := y.GetOpenOrders(exchange.FormatExchangeCurrency(y.Name,
-			c).String())
+		resp, err := y.GetOpenOrders(y.FormatExchangeCurrency(c).String())
 		if err != nil {
 			return nil, err
 		}
@@ -334,7 +372,7 @@ func (y *Yobit) GetOrderHistory(getOrdersRequest *exchange.GetOrdersRequest) ([]
 			getOrdersRequest.StartTicks.Unix(),
 			getOrdersRequest.EndTicks.Unix(),
 			"DESC",
-			exchange.FormatExchangeCurrency(y.Name, currency).String())
+			y.FormatExchangeCurrency(currency).String())
 		if err != nil {
 			return nil, err
 		}
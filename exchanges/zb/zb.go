@@ -14,8 +14,9 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
-	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/websocket/wshandler"
 	log "github.com/thrasher-corp/gocryptotrader/logger"
 )
@@ -40,8 +41,28 @@ const (
 
 	zbAuthRate   = 100
 	zbUnauthRate = 100
+
+	// zbKlineLimit is the maximum number of candles returned per request
+	zbKlineLimit = 1000
 )
 
+// zbKlineTypes maps candle intervals to the kline types ZB supports
+var zbKlineTypes = map[kline.Interval]TimeInterval{
+	kline.OneMin:     TimeIntervalMinute,
+	kline.ThreeMin:   TimeIntervalThreeMinutes,
+	kline.FiveMin:    TimeIntervalFiveMinutes,
+	kline.FifteenMin: TimeIntervalFifteenMinutes,
+	kline.ThirtyMin:  TimeIntervalThirtyMinutes,
+	kline.OneHour:    TimeIntervalHour,
+	kline.TwoHour:    TimeIntervalTwoHours,
+	kline.FourHour:   TimeIntervalFourHours,
+	kline.SixHour:    TimeIntervalSixHours,
+	kline.TwelveHour: TimeIntervalTwelveHours,
+	kline.OneDay:     TimeIntervalDay,
+	kline.ThreeDay:   TimeIntervalThreeDays,
+	kline.OneWeek:    TimeIntervalWeek,
+}
+
 // ZB is the overarching type across this package
 // 47.91.169.147 api.zb.com
 // 47.52.55.212 trade.zb.com
@@ -57,15 +78,40 @@ func (z *ZB) SetDefaults() {
 	z.Fee = 0
 	z.Verbose = false
 	z.RESTPollingDelay = 10
-	z.APIWithdrawPermissions = exchange.AutoWithdrawCrypto |
-		exchange.NoFiatWithdrawals
 	z.RequestCurrencyPairFormat.Delimiter = "_"
 	z.RequestCurrencyPairFormat.Uppercase = false
 	z.ConfigCurrencyPairFormat.Delimiter = "_"
 	z.ConfigCurrencyPairFormat.Uppercase = true
-	z.AssetTypes = []string{ticker.Spot}
-	z.SupportsAutoPairUpdating = true
-	z.SupportsRESTTickerBatching = true
+	z.AssetTypes = asset.Items{asset.Spot}
+	z.Features = exchange.Features{
+		REST: exchange.ProtocolFeatures{
+			TickerFetching:    true,
+			TickerBatching:    true,
+			OrderbookFetching: true,
+			KlineFetching:     true,
+			AutoPairUpdates:   true,
+			AccountInfo:       true,
+			ActiveOrders:      true,
+			OrderHistory:      true,
+			SubmitOrder:       true,
+			CancelOrder:       true,
+			CancelOrders:      true,
+			DepositAddress:    true,
+			CryptoWithdrawal:  true,
+		},
+		Websocket: exchange.ProtocolFeatures{
+			TickerFetching:         true,
+			OrderbookFetching:      true,
+			TradeFetching:          true,
+			AccountInfo:            true,
+			SubmitOrder:            true,
+			CancelOrder:            true,
+			Subscribe:              true,
+			AuthenticatedEndpoints: true,
+			MessageCorrelation:     true,
+		},
+		OrderTypes: []exchange.OrderType{exchange.LimitOrderType},
+	}
 	z.Requester = request.New(z.Name,
 		request.NewRateLimit(time.Second*10, zbAuthRate),
 		request.NewRateLimit(time.Second*10, zbUnauthRate),
@@ -75,15 +121,7 @@ func (z *ZB) SetDefaults() {
 	z.APIUrlSecondaryDefault = zbMarketURL
 	z.APIUrlSecondary = z.APIUrlSecondaryDefault
 	z.Websocket = wshandler.New()
-	z.Websocket.Functionality = wshandler.WebsocketTickerSupported |
-		wshandler.WebsocketOrderbookSupported |
-		wshandler.WebsocketTradeDataSupported |
-		wshandler.WebsocketSubscribeSupported |
-		wshandler.WebsocketAuthenticatedEndpointsSupported |
-		wshandler.WebsocketAccountDataSupported |
-		wshandler.WebsocketCancelOrderSupported |
-		wshandler.WebsocketSubmitOrderSupported |
-		wshandler.WebsocketMessageCorrelationSupported
+	z.Websocket.Functionality = z.Features.Websocket.Functionality()
 	z.WebsocketResponseMaxLimit = exchange.DefaultWebsocketResponseMaxLimit
 	z.WebsocketResponseCheckTimeout = exchange.DefaultWebsocketResponseCheckTimeout
 	z.WebsocketOrderbookBufferLimit = exchange.DefaultWebsocketOrderbookBufferLimit
@@ -101,6 +139,7 @@ func (z *ZB) Setup(exch *config.ExchangeConfig) {
 		z.APIAuthPEMKey = exch.APIAuthPEMKey
 		z.SetHTTPClientTimeout(exch.HTTPTimeout)
 		z.SetHTTPClientUserAgent(exch.HTTPUserAgent)
+		z.SetHTTPRetryPolicy(exch.HTTPRetry)
 		z.RESTPollingDelay = exch.RESTPollingDelay
 		z.Verbose = exch.Verbose
 		z.HTTPDebugging = exch.HTTPDebugging
@@ -10,6 +10,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/websocket/wshandler"
 )
 
@@ -336,8 +337,7 @@ func TestSubmitOrder(t *testing.T) {
 		Quote:     currency.USDT,
 	}
 
-	response, err := z.SubmitOrder(pair,
-		exchange.BuyOrderSide,
+	response, err := z.SubmitOrder(pair, asset.Spot, exchange.BuyOrderSide,
 		exchange.MarketOrderType,
 		1,
 		10,
@@ -11,6 +11,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/websocket/wshandler"
 	log "github.com/thrasher-corp/gocryptotrader/logger"
@@ -95,11 +96,13 @@ func (z *ZB) WsHandleData() {
 				z.Websocket.DataHandler <- wshandler.TickerData{
 					Timestamp:  time.Unix(0, ticker.Date),
 					Pair:       currency.NewPairFromString(cPair[0]),
-					AssetType:  orderbook.Spot,
+					AssetType:  asset.Spot,
 					Exchange:   z.GetName(),
 					ClosePrice: ticker.Data.Last,
 					HighPrice:  ticker.Data.High,
 					LowPrice:   ticker.Data.Low,
+					BidPrice:   ticker.Data.Buy,
+					AskPrice:   ticker.Data.Sell,
 				}
 
 			case common.StringContains(result.Channel, "depth"):
@@ -133,7 +136,7 @@ func (z *ZB) WsHandleData() {
 				var newOrderBook orderbook.Base
 				newOrderBook.Asks = asks
 				newOrderBook.Bids = bids
-				newOrderBook.AssetType = orderbook.Spot
+				newOrderBook.AssetType = asset.Spot
 				newOrderBook.Pair = cPair
 
 				err = z.Websocket.Orderbook.LoadSnapshot(&newOrderBook,
@@ -145,7 +148,7 @@ func (z *ZB) WsHandleData() {
 
 				z.Websocket.DataHandler <- wshandler.WebsocketOrderbookUpdate{
 					Pair:     cPair,
-					Asset:    orderbook.Spot,
+					Asset:    asset.Spot,
 					Exchange: z.GetName(),
 				}
 
@@ -166,7 +169,7 @@ func (z *ZB) WsHandleData() {
 				z.Websocket.DataHandler <- wshandler.TradeData{
 					Timestamp:    time.Unix(0, t.Date),
 					CurrencyPair: cPair,
-					AssetType:    orderbook.Spot,
+					AssetType:    asset.Spot,
 					Exchange:     z.GetName(),
 					EventTime:    t.Date,
 					Price:        t.Price,
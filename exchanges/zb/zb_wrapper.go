@@ -1,6 +1,7 @@
 package zb
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"strconv"
@@ -10,6 +11,8 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/websocket/wshandler"
@@ -25,6 +28,14 @@ func (z *ZB) Start(wg *sync.WaitGroup) {
 	}()
 }
 
+// WithContext returns a copy of ZB whose HTTP requests are abandoned once
+// the context is done
+func (z *ZB) WithContext(ctx context.Context) exchange.IBotExchange {
+	cp := *z
+	cp.Base = z.ContextBase(ctx)
+	return &cp
+}
+
 // Run implements the OKEX wrapper
 func (z *ZB) Run() {
 	if z.Verbose {
@@ -56,7 +67,7 @@ func (z *ZB) Run() {
 }
 
 // UpdateTicker updates and returns the ticker for a currency pair
-func (z *ZB) UpdateTicker(p currency.Pair, assetType string) (ticker.Price, error) {
+func (z *ZB) UpdateTicker(p currency.Pair, assetType asset.Item) (ticker.Price, error) {
 	var tickerPrice ticker.Price
 
 	result, err := z.GetTickers()
@@ -65,7 +76,7 @@ func (z *ZB) UpdateTicker(p currency.Pair, assetType string) (ticker.Price, erro
 	}
 
 	for _, x := range z.GetEnabledCurrencies() {
-		currencySplit := common.SplitStrings(exchange.FormatExchangeCurrency(z.Name, x).String(), "_")
+		currencySplit := common.SplitStrings(z.FormatExchangeCurrency(x).String(), "_")
 		currency := currencySplit[0] + currencySplit[1]
 		var tp ticker.Price
 		tp.Pair = x
@@ -87,7 +98,7 @@ func (z *ZB) UpdateTicker(p currency.Pair, assetType string) (ticker.Price, erro
 }
 
 // GetTickerPrice returns the ticker for a currency pair
-func (z *ZB) GetTickerPrice(p currency.Pair, assetType string) (ticker.Price, error) {
+func (z *ZB) GetTickerPrice(p currency.Pair, assetType asset.Item) (ticker.Price, error) {
 	tickerNew, err := ticker.GetTicker(z.GetName(), p, assetType)
 	if err != nil {
 		return z.UpdateTicker(p, assetType)
@@ -96,7 +107,7 @@ func (z *ZB) GetTickerPrice(p currency.Pair, assetType string) (ticker.Price, er
 }
 
 // GetOrderbookEx returns orderbook base on the currency pair
-func (z *ZB) GetOrderbookEx(currency currency.Pair, assetType string) (orderbook.Base, error) {
+func (z *ZB) GetOrderbookEx(currency currency.Pair, assetType asset.Item) (orderbook.Base, error) {
 	ob, err := orderbook.Get(z.GetName(), currency, assetType)
 	if err != nil {
 		return z.UpdateOrderbook(currency, assetType)
@@ -105,9 +116,9 @@ func (z *ZB) GetOrderbookEx(currency currency.Pair, assetType string) (orderbook
 }
 
 // UpdateOrderbook updates and returns the orderbook for a currency pair
-func (z *ZB) UpdateOrderbook(p currency.Pair, assetType string) (orderbook.Base, error) {
+func (z *ZB) UpdateOrderbook(p currency.Pair, assetType asset.Item) (orderbook.Base, error) {
 	var orderBook orderbook.Base
-	currency := exchange.FormatExchangeCurrency(z.Name, p).String()
+	currency := z.FormatExchangeCurrency(p).String()
 
 	orderbookNew, err := z.GetOrderbook(currency)
 	if err != nil {
@@ -180,14 +191,69 @@ func (z *ZB) GetFundingHistory() ([]exchange.FundHistory, error) {
 }
 
 // GetExchangeHistory returns historic trade data since exchange opening.
-func (z *ZB) GetExchangeHistory(p currency.Pair, assetType string) ([]exchange.TradeHistory, error) {
+func (z *ZB) GetExchangeHistory(p currency.Pair, assetType asset.Item) ([]exchange.TradeHistory, error) {
 	var resp []exchange.TradeHistory
 
 	return resp, common.ErrNotYetImplemented
 }
 
+// GetHistoricTrades returns historic trade data within the timeframe provided.
+func (z *ZB) GetHistoricTrades(p currency.Pair, assetType asset.Item, timestampStart, timestampEnd time.Time) ([]exchange.TradeHistory, error) {
+	return nil, common.ErrFunctionNotSupported
+}
+
+// GetHistoricCandles returns candles between the start and end times, paging
+// forward from the start time up to 1000 candles at a time
+func (z *ZB) GetHistoricCandles(p currency.Pair, assetType asset.Item, timestampStart, timestampEnd time.Time, interval kline.Interval) (kline.Item, error) {
+	err := kline.CheckRequest(timestampStart, timestampEnd, interval)
+	if err != nil {
+		return kline.Item{}, err
+	}
+
+	klineType, ok := zbKlineTypes[interval]
+	if !ok {
+		return kline.Item{}, kline.ErrUnsupportedInterval
+	}
+
+	item := kline.Item{
+		Exchange:  z.Name,
+		Pair:      p,
+		AssetType: assetType,
+		Interval:  interval,
+	}
+
+	symbol := z.FormatExchangeCurrency(p).String()
+	dates := kline.CalculateCandleDateRanges(timestampStart, timestampEnd,
+		interval, zbKlineLimit)
+	for x := range dates {
+		candles, err := z.GetSpotKline(KlinesRequestParams{
+			Symbol: symbol,
+			Type:   klineType,
+			Since:  strconv.FormatInt(common.UnixMillis(dates[x].Start), 10),
+			Size:   zbKlineLimit,
+		})
+		if err != nil {
+			return kline.Item{}, err
+		}
+
+		for i := range candles.Data {
+			item.Candles = append(item.Candles, kline.Candle{
+				Time:   candles.Data[i].KlineTime,
+				Open:   candles.Data[i].Open,
+				High:   candles.Data[i].High,
+				Low:    candles.Data[i].Low,
+				Close:  candles.Data[i].Close,
+				Volume: candles.Data[i].Volume,
+			})
+		}
+	}
+
+	item.FilterCandles(timestampStart, timestampEnd)
+	return item, nil
+}
+
 // SubmitOrder submits a new order
-func (z *ZB) SubmitOrder(p currency.Pair, side exchange.OrderSide, _ exchange.OrderType, amount, price float64, _ string) (exchange.SubmitOrderResponse, error) {
+func (z *ZB) SubmitOrder(p currency.Pair, _ asset.Item, side exchange.OrderSide, _ exchange.OrderType, amount, price float64, _ string) (exchange.SubmitOrderResponse, error) {
 	var submitOrderResponse exchange.SubmitOrderResponse
 	var oT SpotNewOrderRequestParamsType
 
@@ -230,7 +296,7 @@ func (z *ZB) CancelOrder(order *exchange.OrderCancellation) error {
 		return err
 	}
 
-	return z.CancelExistingOrder(orderIDInt, exchange.FormatExchangeCurrency(z.Name, order.CurrencyPair).String())
+	return z.CancelExistingOrder(orderIDInt, z.FormatExchangeCurrency(order.CurrencyPair).String())
 }
 
 // CancelAllOrders cancels all orders associated with a currency pair
@@ -242,7 +308,7 @@ func (z *ZB) CancelAllOrders(_ *exchange.OrderCancellation) (exchange.CancelAllO
 	for _, currency := range z.GetEnabledCurrencies() {
 		// Limiting to 10 pages
 		for i := 0; i < 10; i++ {
-			openOrders, err := z.GetUnfinishedOrdersIgnoreTradeType(exchange.FormatExchangeCurrency(z.Name, currency).String(), 1, 10)
+			openOrders, err := z.GetUnfinishedOrdersIgnoreTradeType(z.FormatExchangeCurrency(currency).String(), 1, 10)
 			if err != nil {
 				return cancelAllOrdersResponse, err
 			}
@@ -321,7 +387,7 @@ func (z *ZB) GetActiveOrders(getOrdersRequest *exchange.GetOrdersRequest) ([]exc
 		var pageNumber int64
 		// Limiting to 10 pages
 		for i := 0; i < 10; i++ {
-			resp, err := z.GetUnfinishedOrdersIgnoreTradeType(exchange.FormatExchangeCurrency(z.Name, currency).String(), pageNumber, 10)
+			resp, err := z.GetUnfinishedOrdersIgnoreTradeType(z.FormatExchangeCurrency(currency).String(), pageNumber, 10)
 			if err != nil {
 				return nil, err
 			}
@@ -377,7 +443,7 @@ func (z *ZB) GetOrderHistory(getOrdersRequest *exchange.GetOrdersRequest) ([]exc
 		var pageNumber int64
 		// Limiting to 10 pages
 		for i := 0; i < 10; i++ {
-			resp, err := z.GetOrders(exchange.FormatExchangeCurrency(z.Name, currency).String(), pageNumber, side)
+			resp, err := z.GetOrders(z.FormatExchangeCurrency(currency).String(), pageNumber, side)
 			if err != nil {
 				return nil, err
 			}
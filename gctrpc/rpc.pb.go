@@ -0,0 +1,5174 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: rpc.proto
+
+package gctrpc
+
+import (
+	context "context"
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+type GetInfoRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetInfoRequest) Reset()         { *m = GetInfoRequest{} }
+func (m *GetInfoRequest) String() string { return proto.CompactTextString(m) }
+func (*GetInfoRequest) ProtoMessage()    {}
+func (*GetInfoRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{0}
+}
+
+func (m *GetInfoRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetInfoRequest.Unmarshal(m, b)
+}
+func (m *GetInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetInfoRequest.Marshal(b, m, deterministic)
+}
+func (m *GetInfoRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetInfoRequest.Merge(m, src)
+}
+func (m *GetInfoRequest) XXX_Size() int {
+	return xxx_messageInfo_GetInfoRequest.Size(m)
+}
+func (m *GetInfoRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetInfoRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetInfoRequest proto.InternalMessageInfo
+
+type GetInfoResponse struct {
+	Uptime               string          `protobuf:"bytes,1,opt,name=uptime,proto3" json:"uptime,omitempty"`
+	AvailableExchanges   int64           `protobuf:"varint,2,opt,name=available_exchanges,json=availableExchanges,proto3" json:"available_exchanges,omitempty"`
+	EnabledExchanges     int64           `protobuf:"varint,3,opt,name=enabled_exchanges,json=enabledExchanges,proto3" json:"enabled_exchanges,omitempty"`
+	DefaultForexProvider string          `protobuf:"bytes,4,opt,name=default_forex_provider,json=defaultForexProvider,proto3" json:"default_forex_provider,omitempty"`
+	DefaultFiatCurrency  string          `protobuf:"bytes,5,opt,name=default_fiat_currency,json=defaultFiatCurrency,proto3" json:"default_fiat_currency,omitempty"`
+	SubsystemStatus      map[string]bool `protobuf:"bytes,6,rep,name=subsystem_status,json=subsystemStatus,proto3" json:"subsystem_status,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	Version              string          `protobuf:"bytes,7,opt,name=version,proto3" json:"version,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *GetInfoResponse) Reset()         { *m = GetInfoResponse{} }
+func (m *GetInfoResponse) String() string { return proto.CompactTextString(m) }
+func (*GetInfoResponse) ProtoMessage()    {}
+func (*GetInfoResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{1}
+}
+
+func (m *GetInfoResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetInfoResponse.Unmarshal(m, b)
+}
+func (m *GetInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetInfoResponse.Marshal(b, m, deterministic)
+}
+func (m *GetInfoResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetInfoResponse.Merge(m, src)
+}
+func (m *GetInfoResponse) XXX_Size() int {
+	return xxx_messageInfo_GetInfoResponse.Size(m)
+}
+func (m *GetInfoResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetInfoResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetInfoResponse proto.InternalMessageInfo
+
+func (m *GetInfoResponse) GetUptime() string {
+	if m != nil {
+		return m.Uptime
+	}
+	return ""
+}
+
+func (m *GetInfoResponse) GetAvailableExchanges() int64 {
+	if m != nil {
+		return m.AvailableExchanges
+	}
+	return 0
+}
+
+func (m *GetInfoResponse) GetEnabledExchanges() int64 {
+	if m != nil {
+		return m.EnabledExchanges
+	}
+	return 0
+}
+
+func (m *GetInfoResponse) GetDefaultForexProvider() string {
+	if m != nil {
+		return m.DefaultForexProvider
+	}
+	return ""
+}
+
+func (m *GetInfoResponse) GetDefaultFiatCurrency() string {
+	if m != nil {
+		return m.DefaultFiatCurrency
+	}
+	return ""
+}
+
+func (m *GetInfoResponse) GetSubsystemStatus() map[string]bool {
+	if m != nil {
+		return m.SubsystemStatus
+	}
+	return nil
+}
+
+func (m *GetInfoResponse) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
+type GetSubsystemsRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetSubsystemsRequest) Reset()         { *m = GetSubsystemsRequest{} }
+func (m *GetSubsystemsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetSubsystemsRequest) ProtoMessage()    {}
+func (*GetSubsystemsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{2}
+}
+
+func (m *GetSubsystemsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetSubsystemsRequest.Unmarshal(m, b)
+}
+func (m *GetSubsystemsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetSubsystemsRequest.Marshal(b, m, deterministic)
+}
+func (m *GetSubsystemsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetSubsystemsRequest.Merge(m, src)
+}
+func (m *GetSubsystemsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetSubsystemsRequest.Size(m)
+}
+func (m *GetSubsystemsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetSubsystemsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetSubsystemsRequest proto.InternalMessageInfo
+
+type GetSubsystemsResponse struct {
+	SubsystemsStatus     map[string]bool `protobuf:"bytes,1,rep,name=subsystems_status,json=subsystemsStatus,proto3" json:"subsystems_status,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *GetSubsystemsResponse) Reset()         { *m = GetSubsystemsResponse{} }
+func (m *GetSubsystemsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetSubsystemsResponse) ProtoMessage()    {}
+func (*GetSubsystemsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{3}
+}
+
+func (m *GetSubsystemsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetSubsystemsResponse.Unmarshal(m, b)
+}
+func (m *GetSubsystemsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetSubsystemsResponse.Marshal(b, m, deterministic)
+}
+func (m *GetSubsystemsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetSubsystemsResponse.Merge(m, src)
+}
+func (m *GetSubsystemsResponse) XXX_Size() int {
+	return xxx_messageInfo_GetSubsystemsResponse.Size(m)
+}
+func (m *GetSubsystemsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetSubsystemsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetSubsystemsResponse proto.InternalMessageInfo
+
+func (m *GetSubsystemsResponse) GetSubsystemsStatus() map[string]bool {
+	if m != nil {
+		return m.SubsystemsStatus
+	}
+	return nil
+}
+
+type SetSubsystemRequest struct {
+	Subsystem            string   `protobuf:"bytes,1,opt,name=subsystem,proto3" json:"subsystem,omitempty"`
+	Enable               bool     `protobuf:"varint,2,opt,name=enable,proto3" json:"enable,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetSubsystemRequest) Reset()         { *m = SetSubsystemRequest{} }
+func (m *SetSubsystemRequest) String() string { return proto.CompactTextString(m) }
+func (*SetSubsystemRequest) ProtoMessage()    {}
+func (*SetSubsystemRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{4}
+}
+
+func (m *SetSubsystemRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetSubsystemRequest.Unmarshal(m, b)
+}
+func (m *SetSubsystemRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetSubsystemRequest.Marshal(b, m, deterministic)
+}
+func (m *SetSubsystemRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetSubsystemRequest.Merge(m, src)
+}
+func (m *SetSubsystemRequest) XXX_Size() int {
+	return xxx_messageInfo_SetSubsystemRequest.Size(m)
+}
+func (m *SetSubsystemRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetSubsystemRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetSubsystemRequest proto.InternalMessageInfo
+
+func (m *SetSubsystemRequest) GetSubsystem() string {
+	if m != nil {
+		return m.Subsystem
+	}
+	return ""
+}
+
+func (m *SetSubsystemRequest) GetEnable() bool {
+	if m != nil {
+		return m.Enable
+	}
+	return false
+}
+
+type ReloadConfigRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReloadConfigRequest) Reset()         { *m = ReloadConfigRequest{} }
+func (m *ReloadConfigRequest) String() string { return proto.CompactTextString(m) }
+func (*ReloadConfigRequest) ProtoMessage()    {}
+func (*ReloadConfigRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{5}
+}
+
+func (m *ReloadConfigRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReloadConfigRequest.Unmarshal(m, b)
+}
+func (m *ReloadConfigRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReloadConfigRequest.Marshal(b, m, deterministic)
+}
+func (m *ReloadConfigRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReloadConfigRequest.Merge(m, src)
+}
+func (m *ReloadConfigRequest) XXX_Size() int {
+	return xxx_messageInfo_ReloadConfigRequest.Size(m)
+}
+func (m *ReloadConfigRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReloadConfigRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReloadConfigRequest proto.InternalMessageInfo
+
+type ReloadConfigResponse struct {
+	LoadedExchanges       []string `protobuf:"bytes,1,rep,name=loaded_exchanges,json=loadedExchanges,proto3" json:"loaded_exchanges,omitempty"`
+	ReloadedExchanges     []string `protobuf:"bytes,2,rep,name=reloaded_exchanges,json=reloadedExchanges,proto3" json:"reloaded_exchanges,omitempty"`
+	UnloadedExchanges     []string `protobuf:"bytes,3,rep,name=unloaded_exchanges,json=unloadedExchanges,proto3" json:"unloaded_exchanges,omitempty"`
+	ResubscribedExchanges []string `protobuf:"bytes,4,rep,name=resubscribed_exchanges,json=resubscribedExchanges,proto3" json:"resubscribed_exchanges,omitempty"`
+	RestartedSubsystems   []string `protobuf:"bytes,5,rep,name=restarted_subsystems,json=restartedSubsystems,proto3" json:"restarted_subsystems,omitempty"`
+	RestartRequired       []string `protobuf:"bytes,6,rep,name=restart_required,json=restartRequired,proto3" json:"restart_required,omitempty"`
+	XXX_NoUnkeyedLiteral  struct{} `json:"-"`
+	XXX_unrecognized      []byte   `json:"-"`
+	XXX_sizecache         int32    `json:"-"`
+}
+
+func (m *ReloadConfigResponse) Reset()         { *m = ReloadConfigResponse{} }
+func (m *ReloadConfigResponse) String() string { return proto.CompactTextString(m) }
+func (*ReloadConfigResponse) ProtoMessage()    {}
+func (*ReloadConfigResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{6}
+}
+
+func (m *ReloadConfigResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReloadConfigResponse.Unmarshal(m, b)
+}
+func (m *ReloadConfigResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReloadConfigResponse.Marshal(b, m, deterministic)
+}
+func (m *ReloadConfigResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReloadConfigResponse.Merge(m, src)
+}
+func (m *ReloadConfigResponse) XXX_Size() int {
+	return xxx_messageInfo_ReloadConfigResponse.Size(m)
+}
+func (m *ReloadConfigResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReloadConfigResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReloadConfigResponse proto.InternalMessageInfo
+
+func (m *ReloadConfigResponse) GetLoadedExchanges() []string {
+	if m != nil {
+		return m.LoadedExchanges
+	}
+	return nil
+}
+
+func (m *ReloadConfigResponse) GetReloadedExchanges() []string {
+	if m != nil {
+		return m.ReloadedExchanges
+	}
+	return nil
+}
+
+func (m *ReloadConfigResponse) GetUnloadedExchanges() []string {
+	if m != nil {
+		return m.UnloadedExchanges
+	}
+	return nil
+}
+
+func (m *ReloadConfigResponse) GetResubscribedExchanges() []string {
+	if m != nil {
+		return m.ResubscribedExchanges
+	}
+	return nil
+}
+
+func (m *ReloadConfigResponse) GetRestartedSubsystems() []string {
+	if m != nil {
+		return m.RestartedSubsystems
+	}
+	return nil
+}
+
+func (m *ReloadConfigResponse) GetRestartRequired() []string {
+	if m != nil {
+		return m.RestartRequired
+	}
+	return nil
+}
+
+type GetExchangesRequest struct {
+	Enabled              bool     `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetExchangesRequest) Reset()         { *m = GetExchangesRequest{} }
+func (m *GetExchangesRequest) String() string { return proto.CompactTextString(m) }
+func (*GetExchangesRequest) ProtoMessage()    {}
+func (*GetExchangesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{7}
+}
+
+func (m *GetExchangesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetExchangesRequest.Unmarshal(m, b)
+}
+func (m *GetExchangesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetExchangesRequest.Marshal(b, m, deterministic)
+}
+func (m *GetExchangesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetExchangesRequest.Merge(m, src)
+}
+func (m *GetExchangesRequest) XXX_Size() int {
+	return xxx_messageInfo_GetExchangesRequest.Size(m)
+}
+func (m *GetExchangesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetExchangesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetExchangesRequest proto.InternalMessageInfo
+
+func (m *GetExchangesRequest) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+type GetExchangesResponse struct {
+	Exchanges            []string `protobuf:"bytes,1,rep,name=exchanges,proto3" json:"exchanges,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetExchangesResponse) Reset()         { *m = GetExchangesResponse{} }
+func (m *GetExchangesResponse) String() string { return proto.CompactTextString(m) }
+func (*GetExchangesResponse) ProtoMessage()    {}
+func (*GetExchangesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{8}
+}
+
+func (m *GetExchangesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetExchangesResponse.Unmarshal(m, b)
+}
+func (m *GetExchangesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetExchangesResponse.Marshal(b, m, deterministic)
+}
+func (m *GetExchangesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetExchangesResponse.Merge(m, src)
+}
+func (m *GetExchangesResponse) XXX_Size() int {
+	return xxx_messageInfo_GetExchangesResponse.Size(m)
+}
+func (m *GetExchangesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetExchangesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetExchangesResponse proto.InternalMessageInfo
+
+func (m *GetExchangesResponse) GetExchanges() []string {
+	if m != nil {
+		return m.Exchanges
+	}
+	return nil
+}
+
+type GenericExchangeNameRequest struct {
+	Exchange             string   `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GenericExchangeNameRequest) Reset()         { *m = GenericExchangeNameRequest{} }
+func (m *GenericExchangeNameRequest) String() string { return proto.CompactTextString(m) }
+func (*GenericExchangeNameRequest) ProtoMessage()    {}
+func (*GenericExchangeNameRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{9}
+}
+
+func (m *GenericExchangeNameRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GenericExchangeNameRequest.Unmarshal(m, b)
+}
+func (m *GenericExchangeNameRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GenericExchangeNameRequest.Marshal(b, m, deterministic)
+}
+func (m *GenericExchangeNameRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenericExchangeNameRequest.Merge(m, src)
+}
+func (m *GenericExchangeNameRequest) XXX_Size() int {
+	return xxx_messageInfo_GenericExchangeNameRequest.Size(m)
+}
+func (m *GenericExchangeNameRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenericExchangeNameRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenericExchangeNameRequest proto.InternalMessageInfo
+
+func (m *GenericExchangeNameRequest) GetExchange() string {
+	if m != nil {
+		return m.Exchange
+	}
+	return ""
+}
+
+type GenericResponse struct {
+	Status               string   `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Data                 string   `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GenericResponse) Reset()         { *m = GenericResponse{} }
+func (m *GenericResponse) String() string { return proto.CompactTextString(m) }
+func (*GenericResponse) ProtoMessage()    {}
+func (*GenericResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{10}
+}
+
+func (m *GenericResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GenericResponse.Unmarshal(m, b)
+}
+func (m *GenericResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GenericResponse.Marshal(b, m, deterministic)
+}
+func (m *GenericResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenericResponse.Merge(m, src)
+}
+func (m *GenericResponse) XXX_Size() int {
+	return xxx_messageInfo_GenericResponse.Size(m)
+}
+func (m *GenericResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenericResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenericResponse proto.InternalMessageInfo
+
+func (m *GenericResponse) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *GenericResponse) GetData() string {
+	if m != nil {
+		return m.Data
+	}
+	return ""
+}
+
+type GetExchangeInfoResponse struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Enabled              bool     `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	Verbose              bool     `protobuf:"varint,3,opt,name=verbose,proto3" json:"verbose,omitempty"`
+	UsingSandbox         bool     `protobuf:"varint,4,opt,name=using_sandbox,json=usingSandbox,proto3" json:"using_sandbox,omitempty"`
+	HttpTimeout          string   `protobuf:"bytes,5,opt,name=http_timeout,json=httpTimeout,proto3" json:"http_timeout,omitempty"`
+	HttpUseragent        string   `protobuf:"bytes,6,opt,name=http_useragent,json=httpUseragent,proto3" json:"http_useragent,omitempty"`
+	HttpProxy            string   `protobuf:"bytes,7,opt,name=http_proxy,json=httpProxy,proto3" json:"http_proxy,omitempty"`
+	BaseCurrencies       string   `protobuf:"bytes,8,opt,name=base_currencies,json=baseCurrencies,proto3" json:"base_currencies,omitempty"`
+	AvailablePairs       string   `protobuf:"bytes,9,opt,name=available_pairs,json=availablePairs,proto3" json:"available_pairs,omitempty"`
+	EnabledPairs         string   `protobuf:"bytes,10,opt,name=enabled_pairs,json=enabledPairs,proto3" json:"enabled_pairs,omitempty"`
+	AuthenticatedApi     bool     `protobuf:"varint,11,opt,name=authenticated_api,json=authenticatedApi,proto3" json:"authenticated_api,omitempty"`
+	WebsocketEnabled     bool     `protobuf:"varint,12,opt,name=websocket_enabled,json=websocketEnabled,proto3" json:"websocket_enabled,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetExchangeInfoResponse) Reset()         { *m = GetExchangeInfoResponse{} }
+func (m *GetExchangeInfoResponse) String() string { return proto.CompactTextString(m) }
+func (*GetExchangeInfoResponse) ProtoMessage()    {}
+func (*GetExchangeInfoResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{11}
+}
+
+func (m *GetExchangeInfoResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetExchangeInfoResponse.Unmarshal(m, b)
+}
+func (m *GetExchangeInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetExchangeInfoResponse.Marshal(b, m, deterministic)
+}
+func (m *GetExchangeInfoResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetExchangeInfoResponse.Merge(m, src)
+}
+func (m *GetExchangeInfoResponse) XXX_Size() int {
+	return xxx_messageInfo_GetExchangeInfoResponse.Size(m)
+}
+func (m *GetExchangeInfoResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetExchangeInfoResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetExchangeInfoResponse proto.InternalMessageInfo
+
+func (m *GetExchangeInfoResponse) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *GetExchangeInfoResponse) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+func (m *GetExchangeInfoResponse) GetVerbose() bool {
+	if m != nil {
+		return m.Verbose
+	}
+	return false
+}
+
+func (m *GetExchangeInfoResponse) GetUsingSandbox() bool {
+	if m != nil {
+		return m.UsingSandbox
+	}
+	return false
+}
+
+func (m *GetExchangeInfoResponse) GetHttpTimeout() string {
+	if m != nil {
+		return m.HttpTimeout
+	}
+	return ""
+}
+
+func (m *GetExchangeInfoResponse) GetHttpUseragent() string {
+	if m != nil {
+		return m.HttpUseragent
+	}
+	return ""
+}
+
+func (m *GetExchangeInfoResponse) GetHttpProxy() string {
+	if m != nil {
+		return m.HttpProxy
+	}
+	return ""
+}
+
+func (m *GetExchangeInfoResponse) GetBaseCurrencies() string {
+	if m != nil {
+		return m.BaseCurrencies
+	}
+	return ""
+}
+
+func (m *GetExchangeInfoResponse) GetAvailablePairs() string {
+	if m != nil {
+		return m.AvailablePairs
+	}
+	return ""
+}
+
+func (m *GetExchangeInfoResponse) GetEnabledPairs() string {
+	if m != nil {
+		return m.EnabledPairs
+	}
+	return ""
+}
+
+func (m *GetExchangeInfoResponse) GetAuthenticatedApi() bool {
+	if m != nil {
+		return m.AuthenticatedApi
+	}
+	return false
+}
+
+func (m *GetExchangeInfoResponse) GetWebsocketEnabled() bool {
+	if m != nil {
+		return m.WebsocketEnabled
+	}
+	return false
+}
+
+type CurrencyPair struct {
+	Delimiter            string   `protobuf:"bytes,1,opt,name=delimiter,proto3" json:"delimiter,omitempty"`
+	Base                 string   `protobuf:"bytes,2,opt,name=base,proto3" json:"base,omitempty"`
+	Quote                string   `protobuf:"bytes,3,opt,name=quote,proto3" json:"quote,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CurrencyPair) Reset()         { *m = CurrencyPair{} }
+func (m *CurrencyPair) String() string { return proto.CompactTextString(m) }
+func (*CurrencyPair) ProtoMessage()    {}
+func (*CurrencyPair) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{12}
+}
+
+func (m *CurrencyPair) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CurrencyPair.Unmarshal(m, b)
+}
+func (m *CurrencyPair) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CurrencyPair.Marshal(b, m, deterministic)
+}
+func (m *CurrencyPair) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CurrencyPair.Merge(m, src)
+}
+func (m *CurrencyPair) XXX_Size() int {
+	return xxx_messageInfo_CurrencyPair.Size(m)
+}
+func (m *CurrencyPair) XXX_DiscardUnknown() {
+	xxx_messageInfo_CurrencyPair.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CurrencyPair proto.InternalMessageInfo
+
+func (m *CurrencyPair) GetDelimiter() string {
+	if m != nil {
+		return m.Delimiter
+	}
+	return ""
+}
+
+func (m *CurrencyPair) GetBase() string {
+	if m != nil {
+		return m.Base
+	}
+	return ""
+}
+
+func (m *CurrencyPair) GetQuote() string {
+	if m != nil {
+		return m.Quote
+	}
+	return ""
+}
+
+type GetTickerRequest struct {
+	Exchange             string        `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Pair                 *CurrencyPair `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
+	AssetType            string        `protobuf:"bytes,3,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *GetTickerRequest) Reset()         { *m = GetTickerRequest{} }
+func (m *GetTickerRequest) String() string { return proto.CompactTextString(m) }
+func (*GetTickerRequest) ProtoMessage()    {}
+func (*GetTickerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{13}
+}
+
+func (m *GetTickerRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetTickerRequest.Unmarshal(m, b)
+}
+func (m *GetTickerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetTickerRequest.Marshal(b, m, deterministic)
+}
+func (m *GetTickerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTickerRequest.Merge(m, src)
+}
+func (m *GetTickerRequest) XXX_Size() int {
+	return xxx_messageInfo_GetTickerRequest.Size(m)
+}
+func (m *GetTickerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTickerRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetTickerRequest proto.InternalMessageInfo
+
+func (m *GetTickerRequest) GetExchange() string {
+	if m != nil {
+		return m.Exchange
+	}
+	return ""
+}
+
+func (m *GetTickerRequest) GetPair() *CurrencyPair {
+	if m != nil {
+		return m.Pair
+	}
+	return nil
+}
+
+func (m *GetTickerRequest) GetAssetType() string {
+	if m != nil {
+		return m.AssetType
+	}
+	return ""
+}
+
+type TickerResponse struct {
+	Pair                 *CurrencyPair `protobuf:"bytes,1,opt,name=pair,proto3" json:"pair,omitempty"`
+	LastUpdated          int64         `protobuf:"varint,2,opt,name=last_updated,json=lastUpdated,proto3" json:"last_updated,omitempty"`
+	CurrencyPair         string        `protobuf:"bytes,3,opt,name=currency_pair,json=currencyPair,proto3" json:"currency_pair,omitempty"`
+	Last                 float64       `protobuf:"fixed64,4,opt,name=last,proto3" json:"last,omitempty"`
+	High                 float64       `protobuf:"fixed64,5,opt,name=high,proto3" json:"high,omitempty"`
+	Low                  float64       `protobuf:"fixed64,6,opt,name=low,proto3" json:"low,omitempty"`
+	Bid                  float64       `protobuf:"fixed64,7,opt,name=bid,proto3" json:"bid,omitempty"`
+	Ask                  float64       `protobuf:"fixed64,8,opt,name=ask,proto3" json:"ask,omitempty"`
+	Volume               float64       `protobuf:"fixed64,9,opt,name=volume,proto3" json:"volume,omitempty"`
+	PriceAth             float64       `protobuf:"fixed64,10,opt,name=price_ath,json=priceAth,proto3" json:"price_ath,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *TickerResponse) Reset()         { *m = TickerResponse{} }
+func (m *TickerResponse) String() string { return proto.CompactTextString(m) }
+func (*TickerResponse) ProtoMessage()    {}
+func (*TickerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{14}
+}
+
+func (m *TickerResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TickerResponse.Unmarshal(m, b)
+}
+func (m *TickerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TickerResponse.Marshal(b, m, deterministic)
+}
+func (m *TickerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TickerResponse.Merge(m, src)
+}
+func (m *TickerResponse) XXX_Size() int {
+	return xxx_messageInfo_TickerResponse.Size(m)
+}
+func (m *TickerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TickerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TickerResponse proto.InternalMessageInfo
+
+func (m *TickerResponse) GetPair() *CurrencyPair {
+	if m != nil {
+		return m.Pair
+	}
+	return nil
+}
+
+func (m *TickerResponse) GetLastUpdated() int64 {
+	if m != nil {
+		return m.LastUpdated
+	}
+	return 0
+}
+
+func (m *TickerResponse) GetCurrencyPair() string {
+	if m != nil {
+		return m.CurrencyPair
+	}
+	return ""
+}
+
+func (m *TickerResponse) GetLast() float64 {
+	if m != nil {
+		return m.Last
+	}
+	return 0
+}
+
+func (m *TickerResponse) GetHigh() float64 {
+	if m != nil {
+		return m.High
+	}
+	return 0
+}
+
+func (m *TickerResponse) GetLow() float64 {
+	if m != nil {
+		return m.Low
+	}
+	return 0
+}
+
+func (m *TickerResponse) GetBid() float64 {
+	if m != nil {
+		return m.Bid
+	}
+	return 0
+}
+
+func (m *TickerResponse) GetAsk() float64 {
+	if m != nil {
+		return m.Ask
+	}
+	return 0
+}
+
+func (m *TickerResponse) GetVolume() float64 {
+	if m != nil {
+		return m.Volume
+	}
+	return 0
+}
+
+func (m *TickerResponse) GetPriceAth() float64 {
+	if m != nil {
+		return m.PriceAth
+	}
+	return 0
+}
+
+type GetTickersRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetTickersRequest) Reset()         { *m = GetTickersRequest{} }
+func (m *GetTickersRequest) String() string { return proto.CompactTextString(m) }
+func (*GetTickersRequest) ProtoMessage()    {}
+func (*GetTickersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{15}
+}
+
+func (m *GetTickersRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetTickersRequest.Unmarshal(m, b)
+}
+func (m *GetTickersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetTickersRequest.Marshal(b, m, deterministic)
+}
+func (m *GetTickersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTickersRequest.Merge(m, src)
+}
+func (m *GetTickersRequest) XXX_Size() int {
+	return xxx_messageInfo_GetTickersRequest.Size(m)
+}
+func (m *GetTickersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTickersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetTickersRequest proto.InternalMessageInfo
+
+type Tickers struct {
+	Exchange             string            `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Tickers              []*TickerResponse `protobuf:"bytes,2,rep,name=tickers,proto3" json:"tickers,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *Tickers) Reset()         { *m = Tickers{} }
+func (m *Tickers) String() string { return proto.CompactTextString(m) }
+func (*Tickers) ProtoMessage()    {}
+func (*Tickers) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{16}
+}
+
+func (m *Tickers) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Tickers.Unmarshal(m, b)
+}
+func (m *Tickers) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Tickers.Marshal(b, m, deterministic)
+}
+func (m *Tickers) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Tickers.Merge(m, src)
+}
+func (m *Tickers) XXX_Size() int {
+	return xxx_messageInfo_Tickers.Size(m)
+}
+func (m *Tickers) XXX_DiscardUnknown() {
+	xxx_messageInfo_Tickers.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Tickers proto.InternalMessageInfo
+
+func (m *Tickers) GetExchange() string {
+	if m != nil {
+		return m.Exchange
+	}
+	return ""
+}
+
+func (m *Tickers) GetTickers() []*TickerResponse {
+	if m != nil {
+		return m.Tickers
+	}
+	return nil
+}
+
+type GetTickersResponse struct {
+	Tickers              []*Tickers `protobuf:"bytes,1,rep,name=tickers,proto3" json:"tickers,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *GetTickersResponse) Reset()         { *m = GetTickersResponse{} }
+func (m *GetTickersResponse) String() string { return proto.CompactTextString(m) }
+func (*GetTickersResponse) ProtoMessage()    {}
+func (*GetTickersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{17}
+}
+
+func (m *GetTickersResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetTickersResponse.Unmarshal(m, b)
+}
+func (m *GetTickersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetTickersResponse.Marshal(b, m, deterministic)
+}
+func (m *GetTickersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTickersResponse.Merge(m, src)
+}
+func (m *GetTickersResponse) XXX_Size() int {
+	return xxx_messageInfo_GetTickersResponse.Size(m)
+}
+func (m *GetTickersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTickersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetTickersResponse proto.InternalMessageInfo
+
+func (m *GetTickersResponse) GetTickers() []*Tickers {
+	if m != nil {
+		return m.Tickers
+	}
+	return nil
+}
+
+type GetOrderbookRequest struct {
+	Exchange             string        `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Pair                 *CurrencyPair `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
+	AssetType            string        `protobuf:"bytes,3,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *GetOrderbookRequest) Reset()         { *m = GetOrderbookRequest{} }
+func (m *GetOrderbookRequest) String() string { return proto.CompactTextString(m) }
+func (*GetOrderbookRequest) ProtoMessage()    {}
+func (*GetOrderbookRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{18}
+}
+
+func (m *GetOrderbookRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetOrderbookRequest.Unmarshal(m, b)
+}
+func (m *GetOrderbookRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetOrderbookRequest.Marshal(b, m, deterministic)
+}
+func (m *GetOrderbookRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetOrderbookRequest.Merge(m, src)
+}
+func (m *GetOrderbookRequest) XXX_Size() int {
+	return xxx_messageInfo_GetOrderbookRequest.Size(m)
+}
+func (m *GetOrderbookRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetOrderbookRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetOrderbookRequest proto.InternalMessageInfo
+
+func (m *GetOrderbookRequest) GetExchange() string {
+	if m != nil {
+		return m.Exchange
+	}
+	return ""
+}
+
+func (m *GetOrderbookRequest) GetPair() *CurrencyPair {
+	if m != nil {
+		return m.Pair
+	}
+	return nil
+}
+
+func (m *GetOrderbookRequest) GetAssetType() string {
+	if m != nil {
+		return m.AssetType
+	}
+	return ""
+}
+
+type OrderbookItem struct {
+	Amount               float64  `protobuf:"fixed64,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	Price                float64  `protobuf:"fixed64,2,opt,name=price,proto3" json:"price,omitempty"`
+	Id                   int64    `protobuf:"varint,3,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *OrderbookItem) Reset()         { *m = OrderbookItem{} }
+func (m *OrderbookItem) String() string { return proto.CompactTextString(m) }
+func (*OrderbookItem) ProtoMessage()    {}
+func (*OrderbookItem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{19}
+}
+
+func (m *OrderbookItem) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OrderbookItem.Unmarshal(m, b)
+}
+func (m *OrderbookItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OrderbookItem.Marshal(b, m, deterministic)
+}
+func (m *OrderbookItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrderbookItem.Merge(m, src)
+}
+func (m *OrderbookItem) XXX_Size() int {
+	return xxx_messageInfo_OrderbookItem.Size(m)
+}
+func (m *OrderbookItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrderbookItem.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OrderbookItem proto.InternalMessageInfo
+
+func (m *OrderbookItem) GetAmount() float64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *OrderbookItem) GetPrice() float64 {
+	if m != nil {
+		return m.Price
+	}
+	return 0
+}
+
+func (m *OrderbookItem) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type OrderbookResponse struct {
+	Pair                 *CurrencyPair    `protobuf:"bytes,1,opt,name=pair,proto3" json:"pair,omitempty"`
+	CurrencyPair         string           `protobuf:"bytes,2,opt,name=currency_pair,json=currencyPair,proto3" json:"currency_pair,omitempty"`
+	Bids                 []*OrderbookItem `protobuf:"bytes,3,rep,name=bids,proto3" json:"bids,omitempty"`
+	Asks                 []*OrderbookItem `protobuf:"bytes,4,rep,name=asks,proto3" json:"asks,omitempty"`
+	LastUpdated          int64            `protobuf:"varint,5,opt,name=last_updated,json=lastUpdated,proto3" json:"last_updated,omitempty"`
+	AssetType            string           `protobuf:"bytes,6,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *OrderbookResponse) Reset()         { *m = OrderbookResponse{} }
+func (m *OrderbookResponse) String() string { return proto.CompactTextString(m) }
+func (*OrderbookResponse) ProtoMessage()    {}
+func (*OrderbookResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{20}
+}
+
+func (m *OrderbookResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OrderbookResponse.Unmarshal(m, b)
+}
+func (m *OrderbookResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OrderbookResponse.Marshal(b, m, deterministic)
+}
+func (m *OrderbookResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrderbookResponse.Merge(m, src)
+}
+func (m *OrderbookResponse) XXX_Size() int {
+	return xxx_messageInfo_OrderbookResponse.Size(m)
+}
+func (m *OrderbookResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrderbookResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OrderbookResponse proto.InternalMessageInfo
+
+func (m *OrderbookResponse) GetPair() *CurrencyPair {
+	if m != nil {
+		return m.Pair
+	}
+	return nil
+}
+
+func (m *OrderbookResponse) GetCurrencyPair() string {
+	if m != nil {
+		return m.CurrencyPair
+	}
+	return ""
+}
+
+func (m *OrderbookResponse) GetBids() []*OrderbookItem {
+	if m != nil {
+		return m.Bids
+	}
+	return nil
+}
+
+func (m *OrderbookResponse) GetAsks() []*OrderbookItem {
+	if m != nil {
+		return m.Asks
+	}
+	return nil
+}
+
+func (m *OrderbookResponse) GetLastUpdated() int64 {
+	if m != nil {
+		return m.LastUpdated
+	}
+	return 0
+}
+
+func (m *OrderbookResponse) GetAssetType() string {
+	if m != nil {
+		return m.AssetType
+	}
+	return ""
+}
+
+type GetOrderbooksRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetOrderbooksRequest) Reset()         { *m = GetOrderbooksRequest{} }
+func (m *GetOrderbooksRequest) String() string { return proto.CompactTextString(m) }
+func (*GetOrderbooksRequest) ProtoMessage()    {}
+func (*GetOrderbooksRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{21}
+}
+
+func (m *GetOrderbooksRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetOrderbooksRequest.Unmarshal(m, b)
+}
+func (m *GetOrderbooksRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetOrderbooksRequest.Marshal(b, m, deterministic)
+}
+func (m *GetOrderbooksRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetOrderbooksRequest.Merge(m, src)
+}
+func (m *GetOrderbooksRequest) XXX_Size() int {
+	return xxx_messageInfo_GetOrderbooksRequest.Size(m)
+}
+func (m *GetOrderbooksRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetOrderbooksRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetOrderbooksRequest proto.InternalMessageInfo
+
+type Orderbooks struct {
+	Exchange             string               `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Orderbooks           []*OrderbookResponse `protobuf:"bytes,2,rep,name=orderbooks,proto3" json:"orderbooks,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *Orderbooks) Reset()         { *m = Orderbooks{} }
+func (m *Orderbooks) String() string { return proto.CompactTextString(m) }
+func (*Orderbooks) ProtoMessage()    {}
+func (*Orderbooks) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{22}
+}
+
+func (m *Orderbooks) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Orderbooks.Unmarshal(m, b)
+}
+func (m *Orderbooks) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Orderbooks.Marshal(b, m, deterministic)
+}
+func (m *Orderbooks) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Orderbooks.Merge(m, src)
+}
+func (m *Orderbooks) XXX_Size() int {
+	return xxx_messageInfo_Orderbooks.Size(m)
+}
+func (m *Orderbooks) XXX_DiscardUnknown() {
+	xxx_messageInfo_Orderbooks.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Orderbooks proto.InternalMessageInfo
+
+func (m *Orderbooks) GetExchange() string {
+	if m != nil {
+		return m.Exchange
+	}
+	return ""
+}
+
+func (m *Orderbooks) GetOrderbooks() []*OrderbookResponse {
+	if m != nil {
+		return m.Orderbooks
+	}
+	return nil
+}
+
+type GetOrderbooksResponse struct {
+	Orderbooks           []*Orderbooks `protobuf:"bytes,1,rep,name=orderbooks,proto3" json:"orderbooks,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *GetOrderbooksResponse) Reset()         { *m = GetOrderbooksResponse{} }
+func (m *GetOrderbooksResponse) String() string { return proto.CompactTextString(m) }
+func (*GetOrderbooksResponse) ProtoMessage()    {}
+func (*GetOrderbooksResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{23}
+}
+
+func (m *GetOrderbooksResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetOrderbooksResponse.Unmarshal(m, b)
+}
+func (m *GetOrderbooksResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetOrderbooksResponse.Marshal(b, m, deterministic)
+}
+func (m *GetOrderbooksResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetOrderbooksResponse.Merge(m, src)
+}
+func (m *GetOrderbooksResponse) XXX_Size() int {
+	return xxx_messageInfo_GetOrderbooksResponse.Size(m)
+}
+func (m *GetOrderbooksResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetOrderbooksResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetOrderbooksResponse proto.InternalMessageInfo
+
+func (m *GetOrderbooksResponse) GetOrderbooks() []*Orderbooks {
+	if m != nil {
+		return m.Orderbooks
+	}
+	return nil
+}
+
+type GetSyncStatusRequest struct {
+	Exchange             string   `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetSyncStatusRequest) Reset()         { *m = GetSyncStatusRequest{} }
+func (m *GetSyncStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*GetSyncStatusRequest) ProtoMessage()    {}
+func (*GetSyncStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{24}
+}
+
+func (m *GetSyncStatusRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetSyncStatusRequest.Unmarshal(m, b)
+}
+func (m *GetSyncStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetSyncStatusRequest.Marshal(b, m, deterministic)
+}
+func (m *GetSyncStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetSyncStatusRequest.Merge(m, src)
+}
+func (m *GetSyncStatusRequest) XXX_Size() int {
+	return xxx_messageInfo_GetSyncStatusRequest.Size(m)
+}
+func (m *GetSyncStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetSyncStatusRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetSyncStatusRequest proto.InternalMessageInfo
+
+func (m *GetSyncStatusRequest) GetExchange() string {
+	if m != nil {
+		return m.Exchange
+	}
+	return ""
+}
+
+type SyncItemStatus struct {
+	Source               string   `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	Synced               bool     `protobuf:"varint,2,opt,name=synced,proto3" json:"synced,omitempty"`
+	LastUpdated          int64    `protobuf:"varint,3,opt,name=last_updated,json=lastUpdated,proto3" json:"last_updated,omitempty"`
+	LastWebsocketUpdate  int64    `protobuf:"varint,4,opt,name=last_websocket_update,json=lastWebsocketUpdate,proto3" json:"last_websocket_update,omitempty"`
+	LastRestUpdate       int64    `protobuf:"varint,5,opt,name=last_rest_update,json=lastRestUpdate,proto3" json:"last_rest_update,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SyncItemStatus) Reset()         { *m = SyncItemStatus{} }
+func (m *SyncItemStatus) String() string { return proto.CompactTextString(m) }
+func (*SyncItemStatus) ProtoMessage()    {}
+func (*SyncItemStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{25}
+}
+
+func (m *SyncItemStatus) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SyncItemStatus.Unmarshal(m, b)
+}
+func (m *SyncItemStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SyncItemStatus.Marshal(b, m, deterministic)
+}
+func (m *SyncItemStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyncItemStatus.Merge(m, src)
+}
+func (m *SyncItemStatus) XXX_Size() int {
+	return xxx_messageInfo_SyncItemStatus.Size(m)
+}
+func (m *SyncItemStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_SyncItemStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SyncItemStatus proto.InternalMessageInfo
+
+func (m *SyncItemStatus) GetSource() string {
+	if m != nil {
+		return m.Source
+	}
+	return ""
+}
+
+func (m *SyncItemStatus) GetSynced() bool {
+	if m != nil {
+		return m.Synced
+	}
+	return false
+}
+
+func (m *SyncItemStatus) GetLastUpdated() int64 {
+	if m != nil {
+		return m.LastUpdated
+	}
+	return 0
+}
+
+func (m *SyncItemStatus) GetLastWebsocketUpdate() int64 {
+	if m != nil {
+		return m.LastWebsocketUpdate
+	}
+	return 0
+}
+
+func (m *SyncItemStatus) GetLastRestUpdate() int64 {
+	if m != nil {
+		return m.LastRestUpdate
+	}
+	return 0
+}
+
+type SyncStatus struct {
+	Exchange             string          `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	AssetType            string          `protobuf:"bytes,2,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
+	Pair                 *CurrencyPair   `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
+	WebsocketConnected   bool            `protobuf:"varint,4,opt,name=websocket_connected,json=websocketConnected,proto3" json:"websocket_connected,omitempty"`
+	Ticker               *SyncItemStatus `protobuf:"bytes,5,opt,name=ticker,proto3" json:"ticker,omitempty"`
+	Orderbook            *SyncItemStatus `protobuf:"bytes,6,opt,name=orderbook,proto3" json:"orderbook,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *SyncStatus) Reset()         { *m = SyncStatus{} }
+func (m *SyncStatus) String() string { return proto.CompactTextString(m) }
+func (*SyncStatus) ProtoMessage()    {}
+func (*SyncStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{26}
+}
+
+func (m *SyncStatus) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SyncStatus.Unmarshal(m, b)
+}
+func (m *SyncStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SyncStatus.Marshal(b, m, deterministic)
+}
+func (m *SyncStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyncStatus.Merge(m, src)
+}
+func (m *SyncStatus) XXX_Size() int {
+	return xxx_messageInfo_SyncStatus.Size(m)
+}
+func (m *SyncStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_SyncStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SyncStatus proto.InternalMessageInfo
+
+func (m *SyncStatus) GetExchange() string {
+	if m != nil {
+		return m.Exchange
+	}
+	return ""
+}
+
+func (m *SyncStatus) GetAssetType() string {
+	if m != nil {
+		return m.AssetType
+	}
+	return ""
+}
+
+func (m *SyncStatus) GetPair() *CurrencyPair {
+	if m != nil {
+		return m.Pair
+	}
+	return nil
+}
+
+func (m *SyncStatus) GetWebsocketConnected() bool {
+	if m != nil {
+		return m.WebsocketConnected
+	}
+	return false
+}
+
+func (m *SyncStatus) GetTicker() *SyncItemStatus {
+	if m != nil {
+		return m.Ticker
+	}
+	return nil
+}
+
+func (m *SyncStatus) GetOrderbook() *SyncItemStatus {
+	if m != nil {
+		return m.Orderbook
+	}
+	return nil
+}
+
+type GetSyncStatusResponse struct {
+	Status               []*SyncStatus `protobuf:"bytes,1,rep,name=status,proto3" json:"status,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *GetSyncStatusResponse) Reset()         { *m = GetSyncStatusResponse{} }
+func (m *GetSyncStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*GetSyncStatusResponse) ProtoMessage()    {}
+func (*GetSyncStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{27}
+}
+
+func (m *GetSyncStatusResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetSyncStatusResponse.Unmarshal(m, b)
+}
+func (m *GetSyncStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetSyncStatusResponse.Marshal(b, m, deterministic)
+}
+func (m *GetSyncStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetSyncStatusResponse.Merge(m, src)
+}
+func (m *GetSyncStatusResponse) XXX_Size() int {
+	return xxx_messageInfo_GetSyncStatusResponse.Size(m)
+}
+func (m *GetSyncStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetSyncStatusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetSyncStatusResponse proto.InternalMessageInfo
+
+func (m *GetSyncStatusResponse) GetStatus() []*SyncStatus {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+type GetAccountInfoRequest struct {
+	Exchange             string   `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetAccountInfoRequest) Reset()         { *m = GetAccountInfoRequest{} }
+func (m *GetAccountInfoRequest) String() string { return proto.CompactTextString(m) }
+func (*GetAccountInfoRequest) ProtoMessage()    {}
+func (*GetAccountInfoRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{28}
+}
+
+func (m *GetAccountInfoRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetAccountInfoRequest.Unmarshal(m, b)
+}
+func (m *GetAccountInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetAccountInfoRequest.Marshal(b, m, deterministic)
+}
+func (m *GetAccountInfoRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetAccountInfoRequest.Merge(m, src)
+}
+func (m *GetAccountInfoRequest) XXX_Size() int {
+	return xxx_messageInfo_GetAccountInfoRequest.Size(m)
+}
+func (m *GetAccountInfoRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetAccountInfoRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetAccountInfoRequest proto.InternalMessageInfo
+
+func (m *GetAccountInfoRequest) GetExchange() string {
+	if m != nil {
+		return m.Exchange
+	}
+	return ""
+}
+
+type AccountCurrencyInfo struct {
+	Currency             string   `protobuf:"bytes,1,opt,name=currency,proto3" json:"currency,omitempty"`
+	TotalValue           float64  `protobuf:"fixed64,2,opt,name=total_value,json=totalValue,proto3" json:"total_value,omitempty"`
+	Hold                 float64  `protobuf:"fixed64,3,opt,name=hold,proto3" json:"hold,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AccountCurrencyInfo) Reset()         { *m = AccountCurrencyInfo{} }
+func (m *AccountCurrencyInfo) String() string { return proto.CompactTextString(m) }
+func (*AccountCurrencyInfo) ProtoMessage()    {}
+func (*AccountCurrencyInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{29}
+}
+
+func (m *AccountCurrencyInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AccountCurrencyInfo.Unmarshal(m, b)
+}
+func (m *AccountCurrencyInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AccountCurrencyInfo.Marshal(b, m, deterministic)
+}
+func (m *AccountCurrencyInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountCurrencyInfo.Merge(m, src)
+}
+func (m *AccountCurrencyInfo) XXX_Size() int {
+	return xxx_messageInfo_AccountCurrencyInfo.Size(m)
+}
+func (m *AccountCurrencyInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountCurrencyInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountCurrencyInfo proto.InternalMessageInfo
+
+func (m *AccountCurrencyInfo) GetCurrency() string {
+	if m != nil {
+		return m.Currency
+	}
+	return ""
+}
+
+func (m *AccountCurrencyInfo) GetTotalValue() float64 {
+	if m != nil {
+		return m.TotalValue
+	}
+	return 0
+}
+
+func (m *AccountCurrencyInfo) GetHold() float64 {
+	if m != nil {
+		return m.Hold
+	}
+	return 0
+}
+
+type Account struct {
+	Id                   string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Currencies           []*AccountCurrencyInfo `protobuf:"bytes,2,rep,name=currencies,proto3" json:"currencies,omitempty"`
+	CredentialSet        string                 `protobuf:"bytes,3,opt,name=credential_set,json=credentialSet,proto3" json:"credential_set,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *Account) Reset()         { *m = Account{} }
+func (m *Account) String() string { return proto.CompactTextString(m) }
+func (*Account) ProtoMessage()    {}
+func (*Account) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{30}
+}
+
+func (m *Account) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Account.Unmarshal(m, b)
+}
+func (m *Account) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Account.Marshal(b, m, deterministic)
+}
+func (m *Account) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Account.Merge(m, src)
+}
+func (m *Account) XXX_Size() int {
+	return xxx_messageInfo_Account.Size(m)
+}
+func (m *Account) XXX_DiscardUnknown() {
+	xxx_messageInfo_Account.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Account proto.InternalMessageInfo
+
+func (m *Account) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *Account) GetCurrencies() []*AccountCurrencyInfo {
+	if m != nil {
+		return m.Currencies
+	}
+	return nil
+}
+
+func (m *Account) GetCredentialSet() string {
+	if m != nil {
+		return m.CredentialSet
+	}
+	return ""
+}
+
+type GetAccountInfoResponse struct {
+	Exchange             string     `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Accounts             []*Account `protobuf:"bytes,2,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *GetAccountInfoResponse) Reset()         { *m = GetAccountInfoResponse{} }
+func (m *GetAccountInfoResponse) String() string { return proto.CompactTextString(m) }
+func (*GetAccountInfoResponse) ProtoMessage()    {}
+func (*GetAccountInfoResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{31}
+}
+
+func (m *GetAccountInfoResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetAccountInfoResponse.Unmarshal(m, b)
+}
+func (m *GetAccountInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetAccountInfoResponse.Marshal(b, m, deterministic)
+}
+func (m *GetAccountInfoResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetAccountInfoResponse.Merge(m, src)
+}
+func (m *GetAccountInfoResponse) XXX_Size() int {
+	return xxx_messageInfo_GetAccountInfoResponse.Size(m)
+}
+func (m *GetAccountInfoResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetAccountInfoResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetAccountInfoResponse proto.InternalMessageInfo
+
+func (m *GetAccountInfoResponse) GetExchange() string {
+	if m != nil {
+		return m.Exchange
+	}
+	return ""
+}
+
+func (m *GetAccountInfoResponse) GetAccounts() []*Account {
+	if m != nil {
+		return m.Accounts
+	}
+	return nil
+}
+
+type OrderStatusChange struct {
+	Status               string   `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Time                 int64    `protobuf:"varint,2,opt,name=time,proto3" json:"time,omitempty"`
+	Reason               string   `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *OrderStatusChange) Reset()         { *m = OrderStatusChange{} }
+func (m *OrderStatusChange) String() string { return proto.CompactTextString(m) }
+func (*OrderStatusChange) ProtoMessage()    {}
+func (*OrderStatusChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{32}
+}
+
+func (m *OrderStatusChange) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OrderStatusChange.Unmarshal(m, b)
+}
+func (m *OrderStatusChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OrderStatusChange.Marshal(b, m, deterministic)
+}
+func (m *OrderStatusChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrderStatusChange.Merge(m, src)
+}
+func (m *OrderStatusChange) XXX_Size() int {
+	return xxx_messageInfo_OrderStatusChange.Size(m)
+}
+func (m *OrderStatusChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrderStatusChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OrderStatusChange proto.InternalMessageInfo
+
+func (m *OrderStatusChange) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *OrderStatusChange) GetTime() int64 {
+	if m != nil {
+		return m.Time
+	}
+	return 0
+}
+
+func (m *OrderStatusChange) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+type OrderFill struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Price                float64  `protobuf:"fixed64,2,opt,name=price,proto3" json:"price,omitempty"`
+	Amount               float64  `protobuf:"fixed64,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Fee                  float64  `protobuf:"fixed64,4,opt,name=fee,proto3" json:"fee,omitempty"`
+	Time                 int64    `protobuf:"varint,5,opt,name=time,proto3" json:"time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *OrderFill) Reset()         { *m = OrderFill{} }
+func (m *OrderFill) String() string { return proto.CompactTextString(m) }
+func (*OrderFill) ProtoMessage()    {}
+func (*OrderFill) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{33}
+}
+
+func (m *OrderFill) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OrderFill.Unmarshal(m, b)
+}
+func (m *OrderFill) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OrderFill.Marshal(b, m, deterministic)
+}
+func (m *OrderFill) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrderFill.Merge(m, src)
+}
+func (m *OrderFill) XXX_Size() int {
+	return xxx_messageInfo_OrderFill.Size(m)
+}
+func (m *OrderFill) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrderFill.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OrderFill proto.InternalMessageInfo
+
+func (m *OrderFill) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *OrderFill) GetPrice() float64 {
+	if m != nil {
+		return m.Price
+	}
+	return 0
+}
+
+func (m *OrderFill) GetAmount() float64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *OrderFill) GetFee() float64 {
+	if m != nil {
+		return m.Fee
+	}
+	return 0
+}
+
+func (m *OrderFill) GetTime() int64 {
+	if m != nil {
+		return m.Time
+	}
+	return 0
+}
+
+type OrderDetails struct {
+	Id                   string               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Exchange             string               `protobuf:"bytes,2,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	ExchangeOrderId      string               `protobuf:"bytes,3,opt,name=exchange_order_id,json=exchangeOrderId,proto3" json:"exchange_order_id,omitempty"`
+	ClientId             string               `protobuf:"bytes,4,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	CurrencyPair         string               `protobuf:"bytes,5,opt,name=currency_pair,json=currencyPair,proto3" json:"currency_pair,omitempty"`
+	OrderSide            string               `protobuf:"bytes,6,opt,name=order_side,json=orderSide,proto3" json:"order_side,omitempty"`
+	OrderType            string               `protobuf:"bytes,7,opt,name=order_type,json=orderType,proto3" json:"order_type,omitempty"`
+	Price                float64              `protobuf:"fixed64,8,opt,name=price,proto3" json:"price,omitempty"`
+	Amount               float64              `protobuf:"fixed64,9,opt,name=amount,proto3" json:"amount,omitempty"`
+	ExecutedAmount       float64              `protobuf:"fixed64,10,opt,name=executed_amount,json=executedAmount,proto3" json:"executed_amount,omitempty"`
+	RemainingAmount      float64              `protobuf:"fixed64,11,opt,name=remaining_amount,json=remainingAmount,proto3" json:"remaining_amount,omitempty"`
+	Fee                  float64              `protobuf:"fixed64,12,opt,name=fee,proto3" json:"fee,omitempty"`
+	Status               string               `protobuf:"bytes,13,opt,name=status,proto3" json:"status,omitempty"`
+	StatusHistory        []*OrderStatusChange `protobuf:"bytes,14,rep,name=status_history,json=statusHistory,proto3" json:"status_history,omitempty"`
+	Fills                []*OrderFill         `protobuf:"bytes,15,rep,name=fills,proto3" json:"fills,omitempty"`
+	External             bool                 `protobuf:"varint,16,opt,name=external,proto3" json:"external,omitempty"`
+	Placed               int64                `protobuf:"varint,17,opt,name=placed,proto3" json:"placed,omitempty"`
+	LastUpdated          int64                `protobuf:"varint,18,opt,name=last_updated,json=lastUpdated,proto3" json:"last_updated,omitempty"`
+	CredentialSet        string               `protobuf:"bytes,19,opt,name=credential_set,json=credentialSet,proto3" json:"credential_set,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *OrderDetails) Reset()         { *m = OrderDetails{} }
+func (m *OrderDetails) String() string { return proto.CompactTextString(m) }
+func (*OrderDetails) ProtoMessage()    {}
+func (*OrderDetails) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{34}
+}
+
+func (m *OrderDetails) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OrderDetails.Unmarshal(m, b)
+}
+func (m *OrderDetails) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OrderDetails.Marshal(b, m, deterministic)
+}
+func (m *OrderDetails) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrderDetails.Merge(m, src)
+}
+func (m *OrderDetails) XXX_Size() int {
+	return xxx_messageInfo_OrderDetails.Size(m)
+}
+func (m *OrderDetails) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrderDetails.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OrderDetails proto.InternalMessageInfo
+
+func (m *OrderDetails) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *OrderDetails) GetExchange() string {
+	if m != nil {
+		return m.Exchange
+	}
+	return ""
+}
+
+func (m *OrderDetails) GetExchangeOrderId() string {
+	if m != nil {
+		return m.ExchangeOrderId
+	}
+	return ""
+}
+
+func (m *OrderDetails) GetClientId() string {
+	if m != nil {
+		return m.ClientId
+	}
+	return ""
+}
+
+func (m *OrderDetails) GetCurrencyPair() string {
+	if m != nil {
+		return m.CurrencyPair
+	}
+	return ""
+}
+
+func (m *OrderDetails) GetOrderSide() string {
+	if m != nil {
+		return m.OrderSide
+	}
+	return ""
+}
+
+func (m *OrderDetails) GetOrderType() string {
+	if m != nil {
+		return m.OrderType
+	}
+	return ""
+}
+
+func (m *OrderDetails) GetPrice() float64 {
+	if m != nil {
+		return m.Price
+	}
+	return 0
+}
+
+func (m *OrderDetails) GetAmount() float64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *OrderDetails) GetExecutedAmount() float64 {
+	if m != nil {
+		return m.ExecutedAmount
+	}
+	return 0
+}
+
+func (m *OrderDetails) GetRemainingAmount() float64 {
+	if m != nil {
+		return m.RemainingAmount
+	}
+	return 0
+}
+
+func (m *OrderDetails) GetFee() float64 {
+	if m != nil {
+		return m.Fee
+	}
+	return 0
+}
+
+func (m *OrderDetails) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *OrderDetails) GetStatusHistory() []*OrderStatusChange {
+	if m != nil {
+		return m.StatusHistory
+	}
+	return nil
+}
+
+func (m *OrderDetails) GetFills() []*OrderFill {
+	if m != nil {
+		return m.Fills
+	}
+	return nil
+}
+
+func (m *OrderDetails) GetExternal() bool {
+	if m != nil {
+		return m.External
+	}
+	return false
+}
+
+func (m *OrderDetails) GetPlaced() int64 {
+	if m != nil {
+		return m.Placed
+	}
+	return 0
+}
+
+func (m *OrderDetails) GetLastUpdated() int64 {
+	if m != nil {
+		return m.LastUpdated
+	}
+	return 0
+}
+
+func (m *OrderDetails) GetCredentialSet() string {
+	if m != nil {
+		return m.CredentialSet
+	}
+	return ""
+}
+
+type GetOrdersRequest struct {
+	Exchange             string        `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Pair                 *CurrencyPair `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
+	OrderSide            string        `protobuf:"bytes,3,opt,name=order_side,json=orderSide,proto3" json:"order_side,omitempty"`
+	Status               string        `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	ActiveOnly           bool          `protobuf:"varint,5,opt,name=active_only,json=activeOnly,proto3" json:"active_only,omitempty"`
+	CredentialSet        string        `protobuf:"bytes,6,opt,name=credential_set,json=credentialSet,proto3" json:"credential_set,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *GetOrdersRequest) Reset()         { *m = GetOrdersRequest{} }
+func (m *GetOrdersRequest) String() string { return proto.CompactTextString(m) }
+func (*GetOrdersRequest) ProtoMessage()    {}
+func (*GetOrdersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{35}
+}
+
+func (m *GetOrdersRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetOrdersRequest.Unmarshal(m, b)
+}
+func (m *GetOrdersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetOrdersRequest.Marshal(b, m, deterministic)
+}
+func (m *GetOrdersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetOrdersRequest.Merge(m, src)
+}
+func (m *GetOrdersRequest) XXX_Size() int {
+	return xxx_messageInfo_GetOrdersRequest.Size(m)
+}
+func (m *GetOrdersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetOrdersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetOrdersRequest proto.InternalMessageInfo
+
+func (m *GetOrdersRequest) GetExchange() string {
+	if m != nil {
+		return m.Exchange
+	}
+	return ""
+}
+
+func (m *GetOrdersRequest) GetPair() *CurrencyPair {
+	if m != nil {
+		return m.Pair
+	}
+	return nil
+}
+
+func (m *GetOrdersRequest) GetOrderSide() string {
+	if m != nil {
+		return m.OrderSide
+	}
+	return ""
+}
+
+func (m *GetOrdersRequest) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *GetOrdersRequest) GetActiveOnly() bool {
+	if m != nil {
+		return m.ActiveOnly
+	}
+	return false
+}
+
+func (m *GetOrdersRequest) GetCredentialSet() string {
+	if m != nil {
+		return m.CredentialSet
+	}
+	return ""
+}
+
+type GetOrdersResponse struct {
+	Orders               []*OrderDetails `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *GetOrdersResponse) Reset()         { *m = GetOrdersResponse{} }
+func (m *GetOrdersResponse) String() string { return proto.CompactTextString(m) }
+func (*GetOrdersResponse) ProtoMessage()    {}
+func (*GetOrdersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{36}
+}
+
+func (m *GetOrdersResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetOrdersResponse.Unmarshal(m, b)
+}
+func (m *GetOrdersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetOrdersResponse.Marshal(b, m, deterministic)
+}
+func (m *GetOrdersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetOrdersResponse.Merge(m, src)
+}
+func (m *GetOrdersResponse) XXX_Size() int {
+	return xxx_messageInfo_GetOrdersResponse.Size(m)
+}
+func (m *GetOrdersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetOrdersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetOrdersResponse proto.InternalMessageInfo
+
+func (m *GetOrdersResponse) GetOrders() []*OrderDetails {
+	if m != nil {
+		return m.Orders
+	}
+	return nil
+}
+
+type GetOrderRequest struct {
+	OrderId              string   `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetOrderRequest) Reset()         { *m = GetOrderRequest{} }
+func (m *GetOrderRequest) String() string { return proto.CompactTextString(m) }
+func (*GetOrderRequest) ProtoMessage()    {}
+func (*GetOrderRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{37}
+}
+
+func (m *GetOrderRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetOrderRequest.Unmarshal(m, b)
+}
+func (m *GetOrderRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetOrderRequest.Marshal(b, m, deterministic)
+}
+func (m *GetOrderRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetOrderRequest.Merge(m, src)
+}
+func (m *GetOrderRequest) XXX_Size() int {
+	return xxx_messageInfo_GetOrderRequest.Size(m)
+}
+func (m *GetOrderRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetOrderRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetOrderRequest proto.InternalMessageInfo
+
+func (m *GetOrderRequest) GetOrderId() string {
+	if m != nil {
+		return m.OrderId
+	}
+	return ""
+}
+
+type SubmitOrderRequest struct {
+	Exchange             string        `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Pair                 *CurrencyPair `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
+	Side                 string        `protobuf:"bytes,3,opt,name=side,proto3" json:"side,omitempty"`
+	OrderType            string        `protobuf:"bytes,4,opt,name=order_type,json=orderType,proto3" json:"order_type,omitempty"`
+	Amount               float64       `protobuf:"fixed64,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	Price                float64       `protobuf:"fixed64,6,opt,name=price,proto3" json:"price,omitempty"`
+	ClientId             string        `protobuf:"bytes,7,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	CredentialSet        string        `protobuf:"bytes,8,opt,name=credential_set,json=credentialSet,proto3" json:"credential_set,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *SubmitOrderRequest) Reset()         { *m = SubmitOrderRequest{} }
+func (m *SubmitOrderRequest) String() string { return proto.CompactTextString(m) }
+func (*SubmitOrderRequest) ProtoMessage()    {}
+func (*SubmitOrderRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{38}
+}
+
+func (m *SubmitOrderRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SubmitOrderRequest.Unmarshal(m, b)
+}
+func (m *SubmitOrderRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SubmitOrderRequest.Marshal(b, m, deterministic)
+}
+func (m *SubmitOrderRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubmitOrderRequest.Merge(m, src)
+}
+func (m *SubmitOrderRequest) XXX_Size() int {
+	return xxx_messageInfo_SubmitOrderRequest.Size(m)
+}
+func (m *SubmitOrderRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubmitOrderRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubmitOrderRequest proto.InternalMessageInfo
+
+func (m *SubmitOrderRequest) GetExchange() string {
+	if m != nil {
+		return m.Exchange
+	}
+	return ""
+}
+
+func (m *SubmitOrderRequest) GetPair() *CurrencyPair {
+	if m != nil {
+		return m.Pair
+	}
+	return nil
+}
+
+func (m *SubmitOrderRequest) GetSide() string {
+	if m != nil {
+		return m.Side
+	}
+	return ""
+}
+
+func (m *SubmitOrderRequest) GetOrderType() string {
+	if m != nil {
+		return m.OrderType
+	}
+	return ""
+}
+
+func (m *SubmitOrderRequest) GetAmount() float64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *SubmitOrderRequest) GetPrice() float64 {
+	if m != nil {
+		return m.Price
+	}
+	return 0
+}
+
+func (m *SubmitOrderRequest) GetClientId() string {
+	if m != nil {
+		return m.ClientId
+	}
+	return ""
+}
+
+func (m *SubmitOrderRequest) GetCredentialSet() string {
+	if m != nil {
+		return m.CredentialSet
+	}
+	return ""
+}
+
+type SubmitOrderResponse struct {
+	OrderPlaced          bool     `protobuf:"varint,1,opt,name=order_placed,json=orderPlaced,proto3" json:"order_placed,omitempty"`
+	OrderId              string   `protobuf:"bytes,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	ExchangeOrderId      string   `protobuf:"bytes,3,opt,name=exchange_order_id,json=exchangeOrderId,proto3" json:"exchange_order_id,omitempty"`
+	Status               string   `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SubmitOrderResponse) Reset()         { *m = SubmitOrderResponse{} }
+func (m *SubmitOrderResponse) String() string { return proto.CompactTextString(m) }
+func (*SubmitOrderResponse) ProtoMessage()    {}
+func (*SubmitOrderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{39}
+}
+
+func (m *SubmitOrderResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SubmitOrderResponse.Unmarshal(m, b)
+}
+func (m *SubmitOrderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SubmitOrderResponse.Marshal(b, m, deterministic)
+}
+func (m *SubmitOrderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubmitOrderResponse.Merge(m, src)
+}
+func (m *SubmitOrderResponse) XXX_Size() int {
+	return xxx_messageInfo_SubmitOrderResponse.Size(m)
+}
+func (m *SubmitOrderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubmitOrderResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubmitOrderResponse proto.InternalMessageInfo
+
+func (m *SubmitOrderResponse) GetOrderPlaced() bool {
+	if m != nil {
+		return m.OrderPlaced
+	}
+	return false
+}
+
+func (m *SubmitOrderResponse) GetOrderId() string {
+	if m != nil {
+		return m.OrderId
+	}
+	return ""
+}
+
+func (m *SubmitOrderResponse) GetExchangeOrderId() string {
+	if m != nil {
+		return m.ExchangeOrderId
+	}
+	return ""
+}
+
+func (m *SubmitOrderResponse) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+type CancelOrderRequest struct {
+	OrderId              string   `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CancelOrderRequest) Reset()         { *m = CancelOrderRequest{} }
+func (m *CancelOrderRequest) String() string { return proto.CompactTextString(m) }
+func (*CancelOrderRequest) ProtoMessage()    {}
+func (*CancelOrderRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{40}
+}
+
+func (m *CancelOrderRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CancelOrderRequest.Unmarshal(m, b)
+}
+func (m *CancelOrderRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CancelOrderRequest.Marshal(b, m, deterministic)
+}
+func (m *CancelOrderRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelOrderRequest.Merge(m, src)
+}
+func (m *CancelOrderRequest) XXX_Size() int {
+	return xxx_messageInfo_CancelOrderRequest.Size(m)
+}
+func (m *CancelOrderRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelOrderRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CancelOrderRequest proto.InternalMessageInfo
+
+func (m *CancelOrderRequest) GetOrderId() string {
+	if m != nil {
+		return m.OrderId
+	}
+	return ""
+}
+
+type CancelAllOrdersRequest struct {
+	Exchange             string        `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Pair                 *CurrencyPair `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
+	CredentialSet        string        `protobuf:"bytes,3,opt,name=credential_set,json=credentialSet,proto3" json:"credential_set,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *CancelAllOrdersRequest) Reset()         { *m = CancelAllOrdersRequest{} }
+func (m *CancelAllOrdersRequest) String() string { return proto.CompactTextString(m) }
+func (*CancelAllOrdersRequest) ProtoMessage()    {}
+func (*CancelAllOrdersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{41}
+}
+
+func (m *CancelAllOrdersRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CancelAllOrdersRequest.Unmarshal(m, b)
+}
+func (m *CancelAllOrdersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CancelAllOrdersRequest.Marshal(b, m, deterministic)
+}
+func (m *CancelAllOrdersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelAllOrdersRequest.Merge(m, src)
+}
+func (m *CancelAllOrdersRequest) XXX_Size() int {
+	return xxx_messageInfo_CancelAllOrdersRequest.Size(m)
+}
+func (m *CancelAllOrdersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelAllOrdersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CancelAllOrdersRequest proto.InternalMessageInfo
+
+func (m *CancelAllOrdersRequest) GetExchange() string {
+	if m != nil {
+		return m.Exchange
+	}
+	return ""
+}
+
+func (m *CancelAllOrdersRequest) GetPair() *CurrencyPair {
+	if m != nil {
+		return m.Pair
+	}
+	return nil
+}
+
+func (m *CancelAllOrdersRequest) GetCredentialSet() string {
+	if m != nil {
+		return m.CredentialSet
+	}
+	return ""
+}
+
+type GetDepositAddressRequest struct {
+	Exchange             string   `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Cryptocurrency       string   `protobuf:"bytes,2,opt,name=cryptocurrency,proto3" json:"cryptocurrency,omitempty"`
+	AccountId            string   `protobuf:"bytes,3,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	CredentialSet        string   `protobuf:"bytes,4,opt,name=credential_set,json=credentialSet,proto3" json:"credential_set,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetDepositAddressRequest) Reset()         { *m = GetDepositAddressRequest{} }
+func (m *GetDepositAddressRequest) String() string { return proto.CompactTextString(m) }
+func (*GetDepositAddressRequest) ProtoMessage()    {}
+func (*GetDepositAddressRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{42}
+}
+
+func (m *GetDepositAddressRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetDepositAddressRequest.Unmarshal(m, b)
+}
+func (m *GetDepositAddressRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetDepositAddressRequest.Marshal(b, m, deterministic)
+}
+func (m *GetDepositAddressRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetDepositAddressRequest.Merge(m, src)
+}
+func (m *GetDepositAddressRequest) XXX_Size() int {
+	return xxx_messageInfo_GetDepositAddressRequest.Size(m)
+}
+func (m *GetDepositAddressRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetDepositAddressRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetDepositAddressRequest proto.InternalMessageInfo
+
+func (m *GetDepositAddressRequest) GetExchange() string {
+	if m != nil {
+		return m.Exchange
+	}
+	return ""
+}
+
+func (m *GetDepositAddressRequest) GetCryptocurrency() string {
+	if m != nil {
+		return m.Cryptocurrency
+	}
+	return ""
+}
+
+func (m *GetDepositAddressRequest) GetAccountId() string {
+	if m != nil {
+		return m.AccountId
+	}
+	return ""
+}
+
+func (m *GetDepositAddressRequest) GetCredentialSet() string {
+	if m != nil {
+		return m.CredentialSet
+	}
+	return ""
+}
+
+type GetDepositAddressResponse struct {
+	Address              string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetDepositAddressResponse) Reset()         { *m = GetDepositAddressResponse{} }
+func (m *GetDepositAddressResponse) String() string { return proto.CompactTextString(m) }
+func (*GetDepositAddressResponse) ProtoMessage()    {}
+func (*GetDepositAddressResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{43}
+}
+
+func (m *GetDepositAddressResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetDepositAddressResponse.Unmarshal(m, b)
+}
+func (m *GetDepositAddressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetDepositAddressResponse.Marshal(b, m, deterministic)
+}
+func (m *GetDepositAddressResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetDepositAddressResponse.Merge(m, src)
+}
+func (m *GetDepositAddressResponse) XXX_Size() int {
+	return xxx_messageInfo_GetDepositAddressResponse.Size(m)
+}
+func (m *GetDepositAddressResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetDepositAddressResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetDepositAddressResponse proto.InternalMessageInfo
+
+func (m *GetDepositAddressResponse) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+type WithdrawCryptoRequest struct {
+	Exchange             string   `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Currency             string   `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
+	Address              string   `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	AddressTag           string   `protobuf:"bytes,4,opt,name=address_tag,json=addressTag,proto3" json:"address_tag,omitempty"`
+	Amount               float64  `protobuf:"fixed64,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	Fee                  float64  `protobuf:"fixed64,6,opt,name=fee,proto3" json:"fee,omitempty"`
+	Description          string   `protobuf:"bytes,7,opt,name=description,proto3" json:"description,omitempty"`
+	CredentialSet        string   `protobuf:"bytes,8,opt,name=credential_set,json=credentialSet,proto3" json:"credential_set,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WithdrawCryptoRequest) Reset()         { *m = WithdrawCryptoRequest{} }
+func (m *WithdrawCryptoRequest) String() string { return proto.CompactTextString(m) }
+func (*WithdrawCryptoRequest) ProtoMessage()    {}
+func (*WithdrawCryptoRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{44}
+}
+
+func (m *WithdrawCryptoRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WithdrawCryptoRequest.Unmarshal(m, b)
+}
+func (m *WithdrawCryptoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WithdrawCryptoRequest.Marshal(b, m, deterministic)
+}
+func (m *WithdrawCryptoRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WithdrawCryptoRequest.Merge(m, src)
+}
+func (m *WithdrawCryptoRequest) XXX_Size() int {
+	return xxx_messageInfo_WithdrawCryptoRequest.Size(m)
+}
+func (m *WithdrawCryptoRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WithdrawCryptoRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WithdrawCryptoRequest proto.InternalMessageInfo
+
+func (m *WithdrawCryptoRequest) GetExchange() string {
+	if m != nil {
+		return m.Exchange
+	}
+	return ""
+}
+
+func (m *WithdrawCryptoRequest) GetCurrency() string {
+	if m != nil {
+		return m.Currency
+	}
+	return ""
+}
+
+func (m *WithdrawCryptoRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *WithdrawCryptoRequest) GetAddressTag() string {
+	if m != nil {
+		return m.AddressTag
+	}
+	return ""
+}
+
+func (m *WithdrawCryptoRequest) GetAmount() float64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *WithdrawCryptoRequest) GetFee() float64 {
+	if m != nil {
+		return m.Fee
+	}
+	return 0
+}
+
+func (m *WithdrawCryptoRequest) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *WithdrawCryptoRequest) GetCredentialSet() string {
+	if m != nil {
+		return m.CredentialSet
+	}
+	return ""
+}
+
+type WithdrawFiatRequest struct {
+	Exchange             string   `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Currency             string   `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
+	Amount               float64  `protobuf:"fixed64,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Description          string   `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	CredentialSet        string   `protobuf:"bytes,5,opt,name=credential_set,json=credentialSet,proto3" json:"credential_set,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WithdrawFiatRequest) Reset()         { *m = WithdrawFiatRequest{} }
+func (m *WithdrawFiatRequest) String() string { return proto.CompactTextString(m) }
+func (*WithdrawFiatRequest) ProtoMessage()    {}
+func (*WithdrawFiatRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{45}
+}
+
+func (m *WithdrawFiatRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WithdrawFiatRequest.Unmarshal(m, b)
+}
+func (m *WithdrawFiatRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WithdrawFiatRequest.Marshal(b, m, deterministic)
+}
+func (m *WithdrawFiatRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WithdrawFiatRequest.Merge(m, src)
+}
+func (m *WithdrawFiatRequest) XXX_Size() int {
+	return xxx_messageInfo_WithdrawFiatRequest.Size(m)
+}
+func (m *WithdrawFiatRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WithdrawFiatRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WithdrawFiatRequest proto.InternalMessageInfo
+
+func (m *WithdrawFiatRequest) GetExchange() string {
+	if m != nil {
+		return m.Exchange
+	}
+	return ""
+}
+
+func (m *WithdrawFiatRequest) GetCurrency() string {
+	if m != nil {
+		return m.Currency
+	}
+	return ""
+}
+
+func (m *WithdrawFiatRequest) GetAmount() float64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *WithdrawFiatRequest) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *WithdrawFiatRequest) GetCredentialSet() string {
+	if m != nil {
+		return m.CredentialSet
+	}
+	return ""
+}
+
+type WithdrawResponse struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WithdrawResponse) Reset()         { *m = WithdrawResponse{} }
+func (m *WithdrawResponse) String() string { return proto.CompactTextString(m) }
+func (*WithdrawResponse) ProtoMessage()    {}
+func (*WithdrawResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{46}
+}
+
+func (m *WithdrawResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WithdrawResponse.Unmarshal(m, b)
+}
+func (m *WithdrawResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WithdrawResponse.Marshal(b, m, deterministic)
+}
+func (m *WithdrawResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WithdrawResponse.Merge(m, src)
+}
+func (m *WithdrawResponse) XXX_Size() int {
+	return xxx_messageInfo_WithdrawResponse.Size(m)
+}
+func (m *WithdrawResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_WithdrawResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WithdrawResponse proto.InternalMessageInfo
+
+func (m *WithdrawResponse) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+type EventCondition struct {
+	Item                 string   `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+	Operator             string   `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"`
+	Value                float64  `protobuf:"fixed64,3,opt,name=value,proto3" json:"value,omitempty"`
+	WindowSeconds        int64    `protobuf:"varint,4,opt,name=window_seconds,json=windowSeconds,proto3" json:"window_seconds,omitempty"`
+	Side                 string   `protobuf:"bytes,5,opt,name=side,proto3" json:"side,omitempty"`
+	Price                float64  `protobuf:"fixed64,6,opt,name=price,proto3" json:"price,omitempty"`
+	Currency             string   `protobuf:"bytes,7,opt,name=currency,proto3" json:"currency,omitempty"`
+	Amount               float64  `protobuf:"fixed64,8,opt,name=amount,proto3" json:"amount,omitempty"`
+	QuoteAmount          bool     `protobuf:"varint,9,opt,name=quote_amount,json=quoteAmount,proto3" json:"quote_amount,omitempty"`
+	Percent              float64  `protobuf:"fixed64,10,opt,name=percent,proto3" json:"percent,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EventCondition) Reset()         { *m = EventCondition{} }
+func (m *EventCondition) String() string { return proto.CompactTextString(m) }
+func (*EventCondition) ProtoMessage()    {}
+func (*EventCondition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{47}
+}
+
+func (m *EventCondition) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EventCondition.Unmarshal(m, b)
+}
+func (m *EventCondition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EventCondition.Marshal(b, m, deterministic)
+}
+func (m *EventCondition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventCondition.Merge(m, src)
+}
+func (m *EventCondition) XXX_Size() int {
+	return xxx_messageInfo_EventCondition.Size(m)
+}
+func (m *EventCondition) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventCondition.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventCondition proto.InternalMessageInfo
+
+func (m *EventCondition) GetItem() string {
+	if m != nil {
+		return m.Item
+	}
+	return ""
+}
+
+func (m *EventCondition) GetOperator() string {
+	if m != nil {
+		return m.Operator
+	}
+	return ""
+}
+
+func (m *EventCondition) GetValue() float64 {
+	if m != nil {
+		return m.Value
+	}
+	return 0
+}
+
+func (m *EventCondition) GetWindowSeconds() int64 {
+	if m != nil {
+		return m.WindowSeconds
+	}
+	return 0
+}
+
+func (m *EventCondition) GetSide() string {
+	if m != nil {
+		return m.Side
+	}
+	return ""
+}
+
+func (m *EventCondition) GetPrice() float64 {
+	if m != nil {
+		return m.Price
+	}
+	return 0
+}
+
+func (m *EventCondition) GetCurrency() string {
+	if m != nil {
+		return m.Currency
+	}
+	return ""
+}
+
+func (m *EventCondition) GetAmount() float64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *EventCondition) GetQuoteAmount() bool {
+	if m != nil {
+		return m.QuoteAmount
+	}
+	return false
+}
+
+func (m *EventCondition) GetPercent() float64 {
+	if m != nil {
+		return m.Percent
+	}
+	return 0
+}
+
+type EventOrderAction struct {
+	Side                 string   `protobuf:"bytes,1,opt,name=side,proto3" json:"side,omitempty"`
+	OrderType            string   `protobuf:"bytes,2,opt,name=order_type,json=orderType,proto3" json:"order_type,omitempty"`
+	Amount               string   `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Price                string   `protobuf:"bytes,4,opt,name=price,proto3" json:"price,omitempty"`
+	OrderId              string   `protobuf:"bytes,5,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	ClientId             string   `protobuf:"bytes,6,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	MaxNotional          float64  `protobuf:"fixed64,7,opt,name=max_notional,json=maxNotional,proto3" json:"max_notional,omitempty"`
+	DryRun               bool     `protobuf:"varint,8,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EventOrderAction) Reset()         { *m = EventOrderAction{} }
+func (m *EventOrderAction) String() string { return proto.CompactTextString(m) }
+func (*EventOrderAction) ProtoMessage()    {}
+func (*EventOrderAction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{48}
+}
+
+func (m *EventOrderAction) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EventOrderAction.Unmarshal(m, b)
+}
+func (m *EventOrderAction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EventOrderAction.Marshal(b, m, deterministic)
+}
+func (m *EventOrderAction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventOrderAction.Merge(m, src)
+}
+func (m *EventOrderAction) XXX_Size() int {
+	return xxx_messageInfo_EventOrderAction.Size(m)
+}
+func (m *EventOrderAction) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventOrderAction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventOrderAction proto.InternalMessageInfo
+
+func (m *EventOrderAction) GetSide() string {
+	if m != nil {
+		return m.Side
+	}
+	return ""
+}
+
+func (m *EventOrderAction) GetOrderType() string {
+	if m != nil {
+		return m.OrderType
+	}
+	return ""
+}
+
+func (m *EventOrderAction) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+func (m *EventOrderAction) GetPrice() string {
+	if m != nil {
+		return m.Price
+	}
+	return ""
+}
+
+func (m *EventOrderAction) GetOrderId() string {
+	if m != nil {
+		return m.OrderId
+	}
+	return ""
+}
+
+func (m *EventOrderAction) GetClientId() string {
+	if m != nil {
+		return m.ClientId
+	}
+	return ""
+}
+
+func (m *EventOrderAction) GetMaxNotional() float64 {
+	if m != nil {
+		return m.MaxNotional
+	}
+	return 0
+}
+
+func (m *EventOrderAction) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
+
+type Event struct {
+	Id                   int64             `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name                 string            `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Exchange             string            `protobuf:"bytes,3,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Pair                 *CurrencyPair     `protobuf:"bytes,4,opt,name=pair,proto3" json:"pair,omitempty"`
+	AssetType            string            `protobuf:"bytes,5,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
+	Logic                string            `protobuf:"bytes,6,opt,name=logic,proto3" json:"logic,omitempty"`
+	Conditions           []*EventCondition `protobuf:"bytes,7,rep,name=conditions,proto3" json:"conditions,omitempty"`
+	Action               string            `protobuf:"bytes,8,opt,name=action,proto3" json:"action,omitempty"`
+	Rearm                bool              `protobuf:"varint,9,opt,name=rearm,proto3" json:"rearm,omitempty"`
+	CooldownSeconds      int64             `protobuf:"varint,10,opt,name=cooldown_seconds,json=cooldownSeconds,proto3" json:"cooldown_seconds,omitempty"`
+	Order                *EventOrderAction `protobuf:"bytes,11,opt,name=order,proto3" json:"order,omitempty"`
+	Executed             bool              `protobuf:"varint,12,opt,name=executed,proto3" json:"executed,omitempty"`
+	TriggerCount         int64             `protobuf:"varint,13,opt,name=trigger_count,json=triggerCount,proto3" json:"trigger_count,omitempty"`
+	LastTriggered        int64             `protobuf:"varint,14,opt,name=last_triggered,json=lastTriggered,proto3" json:"last_triggered,omitempty"`
+	Description          string            `protobuf:"bytes,15,opt,name=description,proto3" json:"description,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *Event) Reset()         { *m = Event{} }
+func (m *Event) String() string { return proto.CompactTextString(m) }
+func (*Event) ProtoMessage()    {}
+func (*Event) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{49}
+}
+
+func (m *Event) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Event.Unmarshal(m, b)
+}
+func (m *Event) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Event.Marshal(b, m, deterministic)
+}
+func (m *Event) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Event.Merge(m, src)
+}
+func (m *Event) XXX_Size() int {
+	return xxx_messageInfo_Event.Size(m)
+}
+func (m *Event) XXX_DiscardUnknown() {
+	xxx_messageInfo_Event.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Event proto.InternalMessageInfo
+
+func (m *Event) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Event) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Event) GetExchange() string {
+	if m != nil {
+		return m.Exchange
+	}
+	return ""
+}
+
+func (m *Event) GetPair() *CurrencyPair {
+	if m != nil {
+		return m.Pair
+	}
+	return nil
+}
+
+func (m *Event) GetAssetType() string {
+	if m != nil {
+		return m.AssetType
+	}
+	return ""
+}
+
+func (m *Event) GetLogic() string {
+	if m != nil {
+		return m.Logic
+	}
+	return ""
+}
+
+func (m *Event) GetConditions() []*EventCondition {
+	if m != nil {
+		return m.Conditions
+	}
+	return nil
+}
+
+func (m *Event) GetAction() string {
+	if m != nil {
+		return m.Action
+	}
+	return ""
+}
+
+func (m *Event) GetRearm() bool {
+	if m != nil {
+		return m.Rearm
+	}
+	return false
+}
+
+func (m *Event) GetCooldownSeconds() int64 {
+	if m != nil {
+		return m.CooldownSeconds
+	}
+	return 0
+}
+
+func (m *Event) GetOrder() *EventOrderAction {
+	if m != nil {
+		return m.Order
+	}
+	return nil
+}
+
+func (m *Event) GetExecuted() bool {
+	if m != nil {
+		return m.Executed
+	}
+	return false
+}
+
+func (m *Event) GetTriggerCount() int64 {
+	if m != nil {
+		return m.TriggerCount
+	}
+	return 0
+}
+
+func (m *Event) GetLastTriggered() int64 {
+	if m != nil {
+		return m.LastTriggered
+	}
+	return 0
+}
+
+func (m *Event) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+type GetEventsRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetEventsRequest) Reset()         { *m = GetEventsRequest{} }
+func (m *GetEventsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetEventsRequest) ProtoMessage()    {}
+func (*GetEventsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{50}
+}
+
+func (m *GetEventsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetEventsRequest.Unmarshal(m, b)
+}
+func (m *GetEventsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetEventsRequest.Marshal(b, m, deterministic)
+}
+func (m *GetEventsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetEventsRequest.Merge(m, src)
+}
+func (m *GetEventsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetEventsRequest.Size(m)
+}
+func (m *GetEventsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetEventsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetEventsRequest proto.InternalMessageInfo
+
+type GetEventsResponse struct {
+	Events               []*Event `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetEventsResponse) Reset()         { *m = GetEventsResponse{} }
+func (m *GetEventsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetEventsResponse) ProtoMessage()    {}
+func (*GetEventsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{51}
+}
+
+func (m *GetEventsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetEventsResponse.Unmarshal(m, b)
+}
+func (m *GetEventsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetEventsResponse.Marshal(b, m, deterministic)
+}
+func (m *GetEventsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetEventsResponse.Merge(m, src)
+}
+func (m *GetEventsResponse) XXX_Size() int {
+	return xxx_messageInfo_GetEventsResponse.Size(m)
+}
+func (m *GetEventsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetEventsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetEventsResponse proto.InternalMessageInfo
+
+func (m *GetEventsResponse) GetEvents() []*Event {
+	if m != nil {
+		return m.Events
+	}
+	return nil
+}
+
+type AddEventResponse struct {
+	Id                   int64    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AddEventResponse) Reset()         { *m = AddEventResponse{} }
+func (m *AddEventResponse) String() string { return proto.CompactTextString(m) }
+func (*AddEventResponse) ProtoMessage()    {}
+func (*AddEventResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{52}
+}
+
+func (m *AddEventResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AddEventResponse.Unmarshal(m, b)
+}
+func (m *AddEventResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AddEventResponse.Marshal(b, m, deterministic)
+}
+func (m *AddEventResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddEventResponse.Merge(m, src)
+}
+func (m *AddEventResponse) XXX_Size() int {
+	return xxx_messageInfo_AddEventResponse.Size(m)
+}
+func (m *AddEventResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddEventResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddEventResponse proto.InternalMessageInfo
+
+func (m *AddEventResponse) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type GenericEventRequest struct {
+	Id                   int64    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GenericEventRequest) Reset()         { *m = GenericEventRequest{} }
+func (m *GenericEventRequest) String() string { return proto.CompactTextString(m) }
+func (*GenericEventRequest) ProtoMessage()    {}
+func (*GenericEventRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{53}
+}
+
+func (m *GenericEventRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GenericEventRequest.Unmarshal(m, b)
+}
+func (m *GenericEventRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GenericEventRequest.Marshal(b, m, deterministic)
+}
+func (m *GenericEventRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenericEventRequest.Merge(m, src)
+}
+func (m *GenericEventRequest) XXX_Size() int {
+	return xxx_messageInfo_GenericEventRequest.Size(m)
+}
+func (m *GenericEventRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenericEventRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenericEventRequest proto.InternalMessageInfo
+
+func (m *GenericEventRequest) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type PortfolioAddress struct {
+	Address              string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	CoinType             string   `protobuf:"bytes,2,opt,name=coin_type,json=coinType,proto3" json:"coin_type,omitempty"`
+	Description          string   `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Balance              float64  `protobuf:"fixed64,4,opt,name=balance,proto3" json:"balance,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PortfolioAddress) Reset()         { *m = PortfolioAddress{} }
+func (m *PortfolioAddress) String() string { return proto.CompactTextString(m) }
+func (*PortfolioAddress) ProtoMessage()    {}
+func (*PortfolioAddress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{54}
+}
+
+func (m *PortfolioAddress) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PortfolioAddress.Unmarshal(m, b)
+}
+func (m *PortfolioAddress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PortfolioAddress.Marshal(b, m, deterministic)
+}
+func (m *PortfolioAddress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PortfolioAddress.Merge(m, src)
+}
+func (m *PortfolioAddress) XXX_Size() int {
+	return xxx_messageInfo_PortfolioAddress.Size(m)
+}
+func (m *PortfolioAddress) XXX_DiscardUnknown() {
+	xxx_messageInfo_PortfolioAddress.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PortfolioAddress proto.InternalMessageInfo
+
+func (m *PortfolioAddress) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *PortfolioAddress) GetCoinType() string {
+	if m != nil {
+		return m.CoinType
+	}
+	return ""
+}
+
+func (m *PortfolioAddress) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *PortfolioAddress) GetBalance() float64 {
+	if m != nil {
+		return m.Balance
+	}
+	return 0
+}
+
+type GetPortfolioRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetPortfolioRequest) Reset()         { *m = GetPortfolioRequest{} }
+func (m *GetPortfolioRequest) String() string { return proto.CompactTextString(m) }
+func (*GetPortfolioRequest) ProtoMessage()    {}
+func (*GetPortfolioRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{55}
+}
+
+func (m *GetPortfolioRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetPortfolioRequest.Unmarshal(m, b)
+}
+func (m *GetPortfolioRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetPortfolioRequest.Marshal(b, m, deterministic)
+}
+func (m *GetPortfolioRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPortfolioRequest.Merge(m, src)
+}
+func (m *GetPortfolioRequest) XXX_Size() int {
+	return xxx_messageInfo_GetPortfolioRequest.Size(m)
+}
+func (m *GetPortfolioRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPortfolioRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetPortfolioRequest proto.InternalMessageInfo
+
+type GetPortfolioResponse struct {
+	Portfolio            []*PortfolioAddress `protobuf:"bytes,1,rep,name=portfolio,proto3" json:"portfolio,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *GetPortfolioResponse) Reset()         { *m = GetPortfolioResponse{} }
+func (m *GetPortfolioResponse) String() string { return proto.CompactTextString(m) }
+func (*GetPortfolioResponse) ProtoMessage()    {}
+func (*GetPortfolioResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{56}
+}
+
+func (m *GetPortfolioResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetPortfolioResponse.Unmarshal(m, b)
+}
+func (m *GetPortfolioResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetPortfolioResponse.Marshal(b, m, deterministic)
+}
+func (m *GetPortfolioResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPortfolioResponse.Merge(m, src)
+}
+func (m *GetPortfolioResponse) XXX_Size() int {
+	return xxx_messageInfo_GetPortfolioResponse.Size(m)
+}
+func (m *GetPortfolioResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPortfolioResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetPortfolioResponse proto.InternalMessageInfo
+
+func (m *GetPortfolioResponse) GetPortfolio() []*PortfolioAddress {
+	if m != nil {
+		return m.Portfolio
+	}
+	return nil
+}
+
+type Coin struct {
+	Coin                 string   `protobuf:"bytes,1,opt,name=coin,proto3" json:"coin,omitempty"`
+	Balance              float64  `protobuf:"fixed64,2,opt,name=balance,proto3" json:"balance,omitempty"`
+	Address              string   `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	Percentage           float64  `protobuf:"fixed64,4,opt,name=percentage,proto3" json:"percentage,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Coin) Reset()         { *m = Coin{} }
+func (m *Coin) String() string { return proto.CompactTextString(m) }
+func (*Coin) ProtoMessage()    {}
+func (*Coin) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{57}
+}
+
+func (m *Coin) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Coin.Unmarshal(m, b)
+}
+func (m *Coin) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Coin.Marshal(b, m, deterministic)
+}
+func (m *Coin) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Coin.Merge(m, src)
+}
+func (m *Coin) XXX_Size() int {
+	return xxx_messageInfo_Coin.Size(m)
+}
+func (m *Coin) XXX_DiscardUnknown() {
+	xxx_messageInfo_Coin.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Coin proto.InternalMessageInfo
+
+func (m *Coin) GetCoin() string {
+	if m != nil {
+		return m.Coin
+	}
+	return ""
+}
+
+func (m *Coin) GetBalance() float64 {
+	if m != nil {
+		return m.Balance
+	}
+	return 0
+}
+
+func (m *Coin) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *Coin) GetPercentage() float64 {
+	if m != nil {
+		return m.Percentage
+	}
+	return 0
+}
+
+type OfflineCoinSummary struct {
+	Address              string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Balance              float64  `protobuf:"fixed64,2,opt,name=balance,proto3" json:"balance,omitempty"`
+	Percentage           float64  `protobuf:"fixed64,3,opt,name=percentage,proto3" json:"percentage,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *OfflineCoinSummary) Reset()         { *m = OfflineCoinSummary{} }
+func (m *OfflineCoinSummary) String() string { return proto.CompactTextString(m) }
+func (*OfflineCoinSummary) ProtoMessage()    {}
+func (*OfflineCoinSummary) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{58}
+}
+
+func (m *OfflineCoinSummary) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OfflineCoinSummary.Unmarshal(m, b)
+}
+func (m *OfflineCoinSummary) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OfflineCoinSummary.Marshal(b, m, deterministic)
+}
+func (m *OfflineCoinSummary) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OfflineCoinSummary.Merge(m, src)
+}
+func (m *OfflineCoinSummary) XXX_Size() int {
+	return xxx_messageInfo_OfflineCoinSummary.Size(m)
+}
+func (m *OfflineCoinSummary) XXX_DiscardUnknown() {
+	xxx_messageInfo_OfflineCoinSummary.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OfflineCoinSummary proto.InternalMessageInfo
+
+func (m *OfflineCoinSummary) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *OfflineCoinSummary) GetBalance() float64 {
+	if m != nil {
+		return m.Balance
+	}
+	return 0
+}
+
+func (m *OfflineCoinSummary) GetPercentage() float64 {
+	if m != nil {
+		return m.Percentage
+	}
+	return 0
+}
+
+type OnlineCoinSummary struct {
+	Balance              float64  `protobuf:"fixed64,1,opt,name=balance,proto3" json:"balance,omitempty"`
+	Percentage           float64  `protobuf:"fixed64,2,opt,name=percentage,proto3" json:"percentage,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *OnlineCoinSummary) Reset()         { *m = OnlineCoinSummary{} }
+func (m *OnlineCoinSummary) String() string { return proto.CompactTextString(m) }
+func (*OnlineCoinSummary) ProtoMessage()    {}
+func (*OnlineCoinSummary) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{59}
+}
+
+func (m *OnlineCoinSummary) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OnlineCoinSummary.Unmarshal(m, b)
+}
+func (m *OnlineCoinSummary) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OnlineCoinSummary.Marshal(b, m, deterministic)
+}
+func (m *OnlineCoinSummary) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OnlineCoinSummary.Merge(m, src)
+}
+func (m *OnlineCoinSummary) XXX_Size() int {
+	return xxx_messageInfo_OnlineCoinSummary.Size(m)
+}
+func (m *OnlineCoinSummary) XXX_DiscardUnknown() {
+	xxx_messageInfo_OnlineCoinSummary.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OnlineCoinSummary proto.InternalMessageInfo
+
+func (m *OnlineCoinSummary) GetBalance() float64 {
+	if m != nil {
+		return m.Balance
+	}
+	return 0
+}
+
+func (m *OnlineCoinSummary) GetPercentage() float64 {
+	if m != nil {
+		return m.Percentage
+	}
+	return 0
+}
+
+type OfflineCoins struct {
+	Addresses            []*OfflineCoinSummary `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *OfflineCoins) Reset()         { *m = OfflineCoins{} }
+func (m *OfflineCoins) String() string { return proto.CompactTextString(m) }
+func (*OfflineCoins) ProtoMessage()    {}
+func (*OfflineCoins) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{60}
+}
+
+func (m *OfflineCoins) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OfflineCoins.Unmarshal(m, b)
+}
+func (m *OfflineCoins) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OfflineCoins.Marshal(b, m, deterministic)
+}
+func (m *OfflineCoins) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OfflineCoins.Merge(m, src)
+}
+func (m *OfflineCoins) XXX_Size() int {
+	return xxx_messageInfo_OfflineCoins.Size(m)
+}
+func (m *OfflineCoins) XXX_DiscardUnknown() {
+	xxx_messageInfo_OfflineCoins.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OfflineCoins proto.InternalMessageInfo
+
+func (m *OfflineCoins) GetAddresses() []*OfflineCoinSummary {
+	if m != nil {
+		return m.Addresses
+	}
+	return nil
+}
+
+type OnlineCoins struct {
+	Coins                map[string]*OnlineCoinSummary `protobuf:"bytes,1,rep,name=coins,proto3" json:"coins,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}                      `json:"-"`
+	XXX_unrecognized     []byte                        `json:"-"`
+	XXX_sizecache        int32                         `json:"-"`
+}
+
+func (m *OnlineCoins) Reset()         { *m = OnlineCoins{} }
+func (m *OnlineCoins) String() string { return proto.CompactTextString(m) }
+func (*OnlineCoins) ProtoMessage()    {}
+func (*OnlineCoins) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{61}
+}
+
+func (m *OnlineCoins) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OnlineCoins.Unmarshal(m, b)
+}
+func (m *OnlineCoins) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OnlineCoins.Marshal(b, m, deterministic)
+}
+func (m *OnlineCoins) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OnlineCoins.Merge(m, src)
+}
+func (m *OnlineCoins) XXX_Size() int {
+	return xxx_messageInfo_OnlineCoins.Size(m)
+}
+func (m *OnlineCoins) XXX_DiscardUnknown() {
+	xxx_messageInfo_OnlineCoins.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OnlineCoins proto.InternalMessageInfo
+
+func (m *OnlineCoins) GetCoins() map[string]*OnlineCoinSummary {
+	if m != nil {
+		return m.Coins
+	}
+	return nil
+}
+
+type GetPortfolioSummaryRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetPortfolioSummaryRequest) Reset()         { *m = GetPortfolioSummaryRequest{} }
+func (m *GetPortfolioSummaryRequest) String() string { return proto.CompactTextString(m) }
+func (*GetPortfolioSummaryRequest) ProtoMessage()    {}
+func (*GetPortfolioSummaryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{62}
+}
+
+func (m *GetPortfolioSummaryRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetPortfolioSummaryRequest.Unmarshal(m, b)
+}
+func (m *GetPortfolioSummaryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetPortfolioSummaryRequest.Marshal(b, m, deterministic)
+}
+func (m *GetPortfolioSummaryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPortfolioSummaryRequest.Merge(m, src)
+}
+func (m *GetPortfolioSummaryRequest) XXX_Size() int {
+	return xxx_messageInfo_GetPortfolioSummaryRequest.Size(m)
+}
+func (m *GetPortfolioSummaryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPortfolioSummaryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetPortfolioSummaryRequest proto.InternalMessageInfo
+
+type GetPortfolioSummaryResponse struct {
+	CoinTotals           []*Coin                  `protobuf:"bytes,1,rep,name=coin_totals,json=coinTotals,proto3" json:"coin_totals,omitempty"`
+	CoinsOffline         []*Coin                  `protobuf:"bytes,2,rep,name=coins_offline,json=coinsOffline,proto3" json:"coins_offline,omitempty"`
+	CoinsOfflineSummary  map[string]*OfflineCoins `protobuf:"bytes,3,rep,name=coins_offline_summary,json=coinsOfflineSummary,proto3" json:"coins_offline_summary,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	CoinsOnline          []*Coin                  `protobuf:"bytes,4,rep,name=coins_online,json=coinsOnline,proto3" json:"coins_online,omitempty"`
+	CoinsOnlineSummary   map[string]*OnlineCoins  `protobuf:"bytes,5,rep,name=coins_online_summary,json=coinsOnlineSummary,proto3" json:"coins_online_summary,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *GetPortfolioSummaryResponse) Reset()         { *m = GetPortfolioSummaryResponse{} }
+func (m *GetPortfolioSummaryResponse) String() string { return proto.CompactTextString(m) }
+func (*GetPortfolioSummaryResponse) ProtoMessage()    {}
+func (*GetPortfolioSummaryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{63}
+}
+
+func (m *GetPortfolioSummaryResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetPortfolioSummaryResponse.Unmarshal(m, b)
+}
+func (m *GetPortfolioSummaryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetPortfolioSummaryResponse.Marshal(b, m, deterministic)
+}
+func (m *GetPortfolioSummaryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPortfolioSummaryResponse.Merge(m, src)
+}
+func (m *GetPortfolioSummaryResponse) XXX_Size() int {
+	return xxx_messageInfo_GetPortfolioSummaryResponse.Size(m)
+}
+func (m *GetPortfolioSummaryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPortfolioSummaryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetPortfolioSummaryResponse proto.InternalMessageInfo
+
+func (m *GetPortfolioSummaryResponse) GetCoinTotals() []*Coin {
+	if m != nil {
+		return m.CoinTotals
+	}
+	return nil
+}
+
+func (m *GetPortfolioSummaryResponse) GetCoinsOffline() []*Coin {
+	if m != nil {
+		return m.CoinsOffline
+	}
+	return nil
+}
+
+func (m *GetPortfolioSummaryResponse) GetCoinsOfflineSummary() map[string]*OfflineCoins {
+	if m != nil {
+		return m.CoinsOfflineSummary
+	}
+	return nil
+}
+
+func (m *GetPortfolioSummaryResponse) GetCoinsOnline() []*Coin {
+	if m != nil {
+		return m.CoinsOnline
+	}
+	return nil
+}
+
+func (m *GetPortfolioSummaryResponse) GetCoinsOnlineSummary() map[string]*OnlineCoins {
+	if m != nil {
+		return m.CoinsOnlineSummary
+	}
+	return nil
+}
+
+type AddPortfolioAddressRequest struct {
+	Address              string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	CoinType             string   `protobuf:"bytes,2,opt,name=coin_type,json=coinType,proto3" json:"coin_type,omitempty"`
+	Description          string   `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Balance              float64  `protobuf:"fixed64,4,opt,name=balance,proto3" json:"balance,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AddPortfolioAddressRequest) Reset()         { *m = AddPortfolioAddressRequest{} }
+func (m *AddPortfolioAddressRequest) String() string { return proto.CompactTextString(m) }
+func (*AddPortfolioAddressRequest) ProtoMessage()    {}
+func (*AddPortfolioAddressRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{64}
+}
+
+func (m *AddPortfolioAddressRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AddPortfolioAddressRequest.Unmarshal(m, b)
+}
+func (m *AddPortfolioAddressRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AddPortfolioAddressRequest.Marshal(b, m, deterministic)
+}
+func (m *AddPortfolioAddressRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddPortfolioAddressRequest.Merge(m, src)
+}
+func (m *AddPortfolioAddressRequest) XXX_Size() int {
+	return xxx_messageInfo_AddPortfolioAddressRequest.Size(m)
+}
+func (m *AddPortfolioAddressRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddPortfolioAddressRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddPortfolioAddressRequest proto.InternalMessageInfo
+
+func (m *AddPortfolioAddressRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *AddPortfolioAddressRequest) GetCoinType() string {
+	if m != nil {
+		return m.CoinType
+	}
+	return ""
+}
+
+func (m *AddPortfolioAddressRequest) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *AddPortfolioAddressRequest) GetBalance() float64 {
+	if m != nil {
+		return m.Balance
+	}
+	return 0
+}
+
+type RemovePortfolioAddressRequest struct {
+	Address              string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	CoinType             string   `protobuf:"bytes,2,opt,name=coin_type,json=coinType,proto3" json:"coin_type,omitempty"`
+	Description          string   `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RemovePortfolioAddressRequest) Reset()         { *m = RemovePortfolioAddressRequest{} }
+func (m *RemovePortfolioAddressRequest) String() string { return proto.CompactTextString(m) }
+func (*RemovePortfolioAddressRequest) ProtoMessage()    {}
+func (*RemovePortfolioAddressRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{65}
+}
+
+func (m *RemovePortfolioAddressRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RemovePortfolioAddressRequest.Unmarshal(m, b)
+}
+func (m *RemovePortfolioAddressRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RemovePortfolioAddressRequest.Marshal(b, m, deterministic)
+}
+func (m *RemovePortfolioAddressRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemovePortfolioAddressRequest.Merge(m, src)
+}
+func (m *RemovePortfolioAddressRequest) XXX_Size() int {
+	return xxx_messageInfo_RemovePortfolioAddressRequest.Size(m)
+}
+func (m *RemovePortfolioAddressRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemovePortfolioAddressRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemovePortfolioAddressRequest proto.InternalMessageInfo
+
+func (m *RemovePortfolioAddressRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *RemovePortfolioAddressRequest) GetCoinType() string {
+	if m != nil {
+		return m.CoinType
+	}
+	return ""
+}
+
+func (m *RemovePortfolioAddressRequest) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*GetInfoRequest)(nil), "gctrpc.GetInfoRequest")
+	proto.RegisterType((*GetInfoResponse)(nil), "gctrpc.GetInfoResponse")
+	proto.RegisterMapType((map[string]bool)(nil), "gctrpc.GetInfoResponse.SubsystemStatusEntry")
+	proto.RegisterType((*GetSubsystemsRequest)(nil), "gctrpc.GetSubsystemsRequest")
+	proto.RegisterType((*GetSubsystemsResponse)(nil), "gctrpc.GetSubsystemsResponse")
+	proto.RegisterMapType((map[string]bool)(nil), "gctrpc.GetSubsystemsResponse.SubsystemsStatusEntry")
+	proto.RegisterType((*SetSubsystemRequest)(nil), "gctrpc.SetSubsystemRequest")
+	proto.RegisterType((*ReloadConfigRequest)(nil), "gctrpc.ReloadConfigRequest")
+	proto.RegisterType((*ReloadConfigResponse)(nil), "gctrpc.ReloadConfigResponse")
+	proto.RegisterType((*GetExchangesRequest)(nil), "gctrpc.GetExchangesRequest")
+	proto.RegisterType((*GetExchangesResponse)(nil), "gctrpc.GetExchangesResponse")
+	proto.RegisterType((*GenericExchangeNameRequest)(nil), "gctrpc.GenericExchangeNameRequest")
+	proto.RegisterType((*GenericResponse)(nil), "gctrpc.GenericResponse")
+	proto.RegisterType((*GetExchangeInfoResponse)(nil), "gctrpc.GetExchangeInfoResponse")
+	proto.RegisterType((*CurrencyPair)(nil), "gctrpc.CurrencyPair")
+	proto.RegisterType((*GetTickerRequest)(nil), "gctrpc.GetTickerRequest")
+	proto.RegisterType((*TickerResponse)(nil), "gctrpc.TickerResponse")
+	proto.RegisterType((*GetTickersRequest)(nil), "gctrpc.GetTickersRequest")
+	proto.RegisterType((*Tickers)(nil), "gctrpc.Tickers")
+	proto.RegisterType((*GetTickersResponse)(nil), "gctrpc.GetTickersResponse")
+	proto.RegisterType((*GetOrderbookRequest)(nil), "gctrpc.GetOrderbookRequest")
+	proto.RegisterType((*OrderbookItem)(nil), "gctrpc.OrderbookItem")
+	proto.RegisterType((*OrderbookResponse)(nil), "gctrpc.OrderbookResponse")
+	proto.RegisterType((*GetOrderbooksRequest)(nil), "gctrpc.GetOrderbooksRequest")
+	proto.RegisterType((*Orderbooks)(nil), "gctrpc.Orderbooks")
+	proto.RegisterType((*GetOrderbooksResponse)(nil), "gctrpc.GetOrderbooksResponse")
+	proto.RegisterType((*GetSyncStatusRequest)(nil), "gctrpc.GetSyncStatusRequest")
+	proto.RegisterType((*SyncItemStatus)(nil), "gctrpc.SyncItemStatus")
+	proto.RegisterType((*SyncStatus)(nil), "gctrpc.SyncStatus")
+	proto.RegisterType((*GetSyncStatusResponse)(nil), "gctrpc.GetSyncStatusResponse")
+	proto.RegisterType((*GetAccountInfoRequest)(nil), "gctrpc.GetAccountInfoRequest")
+	proto.RegisterType((*AccountCurrencyInfo)(nil), "gctrpc.AccountCurrencyInfo")
+	proto.RegisterType((*Account)(nil), "gctrpc.Account")
+	proto.RegisterType((*GetAccountInfoResponse)(nil), "gctrpc.GetAccountInfoResponse")
+	proto.RegisterType((*OrderStatusChange)(nil), "gctrpc.OrderStatusChange")
+	proto.RegisterType((*OrderFill)(nil), "gctrpc.OrderFill")
+	proto.RegisterType((*OrderDetails)(nil), "gctrpc.OrderDetails")
+	proto.RegisterType((*GetOrdersRequest)(nil), "gctrpc.GetOrdersRequest")
+	proto.RegisterType((*GetOrdersResponse)(nil), "gctrpc.GetOrdersResponse")
+	proto.RegisterType((*GetOrderRequest)(nil), "gctrpc.GetOrderRequest")
+	proto.RegisterType((*SubmitOrderRequest)(nil), "gctrpc.SubmitOrderRequest")
+	proto.RegisterType((*SubmitOrderResponse)(nil), "gctrpc.SubmitOrderResponse")
+	proto.RegisterType((*CancelOrderRequest)(nil), "gctrpc.CancelOrderRequest")
+	proto.RegisterType((*CancelAllOrdersRequest)(nil), "gctrpc.CancelAllOrdersRequest")
+	proto.RegisterType((*GetDepositAddressRequest)(nil), "gctrpc.GetDepositAddressRequest")
+	proto.RegisterType((*GetDepositAddressResponse)(nil), "gctrpc.GetDepositAddressResponse")
+	proto.RegisterType((*WithdrawCryptoRequest)(nil), "gctrpc.WithdrawCryptoRequest")
+	proto.RegisterType((*WithdrawFiatRequest)(nil), "gctrpc.WithdrawFiatRequest")
+	proto.RegisterType((*WithdrawResponse)(nil), "gctrpc.WithdrawResponse")
+	proto.RegisterType((*EventCondition)(nil), "gctrpc.EventCondition")
+	proto.RegisterType((*EventOrderAction)(nil), "gctrpc.EventOrderAction")
+	proto.RegisterType((*Event)(nil), "gctrpc.Event")
+	proto.RegisterType((*GetEventsRequest)(nil), "gctrpc.GetEventsRequest")
+	proto.RegisterType((*GetEventsResponse)(nil), "gctrpc.GetEventsResponse")
+	proto.RegisterType((*AddEventResponse)(nil), "gctrpc.AddEventResponse")
+	proto.RegisterType((*GenericEventRequest)(nil), "gctrpc.GenericEventRequest")
+	proto.RegisterType((*PortfolioAddress)(nil), "gctrpc.PortfolioAddress")
+	proto.RegisterType((*GetPortfolioRequest)(nil), "gctrpc.GetPortfolioRequest")
+	proto.RegisterType((*GetPortfolioResponse)(nil), "gctrpc.GetPortfolioResponse")
+	proto.RegisterType((*Coin)(nil), "gctrpc.Coin")
+	proto.RegisterType((*OfflineCoinSummary)(nil), "gctrpc.OfflineCoinSummary")
+	proto.RegisterType((*OnlineCoinSummary)(nil), "gctrpc.OnlineCoinSummary")
+	proto.RegisterType((*OfflineCoins)(nil), "gctrpc.OfflineCoins")
+	proto.RegisterType((*OnlineCoins)(nil), "gctrpc.OnlineCoins")
+	proto.RegisterMapType((map[string]*OnlineCoinSummary)(nil), "gctrpc.OnlineCoins.CoinsEntry")
+	proto.RegisterType((*GetPortfolioSummaryRequest)(nil), "gctrpc.GetPortfolioSummaryRequest")
+	proto.RegisterType((*GetPortfolioSummaryResponse)(nil), "gctrpc.GetPortfolioSummaryResponse")
+	proto.RegisterMapType((map[string]*OfflineCoins)(nil), "gctrpc.GetPortfolioSummaryResponse.CoinsOfflineSummaryEntry")
+	proto.RegisterMapType((map[string]*OnlineCoins)(nil), "gctrpc.GetPortfolioSummaryResponse.CoinsOnlineSummaryEntry")
+	proto.RegisterType((*AddPortfolioAddressRequest)(nil), "gctrpc.AddPortfolioAddressRequest")
+	proto.RegisterType((*RemovePortfolioAddressRequest)(nil), "gctrpc.RemovePortfolioAddressRequest")
+}
+
+func init() { proto.RegisterFile("rpc.proto", fileDescriptor_77a6da22d6a3feb1) }
+
+var fileDescriptor_77a6da22d6a3feb1 = []byte{
+	// 3390 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x5a, 0x4b, 0x6f, 0x1c, 0xc7,
+	0xb5, 0x56, 0xcf, 0x83, 0x33, 0x73, 0x66, 0xc8, 0x21, 0x6b, 0x48, 0xaa, 0x35, 0xa2, 0x64, 0xa9,
+	0x0d, 0x5d, 0xcb, 0x2f, 0xea, 0x9a, 0x7e, 0xc0, 0xd7, 0xf6, 0x85, 0x2f, 0x4d, 0x49, 0xb4, 0xe0,
+	0x6b, 0x49, 0x69, 0xd2, 0x56, 0x60, 0x04, 0x18, 0x37, 0xbb, 0x8b, 0x64, 0x43, 0x3d, 0xdd, 0xe3,
+	0xee, 0x1e, 0x8a, 0x13, 0x20, 0x4b, 0x03, 0x59, 0x25, 0xab, 0x20, 0xfe, 0x01, 0x59, 0x64, 0x95,
+	0x55, 0xd6, 0x41, 0xb2, 0xce, 0x0f, 0xc8, 0x4f, 0xc8, 0x3a, 0xdb, 0x04, 0x41, 0x82, 0x3a, 0xf5,
+	0xe8, 0xea, 0xc7, 0x8c, 0x29, 0xd8, 0xf1, 0x66, 0xd0, 0x75, 0xea, 0xd4, 0xa9, 0xf3, 0xf8, 0xea,
+	0xd4, 0xa9, 0xaa, 0x81, 0x4e, 0x3c, 0x71, 0xb7, 0x27, 0x71, 0x94, 0x46, 0x64, 0xe9, 0xc4, 0x4d,
+	0xe3, 0x89, 0x6b, 0xad, 0xc2, 0xca, 0x3e, 0x4d, 0x1f, 0x84, 0xc7, 0x91, 0x4d, 0xbf, 0x9a, 0xd2,
+	0x24, 0xb5, 0x7e, 0x5b, 0x87, 0xbe, 0x22, 0x25, 0x93, 0x28, 0x4c, 0x28, 0xd9, 0x84, 0xa5, 0xe9,
+	0x24, 0xf5, 0xc7, 0xd4, 0x34, 0x6e, 0x18, 0xb7, 0x3b, 0xb6, 0x68, 0x91, 0x3b, 0x30, 0x70, 0xce,
+	0x1c, 0x3f, 0x70, 0x8e, 0x02, 0x3a, 0xa2, 0xe7, 0xee, 0xa9, 0x13, 0x9e, 0xd0, 0xc4, 0xac, 0xdd,
+	0x30, 0x6e, 0xd7, 0x6d, 0xa2, 0xba, 0xee, 0xc9, 0x1e, 0xf2, 0x2a, 0xac, 0xd1, 0x90, 0x91, 0x3c,
+	0x8d, 0xbd, 0x8e, 0xec, 0xab, 0xa2, 0x23, 0x63, 0x7e, 0x0b, 0x36, 0x3d, 0x7a, 0xec, 0x4c, 0x83,
+	0x74, 0x74, 0x1c, 0xc5, 0xf4, 0x7c, 0x34, 0x89, 0xa3, 0x33, 0xdf, 0xa3, 0xb1, 0xd9, 0x40, 0x2d,
+	0xd6, 0x45, 0xef, 0x7d, 0xd6, 0xf9, 0x58, 0xf4, 0x91, 0x1d, 0xd8, 0x50, 0xa3, 0x7c, 0x27, 0x1d,
+	0xb9, 0xd3, 0x38, 0xa6, 0xa1, 0x3b, 0x33, 0x9b, 0x38, 0x68, 0x20, 0x07, 0xf9, 0x4e, 0xba, 0x27,
+	0xba, 0xc8, 0x13, 0x58, 0x4d, 0xa6, 0x47, 0xc9, 0x2c, 0x49, 0xe9, 0x78, 0x94, 0xa4, 0x4e, 0x3a,
+	0x4d, 0xcc, 0xa5, 0x1b, 0xf5, 0xdb, 0xdd, 0x9d, 0xd7, 0xb6, 0xb9, 0xa3, 0xb6, 0x0b, 0x2e, 0xd9,
+	0x3e, 0x90, 0xfc, 0x07, 0xc8, 0x7e, 0x2f, 0x4c, 0xe3, 0x99, 0xdd, 0x4f, 0xf2, 0x54, 0x62, 0x42,
+	0xeb, 0x8c, 0xc6, 0x89, 0x1f, 0x85, 0x66, 0x0b, 0xa7, 0x97, 0xcd, 0xe1, 0x47, 0xb0, 0x5e, 0x25,
+	0x82, 0xac, 0x42, 0xfd, 0x29, 0x9d, 0x09, 0x3f, 0xb3, 0x4f, 0xb2, 0x0e, 0xcd, 0x33, 0x27, 0x98,
+	0x52, 0x74, 0x6b, 0xdb, 0xe6, 0x8d, 0xf7, 0x6a, 0xef, 0x1a, 0xd6, 0x26, 0xac, 0xef, 0xd3, 0x54,
+	0x89, 0x49, 0x64, 0x08, 0xff, 0x60, 0xc0, 0x46, 0xa1, 0x43, 0x04, 0xf2, 0x4b, 0x58, 0x53, 0x2a,
+	0x26, 0xd2, 0x52, 0x03, 0x2d, 0x7d, 0x53, 0xb3, 0xb4, 0x3c, 0x32, 0xb3, 0x37, 0xd1, 0x0d, 0x5e,
+	0x4d, 0x0a, 0xe4, 0xe1, 0x1e, 0x6c, 0x54, 0xb2, 0x3e, 0x97, 0x61, 0x9f, 0xc0, 0xe0, 0x40, 0xd3,
+	0x42, 0xd8, 0x45, 0xb6, 0xa0, 0xa3, 0xe6, 0x13, 0x82, 0x32, 0x02, 0x03, 0x29, 0x87, 0x90, 0x90,
+	0x27, 0x5a, 0xd6, 0x06, 0x0c, 0x6c, 0x1a, 0x44, 0x8e, 0xb7, 0x17, 0x85, 0xc7, 0xfe, 0x89, 0x74,
+	0xd2, 0xef, 0x6b, 0xb0, 0x9e, 0xa7, 0x0b, 0x1f, 0xbd, 0x0c, 0xab, 0x8c, 0x9a, 0x83, 0x28, 0x73,
+	0x51, 0xc7, 0xee, 0x73, 0x7a, 0x86, 0xd0, 0xd7, 0x81, 0xc4, 0xb4, 0xc4, 0x5c, 0x43, 0xe6, 0xb5,
+	0x98, 0x56, 0xb0, 0x4f, 0xc3, 0x12, 0x7b, 0x9d, 0xb3, 0x4f, 0xc3, 0x22, 0xfb, 0xdb, 0xb0, 0x19,
+	0x53, 0x66, 0x9f, 0x1b, 0xfb, 0x47, 0xb9, 0x21, 0x0d, 0x1c, 0xb2, 0xa1, 0xf7, 0x66, 0xc3, 0xde,
+	0x80, 0xf5, 0x98, 0x26, 0xa9, 0x13, 0xa7, 0xd4, 0x1b, 0x65, 0xf1, 0x31, 0x9b, 0x38, 0x68, 0xa0,
+	0xfa, 0xb2, 0x30, 0x31, 0x93, 0x05, 0x79, 0x14, 0xd3, 0xaf, 0xa6, 0x7e, 0x4c, 0x3d, 0xc4, 0x7f,
+	0xc7, 0xee, 0x0b, 0xba, 0x2d, 0xc8, 0xd6, 0x1d, 0x18, 0xec, 0xd3, 0x54, 0xcd, 0x26, 0x43, 0x63,
+	0x42, 0x4b, 0xac, 0x5f, 0x0c, 0x4c, 0xdb, 0x96, 0x4d, 0xeb, 0x2d, 0x58, 0xcf, 0x0f, 0x10, 0x6e,
+	0xde, 0x82, 0x4e, 0xd1, 0xbf, 0x19, 0xc1, 0x7a, 0x17, 0x86, 0xfb, 0x34, 0xa4, 0xb1, 0xef, 0xca,
+	0x91, 0x0f, 0x9d, 0x31, 0x95, 0xb3, 0x0d, 0xa1, 0x2d, 0x59, 0x05, 0x0e, 0x54, 0xdb, 0xfa, 0x5f,
+	0xe8, 0x8b, 0x91, 0x7a, 0xfa, 0x52, 0x50, 0xc7, 0xf4, 0xc5, 0x5b, 0x84, 0x40, 0xc3, 0x73, 0x52,
+	0x07, 0xf1, 0xd2, 0xb1, 0xf1, 0xdb, 0xfa, 0x63, 0x1d, 0x2e, 0x6b, 0xfa, 0xe6, 0xd2, 0x20, 0x81,
+	0x46, 0xe8, 0xa8, 0x24, 0x88, 0xdf, 0xba, 0xe1, 0xb5, 0x9c, 0xe1, 0x62, 0xed, 0x1f, 0x45, 0x09,
+	0xc5, 0x0c, 0xd7, 0xb6, 0x65, 0x93, 0xbc, 0x08, 0xcb, 0xd3, 0xc4, 0x0f, 0x4f, 0x46, 0x89, 0x13,
+	0x7a, 0x47, 0xd1, 0x39, 0xe6, 0xb3, 0xb6, 0xdd, 0x43, 0xe2, 0x01, 0xa7, 0x91, 0x9b, 0xd0, 0x3b,
+	0x4d, 0xd3, 0xc9, 0x88, 0x25, 0xda, 0x68, 0x9a, 0x8a, 0xf4, 0xd5, 0x65, 0xb4, 0x43, 0x4e, 0x22,
+	0xb7, 0x60, 0x05, 0x59, 0xa6, 0x09, 0x8d, 0x9d, 0x13, 0x1a, 0xa6, 0xe6, 0x12, 0x32, 0x2d, 0x33,
+	0xea, 0x67, 0x92, 0x48, 0xae, 0x01, 0x20, 0xdb, 0x24, 0x8e, 0xce, 0x67, 0x22, 0x0f, 0x75, 0x18,
+	0xe5, 0x31, 0x23, 0x90, 0x97, 0xa0, 0x7f, 0xe4, 0x24, 0x54, 0x26, 0x4a, 0x9f, 0x26, 0x66, 0x1b,
+	0x79, 0x56, 0x18, 0x79, 0x4f, 0x51, 0x19, 0x63, 0x96, 0xed, 0x27, 0x8e, 0x1f, 0x27, 0x66, 0x87,
+	0x33, 0x2a, 0xf2, 0x63, 0x46, 0x65, 0xf6, 0xc9, 0x2c, 0xcf, 0xd9, 0x00, 0xd9, 0x7a, 0x82, 0xc8,
+	0x99, 0x5e, 0x85, 0x35, 0x67, 0x9a, 0x9e, 0xd2, 0x30, 0xf5, 0x5d, 0x87, 0x41, 0xd5, 0x99, 0xf8,
+	0x66, 0x17, 0x1d, 0xb1, 0x9a, 0xeb, 0xd8, 0x9d, 0xf8, 0x8c, 0xf9, 0x19, 0x3d, 0x4a, 0x22, 0xf7,
+	0x29, 0x4d, 0x47, 0xd2, 0xdf, 0x3d, 0xce, 0xac, 0x3a, 0xee, 0x09, 0xc4, 0x7d, 0x0e, 0x3d, 0x99,
+	0xd9, 0xd9, 0x54, 0x0c, 0x69, 0x1e, 0x0d, 0xfc, 0xb1, 0x9f, 0xd2, 0x58, 0xa6, 0x0d, 0x45, 0x60,
+	0x41, 0x65, 0x76, 0x4a, 0x10, 0xb0, 0x6f, 0x96, 0x99, 0xbe, 0x9a, 0x46, 0x29, 0x0f, 0x5c, 0xc7,
+	0xe6, 0x0d, 0xeb, 0x19, 0xac, 0xee, 0xd3, 0xf4, 0xd0, 0x77, 0x9f, 0xd2, 0xf8, 0x02, 0x48, 0x24,
+	0xb7, 0xa1, 0xc1, 0xcc, 0x47, 0xc9, 0xdd, 0x9d, 0x75, 0x99, 0x5f, 0x75, 0xdd, 0x6c, 0xe4, 0x60,
+	0x11, 0x72, 0x92, 0x84, 0xa6, 0xa3, 0x74, 0x36, 0x91, 0x93, 0x76, 0x90, 0x72, 0x38, 0x9b, 0x50,
+	0xeb, 0x57, 0x35, 0x58, 0x91, 0xd3, 0x0a, 0x28, 0x4a, 0xd9, 0xc6, 0xb7, 0xca, 0xbe, 0x09, 0xbd,
+	0xc0, 0x49, 0xd2, 0xd1, 0x74, 0xe2, 0x31, 0x6f, 0x8a, 0xcd, 0xb9, 0xcb, 0x68, 0x9f, 0x71, 0x12,
+	0x8b, 0x97, 0xdc, 0x25, 0x31, 0x60, 0x42, 0x83, 0x9e, 0xab, 0x7b, 0x91, 0x40, 0x83, 0x8d, 0x41,
+	0xac, 0x1a, 0x36, 0x7e, 0x33, 0xda, 0xa9, 0x7f, 0x72, 0x8a, 0xd8, 0x34, 0x6c, 0xfc, 0x66, 0x79,
+	0x3e, 0x88, 0x9e, 0x21, 0x12, 0x0d, 0x9b, 0x7d, 0x32, 0xca, 0x91, 0xef, 0x21, 0xf0, 0x0c, 0x9b,
+	0x7d, 0x32, 0x8a, 0x93, 0x3c, 0x45, 0x98, 0x19, 0x36, 0xfb, 0x64, 0x4b, 0xf4, 0x2c, 0x0a, 0xa6,
+	0x63, 0x8a, 0x90, 0x32, 0x6c, 0xd1, 0x22, 0x57, 0xa1, 0x33, 0x89, 0x7d, 0x97, 0x8e, 0x9c, 0xf4,
+	0x14, 0x61, 0x64, 0xd8, 0x6d, 0x24, 0xec, 0xa6, 0xa7, 0xd6, 0x00, 0xd6, 0x54, 0x40, 0xd4, 0xe6,
+	0xf7, 0x04, 0x5a, 0x82, 0xb2, 0x30, 0x38, 0xff, 0x0d, 0xad, 0x94, 0xb3, 0x61, 0xbe, 0xee, 0xee,
+	0x6c, 0x4a, 0x1f, 0xe6, 0x3d, 0x6d, 0x4b, 0x36, 0xeb, 0x43, 0x20, 0xfa, 0x6c, 0x6a, 0xb7, 0x50,
+	0x72, 0xf8, 0x3e, 0xda, 0xcf, 0xcb, 0x49, 0x32, 0x01, 0x3f, 0xc5, 0xd4, 0xf9, 0x28, 0xf6, 0x58,
+	0x1a, 0x88, 0x9e, 0xfe, 0xa0, 0x10, 0xfa, 0x14, 0x96, 0xd5, 0xc4, 0x0f, 0xc4, 0x6e, 0xe9, 0x8c,
+	0xa3, 0x69, 0x98, 0xe2, 0x9c, 0x86, 0x2d, 0x5a, 0x0c, 0xfa, 0xe8, 0x5f, 0x9c, 0xd2, 0xb0, 0x79,
+	0x83, 0xac, 0x40, 0xcd, 0xf7, 0x44, 0xa1, 0x56, 0xf3, 0x3d, 0xeb, 0xef, 0x06, 0xac, 0x69, 0x86,
+	0x3c, 0x37, 0x28, 0x4b, 0x88, 0xab, 0x55, 0x20, 0xee, 0x65, 0x68, 0x1c, 0xf9, 0x1e, 0xdf, 0x20,
+	0xbb, 0x3b, 0x1b, 0x52, 0x5c, 0xce, 0x0e, 0x1b, 0x59, 0x18, 0xab, 0x93, 0x3c, 0xe5, 0x1b, 0xe3,
+	0x7c, 0x56, 0xc6, 0x52, 0x5a, 0x0f, 0xcd, 0xf2, 0x7a, 0xc8, 0xfb, 0x72, 0xa9, 0xe8, 0x4b, 0x5e,
+	0x76, 0x29, 0xd9, 0x0a, 0x79, 0x2e, 0x40, 0x46, 0x5c, 0x18, 0xd6, 0xff, 0x01, 0x88, 0x14, 0xa7,
+	0xc0, 0xdf, 0x95, 0x92, 0xd2, 0x0a, 0x82, 0x1a, 0xb3, 0xf5, 0x09, 0x96, 0x76, 0xfa, 0xe4, 0xc2,
+	0xf9, 0x3b, 0x39, 0x99, 0x1c, 0x8b, 0xa4, 0x24, 0x33, 0xc9, 0x09, 0xdb, 0xe1, 0x05, 0xe4, 0x2c,
+	0x74, 0x79, 0xa5, 0x76, 0x91, 0xfd, 0xf5, 0x4f, 0x06, 0xac, 0xb0, 0x11, 0x0f, 0xb2, 0x2a, 0x97,
+	0xed, 0xaf, 0xd1, 0x34, 0x76, 0xd5, 0xf1, 0x80, 0xb7, 0x90, 0x3e, 0x0b, 0x5d, 0xb5, 0x35, 0x8a,
+	0x56, 0x29, 0x04, 0xf5, 0x72, 0x08, 0x76, 0x60, 0x03, 0x59, 0xb2, 0xac, 0xcf, 0x99, 0x31, 0xfd,
+	0xd4, 0xed, 0x01, 0xeb, 0x7c, 0x22, 0xfb, 0xf8, 0x20, 0x72, 0x1b, 0x56, 0x71, 0x4c, 0x4c, 0x95,
+	0x6c, 0x11, 0xdd, 0x15, 0x46, 0xb7, 0xa9, 0x14, 0x6f, 0xfd, 0xbc, 0x06, 0x90, 0x59, 0xbd, 0x30,
+	0x54, 0x79, 0x2c, 0xd4, 0x0a, 0x58, 0x50, 0x90, 0xaf, 0x7f, 0x2b, 0xe4, 0xef, 0xc0, 0x20, 0x33,
+	0xc6, 0x8d, 0xc2, 0x90, 0xba, 0xcc, 0x76, 0xbe, 0xf5, 0x13, 0xd5, 0xb5, 0x27, 0x7b, 0xc8, 0x36,
+	0x2c, 0xf1, 0xcc, 0x81, 0x46, 0x68, 0x09, 0x2a, 0xef, 0x7d, 0x5b, 0x70, 0x91, 0xb7, 0xa0, 0xa3,
+	0x42, 0x6b, 0x2e, 0x2d, 0x1c, 0x92, 0x31, 0x5a, 0x7b, 0xfc, 0xa8, 0xa0, 0x41, 0x40, 0xe0, 0xe9,
+	0x15, 0xad, 0x68, 0xca, 0x61, 0x49, 0xe3, 0x15, 0x1c, 0xd6, 0x9b, 0x28, 0x64, 0xd7, 0x75, 0x59,
+	0x0a, 0xd1, 0x0e, 0x93, 0x0b, 0x81, 0x74, 0x0c, 0x03, 0x31, 0x42, 0x7a, 0x8b, 0x8d, 0x64, 0x43,
+	0xd4, 0x91, 0x4d, 0x0c, 0x91, 0x6d, 0xf2, 0x02, 0x74, 0xd3, 0x28, 0x75, 0x82, 0x51, 0x76, 0x6e,
+	0x30, 0x6c, 0x40, 0xd2, 0xe7, 0x8c, 0x82, 0x1b, 0x52, 0x14, 0x70, 0x44, 0xb1, 0x0d, 0x29, 0x0a,
+	0x3c, 0xeb, 0x67, 0xd0, 0x12, 0xf3, 0x88, 0x34, 0xc6, 0xa5, 0xd6, 0x7c, 0x8f, 0xbc, 0x0f, 0xa0,
+	0x55, 0x3d, 0x7c, 0x1d, 0x5e, 0x95, 0x76, 0x56, 0x28, 0x67, 0x6b, 0xec, 0xac, 0xfa, 0x72, 0x63,
+	0xea, 0xd1, 0x30, 0xf5, 0x9d, 0x60, 0x94, 0xd0, 0x54, 0x64, 0xdd, 0xe5, 0x8c, 0x7a, 0x40, 0x53,
+	0xcb, 0x81, 0xcd, 0xa2, 0x6f, 0x84, 0x87, 0x17, 0xc1, 0xee, 0x55, 0x68, 0x3b, 0x7c, 0x88, 0xd4,
+	0xab, 0x5f, 0xd0, 0xcb, 0x56, 0x0c, 0xd6, 0x13, 0x91, 0x8c, 0x79, 0x54, 0xf6, 0xb8, 0x84, 0x05,
+	0x45, 0x2f, 0x9e, 0xe4, 0x79, 0x1d, 0x80, 0xdf, 0x8c, 0x37, 0xa6, 0x4e, 0x12, 0x85, 0xc2, 0x04,
+	0xd1, 0xb2, 0x22, 0xe8, 0xa0, 0xe0, 0xfb, 0x7e, 0x10, 0x94, 0x9c, 0x57, 0xbd, 0x53, 0x64, 0xfb,
+	0x4a, 0x3d, 0xb7, 0xaf, 0xac, 0x42, 0xfd, 0x98, 0x52, 0x51, 0x3d, 0xb0, 0x4f, 0xa5, 0x48, 0x33,
+	0x53, 0xc4, 0xfa, 0x47, 0x03, 0x7a, 0x38, 0xe3, 0x5d, 0x9a, 0x3a, 0x7e, 0x90, 0x94, 0x26, 0xd5,
+	0x7d, 0x56, 0x2b, 0xf8, 0xec, 0x15, 0x58, 0x93, 0xdf, 0x23, 0x04, 0xf8, 0x48, 0xec, 0x59, 0x1d,
+	0xbb, 0x2f, 0x3b, 0x50, 0xf8, 0x03, 0x8f, 0xd5, 0x15, 0x6e, 0xe0, 0xd3, 0x30, 0x65, 0x3c, 0x0d,
+	0x01, 0x33, 0x24, 0x3c, 0xa8, 0xa8, 0x87, 0x9a, 0x15, 0xbb, 0xd3, 0x35, 0x91, 0x6f, 0x47, 0x89,
+	0xef, 0xa9, 0x4d, 0x02, 0x29, 0x07, 0xbe, 0x47, 0xb3, 0x6e, 0xcc, 0x1b, 0x2d, 0xad, 0x1b, 0xf3,
+	0x86, 0x72, 0x5e, 0xbb, 0xda, 0x79, 0x9d, 0x9c, 0xf3, 0x5e, 0x82, 0x3e, 0x3d, 0xa7, 0xee, 0x14,
+	0xcb, 0x64, 0xce, 0xc0, 0x6b, 0xa1, 0x15, 0x49, 0xde, 0xe5, 0x8c, 0x78, 0x90, 0x1b, 0x3b, 0x7e,
+	0xc8, 0x4e, 0x17, 0x82, 0xb3, 0x8b, 0x9c, 0x7d, 0x45, 0xdf, 0xcd, 0x05, 0xa4, 0x97, 0x05, 0x24,
+	0x43, 0xcc, 0x72, 0x0e, 0x31, 0xff, 0x07, 0x2b, 0xfc, 0x6b, 0x74, 0xea, 0x27, 0x69, 0x14, 0xcf,
+	0xcc, 0x95, 0x8a, 0x1d, 0x4b, 0x07, 0x9f, 0xbd, 0xcc, 0x07, 0x7c, 0xcc, 0xf9, 0xc9, 0x4b, 0xd0,
+	0x3c, 0xf6, 0x83, 0x20, 0x31, 0xfb, 0x38, 0x70, 0x2d, 0x37, 0x90, 0x81, 0xcb, 0xe6, 0xfd, 0x3c,
+	0xbc, 0x29, 0x8d, 0x43, 0x27, 0x30, 0x57, 0x31, 0x33, 0xaa, 0x36, 0x53, 0x6f, 0x12, 0x38, 0x6c,
+	0x37, 0x59, 0x43, 0xc4, 0x88, 0x56, 0x69, 0x37, 0x21, 0xe5, 0xdd, 0xa4, 0xbc, 0x54, 0x07, 0x55,
+	0x4b, 0xf5, 0x2f, 0x06, 0x56, 0xf8, 0xa8, 0x55, 0xf2, 0xbd, 0x97, 0x67, 0x1a, 0x5a, 0xea, 0x45,
+	0xb4, 0x64, 0xae, 0x6f, 0xe4, 0x5c, 0xff, 0x02, 0x74, 0x1d, 0x37, 0xf5, 0xcf, 0xe8, 0x28, 0x0a,
+	0x03, 0x7e, 0x85, 0xd5, 0xb6, 0x81, 0x93, 0x1e, 0x85, 0xc1, 0xac, 0xc2, 0xb2, 0xa5, 0x2a, 0xcb,
+	0x76, 0x61, 0x4d, 0x33, 0x4c, 0xe4, 0x9f, 0xd7, 0x60, 0x09, 0x35, 0x90, 0x19, 0x7e, 0x3d, 0x17,
+	0x16, 0xb1, 0x02, 0x6d, 0xc1, 0x63, 0xbd, 0x86, 0xd7, 0x82, 0xd8, 0x25, 0x5d, 0x73, 0x05, 0xda,
+	0x6a, 0x9d, 0x71, 0xd7, 0xb4, 0x22, 0xbe, 0xbe, 0xac, 0x7f, 0x19, 0x40, 0x0e, 0xa6, 0x47, 0x63,
+	0x3f, 0x3f, 0xe2, 0xfb, 0x71, 0x26, 0x81, 0x86, 0xe6, 0x46, 0xfc, 0x2e, 0xac, 0xb7, 0x46, 0x71,
+	0xbd, 0x65, 0x2b, 0xab, 0x59, 0x5d, 0xee, 0x2e, 0xe9, 0xeb, 0x30, 0x97, 0x1d, 0x5a, 0x85, 0xec,
+	0x50, 0x76, 0x79, 0xbb, 0xca, 0xe5, 0xbf, 0x36, 0x60, 0x90, 0xf3, 0x80, 0xf0, 0xfa, 0x4d, 0xe8,
+	0x71, 0x45, 0x05, 0x98, 0xf9, 0x75, 0x49, 0x17, 0x69, 0x8f, 0x91, 0x94, 0xf3, 0x6b, 0x2d, 0xe7,
+	0xd7, 0xe7, 0xca, 0x71, 0x73, 0x40, 0x65, 0xdd, 0x01, 0xb2, 0xe7, 0x84, 0x2e, 0x0d, 0x2e, 0x1a,
+	0xcc, 0xaf, 0x0d, 0xd8, 0xe4, 0x23, 0x76, 0x83, 0xe0, 0x3f, 0xb1, 0x3a, 0x2e, 0xb8, 0x95, 0xfe,
+	0xc6, 0x00, 0x73, 0x9f, 0xa6, 0x77, 0xe9, 0x24, 0x4a, 0xfc, 0x74, 0xd7, 0xf3, 0x62, 0x9a, 0x5c,
+	0x48, 0x93, 0xff, 0x62, 0xf2, 0x67, 0x93, 0x34, 0x52, 0x95, 0x05, 0x77, 0x6b, 0x81, 0x8a, 0xc5,
+	0x1e, 0xdf, 0x54, 0x33, 0xb7, 0x76, 0x04, 0xa5, 0x32, 0xf2, 0x8d, 0x2a, 0x35, 0xdf, 0x86, 0x2b,
+	0x15, 0x5a, 0x8a, 0xf0, 0x9b, 0xd0, 0x72, 0x38, 0x49, 0x7a, 0x59, 0x34, 0xad, 0x7f, 0x1a, 0xb0,
+	0xf1, 0xc4, 0x4f, 0x4f, 0xbd, 0xd8, 0x79, 0xb6, 0x87, 0x7a, 0x5d, 0xc4, 0x34, 0xbd, 0x5c, 0xaa,
+	0x15, 0xca, 0x25, 0x6d, 0xae, 0x7a, 0x6e, 0x2e, 0xcc, 0x2b, 0xfc, 0x73, 0x94, 0x3a, 0x27, 0xc2,
+	0x0c, 0x10, 0xa4, 0x43, 0xe7, 0x64, 0xee, 0x7a, 0x11, 0xbb, 0xc6, 0x52, 0xb6, 0x6b, 0xdc, 0x80,
+	0xae, 0x47, 0x13, 0x37, 0xf6, 0x27, 0x69, 0x76, 0xcd, 0xad, 0x93, 0x2e, 0xba, 0x60, 0x7e, 0x67,
+	0xc0, 0x40, 0xda, 0xcf, 0x6e, 0xe7, 0xbf, 0xab, 0xf5, 0xf3, 0x2a, 0x91, 0x82, 0xc2, 0x8d, 0x8b,
+	0x28, 0xdc, 0xac, 0x52, 0xd8, 0x82, 0x55, 0xa9, 0xaf, 0x0a, 0x6f, 0xa1, 0x5e, 0xb1, 0xbe, 0xa9,
+	0xc1, 0xca, 0xbd, 0x33, 0x1a, 0xb2, 0xba, 0xde, 0xf3, 0x51, 0x3a, 0x81, 0x86, 0x9f, 0x5d, 0x60,
+	0xe3, 0x37, 0xb3, 0x23, 0x9a, 0xd0, 0xd8, 0x49, 0x23, 0x79, 0x14, 0x56, 0xed, 0xec, 0x9a, 0x9c,
+	0x9b, 0xc1, 0x1b, 0x4c, 0xc7, 0x67, 0x7e, 0xe8, 0x45, 0xcf, 0x46, 0x09, 0x75, 0xa3, 0xd0, 0x4b,
+	0xc4, 0xc9, 0x68, 0x99, 0x53, 0x0f, 0x38, 0x51, 0xa5, 0xca, 0xa6, 0x96, 0x2a, 0xab, 0x73, 0x9e,
+	0xee, 0xca, 0xd6, 0x5c, 0x57, 0xb6, 0x73, 0xae, 0xbc, 0x09, 0x3d, 0xbc, 0x1a, 0x1b, 0x69, 0x55,
+	0x4b, 0xdb, 0xee, 0x22, 0x4d, 0x94, 0x19, 0x26, 0xb4, 0x26, 0x34, 0x76, 0xa9, 0x2a, 0x59, 0x64,
+	0xd3, 0xfa, 0xab, 0x01, 0xab, 0xe8, 0x1a, 0xcc, 0x28, 0xbb, 0xae, 0x74, 0x0e, 0xea, 0x6b, 0xcc,
+	0x4d, 0xed, 0xb5, 0xf9, 0xa9, 0x5d, 0x14, 0xaf, 0xc5, 0xd4, 0xce, 0x23, 0x2c, 0xcc, 0xd4, 0xd3,
+	0x5c, 0x33, 0x9f, 0x5b, 0x73, 0x59, 0x7f, 0xa9, 0x90, 0xf5, 0x6f, 0x42, 0x6f, 0xec, 0x9c, 0x8f,
+	0xc2, 0x88, 0xa9, 0xe9, 0x04, 0xe2, 0x36, 0xab, 0x3b, 0x76, 0xce, 0x1f, 0x0a, 0x12, 0xb9, 0x0c,
+	0x2d, 0x2f, 0x9e, 0x8d, 0xe2, 0x69, 0x88, 0x6e, 0x6a, 0xdb, 0x4b, 0x5e, 0x3c, 0xb3, 0xa7, 0xa1,
+	0xf5, 0xb7, 0x3a, 0x34, 0xd1, 0x52, 0x0d, 0x1e, 0x78, 0x8f, 0xa2, 0x6e, 0x94, 0x6b, 0xda, 0x8d,
+	0xb2, 0x8e, 0xf7, 0xfa, 0x9c, 0x94, 0xda, 0x78, 0xce, 0xfb, 0xa0, 0x66, 0xf1, 0xdc, 0xba, 0x0e,
+	0xcd, 0x20, 0x3a, 0xf1, 0x5d, 0x61, 0x27, 0x6f, 0x90, 0x77, 0x00, 0x5c, 0x89, 0xd3, 0xc4, 0x6c,
+	0xe5, 0xef, 0xc5, 0xf2, 0x30, 0xb6, 0x35, 0x4e, 0x0c, 0x01, 0xc6, 0x4f, 0xac, 0x6c, 0xd1, 0x62,
+	0xb3, 0xc4, 0xd4, 0x89, 0xc7, 0x02, 0x18, 0xbc, 0xc1, 0x8a, 0x54, 0x37, 0x8a, 0x02, 0x2f, 0x7a,
+	0x16, 0x2a, 0xf0, 0x02, 0xba, 0xa4, 0x2f, 0xe9, 0x12, 0xbe, 0xdb, 0xd0, 0xc4, 0xe8, 0x60, 0x11,
+	0xdb, 0xdd, 0x31, 0x73, 0xba, 0x68, 0xb8, 0xb1, 0x39, 0x1b, 0xf7, 0x1d, 0xaf, 0x88, 0xc5, 0xf5,
+	0xb0, 0x6a, 0xb3, 0xaa, 0x3e, 0x8d, 0xfd, 0x93, 0x13, 0x1a, 0x8f, 0x30, 0xa1, 0x63, 0x95, 0x5b,
+	0xb7, 0x7b, 0x82, 0xb8, 0xc7, 0x68, 0x6c, 0x59, 0x61, 0x31, 0x29, 0x88, 0xd4, 0x33, 0x57, 0xf8,
+	0xb2, 0x62, 0xd4, 0x43, 0x49, 0x2c, 0xe6, 0x90, 0x7e, 0x29, 0x87, 0x58, 0x04, 0x4b, 0x49, 0xd4,
+	0x53, 0x5d, 0x10, 0xbd, 0x07, 0x6b, 0x1a, 0x4d, 0x64, 0x8c, 0x5b, 0xb0, 0x44, 0x91, 0x22, 0xaa,
+	0xb0, 0xe5, 0x9c, 0x8d, 0xb6, 0xe8, 0x64, 0xc9, 0x66, 0xd7, 0xf3, 0x38, 0xad, 0x9c, 0x6c, 0xf8,
+	0xad, 0xdc, 0x2d, 0x18, 0x88, 0xa7, 0x0f, 0xc1, 0xc7, 0x13, 0x68, 0x91, 0xed, 0x6b, 0x03, 0x56,
+	0x1f, 0x47, 0x71, 0x7a, 0x1c, 0x05, 0x7e, 0x24, 0xf6, 0xa7, 0xf9, 0xfb, 0x12, 0x2e, 0x8b, 0xc8,
+	0x0f, 0xf5, 0xd5, 0xd7, 0x66, 0x04, 0xc4, 0x51, 0xc1, 0x11, 0xf5, 0x72, 0x32, 0x35, 0xa1, 0x75,
+	0xe4, 0x04, 0xac, 0x7a, 0x10, 0x87, 0x3f, 0xd9, 0x64, 0x0f, 0x73, 0xfb, 0x34, 0x55, 0x9a, 0x48,
+	0x2f, 0x3d, 0x84, 0xf5, 0x3c, 0x59, 0x58, 0xfb, 0x0e, 0x74, 0x26, 0x92, 0x28, 0x7c, 0xa5, 0xf0,
+	0x50, 0x34, 0xc7, 0xce, 0x58, 0xad, 0x10, 0x1a, 0x7b, 0x91, 0x8f, 0xa9, 0x85, 0xa9, 0x2d, 0x53,
+	0x0b, 0xfb, 0xd6, 0x95, 0xab, 0xe5, 0x94, 0x5b, 0xb0, 0x77, 0x5e, 0x07, 0x10, 0x29, 0xcc, 0x39,
+	0x91, 0x36, 0x69, 0x14, 0xeb, 0x14, 0xc8, 0xa3, 0xe3, 0xe3, 0xc0, 0x0f, 0x29, 0x9b, 0xf6, 0x60,
+	0x3a, 0x1e, 0x3b, 0xf1, 0x6c, 0x81, 0x7f, 0xe7, 0xeb, 0x90, 0x9f, 0xa9, 0x5e, 0x9a, 0xe9, 0x53,
+	0x58, 0x7b, 0x14, 0x56, 0x4c, 0x24, 0xc5, 0x19, 0x8b, 0xc4, 0xd5, 0x4a, 0xe2, 0x3e, 0x86, 0x9e,
+	0xa6, 0x78, 0x42, 0xde, 0x85, 0x8e, 0xd0, 0x91, 0x4a, 0x70, 0x0e, 0xd5, 0x11, 0xa1, 0x64, 0xa1,
+	0x9d, 0x31, 0x5b, 0xdf, 0x18, 0xd0, 0xcd, 0x34, 0x63, 0x2f, 0xf9, 0x4d, 0xe6, 0x6e, 0x29, 0xe5,
+	0xba, 0x92, 0x92, 0xf1, 0x6c, 0xe3, 0x2f, 0x7f, 0x55, 0xe6, 0xcc, 0xc3, 0x03, 0x80, 0x8c, 0x58,
+	0xf1, 0x7e, 0x7c, 0x47, 0x7f, 0x3f, 0xd6, 0x8f, 0xa3, 0x45, 0x9f, 0xe8, 0x4f, 0xcb, 0x5b, 0x30,
+	0xd4, 0xd1, 0x25, 0x39, 0x04, 0xf6, 0xfe, 0xdc, 0x80, 0xab, 0x95, 0xdd, 0x02, 0x83, 0xaf, 0x43,
+	0x97, 0xaf, 0x05, 0x76, 0xe5, 0x24, 0xcd, 0xe9, 0xa9, 0x34, 0x1c, 0xf9, 0x98, 0x17, 0xfd, 0xf0,
+	0x10, 0xfb, 0xc9, 0x1b, 0xb0, 0xcc, 0x5a, 0xc9, 0x28, 0xe2, 0xee, 0x32, 0x6b, 0x15, 0x03, 0x7a,
+	0xc8, 0x22, 0x1c, 0x4a, 0x26, 0xb0, 0x91, 0x1b, 0x32, 0x4a, 0xb8, 0x0a, 0xe2, 0x16, 0xfc, 0x03,
+	0xed, 0x95, 0x7e, 0x9e, 0x96, 0xdb, 0x7b, 0x9a, 0x40, 0xd1, 0xc7, 0x1d, 0x3b, 0x70, 0xcb, 0x3d,
+	0xe4, 0x0e, 0xf4, 0xc4, 0x8c, 0xe8, 0x37, 0xb3, 0x51, 0xa1, 0x63, 0x97, 0x0f, 0x44, 0x06, 0x32,
+	0x86, 0x75, 0x7d, 0x80, 0xd2, 0xb0, 0x89, 0x03, 0xdf, 0xbf, 0xb8, 0x86, 0x61, 0x49, 0x41, 0xe2,
+	0x96, 0x3a, 0x86, 0x3f, 0x01, 0x73, 0x9e, 0x41, 0x15, 0xa0, 0x78, 0x25, 0x0f, 0x8a, 0xf5, 0x0a,
+	0xc0, 0x26, 0x1a, 0x1e, 0x86, 0x5f, 0xc0, 0xe5, 0x39, 0xca, 0x54, 0x08, 0x7f, 0x39, 0x2f, 0x7c,
+	0x50, 0x81, 0x63, 0x1d, 0x6b, 0xbf, 0x34, 0x60, 0xb8, 0xeb, 0x79, 0xa5, 0xe4, 0x94, 0xbd, 0x99,
+	0xff, 0xd0, 0x29, 0xf7, 0x1c, 0xae, 0xd9, 0x74, 0x1c, 0x9d, 0xd1, 0x1f, 0x5a, 0xa7, 0x9d, 0x5f,
+	0x10, 0x58, 0xd9, 0x8f, 0xf8, 0xb9, 0xe6, 0x30, 0x76, 0xd8, 0x66, 0xfd, 0x01, 0xb4, 0xc4, 0xbf,
+	0x6a, 0xc8, 0x66, 0xe9, 0x6f, 0x36, 0xa8, 0xce, 0xf0, 0xf2, 0x9c, 0xbf, 0xdf, 0x58, 0x97, 0xc8,
+	0x43, 0x58, 0xce, 0xfd, 0x53, 0x85, 0x6c, 0xcd, 0xf9, 0x03, 0x0b, 0x97, 0x74, 0x6d, 0xe1, 0xdf,
+	0x5b, 0xac, 0x4b, 0xe4, 0x3e, 0xf4, 0xf4, 0xff, 0x9c, 0x10, 0x75, 0x0f, 0x5c, 0xf1, 0x4f, 0x14,
+	0x5d, 0xaf, 0xdc, 0x5f, 0x0d, 0xac, 0x4b, 0xe4, 0x13, 0xe8, 0xe9, 0x7f, 0x2b, 0xc9, 0xe4, 0x54,
+	0xfc, 0x09, 0x65, 0xb8, 0x55, 0xdd, 0xa9, 0x0b, 0xd3, 0xff, 0x3c, 0x91, 0x09, 0xab, 0xf8, 0x0f,
+	0xc6, 0x70, 0xab, 0xba, 0x53, 0x09, 0xfb, 0x31, 0xf4, 0xb5, 0x1e, 0xf4, 0xbb, 0x55, 0xb0, 0xa3,
+	0xe2, 0xcf, 0x16, 0xc3, 0x17, 0x2a, 0xc4, 0x16, 0x62, 0xf1, 0x08, 0x56, 0xf8, 0xe3, 0xbb, 0xec,
+	0xbf, 0x90, 0xe0, 0x05, 0x4e, 0x7c, 0x0c, 0xfd, 0xbb, 0x7e, 0xf2, 0x7d, 0x4a, 0xfc, 0x10, 0x3a,
+	0xea, 0xf5, 0x96, 0x98, 0x9a, 0x49, 0xb9, 0xf7, 0xfc, 0xe1, 0x9c, 0x57, 0x60, 0xeb, 0x12, 0xb9,
+	0x07, 0xa0, 0xb8, 0x13, 0x72, 0xa5, 0x24, 0x41, 0x85, 0x61, 0x58, 0xd5, 0xa5, 0xc4, 0x7c, 0x8c,
+	0x11, 0x55, 0xef, 0x71, 0xb9, 0x88, 0x16, 0x9f, 0x86, 0x87, 0xf3, 0xdf, 0x04, 0xd5, 0x02, 0x78,
+	0x94, 0xbd, 0x38, 0x6e, 0x55, 0x89, 0xaa, 0x5c, 0x00, 0xe5, 0xe7, 0xc3, 0x6c, 0x41, 0x65, 0xcf,
+	0x62, 0xb9, 0x05, 0x55, 0x7c, 0x23, 0x1c, 0x5e, 0x9b, 0xd3, 0xab, 0xe4, 0xfd, 0x08, 0xff, 0x5a,
+	0xa8, 0x3d, 0x7c, 0x10, 0x7d, 0x48, 0xf9, 0xb1, 0x68, 0x78, 0x7d, 0x5e, 0xb7, 0x12, 0xf9, 0x11,
+	0x74, 0xa4, 0xf6, 0x49, 0x2e, 0x88, 0xb9, 0x4b, 0xa9, 0xe1, 0x95, 0x8a, 0x1e, 0x25, 0xe3, 0x7d,
+	0x68, 0x4b, 0x32, 0xb9, 0x5c, 0x64, 0x94, 0x12, 0x2a, 0xaf, 0x42, 0x31, 0x7a, 0x5d, 0xed, 0x4e,
+	0x8f, 0xa8, 0x50, 0x97, 0xaf, 0x3a, 0x87, 0x57, 0x2b, 0xfb, 0x94, 0x1a, 0x77, 0xa1, 0xab, 0x5d,
+	0xc2, 0x65, 0x92, 0xca, 0x37, 0x73, 0x8b, 0x50, 0xfd, 0xff, 0xd0, 0x2f, 0x5c, 0xcc, 0x91, 0xeb,
+	0x79, 0x49, 0xc5, 0x1b, 0xbb, 0x45, 0xd2, 0xbe, 0x80, 0xb5, 0xd2, 0xc5, 0x15, 0xb9, 0x91, 0xf1,
+	0x57, 0xdf, 0xbc, 0x0d, 0x6f, 0x2e, 0xe0, 0xd0, 0x92, 0xcf, 0xd5, 0xfc, 0xe5, 0x96, 0xbc, 0x5c,
+	0xb8, 0x3f, 0x65, 0x07, 0x3d, 0x05, 0x8d, 0xca, 0x1b, 0xb0, 0xa1, 0x59, 0xec, 0xce, 0xf9, 0x60,
+	0x4d, 0xbf, 0x36, 0xe2, 0xf2, 0xae, 0x16, 0x07, 0x68, 0x37, 0x4a, 0x0b, 0xa5, 0x71, 0x88, 0xf1,
+	0x33, 0x5a, 0x0e, 0x62, 0xb9, 0xa3, 0xdc, 0xf0, 0x4a, 0x45, 0x8f, 0x92, 0xf1, 0x36, 0xb4, 0xe5,
+	0x59, 0x8d, 0xe4, 0x8f, 0x73, 0xd9, 0xd4, 0xc5, 0xc3, 0x1c, 0x66, 0x98, 0x2e, 0xdf, 0x9c, 0xf9,
+	0xc8, 0xab, 0xc5, 0x84, 0xa7, 0x9d, 0xe9, 0x16, 0x45, 0xf1, 0x2e, 0x80, 0xcd, 0xce, 0xd9, 0xdf,
+	0x4d, 0x0a, 0xdf, 0x79, 0x54, 0x99, 0xa0, 0xcb, 0x29, 0x1d, 0xd9, 0x86, 0x5b, 0xd5, 0x9d, 0x4a,
+	0xd8, 0x97, 0xf9, 0x93, 0x9e, 0xac, 0x3c, 0xad, 0x85, 0xa5, 0x22, 0x17, 0xfd, 0xe2, 0x05, 0xca,
+	0x49, 0xeb, 0x12, 0x39, 0x84, 0x41, 0x45, 0xa5, 0x95, 0xcd, 0x30, 0xbf, 0x0c, 0x5b, 0xbc, 0x20,
+	0x36, 0xab, 0xcb, 0x25, 0x72, 0x2b, 0xdb, 0xb8, 0x17, 0x94, 0x53, 0x0b, 0x64, 0x1f, 0x2d, 0xe1,
+	0x1f, 0xb1, 0xdf, 0xfc, 0xf7, 0x00, 0xee, 0xf8, 0x78, 0x56, 0x95, 0x2d, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// GoCryptoTraderClient is the client API for GoCryptoTrader service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type GoCryptoTraderClient interface {
+	GetInfo(ctx context.Context, in *GetInfoRequest, opts ...grpc.CallOption) (*GetInfoResponse, error)
+	GetSubsystems(ctx context.Context, in *GetSubsystemsRequest, opts ...grpc.CallOption) (*GetSubsystemsResponse, error)
+	SetSubsystem(ctx context.Context, in *SetSubsystemRequest, opts ...grpc.CallOption) (*GenericResponse, error)
+	ReloadConfig(ctx context.Context, in *ReloadConfigRequest, opts ...grpc.CallOption) (*ReloadConfigResponse, error)
+	GetExchanges(ctx context.Context, in *GetExchangesRequest, opts ...grpc.CallOption) (*GetExchangesResponse, error)
+	GetExchangeInfo(ctx context.Context, in *GenericExchangeNameRequest, opts ...grpc.CallOption) (*GetExchangeInfoResponse, error)
+	EnableExchange(ctx context.Context, in *GenericExchangeNameRequest, opts ...grpc.CallOption) (*GenericResponse, error)
+	DisableExchange(ctx context.Context, in *GenericExchangeNameRequest, opts ...grpc.CallOption) (*GenericResponse, error)
+	GetTicker(ctx context.Context, in *GetTickerRequest, opts ...grpc.CallOption) (*TickerResponse, error)
+	GetTickers(ctx context.Context, in *GetTickersRequest, opts ...grpc.CallOption) (*GetTickersResponse, error)
+	GetOrderbook(ctx context.Context, in *GetOrderbookRequest, opts ...grpc.CallOption) (*OrderbookResponse, error)
+	GetOrderbooks(ctx context.Context, in *GetOrderbooksRequest, opts ...grpc.CallOption) (*GetOrderbooksResponse, error)
+	GetSyncStatus(ctx context.Context, in *GetSyncStatusRequest, opts ...grpc.CallOption) (*GetSyncStatusResponse, error)
+	GetAccountInfo(ctx context.Context, in *GetAccountInfoRequest, opts ...grpc.CallOption) (*GetAccountInfoResponse, error)
+	GetOrders(ctx context.Context, in *GetOrdersRequest, opts ...grpc.CallOption) (*GetOrdersResponse, error)
+	GetOrder(ctx context.Context, in *GetOrderRequest, opts ...grpc.CallOption) (*OrderDetails, error)
+	SubmitOrder(ctx context.Context, in *SubmitOrderRequest, opts ...grpc.CallOption) (*SubmitOrderResponse, error)
+	CancelOrder(ctx context.Context, in *CancelOrderRequest, opts ...grpc.CallOption) (*GenericResponse, error)
+	CancelAllOrders(ctx context.Context, in *CancelAllOrdersRequest, opts ...grpc.CallOption) (*GenericResponse, error)
+	GetDepositAddress(ctx context.Context, in *GetDepositAddressRequest, opts ...grpc.CallOption) (*GetDepositAddressResponse, error)
+	WithdrawCryptocurrencyFunds(ctx context.Context, in *WithdrawCryptoRequest, opts ...grpc.CallOption) (*WithdrawResponse, error)
+	WithdrawFiatFunds(ctx context.Context, in *WithdrawFiatRequest, opts ...grpc.CallOption) (*WithdrawResponse, error)
+	GetEvents(ctx context.Context, in *GetEventsRequest, opts ...grpc.CallOption) (*GetEventsResponse, error)
+	AddEvent(ctx context.Context, in *Event, opts ...grpc.CallOption) (*AddEventResponse, error)
+	RemoveEvent(ctx context.Context, in *GenericEventRequest, opts ...grpc.CallOption) (*GenericResponse, error)
+	RearmEvent(ctx context.Context, in *GenericEventRequest, opts ...grpc.CallOption) (*GenericResponse, error)
+	GetPortfolio(ctx context.Context, in *GetPortfolioRequest, opts ...grpc.CallOption) (*GetPortfolioResponse, error)
+	GetPortfolioSummary(ctx context.Context, in *GetPortfolioSummaryRequest, opts ...grpc.CallOption) (*GetPortfolioSummaryResponse, error)
+	AddPortfolioAddress(ctx context.Context, in *AddPortfolioAddressRequest, opts ...grpc.CallOption) (*GenericResponse, error)
+	RemovePortfolioAddress(ctx context.Context, in *RemovePortfolioAddressRequest, opts ...grpc.CallOption) (*GenericResponse, error)
+}
+
+type goCryptoTraderClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewGoCryptoTraderClient(cc *grpc.ClientConn) GoCryptoTraderClient {
+	return &goCryptoTraderClient{cc}
+}
+
+func (c *goCryptoTraderClient) GetInfo(ctx context.Context, in *GetInfoRequest, opts ...grpc.CallOption) (*GetInfoResponse, error) {
+	out := new(GetInfoResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetSubsystems(ctx context.Context, in *GetSubsystemsRequest, opts ...grpc.CallOption) (*GetSubsystemsResponse, error) {
+	out := new(GetSubsystemsResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetSubsystems", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) SetSubsystem(ctx context.Context, in *SetSubsystemRequest, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/SetSubsystem", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) ReloadConfig(ctx context.Context, in *ReloadConfigRequest, opts ...grpc.CallOption) (*ReloadConfigResponse, error) {
+	out := new(ReloadConfigResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/ReloadConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetExchanges(ctx context.Context, in *GetExchangesRequest, opts ...grpc.CallOption) (*GetExchangesResponse, error) {
+	out := new(GetExchangesResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetExchanges", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetExchangeInfo(ctx context.Context, in *GenericExchangeNameRequest, opts ...grpc.CallOption) (*GetExchangeInfoResponse, error) {
+	out := new(GetExchangeInfoResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetExchangeInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) EnableExchange(ctx context.Context, in *GenericExchangeNameRequest, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/EnableExchange", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) DisableExchange(ctx context.Context, in *GenericExchangeNameRequest, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/DisableExchange", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetTicker(ctx context.Context, in *GetTickerRequest, opts ...grpc.CallOption) (*TickerResponse, error) {
+	out := new(TickerResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetTicker", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetTickers(ctx context.Context, in *GetTickersRequest, opts ...grpc.CallOption) (*GetTickersResponse, error) {
+	out := new(GetTickersResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetTickers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetOrderbook(ctx context.Context, in *GetOrderbookRequest, opts ...grpc.CallOption) (*OrderbookResponse, error) {
+	out := new(OrderbookResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetOrderbook", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetOrderbooks(ctx context.Context, in *GetOrderbooksRequest, opts ...grpc.CallOption) (*GetOrderbooksResponse, error) {
+	out := new(GetOrderbooksResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetOrderbooks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetSyncStatus(ctx context.Context, in *GetSyncStatusRequest, opts ...grpc.CallOption) (*GetSyncStatusResponse, error) {
+	out := new(GetSyncStatusResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetSyncStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetAccountInfo(ctx context.Context, in *GetAccountInfoRequest, opts ...grpc.CallOption) (*GetAccountInfoResponse, error) {
+	out := new(GetAccountInfoResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetAccountInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetOrders(ctx context.Context, in *GetOrdersRequest, opts ...grpc.CallOption) (*GetOrdersResponse, error) {
+	out := new(GetOrdersResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetOrders", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetOrder(ctx context.Context, in *GetOrderRequest, opts ...grpc.CallOption) (*OrderDetails, error) {
+	out := new(OrderDetails)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) SubmitOrder(ctx context.Context, in *SubmitOrderRequest, opts ...grpc.CallOption) (*SubmitOrderResponse, error) {
+	out := new(SubmitOrderResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/SubmitOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) CancelOrder(ctx context.Context, in *CancelOrderRequest, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/CancelOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) CancelAllOrders(ctx context.Context, in *CancelAllOrdersRequest, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/CancelAllOrders", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetDepositAddress(ctx context.Context, in *GetDepositAddressRequest, opts ...grpc.CallOption) (*GetDepositAddressResponse, error) {
+	out := new(GetDepositAddressResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetDepositAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) WithdrawCryptocurrencyFunds(ctx context.Context, in *WithdrawCryptoRequest, opts ...grpc.CallOption) (*WithdrawResponse, error) {
+	out := new(WithdrawResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/WithdrawCryptocurrencyFunds", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) WithdrawFiatFunds(ctx context.Context, in *WithdrawFiatRequest, opts ...grpc.CallOption) (*WithdrawResponse, error) {
+	out := new(WithdrawResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/WithdrawFiatFunds", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetEvents(ctx context.Context, in *GetEventsRequest, opts ...grpc.CallOption) (*GetEventsResponse, error) {
+	out := new(GetEventsResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetEvents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) AddEvent(ctx context.Context, in *Event, opts ...grpc.CallOption) (*AddEventResponse, error) {
+	out := new(AddEventResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/AddEvent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) RemoveEvent(ctx context.Context, in *GenericEventRequest, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/RemoveEvent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) RearmEvent(ctx context.Context, in *GenericEventRequest, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/RearmEvent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetPortfolio(ctx context.Context, in *GetPortfolioRequest, opts ...grpc.CallOption) (*GetPortfolioResponse, error) {
+	out := new(GetPortfolioResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetPortfolio", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetPortfolioSummary(ctx context.Context, in *GetPortfolioSummaryRequest, opts ...grpc.CallOption) (*GetPortfolioSummaryResponse, error) {
+	out := new(GetPortfolioSummaryResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetPortfolioSummary", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) AddPortfolioAddress(ctx context.Context, in *AddPortfolioAddressRequest, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/AddPortfolioAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) RemovePortfolioAddress(ctx context.Context, in *RemovePortfolioAddressRequest, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/RemovePortfolioAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GoCryptoTraderServer is the server API for GoCryptoTrader service.
+type GoCryptoTraderServer interface {
+	GetInfo(context.Context, *GetInfoRequest) (*GetInfoResponse, error)
+	GetSubsystems(context.Context, *GetSubsystemsRequest) (*GetSubsystemsResponse, error)
+	SetSubsystem(context.Context, *SetSubsystemRequest) (*GenericResponse, error)
+	ReloadConfig(context.Context, *ReloadConfigRequest) (*ReloadConfigResponse, error)
+	GetExchanges(context.Context, *GetExchangesRequest) (*GetExchangesResponse, error)
+	GetExchangeInfo(context.Context, *GenericExchangeNameRequest) (*GetExchangeInfoResponse, error)
+	EnableExchange(context.Context, *GenericExchangeNameRequest) (*GenericResponse, error)
+	DisableExchange(context.Context, *GenericExchangeNameRequest) (*GenericResponse, error)
+	GetTicker(context.Context, *GetTickerRequest) (*TickerResponse, error)
+	GetTickers(context.Context, *GetTickersRequest) (*GetTickersResponse, error)
+	GetOrderbook(context.Context, *GetOrderbookRequest) (*OrderbookResponse, error)
+	GetOrderbooks(context.Context, *GetOrderbooksRequest) (*GetOrderbooksResponse, error)
+	GetSyncStatus(context.Context, *GetSyncStatusRequest) (*GetSyncStatusResponse, error)
+	GetAccountInfo(context.Context, *GetAccountInfoRequest) (*GetAccountInfoResponse, error)
+	GetOrders(context.Context, *GetOrdersRequest) (*GetOrdersResponse, error)
+	GetOrder(context.Context, *GetOrderRequest) (*OrderDetails, error)
+	SubmitOrder(context.Context, *SubmitOrderRequest) (*SubmitOrderResponse, error)
+	CancelOrder(context.Context, *CancelOrderRequest) (*GenericResponse, error)
+	CancelAllOrders(context.Context, *CancelAllOrdersRequest) (*GenericResponse, error)
+	GetDepositAddress(context.Context, *GetDepositAddressRequest) (*GetDepositAddressResponse, error)
+	WithdrawCryptocurrencyFunds(context.Context, *WithdrawCryptoRequest) (*WithdrawResponse, error)
+	WithdrawFiatFunds(context.Context, *WithdrawFiatRequest) (*WithdrawResponse, error)
+	GetEvents(context.Context, *GetEventsRequest) (*GetEventsResponse, error)
+	AddEvent(context.Context, *Event) (*AddEventResponse, error)
+	RemoveEvent(context.Context, *GenericEventRequest) (*GenericResponse, error)
+	RearmEvent(context.Context, *GenericEventRequest) (*GenericResponse, error)
+	GetPortfolio(context.Context, *GetPortfolioRequest) (*GetPortfolioResponse, error)
+	GetPortfolioSummary(context.Context, *GetPortfolioSummaryRequest) (*GetPortfolioSummaryResponse, error)
+	AddPortfolioAddress(context.Context, *AddPortfolioAddressRequest) (*GenericResponse, error)
+	RemovePortfolioAddress(context.Context, *RemovePortfolioAddressRequest) (*GenericResponse, error)
+}
+
+func RegisterGoCryptoTraderServer(s *grpc.Server, srv GoCryptoTraderServer) {
+	s.RegisterService(&_GoCryptoTrader_serviceDesc, srv)
+}
+
+func _GoCryptoTrader_GetInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetInfo(ctx, req.(*GetInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_GetSubsystems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSubsystemsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetSubsystems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetSubsystems",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetSubsystems(ctx, req.(*GetSubsystemsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_SetSubsystem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetSubsystemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).SetSubsystem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/SetSubsystem",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).SetSubsystem(ctx, req.(*SetSubsystemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_ReloadConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReloadConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).ReloadConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/ReloadConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).ReloadConfig(ctx, req.(*ReloadConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_GetExchanges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetExchangesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetExchanges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetExchanges",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetExchanges(ctx, req.(*GetExchangesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_GetExchangeInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenericExchangeNameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetExchangeInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetExchangeInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetExchangeInfo(ctx, req.(*GenericExchangeNameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_EnableExchange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenericExchangeNameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).EnableExchange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/EnableExchange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).EnableExchange(ctx, req.(*GenericExchangeNameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_DisableExchange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenericExchangeNameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).DisableExchange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/DisableExchange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).DisableExchange(ctx, req.(*GenericExchangeNameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_GetTicker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTickerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetTicker(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetTicker",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetTicker(ctx, req.(*GetTickerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_GetTickers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTickersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetTickers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetTickers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetTickers(ctx, req.(*GetTickersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_GetOrderbook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrderbookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetOrderbook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetOrderbook",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetOrderbook(ctx, req.(*GetOrderbookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_GetOrderbooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrderbooksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetOrderbooks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetOrderbooks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetOrderbooks(ctx, req.(*GetOrderbooksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_GetSyncStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSyncStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetSyncStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetSyncStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetSyncStatus(ctx, req.(*GetSyncStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_GetAccountInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAccountInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetAccountInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetAccountInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetAccountInfo(ctx, req.(*GetAccountInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_GetOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrdersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetOrders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetOrders(ctx, req.(*GetOrdersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_GetOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetOrder(ctx, req.(*GetOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_SubmitOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).SubmitOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/SubmitOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).SubmitOrder(ctx, req.(*SubmitOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_CancelOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).CancelOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/CancelOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).CancelOrder(ctx, req.(*CancelOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_CancelAllOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelAllOrdersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).CancelAllOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/CancelAllOrders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).CancelAllOrders(ctx, req.(*CancelAllOrdersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_GetDepositAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDepositAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetDepositAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetDepositAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetDepositAddress(ctx, req.(*GetDepositAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_WithdrawCryptocurrencyFunds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WithdrawCryptoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).WithdrawCryptocurrencyFunds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/WithdrawCryptocurrencyFunds",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).WithdrawCryptocurrencyFunds(ctx, req.(*WithdrawCryptoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_WithdrawFiatFunds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WithdrawFiatRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).WithdrawFiatFunds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/WithdrawFiatFunds",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).WithdrawFiatFunds(ctx, req.(*WithdrawFiatRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_GetEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetEvents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetEvents(ctx, req.(*GetEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_AddEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Event)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).AddEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/AddEvent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).AddEvent(ctx, req.(*Event))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_RemoveEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenericEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).RemoveEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/RemoveEvent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).RemoveEvent(ctx, req.(*GenericEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_RearmEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenericEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).RearmEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/RearmEvent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).RearmEvent(ctx, req.(*GenericEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_GetPortfolio_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPortfolioRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetPortfolio(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetPortfolio",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetPortfolio(ctx, req.(*GetPortfolioRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_GetPortfolioSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPortfolioSummaryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetPortfolioSummary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetPortfolioSummary",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetPortfolioSummary(ctx, req.(*GetPortfolioSummaryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_AddPortfolioAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddPortfolioAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).AddPortfolioAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/AddPortfolioAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).AddPortfolioAddress(ctx, req.(*AddPortfolioAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_RemovePortfolioAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemovePortfolioAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).RemovePortfolioAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/RemovePortfolioAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).RemovePortfolioAddress(ctx, req.(*RemovePortfolioAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _GoCryptoTrader_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "gctrpc.GoCryptoTrader",
+	HandlerType: (*GoCryptoTraderServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetInfo",
+			Handler:    _GoCryptoTrader_GetInfo_Handler,
+		},
+		{
+			MethodName: "GetSubsystems",
+			Handler:    _GoCryptoTrader_GetSubsystems_Handler,
+		},
+		{
+			MethodName: "SetSubsystem",
+			Handler:    _GoCryptoTrader_SetSubsystem_Handler,
+		},
+		{
+			MethodName: "ReloadConfig",
+			Handler:    _GoCryptoTrader_ReloadConfig_Handler,
+		},
+		{
+			MethodName: "GetExchanges",
+			Handler:    _GoCryptoTrader_GetExchanges_Handler,
+		},
+		{
+			MethodName: "GetExchangeInfo",
+			Handler:    _GoCryptoTrader_GetExchangeInfo_Handler,
+		},
+		{
+			MethodName: "EnableExchange",
+			Handler:    _GoCryptoTrader_EnableExchange_Handler,
+		},
+		{
+			MethodName: "DisableExchange",
+			Handler:    _GoCryptoTrader_DisableExchange_Handler,
+		},
+		{
+			MethodName: "GetTicker",
+			Handler:    _GoCryptoTrader_GetTicker_Handler,
+		},
+		{
+			MethodName: "GetTickers",
+			Handler:    _GoCryptoTrader_GetTickers_Handler,
+		},
+		{
+			MethodName: "GetOrderbook",
+			Handler:    _GoCryptoTrader_GetOrderbook_Handler,
+		},
+		{
+			MethodName: "GetOrderbooks",
+			Handler:    _GoCryptoTrader_GetOrderbooks_Handler,
+		},
+		{
+			MethodName: "GetSyncStatus",
+			Handler:    _GoCryptoTrader_GetSyncStatus_Handler,
+		},
+		{
+			MethodName: "GetAccountInfo",
+			Handler:    _GoCryptoTrader_GetAccountInfo_Handler,
+		},
+		{
+			MethodName: "GetOrders",
+			Handler:    _GoCryptoTrader_GetOrders_Handler,
+		},
+		{
+			MethodName: "GetOrder",
+			Handler:    _GoCryptoTrader_GetOrder_Handler,
+		},
+		{
+			MethodName: "SubmitOrder",
+			Handler:    _GoCryptoTrader_SubmitOrder_Handler,
+		},
+		{
+			MethodName: "CancelOrder",
+			Handler:    _GoCryptoTrader_CancelOrder_Handler,
+		},
+		{
+			MethodName: "CancelAllOrders",
+			Handler:    _GoCryptoTrader_CancelAllOrders_Handler,
+		},
+		{
+			MethodName: "GetDepositAddress",
+			Handler:    _GoCryptoTrader_GetDepositAddress_Handler,
+		},
+		{
+			MethodName: "WithdrawCryptocurrencyFunds",
+			Handler:    _GoCryptoTrader_WithdrawCryptocurrencyFunds_Handler,
+		},
+		{
+			MethodName: "WithdrawFiatFunds",
+			Handler:    _GoCryptoTrader_WithdrawFiatFunds_Handler,
+		},
+		{
+			MethodName: "GetEvents",
+			Handler:    _GoCryptoTrader_GetEvents_Handler,
+		},
+		{
+			MethodName: "AddEvent",
+			Handler:    _GoCryptoTrader_AddEvent_Handler,
+		},
+		{
+			MethodName: "RemoveEvent",
+			Handler:    _GoCryptoTrader_RemoveEvent_Handler,
+		},
+		{
+			MethodName: "RearmEvent",
+			Handler:    _GoCryptoTrader_RearmEvent_Handler,
+		},
+		{
+			MethodName: "GetPortfolio",
+			Handler:    _GoCryptoTrader_GetPortfolio_Handler,
+		},
+		{
+			MethodName: "GetPortfolioSummary",
+			Handler:    _GoCryptoTrader_GetPortfolioSummary_Handler,
+		},
+		{
+			MethodName: "AddPortfolioAddress",
+			Handler:    _GoCryptoTrader_AddPortfolioAddress_Handler,
+		},
+		{
+			MethodName: "RemovePortfolioAddress",
+			Handler:    _GoCryptoTrader_RemovePortfolioAddress_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "rpc.proto",
+}
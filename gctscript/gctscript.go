@@ -0,0 +1,437 @@
+package gctscript
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/d5/tengo/v2"
+	"github.com/d5/tengo/v2/stdlib"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+	log "github.com/thrasher-corp/gocryptotrader/logger"
+)
+
+// NewManager returns a script manager which loads scripts from the directory
+func NewManager(dir string) *Manager {
+	return &Manager{
+		Timeout:    defaultTimeout,
+		MaxScripts: defaultMaxScripts,
+		dir:        dir,
+		scripts:    make(map[string]*script),
+	}
+}
+
+// Dir returns the directory scripts are loaded from
+func (m *Manager) Dir() string {
+	return m.dir
+}
+
+// Available returns the names of the scripts in the script directory
+func (m *Manager) Available() ([]string, error) {
+	files, err := ioutil.ReadDir(m.dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return []string{}, nil
+		}
+		return nil, err
+	}
+
+	names := []string{}
+	for x := range files {
+		if !files[x].IsDir() && filepath.Ext(files[x].Name()) == Extension {
+			names = append(names, files[x].Name())
+		}
+	}
+	return names, nil
+}
+
+// Start compiles the named script from the script directory and schedules it
+// to run with the settings
+func (m *Manager) Start(name string, settings Settings) (Status, error) {
+	if m.Wrapper == nil {
+		return Status{}, errNoWrapper
+	}
+
+	name, err := scriptName(name)
+	if err != nil {
+		return Status{}, err
+	}
+
+	err = settings.validate()
+	if err != nil {
+		return Status{}, err
+	}
+
+	src, err := ioutil.ReadFile(filepath.Join(m.dir, name))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return Status{}, ErrScriptNotFound
+		}
+		return Status{}, err
+	}
+
+	m.m.Lock()
+	defer m.m.Unlock()
+
+	if s, ok := m.scripts[name]; ok && s.isRunning() {
+		return Status{}, ErrScriptAlreadyRunning
+	}
+
+	if m.running() >= m.MaxScripts {
+		return Status{}, ErrMaxScripts
+	}
+
+	s := &script{
+		status: Status{
+			Name:     name,
+			Settings: settings,
+			Running:  true,
+			Started:  time.Now(),
+		},
+		timeout: m.Timeout,
+		events:  make(chan tengo.Object, 1),
+		done:    make(chan struct{}),
+	}
+
+	s.compiled, err = m.compile(s, src)
+	if err != nil {
+		return Status{}, err
+	}
+
+	if settings.Pair != "" {
+		s.pair = currency.NewPairFromString(settings.Pair)
+	}
+	s.ctx, s.cancel = context.WithCancel(context.Background())
+
+	m.scripts[name] = s
+	go m.run(s)
+	log.Debugf("Script %s started.\n", name)
+	return s.getStatus(), nil
+}
+
+// Stop stops the named script, aborting any run in progress
+func (m *Manager) Stop(name string) error {
+	name, err := scriptName(name)
+	if err != nil {
+		return err
+	}
+
+	m.m.Lock()
+	s, ok := m.scripts[name]
+	delete(m.scripts, name)
+	m.m.Unlock()
+
+	if !ok {
+		return ErrScriptNotRunning
+	}
+
+	s.stop()
+	log.Debugf("Script %s stopped.\n", name)
+	return nil
+}
+
+// StopAll stops all scripts, aborting any runs in progress
+func (m *Manager) StopAll() {
+	m.m.Lock()
+	scripts := m.scripts
+	m.scripts = make(map[string]*script)
+	m.m.Unlock()
+
+	for _, s := range scripts {
+		s.stop()
+	}
+}
+
+// List returns the status of each started script ordered by name. Scripts
+// which run once remain listed after finishing until they are stopped
+func (m *Manager) List() []Status {
+	m.m.Lock()
+	defer m.m.Unlock()
+
+	statuses := []Status{}
+	for _, s := range m.scripts {
+		statuses = append(statuses, s.getStatus())
+	}
+
+	sort.Slice(statuses, func(i, j int) bool {
+		return statuses[i].Name < statuses[j].Name
+	})
+	return statuses
+}
+
+// OnTicker runs the scripts triggered by ticker updates which match the
+// update
+func (m *Manager) OnTicker(exchName string, assetType asset.Item, t *ticker.Price) {
+	m.dispatch(EventTicker, exchName, t.Pair, assetType, func() tengo.Object {
+		return tickerObject(exchName, assetType, t)
+	})
+}
+
+// OnOrderbook runs the scripts triggered by orderbook updates which match the
+// update
+func (m *Manager) OnOrderbook(ob *orderbook.Base) {
+	m.dispatch(EventOrderbook, ob.ExchangeName, ob.Pair, ob.AssetType,
+		func() tengo.Object {
+			return orderbookObject(ob)
+		})
+}
+
+// Subscribed returns whether any running script is triggered by the event
+// for the exchange, pair and asset
+func (m *Manager) Subscribed(event, exchName string, p currency.Pair, assetType asset.Item) bool {
+	m.m.Lock()
+	defer m.m.Unlock()
+	for _, s := range m.scripts {
+		if s.triggeredBy(event, exchName, p, assetType) {
+			return true
+		}
+	}
+	return false
+}
+
+// dispatch queues the event for each running script it triggers, the event
+// object is only built if a script is triggered
+func (m *Manager) dispatch(event, exchName string, p currency.Pair, assetType asset.Item, object func() tengo.Object) {
+	var triggered []*script
+	m.m.Lock()
+	for _, s := range m.scripts {
+		if s.triggeredBy(event, exchName, p, assetType) {
+			triggered = append(triggered, s)
+		}
+	}
+	m.m.Unlock()
+
+	if len(triggered) == 0 {
+		return
+	}
+
+	o := object()
+	for x := range triggered {
+		triggered[x].send(o.Copy())
+	}
+}
+
+// running returns the number of running scripts
+func (m *Manager) running() int {
+	var count int
+	for _, s := range m.scripts {
+		if s.isRunning() {
+			count++
+		}
+	}
+	return count
+}
+
+// compile compiles the script source with the sandboxed standard library
+// modules and the gct module
+func (m *Manager) compile(sc *script, src []byte) (*tengo.Compiled, error) {
+	name := sc.status.Name
+	imports := stdlib.GetModuleMap(modules...)
+	imports.AddBuiltinModule("times", timesModule(sc))
+	imports.AddBuiltinModule(ModuleName, m.module(sc))
+
+	s := tengo.NewScript(src)
+	s.SetImports(imports)
+	s.SetMaxAllocs(maxAllocs)
+	err := s.Add("event", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	compiled, err := s.Compile()
+	if err != nil {
+		return nil, fmt.Errorf("script %s failed to compile: %s", name, err)
+	}
+	return compiled, nil
+}
+
+// run runs the script on its schedule until it is stopped, scripts without
+// an interval or event are run once
+func (m *Manager) run(s *script) {
+	defer close(s.done)
+
+	settings := s.status.Settings
+	if settings.Interval == 0 && settings.Event == "" {
+		s.execute(nil)
+		s.setRunning(false)
+		return
+	}
+
+	var tick <-chan time.Time
+	if settings.Interval > 0 {
+		t := time.NewTicker(settings.Interval)
+		defer t.Stop()
+		tick = t.C
+	}
+
+	for {
+		select {
+		case <-s.ctx.Done():
+			return
+		case <-tick:
+			s.execute(nil)
+		case event := <-s.events:
+			s.execute(event)
+		}
+	}
+}
+
+// execute runs the script once with the event, recording the outcome. Run
+// errors, panics and timeouts are logged and recorded against the script
+func (s *script) execute(event tengo.Object) {
+	if event == nil {
+		event = tengo.UndefinedValue
+	}
+
+	err := func() (err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				err = fmt.Errorf("panic: %v", r)
+			}
+		}()
+
+		err = s.compiled.Set("event", event)
+		if err != nil {
+			return err
+		}
+
+		ctx, cancel := context.WithTimeout(s.ctx, s.timeout)
+		defer cancel()
+		s.setRunContext(ctx)
+		err = s.compiled.RunContext(ctx)
+		if err != nil && ctx.Err() == context.DeadlineExceeded {
+			err = fmt.Errorf("run exceeded timeout of %v", s.timeout)
+		}
+		return err
+	}()
+
+	s.m.Lock()
+	defer s.m.Unlock()
+	s.status.Runs++
+	s.status.LastRun = time.Now()
+	if err == nil || s.ctx.Err() != nil {
+		return
+	}
+
+	s.status.Errors++
+	s.status.LastError = err.Error()
+	log.Errorf("Script %s error: %s\n", s.status.Name, err)
+}
+
+// send queues the event to be run, replacing any queued event which has not
+// been run yet so a slow script runs on the latest update
+func (s *script) send(event tengo.Object) {
+	select {
+	case s.events <- event:
+		return
+	default:
+	}
+
+	select {
+	case <-s.events:
+	default:
+	}
+
+	select {
+	case s.events <- event:
+	default:
+	}
+}
+
+// triggeredBy returns whether or not the event triggers the script
+func (s *script) triggeredBy(event, exchName string, p currency.Pair, assetType asset.Item) bool {
+	settings := s.status.Settings
+	return s.isRunning() &&
+		settings.Event == event &&
+		(settings.Exchange == "" || strings.EqualFold(settings.Exchange, exchName)) &&
+		(settings.Pair == "" || s.pair.Equal(p)) &&
+		(settings.AssetType == "" || settings.AssetType == assetType.String())
+}
+
+// stop stops the script and waits for its routine to exit, a routine which
+// has not exited by the stop timeout is left to exit on its own
+func (s *script) stop() {
+	s.cancel()
+	t := time.NewTimer(stopTimeout)
+	defer t.Stop()
+	select {
+	case <-s.done:
+	case <-t.C:
+		log.Warnf("Script %s did not stop within %v.\n", s.status.Name,
+			stopTimeout)
+	}
+	s.setRunning(false)
+}
+
+// runContext returns the context of the run in progress
+func (s *script) runContext() context.Context {
+	s.m.Lock()
+	defer s.m.Unlock()
+	if s.runCtx == nil {
+		return s.ctx
+	}
+	return s.runCtx
+}
+
+func (s *script) setRunContext(ctx context.Context) {
+	s.m.Lock()
+	s.runCtx = ctx
+	s.m.Unlock()
+}
+
+func (s *script) isRunning() bool {
+	s.m.Lock()
+	defer s.m.Unlock()
+	return s.status.Running
+}
+
+func (s *script) setRunning(running bool) {
+	s.m.Lock()
+	s.status.Running = running
+	s.m.Unlock()
+}
+
+func (s *script) getStatus() Status {
+	s.m.Lock()
+	defer s.m.Unlock()
+	return s.status
+}
+
+// validate checks the settings, upper casing the event and asset type
+func (s *Settings) validate() error {
+	s.Event = strings.ToUpper(s.Event)
+	if s.Interval < 0 || (s.Interval > 0 && s.Interval < MinInterval) ||
+		(s.Event != "" && s.Event != EventTicker && s.Event != EventOrderbook) {
+		return ErrInvalidSettings
+	}
+
+	if s.AssetType != "" {
+		a, err := asset.New(s.AssetType)
+		if err != nil {
+			return ErrInvalidSettings
+		}
+		s.AssetType = a.String()
+	}
+	return nil
+}
+
+// scriptName validates a script name, appending the script extension if it
+// is not set. Names must refer to a file in the script directory
+func scriptName(name string) (string, error) {
+	if name == "" || name != filepath.Base(name) || strings.HasPrefix(name, ".") {
+		return "", ErrInvalidName
+	}
+
+	if filepath.Ext(name) != Extension {
+		name += Extension
+	}
+	return name, nil
+}
@@ -0,0 +1,365 @@
+package gctscript
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+)
+
+const testExchange = "Bitstamp"
+
+// testWrapper serves fixed market data and records submitted orders
+type testWrapper struct {
+	orders    []string
+	cancelled []string
+	m         sync.Mutex
+}
+
+func (w *testWrapper) Exchanges() []string { return []string{testExchange} }
+
+func (w *testWrapper) Ticker(_ context.Context, exchName string, p currency.Pair, _ asset.Item) (ticker.Price, error) {
+	if exchName != testExchange {
+		return ticker.Price{}, errors.New("exchange not found")
+	}
+	return ticker.Price{Pair: p, Last: 100, Bid: 99, Ask: 101}, nil
+}
+
+func (w *testWrapper) Orderbook(_ context.Context, _ string, p currency.Pair, _ asset.Item) (orderbook.Base, error) {
+	return orderbook.Base{
+		Pair: p,
+		Bids: []orderbook.Item{{Price: 99, Amount: 1}},
+		Asks: []orderbook.Item{{Price: 101, Amount: 2}},
+	}, nil
+}
+
+func (w *testWrapper) Candles(_ context.Context, _ string, _ currency.Pair, _ asset.Item, start, _ time.Time, _ kline.Interval) (kline.Item, error) {
+	return kline.Item{Candles: []kline.Candle{{Time: start, Close: 5}}}, nil
+}
+
+func (w *testWrapper) AccountInfo(_ context.Context, exchName string) (exchange.AccountInfo, error) {
+	return exchange.AccountInfo{
+		Exchange: exchName,
+		Accounts: []exchange.Account{{Currencies: []exchange.AccountCurrencyInfo{
+			{CurrencyName: currency.USD, TotalValue: 1000},
+		}}},
+	}, nil
+}
+
+func (w *testWrapper) ActiveOrders(_ context.Context, _ string, _ currency.Pair) ([]exchange.OrderDetail, error) {
+	return []exchange.OrderDetail{{ID: "1"}}, nil
+}
+
+func (w *testWrapper) SubmitOrder(_ context.Context, exchName string, p currency.Pair, side exchange.OrderSide, orderType exchange.OrderType, amount, price float64, _ string) (string, error) {
+	w.m.Lock()
+	defer w.m.Unlock()
+	w.orders = append(w.orders, strings.Join([]string{exchName, p.String(),
+		string(side), string(orderType)}, " "))
+	return "1337", nil
+}
+
+func (w *testWrapper) CancelOrder(_ context.Context, _, orderID string) error {
+	w.m.Lock()
+	defer w.m.Unlock()
+	w.cancelled = append(w.cancelled, orderID)
+	return nil
+}
+
+func (w *testWrapper) getOrders() []string {
+	w.m.Lock()
+	defer w.m.Unlock()
+	return append([]string(nil), w.orders...)
+}
+
+func setupTest(t *testing.T, scripts map[string]string) (*Manager, *testWrapper, func()) {
+	dir, err := ioutil.TempDir("", "gctscript")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for name, src := range scripts {
+		err = ioutil.WriteFile(filepath.Join(dir, name), []byte(src), 0600)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	w := new(testWrapper)
+	m := NewManager(dir)
+	m.Wrapper = w
+	return m, w, func() {
+		m.StopAll()
+		os.RemoveAll(dir)
+	}
+}
+
+// waitForRuns waits for the script to have run at least the number of times
+func waitForRuns(t *testing.T, m *Manager, name string, runs int64) Status {
+	for x := 0; x < 200; x++ {
+		statuses := m.List()
+		for y := range statuses {
+			if statuses[y].Name == name && statuses[y].Runs >= runs {
+				return statuses[y]
+			}
+		}
+		time.Sleep(time.Millisecond * 10)
+	}
+	t.Fatalf("Test failed. Script %s did not run %d times", name, runs)
+	return Status{}
+}
+
+func TestRunOnce(t *testing.T) {
+	m, w, cleanup := setupTest(t, map[string]string{"buy.gct": `
+gct := import("gct")
+t := gct.ticker("Bitstamp", "BTC-USD")
+ob := gct.orderbook("Bitstamp", "BTC-USD")
+c := gct.candles("Bitstamp", "BTC-USD", "1h", 0, 3600)
+a := gct.account("Bitstamp")
+o := gct.orders("Bitstamp", "BTC-USD")
+if t.last == 100.0 && ob.asks[0].amount == 2.0 && c[0].close == 5.0 &&
+	a.accounts[0].currencies[0].total == 1000.0 && len(o) == 1 &&
+	len(gct.exchanges()) == 1 {
+	id := gct.submit_order("Bitstamp", "BTC-USD", "buy", "limit", 1, t.bid)
+	gct.cancel_order("Bitstamp", id)
+}
+if is_error(gct.ticker("rawr", "BTC-USD")) {
+	gct.submit_order("Bitstamp", "BTC-USD", "sell", "market", 1)
+}
+`})
+	defer cleanup()
+
+	available, err := m.Available()
+	if err != nil || len(available) != 1 || available[0] != "buy.gct" {
+		t.Errorf("Test failed. Unexpected available scripts %v %v", available,
+			err)
+	}
+
+	_, err = m.Start("buy", Settings{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	status := waitForRuns(t, m, "buy.gct", 1)
+	if status.Errors != 0 {
+		t.Fatalf("Test failed. Unexpected script error %s", status.LastError)
+	}
+
+	orders := w.getOrders()
+	if len(orders) != 2 || orders[0] != "Bitstamp BTC-USD BUY LIMIT" ||
+		orders[1] != "Bitstamp BTC-USD SELL MARKET" ||
+		len(w.cancelled) != 1 || w.cancelled[0] != "1337" {
+		t.Errorf("Test failed. Unexpected orders %v cancelled %v", orders,
+			w.cancelled)
+	}
+
+	// Scripts without a schedule stop after running once
+	for x := 0; x < 100 && m.List()[0].Running; x++ {
+		time.Sleep(time.Millisecond * 10)
+	}
+
+	if m.List()[0].Running {
+		t.Error("Test failed. Expected script to have stopped")
+	}
+
+	err = m.Stop("buy")
+	if err != nil {
+		t.Errorf("Test failed. Stop error: %s", err)
+	}
+
+	if len(m.List()) != 0 {
+		t.Error("Test failed. Expected stopped script to be removed")
+	}
+}
+
+func TestScriptErrors(t *testing.T) {
+	m, _, cleanup := setupTest(t, map[string]string{
+		"loop.gct":    "for {}",
+		"sleep.gct":   `times := import("times"); times.sleep(times.hour)`,
+		"invalid.gct": `gct := import("gct"); gct.ticker(1, "BTC-USD")`,
+		"os.gct":      `os := import("os")`,
+		"syntax.gct":  `a := `,
+	})
+	defer cleanup()
+	m.Timeout = time.Millisecond * 50
+
+	for _, name := range []string{"os", "syntax"} {
+		if _, err := m.Start(name, Settings{}); err == nil {
+			t.Errorf("Test failed. Expected %s script to fail to compile", name)
+		}
+	}
+
+	for _, name := range []string{"loop", "sleep"} {
+		if _, err := m.Start(name, Settings{}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	_, err := m.Start("invalid", Settings{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, name := range []string{"loop.gct", "sleep.gct"} {
+		status := waitForRuns(t, m, name, 1)
+		if status.Errors != 1 || !strings.Contains(status.LastError, "timeout") {
+			t.Errorf("Test failed. Expected timeout error, received %+v", status)
+		}
+	}
+
+	status := waitForRuns(t, m, "invalid.gct", 1)
+	if status.Errors != 1 ||
+		!strings.Contains(status.LastError, "invalid type for argument") {
+		t.Errorf("Test failed. Expected argument error, received %+v", status)
+	}
+}
+
+func TestEvents(t *testing.T) {
+	m, w, cleanup := setupTest(t, map[string]string{"event.gct": `
+gct := import("gct")
+if event.type == "TICKER" {
+	gct.submit_order(event.exchange, event.pair, "buy", "market", event.last)
+} else {
+	gct.submit_order(event.exchange, event.pair, "sell", "market", event.bids[0].amount)
+}
+`})
+	defer cleanup()
+
+	_, err := m.Start("event.gct", Settings{
+		Event:    "ticker",
+		Exchange: "bitstamp",
+		Pair:     "BTC-USD",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// None of these updates match the event, exchange and pair filters
+	m.OnTicker("Kraken", asset.Spot, &ticker.Price{
+		Pair: currency.NewPairFromString("BTC-USD"),
+	})
+	m.OnTicker(testExchange, asset.Spot, &ticker.Price{
+		Pair: currency.NewPairFromString("LTC-USD"),
+	})
+	m.OnOrderbook(&orderbook.Base{ExchangeName: testExchange,
+		Pair: currency.NewPairFromString("BTC-USD")})
+
+	m.OnTicker(testExchange, asset.Spot, &ticker.Price{
+		Pair: currency.NewPairFromString("BTC-USD"),
+		Last: 5,
+	})
+
+	status := waitForRuns(t, m, "event.gct", 1)
+	if status.Runs != 1 || status.Errors != 0 {
+		t.Errorf("Test failed. Unexpected status %+v", status)
+	}
+
+	orders := w.getOrders()
+	if len(orders) != 1 || orders[0] != "Bitstamp BTC-USD BUY MARKET" {
+		t.Errorf("Test failed. Unexpected orders %v", orders)
+	}
+}
+
+func TestDryRun(t *testing.T) {
+	m, w, cleanup := setupTest(t, map[string]string{"dry.gct": `
+gct := import("gct")
+if gct.submit_order("Bitstamp", "BTC-USD", "buy", "market", 1) != undefined {
+	gct.ticker(1, 2)
+}
+gct.cancel_order("Bitstamp", "1")
+`})
+	defer cleanup()
+	m.DryRun = true
+
+	_, err := m.Start("dry", Settings{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	status := waitForRuns(t, m, "dry.gct", 1)
+	if status.Errors != 0 || len(w.getOrders()) != 0 || len(w.cancelled) != 0 {
+		t.Errorf("Test failed. Expected no orders in dry run, received %+v",
+			status)
+	}
+}
+
+func TestStartStop(t *testing.T) {
+	m, _, cleanup := setupTest(t, map[string]string{
+		"a.gct": "a := 1",
+		"b.gct": "b := 1",
+	})
+	defer cleanup()
+	m.MaxScripts = 1
+
+	for _, name := range []string{"", "../a", "/a.gct", ".gct"} {
+		if _, err := m.Start(name, Settings{}); err != ErrInvalidName {
+			t.Errorf("Test failed. Expected %s for %q, received %v",
+				ErrInvalidName, name, err)
+		}
+	}
+
+	invalid := []Settings{
+		{Interval: time.Millisecond},
+		{Interval: -time.Second},
+		{Event: "TRADES"},
+	}
+	for x := range invalid {
+		if _, err := m.Start("a", invalid[x]); err != ErrInvalidSettings {
+			t.Errorf("Test failed. Expected %s for %+v, received %v",
+				ErrInvalidSettings, invalid[x], err)
+		}
+	}
+
+	_, err := m.Start("c", Settings{})
+	if err != ErrScriptNotFound {
+		t.Errorf("Test failed. Expected %s, received %v", ErrScriptNotFound, err)
+	}
+
+	status, err := m.Start("a", Settings{Interval: time.Hour})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !status.Running || status.Name != "a.gct" {
+		t.Errorf("Test failed. Unexpected status %+v", status)
+	}
+
+	_, err = m.Start("a", Settings{Interval: time.Hour})
+	if err != ErrScriptAlreadyRunning {
+		t.Errorf("Test failed. Expected %s, received %v",
+			ErrScriptAlreadyRunning, err)
+	}
+
+	_, err = m.Start("b", Settings{})
+	if err != ErrMaxScripts {
+		t.Errorf("Test failed. Expected %s, received %v", ErrMaxScripts, err)
+	}
+
+	err = m.Stop("a")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = m.Stop("a")
+	if err != ErrScriptNotRunning {
+		t.Errorf("Test failed. Expected %s, received %v", ErrScriptNotRunning,
+			err)
+	}
+
+	m.Wrapper = nil
+	_, err = m.Start("b", Settings{})
+	if err != errNoWrapper {
+		t.Errorf("Test failed. Expected %s, received %v", errNoWrapper, err)
+	}
+}
@@ -0,0 +1,130 @@
+package gctscript
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/d5/tengo/v2"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+)
+
+// Events which can trigger a script run
+const (
+	EventTicker    = "TICKER"
+	EventOrderbook = "ORDERBOOK"
+)
+
+const (
+	// Extension is the file extension of scripts, it is appended to script
+	// names which do not have it
+	Extension = ".gct"
+	// ModuleName is the name scripts import the market data and trading API
+	// as
+	ModuleName = "gct"
+	// MinInterval is the shortest interval a script can be run on
+	MinInterval = time.Second
+
+	defaultTimeout    = time.Second * 30
+	defaultMaxScripts = 10
+	// stopTimeout is how long stopping a script waits for its routine to
+	// exit, a wrapper call which ignores its context is abandoned after it
+	stopTimeout = time.Second * 5
+	// maxAllocs is the maximum number of objects a single script run can
+	// allocate
+	maxAllocs = 1000000
+)
+
+// Error declarations
+var (
+	ErrScriptNotFound       = errors.New("script not found")
+	ErrScriptAlreadyRunning = errors.New("script is already running")
+	ErrScriptNotRunning     = errors.New("script is not running")
+	ErrMaxScripts           = errors.New("maximum number of running scripts reached")
+	ErrInvalidName          = errors.New("invalid script name")
+	ErrInvalidSettings      = errors.New("invalid script settings, interval must be at least one second and event must be TICKER or ORDERBOOK")
+	errNoWrapper            = errors.New("no script wrapper has been set")
+)
+
+// modules are the standard library modules scripts can import, os is left
+// out so scripts cannot touch the file system or run commands
+var modules = []string{"math", "text", "times", "rand", "fmt", "json",
+	"base64", "hex", "enum"}
+
+// Wrapper is the market data, account and order API exposed to scripts, it
+// is implemented by the engine over its loaded exchanges. The context is
+// done once the script run is stopped or times out, implementations must
+// return promptly when it is
+type Wrapper interface {
+	Exchanges() []string
+	Ticker(ctx context.Context, exchName string, p currency.Pair, assetType asset.Item) (ticker.Price, error)
+	Orderbook(ctx context.Context, exchName string, p currency.Pair, assetType asset.Item) (orderbook.Base, error)
+	Candles(ctx context.Context, exchName string, p currency.Pair, assetType asset.Item, start, end time.Time, interval kline.Interval) (kline.Item, error)
+	AccountInfo(ctx context.Context, exchName string) (exchange.AccountInfo, error)
+	ActiveOrders(ctx context.Context, exchName string, p currency.Pair) ([]exchange.OrderDetail, error)
+	SubmitOrder(ctx context.Context, exchName string, p currency.Pair, side exchange.OrderSide, orderType exchange.OrderType, amount, price float64, clientID string) (string, error)
+	CancelOrder(ctx context.Context, exchName, orderID string) error
+}
+
+// Settings determine when a script is run. A script runs every interval, on
+// each ticker or orderbook update matching the exchange, pair and asset type
+// filters, or once if neither is set
+type Settings struct {
+	Interval  time.Duration `json:"interval,omitempty"`
+	Event     string        `json:"event,omitempty"`
+	Exchange  string        `json:"exchange,omitempty"`
+	Pair      string        `json:"pair,omitempty"`
+	AssetType string        `json:"assetType,omitempty"`
+}
+
+// Status is the state of a started script
+type Status struct {
+	Name      string    `json:"name"`
+	Settings  Settings  `json:"settings"`
+	Running   bool      `json:"running"`
+	Started   time.Time `json:"started"`
+	LastRun   time.Time `json:"lastRun,omitempty"`
+	Runs      int64     `json:"runs"`
+	Errors    int64     `json:"errors"`
+	LastError string    `json:"lastError,omitempty"`
+}
+
+// Manager loads, schedules and runs scripts from a directory. Each script
+// runs in its own routine and each run is bounded by the timeout, so a
+// failing or slow script cannot affect other scripts or the caller
+type Manager struct {
+	// Wrapper is the API scripts use to access exchanges
+	Wrapper Wrapper
+	// Timeout is the maximum duration of a single script run
+	Timeout time.Duration
+	// MaxScripts is the maximum number of scripts which can run at once
+	MaxScripts int
+	// DryRun logs order functions instead of executing them
+	DryRun bool
+
+	dir     string
+	scripts map[string]*script
+	m       sync.Mutex
+}
+
+// script is a compiled script and its schedule
+type script struct {
+	status   Status
+	pair     currency.Pair
+	compiled *tengo.Compiled
+	timeout  time.Duration
+	events   chan tengo.Object
+	ctx      context.Context
+	cancel   context.CancelFunc
+	done     chan struct{}
+	m        sync.Mutex
+	// runCtx is the context of the run in progress, passed to the wrapper
+	// by the gct module functions
+	runCtx context.Context
+}
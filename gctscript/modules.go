@@ -0,0 +1,470 @@
+package gctscript
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/d5/tengo/v2"
+	"github.com/d5/tengo/v2/stdlib"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+	log "github.com/thrasher-corp/gocryptotrader/logger"
+)
+
+// module returns the gct module functions for the script. Invalid arguments
+// stop the script with an error while wrapper errors are returned to the
+// script as error values. Functions are passed the context of the run in
+// progress and stop the script once it is done
+func (m *Manager) module(s *script) map[string]tengo.Object {
+	name := s.status.Name
+	functions := map[string]func(ctx context.Context, args ...tengo.Object) (tengo.Object, error){
+		"exchanges": m.exchanges,
+		"ticker":    m.ticker,
+		"orderbook": m.orderbook,
+		"candles":   m.candles,
+		"account":   m.account,
+		"orders":    m.orders,
+		"submit_order": func(ctx context.Context, args ...tengo.Object) (tengo.Object, error) {
+			return m.submitOrder(ctx, name, args...)
+		},
+		"cancel_order": func(ctx context.Context, args ...tengo.Object) (tengo.Object, error) {
+			return m.cancelOrder(ctx, name, args...)
+		},
+		"log": func(_ context.Context, args ...tengo.Object) (tengo.Object, error) {
+			text := make([]string, len(args))
+			for x := range args {
+				text[x], _ = tengo.ToString(args[x])
+			}
+			log.Infof("Script %s: %s\n", name, strings.Join(text, " "))
+			return tengo.UndefinedValue, nil
+		},
+	}
+
+	attributes := make(map[string]tengo.Object)
+	for n, f := range functions {
+		attributes[n] = &tengo.UserFunction{Name: n, Value: s.withRunContext(f)}
+	}
+	return attributes
+}
+
+// timesModule returns the standard library times module with sleep replaced
+// by one which returns once the run of the script is stopped
+func timesModule(s *script) map[string]tengo.Object {
+	attributes := make(map[string]tengo.Object)
+	for n, o := range stdlib.BuiltinModules["times"] {
+		attributes[n] = o
+	}
+	attributes["sleep"] = &tengo.UserFunction{Name: "sleep", Value: s.withRunContext(sleep)}
+	return attributes
+}
+
+// withRunContext returns the function as a script function called with the
+// context of the run in progress, the script is stopped with the context
+// error once it is done
+func (s *script) withRunContext(f func(ctx context.Context, args ...tengo.Object) (tengo.Object, error)) tengo.CallableFunc {
+	return func(args ...tengo.Object) (tengo.Object, error) {
+		ctx := s.runContext()
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		ret, err := f(ctx, args...)
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return nil, ctxErr
+		}
+		return ret, err
+	}
+}
+
+// sleep pauses the script for a duration in nanoseconds
+// usage: times.sleep(duration)
+func sleep(ctx context.Context, args ...tengo.Object) (tengo.Object, error) {
+	if len(args) != 1 {
+		return nil, tengo.ErrWrongNumArguments
+	}
+
+	d, ok := tengo.ToInt64(args[0])
+	if !ok {
+		return nil, invalidArgument("duration", "int", args[0])
+	}
+
+	t := time.NewTimer(time.Duration(d))
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case <-t.C:
+		return tengo.UndefinedValue, nil
+	}
+}
+
+// exchanges returns the names of the enabled exchanges
+// usage: gct.exchanges()
+func (m *Manager) exchanges(_ context.Context, args ...tengo.Object) (tengo.Object, error) {
+	if len(args) != 0 {
+		return nil, tengo.ErrWrongNumArguments
+	}
+
+	names := m.Wrapper.Exchanges()
+	values := make([]interface{}, len(names))
+	for x := range names {
+		values[x] = names[x]
+	}
+	return tengo.FromInterface(values)
+}
+
+// ticker returns the latest ticker for the pair
+// usage: gct.ticker(exchange, pair[, assetType])
+func (m *Manager) ticker(ctx context.Context, args ...tengo.Object) (tengo.Object, error) {
+	exchName, p, assetType, err := marketArgs(args, 2)
+	if err != nil {
+		return nil, err
+	}
+
+	t, err := m.Wrapper.Ticker(ctx, exchName, p, assetType)
+	if err != nil {
+		return tengo.FromInterface(err)
+	}
+	return tickerObject(exchName, assetType, &t), nil
+}
+
+// orderbook returns the latest orderbook for the pair
+// usage: gct.orderbook(exchange, pair[, assetType])
+func (m *Manager) orderbook(ctx context.Context, args ...tengo.Object) (tengo.Object, error) {
+	exchName, p, assetType, err := marketArgs(args, 2)
+	if err != nil {
+		return nil, err
+	}
+
+	ob, err := m.Wrapper.Orderbook(ctx, exchName, p, assetType)
+	if err != nil {
+		return tengo.FromInterface(err)
+	}
+
+	if ob.ExchangeName == "" {
+		ob.ExchangeName = exchName
+	}
+
+	if ob.AssetType == "" {
+		ob.AssetType = assetType
+	}
+	return orderbookObject(&ob), nil
+}
+
+// candles returns the candles for the pair between the start and end times,
+// times are either time values or unix timestamps
+// usage: gct.candles(exchange, pair, interval, start, end[, assetType])
+func (m *Manager) candles(ctx context.Context, args ...tengo.Object) (tengo.Object, error) {
+	if len(args) != 5 && len(args) != 6 {
+		return nil, tengo.ErrWrongNumArguments
+	}
+
+	exchName, p, assetType, err := marketArgs(append(args[:2:2], args[5:]...), 2)
+	if err != nil {
+		return nil, err
+	}
+
+	s, err := stringArg(args, 2, "interval")
+	if err != nil {
+		return nil, err
+	}
+
+	interval, err := kline.ParseInterval(s)
+	if err != nil {
+		return tengo.FromInterface(err)
+	}
+
+	start, ok := tengo.ToTime(args[3])
+	if !ok {
+		return nil, invalidArgument("start", "time", args[3])
+	}
+
+	end, ok := tengo.ToTime(args[4])
+	if !ok {
+		return nil, invalidArgument("end", "time", args[4])
+	}
+
+	item, err := m.Wrapper.Candles(ctx, exchName, p, assetType, start, end, interval)
+	if err != nil {
+		return tengo.FromInterface(err)
+	}
+
+	candles := make([]interface{}, len(item.Candles))
+	for x := range item.Candles {
+		candles[x] = map[string]interface{}{
+			"time":   item.Candles[x].Time,
+			"open":   item.Candles[x].Open,
+			"high":   item.Candles[x].High,
+			"low":    item.Candles[x].Low,
+			"close":  item.Candles[x].Close,
+			"volume": item.Candles[x].Volume,
+		}
+	}
+	return tengo.FromInterface(candles)
+}
+
+// account returns the account balances for the exchange
+// usage: gct.account(exchange)
+func (m *Manager) account(ctx context.Context, args ...tengo.Object) (tengo.Object, error) {
+	if len(args) != 1 {
+		return nil, tengo.ErrWrongNumArguments
+	}
+
+	exchName, err := stringArg(args, 0, "exchange")
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := m.Wrapper.AccountInfo(ctx, exchName)
+	if err != nil {
+		return tengo.FromInterface(err)
+	}
+
+	accounts := make([]interface{}, len(info.Accounts))
+	for x := range info.Accounts {
+		currencies := make([]interface{}, len(info.Accounts[x].Currencies))
+		for y := range info.Accounts[x].Currencies {
+			c := info.Accounts[x].Currencies[y]
+			currencies[y] = map[string]interface{}{
+				"currency": c.CurrencyName.String(),
+				"total":    c.TotalValue,
+				"hold":     c.Hold,
+			}
+		}
+		accounts[x] = map[string]interface{}{
+			"id":         info.Accounts[x].ID,
+			"currencies": currencies,
+		}
+	}
+
+	return tengo.FromInterface(map[string]interface{}{
+		"exchange": exchName,
+		"accounts": accounts,
+	})
+}
+
+// orders returns the open orders on the exchange, optionally for a pair
+// usage: gct.orders(exchange[, pair])
+func (m *Manager) orders(ctx context.Context, args ...tengo.Object) (tengo.Object, error) {
+	if len(args) != 1 && len(args) != 2 {
+		return nil, tengo.ErrWrongNumArguments
+	}
+
+	exchName, err := stringArg(args, 0, "exchange")
+	if err != nil {
+		return nil, err
+	}
+
+	var p currency.Pair
+	if len(args) == 2 {
+		var s string
+		s, err = stringArg(args, 1, "pair")
+		if err != nil {
+			return nil, err
+		}
+		p = currency.NewPairFromString(s)
+	}
+
+	orders, err := m.Wrapper.ActiveOrders(ctx, exchName, p)
+	if err != nil {
+		return tengo.FromInterface(err)
+	}
+
+	values := make([]interface{}, len(orders))
+	for x := range orders {
+		values[x] = map[string]interface{}{
+			"id":        orders[x].ID,
+			"pair":      orders[x].CurrencyPair.String(),
+			"side":      string(orders[x].OrderSide),
+			"type":      string(orders[x].OrderType),
+			"status":    orders[x].Status,
+			"price":     orders[x].Price,
+			"amount":    orders[x].Amount,
+			"executed":  orders[x].ExecutedAmount,
+			"remaining": orders[x].RemainingAmount,
+			"date":      orders[x].OrderDate,
+		}
+	}
+	return tengo.FromInterface(values)
+}
+
+// submitOrder places an order and returns its ID, in dry run mode the order
+// is logged and undefined is returned
+// usage: gct.submit_order(exchange, pair, side, type, amount[, price])
+func (m *Manager) submitOrder(ctx context.Context, name string, args ...tengo.Object) (tengo.Object, error) {
+	if len(args) != 5 && len(args) != 6 {
+		return nil, tengo.ErrWrongNumArguments
+	}
+
+	exchName, p, _, err := marketArgs(args[:2], 2)
+	if err != nil {
+		return nil, err
+	}
+
+	side, err := stringArg(args, 2, "side")
+	if err != nil {
+		return nil, err
+	}
+
+	orderType, err := stringArg(args, 3, "type")
+	if err != nil {
+		return nil, err
+	}
+
+	amount, ok := tengo.ToFloat64(args[4])
+	if !ok {
+		return nil, invalidArgument("amount", "float", args[4])
+	}
+
+	var price float64
+	if len(args) == 6 {
+		price, ok = tengo.ToFloat64(args[5])
+		if !ok {
+			return nil, invalidArgument("price", "float", args[5])
+		}
+	}
+
+	if m.DryRun {
+		log.Infof("Script %s: dry run %s %s order for %f %s at %f on %s\n",
+			name, side, orderType, amount, p, price, exchName)
+		return tengo.UndefinedValue, nil
+	}
+
+	id, err := m.Wrapper.SubmitOrder(ctx, exchName,
+		p,
+		exchange.OrderSide(strings.ToUpper(side)),
+		exchange.OrderType(strings.ToUpper(orderType)),
+		amount,
+		price,
+		"")
+	if err != nil {
+		return tengo.FromInterface(err)
+	}
+	return tengo.FromInterface(id)
+}
+
+// cancelOrder cancels an order by its ID, in dry run mode the cancellation
+// is logged
+// usage: gct.cancel_order(exchange, orderID)
+func (m *Manager) cancelOrder(ctx context.Context, name string, args ...tengo.Object) (tengo.Object, error) {
+	if len(args) != 2 {
+		return nil, tengo.ErrWrongNumArguments
+	}
+
+	exchName, err := stringArg(args, 0, "exchange")
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := stringArg(args, 1, "orderID")
+	if err != nil {
+		return nil, err
+	}
+
+	if m.DryRun {
+		log.Infof("Script %s: dry run cancel order %s on %s\n", name, id,
+			exchName)
+		return tengo.TrueValue, nil
+	}
+
+	err = m.Wrapper.CancelOrder(ctx, exchName, id)
+	if err != nil {
+		return tengo.FromInterface(err)
+	}
+	return tengo.TrueValue, nil
+}
+
+// marketArgs parses the exchange, pair and optional asset type arguments
+// which start market data functions, the asset type defaults to spot
+func marketArgs(args []tengo.Object, required int) (exchName string, p currency.Pair, assetType asset.Item, err error) {
+	if len(args) != required && len(args) != required+1 {
+		return "", p, "", tengo.ErrWrongNumArguments
+	}
+
+	exchName, err = stringArg(args, 0, "exchange")
+	if err != nil {
+		return "", p, "", err
+	}
+
+	pair, err := stringArg(args, 1, "pair")
+	if err != nil {
+		return "", p, "", err
+	}
+
+	assetType = asset.Spot
+	if len(args) > required {
+		var name string
+		name, err = stringArg(args, required, "assetType")
+		if err != nil {
+			return "", p, "", err
+		}
+		assetType, err = asset.New(name)
+		if err != nil {
+			return "", p, "", err
+		}
+	}
+	return exchName, currency.NewPairFromString(pair), assetType, nil
+}
+
+// stringArg returns the argument at the index as a string
+func stringArg(args []tengo.Object, index int, name string) (string, error) {
+	s, ok := args[index].(*tengo.String)
+	if !ok {
+		return "", invalidArgument(name, "string", args[index])
+	}
+	return s.Value, nil
+}
+
+func invalidArgument(name, expected string, found tengo.Object) error {
+	return tengo.ErrInvalidArgumentType{
+		Name:     name,
+		Expected: expected,
+		Found:    found.TypeName(),
+	}
+}
+
+// tickerObject converts a ticker to a script map
+func tickerObject(exchName string, assetType asset.Item, t *ticker.Price) tengo.Object {
+	return &tengo.Map{Value: map[string]tengo.Object{
+		"type":     &tengo.String{Value: EventTicker},
+		"exchange": &tengo.String{Value: exchName},
+		"pair":     &tengo.String{Value: t.Pair.String()},
+		"asset":    &tengo.String{Value: assetType.String()},
+		"last":     &tengo.Float{Value: t.Last},
+		"high":     &tengo.Float{Value: t.High},
+		"low":      &tengo.Float{Value: t.Low},
+		"bid":      &tengo.Float{Value: t.Bid},
+		"ask":      &tengo.Float{Value: t.Ask},
+		"volume":   &tengo.Float{Value: t.Volume},
+		"updated":  &tengo.Time{Value: t.LastUpdated},
+	}}
+}
+
+// orderbookObject converts an orderbook to a script map
+func orderbookObject(ob *orderbook.Base) tengo.Object {
+	levels := func(items []orderbook.Item) tengo.Object {
+		values := make([]tengo.Object, len(items))
+		for x := range items {
+			values[x] = &tengo.Map{Value: map[string]tengo.Object{
+				"price":  &tengo.Float{Value: items[x].Price},
+				"amount": &tengo.Float{Value: items[x].Amount},
+			}}
+		}
+		return &tengo.Array{Value: values}
+	}
+
+	return &tengo.Map{Value: map[string]tengo.Object{
+		"type":     &tengo.String{Value: EventOrderbook},
+		"exchange": &tengo.String{Value: ob.ExchangeName},
+		"pair":     &tengo.String{Value: ob.Pair.String()},
+		"asset":    &tengo.String{Value: ob.AssetType.String()},
+		"bids":     levels(ob.Bids),
+		"asks":     levels(ob.Asks),
+		"updated":  &tengo.Time{Value: ob.LastUpdated},
+	}}
+}
@@ -3,9 +3,12 @@ module github.com/thrasher-corp/gocryptotrader
 go 1.12
 
 require (
+	github.com/d5/tengo/v2 v2.17.0
+	github.com/golang/protobuf v1.3.1
 	github.com/google/go-querystring v1.0.0
 	github.com/gorilla/mux v1.7.3
 	github.com/gorilla/websocket v1.4.0
 	github.com/toorop/go-pusher v0.0.0-20180521062818-4521e2eb39fb
 	golang.org/x/crypto v0.0.0-20190513172903-22d7a77e9e5f
+	google.golang.org/grpc v1.18.0
 )
@@ -0,0 +1,140 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/thrasher-corp/gocryptotrader/backtester"
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/engine"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+)
+
+func main() {
+	var dataFile, dataType, exchangeName, pair, assetType, strategy, slippage,
+		outFile string
+	var funds, amount, makerFee, takerFee, minPnL, maxDrawdown float64
+	var shortPeriod, longPeriod int
+
+	flag.StringVar(&dataFile, "data", "", "The recorded market data file (.json or .csv) to replay.")
+	flag.StringVar(&dataType, "type", backtester.DataTypeCandles, "The market data type: candles, trades or orderbook.")
+	flag.StringVar(&exchangeName, "exchange", "", "The exchange whose fees are simulated, overrides makerfee and takerfee.")
+	flag.StringVar(&pair, "pair", "BTC-USD", "The currency pair of the market data.")
+	flag.StringVar(&assetType, "asset", asset.Spot.String(), "The asset type of the market data.")
+	flag.Float64Var(&funds, "funds", 10000, "The initial quote currency funds.")
+	flag.StringVar(&strategy, "strategy", backtester.StrategyBuyAndHold, "The strategy to run: buyandhold or smacrossover.")
+	flag.Float64Var(&amount, "amount", 1, "The base currency amount bought by each strategy entry.")
+	flag.IntVar(&shortPeriod, "short", 10, "The short moving average period for smacrossover.")
+	flag.IntVar(&longPeriod, "long", 30, "The long moving average period for smacrossover.")
+	flag.Float64Var(&makerFee, "makerfee", 0, "The maker fee percentage.")
+	flag.Float64Var(&takerFee, "takerfee", 0, "The taker fee percentage.")
+	flag.StringVar(&slippage, "slippage", "none", "The slippage model: none, orderbook or a fixed percentage.")
+	flag.StringVar(&outFile, "outfile", "", "The file to write the JSON results to, defaults to stdout.")
+	flag.Float64Var(&minPnL, "minpnl", 0, "Exit with an error if the PnL percentage is below this value.")
+	flag.Float64Var(&maxDrawdown, "maxdrawdown", 0, "Exit with an error if the max drawdown percentage is above this value.")
+	flag.Parse()
+
+	log.Println("GoCryptoTrader: backtester tool.")
+
+	if dataFile == "" {
+		log.Fatal("A market data file is required.")
+	}
+
+	data, err := backtester.LoadFile(dataFile, dataType)
+	if err != nil {
+		log.Fatalf("Unable to load market data file %s. Error: %s.", dataFile, err)
+	}
+
+	a, err := asset.New(assetType)
+	if err != nil {
+		log.Fatalf("Invalid asset type %s. Error: %s.", assetType, err)
+	}
+
+	cfg := backtester.Config{
+		Exchange:     exchangeName,
+		Pair:         currency.NewPairFromString(pair),
+		AssetType:    a,
+		InitialFunds: funds,
+		Fees:         backtester.PercentageFee{Maker: makerFee, Taker: takerFee},
+	}
+
+	if exchangeName != "" {
+		exch, errExch := engine.NewExchangeByName(exchangeName)
+		if errExch != nil {
+			log.Fatalf("Unable to load exchange %s fees. Error: %s.", exchangeName, errExch)
+		}
+		exch.SetDefaults()
+		cfg.Fees = exch
+	}
+
+	switch slippage {
+	case "none":
+		cfg.Slippage = backtester.NoSlippage{}
+	case "orderbook":
+		cfg.Slippage = backtester.OrderbookSlippage{}
+	default:
+		percentage, errParse := strconv.ParseFloat(slippage, 64)
+		if errParse != nil {
+			log.Fatalf("Invalid slippage model %s.", slippage)
+		}
+		cfg.Slippage = backtester.FixedSlippage{Percentage: percentage}
+	}
+
+	s, err := backtester.NewStrategy(strategy, backtester.StrategySettings{
+		Amount:      amount,
+		ShortPeriod: shortPeriod,
+		LongPeriod:  longPeriod,
+	})
+	if err != nil {
+		log.Fatalf("Unable to load strategy %s. Error: %s.", strategy, err)
+	}
+
+	b, err := backtester.New(cfg)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	results, err := b.Run(data, s)
+	if err != nil {
+		log.Fatalf("Backtest failed. Error: %s.", err)
+	}
+
+	output, err := json.MarshalIndent(results, "", " ")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if outFile == "" {
+		fmt.Println(string(output))
+	} else {
+		err = common.WriteFile(outFile, output)
+		if err != nil {
+			log.Fatalf("Unable to write output file %s. Error: %s", outFile, err)
+		}
+	}
+
+	log.Printf("Replayed %d data points. PnL: %.2f (%.2f%%) Max drawdown: %.2f%% Sharpe ratio: %.2f Trades: %d Rejected orders: %d.",
+		results.DataPointsReplayed, results.PnL, results.PnLPercentage,
+		results.MaxDrawdown, results.SharpeRatio, len(results.Trades),
+		results.RejectedOrders)
+
+	// Only gate on the thresholds which were explicitly set
+	flag.Visit(func(f *flag.Flag) {
+		switch f.Name {
+		case "minpnl":
+			if results.PnLPercentage < minPnL {
+				log.Fatalf("PnL percentage %.2f%% is below the minimum %.2f%%.",
+					results.PnLPercentage, minPnL)
+			}
+		case "maxdrawdown":
+			if results.MaxDrawdown > maxDrawdown {
+				log.Fatalf("Max drawdown %.2f%% is above the maximum %.2f%%.",
+					results.MaxDrawdown, maxDrawdown)
+			}
+		}
+	})
+}
@@ -3,6 +3,7 @@ package main
 import (
 	"flag"
 	"log"
+	"strings"
 
 	"github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/config"
@@ -17,8 +18,8 @@ func EncryptOrDecrypt(encrypt bool) string {
 }
 
 func main() {
-	var inFile, outFile, key string
-	var encrypt bool
+	var inFile, outFile, key, newKey string
+	var encrypt, secrets, rekey bool
 	var err error
 
 	configFile, err := config.GetFilePath("")
@@ -29,13 +30,16 @@ func main() {
 	flag.StringVar(&inFile, "infile", configFile, "The config input file to process.")
 	flag.StringVar(&outFile, "outfile", configFile+".out", "The config output file.")
 	flag.BoolVar(&encrypt, "encrypt", true, "Whether to encrypt or decrypt.")
-	flag.StringVar(&key, "key", "", "The key to use for AES encryption.")
+	flag.BoolVar(&secrets, "secrets", false, "Whether to encrypt only the secret values of the config, such as API secrets, bank accounts and passwords.")
+	flag.StringVar(&key, "key", "", "The key to use for AES encryption, read from the "+config.KeyEnvVar+", "+config.KeyFileEnvVar+" or "+config.KeyFDEnvVar+" environment variables or prompted for when empty.")
+	flag.BoolVar(&rekey, "rekey", false, "Whether to decrypt the encrypted config with the key and encrypt it again with the new key.")
+	flag.StringVar(&newKey, "newkey", "", "The new key to use when rekeying, prompted for when empty.")
 	flag.Parse()
 
 	log.Println("GoCryptoTrader: config-helper tool.")
 
 	if key == "" {
-		result, errf := config.PromptForConfigKey(false)
+		result, _, errf := config.GetConfigKey(false)
 		if errf != nil {
 			log.Fatal("Unable to obtain encryption/decryption key.")
 		}
@@ -47,12 +51,37 @@ func main() {
 		log.Fatalf("Unable to read input file %s. Error: %s.", inFile, err)
 	}
 
-	if config.ConfirmECS(file) && encrypt {
+	if rekey {
+		if newKey == "" {
+			log.Println("Enter the new key.")
+			result, errf := config.PromptForConfigKey(true)
+			if errf != nil {
+				log.Fatal("Unable to obtain new encryption key.")
+			}
+			newKey = string(result)
+		}
+
+		data, errf := config.RekeyConfigFile(file, []byte(key), []byte(newKey))
+		if errf != nil {
+			log.Fatalf("Unable to rekey config data. Error: %s.", errf)
+		}
+
+		err = common.WriteFile(outFile, data)
+		if err != nil {
+			log.Fatalf("Unable to write output file %s. Error: %s", outFile, err)
+		}
+		log.Printf("Successfully rekeyed input file %s and wrote output to %s.\n",
+			inFile, outFile)
+		return
+	}
+
+	encrypted := config.ConfirmECS(file) || config.ConfirmSecretsEncryption(file)
+	if encrypted && encrypt {
 		log.Println("File is already encrypted. Decrypting..")
 		encrypt = false
 	}
 
-	if !config.ConfirmECS(file) && !encrypt {
+	if !encrypted && !encrypt {
 		var result interface{}
 		errf := config.ConfirmConfigJSON(file, result)
 		if errf != nil {
@@ -63,16 +92,19 @@ func main() {
 	}
 
 	var data []byte
-	if encrypt {
+	switch {
+	case encrypt && secrets:
+		data, err = config.EncryptConfigSecrets(file, []byte(key))
+	case encrypt:
 		data, err = config.EncryptConfigFile(file, []byte(key))
-		if err != nil {
-			log.Fatalf("Unable to encrypt config data. Error: %s.", err)
-		}
-	} else {
+	case config.ConfirmECS(file):
 		data, err = config.DecryptConfigFile(file, []byte(key))
-		if err != nil {
-			log.Fatalf("Unable to decrypt config data. Error: %s.", err)
-		}
+	default:
+		data, err = config.DecryptConfigSecrets(file, []byte(key))
+	}
+	if err != nil {
+		log.Fatalf("Unable to %s config data. Error: %s.",
+			strings.TrimSuffix(EncryptOrDecrypt(encrypt), "ed"), err)
 	}
 
 	err = common.WriteFile(outFile, data)
@@ -6,10 +6,13 @@ import (
 	"html/template"
 	"log"
 	"os"
+	"sort"
 	"strings"
 	"time"
 
 	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/engine"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 )
 
 const (
@@ -29,12 +32,16 @@ const (
 	currencyFXFixerPath             = "..%s..%scurrency%sforexprovider%sfixer.io%s"
 	currencyFXOpenExchangeRatesPath = "..%s..%scurrency%sforexprovider%sopenexchangerates%s"
 	eventsPath                      = "..%s..%sevents%s"
+	backtesterPath                  = "..%s..%sbacktester%s"
+	gctscriptPath                   = "..%s..%sgctscript%s"
 	exchangesPath                   = "..%s..%sexchanges%s"
+	exchangesAssetPath              = "..%s..%sexchanges%sasset%s"
+	exchangesKlinePath              = "..%s..%sexchanges%skline%s"
 	exchangesNoncePath              = "..%s..%sexchanges%snonce%s"
 	exchangesOrderbookPath          = "..%s..%sexchanges%sorderbook%s"
+	exchangesPaperPath              = "..%s..%sexchanges%spaper%s"
 	exchangesStatsPath              = "..%s..%sexchanges%sstats%s"
 	exchangesTickerPath             = "..%s..%sexchanges%sticker%s"
-	exchangesOrdersPath             = "..%s..%sexchanges%sorders%s"
 	exchangesRequestPath            = "..%s..%sexchanges%srequest%s"
 	portfolioPath                   = "..%s..%sportfolio%s"
 	testdataPath                    = "..%s..%stestdata%s"
@@ -86,6 +93,7 @@ var (
 	tmpl                 *template.Template
 	path                 string
 	contributors         []contributor
+	support              supportMatrix
 )
 
 type readme struct {
@@ -94,6 +102,45 @@ type readme struct {
 	NameURL      string
 	Year         int
 	CapitalName  string
+	Support      supportMatrix
+}
+
+// supportMatrix holds the operations each supported exchange implements over
+// REST and websocket
+type supportMatrix struct {
+	Operations []string
+	Exchanges  []exchangeSupport
+}
+
+type exchangeSupport struct {
+	Name       string
+	Support    []string
+	OrderTypes string
+}
+
+// supportOperations are the operations shown in the support matrix
+var supportOperations = []struct {
+	name      string
+	supported func(p *exchange.ProtocolFeatures) bool
+}{
+	{"Ticker", func(p *exchange.ProtocolFeatures) bool { return p.TickerFetching }},
+	{"Orderbook", func(p *exchange.ProtocolFeatures) bool { return p.OrderbookFetching }},
+	{"Trades", func(p *exchange.ProtocolFeatures) bool { return p.TradeFetching }},
+	{"Historic Trades", func(p *exchange.ProtocolFeatures) bool { return p.HistoricTrades }},
+	{"Klines", func(p *exchange.ProtocolFeatures) bool { return p.KlineFetching }},
+	{"Account Info", func(p *exchange.ProtocolFeatures) bool { return p.AccountInfo }},
+	{"Get Order", func(p *exchange.ProtocolFeatures) bool { return p.GetOrder }},
+	{"Active Orders", func(p *exchange.ProtocolFeatures) bool { return p.ActiveOrders }},
+	{"Order History", func(p *exchange.ProtocolFeatures) bool { return p.OrderHistory }},
+	{"Submit Order", func(p *exchange.ProtocolFeatures) bool { return p.SubmitOrder }},
+	{"Batch Orders", func(p *exchange.ProtocolFeatures) bool { return p.BatchOrders }},
+	{"Modify Order", func(p *exchange.ProtocolFeatures) bool { return p.ModifyOrder }},
+	{"Cancel Order", func(p *exchange.ProtocolFeatures) bool { return p.CancelOrder }},
+	{"Cancel All", func(p *exchange.ProtocolFeatures) bool { return p.CancelOrders }},
+	{"Funding History", func(p *exchange.ProtocolFeatures) bool { return p.FundingHistory }},
+	{"Deposit Address", func(p *exchange.ProtocolFeatures) bool { return p.DepositAddress }},
+	{"Crypto Withdrawal", func(p *exchange.ProtocolFeatures) bool { return p.CryptoWithdrawal }},
+	{"Fiat Withdrawal", func(p *exchange.ProtocolFeatures) bool { return p.FiatWithdrawal }},
 }
 
 type contributor struct {
@@ -124,6 +171,12 @@ func main() {
 
 	fmt.Println("Contributor list fetched")
 
+	if err := getSupportMatrix(); err != nil {
+		log.Fatal("GoCryptoTrader: Exchange documentation tool support matrix error ", err)
+	}
+
+	fmt.Println("Exchange support matrix built")
+
 	if err := addTemplates(); err != nil {
 		log.Fatal("GoCryptoTrader: Exchange documentation tool add template error ", err)
 	}
@@ -198,6 +251,8 @@ func addPaths() {
 	codebasePaths["currency forexprovider openexchangerates"] = fmt.Sprintf(currencyFXOpenExchangeRatesPath, path, path, path, path, path)
 
 	codebasePaths["events"] = fmt.Sprintf(eventsPath, path, path, path)
+	codebasePaths["backtester"] = fmt.Sprintf(backtesterPath, path, path, path)
+	codebasePaths["gctscript"] = fmt.Sprintf(gctscriptPath, path, path, path)
 
 	codebasePaths["portfolio"] = fmt.Sprintf(portfolioPath, path, path, path)
 	codebasePaths["testdata"] = fmt.Sprintf(testdataPath, path, path, path)
@@ -206,11 +261,13 @@ func addPaths() {
 	codebasePaths["root"] = fmt.Sprintf(rootPath, path, path)
 
 	codebasePaths["exchanges"] = fmt.Sprintf(exchangesPath, path, path, path)
+	codebasePaths["exchanges asset"] = fmt.Sprintf(exchangesAssetPath, path, path, path, path)
+	codebasePaths["exchanges kline"] = fmt.Sprintf(exchangesKlinePath, path, path, path, path)
 	codebasePaths["exchanges nonce"] = fmt.Sprintf(exchangesNoncePath, path, path, path, path)
 	codebasePaths["exchanges orderbook"] = fmt.Sprintf(exchangesOrderbookPath, path, path, path, path)
+	codebasePaths["exchanges paper"] = fmt.Sprintf(exchangesPaperPath, path, path, path, path)
 	codebasePaths["exchanges stats"] = fmt.Sprintf(exchangesStatsPath, path, path, path, path)
 	codebasePaths["exchanges ticker"] = fmt.Sprintf(exchangesTickerPath, path, path, path, path)
-	codebasePaths["exchanges orders"] = fmt.Sprintf(exchangesOrdersPath, path, path, path, path)
 	codebasePaths["exchanges request"] = fmt.Sprintf(exchangesRequestPath, path, path, path, path)
 
 	codebasePaths["exchanges alphapoint"] = fmt.Sprintf(alphapoint, path, path, path, path)
@@ -253,6 +310,7 @@ func addReadmeData(packageName string) {
 		NameURL:      getslashFromName(packageName),
 		Year:         time.Now().Year(),
 		CapitalName:  getName(packageName, true),
+		Support:      support,
 	}
 	codebaseReadme[packageName] = readmeInfo
 }
@@ -291,6 +349,8 @@ func getslashFromName(packageName string) string {
 }
 
 var globS = []string{
+	fmt.Sprintf("backtester_templates%s*", common.GetOSPathSlash()),
+	fmt.Sprintf("gctscript_templates%s*", common.GetOSPathSlash()),
 	fmt.Sprintf("common_templates%s*", common.GetOSPathSlash()),
 	fmt.Sprintf("communications_templates%s*", common.GetOSPathSlash()),
 	fmt.Sprintf("config_templates%s*", common.GetOSPathSlash()),
@@ -373,3 +433,58 @@ func deleteFile(path string) error {
 func getContributorList() error {
 	return common.SendHTTPGetRequest(contributorsList, true, false, &contributors)
 }
+
+// getSupportMatrix builds the support matrix from the features each exchange
+// declares in its defaults
+func getSupportMatrix() error {
+	support = supportMatrix{}
+	for x := range supportOperations {
+		support.Operations = append(support.Operations, supportOperations[x].name)
+	}
+
+	for x := range engine.SupportedExchanges {
+		exch, err := engine.NewExchangeByName(engine.SupportedExchanges[x])
+		if err != nil {
+			return err
+		}
+		exch.SetDefaults()
+		features := exch.GetFeatures()
+
+		e := exchangeSupport{Name: exch.GetName()}
+		for y := range supportOperations {
+			e.Support = append(e.Support, protocolSupport(
+				supportOperations[y].supported(&features.REST),
+				supportOperations[y].supported(&features.Websocket)))
+		}
+
+		orderTypes := make([]string, len(features.OrderTypes))
+		for y := range features.OrderTypes {
+			orderTypes[y] = features.OrderTypes[y].ToString()
+		}
+		e.OrderTypes = strings.Join(orderTypes, ", ")
+		if e.OrderTypes == "" {
+			e.OrderTypes = "-"
+		}
+		support.Exchanges = append(support.Exchanges, e)
+	}
+
+	sort.Slice(support.Exchanges, func(i, j int) bool {
+		return strings.ToLower(support.Exchanges[i].Name) <
+			strings.ToLower(support.Exchanges[j].Name)
+	})
+	return nil
+}
+
+// protocolSupport returns the support matrix cell for an operation
+func protocolSupport(rest, websocket bool) string {
+	switch {
+	case rest && websocket:
+		return "REST, WS"
+	case rest:
+		return "REST"
+	case websocket:
+		return "WS"
+	default:
+		return "-"
+	}
+}
@@ -0,0 +1,517 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"strings"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/gctrpc"
+)
+
+// action performs a gRPC request and returns its response
+type action func(ctx context.Context, c gctrpc.GoCryptoTraderClient) (interface{}, error)
+
+// command is a gctcli command which registers its flags and returns the action
+// to run once they have been parsed
+type command struct {
+	description string
+	setup       func(fs *flag.FlagSet) action
+}
+
+var errExchangeRequired = errors.New("exchange is required")
+
+var commands = map[string]command{
+	"getinfo": {
+		description: "gets GoCryptoTrader info",
+		setup: func(_ *flag.FlagSet) action {
+			return func(ctx context.Context, c gctrpc.GoCryptoTraderClient) (interface{}, error) {
+				return c.GetInfo(ctx, &gctrpc.GetInfoRequest{})
+			}
+		},
+	},
+	"getsubsystems": {
+		description: "gets the status of each engine subsystem",
+		setup: func(_ *flag.FlagSet) action {
+			return func(ctx context.Context, c gctrpc.GoCryptoTraderClient) (interface{}, error) {
+				return c.GetSubsystems(ctx, &gctrpc.GetSubsystemsRequest{})
+			}
+		},
+	},
+	"enablesubsystem": {
+		description: "enables an engine subsystem",
+		setup:       setSubsystem(true),
+	},
+	"disablesubsystem": {
+		description: "disables an engine subsystem",
+		setup:       setSubsystem(false),
+	},
+	"reloadconfig": {
+		description: "reloads the config file, applying the changes without a restart",
+		setup: func(_ *flag.FlagSet) action {
+			return func(ctx context.Context, c gctrpc.GoCryptoTraderClient) (interface{}, error) {
+				return c.ReloadConfig(ctx, &gctrpc.ReloadConfigRequest{})
+			}
+		},
+	},
+	"getexchanges": {
+		description: "gets a list of exchanges",
+		setup: func(fs *flag.FlagSet) action {
+			enabled := fs.Bool("enabled", false, "only list enabled exchanges")
+			return func(ctx context.Context, c gctrpc.GoCryptoTraderClient) (interface{}, error) {
+				return c.GetExchanges(ctx, &gctrpc.GetExchangesRequest{Enabled: *enabled})
+			}
+		},
+	},
+	"getexchangeinfo": {
+		description: "gets an exchanges configuration",
+		setup: func(fs *flag.FlagSet) action {
+			exch := fs.String("exchange", "", "the exchange")
+			return func(ctx context.Context, c gctrpc.GoCryptoTraderClient) (interface{}, error) {
+				if *exch == "" {
+					return nil, errExchangeRequired
+				}
+				return c.GetExchangeInfo(ctx, &gctrpc.GenericExchangeNameRequest{Exchange: *exch})
+			}
+		},
+	},
+	"enableexchange": {
+		description: "loads and enables an exchange",
+		setup: func(fs *flag.FlagSet) action {
+			exch := fs.String("exchange", "", "the exchange")
+			return func(ctx context.Context, c gctrpc.GoCryptoTraderClient) (interface{}, error) {
+				if *exch == "" {
+					return nil, errExchangeRequired
+				}
+				return c.EnableExchange(ctx, &gctrpc.GenericExchangeNameRequest{Exchange: *exch})
+			}
+		},
+	},
+	"disableexchange": {
+		description: "disables and unloads an exchange",
+		setup: func(fs *flag.FlagSet) action {
+			exch := fs.String("exchange", "", "the exchange")
+			return func(ctx context.Context, c gctrpc.GoCryptoTraderClient) (interface{}, error) {
+				if *exch == "" {
+					return nil, errExchangeRequired
+				}
+				return c.DisableExchange(ctx, &gctrpc.GenericExchangeNameRequest{Exchange: *exch})
+			}
+		},
+	},
+	"getticker": {
+		description: "gets the ticker for an exchange, currency pair and asset type",
+		setup: func(fs *flag.FlagSet) action {
+			exch := fs.String("exchange", "", "the exchange")
+			pair := fs.String("pair", "", "the currency pair, e.g. BTC-USD")
+			assetType := fs.String("asset", "SPOT", "the asset type")
+			return func(ctx context.Context, c gctrpc.GoCryptoTraderClient) (interface{}, error) {
+				p, err := parsePair(*pair)
+				if err != nil {
+					return nil, err
+				}
+				return c.GetTicker(ctx, &gctrpc.GetTickerRequest{
+					Exchange:  *exch,
+					Pair:      p,
+					AssetType: *assetType,
+				})
+			}
+		},
+	},
+	"gettickers": {
+		description: "gets the tickers for all enabled exchanges",
+		setup: func(_ *flag.FlagSet) action {
+			return func(ctx context.Context, c gctrpc.GoCryptoTraderClient) (interface{}, error) {
+				return c.GetTickers(ctx, &gctrpc.GetTickersRequest{})
+			}
+		},
+	},
+	"getorderbook": {
+		description: "gets the orderbook for an exchange, currency pair and asset type",
+		setup: func(fs *flag.FlagSet) action {
+			exch := fs.String("exchange", "", "the exchange")
+			pair := fs.String("pair", "", "the currency pair, e.g. BTC-USD")
+			assetType := fs.String("asset", "SPOT", "the asset type")
+			return func(ctx context.Context, c gctrpc.GoCryptoTraderClient) (interface{}, error) {
+				p, err := parsePair(*pair)
+				if err != nil {
+					return nil, err
+				}
+				return c.GetOrderbook(ctx, &gctrpc.GetOrderbookRequest{
+					Exchange:  *exch,
+					Pair:      p,
+					AssetType: *assetType,
+				})
+			}
+		},
+	},
+	"getorderbooks": {
+		description: "gets the orderbooks for all enabled exchanges",
+		setup: func(_ *flag.FlagSet) action {
+			return func(ctx context.Context, c gctrpc.GoCryptoTraderClient) (interface{}, error) {
+				return c.GetOrderbooks(ctx, &gctrpc.GetOrderbooksRequest{})
+			}
+		},
+	},
+	"getsyncstatus": {
+		description: "gets the market data sync status of each enabled currency pair, optionally of a single exchange",
+		setup: func(fs *flag.FlagSet) action {
+			exch := fs.String("exchange", "", "the exchange, all exchanges if empty")
+			return func(ctx context.Context, c gctrpc.GoCryptoTraderClient) (interface{}, error) {
+				return c.GetSyncStatus(ctx, &gctrpc.GetSyncStatusRequest{Exchange: *exch})
+			}
+		},
+	},
+	"getaccountinfo": {
+		description: "gets an exchanges account balances across all credential sets",
+		setup: func(fs *flag.FlagSet) action {
+			exch := fs.String("exchange", "", "the exchange")
+			return func(ctx context.Context, c gctrpc.GoCryptoTraderClient) (interface{}, error) {
+				if *exch == "" {
+					return nil, errExchangeRequired
+				}
+				return c.GetAccountInfo(ctx, &gctrpc.GetAccountInfoRequest{Exchange: *exch})
+			}
+		},
+	},
+	"getorders": {
+		description: "gets the orders recorded by the order manager",
+		setup: func(fs *flag.FlagSet) action {
+			exch := fs.String("exchange", "", "only list orders for the exchange")
+			pair := fs.String("pair", "", "only list orders for the currency pair, e.g. BTC-USD")
+			side := fs.String("side", "", "only list orders for the side")
+			status := fs.String("status", "", "only list orders with the status")
+			active := fs.Bool("active", false, "only list open orders")
+			credentialSet := fs.String("credentialset", "", "only list orders for the credential set")
+			return func(ctx context.Context, c gctrpc.GoCryptoTraderClient) (interface{}, error) {
+				req := &gctrpc.GetOrdersRequest{
+					Exchange:      *exch,
+					OrderSide:     *side,
+					Status:        *status,
+					ActiveOnly:    *active,
+					CredentialSet: *credentialSet,
+				}
+				if *pair != "" {
+					p, err := parsePair(*pair)
+					if err != nil {
+						return nil, err
+					}
+					req.Pair = p
+				}
+				return c.GetOrders(ctx, req)
+			}
+		},
+	},
+	"getorder": {
+		description: "gets an order recorded by the order manager",
+		setup: func(fs *flag.FlagSet) action {
+			id := fs.String("id", "", "the order ID")
+			return func(ctx context.Context, c gctrpc.GoCryptoTraderClient) (interface{}, error) {
+				return c.GetOrder(ctx, &gctrpc.GetOrderRequest{OrderId: *id})
+			}
+		},
+	},
+	"submitorder": {
+		description: "submits an order",
+		setup: func(fs *flag.FlagSet) action {
+			exch := fs.String("exchange", "", "the exchange")
+			pair := fs.String("pair", "", "the currency pair, e.g. BTC-USD")
+			side := fs.String("side", "", "the order side, e.g. BUY or SELL")
+			orderType := fs.String("type", "LIMIT", "the order type, e.g. LIMIT or MARKET")
+			amount := fs.Float64("amount", 0, "the order amount")
+			price := fs.Float64("price", 0, "the order price")
+			clientID := fs.String("clientid", "", "an optional client ID")
+			credentialSet := fs.String("credentialset", "", "the credential set, defaults to the default credentials")
+			return func(ctx context.Context, c gctrpc.GoCryptoTraderClient) (interface{}, error) {
+				p, err := parsePair(*pair)
+				if err != nil {
+					return nil, err
+				}
+				return c.SubmitOrder(ctx, &gctrpc.SubmitOrderRequest{
+					Exchange:      *exch,
+					Pair:          p,
+					Side:          *side,
+					OrderType:     *orderType,
+					Amount:        *amount,
+					Price:         *price,
+					ClientId:      *clientID,
+					CredentialSet: *credentialSet,
+				})
+			}
+		},
+	},
+	"cancelorder": {
+		description: "cancels an order by its order manager ID",
+		setup: func(fs *flag.FlagSet) action {
+			id := fs.String("id", "", "the order ID")
+			return func(ctx context.Context, c gctrpc.GoCryptoTraderClient) (interface{}, error) {
+				return c.CancelOrder(ctx, &gctrpc.CancelOrderRequest{OrderId: *id})
+			}
+		},
+	},
+	"cancelallorders": {
+		description: "cancels all open orders on an exchange",
+		setup: func(fs *flag.FlagSet) action {
+			exch := fs.String("exchange", "", "the exchange")
+			pair := fs.String("pair", "", "only cancel orders for the currency pair, e.g. BTC-USD")
+			credentialSet := fs.String("credentialset", "", "the credential set, defaults to the default credentials")
+			return func(ctx context.Context, c gctrpc.GoCryptoTraderClient) (interface{}, error) {
+				if *exch == "" {
+					return nil, errExchangeRequired
+				}
+				req := &gctrpc.CancelAllOrdersRequest{
+					Exchange:      *exch,
+					CredentialSet: *credentialSet,
+				}
+				if *pair != "" {
+					p, err := parsePair(*pair)
+					if err != nil {
+						return nil, err
+					}
+					req.Pair = p
+				}
+				return c.CancelAllOrders(ctx, req)
+			}
+		},
+	},
+	"getdepositaddress": {
+		description: "gets a deposit address for an exchange and cryptocurrency",
+		setup: func(fs *flag.FlagSet) action {
+			exch := fs.String("exchange", "", "the exchange")
+			cryptocurrency := fs.String("currency", "", "the cryptocurrency")
+			accountID := fs.String("accountid", "", "an optional account ID")
+			credentialSet := fs.String("credentialset", "", "the credential set, defaults to the default credentials")
+			return func(ctx context.Context, c gctrpc.GoCryptoTraderClient) (interface{}, error) {
+				return c.GetDepositAddress(ctx, &gctrpc.GetDepositAddressRequest{
+					Exchange:       *exch,
+					Cryptocurrency: *cryptocurrency,
+					AccountId:      *accountID,
+					CredentialSet:  *credentialSet,
+				})
+			}
+		},
+	},
+	"withdrawcrypto": {
+		description: "withdraws cryptocurrency from an exchange to an address",
+		setup: func(fs *flag.FlagSet) action {
+			exch := fs.String("exchange", "", "the exchange")
+			cryptocurrency := fs.String("currency", "", "the cryptocurrency")
+			address := fs.String("address", "", "the destination address")
+			addressTag := fs.String("addresstag", "", "an optional address tag or memo")
+			amount := fs.Float64("amount", 0, "the amount to withdraw")
+			fee := fs.Float64("fee", 0, "an optional withdrawal fee")
+			description := fs.String("description", "", "an optional description")
+			credentialSet := fs.String("credentialset", "", "the credential set, defaults to the default credentials")
+			return func(ctx context.Context, c gctrpc.GoCryptoTraderClient) (interface{}, error) {
+				return c.WithdrawCryptocurrencyFunds(ctx, &gctrpc.WithdrawCryptoRequest{
+					Exchange:      *exch,
+					Currency:      *cryptocurrency,
+					Address:       *address,
+					AddressTag:    *addressTag,
+					Amount:        *amount,
+					Fee:           *fee,
+					Description:   *description,
+					CredentialSet: *credentialSet,
+				})
+			}
+		},
+	},
+	"withdrawfiat": {
+		description: "withdraws fiat from an exchange to the configured bank account",
+		setup: func(fs *flag.FlagSet) action {
+			exch := fs.String("exchange", "", "the exchange")
+			fiat := fs.String("currency", "", "the fiat currency")
+			amount := fs.Float64("amount", 0, "the amount to withdraw")
+			description := fs.String("description", "", "an optional description")
+			credentialSet := fs.String("credentialset", "", "the credential set, defaults to the default credentials")
+			return func(ctx context.Context, c gctrpc.GoCryptoTraderClient) (interface{}, error) {
+				return c.WithdrawFiatFunds(ctx, &gctrpc.WithdrawFiatRequest{
+					Exchange:      *exch,
+					Currency:      *fiat,
+					Amount:        *amount,
+					Description:   *description,
+					CredentialSet: *credentialSet,
+				})
+			}
+		},
+	},
+	"getevents": {
+		description: "gets all events",
+		setup: func(_ *flag.FlagSet) action {
+			return func(ctx context.Context, c gctrpc.GoCryptoTraderClient) (interface{}, error) {
+				return c.GetEvents(ctx, &gctrpc.GetEventsRequest{})
+			}
+		},
+	},
+	"addevent": {
+		description: "adds an event with a single condition",
+		setup: func(fs *flag.FlagSet) action {
+			name := fs.String("name", "", "an optional event name")
+			exch := fs.String("exchange", "", "the exchange")
+			pair := fs.String("pair", "", "the currency pair, e.g. BTC-USD")
+			assetType := fs.String("asset", "SPOT", "the asset type")
+			item := fs.String("item", "PRICE", "the condition item, e.g. PRICE or SPREAD")
+			operator := fs.String("condition", "", "the condition operator, e.g. >=")
+			value := fs.Float64("value", 0, "the condition value")
+			window := fs.Duration("window", 0, "the PERCENT_CHANGE window")
+			side := fs.String("side", "", "the DEPTH, fill or LIQUIDITY orderbook side, ASKS fills a buy and BIDS a sell")
+			price := fs.Float64("depthprice", 0, "the DEPTH price")
+			balance := fs.String("balancecurrency", "", "the BALANCE currency")
+			amount := fs.Float64("fillamount", 0, "the AVERAGE_PRICE, SLIPPAGE, MARKET_IMPACT or FILL_PRICE amount")
+			quoteAmount := fs.Bool("quoteamount", false, "the fill amount is in the quote currency")
+			percent := fs.Float64("percent", 0, "the LIQUIDITY percentage from the mid price")
+			act := fs.String("action", "CONSOLE_PRINT", "the event action")
+			rearm := fs.Bool("rearm", false, "re-arm the event after it triggers")
+			cooldown := fs.Duration("cooldown", 0, "the minimum time between triggers and before retrying a failed action, required for re-armed order actions")
+			orderSide := fs.String("orderside", "", "the order action side")
+			orderType := fs.String("ordertype", "", "the order action type")
+			orderAmount := fs.String("orderamount", "", "the order action amount or expression")
+			orderPrice := fs.String("orderprice", "", "the order action price or expression, e.g. \"last minus 0.5%\"")
+			orderID := fs.String("orderid", "", "the order action order ID")
+			maxNotional := fs.Float64("maxnotional", 0, "the order action max notional")
+			dryRun := fs.Bool("dryrun", false, "log the order action instead of executing it")
+			return func(ctx context.Context, c gctrpc.GoCryptoTraderClient) (interface{}, error) {
+				evt := &gctrpc.Event{
+					Name:      *name,
+					Exchange:  *exch,
+					AssetType: *assetType,
+					Conditions: []*gctrpc.EventCondition{{
+						Item:          *item,
+						Operator:      *operator,
+						Value:         *value,
+						WindowSeconds: int64(*window / time.Second),
+						Side:          *side,
+						Price:         *price,
+						Currency:      *balance,
+						Amount:        *amount,
+						QuoteAmount:   *quoteAmount,
+						Percent:       *percent,
+					}},
+					Action:          *act,
+					Rearm:           *rearm,
+					CooldownSeconds: int64(*cooldown / time.Second),
+				}
+
+				if *pair != "" {
+					p, err := parsePair(*pair)
+					if err != nil {
+						return nil, err
+					}
+					evt.Pair = p
+				}
+
+				if *orderSide != "" || *orderType != "" || *orderAmount != "" ||
+					*orderPrice != "" || *orderID != "" {
+					evt.Order = &gctrpc.EventOrderAction{
+						Side:        *orderSide,
+						OrderType:   *orderType,
+						Amount:      *orderAmount,
+						Price:       *orderPrice,
+						OrderId:     *orderID,
+						MaxNotional: *maxNotional,
+						DryRun:      *dryRun,
+					}
+				}
+				return c.AddEvent(ctx, evt)
+			}
+		},
+	},
+	"removeevent": {
+		description: "removes an event",
+		setup: func(fs *flag.FlagSet) action {
+			id := fs.Int64("id", 0, "the event ID")
+			return func(ctx context.Context, c gctrpc.GoCryptoTraderClient) (interface{}, error) {
+				return c.RemoveEvent(ctx, &gctrpc.GenericEventRequest{Id: *id})
+			}
+		},
+	},
+	"rearmevent": {
+		description: "re-arms an executed event",
+		setup: func(fs *flag.FlagSet) action {
+			id := fs.Int64("id", 0, "the event ID")
+			return func(ctx context.Context, c gctrpc.GoCryptoTraderClient) (interface{}, error) {
+				return c.RearmEvent(ctx, &gctrpc.GenericEventRequest{Id: *id})
+			}
+		},
+	},
+	"getportfolio": {
+		description: "gets the portfolio addresses",
+		setup: func(_ *flag.FlagSet) action {
+			return func(ctx context.Context, c gctrpc.GoCryptoTraderClient) (interface{}, error) {
+				return c.GetPortfolio(ctx, &gctrpc.GetPortfolioRequest{})
+			}
+		},
+	},
+	"getportfoliosummary": {
+		description: "gets the portfolio summary",
+		setup: func(_ *flag.FlagSet) action {
+			return func(ctx context.Context, c gctrpc.GoCryptoTraderClient) (interface{}, error) {
+				return c.GetPortfolioSummary(ctx, &gctrpc.GetPortfolioSummaryRequest{})
+			}
+		},
+	},
+	"addportfolioaddress": {
+		description: "adds an address to the portfolio",
+		setup: func(fs *flag.FlagSet) action {
+			address := fs.String("address", "", "the address")
+			coinType := fs.String("coin", "", "the coin type, e.g. BTC")
+			description := fs.String("description", "", "an optional description")
+			balance := fs.Float64("balance", 0, "the current balance")
+			return func(ctx context.Context, c gctrpc.GoCryptoTraderClient) (interface{}, error) {
+				return c.AddPortfolioAddress(ctx, &gctrpc.AddPortfolioAddressRequest{
+					Address:     *address,
+					CoinType:    *coinType,
+					Description: *description,
+					Balance:     *balance,
+				})
+			}
+		},
+	},
+	"removeportfolioaddress": {
+		description: "removes an address from the portfolio",
+		setup: func(fs *flag.FlagSet) action {
+			address := fs.String("address", "", "the address")
+			coinType := fs.String("coin", "", "the coin type, e.g. BTC")
+			description := fs.String("description", "", "the address description")
+			return func(ctx context.Context, c gctrpc.GoCryptoTraderClient) (interface{}, error) {
+				return c.RemovePortfolioAddress(ctx, &gctrpc.RemovePortfolioAddressRequest{
+					Address:     *address,
+					CoinType:    *coinType,
+					Description: *description,
+				})
+			}
+		},
+	},
+}
+
+func setSubsystem(enable bool) func(fs *flag.FlagSet) action {
+	return func(fs *flag.FlagSet) action {
+		subsystem := fs.String("subsystem", "", "the subsystem name")
+		return func(ctx context.Context, c gctrpc.GoCryptoTraderClient) (interface{}, error) {
+			if *subsystem == "" {
+				return nil, errors.New("subsystem is required")
+			}
+			return c.SetSubsystem(ctx, &gctrpc.SetSubsystemRequest{
+				Subsystem: *subsystem,
+				Enable:    enable,
+			})
+		}
+	}
+}
+
+// parsePair parses a currency pair in the form BASE-QUOTE, BASE_QUOTE or
+// BASE/QUOTE
+func parsePair(pair string) (*gctrpc.CurrencyPair, error) {
+	for _, delimiter := range []string{"-", "_", "/"} {
+		if parts := strings.Split(pair, delimiter); len(parts) == 2 &&
+			parts[0] != "" && parts[1] != "" {
+			return &gctrpc.CurrencyPair{
+				Base:      strings.ToUpper(parts[0]),
+				Quote:     strings.ToUpper(parts[1]),
+				Delimiter: delimiter,
+			}, nil
+		}
+	}
+	return nil, errors.New("currency pair must be in the form BASE-QUOTE")
+}
@@ -0,0 +1,164 @@
+package main
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/gctrpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+var (
+	host       string
+	username   string
+	password   string
+	certPath   string
+	clientCert string
+	clientKey  string
+	timeout    time.Duration
+)
+
+// basicAuth sends the webserver admin credentials with each request
+type basicAuth struct {
+	username string
+	password string
+}
+
+// GetRequestMetadata returns the HTTP basic auth header for each request
+func (b basicAuth) GetRequestMetadata(_ context.Context, _ ...string) (map[string]string, error) {
+	auth := base64.StdEncoding.EncodeToString([]byte(b.username + ":" + b.password))
+	return map[string]string{"authorization": "Basic " + auth}, nil
+}
+
+// RequireTransportSecurity requires the credentials to be sent over TLS
+func (basicAuth) RequireTransportSecurity() bool {
+	return true
+}
+
+func setupClient() (*grpc.ClientConn, error) {
+	data, err := ioutil.ReadFile(certPath)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read server certificate: %s", err)
+	}
+
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(data) {
+		return nil, errors.New("unable to parse server certificate")
+	}
+
+	tlsConfig := &tls.Config{RootCAs: pool}
+	if clientCert != "" || clientKey != "" {
+		cert, err := tls.LoadX509KeyPair(clientCert, clientKey)
+		if err != nil {
+			return nil, fmt.Errorf("unable to load client certificate: %s", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	opts := []grpc.DialOption{
+		grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig)),
+	}
+
+	if username != "" || password != "" {
+		opts = append(opts, grpc.WithPerRPCCredentials(basicAuth{
+			username: username,
+			password: password,
+		}))
+	}
+	return grpc.Dial(host, opts...)
+}
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "gctcli is a command line interface for the GoCryptoTrader gRPC server\n\n")
+	fmt.Fprintf(os.Stderr, "Usage: gctcli [flags] <command> [command flags]\n\nFlags:\n")
+	flag.PrintDefaults()
+	fmt.Fprintf(os.Stderr, "\nCommands:\n")
+
+	names := make([]string, 0, len(commands))
+	for name := range commands {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		fmt.Fprintf(os.Stderr, "  %-28s %s\n", name, commands[name].description)
+	}
+	fmt.Fprintf(os.Stderr, "\nRun gctcli <command> -h for command flags\n")
+}
+
+func main() {
+	defaultCert := filepath.Join(common.GetDefaultDataDir(runtime.GOOS), "tls",
+		"cert.pem")
+
+	flag.StringVar(&host, "host", "localhost:9052", "the gRPC server address")
+	flag.StringVar(&username, "username", os.Getenv("GCT_USERNAME"), "the webserver admin username, defaults to $GCT_USERNAME")
+	flag.StringVar(&password, "password", os.Getenv("GCT_PASSWORD"), "the webserver admin password, defaults to $GCT_PASSWORD")
+	flag.StringVar(&certPath, "cert", defaultCert, "the server TLS certificate")
+	flag.StringVar(&clientCert, "clientcert", "", "the client TLS certificate used instead of the admin credentials")
+	flag.StringVar(&clientKey, "clientkey", "", "the client TLS key")
+	flag.DurationVar(&timeout, "timeout", time.Second*30, "the request timeout")
+	flag.Usage = usage
+	flag.Parse()
+
+	if flag.NArg() == 0 {
+		usage()
+		os.Exit(1)
+	}
+
+	cmd, ok := commands[flag.Arg(0)]
+	if !ok {
+		fmt.Fprintf(os.Stderr, "Unknown command %q\n\n", flag.Arg(0))
+		usage()
+		os.Exit(1)
+	}
+
+	fs := flag.NewFlagSet(flag.Arg(0), flag.ExitOnError)
+	err := execute(cmd.setup(fs), fs)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+// execute parses the command flags, runs the action and prints the response
+// as JSON
+func execute(run action, fs *flag.FlagSet) error {
+	err := fs.Parse(flag.Args()[1:])
+	if err != nil {
+		return err
+	}
+
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	resp, err := run(ctx, gctrpc.NewGoCryptoTraderClient(conn))
+	if err != nil {
+		return err
+	}
+
+	data, err := json.MarshalIndent(resp, "", " ")
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(data))
+	return nil
+}
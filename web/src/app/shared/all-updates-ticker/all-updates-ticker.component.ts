@@ -1,6 +1,6 @@
 import {   Component,  OnInit,  OnDestroy} from '@angular/core';
 import {   WebsocketResponseHandlerService } from './../../services/websocket-response-handler/websocket-response-handler.service';
-import {  WebSocketMessageType } from './../../shared/classes/websocket';
+import {  WebSocketMessageType, WebSocketMessage } from './../../shared/classes/websocket';
 import {  ExchangeCurrency, TickerUpdate } from './../../shared/classes/ticker';
 
 @Component({
@@ -44,6 +44,8 @@ export class AllEnabledCurrencyTickersComponent implements OnInit {
   }
 
   ngOnInit() {
+    this.ws.messages.next(WebSocketMessage.CreateSubscribeMessage('ticker',
+      window.localStorage['selectedExchange'], window.localStorage['selectedCurrency']));
   }
 
   private stripCurrencyCharacters(name: string): string {
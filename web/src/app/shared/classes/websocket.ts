@@ -4,6 +4,8 @@ export class WebSocketMessageType {
     public static SaveConfig = 'SaveConfig';
     public static GetPortfolio = 'GetPortfolio';
     public static TickerUpdate = 'ticker_update';
+    public static Subscribe = 'subscribe';
+    public static Unsubscribe = 'unsubscribe';
 }
 
 export class WebSocketMessage {
@@ -22,6 +24,15 @@ export class WebSocketMessage {
         return response;
     }
 
+    public static CreateSubscribeMessage(dataType: string, exchange?: string, pair?: string): WebSocketMessage {
+        const response = new WebSocketMessage();
+
+        response.event = WebSocketMessageType.Subscribe;
+        response.data = { 'dataType': dataType, 'exchange': exchange, 'pair': pair };
+
+        return response;
+    }
+
     public static GetSettingsMessage(): WebSocketMessage {
         const response = new WebSocketMessage();
 